@@ -36,6 +36,7 @@ var defaultSupportedFeatures = []string{
 	string(features.OverSubscriptionFeature),
 	string(features.EvictionFeature),
 	string(features.ResourcesFeature),
+	string(features.GPUResetFeature),
 }
 
 type VolcanoAgentOptions struct {
@@ -38,6 +38,8 @@ func main() {
 	rootCmd.AddCommand(buildJobTemplateCmd())
 	rootCmd.AddCommand(buildJobFlowCmd())
 	rootCmd.AddCommand(buildPodCmd())
+	rootCmd.AddCommand(buildCapacityCmd())
+	rootCmd.AddCommand(buildConfigCmd())
 	rootCmd.AddCommand(versionCommand())
 
 	code := cli.Run(&rootCmd)
@@ -44,7 +44,7 @@ const (
 	defaultPodGroupWorkers     = 5
 	defaultQueueWorkers        = 5
 	defaultGCWorkers           = 1
-	defaultControllers         = "*,-sharding-controller"
+	defaultControllers         = "*,-sharding-controller,-provisioning-controller,-nodeclaim-controller,-kueue-controller,-dispatch-controller,-ray-controller,-trainjob-controller"
 )
 
 // ServerOption is the main context object for the controllers.
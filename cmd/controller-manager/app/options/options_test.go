@@ -105,7 +105,7 @@ func TestAddFlags(t *testing.T) {
 		WorkerThreadsForPG:    5,
 		WorkerThreadsForQueue: 5,
 		WorkerThreadsForGC:    1,
-		Controllers:           strings.Split("*,-sharding-controller", ","),
+		Controllers:           strings.Split("*,-sharding-controller,-provisioning-controller,-nodeclaim-controller,-kueue-controller,-dispatch-controller,-ray-controller,-trainjob-controller", ","),
 	}
 	expectedFeatureGates := map[featuregate.Feature]bool{features.ResourceTopology: false}
 
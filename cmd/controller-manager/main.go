@@ -35,15 +35,22 @@ import (
 	"volcano.sh/volcano/cmd/controller-manager/app/options"
 	_ "volcano.sh/volcano/pkg/controllers/colocationconfig"
 	_ "volcano.sh/volcano/pkg/controllers/cronjob"
+	_ "volcano.sh/volcano/pkg/controllers/dispatch"
 	"volcano.sh/volcano/pkg/controllers/framework"
 	_ "volcano.sh/volcano/pkg/controllers/garbagecollector"
 	_ "volcano.sh/volcano/pkg/controllers/hypernode"
 	_ "volcano.sh/volcano/pkg/controllers/job"
 	_ "volcano.sh/volcano/pkg/controllers/jobflow"
+	_ "volcano.sh/volcano/pkg/controllers/jobscaler"
 	_ "volcano.sh/volcano/pkg/controllers/jobtemplate"
+	_ "volcano.sh/volcano/pkg/controllers/kueue"
+	_ "volcano.sh/volcano/pkg/controllers/nodeclaim"
 	_ "volcano.sh/volcano/pkg/controllers/podgroup"
+	_ "volcano.sh/volcano/pkg/controllers/provisioning"
 	_ "volcano.sh/volcano/pkg/controllers/queue"
+	_ "volcano.sh/volcano/pkg/controllers/ray"
 	_ "volcano.sh/volcano/pkg/controllers/sharding"
+	_ "volcano.sh/volcano/pkg/controllers/trainjob"
 	commonutil "volcano.sh/volcano/pkg/util"
 	"volcano.sh/volcano/pkg/version"
 )
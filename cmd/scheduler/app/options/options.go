@@ -51,6 +51,17 @@ const (
 	defaultPercentageOfNodesToFind    = 0
 	defaultLockObjectNamespace        = "volcano-system"
 	defaultNodeWorkers                = 20
+
+	// Default parameters to quarantine the nodes failing binds or evictions repeatedly
+	defaultNodeQuarantineThreshold  = 0
+	defaultNodeQuarantineBackoff    = time.Minute
+	defaultNodeQuarantineMaxBackoff = 10 * time.Minute
+
+	defaultGPUResetTimeout = 5 * time.Minute
+
+	defaultVictimGuardPeriod = 0
+
+	defaultPowerEfficiencyLabel = "volcano.sh/power-efficiency"
 )
 
 var (
@@ -78,11 +89,14 @@ type ServerOption struct {
 	PrintVersion        bool
 	EnableMetrics       bool
 	EnablePprof         bool
+	EnableOpenAPI       bool
 	ListenAddress       string
 	EnablePriorityClass bool
 	// EnableCSIStorage registers CSIDriver and CSIStorageCapacity informers on the scheduler cache.
 	// It does not change upstream VolumeBinding behavior (kube VolumeBinding uses the shared informer factory separately).
 	EnableCSIStorage bool
+	// EnablePluginProfiling records the execution time of every plugin function, by plugin, extension point and action.
+	EnablePluginProfiling bool
 	// vc-scheduler will load (not activate) custom plugins which are in this directory
 	PluginsDir    string
 	EnableHealthz bool
@@ -100,7 +114,7 @@ type ServerOption struct {
 	NodeWorkerThreads uint32
 
 	// GateRemovalWorkerNum is the number of async workers for scheduling gate removal.
-	// Only used when SchedulingGatesQueueAdmission feature gate is enabled.
+	// Only used when SchedulingGatesQueueAdmission or SchedulingGatesGangAdmission feature gate is enabled.
 	GateRemovalWorkerNum int
 
 	// IgnoredCSIProvisioners contains a list of provisioners, and pod request pvc with these provisioners will
@@ -111,6 +125,30 @@ type ServerOption struct {
 	// timeout on waiting for handlers handle initial resource synchronization before starting scheduling, 0 will skip waiting
 	ResourceSyncTimeout time.Duration
 
+	// NodeQuarantineThreshold is the number of consecutive binds or evictions failed on a node which quarantine
+	// the node from placement, 0 disables the quarantine.
+	NodeQuarantineThreshold int
+	// NodeQuarantineBackoff is the duration of the first quarantine of a node, doubled for each following
+	// quarantine up to NodeQuarantineMaxBackoff until a bind or an eviction succeeds on the node.
+	NodeQuarantineBackoff    time.Duration
+	NodeQuarantineMaxBackoff time.Duration
+
+	// GPUResetTimeout is the longest time the GPU tasks are not bound to a node waiting for its volcano agent to
+	// clean up the GPUs after evicting the GPU tasks of another queue, 0 disables the GPU cleanup requests.
+	GPUResetTimeout time.Duration
+
+	// VictimGuardPeriod is the longest time the capacity freed on a node by evicting the victims of reclaim or preempt is
+	// held for the job they were evicted for, until the job binds a task to the node. 0 disables the guards.
+	VictimGuardPeriod time.Duration
+
+	// NodeTieBreakers are applied in order to pick among the nodes with the same score in allocate, preempt and
+	// reclaim, the node name breaking the remaining ties. The nodes with the same score are picked randomly if
+	// none is configured.
+	NodeTieBreakers []string
+	// PowerEfficiencyLabel is the node label holding the power efficiency of the node used by the
+	// power-efficiency tie-breaker, the nodes with a higher value are preferred.
+	PowerEfficiencyLabel string
+
 	// DisableDefaultSchedulerConfig indicates if the scheduler should fallback to default
 	// config if the current scheduler config is invalid
 	DisableDefaultSchedulerConfig bool
@@ -135,6 +173,14 @@ func NewServerOption() *ServerOption {
 	}
 }
 
+// NewDefaultServerOption creates a new ServerOption with the default values of the flags,
+// for the schedulers which are not configured by the command line, e.g. embedded in another program.
+func NewDefaultServerOption() *ServerOption {
+	s := NewServerOption()
+	s.AddFlags(pflag.NewFlagSet("default", pflag.ContinueOnError))
+	return s
+}
+
 // AddFlags adds flags for a specific CMServer to the specified FlagSet.
 func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.KubeClientOptions.Master, "master", s.KubeClientOptions.Master, "The address of the Kubernetes API server (overrides any value in kubeconfig)")
@@ -175,13 +221,22 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableHealthz, "enable-healthz", false, "Enable the health check; it is false by default")
 	fs.BoolVar(&s.EnableMetrics, "enable-metrics", false, "Enable the metrics function; it is false by default")
 	fs.BoolVar(&s.EnablePprof, "enable-pprof", false, "Enable the pprof endpoint; it is false by default")
+	fs.BoolVar(&s.EnableOpenAPI, "enable-openapi", false, "Enable the endpoint serving the OpenAPI document of the Volcano resources and scheduler endpoints; it is false by default")
+	fs.BoolVar(&s.EnablePluginProfiling, "enable-plugin-profiling", false, "Enable the execution time metrics of every plugin function, by plugin and action; it is false by default")
 	fs.StringSliceVar(&s.NodeSelector, "node-selector", nil, "volcano only work with the labeled node, like: --node-selector=volcano.sh/role:train --node-selector=volcano.sh/role:serving")
 	fs.BoolVar(&s.EnableCacheDumper, "cache-dumper", true, "Enable the cache dumper, it's true by default")
 	fs.StringVar(&s.CacheDumpFileDir, "cache-dump-dir", "/tmp", "The target dir where the json file put at when dump cache info to json file")
 	fs.Uint32Var(&s.NodeWorkerThreads, "node-worker-threads", defaultNodeWorkers, "The number of threads syncing node operations.")
-	fs.IntVar(&s.GateRemovalWorkerNum, "gate-removal-worker-num", 5, "The number of async workers for scheduling gate removal (used when SchedulingGatesQueueAdmission is enabled).")
+	fs.IntVar(&s.GateRemovalWorkerNum, "gate-removal-worker-num", 5, "The number of async workers for scheduling gate removal (used when SchedulingGatesQueueAdmission or SchedulingGatesGangAdmission is enabled).")
 	fs.StringSliceVar(&s.IgnoredCSIProvisioners, "ignored-provisioners", nil, "The provisioners that will be ignored during pod pvc request computation and preemption.")
 	fs.DurationVar(&s.ResourceSyncTimeout, "resource-sync-timeout", defaultResourceSyncTimeout, "timeout on waiting for handler handling initial resources synchronization before starting scheduler, default is 60s, 0 skip waiting")
+	fs.IntVar(&s.NodeQuarantineThreshold, "node-quarantine-threshold", defaultNodeQuarantineThreshold, "The number of consecutive binds or evictions failed on a node which quarantine the node from placement, 0 (the default) disables the quarantine.")
+	fs.DurationVar(&s.NodeQuarantineBackoff, "node-quarantine-backoff", defaultNodeQuarantineBackoff, "The duration of the first quarantine of a node, doubled for each following quarantine until a bind or an eviction succeeds on the node.")
+	fs.DurationVar(&s.NodeQuarantineMaxBackoff, "node-quarantine-max-backoff", defaultNodeQuarantineMaxBackoff, "The maximum duration of the quarantine of a node.")
+	fs.DurationVar(&s.GPUResetTimeout, "gpu-reset-timeout", defaultGPUResetTimeout, "The longest time the GPU tasks are not bound to a node waiting for its volcano agent to clean up the GPUs after evicting the GPU tasks of another queue, 0 disables the GPU cleanup requests.")
+	fs.DurationVar(&s.VictimGuardPeriod, "victim-guard-period", defaultVictimGuardPeriod, "The longest time the capacity freed on a node by evicting victims is held for the job they were evicted for, until the job binds a task to the node, 0 (the default) disables the guards.")
+	fs.StringSliceVar(&s.NodeTieBreakers, "node-tie-breakers", nil, "The tie-breakers applied in order to pick among the nodes with the same score, node-name-hash|least-recently-bound|power-efficiency are supported; the nodes with the same score are picked randomly if none is configured.")
+	fs.StringVar(&s.PowerEfficiencyLabel, "power-efficiency-label", defaultPowerEfficiencyLabel, "The node label holding the power efficiency of the node used by the power-efficiency tie-breaker, the nodes with a higher value are preferred.")
 	fs.BoolVar(&s.DisableDefaultSchedulerConfig, "disable-default-scheduler-config", false, "The flag indicates whether the scheduler should avoid using the default configuration if the provided scheduler configuration is invalid.")
 	fs.StringVar(&s.ShardingMode, "scheduler-sharding-mode", util.NoneShardingMode, "The node sharding mode for scheduling, none(default)|hard|soft mode is supported")
 	fs.StringVar(&s.ShardName, "scheduler-sharding-name", defaultShardName, "The name of shard used for this scheduler")
@@ -189,6 +244,13 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 
 // CheckOptionOrDie check leader election flag when LeaderElection is enabled.
 func (s *ServerOption) CheckOptionOrDie() error {
+	for _, tieBreaker := range s.NodeTieBreakers {
+		switch tieBreaker {
+		case util.NodeNameHashTieBreaker, util.LeastRecentlyBoundTieBreaker, util.PowerEfficiencyTieBreaker:
+		default:
+			return fmt.Errorf("unsupported node tie-breaker %q", tieBreaker)
+		}
+	}
 	return componentbaseconfigvalidation.ValidateLeaderElectionConfiguration(&s.LeaderElection, field.NewPath("leaderElection")).ToAggregate()
 }
 
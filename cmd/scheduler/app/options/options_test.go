@@ -89,6 +89,12 @@ func TestAddFlags(t *testing.T) {
 		ShardingMode:                  commonutil.NoneShardingMode,
 		ShardName:                     defaultSchedulerName,
 		ResourceSyncTimeout:           60 * time.Second,
+		NodeQuarantineThreshold:       defaultNodeQuarantineThreshold,
+		NodeQuarantineBackoff:         defaultNodeQuarantineBackoff,
+		NodeQuarantineMaxBackoff:      defaultNodeQuarantineMaxBackoff,
+		GPUResetTimeout:               defaultGPUResetTimeout,
+		VictimGuardPeriod:             defaultVictimGuardPeriod,
+		PowerEfficiencyLabel:          defaultPowerEfficiencyLabel,
 	}
 	expectedFeatureGates := map[featuregate.Feature]bool{
 		features.PodDisruptionBudgetsSupport: false,
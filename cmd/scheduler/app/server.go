@@ -24,11 +24,14 @@ import (
 	"os"
 
 	"volcano.sh/apis/pkg/apis/helpers"
+	"volcano.sh/volcano/api/openapi"
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/kube"
 	"volcano.sh/volcano/pkg/scheduler"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
+	scheduleropenapi "volcano.sh/volcano/pkg/scheduler/openapi"
+	"volcano.sh/volcano/pkg/scheduler/plugins/sizing"
 	"volcano.sh/volcano/pkg/signals"
 	commonutil "volcano.sh/volcano/pkg/util"
 
@@ -80,8 +83,8 @@ func Run(opt *options.ServerOption) error {
 	// k8smetrics.Goroutines which is used by Kubernetes scheduler framework plugins
 	metrics.InitKubeSchedulerRelatedMetrics()
 
-	if opt.EnableMetrics || opt.EnablePprof {
-		go startMetricsServer(opt)
+	if opt.EnableMetrics || opt.EnablePprof || opt.EnableOpenAPI {
+		go startMetricsServer(opt, sched)
 	}
 
 	if opt.EnableHealthz {
@@ -151,7 +154,7 @@ func Run(opt *options.ServerOption) error {
 	return fmt.Errorf("lost lease")
 }
 
-func startMetricsServer(opt *options.ServerOption) {
+func startMetricsServer(opt *options.ServerOption, sched *scheduler.Scheduler) {
 	mux := http.NewServeMux()
 
 	if opt.EnableMetrics {
@@ -166,6 +169,14 @@ func startMetricsServer(opt *options.ServerOption) {
 		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 	}
 
+	if opt.EnableOpenAPI {
+		mux.Handle(scheduleropenapi.DocumentPath, scheduleropenapi.Handler(openapi.Document))
+	}
+
+	mux.Handle(scheduler.ConfigValidatePath, scheduler.ValidateConfigHandler())
+	mux.Handle(scheduler.ConfigEffectivePath, sched.EffectiveConfigHandler())
+	mux.Handle(sizing.Path, sizing.Handler())
+
 	server := &http.Server{
 		Addr:              opt.ListenAddress,
 		Handler:           mux,
@@ -175,6 +186,6 @@ func startMetricsServer(opt *options.ServerOption) {
 	}
 
 	if err := server.ListenAndServe(); err != nil {
-		klog.Errorf("start metrics/pprof http server failed: %v", err)
+		klog.Errorf("start metrics/pprof/openapi http server failed: %v", err)
 	}
 }
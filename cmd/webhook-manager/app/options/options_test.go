@@ -61,6 +61,7 @@ func TestAddFlags(t *testing.T) {
 		EnableQueueAllocatedPodsCheck: false,
 		MaxQueueDepth:                 defaultMaxQueueDepth,
 		EnableRootQueueProtection:     true,
+		ControllerUser:                defaultControllerUser,
 	}
 
 	if !equality.Semantic.DeepEqual(expected, s) {
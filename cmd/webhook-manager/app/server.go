@@ -104,6 +104,7 @@ func Run(config *options.Config) error {
 			service.Config.EnableQueueAllocatedPodsCheck = config.EnableQueueAllocatedPodsCheck
 			service.Config.MaxQueueDepth = config.MaxQueueDepth
 			service.Config.EnableRootQueueProtection = config.EnableRootQueueProtection
+			service.Config.ControllerUser = config.ControllerUser
 		}
 
 		klog.V(3).Infof("Registered '%s' as webhook.", service.Path)
@@ -0,0 +1,34 @@
+#!/bin/bash
+
+# Copyright 2025 The Volcano Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+VK_ROOT=$(unset CDPATH && cd $(dirname "${BASH_SOURCE[0]}")/.. && pwd)
+CRD_DIR=${VK_ROOT}/config/crd/volcano/bases
+OPENAPI_DIR=${VK_ROOT}/api/openapi
+
+# The resources exposed in the OpenAPI document, typed clients are generated from it.
+CRDS=(
+  "${CRD_DIR}/batch.volcano.sh_jobs.yaml"
+  "${CRD_DIR}/scheduling.volcano.sh_queues.yaml"
+  "${CRD_DIR}/scheduling.volcano.sh_podgroups.yaml"
+)
+
+cd "${VK_ROOT}"
+go run ./hack/openapi-gen --output "${OPENAPI_DIR}/volcano.json" "${CRDS[@]}"
+echo "OpenAPI document generated at ${OPENAPI_DIR}/volcano.json"
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// openapi-gen builds the OpenAPI document of the Volcano resources and the scheduler
+// endpoints from the CustomResourceDefinition manifests.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"volcano.sh/volcano/pkg/scheduler/openapi"
+)
+
+func main() {
+	output := flag.String("output", "api/openapi/volcano.json", "the file the OpenAPI document is written to")
+	flag.Parse()
+
+	if err := generate(*output, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate OpenAPI document: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func generate(output string, crdFiles []string) error {
+	if len(crdFiles) == 0 {
+		return fmt.Errorf("no CustomResourceDefinition manifests given")
+	}
+
+	var crds [][]byte
+	for _, f := range crdFiles {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		crds = append(crds, data)
+	}
+
+	doc, err := openapi.NewDocument(crds...)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, append(data, '\n'), 0644)
+}
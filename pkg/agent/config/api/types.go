@@ -71,6 +71,9 @@ type ColocationConfig struct {
 
 	// cpuThrottling related config
 	CPUThrottlingConfig *CPUThrottling `json:"cpuThrottlingConfig,omitempty" configKey:"CPUThrottling"`
+
+	// gpu reset related config.
+	GPUResetConfig *GPUReset `json:"gpuResetConfig,omitempty" configKey:"GPUReset"`
 }
 
 type CPUQos struct {
@@ -129,3 +132,13 @@ type CPUThrottling struct {
 	// CPURecoverLimitPercent defines the maximum percent CPU quota increase allowed per interval.
 	CPURecoverLimitPercent *int `json:"cpuRecoverLimitPercent,omitempty"`
 }
+
+type GPUReset struct {
+	// Enable GPUReset or not.
+	Enable *bool `json:"enable,omitempty"`
+	// Command cleans up the GPUs of the node once the GPU pods evicted for the pods of another queue are gone,
+	// e.g. resets the GPUs or restarts the MPS daemon. It is run by "sh -c".
+	Command *string `json:"command,omitempty"`
+	// TimeoutSeconds is the timeout of the command, 60 by default.
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+}
@@ -38,6 +38,8 @@ var (
 	IllegalCPUThrottlingThreshold                                = "cpuThrottlingThreshold must be a positive number between 1 and 100"
 	IllegalCPUJitterLimitPercent                                 = "cpuJitterLimitPercent must be a non-negative number between 1 and 100"
 	IllegalCPURecoverLimitPercent                                = "cpuRecoverLimitPercent must be a positive number"
+	IllegalGPUResetCommand                                       = "command must be set when gpu reset is enabled"
+	IllegalGPUResetTimeoutSeconds                                = "timeoutSeconds must be a positive number"
 )
 
 type Validate interface {
@@ -147,6 +149,7 @@ func (c *ColocationConfig) Validate() []error {
 	errs = append(errs, c.OverSubscriptionConfig.Validate()...)
 	errs = append(errs, c.EvictingConfig.Validate()...)
 	errs = append(errs, c.CPUThrottlingConfig.Validate()...)
+	errs = append(errs, c.GPUResetConfig.Validate()...)
 	return errs
 }
 
@@ -171,3 +174,18 @@ func (c *CPUThrottling) Validate() []error {
 
 	return errs
 }
+
+func (g *GPUReset) Validate() []error {
+	if g == nil {
+		return nil
+	}
+
+	var errs []error
+	if g.Enable != nil && *g.Enable && (g.Command == nil || strings.TrimSpace(*g.Command) == "") {
+		errs = append(errs, errors.New(IllegalGPUResetCommand))
+	}
+	if g.TimeoutSeconds != nil && *g.TimeoutSeconds <= 0 {
+		errs = append(errs, errors.New(IllegalGPUResetTimeoutSeconds))
+	}
+	return errs
+}
@@ -163,6 +163,16 @@ func TestColocationConfigValidate(t *testing.T) {
 			},
 			expectedErr: []error{errors.New(IllegalCPURecoverLimitPercent)},
 		},
+		{
+			name: "illegal GPUResetConfig && enabled without command",
+			colocationCfg: &ColocationConfig{
+				GPUResetConfig: &GPUReset{
+					Enable:         utilpointer.Bool(true),
+					TimeoutSeconds: utilpointer.Int(0),
+				},
+			},
+			expectedErr: []error{errors.New(IllegalGPUResetCommand), errors.New(IllegalGPUResetTimeoutSeconds)},
+		},
 	}
 
 	for _, tc := range testCases {
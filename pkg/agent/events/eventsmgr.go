@@ -34,11 +34,13 @@ import (
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/cpuqos"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/cputhrottle"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/eviction"
+	_ "volcano.sh/volcano/pkg/agent/events/handlers/gpureset"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/memoryqos"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/memoryqosv2"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/networkqos"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/oversubscription"
 	_ "volcano.sh/volcano/pkg/agent/events/handlers/resources"
+	_ "volcano.sh/volcano/pkg/agent/events/probes/gpureset"
 	_ "volcano.sh/volcano/pkg/agent/events/probes/nodemonitor"
 	_ "volcano.sh/volcano/pkg/agent/events/probes/noderesources"
 	_ "volcano.sh/volcano/pkg/agent/events/probes/pods"
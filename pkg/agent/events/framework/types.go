@@ -33,6 +33,8 @@ const (
 	NodeMonitorEventName EventName = "NodeUtilizationSync"
 
 	NodeCPUThrottleEventName EventName = "NodeCPUThrottleSync"
+
+	NodeGPUResetEventName EventName = "NodeGPUResetSync"
 )
 
 type PodEvent struct {
@@ -61,3 +63,9 @@ type NodeCPUThrottleEvent struct {
 	Resource      corev1.ResourceName
 	CPUQuotaMilli int64
 }
+
+// NodeGPUResetEvent defines the GPU cleanup requested by the scheduler, once the evicted GPU pods are gone.
+type NodeGPUResetEvent struct {
+	// Request is the value of the request annotated on the node.
+	Request string
+}
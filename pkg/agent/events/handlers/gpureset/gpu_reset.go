@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpureset
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/agent/config/api"
+	"volcano.sh/volcano/pkg/agent/events/framework"
+	"volcano.sh/volcano/pkg/agent/events/handlers"
+	"volcano.sh/volcano/pkg/agent/events/handlers/base"
+	"volcano.sh/volcano/pkg/agent/features"
+	"volcano.sh/volcano/pkg/agent/utils/cgroup"
+	"volcano.sh/volcano/pkg/agent/utils/exec"
+	utilnode "volcano.sh/volcano/pkg/agent/utils/node"
+	"volcano.sh/volcano/pkg/config"
+	"volcano.sh/volcano/pkg/metriccollect"
+)
+
+const defaultTimeoutSeconds = 60
+
+func init() {
+	handlers.RegisterEventHandleFunc(string(framework.NodeGPUResetEventName), NewGPUResetHandler)
+}
+
+// GPUResetHandler runs the GPU cleanup command on request of the scheduler, and confirms the cleanup to the
+// scheduler by the node annotation, so the GPU pods of another queue are bound to the node after the cleanup.
+type GPUResetHandler struct {
+	*base.BaseHandle
+	command string
+	timeout time.Duration
+
+	mutex sync.Mutex
+	// done is the last request the cleanup is done for, the probe may see the request again before the
+	// confirmation is seen on the node.
+	done string
+
+	announce func(*config.Configuration) error
+	withdraw func(*config.Configuration) error
+	confirm  func(*config.Configuration, string) error
+}
+
+func NewGPUResetHandler(config *config.Configuration, mgr *metriccollect.MetricCollectorManager, cgroupMgr cgroup.CgroupManager) framework.Handle {
+	return &GPUResetHandler{
+		BaseHandle: &base.BaseHandle{
+			Name:   string(features.GPUResetFeature),
+			Config: config,
+		},
+		timeout:  defaultTimeoutSeconds * time.Second,
+		announce: utilnode.AddGPUResetAgentAnnotation,
+		withdraw: utilnode.RemoveGPUResetAgentAnnotation,
+		confirm:  utilnode.ConfirmGPUReset,
+	}
+}
+
+func (h *GPUResetHandler) Handle(event interface{}) error {
+	resetEvent, ok := event.(framework.NodeGPUResetEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type for gpu reset handler")
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if resetEvent.Request == h.done {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+	klog.InfoS("Resetting gpus of the node", "request", resetEvent.Request, "command", h.command)
+	if output, err := exec.GetExecutor().CommandContext(ctx, h.command); err != nil {
+		return fmt.Errorf("failed to reset gpus for request %s: %v, output: %s", resetEvent.Request, err, output)
+	}
+	if err := h.confirm(h.Config, resetEvent.Request); err != nil {
+		return fmt.Errorf("failed to confirm gpu reset for request %s: %v", resetEvent.Request, err)
+	}
+	h.done = resetEvent.Request
+	klog.InfoS("Successfully reset gpus of the node", "request", resetEvent.Request)
+	return nil
+}
+
+// RefreshCfg refreshes the command, and announces to the scheduler whether the agent cleans up the GPUs of the node.
+func (h *GPUResetHandler) RefreshCfg(cfg *api.ColocationConfig) error {
+	if err := h.BaseHandle.RefreshCfg(cfg); err != nil {
+		return err
+	}
+
+	h.mutex.Lock()
+	h.command, h.timeout = "", defaultTimeoutSeconds*time.Second
+	if cfg.GPUResetConfig != nil {
+		if cfg.GPUResetConfig.Command != nil {
+			h.command = *cfg.GPUResetConfig.Command
+		}
+		if cfg.GPUResetConfig.TimeoutSeconds != nil {
+			h.timeout = time.Duration(*cfg.GPUResetConfig.TimeoutSeconds) * time.Second
+		}
+	}
+	h.mutex.Unlock()
+
+	if h.IsActive() {
+		return h.announce(h.Config)
+	}
+	return h.withdraw(h.Config)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpureset
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	utilpointer "k8s.io/utils/pointer"
+
+	"volcano.sh/volcano/pkg/agent/config/api"
+	"volcano.sh/volcano/pkg/agent/events/framework"
+	"volcano.sh/volcano/pkg/agent/events/handlers/base"
+	"volcano.sh/volcano/pkg/agent/features"
+	"volcano.sh/volcano/pkg/agent/utils/exec"
+	"volcano.sh/volcano/pkg/agent/utils/exec/mocks"
+	"volcano.sh/volcano/pkg/config"
+)
+
+func TestGPUResetHandler_Handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	executor := mocks.NewMockExecInterface(ctrl)
+	exec.SetExecutor(executor)
+	defer exec.SetExecutor(&exec.Executor{})
+
+	var confirmed []string
+	confirmErr := errors.New("conflict")
+	failConfirm := false
+	h := &GPUResetHandler{
+		BaseHandle: &base.BaseHandle{Name: string(features.GPUResetFeature), Config: &config.Configuration{}},
+		command:    "nvidia-smi --gpu-reset",
+		timeout:    time.Minute,
+		confirm: func(_ *config.Configuration, request string) error {
+			if failConfirm {
+				return confirmErr
+			}
+			confirmed = append(confirmed, request)
+			return nil
+		},
+	}
+
+	assert.Error(t, h.Handle(framework.NodeCPUThrottleEvent{}))
+
+	executor.EXPECT().CommandContext(gomock.Any(), "nvidia-smi --gpu-reset").Return("", errors.New("device busy"))
+	assert.Error(t, h.Handle(framework.NodeGPUResetEvent{Request: "r1"}), "expected the failed reset to be retried")
+	assert.Empty(t, confirmed)
+
+	failConfirm = true
+	executor.EXPECT().CommandContext(gomock.Any(), "nvidia-smi --gpu-reset").Return("", nil)
+	assert.Error(t, h.Handle(framework.NodeGPUResetEvent{Request: "r1"}), "expected the failed confirmation to be retried")
+
+	failConfirm = false
+	executor.EXPECT().CommandContext(gomock.Any(), "nvidia-smi --gpu-reset").Return("", nil).Times(1)
+	assert.NoError(t, h.Handle(framework.NodeGPUResetEvent{Request: "r1"}))
+	// the request seen again before the confirmation is seen on the node is not reset again
+	assert.NoError(t, h.Handle(framework.NodeGPUResetEvent{Request: "r1"}))
+	assert.Equal(t, []string{"r1"}, confirmed)
+}
+
+func TestGPUResetHandler_RefreshCfg(t *testing.T) {
+	var announced, withdrawn int
+	h := &GPUResetHandler{
+		BaseHandle: &base.BaseHandle{Name: string(features.GPUResetFeature), Config: &config.Configuration{
+			GenericConfiguration: &config.VolcanoAgentConfiguration{SupportedFeatures: []string{"*"}},
+		}},
+		announce: func(*config.Configuration) error { announced++; return nil },
+		withdraw: func(*config.Configuration) error { withdrawn++; return nil },
+	}
+	cfg := &api.ColocationConfig{
+		NodeLabelConfig: &api.NodeLabelConfig{
+			NodeColocationEnable:       utilpointer.Bool(false),
+			NodeOverSubscriptionEnable: utilpointer.Bool(false),
+		},
+	}
+
+	assert.NoError(t, h.RefreshCfg(cfg))
+	assert.False(t, h.IsActive())
+	assert.Equal(t, 1, withdrawn)
+
+	cfg.GPUResetConfig = &api.GPUReset{
+		Enable:         utilpointer.Bool(true),
+		Command:        utilpointer.String("systemctl restart nvidia-mps"),
+		TimeoutSeconds: utilpointer.Int(30),
+	}
+	assert.NoError(t, h.RefreshCfg(cfg))
+	assert.True(t, h.IsActive())
+	assert.Equal(t, 1, announced)
+	assert.Equal(t, "systemctl restart nvidia-mps", h.command)
+	assert.Equal(t, 30*time.Second, h.timeout)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpureset
+
+import (
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/agent/config/api"
+	"volcano.sh/volcano/pkg/agent/events/framework"
+	"volcano.sh/volcano/pkg/agent/events/probes"
+	utilnode "volcano.sh/volcano/pkg/agent/utils/node"
+	utilpod "volcano.sh/volcano/pkg/agent/utils/pod"
+	"volcano.sh/volcano/pkg/config"
+	"volcano.sh/volcano/pkg/metriccollect"
+)
+
+func init() {
+	probes.RegisterEventProbeFunc(string(framework.NodeGPUResetEventName), NewProbe)
+}
+
+const detectInterval = 5 * time.Second
+
+// probe detects the GPU cleanup requested by the scheduler on the node, and generates the event once the GPU pods
+// evicted for the request are gone, so the GPUs are not in use when they are cleaned up.
+type probe struct {
+	eventQueueFactory *framework.EventQueueFactory
+	getNodeFunc       utilnode.ActiveNode
+	getPodsFunc       utilpod.ActivePods
+}
+
+func NewProbe(config *config.Configuration, mgr *metriccollect.MetricCollectorManager, eventQueueFactory *framework.EventQueueFactory) framework.Probe {
+	return &probe{
+		eventQueueFactory: eventQueueFactory,
+		getNodeFunc:       config.GetNode,
+		getPodsFunc:       config.GetActivePods,
+	}
+}
+
+func (p *probe) ProbeName() string {
+	return "GPUResetProbe"
+}
+
+func (p *probe) Run(stop <-chan struct{}) {
+	klog.InfoS("Started gpu reset probe")
+	go wait.Until(p.detect, detectInterval, stop)
+}
+
+func (p *probe) RefreshCfg(cfg *api.ColocationConfig) error {
+	return nil
+}
+
+func (p *probe) detect() {
+	event, found, err := p.pendingRequest()
+	if err != nil {
+		klog.ErrorS(err, "Failed to detect gpu reset request")
+		return
+	}
+	if !found {
+		return
+	}
+	p.eventQueueFactory.EventQueue(string(framework.NodeGPUResetEventName)).GetQueue().Add(event)
+}
+
+// pendingRequest returns the GPU cleanup request of the node not done yet, if no GPU pod is terminating on the node.
+func (p *probe) pendingRequest() (framework.NodeGPUResetEvent, bool, error) {
+	node, err := p.getNodeFunc()
+	if err != nil {
+		return framework.NodeGPUResetEvent{}, false, err
+	}
+	request := node.Annotations[v1beta1.GPUResetRequestAnnotationKey]
+	if request == "" || node.Annotations[v1beta1.GPUResetDoneAnnotationKey] == request {
+		return framework.NodeGPUResetEvent{}, false, nil
+	}
+
+	pods, err := p.getPodsFunc()
+	if err != nil {
+		return framework.NodeGPUResetEvent{}, false, err
+	}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil && usesGPU(pod) {
+			klog.V(4).InfoS("Waiting for the terminating gpu pod before gpu reset", "pod", klog.KObj(pod), "request", request)
+			return framework.NodeGPUResetEvent{}, false, nil
+		}
+	}
+	return framework.NodeGPUResetEvent{Request: request}, true, nil
+}
+
+func usesGPU(pod *v1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range container.Resources.Limits {
+			if !quantity.IsZero() && strings.Contains(strings.ToLower(string(name)), "gpu") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -50,4 +50,8 @@ const (
 
 	// ResourcesFeature is the feature gate for extend resource management.
 	ResourcesFeature Feature = "Resources"
+
+	// GPUResetFeature is the feature gate for cleaning up the GPUs on request of the scheduler,
+	// after the GPU pods of a queue are evicted for the pods of another queue.
+	GPUResetFeature Feature = "GPUReset"
 )
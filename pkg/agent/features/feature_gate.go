@@ -96,6 +96,12 @@ func (f *featureGate) Enabled(key Feature, c *api.ColocationConfig) (bool, error
 			return false, fmt.Errorf("nil cpuThrottling config")
 		}
 		return nodeOverSubscriptionEnabled && *c.CPUThrottlingConfig.Enable, nil
+	case GPUResetFeature:
+		// gpu reset is optional and not in the default config, nil means disabled
+		if c.GPUResetConfig == nil || c.GPUResetConfig.Enable == nil {
+			return false, nil
+		}
+		return *c.GPUResetConfig.Enable, nil
 	default:
 		return false, fmt.Errorf("unsupported feature %s", string(key))
 	}
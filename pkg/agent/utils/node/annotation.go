@@ -19,6 +19,8 @@ package node
 import (
 	v1 "k8s.io/api/core/v1"
 
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
 	"volcano.sh/volcano/pkg/agent/apis"
 	"volcano.sh/volcano/pkg/config"
 )
@@ -54,3 +56,24 @@ func removeEvictionAnnotation() Modifier {
 		})(node)
 	}
 }
+
+// AddGPUResetAgentAnnotation announces to the scheduler that the agent cleans up the GPUs of the node on request.
+func AddGPUResetAgentAnnotation(config *config.Configuration) error {
+	return update(config, []Modifier{updateAnnotation(map[string]string{
+		v1beta1.GPUResetAgentAnnotationKey: "true",
+	})})
+}
+
+// RemoveGPUResetAgentAnnotation stops the scheduler requesting the GPU cleanup from the node.
+func RemoveGPUResetAgentAnnotation(config *config.Configuration) error {
+	return update(config, []Modifier{func(node *v1.Node) {
+		delete(node.Annotations, v1beta1.GPUResetAgentAnnotationKey)
+	}})
+}
+
+// ConfirmGPUReset confirms to the scheduler that the GPU cleanup of the request is done.
+func ConfirmGPUReset(config *config.Configuration, request string) error {
+	return update(config, []Modifier{updateAnnotation(map[string]string{
+		v1beta1.GPUResetDoneAnnotationKey: request,
+	})})
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/pkg/agentscheduler/framework"
 	"volcano.sh/volcano/pkg/agentscheduler/plugins"
@@ -46,6 +47,9 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
 		return nil, nil, nil, nil, err
 	}
+	for _, warning := range schedulerConf.ResolveDeprecatedArguments() {
+		klog.Warning(warning)
+	}
 	// Set default settings for each plugin if not set
 	for i, tier := range schedulerConf.Tiers {
 		for j := range tier.Plugins {
@@ -0,0 +1,328 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/scheduler/audit"
+)
+
+// bindDecision is the decision of the audit records binding a pod, see the audit log of the scheduler.
+const bindDecision = "Bind"
+
+type planFlags struct {
+	Traces        []string
+	Percentile    float64
+	TargetWait    time.Duration
+	Runtime       time.Duration
+	NodeResources string
+	WhatIf        []string
+}
+
+var planCapacityFlags = &planFlags{}
+
+// InitPlanFlags is used to init all flags.
+func InitPlanFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&planCapacityFlags.Traces, "trace", "t", nil,
+		"the audit log files of the scheduler recording the demand of the queues")
+	cmd.Flags().Float64VarP(&planCapacityFlags.Percentile, "percentile", "p", 95, "the percentile of the queue time to meet the target")
+	cmd.Flags().DurationVarP(&planCapacityFlags.TargetWait, "target-wait", "w", 10*time.Minute, "the target queue time at the percentile")
+	cmd.Flags().DurationVarP(&planCapacityFlags.Runtime, "runtime", "r", time.Hour, "the runtime of the pods, which is not recorded in the audit log")
+	cmd.Flags().StringVarP(&planCapacityFlags.NodeResources, "node-resources", "n", "",
+		"the allocatable resources of a node to size the node pool, e.g. cpu=32,memory=128Gi")
+	cmd.Flags().StringArrayVar(&planCapacityFlags.WhatIf, "what-if", nil,
+		"the deserved resources of a queue to simulate instead of planning, e.g. q1:cpu=8,memory=32Gi")
+}
+
+// PlanCapacity suggests the deserved and capability resources of the queues meeting the target queue time,
+// from the demand recorded in the audit log of the scheduler.
+func PlanCapacity(ctx context.Context) error {
+	return plan(os.Stdout, planCapacityFlags)
+}
+
+// demand is a pod of a queue bound by the scheduler.
+type demand struct {
+	created  time.Time
+	bound    time.Time
+	requests vector
+}
+
+func plan(writer io.Writer, flags *planFlags) error {
+	if len(flags.Traces) == 0 {
+		return fmt.Errorf("at least one audit log file is required by --trace")
+	}
+	if flags.Percentile <= 0 || flags.Percentile > 100 {
+		return fmt.Errorf("percentile must be in (0, 100], got %v", flags.Percentile)
+	}
+	if flags.Runtime <= 0 {
+		return fmt.Errorf("runtime must be positive, got %v", flags.Runtime)
+	}
+
+	demands, err := readDemands(flags.Traces)
+	if err != nil {
+		return err
+	}
+	if len(demands) == 0 {
+		return fmt.Errorf("no bind decision with the requests and creation time of the pod found in the audit logs")
+	}
+	queues := make([]string, 0, len(demands))
+	for queue := range demands {
+		queues = append(queues, queue)
+	}
+	sort.Strings(queues)
+
+	if len(flags.WhatIf) != 0 {
+		return whatIf(writer, flags, demands)
+	}
+
+	fmt.Fprintf(writer, "%-25s%-8s%-16s%-40s%-40s%s\n", "Name", "Pods",
+		fmt.Sprintf("Observed P%v", flags.Percentile), "Deserved", "Capability", fmt.Sprintf("Planned P%v", flags.Percentile))
+	totalDeserved, totalCapability := vector{}, vector{}
+	for _, queue := range queues {
+		pods := demands[queue]
+		observed := make([]time.Duration, 0, len(pods))
+		for _, pod := range pods {
+			observed = append(observed, pod.bound.Sub(pod.created))
+		}
+		capability := peakUsage(pods, flags.Runtime)
+		deserved := planDeserved(pods, capability, flags)
+		waits, _ := simulate(pods, deserved, flags.Runtime)
+		totalDeserved.add(deserved)
+		totalCapability.add(capability)
+
+		fmt.Fprintf(writer, "%-25s%-8d%-16s%-40s%-40s%s\n", queue, len(pods),
+			util.HumanDuration(percentile(observed, flags.Percentile)), deserved, capability,
+			util.HumanDuration(percentile(waits, flags.Percentile)))
+	}
+
+	if flags.NodeResources != "" {
+		node, err := util.PopulateResourceListV1(flags.NodeResources)
+		if err != nil {
+			return fmt.Errorf("invalid node resources %q: %v", flags.NodeResources, err)
+		}
+		shape := newVector(node)
+		fmt.Fprintf(writer, "\nNode pool of %s: %d nodes for the deserved resources, %d nodes for the capability\n",
+			shape, nodesFor(totalDeserved, shape), nodesFor(totalCapability, shape))
+	}
+	return nil
+}
+
+// whatIf prints the queue time of the queues with the given deserved resources.
+func whatIf(writer io.Writer, flags *planFlags, demands map[string][]demand) error {
+	fmt.Fprintf(writer, "%-25s%-8s%-40s%-16s%s\n", "Name", "Pods", "Deserved",
+		fmt.Sprintf("Simulated P%v", flags.Percentile), "Target Met")
+	for _, spec := range flags.WhatIf {
+		queue, resources, found := strings.Cut(spec, ":")
+		if !found {
+			return fmt.Errorf("invalid what-if %q, expected <queue>:<resource>=<value>,...", spec)
+		}
+		list, err := util.PopulateResourceListV1(resources)
+		if err != nil {
+			return fmt.Errorf("invalid what-if %q: %v", spec, err)
+		}
+		pods, found := demands[queue]
+		if !found {
+			return fmt.Errorf("no demand of queue %s found in the audit logs", queue)
+		}
+		deserved := newVector(list)
+		wait, met := "<never>", false
+		if waits, ok := simulate(pods, deserved, flags.Runtime); ok {
+			p := percentile(waits, flags.Percentile)
+			wait, met = util.HumanDuration(p), p <= flags.TargetWait
+		}
+		fmt.Fprintf(writer, "%-25s%-8d%-40s%-16s%v\n", queue, len(pods), deserved, wait, met)
+	}
+	return nil
+}
+
+// readDemands returns the pods bound by the scheduler per queue in order of creation.
+func readDemands(paths []string) (map[string][]demand, error) {
+	demands := map[string][]demand{}
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		records, err := audit.ReadRecords(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit log %s: %v", path, err)
+		}
+		for _, record := range records {
+			if record.Decision != bindDecision || record.Queue == "" || record.Created == nil || len(record.Requests) == 0 {
+				continue
+			}
+			demands[record.Queue] = append(demands[record.Queue], demand{
+				created:  *record.Created,
+				bound:    record.Time,
+				requests: newVector(record.Requests),
+			})
+		}
+	}
+	for _, pods := range demands {
+		sort.SliceStable(pods, func(i, j int) bool { return pods[i].created.Before(pods[j].created) })
+	}
+	return demands, nil
+}
+
+// planDeserved returns the least share of the capability, not less than any request, which meets the target queue time.
+func planDeserved(pods []demand, capability vector, flags *planFlags) vector {
+	largest := vector{}
+	for _, pod := range pods {
+		largest.max(pod.requests)
+	}
+	capacity := func(share float64) vector {
+		scaled := capability.scale(share)
+		scaled.max(largest)
+		return scaled
+	}
+	meets := func(share float64) bool {
+		waits, ok := simulate(pods, capacity(share), flags.Runtime)
+		return ok && percentile(waits, flags.Percentile) <= flags.TargetWait
+	}
+
+	// search the least share in permille of the capability
+	low, high := 0, 1000
+	for low < high {
+		mid := (low + high) / 2
+		if meets(float64(mid) / 1000) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return capacity(float64(high) / 1000)
+}
+
+// simulate returns the queue time of the pods started in order of creation within the capacity, each running for
+// the runtime. It returns false if a pod requests more than the capacity.
+func simulate(pods []demand, capacity vector, runtime time.Duration) ([]time.Duration, bool) {
+	type running struct {
+		end      time.Time
+		requests vector
+	}
+	var runnings []running
+	used := vector{}
+	release := func() time.Time {
+		sort.Slice(runnings, func(i, j int) bool { return runnings[i].end.Before(runnings[j].end) })
+		first := runnings[0]
+		runnings = runnings[1:]
+		used.sub(first.requests)
+		return first.end
+	}
+
+	waits := make([]time.Duration, 0, len(pods))
+	var last time.Time
+	for _, pod := range pods {
+		if !pod.requests.lessEqual(capacity) {
+			return nil, false
+		}
+		start := pod.created
+		if start.Before(last) {
+			start = last
+		}
+		for i := 0; i < len(runnings); {
+			if runnings[i].end.After(start) {
+				i++
+				continue
+			}
+			used.sub(runnings[i].requests)
+			runnings = append(runnings[:i], runnings[i+1:]...)
+		}
+		for len(runnings) != 0 && !used.plus(pod.requests).lessEqual(capacity) {
+			if end := release(); end.After(start) {
+				start = end
+			}
+		}
+		used.add(pod.requests)
+		runnings = append(runnings, running{end: start.Add(runtime), requests: pod.requests})
+		last = start
+		waits = append(waits, start.Sub(pod.created))
+	}
+	return waits, true
+}
+
+// peakUsage returns the peak of each resource used by the pods started at their creation, each running for the runtime.
+func peakUsage(pods []demand, runtime time.Duration) vector {
+	type event struct {
+		at       time.Time
+		start    bool
+		requests vector
+	}
+	events := make([]event, 0, 2*len(pods))
+	for _, pod := range pods {
+		events = append(events, event{at: pod.created, start: true, requests: pod.requests},
+			event{at: pod.created.Add(runtime), requests: pod.requests})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].at.Equal(events[j].at) {
+			return !events[i].start && events[j].start
+		}
+		return events[i].at.Before(events[j].at)
+	})
+
+	used, peak := vector{}, vector{}
+	for _, e := range events {
+		if e.start {
+			used.add(e.requests)
+			peak.max(used)
+		} else {
+			used.sub(e.requests)
+		}
+	}
+	return peak
+}
+
+// percentile returns the nearest-rank percentile of the durations.
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// nodesFor returns the number of nodes of the shape holding the resources.
+func nodesFor(resources, shape vector) int64 {
+	var nodes int64
+	for name, value := range resources {
+		size, found := shape[name]
+		if !found || size <= 0 {
+			continue
+		}
+		if n := (value + size - 1) / size; n > nodes {
+			nodes = n
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/scheduler/audit"
+)
+
+// writeTrace writes the bind decisions of the pods of q1, each created at the same time and requesting
+// 2 cpus and 1Gi memory, to an audit log file.
+func writeTrace(t *testing.T, created time.Time, pods int) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	requests, err := util.PopulateResourceListV1("cpu=2,memory=1Gi,pods=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < pods; i++ {
+		if err := encoder.Encode(audit.Record{
+			Time:     created.Add(time.Duration(i) * time.Minute),
+			Decision: bindDecision,
+			Pod:      "ns/p",
+			Queue:    "q1",
+			Requests: requests,
+			Created:  &created,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// decisions other than bind are not demand
+	if err := encoder.Encode(audit.Record{Time: created, Decision: "Evict", Pod: "ns/p", Queue: "q1"}); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "audit.log")
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPlan(t *testing.T) {
+	trace := writeTrace(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 4)
+
+	testCases := []struct {
+		name     string
+		flags    planFlags
+		expected []string
+	}{
+		{
+			name:     "capability of the peak demand meets a zero target",
+			flags:    planFlags{Traces: []string{trace}, Percentile: 100, Runtime: time.Hour},
+			expected: []string{"q1", "cpu=8,memory=4Gi", "3m"},
+		},
+		{
+			name:  "half of the capability meets the target when half of the pods wait a runtime",
+			flags: planFlags{Traces: []string{trace}, Percentile: 100, TargetWait: time.Hour, Runtime: time.Hour, NodeResources: "cpu=4,memory=16Gi"},
+			expected: []string{
+				"cpu=4,memory=2Gi",
+				"Node pool of cpu=4,memory=16Gi: 1 nodes for the deserved resources, 2 nodes for the capability",
+			},
+		},
+		{
+			name:     "what-if simulates the queue time with the deserved resources",
+			flags:    planFlags{Traces: []string{trace}, Percentile: 100, TargetWait: time.Hour, Runtime: time.Hour, WhatIf: []string{"q1:cpu=2,memory=1Gi"}},
+			expected: []string{"cpu=2,memory=1Gi", "3h", "false"},
+		},
+		{
+			name:     "what-if of pods not fitting the deserved resources",
+			flags:    planFlags{Traces: []string{trace}, Percentile: 100, Runtime: time.Hour, WhatIf: []string{"q1:cpu=1,memory=1Gi"}},
+			expected: []string{"<never>", "false"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := plan(&out, &tc.flags); err != nil {
+				t.Fatal(err)
+			}
+			for _, expected := range tc.expected {
+				if !strings.Contains(out.String(), expected) {
+					t.Errorf("expected %q in output:\n%s", expected, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pod := func(offset time.Duration, cpu int64) demand {
+		return demand{created: created.Add(offset), requests: vector{"cpu": cpu * 1000}}
+	}
+	pods := []demand{pod(0, 2), pod(0, 2), pod(30*time.Minute, 1), pod(90*time.Minute, 2)}
+
+	waits, ok := simulate(pods, vector{"cpu": 3000}, time.Hour)
+	if !ok {
+		t.Fatal("expected all pods to fit the capacity")
+	}
+	// the second pod waits for the first one, the third one starts after the second in order of creation
+	expected := []time.Duration{0, time.Hour, 30 * time.Minute, 30 * time.Minute}
+	for i := range expected {
+		if waits[i] != expected[i] {
+			t.Errorf("expected pod %d to wait %v, got %v", i, expected[i], waits[i])
+		}
+	}
+
+	if peak := peakUsage(pods, time.Hour); peak["cpu"] != 5000 {
+		t.Errorf("expected peak of 5 cpus, got %v", peak)
+	}
+}
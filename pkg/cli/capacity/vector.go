@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// vector is the milli value of each resource, the number of pods is not planned.
+type vector map[v1.ResourceName]int64
+
+func newVector(list v1.ResourceList) vector {
+	v := vector{}
+	for name, quantity := range list {
+		if name == v1.ResourcePods {
+			continue
+		}
+		v[name] = quantity.MilliValue()
+	}
+	return v
+}
+
+func (v vector) add(other vector) {
+	for name, value := range other {
+		v[name] += value
+	}
+}
+
+func (v vector) sub(other vector) {
+	for name, value := range other {
+		v[name] -= value
+	}
+}
+
+func (v vector) plus(other vector) vector {
+	sum := vector{}
+	sum.add(v)
+	sum.add(other)
+	return sum
+}
+
+// max sets each resource to the larger of both vectors.
+func (v vector) max(other vector) {
+	for name, value := range other {
+		if value > v[name] {
+			v[name] = value
+		}
+	}
+}
+
+// scale returns the vector scaled by the factor, rounded up to whole units except for cpu.
+func (v vector) scale(factor float64) vector {
+	scaled := vector{}
+	for name, value := range v {
+		milli := math.Ceil(float64(value) * factor)
+		if name != v1.ResourceCPU {
+			milli = math.Ceil(milli/1000) * 1000
+		}
+		scaled[name] = int64(milli)
+	}
+	return scaled
+}
+
+// lessEqual returns whether each resource is not more than in the other vector, the resources missing in the other
+// vector are not limited.
+func (v vector) lessEqual(other vector) bool {
+	for name, value := range v {
+		if limit, found := other[name]; found && value > limit {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the resources in the format of the --what-if flag, e.g. cpu=8,memory=32Gi.
+func (v vector) String() string {
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		format := resource.DecimalSI
+		if name == string(v1.ResourceMemory) || name == string(v1.ResourceEphemeralStorage) {
+			format = resource.BinarySI
+		}
+		parts = append(parts, name+"="+resource.NewMilliQuantity(v[v1.ResourceName(name)], format).String())
+	}
+	if len(parts) == 0 {
+		return "<none>"
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// effectivePath is the path of the endpoint of the scheduler responding the effective configuration.
+const effectivePath = "/scheduler/config/effective"
+
+type effectiveFlags struct {
+	Server  string
+	Timeout time.Duration
+}
+
+var effectiveConfigFlags = &effectiveFlags{}
+
+// InitEffectiveFlags is used to init all flags.
+func InitEffectiveFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&effectiveConfigFlags.Server, "server", "s", "http://127.0.0.1:8080",
+		"the address of the metrics server of the scheduler, e.g. forwarded by kubectl port-forward")
+	cmd.Flags().DurationVarP(&effectiveConfigFlags.Timeout, "timeout", "t", 10*time.Second, "the timeout of the request")
+}
+
+// GetEffectiveConfig prints the configuration the scheduler uses.
+func GetEffectiveConfig(ctx context.Context) error {
+	return getEffective(ctx, os.Stdout, effectiveConfigFlags)
+}
+
+func getEffective(ctx context.Context, writer io.Writer, flags *effectiveFlags) error {
+	ctx, cancel := context.WithTimeout(ctx, flags.Timeout)
+	defer cancel()
+
+	url := strings.TrimSuffix(flags.Server, "/") + effectivePath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get the effective configuration from %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the effective configuration from %s: %s: %s",
+			url, resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	effective := &conf.EffectiveConfiguration{}
+	if err := yaml.Unmarshal(data, effective); err != nil {
+		return fmt.Errorf("invalid effective configuration: %v", err)
+	}
+	if effective.APIVersion != conf.EffectiveConfigurationAPIVersion {
+		return fmt.Errorf("unsupported version %q of the effective configuration, expected %s",
+			effective.APIVersion, conf.EffectiveConfigurationAPIVersion)
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetEffective(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected string
+		err      string
+	}{
+		{
+			name:     "effective configuration is printed",
+			status:   http.StatusOK,
+			body:     "apiVersion: scheduler.volcano.sh/v1\nkind: EffectiveSchedulerConfiguration\nactions: enqueue, allocate\n",
+			expected: "apiVersion: scheduler.volcano.sh/v1\nkind: EffectiveSchedulerConfiguration\nactions: enqueue, allocate\n",
+		},
+		{
+			name:   "unsupported version is rejected",
+			status: http.StatusOK,
+			body:   "apiVersion: scheduler.volcano.sh/v2\nkind: EffectiveSchedulerConfiguration\n",
+			err:    "unsupported version",
+		},
+		{
+			name:   "error of the scheduler is returned",
+			status: http.StatusServiceUnavailable,
+			body:   "no scheduler configuration is applied yet",
+			err:    "no scheduler configuration is applied yet",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != effectivePath {
+					http.NotFound(w, r)
+					return
+				}
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			out := &bytes.Buffer{}
+			err := getEffective(context.Background(), out, &effectiveFlags{Server: server.URL + "/", Timeout: time.Second})
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.String() != test.expected {
+				t.Errorf("expected output %q, got %q", test.expected, out.String())
+			}
+		})
+	}
+}
@@ -21,9 +21,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 
 	"github.com/spf13/cobra"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
@@ -35,9 +37,13 @@ import (
 type getFlags struct {
 	util.CommonFlags
 
-	Name string
+	Name   string
+	Format string
 }
 
+// wideFormat is the output format printing the podgroup, share and reclaim statistics of the queue.
+const wideFormat = "wide"
+
 var getQueueFlags = &getFlags{}
 
 // InitGetFlags is used to init all flags.
@@ -45,6 +51,7 @@ func InitGetFlags(cmd *cobra.Command) {
 	util.InitFlags(cmd, &getQueueFlags.CommonFlags)
 
 	cmd.Flags().StringVarP(&getQueueFlags.Name, "name", "n", "", "the name of queue")
+	cmd.Flags().StringVarP(&getQueueFlags.Format, "format", "o", "", "the format of output, wide to print the share and reclaim statistics")
 }
 
 // GetQueue gets a queue.
@@ -59,6 +66,10 @@ func GetQueue(ctx context.Context) error {
 		return err
 	}
 
+	if getQueueFlags.Format != "" && getQueueFlags.Format != wideFormat {
+		return fmt.Errorf("unsupported output format %q, only %q is supported", getQueueFlags.Format, wideFormat)
+	}
+
 	queueClient := versioned.NewForConfigOrDie(config)
 	queue, err := queueClient.SchedulingV1beta1().Queues().Get(ctx, getQueueFlags.Name, metav1.GetOptions{})
 	if err != nil {
@@ -80,6 +91,10 @@ func GetQueue(ctx context.Context) error {
 		}
 	}
 
+	if getQueueFlags.Format == wideFormat {
+		PrintQueueWide(queue, pgStats, os.Stdout)
+		return nil
+	}
 	PrintQueue(queue, pgStats, os.Stdout)
 
 	return nil
@@ -100,3 +115,56 @@ func PrintQueue(queue *v1beta1.Queue, pgStats *podgroup.PodGroupStatistics, writ
 		fmt.Printf("Failed to print queue command result: %s.\n", err)
 	}
 }
+
+// PrintQueueWide prints queue information with the running and pending podgroups, the share of the
+// dominant resource and the reclaims of the last hour, as populated in the queue status by the scheduler.
+func PrintQueueWide(queue *v1beta1.Queue, pgStats *podgroup.PodGroupStatistics, writer io.Writer) {
+	_, err := fmt.Fprintf(writer, "%-25s%-8s%-8s%-8s%-8s%-8s%-24s%-12s%-12s%s\n",
+		Name, Weight, State, Parent, Running, Pending, Share, Reclaiming, Reclaimed, LastReclaim)
+	if err != nil {
+		fmt.Printf("Failed to print queue command result: %s.\n", err)
+	}
+
+	var reclaiming, reclaimed int32
+	lastReclaim := "<none>"
+	if reclaim := queue.Status.Reclaim; reclaim != nil {
+		reclaiming, reclaimed = reclaim.Reclaiming, reclaim.Reclaimed
+		if reclaim.LastReclaimTime != nil {
+			lastReclaim = util.TranslateTimestampSince(*reclaim.LastReclaimTime)
+		}
+	}
+
+	_, err = fmt.Fprintf(writer, "%-25s%-8d%-8s%-8s%-8d%-8d%-24s%-12d%-12d%s\n",
+		queue.Name, queue.Spec.Weight, queue.Status.State, queue.Spec.Parent, pgStats.Running, pgStats.Pending,
+		dominantShare(queue.Status.Allocated, queue.Spec.Deserved), reclaiming, reclaimed, lastReclaim)
+	if err != nil {
+		fmt.Printf("Failed to print queue command result: %s.\n", err)
+	}
+}
+
+// dominantShare returns the resource of the deserved resources with the highest allocated percentage,
+// e.g. "cpu 75%", or "<none>" if the queue has no deserved resources.
+func dominantShare(allocated, deserved v1.ResourceList) string {
+	names := make([]string, 0, len(deserved))
+	for name := range deserved {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	dominant, maxShare := "", -1.0
+	for _, name := range names {
+		deservedQuantity := deserved[v1.ResourceName(name)]
+		if deservedQuantity.IsZero() {
+			continue
+		}
+		allocatedQuantity := allocated[v1.ResourceName(name)]
+		share := allocatedQuantity.AsApproximateFloat64() / deservedQuantity.AsApproximateFloat64()
+		if share > maxShare {
+			dominant, maxShare = name, share
+		}
+	}
+	if dominant == "" {
+		return "<none>"
+	}
+	return fmt.Sprintf("%s %.0f%%", dominant, maxShare*100)
+}
@@ -63,6 +63,18 @@ const (
 
 	// Parent of the queue
 	Parent string = "Parent"
+
+	// Share is the allocated percentage of the deserved dominant resource of the queue
+	Share string = "Share"
+
+	// Reclaiming is the number of tasks evicted to reclaim resources for the queue
+	Reclaiming string = "Reclaiming"
+
+	// Reclaimed is the number of tasks of the queue evicted by reclaims
+	Reclaimed string = "Reclaimed"
+
+	// LastReclaim is the age of the last reclaim involving the queue
+	LastReclaim string = "LastReclaim"
 )
 
 var listQueueFlags = &listFlags{}
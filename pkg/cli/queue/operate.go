@@ -19,6 +19,7 @@ package queue
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/apis/pkg/client/clientset/versioned"
 	"volcano.sh/volcano/pkg/cli/util"
 )
@@ -37,6 +39,8 @@ const (
 	ActionClose = "close"
 	// ActionUpdate is `update` action
 	ActionUpdate = "update"
+	// ActionDrain is `drain` action
+	ActionDrain = "drain"
 )
 
 type operateFlags struct {
@@ -48,6 +52,8 @@ type operateFlags struct {
 	Weight int32
 	// Action is operation action of queue
 	Action string
+	// DrainTimeout is the time after which the jobs still running in a draining queue are evicted
+	DrainTimeout time.Duration
 }
 
 var operateQueueFlags = &operateFlags{}
@@ -59,7 +65,9 @@ func InitOperateFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&operateQueueFlags.Name, "name", "n", "", "the name of queue")
 	cmd.Flags().Int32VarP(&operateQueueFlags.Weight, "weight", "w", 0, "the weight of the queue")
 	cmd.Flags().StringVarP(&operateQueueFlags.Action, "action", "a", "",
-		"operate action to queue, valid actions are open, close, update")
+		"operate action to queue, valid actions are open, close, update, drain")
+	cmd.Flags().DurationVarP(&operateQueueFlags.DrainTimeout, "drain-timeout", "", 0,
+		"the time after which the jobs still running in the drained queue are evicted, 0 to let them finish")
 }
 
 // OperateQueue operates queue
@@ -80,6 +88,17 @@ func OperateQueue(ctx context.Context) error {
 		action = v1alpha1.OpenQueueAction
 	case ActionClose:
 		action = v1alpha1.CloseQueueAction
+	case ActionDrain:
+		action = v1alpha1.DrainQueueAction
+		if operateQueueFlags.DrainTimeout > 0 {
+			deadline := time.Now().Add(operateQueueFlags.DrainTimeout).UTC().Format(time.RFC3339)
+			queueClient := versioned.NewForConfigOrDie(config)
+			patchBytes := []byte(fmt.Sprintf(`{"metadata":{"annotations":{"%s":"%s"}}}`, schedulingv1beta1.DrainDeadlineAnnotationKey, deadline))
+			if _, err := queueClient.SchedulingV1beta1().Queues().Patch(ctx,
+				operateQueueFlags.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+		}
 	case ActionUpdate:
 		if operateQueueFlags.Weight == 0 {
 			return fmt.Errorf("when %s queue %s, weight must be specified, "+
@@ -95,8 +114,8 @@ func OperateQueue(ctx context.Context) error {
 	case "":
 		return fmt.Errorf("action can not be null")
 	default:
-		return fmt.Errorf("action %s invalid, valid actions are %s, %s and %s",
-			operateQueueFlags.Action, ActionOpen, ActionClose, ActionUpdate)
+		return fmt.Errorf("action %s invalid, valid actions are %s, %s, %s and %s",
+			operateQueueFlags.Action, ActionOpen, ActionClose, ActionUpdate, ActionDrain)
 	}
 
 	return createQueueCommand(ctx, config, action)
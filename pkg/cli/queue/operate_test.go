@@ -24,6 +24,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
@@ -52,11 +53,12 @@ func TestOperateQueue(t *testing.T) {
 
 	operateQueueFlags.Master = server.URL
 	testCases := []struct {
-		Name        string
-		QueueName   string
-		Weight      int32
-		Action      string
-		ExpectValue error
+		Name         string
+		QueueName    string
+		Weight       int32
+		Action       string
+		DrainTimeout time.Duration
+		ExpectValue  error
 	}{
 		{
 			Name:        "Normal Case Operate Queue Succeed, Action close",
@@ -70,6 +72,19 @@ func TestOperateQueue(t *testing.T) {
 			Action:      ActionOpen,
 			ExpectValue: nil,
 		},
+		{
+			Name:        "Normal Case Operate Queue Succeed, Action drain",
+			QueueName:   "normal-case-action-drain",
+			Action:      ActionDrain,
+			ExpectValue: nil,
+		},
+		{
+			Name:         "Normal Case Operate Queue Succeed, Action drain with timeout",
+			QueueName:    "normal-case-action-drain-timeout",
+			Action:       ActionDrain,
+			DrainTimeout: time.Hour,
+			ExpectValue:  nil,
+		},
 		{
 			Name:        "Normal Case Operate Queue Succeed, Update Weight",
 			QueueName:   "normal-case-update-weight",
@@ -99,8 +114,8 @@ func TestOperateQueue(t *testing.T) {
 			Name:      "Abnormal Case Operate Queue Failed For Action Invalid",
 			QueueName: "abnormal-case-invalid-action",
 			Action:    "invalid",
-			ExpectValue: fmt.Errorf("action %s invalid, valid actions are %s, %s and %s",
-				"invalid", ActionOpen, ActionClose, ActionUpdate),
+			ExpectValue: fmt.Errorf("action %s invalid, valid actions are %s, %s, %s and %s",
+				"invalid", ActionOpen, ActionClose, ActionUpdate, ActionDrain),
 		},
 	}
 
@@ -108,6 +123,7 @@ func TestOperateQueue(t *testing.T) {
 		operateQueueFlags.Name = testCase.QueueName
 		operateQueueFlags.Action = testCase.Action
 		operateQueueFlags.Weight = testCase.Weight
+		operateQueueFlags.DrainTimeout = testCase.DrainTimeout
 
 		err := OperateQueue(context.TODO())
 		if false == reflect.DeepEqual(err, testCase.ExpectValue) {
@@ -129,4 +145,7 @@ func TestInitOperateFlags(t *testing.T) {
 	if cmd.Flag("action") == nil {
 		t.Errorf("Could not find the flag action")
 	}
+	if cmd.Flag("drain-timeout") == nil {
+		t.Errorf("Could not find the flag drain-timeout")
+	}
 }
@@ -27,9 +27,12 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
@@ -199,6 +202,68 @@ func TestGetQueue_nonempty(t *testing.T) {
 	}
 }
 
+func TestPrintQueueWide(t *testing.T) {
+	testCases := []struct {
+		name     string
+		queue    *v1beta1.Queue
+		pgStats  *podgroup.PodGroupStatistics
+		expected string
+	}{
+		{
+			name: "queue without deserved resources nor reclaims",
+			queue: &v1beta1.Queue{
+				ObjectMeta: v1.ObjectMeta{Name: "test-queue"},
+				Spec:       v1beta1.QueueSpec{Weight: 1, Parent: "root"},
+				Status:     v1beta1.QueueStatus{State: v1beta1.QueueStateOpen},
+			},
+			pgStats: &podgroup.PodGroupStatistics{Pending: 2, Running: 3},
+			expected: `Name                     Weight  State   Parent  Running Pending Share                   Reclaiming  Reclaimed   LastReclaim
+test-queue               1       Open    root    3       2       <none>                  0           0           <none>
+`,
+		},
+		{
+			name: "queue with deserved resources and reclaims",
+			queue: &v1beta1.Queue{
+				ObjectMeta: v1.ObjectMeta{Name: "test-queue"},
+				Spec: v1beta1.QueueSpec{
+					Weight: 1,
+					Parent: "root",
+					Deserved: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("8Gi"),
+					},
+				},
+				Status: v1beta1.QueueStatus{
+					State: v1beta1.QueueStateOpen,
+					Allocated: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+					Reclaim: &v1beta1.QueueReclaimStatus{
+						Reclaiming:      4,
+						Reclaimed:       1,
+						LastReclaimTime: &v1.Time{Time: time.Now().Add(-5 * time.Minute)},
+					},
+				},
+			},
+			pgStats: &podgroup.PodGroupStatistics{Pending: 1, Running: 2},
+			expected: `Name                     Weight  State   Parent  Running Pending Share                   Reclaiming  Reclaimed   LastReclaim
+test-queue               1       Open    root    2       1       cpu 75%                 4           1           5m
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			PrintQueueWide(tc.queue, tc.pgStats, &buf)
+			if got := buf.String(); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
 func TestListQueue(t *testing.T) {
 	InitListFlags(&cobra.Command{})
 
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+// memberCluster is a member cluster jobs are dispatched to.
+type memberCluster struct {
+	kubeconfig []byte
+	client     vcclientset.Interface
+}
+
+// newClusterClient creates the client of a member cluster from its kubeconfig.
+func newClusterClient(kubeconfig []byte) (vcclientset.Interface, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return vcclientset.NewForConfig(config)
+}
+
+// memberClusters returns the clients of the member clusters, by the kubeconfig files named after them in the
+// kubeconfig directory. The clients are created again when the kubeconfig of their cluster changes.
+func (dc *dispatchcontroller) memberClusters() (map[string]vcclientset.Interface, error) {
+	if dc.kubeconfigDir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dc.kubeconfigDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read member cluster kubeconfig directory <%s>: %v", dc.kubeconfigDir, err)
+	}
+
+	clusters := map[string]*memberCluster{}
+	for _, entry := range entries {
+		// The files of a mounted secret are linked to its hidden data directory.
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		name := entry.Name()
+		kubeconfig, err := os.ReadFile(filepath.Join(dc.kubeconfigDir, name))
+		if err != nil {
+			klog.Errorf("Failed to read kubeconfig of member cluster <%s>: %v", name, err)
+			continue
+		}
+		if cluster, found := dc.clusters[name]; found && bytes.Equal(cluster.kubeconfig, kubeconfig) {
+			clusters[name] = cluster
+			continue
+		}
+		client, err := dc.newClusterClient(kubeconfig)
+		if err != nil {
+			klog.Errorf("Failed to create client of member cluster <%s>: %v", name, err)
+			continue
+		}
+		clusters[name] = &memberCluster{kubeconfig: kubeconfig, client: client}
+	}
+	dc.clusters = clusters
+
+	result := make(map[string]vcclientset.Interface, len(clusters))
+	for name, cluster := range clusters {
+		result[name] = cluster.client
+	}
+	return result, nil
+}
+
+// selectCluster returns the member cluster the job is dispatched to, among the clusters of the policy whose queue
+// has room for the job, or an empty name if none has.
+func (dc *dispatchcontroller) selectCluster(job *batch.Job, policy *scheduling.QueueDispatchPolicy) (string, error) {
+	clusters, err := dc.memberClusters()
+	if err != nil {
+		return "", err
+	}
+	names := policy.Clusters
+	if len(names) == 0 {
+		for name := range clusters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	request := jobRequest(job)
+	queueName := memberQueue(job, policy)
+	best, bestScore := "", math.Inf(-1)
+	for _, name := range names {
+		client, found := clusters[name]
+		if !found {
+			klog.V(3).Infof("Member cluster <%s> of queue <%s> has no kubeconfig", name, job.Spec.Queue)
+			continue
+		}
+		queue, err := client.SchedulingV1beta1().Queues().Get(context.TODO(), queueName, metav1.GetOptions{})
+		if err != nil {
+			klog.V(3).Infof("Failed to get queue <%s> of member cluster <%s>: %v", queueName, name, err)
+			continue
+		}
+		if queue.Status.State != scheduling.QueueStateOpen {
+			continue
+		}
+		score, fits := queueScore(queue, request)
+		if !fits {
+			continue
+		}
+		if policy.Strategy == scheduling.DispatchStrategyFirstFit {
+			return name, nil
+		}
+		if score > bestScore {
+			best, bestScore = name, score
+		}
+	}
+	return best, nil
+}
+
+// memberQueue returns the queue of the member cluster the job is dispatched to.
+func memberQueue(job *batch.Job, policy *scheduling.QueueDispatchPolicy) string {
+	if policy.Queue != "" {
+		return policy.Queue
+	}
+	return job.Spec.Queue
+}
+
+// jobRequest sums the requests of all the replicas of the tasks of the job.
+func jobRequest(job *batch.Job) v1.ResourceList {
+	request := v1.ResourceList{}
+	for _, task := range job.Spec.Tasks {
+		request = quotav1.Add(request, util.CalTaskRequests(&v1.Pod{Spec: task.Template.Spec}, task.Replicas))
+	}
+	return request
+}
+
+// queueScore returns the smallest share of the capability of the queue left for every resource once the job is
+// placed, and whether the queue has room for the job. The resources the queue does not limit leave it all.
+func queueScore(queue *scheduling.Queue, request v1.ResourceList) (float64, bool) {
+	score := 1.0
+	for name, capability := range queue.Spec.Capability {
+		if capability.IsZero() {
+			continue
+		}
+		left := capability.DeepCopy()
+		if allocated, found := queue.Status.Allocated[name]; found {
+			left.Sub(allocated)
+		}
+		if requested, found := request[name]; found {
+			left.Sub(requested)
+		}
+		if left.Sign() < 0 {
+			return 0, false
+		}
+		score = math.Min(score, float64(left.MilliValue())/float64(capability.MilliValue()))
+	}
+	return score, true
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/spf13/pflag"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcscheme "volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	batchlister "volcano.sh/apis/pkg/client/listers/batch/v1alpha1"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+const (
+	controllerName = "dispatch-controller"
+
+	// dispatchFinalizer keeps a dispatched job until its job in the member cluster is deleted.
+	dispatchFinalizer = "volcano.sh/dispatch"
+
+	// dispatchSyncPeriod is the period the status of the dispatched jobs is synced from their member cluster at.
+	dispatchSyncPeriod = 15 * time.Second
+	// dispatchRetryPeriod is the time after which a job no member cluster had room for is dispatched again.
+	dispatchRetryPeriod = time.Minute
+
+	// DispatchedReason is the reason of the event when a job is dispatched to a member cluster.
+	DispatchedReason = "Dispatched"
+	// NoMemberClusterReason is the reason of the event when no member cluster has room for a job.
+	NoMemberClusterReason = "NoMemberCluster"
+)
+
+func init() {
+	framework.RegisterController(&dispatchcontroller{})
+}
+
+// dispatchcontroller dispatches the jobs of the queues with a dispatch policy to the member cluster whose queue has
+// the most room for them, and syncs the status of the jobs and their podgroups in the member cluster back.
+type dispatchcontroller struct {
+	vcClient vcclientset.Interface
+
+	vcInformerFactory vcinformer.SharedInformerFactory
+	jobLister         batchlister.JobLister
+	jobSynced         func() bool
+	queueLister       schedulinglister.QueueLister
+	queueSynced       func() bool
+
+	queue    workqueue.TypedRateLimitingInterface[string]
+	recorder record.EventRecorder
+
+	// kubeconfigDir is the directory of the kubeconfig files of the member clusters, named after them.
+	kubeconfigDir    string
+	newClusterClient func(kubeconfig []byte) (vcclientset.Interface, error)
+	clusters         map[string]*memberCluster
+}
+
+func (dc *dispatchcontroller) Name() string {
+	return controllerName
+}
+
+// AddFlags implements framework.FlagProvider.
+func (dc *dispatchcontroller) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&dc.kubeconfigDir, "member-cluster-kubeconfig-dir", "",
+		"The directory of the kubeconfig files of the member clusters the dispatch-controller dispatches jobs to, "+
+			"each file named after its cluster")
+}
+
+func (dc *dispatchcontroller) Initialize(opt *framework.ControllerOption) error {
+	dc.vcClient = opt.VolcanoClient
+	if dc.newClusterClient == nil {
+		dc.newClusterClient = newClusterClient
+	}
+	dc.vcInformerFactory = opt.VCSharedInformerFactory
+	dc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(klog.Infof)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: opt.KubeClient.CoreV1().Events("")})
+	dc.recorder = eventBroadcaster.NewRecorder(vcscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
+
+	jobInformer := dc.vcInformerFactory.Batch().V1alpha1().Jobs()
+	jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: dc.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			dc.enqueue(newObj)
+		},
+	})
+	dc.jobLister = jobInformer.Lister()
+	dc.jobSynced = jobInformer.Informer().HasSynced
+
+	queueInformer := dc.vcInformerFactory.Scheduling().V1beta1().Queues()
+	dc.queueLister = queueInformer.Lister()
+	dc.queueSynced = queueInformer.Informer().HasSynced
+	return nil
+}
+
+// Run starts the DispatchController.
+func (dc *dispatchcontroller) Run(stopCh <-chan struct{}) {
+	defer dc.queue.ShutDown()
+
+	dc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, dc.jobSynced, dc.queueSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(dc.worker, 0, stopCh)
+	klog.Infof("DispatchController is running ...... ")
+	<-stopCh
+}
+
+func (dc *dispatchcontroller) enqueue(obj interface{}) {
+	job, ok := obj.(*batch.Job)
+	if !ok {
+		klog.Errorf("obj is not Job")
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(job)
+	if err != nil {
+		klog.Errorf("Failed to get key of Job <%s/%s>: %v", job.Namespace, job.Name, err)
+		return
+	}
+	dc.queue.Add(key)
+}
+
+func (dc *dispatchcontroller) worker() {
+	for dc.processNextReq() {
+	}
+}
+
+func (dc *dispatchcontroller) processNextReq() bool {
+	key, shutdown := dc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer dc.queue.Done(key)
+
+	requeueAfter, err := dc.sync(key)
+	if err != nil {
+		klog.V(2).Infof("Failed to sync dispatch of Job <%s>: %v", key, err)
+		dc.queue.AddRateLimited(key)
+		return true
+	}
+	dc.queue.Forget(key)
+	if requeueAfter > 0 {
+		dc.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// sync dispatches the job of a queue with a dispatch policy to a member cluster, syncs its status back until it
+// finishes, and deletes its job in the member cluster with it. It returns when the job is to be synced again.
+func (dc *dispatchcontroller) sync(key string) (time.Duration, error) {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return 0, err
+	}
+	job, err := dc.jobLister.Jobs(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	cluster := job.Annotations[batch.DispatchedClusterKey]
+
+	if job.DeletionTimestamp != nil {
+		if !slices.Contains(job.Finalizers, dispatchFinalizer) {
+			return 0, nil
+		}
+		if cluster != "" {
+			if err := dc.deleteMemberJob(job, cluster); err != nil {
+				return 0, err
+			}
+		}
+		job = job.DeepCopy()
+		job.Finalizers = slices.Filter(nil, job.Finalizers, func(finalizer string) bool {
+			return finalizer != dispatchFinalizer
+		})
+		_, err := dc.vcClient.BatchV1alpha1().Jobs(ns).Update(context.TODO(), job, metav1.UpdateOptions{})
+		return 0, err
+	}
+
+	if cluster == "" {
+		queue, err := dc.queueLister.Get(job.Spec.Queue)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return 0, err
+		}
+		// The jobs started in this cluster before their queue dispatched its jobs keep running here.
+		if queue == nil || queue.Spec.Dispatch == nil ||
+			(job.Status.State.Phase != "" && job.Status.State.Phase != batch.Pending) {
+			return 0, nil
+		}
+		cluster, err = dc.selectCluster(job, queue.Spec.Dispatch)
+		if err != nil {
+			return 0, err
+		}
+		if cluster == "" {
+			dc.recorder.Eventf(job, v1.EventTypeWarning, NoMemberClusterReason,
+				"no member cluster of queue %s has room for the job", job.Spec.Queue)
+			return dispatchRetryPeriod, nil
+		}
+
+		job = job.DeepCopy()
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[batch.DispatchedClusterKey] = cluster
+		job.Annotations[batch.DispatchedQueueKey] = memberQueue(job, queue.Spec.Dispatch)
+		job.Finalizers = append(job.Finalizers, dispatchFinalizer)
+		if job, err = dc.vcClient.BatchV1alpha1().Jobs(ns).Update(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+			return 0, err
+		}
+		dc.recorder.Eventf(job, v1.EventTypeNormal, DispatchedReason, "dispatched to member cluster %s", cluster)
+	}
+	return dc.syncMemberJob(job, cluster)
+}
+
+// syncMemberJob creates the job in its member cluster if it does not exist yet, and syncs the status of the job and
+// the phase of its podgroup back from the member cluster.
+func (dc *dispatchcontroller) syncMemberJob(job *batch.Job, cluster string) (time.Duration, error) {
+	clusters, err := dc.memberClusters()
+	if err != nil {
+		return 0, err
+	}
+	client, found := clusters[cluster]
+	if !found {
+		return 0, fmt.Errorf("member cluster <%s> of Job <%s/%s> has no kubeconfig", cluster, job.Namespace, job.Name)
+	}
+
+	member, err := client.BatchV1alpha1().Jobs(job.Namespace).Get(context.TODO(), job.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err := client.BatchV1alpha1().Jobs(job.Namespace).Create(context.TODO(), buildMemberJob(job), metav1.CreateOptions{}); err != nil {
+			return 0, fmt.Errorf("failed to create Job <%s/%s> in member cluster <%s>: %v", job.Namespace, job.Name, cluster, err)
+		}
+		return dispatchSyncPeriod, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	pgPhase := ""
+	pg, err := client.SchedulingV1beta1().PodGroups(member.Namespace).Get(context.TODO(),
+		fmt.Sprintf("%s-%s", member.Name, member.UID), metav1.GetOptions{})
+	if err == nil {
+		pgPhase = string(pg.Status.Phase)
+	} else if !apierrors.IsNotFound(err) {
+		return 0, err
+	}
+	if job.Annotations[batch.DispatchedPodGroupPhaseKey] != pgPhase {
+		job = job.DeepCopy()
+		job.Annotations[batch.DispatchedPodGroupPhaseKey] = pgPhase
+		if job, err = dc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Update(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+			return 0, err
+		}
+	}
+	if !reflect.DeepEqual(job.Status, member.Status) {
+		job = job.DeepCopy()
+		job.Status = member.Status
+		if _, err := dc.vcClient.BatchV1alpha1().Jobs(job.Namespace).UpdateStatus(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+			return 0, err
+		}
+	}
+
+	switch member.Status.State.Phase {
+	case batch.Completed, batch.Failed, batch.Aborted, batch.Terminated:
+		return 0, nil
+	default:
+		return dispatchSyncPeriod, nil
+	}
+}
+
+// buildMemberJob builds the job dispatched to the member cluster, in its queue there.
+func buildMemberJob(job *batch.Job) *batch.Job {
+	member := &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        job.Name,
+			Namespace:   job.Namespace,
+			Labels:      job.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: *job.Spec.DeepCopy(),
+	}
+	for key, value := range job.Annotations {
+		switch key {
+		case batch.DispatchedClusterKey, batch.DispatchedPodGroupPhaseKey, batch.DispatchedQueueKey:
+		default:
+			member.Annotations[key] = value
+		}
+	}
+	member.Spec.Queue = job.Annotations[batch.DispatchedQueueKey]
+	return member
+}
+
+func (dc *dispatchcontroller) deleteMemberJob(job *batch.Job, cluster string) error {
+	clusters, err := dc.memberClusters()
+	if err != nil {
+		return err
+	}
+	client, found := clusters[cluster]
+	if !found {
+		klog.Warningf("Member cluster <%s> of Job <%s/%s> has no kubeconfig, its job there is left", cluster, job.Namespace, job.Name)
+		return nil
+	}
+	policy := metav1.DeletePropagationBackground
+	err = client.BatchV1alpha1().Jobs(job.Namespace).Delete(context.TODO(), job.Name, metav1.DeleteOptions{PropagationPolicy: &policy})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newQueue(name string, state scheduling.QueueState, capability, allocated string) *scheduling.Queue {
+	return &scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: scheduling.QueueSpec{
+			Capability: v1.ResourceList{v1.ResourceCPU: resource.MustParse(capability)},
+		},
+		Status: scheduling.QueueStatus{
+			State:     state,
+			Allocated: v1.ResourceList{v1.ResourceCPU: resource.MustParse(allocated)},
+		},
+	}
+}
+
+func newJob(queue string) *batch.Job {
+	return &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "default", Annotations: map[string]string{"team": "ml"}},
+		Spec: batch.JobSpec{
+			Queue: queue,
+			Tasks: []batch.TaskSpec{{
+				Name:     "worker",
+				Replicas: 4,
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{
+							Name: "main",
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+}
+
+// newFakeController returns a controller whose member clusters are served by the given fake clients.
+func newFakeController(t *testing.T, members map[string]*vcclient.Clientset) *dispatchcontroller {
+	dir := t.TempDir()
+	for name := range members {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0600))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "..data"), 0700))
+
+	kubeClient := kubeclient.NewSimpleClientset()
+	vcClient := vcclient.NewSimpleClientset()
+	controller := &dispatchcontroller{
+		kubeconfigDir: dir,
+		newClusterClient: func(kubeconfig []byte) (vcclientset.Interface, error) {
+			return members[string(kubeconfig)], nil
+		},
+	}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: informerfactory.NewSharedInformerFactory(vcClient, 0),
+	}
+	controller.Initialize(opt)
+	controller.recorder = record.NewFakeRecorder(10)
+	return controller
+}
+
+func TestSelectCluster(t *testing.T) {
+	members := map[string]*vcclient.Clientset{
+		"east":   vcclient.NewSimpleClientset(newQueue("research", scheduling.QueueStateOpen, "20", "10")),
+		"west":   vcclient.NewSimpleClientset(newQueue("research", scheduling.QueueStateOpen, "16", "0")),
+		"north":  vcclient.NewSimpleClientset(newQueue("research", scheduling.QueueStateOpen, "8", "4")),
+		"closed": vcclient.NewSimpleClientset(newQueue("research", scheduling.QueueStateClosed, "100", "0")),
+	}
+	dc := newFakeController(t, members)
+	job := newJob("research")
+
+	testCases := []struct {
+		name     string
+		policy   *scheduling.QueueDispatchPolicy
+		expected string
+	}{
+		{
+			name:     "most available of all the member clusters",
+			policy:   &scheduling.QueueDispatchPolicy{},
+			expected: "west",
+		},
+		{
+			name:     "most available of the clusters of the policy",
+			policy:   &scheduling.QueueDispatchPolicy{Clusters: []string{"north", "east"}},
+			expected: "east",
+		},
+		{
+			name:     "first fit in the order of the policy",
+			policy:   &scheduling.QueueDispatchPolicy{Clusters: []string{"north", "east", "west"}, Strategy: scheduling.DispatchStrategyFirstFit},
+			expected: "east",
+		},
+		{
+			name:     "no room in the queue of the policy",
+			policy:   &scheduling.QueueDispatchPolicy{Queue: "missing"},
+			expected: "",
+		},
+		{
+			name:     "closed queues and unknown clusters are skipped",
+			policy:   &scheduling.QueueDispatchPolicy{Clusters: []string{"closed", "south"}},
+			expected: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster, err := dc.selectCluster(job, tc.policy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cluster)
+		})
+	}
+}
+
+func TestSyncDispatch(t *testing.T) {
+	ctx := context.TODO()
+	member := vcclient.NewSimpleClientset(newQueue("gpu", scheduling.QueueStateOpen, "16", "0"))
+	dc := newFakeController(t, map[string]*vcclient.Clientset{"east": member})
+	queue := &scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "research"},
+		Spec:       scheduling.QueueSpec{Dispatch: &scheduling.QueueDispatchPolicy{Queue: "gpu"}},
+	}
+	require.NoError(t, dc.vcInformerFactory.Scheduling().V1beta1().Queues().Informer().GetIndexer().Add(queue))
+	_, err := dc.vcClient.BatchV1alpha1().Jobs("default").Create(ctx, newJob("research"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	latestJob := func() *batch.Job {
+		job, err := dc.vcClient.BatchV1alpha1().Jobs("default").Get(ctx, "job1", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, dc.vcInformerFactory.Batch().V1alpha1().Jobs().Informer().GetIndexer().Update(job))
+		return job
+	}
+	latestJob()
+
+	// The job is dispatched to the member cluster, in the queue of the policy.
+	requeue, err := dc.sync("default/job1")
+	require.NoError(t, err)
+	assert.Equal(t, dispatchSyncPeriod, requeue)
+	job := latestJob()
+	assert.Equal(t, "east", job.Annotations[batch.DispatchedClusterKey])
+	assert.Contains(t, job.Finalizers, dispatchFinalizer)
+	memberJob, err := member.BatchV1alpha1().Jobs("default").Get(ctx, "job1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "gpu", memberJob.Spec.Queue)
+	assert.Equal(t, map[string]string{"team": "ml"}, memberJob.Annotations)
+
+	// The status of the job and the phase of its podgroup are synced back.
+	memberJob.UID = "member-uid"
+	memberJob.Status = batch.JobStatus{State: batch.JobState{Phase: batch.Running}, Running: 4, MinAvailable: 4}
+	_, err = member.BatchV1alpha1().Jobs("default").Update(ctx, memberJob, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, err = member.SchedulingV1beta1().PodGroups("default").Create(ctx, &scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1-member-uid", Namespace: "default"},
+		Status:     scheduling.PodGroupStatus{Phase: scheduling.PodGroupRunning},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	requeue, err = dc.sync("default/job1")
+	require.NoError(t, err)
+	assert.Equal(t, dispatchSyncPeriod, requeue)
+	job = latestJob()
+	assert.Equal(t, batch.Running, job.Status.State.Phase)
+	assert.Equal(t, int32(4), job.Status.Running)
+	assert.Equal(t, string(scheduling.PodGroupRunning), job.Annotations[batch.DispatchedPodGroupPhaseKey])
+
+	// The finished job is no longer synced.
+	memberJob.Status.State.Phase = batch.Completed
+	_, err = member.BatchV1alpha1().Jobs("default").Update(ctx, memberJob, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	requeue, err = dc.sync("default/job1")
+	require.NoError(t, err)
+	assert.Zero(t, requeue)
+	assert.Equal(t, batch.Completed, latestJob().Status.State.Phase)
+
+	// The job in the member cluster is deleted with the job.
+	job = latestJob()
+	job.DeletionTimestamp = &metav1.Time{}
+	require.NoError(t, dc.vcInformerFactory.Batch().V1alpha1().Jobs().Informer().GetIndexer().Update(job))
+	_, err = dc.sync("default/job1")
+	require.NoError(t, err)
+	_, err = member.BatchV1alpha1().Jobs("default").Get(ctx, "job1", metav1.GetOptions{})
+	assert.Error(t, err)
+	job, err = dc.vcClient.BatchV1alpha1().Jobs("default").Get(ctx, "job1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, job.Finalizers, dispatchFinalizer)
+}
+
+func TestSyncNoMemberCluster(t *testing.T) {
+	dc := newFakeController(t, map[string]*vcclient.Clientset{
+		"east": vcclient.NewSimpleClientset(newQueue("research", scheduling.QueueStateOpen, "4", "0")),
+	})
+	queue := &scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "research"},
+		Spec:       scheduling.QueueSpec{Dispatch: &scheduling.QueueDispatchPolicy{}},
+	}
+	require.NoError(t, dc.vcInformerFactory.Scheduling().V1beta1().Queues().Informer().GetIndexer().Add(queue))
+	require.NoError(t, dc.vcInformerFactory.Batch().V1alpha1().Jobs().Informer().GetIndexer().Add(newJob("research")))
+
+	requeue, err := dc.sync("default/job1")
+	require.NoError(t, err)
+	assert.Equal(t, dispatchRetryPeriod, requeue)
+	assert.Equal(t, "Warning NoMemberCluster no member cluster of queue research has room for the job",
+		<-dc.recorder.(*record.FakeRecorder).Events)
+}
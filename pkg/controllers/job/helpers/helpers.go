@@ -136,6 +136,17 @@ func GetTaskIndexUnderJob(taskName string, job *batch.Job) int {
 	return -1
 }
 
+// GetAutoscaledTaskIndex returns the index of the task scaled by the scale subresource of the job, -1 if there is none.
+func GetAutoscaledTaskIndex(job *batch.Job) int {
+	if job.Spec.Autoscaling == nil || len(job.Spec.Tasks) == 0 {
+		return -1
+	}
+	if job.Spec.Autoscaling.TaskName == "" {
+		return 0
+	}
+	return GetTaskIndexUnderJob(job.Spec.Autoscaling.TaskName, job)
+}
+
 // GetPodsNameUnderTask return names of all pods in the task.
 func GetPodsNameUnderTask(taskName string, job *batch.Job) []string {
 	var res []string
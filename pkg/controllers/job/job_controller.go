@@ -150,6 +150,11 @@ type jobcontroller struct {
 	// delayActionMap stores delayed actions for jobs, where outer map key is job key (namespace/name),
 	// inner map key is pod name, and value is the delayed action to be performed
 	delayActionMap map[string]map[string]*delayAction
+
+	migrationsLock sync.Mutex
+	// migrations stores the migrations of the pods evicted by the scheduler once checkpointed, by pod key (namespace/name),
+	// applied to the pods recreated to replace them
+	migrations map[string]*podMigration
 }
 
 func (cc *jobcontroller) Name() string {
@@ -262,6 +267,7 @@ func (cc *jobcontroller) Initialize(opt *framework.ControllerOption) error {
 	cc.queueSynced = cc.queueInformer.Informer().HasSynced
 
 	cc.delayActionMap = make(map[string]map[string]*delayAction)
+	cc.migrations = make(map[string]*podMigration)
 
 	// Register actions
 	state.SyncJob = cc.syncJob
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/helpers"
@@ -365,6 +366,13 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		return err
 	}
 
+	// The jobs of a queue dispatching them to member clusters run there, their status is synced by the dispatch-controller.
+	if queueInfo.Spec.Dispatch != nil {
+		klog.V(4).Infof("Job <%s/%s> is dispatched to a member cluster by queue <%s>, skip management process.",
+			job.Namespace, job.Name, queueInfo.Name)
+		return nil
+	}
+
 	var jobForwarding bool
 	if len(queueInfo.Spec.ExtendClusters) != 0 {
 		jobForwarding = true
@@ -412,6 +420,9 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 			syncTask = true
 		}
 		cc.recordPodGroupEvent(job, pg)
+		if job, err = cc.shrinkElasticJob(job, pg); err != nil {
+			return err
+		}
 	}
 
 	var jobCondition batch.JobCondition
@@ -447,6 +458,7 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 
 	podToCreate := make(map[string][]*v1.Pod)
 	var podToDelete []*v1.Pod
+	var podToForceDelete []*v1.Pod
 	var creationErrs []error
 	var deletionErrs []error
 	appendMutex := sync.Mutex{}
@@ -474,6 +486,7 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 			podName := fmt.Sprintf(jobhelpers.PodNameFmt, job.Name, name, i)
 			if pod, found := pods[podName]; !found {
 				newPod := createJobPod(job, tc, i, jobForwarding, pg, &ts)
+				cc.applyMigration(newPod)
 				if err := cc.pluginOnPodCreate(job, newPod); err != nil {
 					return err
 				}
@@ -484,6 +497,9 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 				if pod.DeletionTimestamp != nil {
 					klog.Infof("Pod <%s/%s> is terminating", pod.Namespace, pod.Name)
 					atomic.AddInt32(&terminating, 1)
+					if replaceTerminatingPod(job, pod) {
+						podToForceDelete = append(podToForceDelete, pod) // free the pod name for the replacement
+					}
 					continue
 				}
 
@@ -556,6 +572,16 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		return fmt.Errorf("failed to create %d pods of %d", len(creationErrs), len(podToCreate))
 	}
 
+	// Force delete the terminating pods replaced as soon as they are terminating,
+	// their replacement is created once the deletion is observed.
+	for _, pod := range podToForceDelete {
+		if err := cc.forceDeleteJobPod(job.Name, pod); err != nil {
+			appendError(&deletionErrs, err)
+			continue
+		}
+		klog.V(3).InfoS("Force deleted terminating Pod of Job", "Job", klog.KObj(job), "Pod", klog.KObj(pod), "UID", pod.UID)
+	}
+
 	// Delete pods when scale down.
 	waitDeletionGroup := sync.WaitGroup{}
 	waitDeletionGroup.Add(len(podToDelete))
@@ -582,7 +608,7 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 	if len(deletionErrs) != 0 {
 		cc.recorder.Event(job, v1.EventTypeWarning, FailedDeletePodReason,
 			fmt.Sprintf("Error deleting pods: %+v", deletionErrs))
-		return fmt.Errorf("failed to delete %d pods of %d", len(deletionErrs), len(podToDelete))
+		return fmt.Errorf("failed to delete %d pods of %d", len(deletionErrs), len(podToDelete)+len(podToForceDelete))
 	}
 
 	newStatus := batch.JobStatus{
@@ -600,6 +626,7 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		ControlledResources: job.Status.ControlledResources,
 		Conditions:          job.Status.Conditions,
 		RetryCount:          job.Status.RetryCount,
+		Autoscaling:         calcAutoscalingStatus(job),
 	}
 
 	if updateStatus != nil {
@@ -866,6 +893,32 @@ func (cc *jobcontroller) createOrUpdatePodGroup(job *batch.Job) error {
 	return err
 }
 
+// shrinkElasticJob lowers the replicas of an elastic job to the replicas the scheduler asks its PodGroup
+// to shrink to, instead of having its pods reclaimed, the excess pods are then deleted by the sync.
+func (cc *jobcontroller) shrinkElasticJob(job *batch.Job, pg *scheduling.PodGroup) (*batch.Job, error) {
+	req := pg.Status.ScaleDownRequest
+	if req == nil {
+		return job, nil
+	}
+	minReplicas, elastic := elasticMinReplicas(job)
+	if !elastic {
+		return job, nil
+	}
+	replicas := max(req.Replicas, minReplicas, job.Spec.MinAvailable)
+	if !shrinkTasks(job, replicas) {
+		return job, nil
+	}
+
+	newJob, err := cc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Update(context.TODO(), job, metav1.UpdateOptions{})
+	if err != nil {
+		klog.Errorf("Failed to shrink elastic Job <%s/%s> to %d replicas: %v", job.Namespace, job.Name, replicas, err)
+		return nil, err
+	}
+	cc.recorder.Eventf(newJob, v1.EventTypeNormal, "ScaleDown",
+		"Shrunk to %d replicas as requested by the scheduler: %s", state.TotalTasks(newJob), req.Reason)
+	return newJob, nil
+}
+
 func (cc *jobcontroller) getMinTaskMember(task batch.TaskSpec) int32 {
 	if task.MinAvailable != nil {
 		return *task.MinAvailable
@@ -929,6 +982,22 @@ func (cc *jobcontroller) deleteJobPod(jobName string, pod *v1.Pod) error {
 	return nil
 }
 
+// forceDeleteJobPod deletes the terminating pod without waiting for its graceful termination.
+func (cc *jobcontroller) forceDeleteJobPod(jobName string, pod *v1.Pod) error {
+	err := cc.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{
+		GracePeriodSeconds: ptr.To[int64](0),
+		Preconditions:      metav1.NewUIDPreconditions(string(pod.UID)),
+	})
+	if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		klog.Errorf("Failed to force delete pod %s/%s for Job %s, err %#v",
+			pod.Namespace, pod.Name, jobName, err)
+
+		return fmt.Errorf("failed to force delete pod %s, err %#v", pod.Name, err)
+	}
+
+	return nil
+}
+
 func (cc *jobcontroller) calcPGMinResources(job *batch.Job) *v1.ResourceList {
 	// sort task by priorityClasses
 	var tasksPriority TasksPriority
@@ -1056,6 +1125,16 @@ func calcPodStatus(pod *v1.Pod, taskStatusCount map[string]batch.TaskState) {
 	}
 }
 
+// replaceTerminatingPod returns whether the terminating pod is force deleted to be replaced right away,
+// which is only the case with the TerminatingOrFailed pod replacement policy.
+func replaceTerminatingPod(job *batch.Job, pod *v1.Pod) bool {
+	if job.Spec.PodReplacementPolicy == nil || *job.Spec.PodReplacementPolicy != batch.PodReplacementTerminatingOrFailed {
+		return false
+	}
+	// the pod is already force deleted
+	return pod.DeletionGracePeriodSeconds == nil || *pod.DeletionGracePeriodSeconds != 0
+}
+
 func isInitiated(job *batch.Job) bool {
 	if job.Status.State.Phase == "" || job.Status.State.Phase == batch.Pending {
 		return false
@@ -241,6 +241,7 @@ func TestSyncJobFunc(t *testing.T) {
 		Name           string
 		Job            *v1alpha1.Job
 		PodGroup       *schedulingapi.PodGroup
+		Queue          *schedulingapi.Queue
 		PodRetainPhase state.PhaseMap
 		UpdateStatus   state.UpdateStatusFn
 		JobInfo        *apis.JobInfo
@@ -421,6 +422,63 @@ func TestSyncJobFunc(t *testing.T) {
 			Plugins:      []string{"svc", "ssh", "env"},
 			ExpectVal:    nil,
 		},
+		{
+			Name: "SyncJob of a queue dispatching its jobs to member clusters",
+			Job: &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "job1",
+					Namespace:       namespace,
+					ResourceVersion: "100",
+					UID:             "e7f18111-1cec-11ea-b688-fa163ec79500",
+				},
+				Spec: v1alpha1.JobSpec{
+					Queue: "dispatched",
+					Tasks: []v1alpha1.TaskSpec{
+						{
+							Name:     "task1",
+							Replicas: 6,
+							Template: v1.PodTemplateSpec{
+								Spec: v1.PodSpec{
+									Containers: []v1.Container{
+										{
+											Name: "Containers",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Status: v1alpha1.JobStatus{
+					State: v1alpha1.JobState{
+						Phase: v1alpha1.Pending,
+					},
+				},
+			},
+			PodGroup: &schedulingapi.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "job1-e7f18111-1cec-11ea-b688-fa163ec79500",
+					Namespace: namespace,
+				},
+				Status: schedulingapi.PodGroupStatus{
+					Phase: schedulingapi.PodGroupInqueue,
+				},
+			},
+			Queue: &schedulingapi.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "dispatched"},
+				Spec: schedulingapi.QueueSpec{
+					Dispatch: &schedulingapi.QueueDispatchPolicy{},
+				},
+			},
+			PodRetainPhase: state.PodRetainPhaseNone,
+			JobInfo: &apis.JobInfo{
+				Namespace: namespace,
+				Name:      "jobinfo1",
+				Pods:      map[string]map[string]*v1.Pod{},
+			},
+			TotalNumPods: 0,
+			ExpectVal:    nil,
+		},
 	}
 	for i, testcase := range testcases {
 
@@ -428,6 +486,9 @@ func TestSyncJobFunc(t *testing.T) {
 			fakeController := newFakeController()
 
 			patches := gomonkey.ApplyMethod(reflect.TypeOf(fakeController), "GetQueueInfo", func(_ *jobcontroller, _ string) (*schedulingapi.Queue, error) {
+				if testcase.Queue != nil {
+					return testcase.Queue, nil
+				}
 				return &schedulingapi.Queue{}, nil
 			})
 
@@ -1025,6 +1086,75 @@ func TestDeleteJobPod(t *testing.T) {
 	}
 }
 
+func TestReplaceTerminatingPod(t *testing.T) {
+	terminatingPod := func(gracePeriod int64) *v1.Pod {
+		pod := buildPod("test", "job1-task1-0", v1.PodRunning, nil)
+		pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		pod.DeletionGracePeriodSeconds = ptr.To(gracePeriod)
+		return pod
+	}
+
+	testcases := []struct {
+		Name     string
+		Policy   *v1alpha1.PodReplacementPolicy
+		Pod      *v1.Pod
+		Expected bool
+	}{
+		{
+			Name:     "terminating pod is not replaced without policy",
+			Pod:      terminatingPod(30),
+			Expected: false,
+		},
+		{
+			Name:     "terminating pod is not replaced with Failed policy",
+			Policy:   ptr.To(v1alpha1.PodReplacementFailed),
+			Pod:      terminatingPod(30),
+			Expected: false,
+		},
+		{
+			Name:     "terminating pod is replaced with TerminatingOrFailed policy",
+			Policy:   ptr.To(v1alpha1.PodReplacementTerminatingOrFailed),
+			Pod:      terminatingPod(30),
+			Expected: true,
+		},
+		{
+			Name:     "force deleted pod is not deleted again",
+			Policy:   ptr.To(v1alpha1.PodReplacementTerminatingOrFailed),
+			Pod:      terminatingPod(0),
+			Expected: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{Spec: v1alpha1.JobSpec{PodReplacementPolicy: testcase.Policy}}
+			if replace := replaceTerminatingPod(job, testcase.Pod); replace != testcase.Expected {
+				t.Errorf("Expected replace to be %v, but got %v", testcase.Expected, replace)
+			}
+		})
+	}
+}
+
+func TestForceDeleteJobPod(t *testing.T) {
+	namespace := "test"
+	fakeController := newFakeController()
+	pod := buildPod(namespace, "job1-task1-0", v1.PodRunning, nil)
+	if _, err := fakeController.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Expected no error while creating pod, but got: %v", err)
+	}
+
+	if err := fakeController.forceDeleteJobPod("job1", pod); err != nil {
+		t.Errorf("Expected no error while force deleting pod, but got: %v", err)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); err == nil {
+		t.Error("Expected Pod to be deleted but not deleted")
+	}
+	// the pod already gone is not an error
+	if err := fakeController.forceDeleteJobPod("job1", pod); err != nil {
+		t.Errorf("Expected no error while force deleting deleted pod, but got: %v", err)
+	}
+}
+
 func TestRecordPodGroupEvent(t *testing.T) {
 	job1 := &v1alpha1.Job{
 		ObjectMeta: metav1.ObjectMeta{
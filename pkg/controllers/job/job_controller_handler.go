@@ -375,7 +375,8 @@ func (cc *jobcontroller) deletePod(obj interface{}) {
 	}
 
 	event := bus.PodEvictedEvent
-	if jobhelpers.IsOutOfSyncPod(pod) || !cc.cache.HasPod(pod) {
+	// the pod migrated by the scheduler is recreated on the target node without firing the policies of the eviction
+	if cc.recordMigration(pod) || jobhelpers.IsOutOfSyncPod(pod) || !cc.cache.HasPod(pod) {
 		event = bus.OutOfSyncEvent
 	}
 
@@ -488,6 +489,19 @@ func (cc *jobcontroller) updatePodGroup(oldObj, newObj interface{}) {
 		key := jobhelpers.GetJobKeyByReq(&req)
 		queue := cc.getWorkerQueue(key)
 		queue.Add(req)
+		return
+	}
+
+	if newPG.Status.ScaleDownRequest != nil && !equality.Semantic.DeepEqual(newPG.Status.ScaleDownRequest, oldPG.Status.ScaleDownRequest) {
+		// The scheduler asks the elastic job to shrink, sync it to lower its replicas.
+		req := apis.Request{
+			Namespace: newPG.Namespace,
+			JobName:   jobNameKey,
+			Event:     bus.OutOfSyncEvent,
+		}
+		key := jobhelpers.GetJobKeyByReq(&req)
+		queue := cc.getWorkerQueue(key)
+		queue.Add(req)
 	}
 }
 
@@ -35,6 +35,7 @@ import (
 	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
 	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/controllers/apis"
 	"volcano.sh/volcano/pkg/controllers/framework"
 )
 
@@ -569,6 +570,92 @@ func TestDeletePodFunc(t *testing.T) {
 	}
 }
 
+func TestDeleteMigratedPod(t *testing.T) {
+	namespace := "test"
+
+	testcases := []struct {
+		Name          string
+		Annotation    map[string]string
+		ExpectedEvent bus.Event
+		// ExpectedRestore is the checkpoint the recreated pod is restored from, empty if it is not migrated
+		ExpectedRestore string
+	}{
+		{
+			Name: "checkpointed pod is recreated on the target node",
+			Annotation: map[string]string{
+				scheduling.MigrationTargetKey:     "n2",
+				scheduling.MigrationCheckpointKey: "registry.local/checkpoints/pod1:1",
+			},
+			ExpectedEvent:   bus.OutOfSyncEvent,
+			ExpectedRestore: "registry.local/checkpoints/pod1:1",
+		},
+		{
+			Name: "pod evicted before its checkpoint is not migrated",
+			Annotation: map[string]string{
+				scheduling.MigrationTargetKey: "n2",
+			},
+			ExpectedEvent: bus.PodEvictedEvent,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			controller := newController()
+			controller.addJob(&batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: namespace}})
+			pod := buildPod(namespace, "pod1", v1.PodRunning, nil)
+			addPodAnnotation(pod, map[string]string{
+				batch.JobNameKey:  "job1",
+				batch.JobVersion:  "0",
+				batch.TaskSpecKey: "task1",
+			})
+			controller.addPod(pod)
+			// drain the request of the pod creation
+			queue := controller.getWorkerQueue(fmt.Sprintf("%s/%s", namespace, "job1"))
+			for queue.Len() > 0 {
+				item, _ := queue.Get()
+				queue.Done(item)
+			}
+
+			addPodAnnotation(pod, testcase.Annotation)
+			controller.deletePod(pod)
+			item, _ := queue.Get()
+			if req := item.(apis.Request); req.Event != testcase.ExpectedEvent {
+				t.Errorf("expected event %s, got %s", testcase.ExpectedEvent, req.Event)
+			}
+
+			newPod := buildPod(namespace, "pod1", v1.PodPending, nil)
+			newPod.Spec.Affinity = &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{MatchExpressions: []v1.NodeSelectorRequirement{{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"z1"}}}},
+				}},
+			}}
+			controller.applyMigration(newPod)
+			if restore := newPod.Annotations[scheduling.RestoreFromKey]; restore != testcase.ExpectedRestore {
+				t.Errorf("expected the pod restored from %q, got %q", testcase.ExpectedRestore, restore)
+			}
+			term := newPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0]
+			if len(term.MatchExpressions) != 1 {
+				t.Errorf("expected the node affinity of the pod kept, got %v", term.MatchExpressions)
+			}
+			if testcase.ExpectedRestore == "" {
+				if len(term.MatchFields) != 0 {
+					t.Errorf("expected the pod not bound to a node, got %v", term.MatchFields)
+				}
+				return
+			}
+			if len(term.MatchFields) != 1 || term.MatchFields[0].Values[0] != "n2" {
+				t.Errorf("expected the pod bound to node n2, got %v", term.MatchFields)
+			}
+
+			// the migration is applied once
+			controller.applyMigration(buildPod(namespace, "pod1", v1.PodPending, nil))
+			if len(controller.migrations) != 0 {
+				t.Errorf("expected the migration applied once")
+			}
+		})
+	}
+}
+
 func TestUpdatePodGroupFunc(t *testing.T) {
 
 	namespace := "test"
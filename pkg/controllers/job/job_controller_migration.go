@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	schedulingv2 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// podMigration is the migration of a pod evicted by the scheduler once checkpointed, the pod is recreated
+// on the target node and restored from the checkpoint.
+type podMigration struct {
+	target     string
+	checkpoint string
+}
+
+// migrationKey is the key of the migration of a pod, the pods are recreated under the same name.
+func migrationKey(pod *v1.Pod) string {
+	return fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+}
+
+// recordMigration records the migration of the deleted pod when it was checkpointed for a migration,
+// for its replacement to be recreated on the target node. It returns whether the pod is migrated.
+func (cc *jobcontroller) recordMigration(pod *v1.Pod) bool {
+	target := pod.Annotations[schedulingv2.MigrationTargetKey]
+	checkpoint := pod.Annotations[schedulingv2.MigrationCheckpointKey]
+	if target == "" || checkpoint == "" {
+		return false
+	}
+
+	cc.migrationsLock.Lock()
+	defer cc.migrationsLock.Unlock()
+	cc.migrations[migrationKey(pod)] = &podMigration{target: target, checkpoint: checkpoint}
+	klog.V(3).Infof("Pod <%s/%s> is migrated to node <%s> from checkpoint <%s>", pod.Namespace, pod.Name, target, checkpoint)
+	return true
+}
+
+// applyMigration annotates the new pod replacing a migrated pod with its checkpoint, and binds it to the target
+// node of the migration by node affinity.
+func (cc *jobcontroller) applyMigration(pod *v1.Pod) {
+	key := migrationKey(pod)
+	cc.migrationsLock.Lock()
+	migration, found := cc.migrations[key]
+	delete(cc.migrations, key)
+	cc.migrationsLock.Unlock()
+	if !found {
+		return
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[schedulingv2.RestoreFromKey] = migration.checkpoint
+
+	onTarget := v1.NodeSelectorRequirement{
+		Key:      "metadata.name",
+		Operator: v1.NodeSelectorOpIn,
+		Values:   []string{migration.target},
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &v1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &v1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{}
+	}
+	required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	// the terms are ORed, the target node is required by each of them
+	if len(required.NodeSelectorTerms) == 0 {
+		required.NodeSelectorTerms = []v1.NodeSelectorTerm{{}}
+	}
+	for i := range required.NodeSelectorTerms {
+		required.NodeSelectorTerms[i].MatchFields = append(required.NodeSelectorTerms[i].MatchFields, onTarget)
+	}
+	klog.V(3).Infof("Recreate pod <%s/%s> on node <%s> from checkpoint <%s>", pod.Namespace, pod.Name, migration.target, migration.checkpoint)
+}
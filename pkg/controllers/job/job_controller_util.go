@@ -23,9 +23,11 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	quotav1 "k8s.io/apiserver/pkg/quota/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/bus/v1alpha1"
@@ -132,6 +134,15 @@ func createJobPod(job *batch.Job, template *v1.PodTemplateSpec, ix int, jobForwa
 		if value, found := job.Annotations[schedulingv2.RevocableZone]; found {
 			pod.Annotations[schedulingv2.RevocableZone] = value
 		}
+		for _, key := range []string{
+			schedulingv2.EnablePredicateErrorCacheKey,
+			schedulingv2.PercentageOfNodesToFindKey,
+			schedulingv2.ShardingModeKey,
+		} {
+			if value, found := job.Annotations[key]; found {
+				pod.Annotations[key] = value
+			}
+		}
 
 		if value, found := job.Annotations[schedulingv2.JDBMinAvailable]; found {
 			pod.Annotations[schedulingv2.JDBMinAvailable] = value
@@ -403,6 +414,70 @@ func (p TasksPriority) CalcPGMinResources(jobMinAvailable int32) v1.ResourceList
 	return minReq
 }
 
+// calcAutoscalingStatus returns the status of the task scaled by the scale subresource of the job,
+// nil if the job is not autoscaled.
+func calcAutoscalingStatus(job *batch.Job) *batch.AutoscalingStatus {
+	index := jobhelpers.GetAutoscaledTaskIndex(job)
+	if index < 0 {
+		return nil
+	}
+	task := job.Spec.Tasks[index]
+	return &batch.AutoscalingStatus{
+		Replicas: task.Replicas,
+		Selector: labels.SelectorFromSet(labels.Set{
+			batch.JobNameKey:  job.Name,
+			batch.TaskSpecKey: task.Name,
+		}).String(),
+	}
+}
+
+// elasticMinReplicas returns the minimum number of replicas of an elastic job given by its
+// volcano.sh/elastic-min-replicas and volcano.sh/elastic-max-replicas annotations, false if the job is not elastic.
+func elasticMinReplicas(job *batch.Job) (int32, bool) {
+	minValue, minFound := job.Annotations[schedulingv2.ElasticMinReplicasKey]
+	maxValue, maxFound := job.Annotations[schedulingv2.ElasticMaxReplicasKey]
+	if !minFound || !maxFound {
+		return 0, false
+	}
+	minReplicas, err := strconv.ParseInt(minValue, 10, 32)
+	if err != nil || minReplicas < 1 {
+		return 0, false
+	}
+	maxReplicas, err := strconv.ParseInt(maxValue, 10, 32)
+	if err != nil || maxReplicas < minReplicas {
+		return 0, false
+	}
+	return int32(minReplicas), true
+}
+
+// shrinkTasks lowers the replicas of the tasks of the job, from the last task, until the job has
+// the given replicas. A task keeps at least its minAvailable, or one replica if it has none.
+// It returns whether the job is changed.
+func shrinkTasks(job *batch.Job, replicas int32) bool {
+	excess := state.TotalTasks(job) - replicas
+	changed := false
+	autoscaled := jobhelpers.GetAutoscaledTaskIndex(job)
+	for i := len(job.Spec.Tasks) - 1; i >= 0 && excess > 0; i-- {
+		task := &job.Spec.Tasks[i]
+		floor := int32(1)
+		if task.MinAvailable != nil {
+			floor = *task.MinAvailable
+		}
+		shrunk := min(excess, task.Replicas-floor)
+		if shrunk <= 0 {
+			continue
+		}
+		task.Replicas -= shrunk
+		excess -= shrunk
+		changed = true
+		if i == autoscaled && job.Spec.Autoscaling.Replicas != nil && *job.Spec.Autoscaling.Replicas > task.Replicas {
+			// Keep the scale subresource from scaling the task back up.
+			job.Spec.Autoscaling.Replicas = ptr.To(task.Replicas)
+		}
+	}
+	return changed
+}
+
 // isInternalEvent checks if the event is an internal event
 func isInternalEvent(event v1alpha1.Event) bool {
 	switch event {
@@ -422,7 +497,8 @@ func isInternalAction(action v1alpha1.Action) bool {
 		v1alpha1.EnqueueAction,
 		v1alpha1.SyncQueueAction,
 		v1alpha1.OpenQueueAction,
-		v1alpha1.CloseQueueAction:
+		v1alpha1.CloseQueueAction,
+		v1alpha1.DrainQueueAction:
 		return true
 	default:
 		return false
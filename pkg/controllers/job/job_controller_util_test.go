@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/ptr"
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
@@ -1461,3 +1462,88 @@ func TestCalcPGMinResources(t *testing.T) {
 
 	}
 }
+
+func TestCalcAutoscalingStatus(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{{Name: "master", Replicas: 1}, {Name: "worker", Replicas: 8}},
+		},
+	}
+	if status := calcAutoscalingStatus(job); status != nil {
+		t.Errorf("expected no autoscaling status, got %v", status)
+	}
+
+	job.Spec.Autoscaling = &v1alpha1.AutoscalingSpec{TaskName: "worker"}
+	expected := &v1alpha1.AutoscalingStatus{Replicas: 8, Selector: "volcano.sh/job-name=job1,volcano.sh/task-spec=worker"}
+	if status := calcAutoscalingStatus(job); !reflect.DeepEqual(status, expected) {
+		t.Errorf("expected autoscaling status %v, got %v", expected, status)
+	}
+}
+
+func TestShrinkTasks(t *testing.T) {
+	tests := []struct {
+		name     string
+		replicas int32
+		expected []int32
+		changed  bool
+	}{
+		{
+			name:     "the last task is shrunk first",
+			replicas: 6,
+			expected: []int32{2, 4},
+			changed:  true,
+		},
+		{
+			name:     "the tasks keep their minAvailable",
+			replicas: 2,
+			expected: []int32{1, 2},
+			changed:  true,
+		},
+		{
+			name:     "the job already has the replicas",
+			replicas: 10,
+			expected: []int32{2, 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				Spec: v1alpha1.JobSpec{
+					Tasks: []v1alpha1.TaskSpec{
+						{Name: "ps", Replicas: 2},
+						{Name: "worker", Replicas: 8, MinAvailable: ptr.To[int32](2)},
+					},
+				},
+			}
+			if changed := shrinkTasks(job, tt.replicas); changed != tt.changed {
+				t.Errorf("expected changed %v, got %v", tt.changed, changed)
+			}
+			for i, task := range job.Spec.Tasks {
+				if task.Replicas != tt.expected[i] {
+					t.Errorf("expected %d replicas of task %s, got %d", tt.expected[i], task.Name, task.Replicas)
+				}
+			}
+		})
+	}
+}
+
+func TestElasticMinReplicas(t *testing.T) {
+	tests := []struct {
+		annotations map[string]string
+		expected    int32
+		elastic     bool
+	}{
+		{annotations: nil},
+		{annotations: map[string]string{schedulingv1beta1.ElasticMinReplicasKey: "2"}},
+		{annotations: map[string]string{schedulingv1beta1.ElasticMinReplicasKey: "4", schedulingv1beta1.ElasticMaxReplicasKey: "2"}},
+		{annotations: map[string]string{schedulingv1beta1.ElasticMinReplicasKey: "x", schedulingv1beta1.ElasticMaxReplicasKey: "2"}},
+		{annotations: map[string]string{schedulingv1beta1.ElasticMinReplicasKey: "2", schedulingv1beta1.ElasticMaxReplicasKey: "4"}, expected: 2, elastic: true},
+	}
+	for i, tt := range tests {
+		job := &v1alpha1.Job{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+		if minReplicas, elastic := elasticMinReplicas(job); minReplicas != tt.expected || elastic != tt.elastic {
+			t.Errorf("case %d: expected (%d, %v), got (%d, %v)", i, tt.expected, tt.elastic, minReplicas, elastic)
+		}
+	}
+}
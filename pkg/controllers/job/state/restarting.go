@@ -43,6 +43,12 @@ func (ps *restartingState) restartingUpdateStatus(status *vcbatch.JobStatus) boo
 		total += task.Replicas
 	}
 
+	// Wait for all the terminating pods to be gone, so that no pod of the job runs twice.
+	policy := ps.job.Job.Spec.PodReplacementPolicy
+	if policy != nil && *policy == vcbatch.PodReplacementFailed && status.Terminating != 0 {
+		return false
+	}
+
 	if total-status.Terminating >= status.MinAvailable {
 		status.State.Phase = vcbatch.Pending
 		return true
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobscaler
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcscheme "volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	batchlister "volcano.sh/apis/pkg/client/listers/batch/v1alpha1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
+	"volcano.sh/volcano/pkg/features"
+)
+
+const (
+	controllerName = "jobscaler-controller"
+
+	// ScaledReason is the reason of the event recorded when the task of a job is scaled.
+	ScaledReason = "Scaled"
+	// FailedScaleReason is the reason of the event recorded when the task of a job can not be scaled.
+	FailedScaleReason = "FailedScale"
+)
+
+func init() {
+	framework.RegisterController(&jobscalercontroller{})
+}
+
+// jobscalercontroller translates the desired replicas set by the scale subresource of the jobs,
+// e.g. by a KEDA ScaledObject, into the replicas and minAvailable of their tasks, in whole waves.
+type jobscalercontroller struct {
+	vcClient vcclientset.Interface
+
+	vcInformerFactory vcinformer.SharedInformerFactory
+	jobLister         batchlister.JobLister
+	jobSynced         func() bool
+
+	queue    workqueue.TypedRateLimitingInterface[string]
+	recorder record.EventRecorder
+}
+
+func (jc *jobscalercontroller) Name() string {
+	return controllerName
+}
+
+func (jc *jobscalercontroller) Initialize(opt *framework.ControllerOption) error {
+	jc.vcClient = opt.VolcanoClient
+	jc.vcInformerFactory = opt.VCSharedInformerFactory
+	jc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(klog.Infof)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: opt.KubeClient.CoreV1().Events("")})
+	jc.recorder = eventBroadcaster.NewRecorder(vcscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
+
+	if utilfeature.DefaultFeatureGate.Enabled(features.VolcanoJobSupport) {
+		jobInformer := jc.vcInformerFactory.Batch().V1alpha1().Jobs()
+		jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: jc.addJob,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				jc.addJob(newObj)
+			},
+		})
+		jc.jobLister = jobInformer.Lister()
+		jc.jobSynced = jobInformer.Informer().HasSynced
+	}
+	return nil
+}
+
+// Run starts the JobScalerController.
+func (jc *jobscalercontroller) Run(stopCh <-chan struct{}) {
+	defer jc.queue.ShutDown()
+	if jc.jobLister == nil {
+		klog.Infof("JobScalerController is disabled as Volcano Job support is disabled")
+		return
+	}
+
+	jc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, jc.jobSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(jc.worker, 0, stopCh)
+	klog.Infof("JobScalerController is running ...... ")
+	<-stopCh
+}
+
+func (jc *jobscalercontroller) addJob(obj interface{}) {
+	job, ok := obj.(*batchv1alpha1.Job)
+	if !ok {
+		klog.Errorf("obj is not Job")
+		return
+	}
+	if job.Spec.Autoscaling == nil {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(job)
+	if err != nil {
+		klog.Errorf("Failed to get key of Job <%s/%s>: %v", job.Namespace, job.Name, err)
+		return
+	}
+	jc.queue.Add(key)
+}
+
+func (jc *jobscalercontroller) worker() {
+	for jc.processNextReq() {
+	}
+}
+
+func (jc *jobscalercontroller) processNextReq() bool {
+	key, shutdown := jc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer jc.queue.Done(key)
+
+	if err := jc.sync(key); err != nil {
+		klog.V(2).Infof("Failed to scale Job <%s>: %v", key, err)
+		jc.queue.AddRateLimited(key)
+		return true
+	}
+	jc.queue.Forget(key)
+	return true
+}
+
+func (jc *jobscalercontroller) sync(key string) error {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	job, err := jc.jobLister.Jobs(ns).Get(name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if job.DeletionTimestamp != nil || !scalable(job) {
+		return nil
+	}
+
+	job = job.DeepCopy()
+	from, to, err := scaleJob(job)
+	if err != nil {
+		jc.recorder.Event(job, v1.EventTypeWarning, FailedScaleReason, err.Error())
+		return nil
+	}
+	if from == to {
+		return nil
+	}
+
+	if _, err := jc.vcClient.BatchV1alpha1().Jobs(ns).Update(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	klog.V(3).Infof("Scaled task of Job <%s> from %d to %d replicas", key, from, to)
+	jc.recorder.Eventf(job, v1.EventTypeNormal, ScaledReason, "Scaled task %s from %d to %d replicas",
+		job.Spec.Tasks[jobhelpers.GetAutoscaledTaskIndex(job)].Name, from, to)
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobscaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newAutoscaledJob(replicas, minAvailable int32, desired *int32, waveSize int32) *batchv1alpha1.Job {
+	return &batchv1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "default"},
+		Spec: batchv1alpha1.JobSpec{
+			MinAvailable: minAvailable + 1,
+			Tasks: []batchv1alpha1.TaskSpec{
+				{Name: "master", Replicas: 1, MinAvailable: ptr.To[int32](1)},
+				{Name: "worker", Replicas: replicas, MinAvailable: ptr.To(minAvailable)},
+			},
+			Autoscaling: &batchv1alpha1.AutoscalingSpec{
+				TaskName: "worker",
+				Replicas: desired,
+				WaveSize: waveSize,
+			},
+		},
+		Status: batchv1alpha1.JobStatus{
+			State: batchv1alpha1.JobState{Phase: batchv1alpha1.Running},
+		},
+	}
+}
+
+func TestScaleJob(t *testing.T) {
+	testCases := []struct {
+		name             string
+		job              *batchv1alpha1.Job
+		expectedReplicas int32
+		expectedTaskMin  int32
+		expectedJobMin   int32
+		expectErr        bool
+	}{
+		{
+			name:             "scale up by whole waves",
+			job:              newAutoscaledJob(4, 4, ptr.To[int32](9), 4),
+			expectedReplicas: 12,
+			expectedTaskMin:  12,
+			expectedJobMin:   13,
+		},
+		{
+			name:             "scale down by whole waves keeps the desired replicas",
+			job:              newAutoscaledJob(12, 12, ptr.To[int32](5), 4),
+			expectedReplicas: 8,
+			expectedTaskMin:  8,
+			expectedJobMin:   9,
+		},
+		{
+			name:             "scale to zero",
+			job:              newAutoscaledJob(8, 8, ptr.To[int32](0), 4),
+			expectedReplicas: 0,
+			expectedTaskMin:  0,
+			expectedJobMin:   1,
+		},
+		{
+			name:             "replicas by one without wave size",
+			job:              newAutoscaledJob(2, 1, ptr.To[int32](3), 0),
+			expectedReplicas: 3,
+			expectedTaskMin:  2,
+			expectedJobMin:   3,
+		},
+		{
+			name: "autoscaled task not found",
+			job: func() *batchv1alpha1.Job {
+				job := newAutoscaledJob(2, 2, ptr.To[int32](3), 1)
+				job.Spec.Autoscaling.TaskName = "ps"
+				return job
+			}(),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, to, err := scaleJob(tc.job)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			worker := tc.job.Spec.Tasks[1]
+			assert.Equal(t, tc.expectedReplicas, to)
+			assert.Equal(t, tc.expectedReplicas, worker.Replicas)
+			assert.Equal(t, tc.expectedTaskMin, *worker.MinAvailable)
+			assert.Equal(t, tc.expectedJobMin, tc.job.Spec.MinAvailable)
+		})
+	}
+}
+
+func TestSync(t *testing.T) {
+	testCases := []struct {
+		name             string
+		job              *batchv1alpha1.Job
+		expectedReplicas int32
+		expectedEvent    bool
+	}{
+		{
+			name:             "running job is scaled",
+			job:              newAutoscaledJob(4, 4, ptr.To[int32](8), 4),
+			expectedReplicas: 8,
+			expectedEvent:    true,
+		},
+		{
+			name:             "job without desired replicas is not scaled",
+			job:              newAutoscaledJob(4, 4, nil, 4),
+			expectedReplicas: 4,
+		},
+		{
+			name: "completed job is not scaled",
+			job: func() *batchv1alpha1.Job {
+				job := newAutoscaledJob(4, 4, ptr.To[int32](8), 4)
+				job.Status.State.Phase = batchv1alpha1.Completed
+				return job
+			}(),
+			expectedReplicas: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vcClient := volcanoclient.NewSimpleClientset(tc.job)
+			factory := informerfactory.NewSharedInformerFactory(vcClient, 0)
+			controller := &jobscalercontroller{}
+			require.NoError(t, controller.Initialize(&framework.ControllerOption{
+				KubeClient:              kubeclient.NewSimpleClientset(),
+				VolcanoClient:           vcClient,
+				VCSharedInformerFactory: factory,
+			}))
+			recorder := record.NewFakeRecorder(10)
+			controller.recorder = recorder
+			require.NoError(t, factory.Batch().V1alpha1().Jobs().Informer().GetIndexer().Add(tc.job))
+
+			require.NoError(t, controller.sync("default/job1"))
+
+			job, err := vcClient.BatchV1alpha1().Jobs("default").Get(context.TODO(), "job1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReplicas, job.Spec.Tasks[1].Replicas)
+			assert.Equal(t, tc.expectedEvent, len(recorder.Events) > 0)
+		})
+	}
+}
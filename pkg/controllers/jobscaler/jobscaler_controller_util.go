@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobscaler
+
+import (
+	"fmt"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
+)
+
+// scalable returns whether the job is autoscaled and is not finishing.
+func scalable(job *batchv1alpha1.Job) bool {
+	if job.Spec.Autoscaling == nil || job.Spec.Autoscaling.Replicas == nil {
+		return false
+	}
+	switch job.Status.State.Phase {
+	case "", batchv1alpha1.Pending, batchv1alpha1.Running:
+		return true
+	default:
+		return false
+	}
+}
+
+// scaleJob scales the autoscaled task of the job towards the desired replicas by whole waves, keeping at least
+// the desired replicas. The minAvailable of the task and the job change with the replicas, so the replicas of
+// a wave are admitted through the queue and started as a gang. It returns the replicas of the task before and
+// after scaling.
+func scaleJob(job *batchv1alpha1.Job) (int32, int32, error) {
+	index := jobhelpers.GetAutoscaledTaskIndex(job)
+	if index < 0 {
+		return 0, 0, fmt.Errorf("autoscaled task %q not found", job.Spec.Autoscaling.TaskName)
+	}
+	task := &job.Spec.Tasks[index]
+	if task.PartitionPolicy != nil {
+		return 0, 0, fmt.Errorf("task %s with partitionPolicy can not be autoscaled", task.Name)
+	}
+
+	wave := job.Spec.Autoscaling.WaveSize
+	if wave < 1 {
+		wave = 1
+	}
+	current, desired := task.Replicas, *job.Spec.Autoscaling.Replicas
+	var delta int32
+	if desired > current {
+		delta = (desired - current + wave - 1) / wave * wave
+	} else {
+		delta = -((current - desired) / wave * wave)
+	}
+	if delta == 0 {
+		return current, current, nil
+	}
+
+	taskMinAvailable := task.Replicas
+	if task.MinAvailable != nil {
+		taskMinAvailable = *task.MinAvailable
+	}
+	task.Replicas = current + delta
+	taskMinAvailable = clamp(taskMinAvailable+delta, 0, task.Replicas)
+	task.MinAvailable = &taskMinAvailable
+
+	var totalReplicas int32
+	for _, t := range job.Spec.Tasks {
+		totalReplicas += t.Replicas
+	}
+	job.Spec.MinAvailable = clamp(job.Spec.MinAvailable+delta, 0, totalReplicas)
+	return current, task.Replicas, nil
+}
+
+func clamp(value, low, high int32) int32 {
+	if value < low {
+		return low
+	}
+	if value > high {
+		return high
+	}
+	return value
+}
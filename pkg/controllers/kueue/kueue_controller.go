@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kueue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+const (
+	controllerName = "kueue-controller"
+
+	// admissionCheckSyncPeriod is the period the AdmissionChecks managed by the controller are activated at.
+	admissionCheckSyncPeriod = time.Minute
+
+	defaultAdmissionCheckControllerName = "volcano.sh/kueue-bridge"
+)
+
+func init() {
+	framework.RegisterController(&kueuecontroller{})
+}
+
+// kueuecontroller bridges Kueue and Volcano: the Workloads which got quota reserved by a Kueue ClusterQueue get a
+// PodGroup gang scheduling their pods in the queue of the same name, whose capability follows the nominal quota of
+// the ClusterQueue, and the admission checks of the Workloads managed by the controller are ready once the PodGroup
+// is admitted by its queue.
+type kueuecontroller struct {
+	vcClient      vcclientset.Interface
+	dynamicClient dynamic.Interface
+
+	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	vcInformerFactory      vcinformer.SharedInformerFactory
+	workloadLister         cache.GenericLister
+	workloadSynced         func() bool
+	pgLister               schedulinglister.PodGroupLister
+	pgSynced               func() bool
+	queueLister            schedulinglister.QueueLister
+	queueSynced            func() bool
+
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	// admissionCheckControllerName is the controller name of the AdmissionChecks of Kueue managed by the controller.
+	admissionCheckControllerName string
+}
+
+func (kc *kueuecontroller) Name() string {
+	return controllerName
+}
+
+// AddFlags implements framework.FlagProvider.
+func (kc *kueuecontroller) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&kc.admissionCheckControllerName, "kueue-admission-check-controller-name", defaultAdmissionCheckControllerName,
+		"The controller name of the Kueue AdmissionChecks which the kueue-controller sets ready once the PodGroup of the "+
+			"Workload is admitted by its queue")
+}
+
+func (kc *kueuecontroller) Initialize(opt *framework.ControllerOption) error {
+	kc.vcClient = opt.VolcanoClient
+	if kc.dynamicClient == nil {
+		dynamicClient, err := dynamic.NewForConfig(opt.Config)
+		if err != nil {
+			return fmt.Errorf("failed to create dynamic client: %v", err)
+		}
+		kc.dynamicClient = dynamicClient
+	}
+	if kc.admissionCheckControllerName == "" {
+		kc.admissionCheckControllerName = defaultAdmissionCheckControllerName
+	}
+	kc.vcInformerFactory = opt.VCSharedInformerFactory
+	kc.dynamicInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(kc.dynamicClient, 0)
+	kc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	workloadInformer := kc.dynamicInformerFactory.ForResource(workloadGVR)
+	workloadInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: kc.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			kc.enqueue(newObj)
+		},
+	})
+	kc.workloadLister = workloadInformer.Lister()
+	kc.workloadSynced = workloadInformer.Informer().HasSynced
+
+	pgInformer := kc.vcInformerFactory.Scheduling().V1beta1().PodGroups()
+	pgInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			kc.updatePodGroup(newObj)
+		},
+	})
+	kc.pgLister = pgInformer.Lister()
+	kc.pgSynced = pgInformer.Informer().HasSynced
+
+	queueInformer := kc.vcInformerFactory.Scheduling().V1beta1().Queues()
+	kc.queueLister = queueInformer.Lister()
+	kc.queueSynced = queueInformer.Informer().HasSynced
+	return nil
+}
+
+// Run starts the KueueController.
+func (kc *kueuecontroller) Run(stopCh <-chan struct{}) {
+	defer kc.queue.ShutDown()
+
+	kc.dynamicInformerFactory.Start(stopCh)
+	kc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, kc.workloadSynced, kc.pgSynced, kc.queueSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(kc.activateAdmissionChecks, admissionCheckSyncPeriod, stopCh)
+	go wait.Until(kc.worker, 0, stopCh)
+	klog.Infof("KueueController is running ...... ")
+	<-stopCh
+}
+
+func (kc *kueuecontroller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		klog.Errorf("Failed to get key of Workload: %v", err)
+		return
+	}
+	kc.queue.Add(key)
+}
+
+// updatePodGroup enqueues the Workload of a PodGroup, whose admission checks follow the phase of the PodGroup.
+func (kc *kueuecontroller) updatePodGroup(obj interface{}) {
+	pg, ok := obj.(*scheduling.PodGroup)
+	if !ok {
+		klog.Errorf("obj is not PodGroup")
+		return
+	}
+	if workload, found := pg.Labels[workloadLabelKey]; found {
+		kc.queue.Add(pg.Namespace + "/" + workload)
+	}
+}
+
+func (kc *kueuecontroller) worker() {
+	for kc.processNextReq() {
+	}
+}
+
+func (kc *kueuecontroller) processNextReq() bool {
+	key, shutdown := kc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer kc.queue.Done(key)
+
+	if err := kc.sync(key); err != nil {
+		klog.V(2).Infof("Failed to sync Workload <%s>: %v", key, err)
+		kc.queue.AddRateLimited(key)
+		return true
+	}
+	kc.queue.Forget(key)
+	return true
+}
+
+// sync keeps the PodGroup of the Workload while its quota is reserved by a ClusterQueue, and sets its admission
+// checks ready once the PodGroup is admitted.
+func (kc *kueuecontroller) sync(key string) error {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	obj, err := kc.workloadLister.ByNamespace(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		// The PodGroup is garbage collected with the job owning the Workload.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	workload, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("obj of Workload <%s> is not unstructured", key)
+	}
+	if workload.GetDeletionTimestamp() != nil || hasCondition(workload, finishedCondition) {
+		return nil
+	}
+	// The pods of the job get the PodGroup named after the job owning them and the Workload.
+	owner := metav1.GetControllerOf(workload)
+	if owner == nil {
+		klog.V(4).Infof("Workload <%s> has no owner, skip it", key)
+		return nil
+	}
+	pgName := vcbatch.PodgroupNamePrefix + string(owner.UID)
+	pg, err := kc.pgLister.PodGroups(ns).Get(pgName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if pg != nil && pg.Labels[workloadLabelKey] != name {
+		klog.V(4).Infof("PodGroup <%s/%s> is not created for Workload <%s>, skip it", ns, pgName, key)
+		return nil
+	}
+
+	clusterQueue, _, _ := unstructured.NestedString(workload.Object, "status", "admission", "clusterQueue")
+	if clusterQueue == "" {
+		// The jobs of the Workloads whose quota reservation was withdrawn are suspended, their PodGroup is to be
+		// admitted again once they get quota.
+		if pg != nil {
+			err := kc.vcClient.SchedulingV1beta1().PodGroups(ns).Delete(context.TODO(), pgName, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := kc.syncQueue(clusterQueue); err != nil {
+		return err
+	}
+	newPodGroup, err := buildPodGroup(workload, pgName, clusterQueue)
+	if err != nil {
+		return err
+	}
+	if pg == nil {
+		_, err := kc.vcClient.SchedulingV1beta1().PodGroups(ns).Create(context.TODO(), newPodGroup, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	}
+	if pg.Status.Phase == scheduling.PodGroupPending {
+		if !reflect.DeepEqual(pg.Spec, newPodGroup.Spec) {
+			pg = pg.DeepCopy()
+			pg.Spec = newPodGroup.Spec
+			_, err := kc.vcClient.SchedulingV1beta1().PodGroups(ns).Update(context.TODO(), pg, metav1.UpdateOptions{})
+			return err
+		}
+		return nil
+	}
+	return kc.setAdmissionChecksReady(workload, pg)
+}
+
+// syncQueue creates the queue of the ClusterQueue if it does not exist, and updates the capability of the queues it
+// created to the nominal quota of the ClusterQueue.
+func (kc *kueuecontroller) syncQueue(name string) error {
+	queue, err := kc.queueLister.Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if queue != nil && queue.Labels[clusterQueueLabelKey] != name {
+		return nil
+	}
+	clusterQueue, err := kc.dynamicClient.Resource(clusterQueueGVR).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get ClusterQueue <%s>: %v", name, err)
+	}
+	capability := nominalQuota(clusterQueue)
+
+	if queue == nil {
+		queue = &scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{clusterQueueLabelKey: name},
+			},
+			Spec: scheduling.QueueSpec{
+				Weight:     1,
+				Capability: capability,
+			},
+		}
+		_, err := kc.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), queue, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	}
+	if quotav1.Equals(queue.Spec.Capability, capability) {
+		return nil
+	}
+	queue = queue.DeepCopy()
+	queue.Spec.Capability = capability
+	_, err = kc.vcClient.SchedulingV1beta1().Queues().Update(context.TODO(), queue, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kueue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newAdmissionCheck(name, controllerName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kueue.x-k8s.io/v1beta1",
+		"kind":       "AdmissionCheck",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec":       map[string]interface{}{"controllerName": controllerName},
+	}}
+}
+
+func newClusterQueue(name string, nominalQuotas ...string) *unstructured.Unstructured {
+	var flavors []interface{}
+	for _, quota := range nominalQuotas {
+		flavors = append(flavors, map[string]interface{}{
+			"name":      "flavor-" + quota,
+			"resources": []interface{}{map[string]interface{}{"name": "cpu", "nominalQuota": quota}},
+		})
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kueue.x-k8s.io/v1beta1",
+		"kind":       "ClusterQueue",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec": map[string]interface{}{
+			"resourceGroups": []interface{}{map[string]interface{}{"coveredResources": []interface{}{"cpu"}, "flavors": flavors}},
+		},
+	}}
+}
+
+func newWorkload() *unstructured.Unstructured {
+	template, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "main",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+				},
+			}},
+		},
+	})
+	workload := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kueue.x-k8s.io/v1beta1",
+		"kind":       "Workload",
+		"metadata":   map[string]interface{}{"name": "job-wl", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"queueName":           "user-queue",
+			"priorityClassName":   "high",
+			"priorityClassSource": priorityClassSource,
+			"podSets": []interface{}{
+				map[string]interface{}{"name": "main", "count": int64(4), "minCount": int64(3), "template": template},
+			},
+		},
+		"status": map[string]interface{}{
+			"admission": map[string]interface{}{"clusterQueue": "cq"},
+			"admissionChecks": []interface{}{
+				map[string]interface{}{"name": "volcano", "state": "Pending"},
+				map[string]interface{}{"name": "other", "state": "Pending"},
+			},
+		},
+	}}
+	workload.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: "batch/v1", Kind: "Job", Name: "job", UID: "job-uid", Controller: ptr.To(true),
+	}})
+	return workload
+}
+
+func newFakeController(objects ...runtime.Object) *kueuecontroller {
+	kubeClient := kubeclient.NewSimpleClientset()
+	vcClient := vcclient.NewSimpleClientset()
+	controller := &kueuecontroller{
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				workloadGVR:       "WorkloadList",
+				clusterQueueGVR:   "ClusterQueueList",
+				admissionCheckGVR: "AdmissionCheckList",
+			}, objects...),
+	}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: informerfactory.NewSharedInformerFactory(vcClient, 0),
+	}
+	controller.Initialize(opt)
+	return controller
+}
+
+func TestSyncWorkload(t *testing.T) {
+	ctx := context.TODO()
+	kc := newFakeController(newAdmissionCheck("volcano", defaultAdmissionCheckControllerName),
+		newAdmissionCheck("other", "kueue.x-k8s.io/provisioning-request"), newClusterQueue("cq", "10", "6"), newWorkload())
+	setWorkload := func(workload *unstructured.Unstructured) {
+		require.NoError(t, kc.dynamicInformerFactory.ForResource(workloadGVR).Informer().GetIndexer().Update(workload))
+	}
+	latestWorkload := func() *unstructured.Unstructured {
+		workload, err := kc.dynamicClient.Resource(workloadGVR).Namespace("default").Get(ctx, "job-wl", metav1.GetOptions{})
+		require.NoError(t, err)
+		setWorkload(workload)
+		return workload
+	}
+	latestPodGroup := func() *scheduling.PodGroup {
+		pg, err := kc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "podgroup-job-uid", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, kc.vcInformerFactory.Scheduling().V1beta1().PodGroups().Informer().GetIndexer().Update(pg))
+		return pg
+	}
+	latestQueue := func() *scheduling.Queue {
+		queue, err := kc.vcClient.SchedulingV1beta1().Queues().Get(ctx, "cq", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, kc.vcInformerFactory.Scheduling().V1beta1().Queues().Informer().GetIndexer().Update(queue))
+		return queue
+	}
+	checkStates := func() map[string]interface{} {
+		checks, _, _ := unstructured.NestedSlice(latestWorkload().Object, "status", "admissionChecks")
+		states := map[string]interface{}{}
+		for _, c := range checks {
+			states[c.(map[string]interface{})["name"].(string)] = c.(map[string]interface{})["state"]
+		}
+		return states
+	}
+	latestWorkload()
+
+	// The Workload with reserved quota gets a PodGroup in the queue of its ClusterQueue.
+	require.NoError(t, kc.sync("default/job-wl"))
+	queue := latestQueue()
+	assert.Equal(t, "cq", queue.Labels[clusterQueueLabelKey])
+	assert.Equal(t, "16", queue.Spec.Capability.Cpu().String())
+	pg := latestPodGroup()
+	assert.Equal(t, "cq", pg.Spec.Queue)
+	assert.Equal(t, int32(3), pg.Spec.MinMember)
+	assert.Equal(t, "3", pg.Spec.MinResources.Cpu().String())
+	assert.Equal(t, "high", pg.Spec.PriorityClassName)
+	assert.Equal(t, "job", pg.OwnerReferences[0].Name)
+	assert.Equal(t, "job-wl", pg.Labels[workloadLabelKey])
+
+	// The admission checks wait for the PodGroup to be admitted.
+	require.NoError(t, kc.sync("default/job-wl"))
+	assert.Equal(t, map[string]interface{}{"volcano": "Pending", "other": "Pending"}, checkStates())
+	pg.Status.Phase = scheduling.PodGroupInqueue
+	_, err := kc.vcClient.SchedulingV1beta1().PodGroups("default").Update(ctx, pg, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	latestPodGroup()
+	require.NoError(t, kc.sync("default/job-wl"))
+	assert.Equal(t, map[string]interface{}{"volcano": "Ready", "other": "Pending"}, checkStates())
+
+	// The capability of the queue follows the nominal quota of the ClusterQueue.
+	_, err = kc.dynamicClient.Resource(clusterQueueGVR).Update(ctx, newClusterQueue("cq", "20"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, kc.sync("default/job-wl"))
+	assert.Equal(t, "20", latestQueue().Spec.Capability.Cpu().String())
+
+	// The PodGroup of the Workload whose quota reservation was withdrawn is deleted.
+	workload := latestWorkload()
+	unstructured.RemoveNestedField(workload.Object, "status", "admission")
+	setWorkload(workload)
+	require.NoError(t, kc.sync("default/job-wl"))
+	_, err = kc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "podgroup-job-uid", metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestSyncQueueNotCreatedByController(t *testing.T) {
+	kc := newFakeController(newClusterQueue("cq", "10"))
+	queue := &scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "cq"},
+		Spec:       scheduling.QueueSpec{Weight: 1, Capability: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")}},
+	}
+	require.NoError(t, kc.vcInformerFactory.Scheduling().V1beta1().Queues().Informer().GetIndexer().Add(queue))
+
+	require.NoError(t, kc.syncQueue("cq"))
+	queues, err := kc.vcClient.SchedulingV1beta1().Queues().List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, queues.Items)
+}
+
+func TestActivateAdmissionChecks(t *testing.T) {
+	kc := newFakeController(newAdmissionCheck("volcano", defaultAdmissionCheckControllerName),
+		newAdmissionCheck("other", "kueue.x-k8s.io/provisioning-request"))
+
+	kc.activateAdmissionChecks()
+	for name, active := range map[string]bool{"volcano": true, "other": false} {
+		check, err := kc.dynamicClient.Resource(admissionCheckGVR).Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, active, hasCondition(check, activeCondition), name)
+	}
+}
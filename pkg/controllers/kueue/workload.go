@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kueue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/klog/v2"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	// workloadLabelKey is the label of the PodGroups naming the Kueue Workload they are created for.
+	workloadLabelKey = "volcano.sh/kueue-workload"
+	// clusterQueueLabelKey is the label of the queues created for a Kueue ClusterQueue, whose capability follows the
+	// nominal quota of the ClusterQueue.
+	clusterQueueLabelKey = "volcano.sh/kueue-clusterqueue"
+
+	// priorityClassSource is the source of the priority of the Workloads whose priority class is a PriorityClass,
+	// rather than a WorkloadPriorityClass of Kueue.
+	priorityClassSource = "scheduling.k8s.io/priorityclass"
+
+	admissionCheckReady = "Ready"
+	finishedCondition   = "Finished"
+	activeCondition     = "Active"
+)
+
+var (
+	workloadGVR       = schema.GroupVersionResource{Group: "kueue.x-k8s.io", Version: "v1beta1", Resource: "workloads"}
+	clusterQueueGVR   = schema.GroupVersionResource{Group: "kueue.x-k8s.io", Version: "v1beta1", Resource: "clusterqueues"}
+	admissionCheckGVR = schema.GroupVersionResource{Group: "kueue.x-k8s.io", Version: "v1beta1", Resource: "admissionchecks"}
+)
+
+// buildPodGroup builds the PodGroup of the Workload, in the queue of its ClusterQueue, gang scheduling the minimum
+// count of all its pod sets.
+func buildPodGroup(workload *unstructured.Unstructured, pgName, clusterQueue string) (*scheduling.PodGroup, error) {
+	podSets, _, _ := unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	minMember := int32(0)
+	minResources := v1.ResourceList{}
+	for _, ps := range podSets {
+		podSet, ok := ps.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		count, _, _ := unstructured.NestedInt64(podSet, "count")
+		if minCount, found, _ := unstructured.NestedInt64(podSet, "minCount"); found {
+			count = minCount
+		}
+		templateObj, _, _ := unstructured.NestedMap(podSet, "template")
+		template := &v1.PodTemplateSpec{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateObj, template); err != nil {
+			return nil, fmt.Errorf("failed to convert the template of pod set of Workload <%s/%s>: %v",
+				workload.GetNamespace(), workload.GetName(), err)
+		}
+		minMember += int32(count)
+		minResources = quotav1.Add(minResources, util.CalTaskRequests(&v1.Pod{Spec: template.Spec}, int32(count)))
+	}
+
+	pg := &scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       workload.GetNamespace(),
+			Name:            pgName,
+			OwnerReferences: workload.GetOwnerReferences(),
+			Labels:          map[string]string{workloadLabelKey: workload.GetName()},
+		},
+		Spec: scheduling.PodGroupSpec{
+			MinMember:    minMember,
+			Queue:        clusterQueue,
+			MinResources: &minResources,
+		},
+		Status: scheduling.PodGroupStatus{
+			Phase: scheduling.PodGroupPending,
+		},
+	}
+	if source, _, _ := unstructured.NestedString(workload.Object, "spec", "priorityClassSource"); source == priorityClassSource {
+		pg.Spec.PriorityClassName, _, _ = unstructured.NestedString(workload.Object, "spec", "priorityClassName")
+	}
+	return pg, nil
+}
+
+// nominalQuota sums the nominal quota of every resource of the ClusterQueue over its flavors.
+func nominalQuota(clusterQueue *unstructured.Unstructured) v1.ResourceList {
+	quota := v1.ResourceList{}
+	groups, _, _ := unstructured.NestedSlice(clusterQueue.Object, "spec", "resourceGroups")
+	for _, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		flavors, _, _ := unstructured.NestedSlice(group, "flavors")
+		for _, f := range flavors {
+			flavor, ok := f.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			resources, _, _ := unstructured.NestedSlice(flavor, "resources")
+			for _, r := range resources {
+				res, ok := r.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _, _ := unstructured.NestedString(res, "name")
+				value, _, _ := unstructured.NestedString(res, "nominalQuota")
+				quantity, err := resource.ParseQuantity(value)
+				if name == "" || err != nil {
+					continue
+				}
+				sum := quota[v1.ResourceName(name)]
+				sum.Add(quantity)
+				quota[v1.ResourceName(name)] = sum
+			}
+		}
+	}
+	return quota
+}
+
+// hasCondition checks whether the object has a condition of the type with the True status.
+func hasCondition(obj *unstructured.Unstructured, condType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == condType && condition["status"] == string(metav1.ConditionTrue) {
+			return true
+		}
+	}
+	return false
+}
+
+// admissionChecks returns the names of the AdmissionChecks managed by the controller.
+func (kc *kueuecontroller) admissionChecks() (sets.Set[string], error) {
+	list, err := kc.dynamicClient.Resource(admissionCheckGVR).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := sets.New[string]()
+	for _, check := range list.Items {
+		if controllerName, _, _ := unstructured.NestedString(check.Object, "spec", "controllerName"); controllerName == kc.admissionCheckControllerName {
+			names.Insert(check.GetName())
+		}
+	}
+	return names, nil
+}
+
+// activateAdmissionChecks sets the Active condition of the AdmissionChecks managed by the controller, without which
+// Kueue does not admit the Workloads requiring them.
+func (kc *kueuecontroller) activateAdmissionChecks() {
+	list, err := kc.dynamicClient.Resource(admissionCheckGVR).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("Failed to list AdmissionChecks: %v", err)
+		return
+	}
+	for _, check := range list.Items {
+		if controllerName, _, _ := unstructured.NestedString(check.Object, "spec", "controllerName"); controllerName != kc.admissionCheckControllerName {
+			continue
+		}
+		if hasCondition(&check, activeCondition) {
+			continue
+		}
+		check := check.DeepCopy()
+		conditions, _, _ := unstructured.NestedSlice(check.Object, "status", "conditions")
+		var result []interface{}
+		for _, c := range conditions {
+			if condition, ok := c.(map[string]interface{}); ok && condition["type"] == activeCondition {
+				continue
+			}
+			result = append(result, c)
+		}
+		result = append(result, map[string]interface{}{
+			"type":               activeCondition,
+			"status":             string(metav1.ConditionTrue),
+			"reason":             activeCondition,
+			"message":            "the admission check is managed by the volcano kueue-controller",
+			"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+			"observedGeneration": check.GetGeneration(),
+		})
+		if err := unstructured.SetNestedSlice(check.Object, result, "status", "conditions"); err != nil {
+			klog.Errorf("Failed to set conditions of AdmissionCheck <%s>: %v", check.GetName(), err)
+			continue
+		}
+		if _, err := kc.dynamicClient.Resource(admissionCheckGVR).UpdateStatus(context.TODO(), check, metav1.UpdateOptions{}); err != nil {
+			klog.Errorf("Failed to activate AdmissionCheck <%s>: %v", check.GetName(), err)
+		}
+	}
+}
+
+// setAdmissionChecksReady marks the admission checks of the Workload managed by the controller ready, once its
+// PodGroup is admitted by its queue.
+func (kc *kueuecontroller) setAdmissionChecksReady(workload *unstructured.Unstructured, pg *scheduling.PodGroup) error {
+	names, err := kc.admissionChecks()
+	if err != nil {
+		return err
+	}
+	workload = workload.DeepCopy()
+	checks, _, _ := unstructured.NestedSlice(workload.Object, "status", "admissionChecks")
+	changed := false
+	for _, c := range checks {
+		check, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := check["name"].(string)
+		if !names.Has(name) || check["state"] == admissionCheckReady {
+			continue
+		}
+		check["state"] = admissionCheckReady
+		check["message"] = fmt.Sprintf("PodGroup %s was admitted by queue %s", pg.Name, pg.Spec.Queue)
+		check["lastTransitionTime"] = time.Now().UTC().Format(time.RFC3339)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if err := unstructured.SetNestedSlice(workload.Object, checks, "status", "admissionChecks"); err != nil {
+		return err
+	}
+	_, err = kc.dynamicClient.Resource(workloadGVR).Namespace(workload.GetNamespace()).UpdateStatus(context.TODO(), workload, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeclaim
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+const (
+	// podGroupLabelKey and podGroupNamespaceLabelKey are the labels of the NodeClaims naming the podgroup they are
+	// created for, until they are released.
+	podGroupLabelKey          = "volcano.sh/podgroup"
+	podGroupNamespaceLabelKey = "volcano.sh/podgroup-namespace"
+	// nodeClaimCountLabelKey is the label of the NodeClaims of a podgroup recording how many were created for it, to
+	// detect the NodeClaims Karpenter deleted after failing to launch them.
+	nodeClaimCountLabelKey = "volcano.sh/nodeclaim-count"
+
+	nodePoolLabelKey = "karpenter.sh/nodepool"
+	// instanceGPUCountLabel and instanceFamilyLabel are the well-known labels of the instance types of the AWS provider
+	// of Karpenter.
+	instanceGPUCountLabel = "karpenter.k8s.aws/instance-gpu-count"
+	instanceFamilyLabel   = "karpenter.k8s.aws/instance-family"
+
+	gpuResourceName = v1.ResourceName("nvidia.com/gpu")
+)
+
+var (
+	nodeClaimGVR = schema.GroupVersionResource{Group: "karpenter.sh", Version: "v1", Resource: "nodeclaims"}
+	nodePoolGVR  = schema.GroupVersionResource{Group: "karpenter.sh", Version: "v1", Resource: "nodepools"}
+)
+
+// gangTopologyKeys are the node labels the nodes of a gang share: when its pending pods do not constrain them, its
+// NodeClaims follow the nodes its running pods were scheduled to.
+var gangTopologyKeys = []string{v1.LabelTopologyZone, instanceFamilyLabel}
+
+// states of the NodeClaims of a podgroup, by their conditions
+const (
+	nodeClaimsPending = iota
+	nodeClaimsInitialized
+	nodeClaimsFailed
+)
+
+// nodeClaim is a node requested for the pending pods of a gang packed on it.
+type nodeClaim struct {
+	// requirements are the values of the node labels the pods require.
+	requirements map[string]sets.Set[string]
+	requests     v1.ResourceList
+	gpus         int64
+	pods         int
+}
+
+// buildNodeClaims packs the pending pods of the podgroup on as few nodes as the limits of the GPUs and CPUs of a node
+// allow, the pods requiring different node labels being packed apart.
+func (nc *nodeclaimcontroller) buildNodeClaims(pg *scheduling.PodGroup) ([]*nodeClaim, error) {
+	pods, err := nc.podLister.Pods(pg.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*v1.Pod
+	bound := map[string]sets.Set[string]{}
+	for _, pod := range pods {
+		if pod.Annotations[scheduling.KubeGroupNameAnnotationKey] != pg.Name || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Spec.NodeName != "" {
+			node, err := nc.nodeLister.Get(pod.Spec.NodeName)
+			if err != nil {
+				klog.V(4).Infof("Failed to get node <%s> of pod <%s/%s>: %v", pod.Spec.NodeName, pod.Namespace, pod.Name, err)
+				continue
+			}
+			for _, key := range gangTopologyKeys {
+				if value, found := node.Labels[key]; found {
+					if bound[key] == nil {
+						bound[key] = sets.New[string]()
+					}
+					bound[key].Insert(value)
+				}
+			}
+			continue
+		}
+		if pod.Status.Phase == v1.PodPending {
+			pending = append(pending, pod)
+		}
+	}
+
+	groups := map[string][]*v1.Pod{}
+	requirements := map[string]map[string]sets.Set[string]{}
+	for _, pod := range pending {
+		reqs := podRequirements(pod)
+		for _, key := range gangTopologyKeys {
+			if _, found := reqs[key]; !found && bound[key].Len() == 1 {
+				reqs[key] = bound[key]
+			}
+		}
+		key := requirementsKey(reqs)
+		groups[key] = append(groups[key], pod)
+		requirements[key] = reqs
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var claims []*nodeClaim
+	for _, key := range keys {
+		claims = append(claims, nc.pack(requirements[key], groups[key])...)
+	}
+	return claims, nil
+}
+
+// pack places the pods, the largest first, on the first NodeClaim with room for them, a pod exceeding the limits of
+// a node on its own getting a NodeClaim of its own.
+func (nc *nodeclaimcontroller) pack(reqs map[string]sets.Set[string], pods []*v1.Pod) []*nodeClaim {
+	type podRequests struct {
+		requests v1.ResourceList
+		gpus     int64
+		milliCPU int64
+	}
+	requests := map[*v1.Pod]podRequests{}
+	for _, pod := range pods {
+		list := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+		gpus := list[gpuResourceName]
+		requests[pod] = podRequests{requests: list, gpus: gpus.Value(), milliCPU: list.Cpu().MilliValue()}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		ri, rj := requests[pods[i]], requests[pods[j]]
+		if ri.gpus != rj.gpus {
+			return ri.gpus > rj.gpus
+		}
+		if ri.milliCPU != rj.milliCPU {
+			return ri.milliCPU > rj.milliCPU
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	maxMilliCPU := int64(nc.maxCPUsPerNode) * 1000
+	var claims []*nodeClaim
+	milliCPUs := map[*nodeClaim]int64{}
+	for _, pod := range pods {
+		req := requests[pod]
+		var target *nodeClaim
+		for _, claim := range claims {
+			if claim.gpus+req.gpus <= int64(nc.maxGPUsPerNode) && milliCPUs[claim]+req.milliCPU <= maxMilliCPU {
+				target = claim
+				break
+			}
+		}
+		if target == nil {
+			target = &nodeClaim{requirements: reqs, requests: v1.ResourceList{}}
+			claims = append(claims, target)
+		}
+		for name, quantity := range req.requests {
+			sum := target.requests[name]
+			sum.Add(quantity)
+			target.requests[name] = sum
+		}
+		target.gpus += req.gpus
+		milliCPUs[target] += req.milliCPU
+		target.pods++
+	}
+	return claims
+}
+
+// podRequirements returns the values of the node labels the pod requires by its node selector and the In
+// expressions of its required node affinity.
+func podRequirements(pod *v1.Pod) map[string]sets.Set[string] {
+	reqs := map[string]sets.Set[string]{}
+	for key, value := range pod.Spec.NodeSelector {
+		reqs[key] = sets.New(value)
+	}
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return reqs
+	}
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	// Alternative terms cannot be requested of a single node.
+	if len(terms) != 1 {
+		return reqs
+	}
+	for _, expr := range terms[0].MatchExpressions {
+		if expr.Operator != v1.NodeSelectorOpIn {
+			continue
+		}
+		values := sets.New(expr.Values...)
+		if current, found := reqs[expr.Key]; found {
+			values = current.Intersection(values)
+		}
+		reqs[expr.Key] = values
+	}
+	return reqs
+}
+
+func requirementsKey(reqs map[string]sets.Set[string]) string {
+	keys := make([]string, 0, len(reqs))
+	for key := range reqs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%s=%s;", key, strings.Join(sets.List(reqs[key]), ","))
+	}
+	return b.String()
+}
+
+// createNodeClaims creates the NodeClaims from the template of the NodePool, with the requirements and the requests
+// of the pods packed on them. The NodeClaims already created are deleted if one cannot be created.
+func (nc *nodeclaimcontroller) createNodeClaims(pg *scheduling.PodGroup, claims []*nodeClaim) error {
+	pool, err := nc.dynamicClient.Resource(nodePoolGVR).Get(context.TODO(), nc.nodePool, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get NodePool <%s>: %v", nc.nodePool, err)
+	}
+	templateLabels, _, _ := unstructured.NestedStringMap(pool.Object, "spec", "template", "metadata", "labels")
+	templateSpec, _, _ := unstructured.NestedMap(pool.Object, "spec", "template", "spec")
+	poolRequirements, _, _ := unstructured.NestedSlice(templateSpec, "requirements")
+
+	var created []unstructured.Unstructured
+	for _, claim := range claims {
+		spec := runtime.DeepCopyJSON(templateSpec)
+		if spec == nil {
+			spec = map[string]interface{}{}
+		}
+
+		var requirements []interface{}
+		keys := make([]string, 0, len(claim.requirements))
+		for key := range claim.requirements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			var values []interface{}
+			for _, value := range sets.List(claim.requirements[key]) {
+				values = append(values, value)
+			}
+			requirements = append(requirements, map[string]interface{}{
+				"key": key, "operator": string(v1.NodeSelectorOpIn), "values": values,
+			})
+		}
+		if claim.gpus > 0 {
+			requirements = append(requirements, map[string]interface{}{
+				"key": instanceGPUCountLabel, "operator": string(v1.NodeSelectorOpGt),
+				"values": []interface{}{strconv.FormatInt(claim.gpus-1, 10)},
+			})
+		}
+		// The requirements of the NodePool on the other labels keep the NodeClaims within the pool.
+		for _, r := range poolRequirements {
+			requirement, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key, _ := requirement["key"].(string)
+			if _, found := claim.requirements[key]; found || (claim.gpus > 0 && key == instanceGPUCountLabel) {
+				continue
+			}
+			requirements = append(requirements, runtime.DeepCopyJSONValue(requirement))
+		}
+		spec["requirements"] = requirements
+
+		requests := map[string]interface{}{}
+		for name, quantity := range claim.requests {
+			requests[string(name)] = quantity.String()
+		}
+		spec["resources"] = map[string]interface{}{"requests": requests}
+
+		claimLabels := map[string]string{}
+		for key, value := range templateLabels {
+			claimLabels[key] = value
+		}
+		claimLabels[nodePoolLabelKey] = pool.GetName()
+		claimLabels[podGroupLabelKey] = pg.Name
+		claimLabels[podGroupNamespaceLabelKey] = pg.Namespace
+		claimLabels[nodeClaimCountLabelKey] = strconv.Itoa(len(claims))
+
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+		obj.SetAPIVersion(nodeClaimGVR.GroupVersion().String())
+		obj.SetKind("NodeClaim")
+		obj.SetName(fmt.Sprintf("%s-%s", pool.GetName(), utilrand.String(5)))
+		obj.SetLabels(claimLabels)
+		obj.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion:         nodePoolGVR.GroupVersion().String(),
+			Kind:               "NodePool",
+			Name:               pool.GetName(),
+			UID:                pool.GetUID(),
+			BlockOwnerDeletion: ptr.To(true),
+		}})
+		result, err := nc.dynamicClient.Resource(nodeClaimGVR).Create(context.TODO(), obj, metav1.CreateOptions{})
+		if err != nil {
+			if deleteErr := nc.deleteNodeClaims(created); deleteErr != nil {
+				klog.Errorf("Failed to delete NodeClaims of PodGroup <%s/%s>: %v", pg.Namespace, pg.Name, deleteErr)
+			}
+			return fmt.Errorf("failed to create NodeClaim: %v", err)
+		}
+		created = append(created, *result)
+	}
+	return nil
+}
+
+// listNodeClaims returns the NodeClaims created for the podgroup which are not released.
+func (nc *nodeclaimcontroller) listNodeClaims(ns, name string) ([]unstructured.Unstructured, error) {
+	selector := labels.SelectorFromSet(labels.Set{podGroupLabelKey: name, podGroupNamespaceLabelKey: ns}).String()
+	list, err := nc.dynamicClient.Resource(nodeClaimGVR).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (nc *nodeclaimcontroller) deleteNodeClaims(claims []unstructured.Unstructured) error {
+	for _, claim := range claims {
+		err := nc.dynamicClient.Resource(nodeClaimGVR).Delete(context.TODO(), claim.GetName(), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseNodeClaims removes the labels of the podgroup from its NodeClaims, their nodes are then managed by Karpenter
+// like any other node of the NodePool.
+func (nc *nodeclaimcontroller) releaseNodeClaims(claims []unstructured.Unstructured) error {
+	patch := []byte(fmt.Sprintf(`{"metadata":{"labels":{%q:null,%q:null,%q:null}}}`,
+		podGroupLabelKey, podGroupNamespaceLabelKey, nodeClaimCountLabelKey))
+	for _, claim := range claims {
+		_, err := nc.dynamicClient.Resource(nodeClaimGVR).Patch(context.TODO(), claim.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeClaimsState returns the state of the NodeClaims of a podgroup by their conditions, and the message of the
+// failure. The NodeClaims fail together if one of them is deleted, as Karpenter does when it cannot launch it, or
+// fails to launch.
+func nodeClaimsState(claims []unstructured.Unstructured) (int, string) {
+	expected, _ := strconv.Atoi(claims[0].GetLabels()[nodeClaimCountLabelKey])
+	if len(claims) < expected {
+		return nodeClaimsFailed, fmt.Sprintf("%d of %d NodeClaims were deleted before their nodes were initialized",
+			expected-len(claims), expected)
+	}
+	initialized := 0
+	for _, claim := range claims {
+		if claim.GetDeletionTimestamp() != nil {
+			return nodeClaimsFailed, fmt.Sprintf("NodeClaim %s is being deleted", claim.GetName())
+		}
+		conditions, _, _ := unstructured.NestedSlice(claim.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			condType, _, _ := unstructured.NestedString(condition, "type")
+			status, _, _ := unstructured.NestedString(condition, "status")
+			switch {
+			case condType == "Launched" && status == string(metav1.ConditionFalse):
+				message, _, _ := unstructured.NestedString(condition, "message")
+				return nodeClaimsFailed, fmt.Sprintf("NodeClaim %s failed to launch: %s", claim.GetName(), message)
+			case condType == "Initialized" && status == string(metav1.ConditionTrue):
+				initialized++
+			}
+		}
+	}
+	if initialized == len(claims) {
+		return nodeClaimsInitialized, ""
+	}
+	return nodeClaimsPending, ""
+}
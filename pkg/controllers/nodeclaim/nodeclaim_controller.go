@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeclaim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcscheme "volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	controllerName = "nodeclaim-controller"
+
+	// nodeClaimCheckPeriod is the period the podgroups waiting for their NodeClaims are checked at.
+	nodeClaimCheckPeriod = 30 * time.Second
+	// nodeClaimRetryPeriod is the time after which the nodes of a podgroup are claimed again once their NodeClaims
+	// failed, if the podgroup is still unschedulable.
+	nodeClaimRetryPeriod = 5 * time.Minute
+
+	defaultNodePool       = "default"
+	defaultMaxGPUsPerNode = 8
+	defaultMaxCPUsPerNode = 64
+
+	// NodeClaimsRequestedReason is the reason of the condition when the NodeClaims of the podgroup are created.
+	NodeClaimsRequestedReason = "NodeClaimsRequested"
+	// NodeClaimsInitializedReason is the reason of the condition when the nodes of all the NodeClaims of the podgroup
+	// are initialized, until the podgroup is scheduled on them.
+	NodeClaimsInitializedReason = "NodeClaimsInitialized"
+	// NodeClaimsFailedReason is the reason of the condition when a NodeClaim of the podgroup failed, and all of them
+	// were deleted.
+	NodeClaimsFailedReason = "NodeClaimsFailed"
+	// ScheduledReason is the reason of the condition when the podgroup is no longer unschedulable.
+	ScheduledReason = "Scheduled"
+)
+
+func init() {
+	framework.RegisterController(&nodeclaimcontroller{})
+}
+
+// nodeclaimcontroller claims the nodes of the gangs which were admitted, but could not be scheduled for lack of
+// capacity, from Karpenter by NodeClaims consolidating their pending pods, so that the nodes of the whole gang are
+// launched instead of a node per pod, and keeps their podgroup in the WaitingForProvisioning condition until the
+// nodes are initialized.
+type nodeclaimcontroller struct {
+	kubeClient    kubernetes.Interface
+	vcClient      vcclientset.Interface
+	dynamicClient dynamic.Interface
+
+	informerFactory   informers.SharedInformerFactory
+	vcInformerFactory vcinformer.SharedInformerFactory
+	podLister         corelisters.PodLister
+	podSynced         func() bool
+	nodeLister        corelisters.NodeLister
+	nodeSynced        func() bool
+	pgLister          schedulinglister.PodGroupLister
+	pgSynced          func() bool
+
+	queue    workqueue.TypedRateLimitingInterface[string]
+	recorder record.EventRecorder
+
+	// nodePool is the Karpenter NodePool whose template the NodeClaims are created from.
+	nodePool string
+	// maxGPUsPerNode and maxCPUsPerNode limit the pods packed on a NodeClaim.
+	maxGPUsPerNode int
+	maxCPUsPerNode int
+}
+
+func (nc *nodeclaimcontroller) Name() string {
+	return controllerName
+}
+
+// AddFlags implements framework.FlagProvider.
+func (nc *nodeclaimcontroller) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&nc.nodePool, "nodeclaim-nodepool", defaultNodePool,
+		"The Karpenter NodePool the nodeclaim-controller creates the NodeClaims of the gangs which cannot be scheduled "+
+			"for lack of capacity from")
+	fs.IntVar(&nc.maxGPUsPerNode, "nodeclaim-max-gpus-per-node", defaultMaxGPUsPerNode,
+		"The maximum number of GPUs of the pods packed on a NodeClaim by the nodeclaim-controller")
+	fs.IntVar(&nc.maxCPUsPerNode, "nodeclaim-max-cpus-per-node", defaultMaxCPUsPerNode,
+		"The maximum number of CPUs of the pods packed on a NodeClaim by the nodeclaim-controller")
+}
+
+func (nc *nodeclaimcontroller) Initialize(opt *framework.ControllerOption) error {
+	nc.kubeClient = opt.KubeClient
+	nc.vcClient = opt.VolcanoClient
+	if nc.dynamicClient == nil {
+		dynamicClient, err := dynamic.NewForConfig(opt.Config)
+		if err != nil {
+			return fmt.Errorf("failed to create dynamic client: %v", err)
+		}
+		nc.dynamicClient = dynamicClient
+	}
+	if nc.nodePool == "" {
+		nc.nodePool = defaultNodePool
+	}
+	if nc.maxGPUsPerNode <= 0 {
+		nc.maxGPUsPerNode = defaultMaxGPUsPerNode
+	}
+	if nc.maxCPUsPerNode <= 0 {
+		nc.maxCPUsPerNode = defaultMaxCPUsPerNode
+	}
+	nc.informerFactory = opt.SharedInformerFactory
+	nc.vcInformerFactory = opt.VCSharedInformerFactory
+	nc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(klog.Infof)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: opt.KubeClient.CoreV1().Events("")})
+	nc.recorder = eventBroadcaster.NewRecorder(vcscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
+
+	podInformer := nc.informerFactory.Core().V1().Pods()
+	nc.podLister = podInformer.Lister()
+	nc.podSynced = podInformer.Informer().HasSynced
+
+	nodeInformer := nc.informerFactory.Core().V1().Nodes()
+	nc.nodeLister = nodeInformer.Lister()
+	nc.nodeSynced = nodeInformer.Informer().HasSynced
+
+	pgInformer := nc.vcInformerFactory.Scheduling().V1beta1().PodGroups()
+	pgInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: nc.addPodGroup,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			nc.addPodGroup(newObj)
+		},
+		DeleteFunc: nc.deletePodGroup,
+	})
+	nc.pgLister = pgInformer.Lister()
+	nc.pgSynced = pgInformer.Informer().HasSynced
+	return nil
+}
+
+// Run starts the NodeClaimController.
+func (nc *nodeclaimcontroller) Run(stopCh <-chan struct{}) {
+	defer nc.queue.ShutDown()
+
+	nc.informerFactory.Start(stopCh)
+	nc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, nc.podSynced, nc.nodeSynced, nc.pgSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(nc.worker, 0, stopCh)
+	klog.Infof("NodeClaimController is running ...... ")
+	<-stopCh
+}
+
+func (nc *nodeclaimcontroller) addPodGroup(obj interface{}) {
+	pg, ok := obj.(*scheduling.PodGroup)
+	if !ok {
+		klog.Errorf("obj is not PodGroup")
+		return
+	}
+	if !util.UnschedulableForCapacity(pg) && util.GetPodGroupCondition(pg, scheduling.PodGroupWaitingForProvisioningType) == nil {
+		return
+	}
+	nc.enqueue(pg)
+}
+
+func (nc *nodeclaimcontroller) deletePodGroup(obj interface{}) {
+	pg, ok := obj.(*scheduling.PodGroup)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Couldn't get object from tombstone %#v", obj)
+			return
+		}
+		pg, ok = tombstone.Obj.(*scheduling.PodGroup)
+		if !ok {
+			klog.Errorf("Tombstone contained object that is not a PodGroup: %#v", obj)
+			return
+		}
+	}
+	if util.GetPodGroupCondition(pg, scheduling.PodGroupWaitingForProvisioningType) == nil {
+		return
+	}
+	nc.enqueue(pg)
+}
+
+func (nc *nodeclaimcontroller) enqueue(pg *scheduling.PodGroup) {
+	key, err := cache.MetaNamespaceKeyFunc(pg)
+	if err != nil {
+		klog.Errorf("Failed to get key of PodGroup <%s/%s>: %v", pg.Namespace, pg.Name, err)
+		return
+	}
+	nc.queue.Add(key)
+}
+
+func (nc *nodeclaimcontroller) worker() {
+	for nc.processNextReq() {
+	}
+}
+
+func (nc *nodeclaimcontroller) processNextReq() bool {
+	key, shutdown := nc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer nc.queue.Done(key)
+
+	requeueAfter, err := nc.sync(key)
+	if err != nil {
+		klog.V(2).Infof("Failed to sync NodeClaims of PodGroup <%s>: %v", key, err)
+		nc.queue.AddRateLimited(key)
+		return true
+	}
+	nc.queue.Forget(key)
+	if requeueAfter > 0 {
+		nc.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// sync claims the nodes of the podgroup when it is unschedulable for lack of capacity, follows the NodeClaims, and
+// releases them once the podgroup is scheduled. It returns when the podgroup is to be checked again.
+func (nc *nodeclaimcontroller) sync(key string) (time.Duration, error) {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return 0, err
+	}
+	pg, err := nc.pgLister.PodGroups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		// The NodeClaims still labeled with a deleted podgroup were never used by it.
+		claims, err := nc.listNodeClaims(ns, name)
+		if err != nil {
+			return 0, err
+		}
+		return 0, nc.deleteNodeClaims(claims)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if pg.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	claims, err := nc.listNodeClaims(ns, name)
+	if err != nil {
+		return 0, err
+	}
+	cond := util.GetPodGroupCondition(pg, scheduling.PodGroupWaitingForProvisioningType)
+
+	if !util.UnschedulableForCapacity(pg) {
+		// The pods of the podgroup may run on the nodes of its NodeClaims by now, which must not be deleted with it.
+		if err := nc.releaseNodeClaims(claims); err != nil {
+			return 0, err
+		}
+		if cond != nil && cond.Status == v1.ConditionTrue {
+			return 0, nc.updateCondition(pg, v1.ConditionFalse, ScheduledReason, "the podgroup is no longer unschedulable")
+		}
+		return 0, nil
+	}
+
+	if len(claims) == 0 {
+		if cond != nil && cond.Status == v1.ConditionTrue {
+			return nc.fail(pg, nil, "the NodeClaims of the podgroup were deleted before their nodes were initialized")
+		}
+		if cond != nil && cond.Reason == NodeClaimsFailedReason {
+			if retry := nodeClaimRetryPeriod - time.Since(cond.LastTransitionTime.Time); retry > 0 {
+				return retry, nil
+			}
+		}
+		nodeClaims, err := nc.buildNodeClaims(pg)
+		if err != nil {
+			return 0, err
+		}
+		if len(nodeClaims) == 0 {
+			klog.V(4).Infof("PodGroup <%s> has no pending pods to claim nodes for", key)
+			return nodeClaimCheckPeriod, nil
+		}
+		if err := nc.createNodeClaims(pg, nodeClaims); err != nil {
+			return 0, err
+		}
+		pods := 0
+		for _, claim := range nodeClaims {
+			pods += claim.pods
+		}
+		msg := fmt.Sprintf("claimed %d nodes of NodePool %s for %d pods", len(nodeClaims), nc.nodePool, pods)
+		nc.recorder.Event(pg, v1.EventTypeNormal, NodeClaimsRequestedReason, msg)
+		return nodeClaimCheckPeriod, nc.updateCondition(pg, v1.ConditionTrue, NodeClaimsRequestedReason, msg)
+	}
+
+	switch state, message := nodeClaimsState(claims); state {
+	case nodeClaimsFailed:
+		return nc.fail(pg, claims, message)
+	case nodeClaimsInitialized:
+		return nodeClaimCheckPeriod, nc.updateCondition(pg, v1.ConditionTrue, NodeClaimsInitializedReason,
+			"the nodes are initialized, waiting for the podgroup to be scheduled")
+	default:
+		return nodeClaimCheckPeriod, nil
+	}
+}
+
+// fail deletes the NodeClaims of the podgroup, so that the nodes of the gang are not launched in part, and claims
+// them again after the retry period.
+func (nc *nodeclaimcontroller) fail(pg *scheduling.PodGroup, claims []unstructured.Unstructured, message string) (time.Duration, error) {
+	if err := nc.deleteNodeClaims(claims); err != nil {
+		return 0, err
+	}
+	nc.recorder.Event(pg, v1.EventTypeWarning, NodeClaimsFailedReason, message)
+	return nodeClaimRetryPeriod, nc.updateCondition(pg, v1.ConditionFalse, NodeClaimsFailedReason, message)
+}
+
+// updateCondition sets the WaitingForProvisioning condition of the podgroup.
+func (nc *nodeclaimcontroller) updateCondition(pg *scheduling.PodGroup, status v1.ConditionStatus, reason, message string) error {
+	return util.UpdatePodGroupCondition(nc.vcClient, pg, scheduling.PodGroupCondition{
+		Type:               scheduling.PodGroupWaitingForProvisioningType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
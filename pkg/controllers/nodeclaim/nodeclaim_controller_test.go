@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeclaim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+func newFakeController() *nodeclaimcontroller {
+	kubeClient := kubeclient.NewSimpleClientset()
+	vcClient := vcclient.NewSimpleClientset()
+	pool := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "karpenter.sh/v1",
+		"kind":       "NodePool",
+		"metadata":   map[string]interface{}{"name": "default", "uid": "pool-uid"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"team": "ml"}},
+				"spec": map[string]interface{}{
+					"nodeClassRef": map[string]interface{}{"group": "karpenter.k8s.aws", "kind": "EC2NodeClass", "name": "default"},
+					"requirements": []interface{}{
+						map[string]interface{}{"key": "karpenter.sh/capacity-type", "operator": "In", "values": []interface{}{"on-demand"}},
+						map[string]interface{}{"key": instanceGPUCountLabel, "operator": "Gt", "values": []interface{}{"0"}},
+					},
+				},
+			},
+		},
+	}}
+	controller := &nodeclaimcontroller{
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{nodeClaimGVR: "NodeClaimList", nodePoolGVR: "NodePoolList"}, pool),
+	}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: informerfactory.NewSharedInformerFactory(vcClient, 0),
+	}
+	controller.Initialize(opt)
+	controller.recorder = record.NewFakeRecorder(10)
+	return controller
+}
+
+func newPod(name, group string, gpus int64, nodeSelector map[string]string, nodeName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{scheduling.KubeGroupNameAnnotationKey: group},
+		},
+		Spec: v1.PodSpec{
+			NodeName:     nodeName,
+			NodeSelector: nodeSelector,
+			Containers: []v1.Container{{
+				Name: "main",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:  resource.MustParse("8"),
+						gpuResourceName: *resource.NewQuantity(gpus, resource.DecimalSI),
+					},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodPending},
+	}
+}
+
+func newUnschedulablePodGroup() *scheduling.PodGroup {
+	return &scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "default", UID: "pg1-uid"},
+		Spec:       scheduling.PodGroupSpec{MinMember: 6},
+		Status: scheduling.PodGroupStatus{
+			Phase: scheduling.PodGroupInqueue,
+			Conditions: []scheduling.PodGroupCondition{{
+				Type:               scheduling.PodGroupUnschedulableType,
+				Status:             v1.ConditionTrue,
+				Reason:             scheduling.NotEnoughResourcesReason,
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+}
+
+// addGang adds a gang of five pending pods of 4 GPUs, one of them requiring the g6 instance family, whose sixth pod
+// runs in zone-a.
+func addGang(t *testing.T, nc *nodeclaimcontroller) {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{
+		v1.LabelTopologyZone: "zone-a",
+		instanceFamilyLabel:  "g5",
+	}}}
+	require.NoError(t, nc.informerFactory.Core().V1().Nodes().Informer().GetIndexer().Add(node))
+	for _, pod := range []*v1.Pod{
+		newPod("worker-0", "pg1", 4, nil, ""),
+		newPod("worker-1", "pg1", 4, nil, ""),
+		newPod("worker-2", "pg1", 4, nil, ""),
+		newPod("worker-3", "pg1", 4, nil, ""),
+		newPod("worker-4", "pg1", 4, map[string]string{instanceFamilyLabel: "g6"}, ""),
+		newPod("worker-5", "pg1", 4, nil, "n1"),
+		newPod("other", "pg2", 4, nil, ""),
+	} {
+		require.NoError(t, nc.informerFactory.Core().V1().Pods().Informer().GetIndexer().Add(pod))
+	}
+}
+
+func TestBuildNodeClaims(t *testing.T) {
+	nc := newFakeController()
+	addGang(t, nc)
+
+	claims, err := nc.buildNodeClaims(newUnschedulablePodGroup())
+	require.NoError(t, err)
+	require.Len(t, claims, 3)
+	for i, expected := range []struct {
+		family string
+		gpus   int64
+		pods   int
+	}{
+		{family: "g5", gpus: 8, pods: 2},
+		{family: "g5", gpus: 8, pods: 2},
+		{family: "g6", gpus: 4, pods: 1},
+	} {
+		assert.Equal(t, []string{expected.family}, claims[i].requirements[instanceFamilyLabel].UnsortedList())
+		assert.Equal(t, []string{"zone-a"}, claims[i].requirements[v1.LabelTopologyZone].UnsortedList())
+		assert.Equal(t, expected.gpus, claims[i].gpus)
+		assert.Equal(t, expected.pods, claims[i].pods)
+	}
+	cpu := claims[0].requests[v1.ResourceCPU]
+	assert.Equal(t, "16", cpu.String())
+
+	// The CPUs of a node limit the pods packed on it as well.
+	nc.maxCPUsPerNode = 10
+	claims, err = nc.buildNodeClaims(newUnschedulablePodGroup())
+	require.NoError(t, err)
+	assert.Len(t, claims, 5)
+}
+
+func TestSyncNodeClaims(t *testing.T) {
+	nc := newFakeController()
+	ctx := context.TODO()
+	addGang(t, nc)
+
+	pg := newUnschedulablePodGroup()
+	setPodGroup := func(pg *scheduling.PodGroup) {
+		require.NoError(t, nc.vcInformerFactory.Scheduling().V1beta1().PodGroups().Informer().GetIndexer().Update(pg))
+	}
+	_, err := nc.vcClient.SchedulingV1beta1().PodGroups("default").Create(ctx, pg, metav1.CreateOptions{})
+	require.NoError(t, err)
+	setPodGroup(pg)
+	latestPodGroup := func() *scheduling.PodGroup {
+		pg, err := nc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "pg1", metav1.GetOptions{})
+		require.NoError(t, err)
+		setPodGroup(pg)
+		return pg
+	}
+	setPhase := func(phase scheduling.PodGroupPhase) {
+		pg := latestPodGroup()
+		pg.Status.Phase = phase
+		_, err := nc.vcClient.SchedulingV1beta1().PodGroups("default").Update(ctx, pg, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		setPodGroup(pg)
+	}
+	claimsClient := nc.dynamicClient.Resource(nodeClaimGVR)
+	assertCondition := func(status v1.ConditionStatus, reason string) {
+		cond := util.GetPodGroupCondition(latestPodGroup(), scheduling.PodGroupWaitingForProvisioningType)
+		require.NotNil(t, cond)
+		assert.Equal(t, status, cond.Status)
+		assert.Equal(t, reason, cond.Reason)
+	}
+
+	// The nodes of the gang are claimed from the template of the NodePool.
+	requeue, err := nc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Equal(t, nodeClaimCheckPeriod, requeue)
+	claims, err := nc.listNodeClaims("default", "pg1")
+	require.NoError(t, err)
+	require.Len(t, claims, 3)
+	for _, claim := range claims {
+		assert.Equal(t, "default", claim.GetLabels()[nodePoolLabelKey])
+		assert.Equal(t, "ml", claim.GetLabels()["team"])
+		assert.Equal(t, "3", claim.GetLabels()[nodeClaimCountLabelKey])
+		assert.Equal(t, "pool-uid", string(claim.GetOwnerReferences()[0].UID))
+		nodeClass, _, _ := unstructured.NestedString(claim.Object, "spec", "nodeClassRef", "kind")
+		assert.Equal(t, "EC2NodeClass", nodeClass)
+		requirements, _, _ := unstructured.NestedSlice(claim.Object, "spec", "requirements")
+		keys := map[string]interface{}{}
+		for _, r := range requirements {
+			requirement := r.(map[string]interface{})
+			keys[requirement["key"].(string)] = requirement["values"]
+		}
+		assert.Equal(t, []interface{}{"zone-a"}, keys[v1.LabelTopologyZone])
+		assert.Equal(t, []interface{}{"on-demand"}, keys["karpenter.sh/capacity-type"])
+		assert.Contains(t, []interface{}{[]interface{}{"7"}, []interface{}{"3"}}, keys[instanceGPUCountLabel])
+	}
+	assertCondition(v1.ConditionTrue, NodeClaimsRequestedReason)
+
+	// The podgroup waits for the initialized nodes.
+	for _, claim := range claims {
+		require.NoError(t, unstructured.SetNestedSlice(claim.Object, []interface{}{
+			map[string]interface{}{"type": "Launched", "status": "True"},
+			map[string]interface{}{"type": "Initialized", "status": "True"},
+		}, "status", "conditions"))
+		_, err = claimsClient.Update(ctx, &claim, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+	_, err = nc.sync("default/pg1")
+	require.NoError(t, err)
+	assertCondition(v1.ConditionTrue, NodeClaimsInitializedReason)
+
+	// The NodeClaims are released, not deleted, once the gang is scheduled.
+	setPhase(scheduling.PodGroupRunning)
+	_, err = nc.sync("default/pg1")
+	require.NoError(t, err)
+	assertCondition(v1.ConditionFalse, ScheduledReason)
+	claims, err = nc.listNodeClaims("default", "pg1")
+	require.NoError(t, err)
+	assert.Empty(t, claims)
+	all, err := claimsClient.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, all.Items, 3)
+
+	// A NodeClaim failing to launch fails all the NodeClaims of the gang, which is claimed again after the retry period.
+	setPhase(scheduling.PodGroupInqueue)
+	_, err = nc.sync("default/pg1")
+	require.NoError(t, err)
+	claims, err = nc.listNodeClaims("default", "pg1")
+	require.NoError(t, err)
+	require.Len(t, claims, 3)
+	require.NoError(t, claimsClient.Delete(ctx, claims[0].GetName(), metav1.DeleteOptions{}))
+	requeue, err = nc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Equal(t, nodeClaimRetryPeriod, requeue)
+	assertCondition(v1.ConditionFalse, NodeClaimsFailedReason)
+	claims, err = nc.listNodeClaims("default", "pg1")
+	require.NoError(t, err)
+	assert.Empty(t, claims)
+	requeue, err = nc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Greater(t, requeue, nodeClaimCheckPeriod)
+	claims, err = nc.listNodeClaims("default", "pg1")
+	require.NoError(t, err)
+	assert.Empty(t, claims)
+}
+
+func TestNodeClaimsState(t *testing.T) {
+	newClaim := func(conditions ...interface{}) unstructured.Unstructured {
+		claim := unstructured.Unstructured{Object: map[string]interface{}{}}
+		claim.SetName("default-abcde")
+		claim.SetLabels(map[string]string{nodeClaimCountLabelKey: "2"})
+		unstructured.SetNestedSlice(claim.Object, conditions, "status", "conditions")
+		return claim
+	}
+	initialized := map[string]interface{}{"type": "Initialized", "status": "True"}
+
+	state, _ := nodeClaimsState([]unstructured.Unstructured{newClaim(initialized), newClaim()})
+	assert.Equal(t, nodeClaimsPending, state)
+	state, _ = nodeClaimsState([]unstructured.Unstructured{newClaim(initialized), newClaim(initialized)})
+	assert.Equal(t, nodeClaimsInitialized, state)
+	state, message := nodeClaimsState([]unstructured.Unstructured{newClaim(initialized)})
+	assert.Equal(t, nodeClaimsFailed, state)
+	assert.Equal(t, "1 of 2 NodeClaims were deleted before their nodes were initialized", message)
+	state, message = nodeClaimsState([]unstructured.Unstructured{newClaim(initialized), newClaim(
+		map[string]interface{}{"type": "Launched", "status": "False", "message": "InsufficientCapacity"})})
+	assert.Equal(t, nodeClaimsFailed, state)
+	assert.Equal(t, "NodeClaim default-abcde failed to launch: InsufficientCapacity", message)
+}
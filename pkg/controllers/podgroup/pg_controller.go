@@ -19,6 +19,8 @@ package podgroup
 import (
 	"slices"
 
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/informers"
@@ -62,6 +64,12 @@ type pgcontroller struct {
 	// A store of podgroups
 	pgLister schedulinglister.PodGroupLister
 
+	// A store of queues
+	queueLister schedulinglister.QueueLister
+
+	// The podgroups terminated for running longer than the maximum run time of their queue
+	terminating sets.Set[types.UID]
+
 	queue workqueue.TypedRateLimitingInterface[podRequest]
 
 	schedulerNames []string
@@ -98,6 +106,8 @@ func (pg *pgcontroller) Initialize(opt *framework.ControllerOption) error {
 	pg.vcInformerFactory = factory
 	pg.pgInformer = factory.Scheduling().V1beta1().PodGroups()
 	pg.pgLister = pg.pgInformer.Lister()
+	pg.queueLister = factory.Scheduling().V1beta1().Queues().Lister()
+	pg.terminating = sets.New[types.UID]()
 
 	if utilfeature.DefaultFeatureGate.Enabled(features.WorkLoadSupport) {
 		pg.rsInformer = pg.informerFactory.Apps().V1().ReplicaSets()
@@ -135,6 +145,7 @@ func (pg *pgcontroller) Run(stopCh <-chan struct{}) {
 	for range int(pg.workers) {
 		go wait.Until(pg.worker, 0, stopCh)
 	}
+	go wait.Until(pg.enforceMaxRun, maxRunCheckPeriod, stopCh)
 
 	klog.Infof("PodgroupController is running ...... ")
 }
@@ -171,7 +182,7 @@ func (pg *pgcontroller) processNextReq() bool {
 
 	// normal pod use volcano
 	klog.V(4).Infof("Try to create podgroup for pod %s/%s", pod.Namespace, pod.Name)
-	if err := pg.createNormalPodPGIfNotExist(pod); err != nil {
+	if err := pg.bindPodGroup(pod); err != nil {
 		klog.Errorf("Failed to handle Pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
 		pg.queue.AddRateLimited(req)
 		return true
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	// argoWorkflowLabelKey is the label of the pods of Argo Workflows, with the name of their workflow.
+	argoWorkflowLabelKey = "workflows.argoproj.io/workflow"
+	// argoNodeNameAnnotationKey is the annotation of the pods of Argo Workflows, with the name of their node.
+	argoNodeNameAnnotationKey = "workflows.argoproj.io/node-name"
+)
+
+// argoFanOutIndex matches the index and the item of the nodes of a fan-out step, e.g. "(0:a)" in "wf[1].fanout(0:a)".
+var argoFanOutIndex = regexp.MustCompile(`\(\d+:.*\)$`)
+
+// argoGang returns the gang of the pod of an Argo Workflow, or "" if the pod is no member of a gang.
+func argoGang(pod *v1.Pod) string {
+	if pod.Labels[argoWorkflowLabelKey] == "" {
+		return ""
+	}
+	gang, found := pod.Annotations[scheduling.ArgoGangAnnotationKey]
+	if !found {
+		return ""
+	}
+	if gang == "" {
+		return argoFanOutIndex.ReplaceAllString(pod.Annotations[argoNodeNameAnnotationKey], "")
+	}
+	return gang
+}
+
+// argoGangMinMember returns the minimum number of pods of the gang of the pod of an Argo Workflow.
+func argoGangMinMember(pod *v1.Pod) int32 {
+	value, found := pod.Annotations[scheduling.ArgoGangMinMemberAnnotationKey]
+	if !found {
+		return 1
+	}
+	minMember, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || minMember < 1 {
+		klog.Errorf("Invalid %s <%s> of Pod <%s/%s>, minMember remains as 1",
+			scheduling.ArgoGangMinMemberAnnotationKey, value, pod.Namespace, pod.Name)
+		return 1
+	}
+	return int32(minMember)
+}
+
+// argoGangPodGroupName returns the name of the PodGroup of a gang of an Argo Workflow, the gang is hashed as the names
+// of the nodes of the workflows are no valid names.
+func argoGangPodGroupName(pod *v1.Pod, gang string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(gang))
+	return fmt.Sprintf("%s-%x", pod.Labels[argoWorkflowLabelKey], hash.Sum32())
+}
+
+// createArgoGangPGIfNotExist binds the pod of an Argo Workflow to the PodGroup of its gang, so that the pods of a
+// fan-out step or of a level of a DAG are admitted together, in the queue of the workflow.
+func (pg *pgcontroller) createArgoGangPGIfNotExist(pod *v1.Pod, gang string) error {
+	pgName := argoGangPodGroupName(pod, gang)
+	if _, err := pg.pgLister.PodGroups(pod.Namespace).Get(pgName); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to get PodGroup of Argo gang <%s/%s>: %v", pod.Namespace, gang, err)
+			return err
+		}
+
+		podGroup := pg.buildPodGroupFromPod(pod, pgName)
+		podGroup.Spec.MinMember = argoGangMinMember(pod)
+		minResources := util.CalTaskRequests(pod, podGroup.Spec.MinMember)
+		podGroup.Spec.MinResources = &minResources
+		podGroup.Labels[argoWorkflowLabelKey] = pod.Labels[argoWorkflowLabelKey]
+		podGroup.Annotations[scheduling.ArgoGangAnnotationKey] = gang
+		if _, err := pg.vcClient.SchedulingV1beta1().PodGroups(pod.Namespace).Create(context.TODO(), podGroup, metav1.CreateOptions{}); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				klog.Errorf("Failed to create PodGroup of Argo gang <%s/%s>: %v", pod.Namespace, gang, err)
+				return err
+			}
+		} else {
+			klog.V(4).Infof("PodGroup <%s/%s> created for Argo gang %s", pod.Namespace, pgName, gang)
+		}
+	}
+
+	return pg.updatePodAnnotations(pod, pgName)
+}
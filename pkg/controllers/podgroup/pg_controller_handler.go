@@ -41,6 +41,8 @@ import (
 
 const (
 	controllerRevisionHashLabelKey = "controller-revision-hash"
+	// jobSetUIDLabelKey is the label of the pods of a JobSet, with the uid of the JobSet.
+	jobSetUIDLabelKey = "jobset.sigs.k8s.io/jobset-uid"
 )
 
 type podRequest struct {
@@ -298,6 +300,32 @@ func (pg *pgcontroller) inheritUpperAnnotations(upperAnnotations map[string]stri
 	}
 }
 
+// bindPodGroup binds the pod to the PodGroup of its Spark driver, of its JobSet, of its group of VMs or of its Argo
+// gang if any, else to a PodGroup of its own.
+func (pg *pgcontroller) bindPodGroup(pod *v1.Pod) error {
+	driver, err := pg.sparkDriverOf(pod)
+	if err != nil {
+		return err
+	}
+	if driver != nil {
+		return pg.joinSparkDriverPG(pod, driver)
+	}
+	if jobSetUID := pod.Labels[jobSetUIDLabelKey]; jobSetUID != "" {
+		// The JobSets gang scheduled as a whole, e.g. the ones of Kubeflow TrainJobs, have a PodGroup named after them.
+		pgName := batchv1alpha1.PodgroupNamePrefix + jobSetUID
+		if _, err := pg.pgLister.PodGroups(pod.Namespace).Get(pgName); err == nil {
+			return pg.updatePodAnnotations(pod, pgName)
+		}
+	}
+	if group := vmiGroup(pod); group != "" {
+		return pg.createVMIGroupPGIfNotExist(pod, group)
+	}
+	if gang := argoGang(pod); gang != "" {
+		return pg.createArgoGangPGIfNotExist(pod, gang)
+	}
+	return pg.createNormalPodPGIfNotExist(pod)
+}
+
 func (pg *pgcontroller) createNormalPodPGIfNotExist(pod *v1.Pod) error {
 	pgName := helpers.GeneratePodgroupName(pod)
 
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"context"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	// kubevirtLabelKey is the label of the pods created by KubeVirt, virt-launcher for the pods running the VMs.
+	kubevirtLabelKey       = "kubevirt.io"
+	virtLauncherLabelValue = "virt-launcher"
+	// migrationJobLabelKey is the label of the virt-launcher pods which are the targets of a live migration.
+	migrationJobLabelKey = "kubevirt.io/migrationJobUID"
+
+	vmiGroupPodGroupPrefix = "vmi-group-"
+)
+
+// vmiGroup returns the group of the VirtualMachineInstance run by a virt-launcher pod, or "" if the pod is no
+// virt-launcher pod of a group. The target pods of live migrations keep their own PodGroup, their VM already runs.
+func vmiGroup(pod *v1.Pod) string {
+	if pod.Labels[kubevirtLabelKey] != virtLauncherLabelValue {
+		return ""
+	}
+	if _, found := pod.Labels[migrationJobLabelKey]; found {
+		return ""
+	}
+	return pod.Labels[scheduling.VMIGroupLabelKey]
+}
+
+// vmiGroupMinMember returns the minimum number of VirtualMachineInstances of the group of a virt-launcher pod.
+func vmiGroupMinMember(pod *v1.Pod) int32 {
+	value, found := pod.Labels[scheduling.VMIGroupMinMemberLabelKey]
+	if !found {
+		return 1
+	}
+	minMember, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || minMember < 1 {
+		klog.Errorf("Invalid %s <%s> of Pod <%s/%s>, minMember remains as 1",
+			scheduling.VMIGroupMinMemberLabelKey, value, pod.Namespace, pod.Name)
+		return 1
+	}
+	return int32(minMember)
+}
+
+// createVMIGroupPGIfNotExist binds a virt-launcher pod to the PodGroup of its group of VirtualMachineInstances. The
+// PodGroup is owned by all the virt-launcher pods of the group, so that it is kept while any of its VMs runs.
+func (pg *pgcontroller) createVMIGroupPGIfNotExist(pod *v1.Pod, group string) error {
+	pgName := vmiGroupPodGroupPrefix + group
+	ownerRef := *metav1.NewControllerRef(pod, schema.GroupVersionKind{
+		Group:   v1.SchemeGroupVersion.Group,
+		Version: v1.SchemeGroupVersion.Version,
+		Kind:    "Pod",
+	})
+	ownerRef.Controller = nil
+	ownerRef.BlockOwnerDeletion = nil
+
+	podGroup, err := pg.pgLister.PodGroups(pod.Namespace).Get(pgName)
+	if apierrors.IsNotFound(err) {
+		podGroup = pg.buildPodGroupFromPod(pod, pgName)
+		// The requests of the virt-launcher pod include the dedicated CPUs, the hugepages and the overhead of its VM.
+		podGroup.Spec.MinMember = vmiGroupMinMember(pod)
+		minResources := util.CalTaskRequests(pod, podGroup.Spec.MinMember)
+		podGroup.Spec.MinResources = &minResources
+		podGroup.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		podGroup.Labels[scheduling.VMIGroupLabelKey] = group
+		_, err = pg.vcClient.SchedulingV1beta1().PodGroups(pod.Namespace).Create(context.TODO(), podGroup, metav1.CreateOptions{})
+		if err == nil {
+			klog.V(4).Infof("PodGroup <%s/%s> created for VMI group %s", pod.Namespace, pgName, group)
+			return pg.updatePodAnnotations(pod, pgName)
+		}
+		if apierrors.IsAlreadyExists(err) {
+			// Another pod of the group created it since the cache was synced.
+			podGroup, err = pg.vcClient.SchedulingV1beta1().PodGroups(pod.Namespace).Get(context.TODO(), pgName, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		klog.Errorf("Failed to get PodGroup of VMI group <%s/%s>: %v", pod.Namespace, group, err)
+		return err
+	}
+
+	owned := false
+	for _, reference := range podGroup.OwnerReferences {
+		if reference.UID == pod.UID {
+			owned = true
+			break
+		}
+	}
+	if !owned {
+		podGroup = podGroup.DeepCopy()
+		podGroup.OwnerReferences = append(podGroup.OwnerReferences, ownerRef)
+		if _, err := pg.vcClient.SchedulingV1beta1().PodGroups(pod.Namespace).Update(context.TODO(), podGroup, metav1.UpdateOptions{}); err != nil {
+			klog.Errorf("Failed to add Pod <%s/%s> to the owners of PodGroup <%s>: %v", pod.Namespace, pod.Name, pgName, err)
+			return err
+		}
+	}
+
+	return pg.updatePodAnnotations(pod, pgName)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	bus "volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+const (
+	// maxRunCheckPeriod is the period the podgroups are checked against the maximum run time of their queue.
+	maxRunCheckPeriod = 30 * time.Second
+
+	// MaxRunExceededReason is the reason of terminating the jobs running longer than the maximum run time of their queue.
+	MaxRunExceededReason = "MaxRunExceeded"
+)
+
+// enforceMaxRun terminates the running podgroups of the queues with the Terminate max run policy which run longer
+// than the maximum run time of their queue. All the pods of a podgroup are terminated at once, so the gang is not
+// left partially running.
+func (pg *pgcontroller) enforceMaxRun() {
+	queues, err := pg.queueLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list queues: %v", err)
+		return
+	}
+
+	maxRuns := map[string]time.Duration{}
+	for _, queue := range queues {
+		if queue.Spec.MaxRunSeconds == nil || *queue.Spec.MaxRunSeconds <= 0 ||
+			queue.Spec.MaxRunPolicy == scheduling.MaxRunPolicyReclaim {
+			continue
+		}
+		maxRuns[queue.Name] = time.Duration(*queue.Spec.MaxRunSeconds) * time.Second
+	}
+
+	podGroups, err := pg.pgLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list podgroups: %v", err)
+		return
+	}
+
+	now := time.Now()
+	seen := sets.New[types.UID]()
+	for _, podGroup := range podGroups {
+		seen.Insert(podGroup.UID)
+		maxRun, found := maxRuns[podGroup.Spec.Queue]
+		if !found || podGroup.Status.Phase != scheduling.PodGroupRunning || pg.terminating.Has(podGroup.UID) {
+			continue
+		}
+
+		pods, err := pg.podGroupPods(podGroup)
+		if err != nil {
+			klog.Errorf("Failed to list pods of podgroup <%s/%s>: %v", podGroup.Namespace, podGroup.Name, err)
+			continue
+		}
+		since, running := runningSince(pods)
+		if !running || now.Sub(since) <= maxRun {
+			continue
+		}
+
+		klog.V(3).Infof("Podgroup <%s/%s> runs longer than the maximum run time %v of queue <%s>, terminating it",
+			podGroup.Namespace, podGroup.Name, maxRun, podGroup.Spec.Queue)
+		if err := pg.terminate(podGroup, pods, maxRun); err != nil {
+			klog.Errorf("Failed to terminate podgroup <%s/%s>: %v", podGroup.Namespace, podGroup.Name, err)
+			continue
+		}
+		pg.terminating.Insert(podGroup.UID)
+	}
+
+	// forget the podgroups which are gone
+	pg.terminating = pg.terminating.Intersection(seen)
+}
+
+// podGroupPods returns the pods of the podgroup.
+func (pg *pgcontroller) podGroupPods(podGroup *scheduling.PodGroup) ([]*v1.Pod, error) {
+	pods, err := pg.podLister.Pods(podGroup.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var members []*v1.Pod
+	for _, pod := range pods {
+		if pod.Annotations[scheduling.KubeGroupNameAnnotationKey] == podGroup.Name {
+			members = append(members, pod)
+		}
+	}
+	return members, nil
+}
+
+// runningSince returns the earliest start time of the running pods, false if none is running
+// or some pod is terminating already.
+func runningSince(pods []*v1.Pod) (time.Time, bool) {
+	var since time.Time
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			return time.Time{}, false
+		}
+		if pod.Status.Phase != v1.PodRunning || pod.Status.StartTime == nil {
+			continue
+		}
+		if start := pod.Status.StartTime.Time; since.IsZero() || start.Before(since) {
+			since = start
+		}
+	}
+	return since, !since.IsZero()
+}
+
+// terminate terminates the whole podgroup: a Volcano Job is terminated by a TerminateJob command so its
+// policies apply, the pods of other podgroups are deleted together.
+func (pg *pgcontroller) terminate(podGroup *scheduling.PodGroup, pods []*v1.Pod, maxRun time.Duration) error {
+	message := fmt.Sprintf("podgroup runs longer than the maximum run time %v of queue %s", maxRun, podGroup.Spec.Queue)
+	if ref := metav1.GetControllerOf(podGroup); ref != nil &&
+		ref.APIVersion == batch.SchemeGroupVersion.String() && ref.Kind == "Job" {
+		cmd := &bus.Command{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: fmt.Sprintf("%s-%s-", ref.Name, strings.ToLower(string(bus.TerminateJobAction))),
+				Namespace:    podGroup.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*ref,
+				},
+			},
+			TargetObject: ref,
+			Action:       string(bus.TerminateJobAction),
+			Reason:       MaxRunExceededReason,
+			Message:      message,
+		}
+		_, err := pg.vcClient.BusV1alpha1().Commands(podGroup.Namespace).Create(context.TODO(), cmd, metav1.CreateOptions{})
+		return err
+	}
+
+	var errs []error
+	for _, pod := range pods {
+		err := pg.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
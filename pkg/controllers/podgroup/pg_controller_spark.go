@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	// sparkRoleLabelKey is the label of the pods created by Spark on Kubernetes, with their role in the application.
+	sparkRoleLabelKey = "spark-role"
+	sparkRoleDriver   = "driver"
+	sparkRoleExecutor = "executor"
+	// sparkAppSelectorLabelKey is the label of the pods of a Spark application, with its application id.
+	sparkAppSelectorLabelKey = "spark-app-selector"
+)
+
+// sparkDriverOf returns the driver of a Spark executor, or nil if the pod is no executor or its driver is gone. The
+// other pods of Spark, e.g. the shuffle services, are not members of the gang of the application.
+func (pg *pgcontroller) sparkDriverOf(pod *v1.Pod) (*v1.Pod, error) {
+	if pod.Labels[sparkRoleLabelKey] != sparkRoleExecutor {
+		return nil, nil
+	}
+	for _, reference := range pod.OwnerReferences {
+		if reference.Kind != "Pod" {
+			continue
+		}
+		driver, err := pg.podLister.Pods(pod.Namespace).Get(reference.Name)
+		if err == nil && driver.UID == reference.UID {
+			return driver, nil
+		}
+	}
+	appID := pod.Labels[sparkAppSelectorLabelKey]
+	if appID == "" {
+		return nil, nil
+	}
+	drivers, err := pg.podLister.Pods(pod.Namespace).List(labels.SelectorFromSet(labels.Set{
+		sparkAppSelectorLabelKey: appID,
+		sparkRoleLabelKey:        sparkRoleDriver,
+	}))
+	if err != nil || len(drivers) == 0 {
+		return nil, err
+	}
+	return drivers[0], nil
+}
+
+// sparkMinExecutors returns the minimum number of executors of the application of the Spark driver.
+func sparkMinExecutors(driver *v1.Pod) int32 {
+	value, found := driver.Annotations[scheduling.SparkMinExecutorsAnnotationKey]
+	if !found {
+		return 0
+	}
+	minExecutors, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || minExecutors < 0 {
+		klog.Errorf("Invalid %s <%s> of Pod <%s/%s>, no executor is reserved",
+			scheduling.SparkMinExecutorsAnnotationKey, value, driver.Namespace, driver.Name)
+		return 0
+	}
+	return int32(minExecutors)
+}
+
+// joinSparkDriverPG binds a Spark executor to the PodGroup of its driver, rather than to a PodGroup of its own, so that
+// the executors added by dynamic allocation are scheduled as elastic members of the application. The minResources of
+// the PodGroup are adjusted to the driver and the minimum executors of the application.
+func (pg *pgcontroller) joinSparkDriverPG(executor, driver *v1.Pod) error {
+	pgName := driver.Annotations[scheduling.KubeGroupNameAnnotationKey]
+	if pgName == "" {
+		pgName = helpers.GeneratePodgroupName(driver)
+	}
+	podGroup, err := pg.pgLister.PodGroups(driver.Namespace).Get(pgName)
+	if err != nil {
+		return fmt.Errorf("failed to get PodGroup <%s/%s> of Spark driver <%s>: %v", driver.Namespace, pgName, driver.Name, err)
+	}
+
+	minResources := quotav1.Add(util.CalTaskRequests(driver, 1), util.CalTaskRequests(executor, sparkMinExecutors(driver)))
+	if podGroup.Spec.MinResources == nil || !quotav1.Equals(*podGroup.Spec.MinResources, minResources) {
+		podGroup = podGroup.DeepCopy()
+		podGroup.Spec.MinResources = &minResources
+		if _, err := pg.vcClient.SchedulingV1beta1().PodGroups(podGroup.Namespace).Update(context.TODO(), podGroup, metav1.UpdateOptions{}); err != nil {
+			klog.Errorf("Failed to update minResources of PodGroup <%s/%s>: %v", podGroup.Namespace, pgName, err)
+			return err
+		}
+		klog.V(4).Infof("Adjusted minResources of PodGroup <%s/%s> of Spark driver %s", podGroup.Namespace, pgName, driver.Name)
+	}
+
+	return pg.updatePodAnnotations(executor, pgName)
+}
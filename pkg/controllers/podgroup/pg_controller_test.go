@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	vcbus "volcano.sh/apis/pkg/apis/bus/v1alpha1"
 	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
 	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
@@ -1415,3 +1417,386 @@ func TestNoPodGroupCreatedForWhenKubeGroupNameAnnotationExists(t *testing.T) {
 		assert.Equal(t, 1, len(pgList.Items), "Expected 1 PodGroup, found %d: %v", len(pgList.Items), names)
 	})
 }
+
+func TestEnforceMaxRun(t *testing.T) {
+	namespace := "test"
+	maxRun := int64(3600)
+	started := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	jobRef := metav1.OwnerReference{
+		APIVersion: vcbatch.SchemeGroupVersion.String(),
+		Kind:       "Job",
+		Name:       "job1",
+		UID:        "job1-uid",
+		Controller: ptr.To(true),
+	}
+
+	buildQueue := func(policy scheduling.MaxRunPolicy) *scheduling.Queue {
+		return &scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "q1"},
+			Spec:       scheduling.QueueSpec{MaxRunSeconds: &maxRun, MaxRunPolicy: policy},
+		}
+	}
+	buildPodGroup := func(owner *metav1.OwnerReference) *scheduling.PodGroup {
+		pg := &scheduling.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: namespace, UID: "pg1-uid"},
+			Spec:       scheduling.PodGroupSpec{Queue: "q1", MinMember: 2},
+			Status:     scheduling.PodGroupStatus{Phase: scheduling.PodGroupRunning},
+		}
+		if owner != nil {
+			pg.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return pg
+	}
+	buildPod := func(name string, startTime metav1.Time) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: map[string]string{scheduling.KubeGroupNameAnnotationKey: "pg1"},
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning, StartTime: &startTime},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		queue            *scheduling.Queue
+		podGroup         *scheduling.PodGroup
+		pods             []*v1.Pod
+		expectedCommands int
+		expectedPods     int
+	}{
+		{
+			name:             "volcano job exceeding the maximum run time is terminated by command",
+			queue:            buildQueue(""),
+			podGroup:         buildPodGroup(&jobRef),
+			pods:             []*v1.Pod{buildPod("p1", started), buildPod("p2", metav1.Now())},
+			expectedCommands: 1,
+			expectedPods:     2,
+		},
+		{
+			name:             "pods of podgroup exceeding the maximum run time are deleted together",
+			queue:            buildQueue(scheduling.MaxRunPolicyTerminate),
+			podGroup:         buildPodGroup(nil),
+			pods:             []*v1.Pod{buildPod("p1", started), buildPod("p2", metav1.Now())},
+			expectedCommands: 0,
+			expectedPods:     0,
+		},
+		{
+			name:             "podgroup within the maximum run time is kept",
+			queue:            buildQueue(scheduling.MaxRunPolicyTerminate),
+			podGroup:         buildPodGroup(nil),
+			pods:             []*v1.Pod{buildPod("p1", metav1.Now()), buildPod("p2", metav1.Now())},
+			expectedCommands: 0,
+			expectedPods:     2,
+		},
+		{
+			name:             "podgroup of queue with Reclaim policy is left to the scheduler",
+			queue:            buildQueue(scheduling.MaxRunPolicyReclaim),
+			podGroup:         buildPodGroup(nil),
+			pods:             []*v1.Pod{buildPod("p1", started), buildPod("p2", started)},
+			expectedCommands: 0,
+			expectedPods:     2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeController()
+			assert.NoError(t, c.vcInformerFactory.Scheduling().V1beta1().Queues().Informer().GetIndexer().Add(tc.queue))
+			assert.NoError(t, c.pgInformer.Informer().GetIndexer().Add(tc.podGroup))
+			for _, pod := range tc.pods {
+				_, err := c.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+				assert.NoError(t, err)
+				assert.NoError(t, c.podInformer.Informer().GetIndexer().Add(pod))
+			}
+
+			c.enforceMaxRun()
+			// the podgroup is terminated once
+			c.enforceMaxRun()
+
+			commands, err := c.vcClient.BusV1alpha1().Commands(namespace).List(context.TODO(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			assert.Len(t, commands.Items, tc.expectedCommands)
+			for _, cmd := range commands.Items {
+				assert.Equal(t, string(vcbus.TerminateJobAction), cmd.Action)
+				assert.Equal(t, MaxRunExceededReason, cmd.Reason)
+				assert.Equal(t, "job1", cmd.TargetObject.Name)
+			}
+			pods, err := c.kubeClient.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			assert.Len(t, pods.Items, tc.expectedPods)
+		})
+	}
+}
+
+func TestVMIGroupPodGroup(t *testing.T) {
+	namespace := "test"
+	buildVirtLauncher := func(name string, labels map[string]string) *v1.Pod {
+		podLabels := map[string]string{kubevirtLabelKey: virtLauncherLabelValue}
+		for k, v := range labels {
+			podLabels[k] = v
+		}
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				UID:       types.UID(name + "-uid"),
+				Labels:    podLabels,
+			},
+			Spec: v1.PodSpec{
+				SchedulerName: "volcano",
+				Containers: []v1.Container{{
+					Name: "compute",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("4"),
+							v1.ResourceMemory: resource.MustParse("1Gi"),
+							"hugepages-1Gi":   resource.MustParse("4Gi"),
+						},
+						Limits: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("4"),
+							v1.ResourceMemory: resource.MustParse("1Gi"),
+							"hugepages-1Gi":   resource.MustParse("4Gi"),
+						},
+					},
+				}},
+			},
+		}
+	}
+	group := map[string]string{scheduling.VMIGroupLabelKey: "fleet", scheduling.VMIGroupMinMemberLabelKey: "2"}
+	migrationTarget := buildVirtLauncher("virt-launcher-vm3", map[string]string{
+		scheduling.VMIGroupLabelKey: "fleet", migrationJobLabelKey: "migration-uid",
+	})
+	pods := []*v1.Pod{
+		buildVirtLauncher("virt-launcher-vm1", group),
+		buildVirtLauncher("virt-launcher-vm2", group),
+		migrationTarget,
+	}
+
+	c := newFakeController()
+	for _, pod := range pods {
+		_, err := c.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.podInformer.Informer().GetIndexer().Add(pod))
+		c.addPod(pod)
+		c.processNextReq()
+	}
+
+	podGroup, err := c.vcClient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), "vmi-group-fleet", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), podGroup.Spec.MinMember)
+	assert.Equal(t, "fleet", podGroup.Labels[scheduling.VMIGroupLabelKey])
+	assert.True(t, resource.MustParse("8").Equal((*podGroup.Spec.MinResources)[v1.ResourceCPU]))
+	assert.True(t, resource.MustParse("8Gi").Equal((*podGroup.Spec.MinResources)["hugepages-1Gi"]))
+	owners := make([]types.UID, 0, len(podGroup.OwnerReferences))
+	for _, reference := range podGroup.OwnerReferences {
+		assert.Nil(t, reference.Controller)
+		owners = append(owners, reference.UID)
+	}
+	assert.Equal(t, []types.UID{"virt-launcher-vm1-uid", "virt-launcher-vm2-uid"}, owners)
+
+	expectedGroups := map[string]string{
+		"virt-launcher-vm1": "vmi-group-fleet",
+		"virt-launcher-vm2": "vmi-group-fleet",
+		// the target of a live migration is scheduled on its own
+		"virt-launcher-vm3": "podgroup-virt-launcher-vm3-uid",
+	}
+	for name, expected := range expectedGroups {
+		pod, err := c.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, pod.Annotations[scheduling.KubeGroupNameAnnotationKey], name)
+	}
+}
+
+func TestSparkExecutorJoinsDriverPodGroup(t *testing.T) {
+	namespace := "test"
+	buildSparkPod := func(name, role, cpu string, owner metav1.OwnerReference) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				UID:             types.UID(name + "-uid"),
+				Labels:          map[string]string{sparkRoleLabelKey: role, sparkAppSelectorLabelKey: "spark-123"},
+				OwnerReferences: []metav1.OwnerReference{owner},
+			},
+			Spec: v1.PodSpec{
+				SchedulerName: "volcano",
+				Containers: []v1.Container{{
+					Name: "spark",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				}},
+			},
+		}
+	}
+	// the driver of the spark operator is owned by its SparkApplication
+	driver := buildSparkPod("app-driver", sparkRoleDriver, "1", metav1.OwnerReference{
+		APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "SparkApplication", Name: "app", UID: "app-uid", Controller: ptr.To(true),
+	})
+	driver.Annotations = map[string]string{scheduling.SparkMinExecutorsAnnotationKey: "2"}
+	driverRef := metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "app-driver", UID: "app-driver-uid", Controller: ptr.To(true)}
+	pods := []*v1.Pod{
+		driver,
+		buildSparkPod("app-exec-1", sparkRoleExecutor, "2", driverRef),
+		buildSparkPod("app-exec-2", sparkRoleExecutor, "2", driverRef),
+		buildSparkPod("app-shuffle", "shuffle-service", "4", driverRef),
+	}
+
+	c := newFakeController()
+	pgIndexer := c.pgInformer.Informer().GetIndexer()
+	for _, pod := range pods {
+		_, err := c.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.podInformer.Informer().GetIndexer().Add(pod))
+		c.addPod(pod)
+		c.processNextReq()
+		pgList, err := c.vcClient.SchedulingV1beta1().PodGroups(namespace).List(context.TODO(), metav1.ListOptions{})
+		assert.NoError(t, err)
+		for i := range pgList.Items {
+			assert.NoError(t, pgIndexer.Update(&pgList.Items[i]))
+		}
+	}
+
+	podGroup, err := c.vcClient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), "podgroup-app-uid", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), podGroup.Spec.MinMember)
+	// the driver and the minimum executors are reserved, not the shuffle service
+	assert.True(t, resource.MustParse("5").Equal((*podGroup.Spec.MinResources)[v1.ResourceCPU]))
+
+	expectedGroups := map[string]string{
+		"app-driver": "podgroup-app-uid",
+		"app-exec-1": "podgroup-app-uid",
+		"app-exec-2": "podgroup-app-uid",
+		// the shuffle service is scheduled on its own
+		"app-shuffle": "podgroup-app-driver-uid",
+	}
+	for name, expected := range expectedGroups {
+		pod, err := c.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, pod.Annotations[scheduling.KubeGroupNameAnnotationKey], name)
+	}
+}
+
+func TestJobSetPodJoinsJobSetPodGroup(t *testing.T) {
+	namespace := "test"
+	buildJobSetPod := func(name, jobSetUID string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				UID:       types.UID(name + "-uid"),
+				Labels:    map[string]string{jobSetUIDLabelKey: jobSetUID},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "batch/v1", Kind: "Job", Name: name + "-job", UID: types.UID(name + "-job-uid"), Controller: ptr.To(true),
+				}},
+			},
+			Spec: v1.PodSpec{SchedulerName: "volcano"},
+		}
+	}
+
+	c := newFakeController()
+	podGroup := &scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{Name: "podgroup-train-uid", Namespace: namespace}}
+	assert.NoError(t, c.pgInformer.Informer().GetIndexer().Add(podGroup))
+	expectedGroups := map[string]string{
+		// the JobSet is gang scheduled as a whole
+		"train-node-0": "podgroup-train-uid",
+		// the JobSet has no PodGroup, its jobs are scheduled on their own
+		"other-node-0": "podgroup-other-node-0-job-uid",
+	}
+	for _, pod := range []*v1.Pod{buildJobSetPod("train-node-0", "train-uid"), buildJobSetPod("other-node-0", "other-uid")} {
+		_, err := c.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.podInformer.Informer().GetIndexer().Add(pod))
+		c.addPod(pod)
+		c.processNextReq()
+	}
+	for name, expected := range expectedGroups {
+		pod, err := c.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, pod.Annotations[scheduling.KubeGroupNameAnnotationKey], name)
+	}
+}
+
+func TestArgoGangPodGroup(t *testing.T) {
+	namespace := "test"
+	workflow := metav1.OwnerReference{
+		APIVersion: "argoproj.io/v1alpha1",
+		Kind:       "Workflow",
+		Name:       "fan-out",
+		UID:        "fan-out-uid",
+		Controller: ptr.To(true),
+	}
+	buildWorkflowPod := func(name, nodeName string, annotations map[string]string) *v1.Pod {
+		podAnnotations := map[string]string{
+			argoNodeNameAnnotationKey:         nodeName,
+			scheduling.QueueNameAnnotationKey: "research",
+		}
+		for k, v := range annotations {
+			podAnnotations[k] = v
+		}
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				UID:             types.UID(name + "-uid"),
+				Labels:          map[string]string{argoWorkflowLabelKey: "fan-out"},
+				Annotations:     podAnnotations,
+				OwnerReferences: []metav1.OwnerReference{workflow},
+			},
+			Spec: v1.PodSpec{
+				SchedulerName: "volcano",
+				Containers: []v1.Container{{
+					Name: "main",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+					},
+				}},
+			},
+		}
+	}
+	fanOut := map[string]string{scheduling.ArgoGangAnnotationKey: "", scheduling.ArgoGangMinMemberAnnotationKey: "2"}
+	level := map[string]string{scheduling.ArgoGangAnnotationKey: "level-2", scheduling.ArgoGangMinMemberAnnotationKey: "2"}
+	pods := []*v1.Pod{
+		buildWorkflowPod("fan-out-1", "fan-out[1].process(0:a)", fanOut),
+		buildWorkflowPod("fan-out-2", "fan-out[1].process(1:b)", fanOut),
+		buildWorkflowPod("fan-out-3", "fan-out.train", level),
+		buildWorkflowPod("fan-out-4", "fan-out.evaluate", level),
+		buildWorkflowPod("fan-out-5", "fan-out[0].prepare", nil),
+	}
+
+	c := newFakeController()
+	for _, pod := range pods {
+		_, err := c.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.podInformer.Informer().GetIndexer().Add(pod))
+		c.addPod(pod)
+		c.processNextReq()
+	}
+
+	for _, gang := range []string{"fan-out[1].process", "level-2"} {
+		pgName := argoGangPodGroupName(pods[0], gang)
+		podGroup, err := c.vcClient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), pgName, metav1.GetOptions{})
+		assert.NoError(t, err, gang)
+		assert.Equal(t, int32(2), podGroup.Spec.MinMember, gang)
+		assert.Equal(t, "research", podGroup.Spec.Queue, gang)
+		assert.Equal(t, gang, podGroup.Annotations[scheduling.ArgoGangAnnotationKey])
+		assert.True(t, resource.MustParse("4").Equal((*podGroup.Spec.MinResources)[v1.ResourceCPU]), gang)
+		assert.Equal(t, []metav1.OwnerReference{workflow}, podGroup.OwnerReferences, gang)
+	}
+
+	expectedGroups := map[string]string{
+		"fan-out-1": argoGangPodGroupName(pods[0], "fan-out[1].process"),
+		"fan-out-2": argoGangPodGroupName(pods[0], "fan-out[1].process"),
+		"fan-out-3": argoGangPodGroupName(pods[0], "level-2"),
+		"fan-out-4": argoGangPodGroupName(pods[0], "level-2"),
+		// the steps out of gangs share the PodGroup of the workflow
+		"fan-out-5": "podgroup-fan-out-uid",
+	}
+	for name, expected := range expectedGroups {
+		pod, err := c.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, pod.Annotations[scheduling.KubeGroupNameAnnotationKey], name)
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcscheme "volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	controllerName = "provisioning-controller"
+
+	// provisioningCheckPeriod is the period the podgroups waiting for provisioning are checked at.
+	provisioningCheckPeriod = 30 * time.Second
+	// provisioningRetryPeriod is the time after which the nodes of a podgroup are requested again once their
+	// provisioning failed, if the podgroup is still unschedulable.
+	provisioningRetryPeriod = 5 * time.Minute
+
+	defaultProvisioningClassName = "best-effort-atomic-scale-up.autoscaling.x-k8s.io"
+
+	// ProvisioningRequestedReason is the reason of the condition when the nodes of the podgroup are requested.
+	ProvisioningRequestedReason = "ProvisioningRequested"
+	// ProvisionedReason is the reason of the condition when the nodes of the podgroup are provisioned, until the
+	// podgroup is scheduled on them.
+	ProvisionedReason = "Provisioned"
+	// ProvisioningFailedReason is the reason of the condition when the nodes of the podgroup could not be provisioned.
+	ProvisioningFailedReason = "ProvisioningFailed"
+	// ScheduledReason is the reason of the condition when the podgroup is no longer unschedulable.
+	ScheduledReason = "Scheduled"
+)
+
+func init() {
+	framework.RegisterController(&provisioningcontroller{})
+}
+
+// provisioningcontroller asks the cluster autoscaler for the nodes of the gangs which were admitted, but could not be
+// scheduled for lack of capacity, by a ProvisioningRequest of the shape of their pending pods, and keeps their
+// podgroup in the WaitingForProvisioning condition until the nodes arrive.
+type provisioningcontroller struct {
+	kubeClient    kubernetes.Interface
+	vcClient      vcclientset.Interface
+	dynamicClient dynamic.Interface
+
+	informerFactory   informers.SharedInformerFactory
+	vcInformerFactory vcinformer.SharedInformerFactory
+	podLister         corelisters.PodLister
+	podSynced         func() bool
+	pgLister          schedulinglister.PodGroupLister
+	pgSynced          func() bool
+
+	queue    workqueue.TypedRateLimitingInterface[string]
+	recorder record.EventRecorder
+
+	// provisioningClassName is the provisioning class of the ProvisioningRequests.
+	provisioningClassName string
+}
+
+func (pc *provisioningcontroller) Name() string {
+	return controllerName
+}
+
+// AddFlags implements framework.FlagProvider.
+func (pc *provisioningcontroller) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&pc.provisioningClassName, "provisioning-class-name", defaultProvisioningClassName,
+		"The provisioning class of the ProvisioningRequests created by the provisioning-controller for the gangs "+
+			"which cannot be scheduled for lack of capacity")
+}
+
+func (pc *provisioningcontroller) Initialize(opt *framework.ControllerOption) error {
+	pc.kubeClient = opt.KubeClient
+	pc.vcClient = opt.VolcanoClient
+	if pc.dynamicClient == nil {
+		dynamicClient, err := dynamic.NewForConfig(opt.Config)
+		if err != nil {
+			return fmt.Errorf("failed to create dynamic client: %v", err)
+		}
+		pc.dynamicClient = dynamicClient
+	}
+	if pc.provisioningClassName == "" {
+		pc.provisioningClassName = defaultProvisioningClassName
+	}
+	pc.informerFactory = opt.SharedInformerFactory
+	pc.vcInformerFactory = opt.VCSharedInformerFactory
+	pc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(klog.Infof)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: opt.KubeClient.CoreV1().Events("")})
+	pc.recorder = eventBroadcaster.NewRecorder(vcscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
+
+	podInformer := pc.informerFactory.Core().V1().Pods()
+	pc.podLister = podInformer.Lister()
+	pc.podSynced = podInformer.Informer().HasSynced
+
+	pgInformer := pc.vcInformerFactory.Scheduling().V1beta1().PodGroups()
+	pgInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: pc.addPodGroup,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			pc.addPodGroup(newObj)
+		},
+	})
+	pc.pgLister = pgInformer.Lister()
+	pc.pgSynced = pgInformer.Informer().HasSynced
+	return nil
+}
+
+// Run starts the ProvisioningController.
+func (pc *provisioningcontroller) Run(stopCh <-chan struct{}) {
+	defer pc.queue.ShutDown()
+
+	pc.informerFactory.Start(stopCh)
+	pc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, pc.podSynced, pc.pgSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(pc.worker, 0, stopCh)
+	klog.Infof("ProvisioningController is running ...... ")
+	<-stopCh
+}
+
+func (pc *provisioningcontroller) addPodGroup(obj interface{}) {
+	pg, ok := obj.(*scheduling.PodGroup)
+	if !ok {
+		klog.Errorf("obj is not PodGroup")
+		return
+	}
+	if !util.UnschedulableForCapacity(pg) && util.GetPodGroupCondition(pg, scheduling.PodGroupWaitingForProvisioningType) == nil {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(pg)
+	if err != nil {
+		klog.Errorf("Failed to get key of PodGroup <%s/%s>: %v", pg.Namespace, pg.Name, err)
+		return
+	}
+	pc.queue.Add(key)
+}
+
+func (pc *provisioningcontroller) worker() {
+	for pc.processNextReq() {
+	}
+}
+
+func (pc *provisioningcontroller) processNextReq() bool {
+	key, shutdown := pc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer pc.queue.Done(key)
+
+	requeueAfter, err := pc.sync(key)
+	if err != nil {
+		klog.V(2).Infof("Failed to sync provisioning of PodGroup <%s>: %v", key, err)
+		pc.queue.AddRateLimited(key)
+		return true
+	}
+	pc.queue.Forget(key)
+	if requeueAfter > 0 {
+		pc.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// sync requests the nodes of the podgroup when it is unschedulable for lack of capacity, follows the provisioning,
+// and cleans the request up once the podgroup is scheduled. It returns when the podgroup is to be checked again.
+func (pc *provisioningcontroller) sync(key string) (time.Duration, error) {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return 0, err
+	}
+	pg, err := pc.pgLister.PodGroups(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		// The request and its templates are garbage collected with the podgroup owning them.
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if pg.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	request, err := pc.getProvisioningRequest(ns, name)
+	if err != nil {
+		return 0, err
+	}
+	cond := util.GetPodGroupCondition(pg, scheduling.PodGroupWaitingForProvisioningType)
+
+	if !util.UnschedulableForCapacity(pg) {
+		if request != nil {
+			if err := pc.deleteProvisioningRequest(ns, name); err != nil {
+				return 0, err
+			}
+		}
+		if cond != nil && cond.Status == v1.ConditionTrue {
+			return 0, pc.updateCondition(pg, v1.ConditionFalse, ScheduledReason, "the podgroup is no longer unschedulable")
+		}
+		return 0, nil
+	}
+
+	if request == nil {
+		if cond != nil && cond.Reason == ProvisioningFailedReason {
+			if retry := provisioningRetryPeriod - time.Since(cond.LastTransitionTime.Time); retry > 0 {
+				return retry, nil
+			}
+		}
+		podSets, err := pc.buildPodSets(pg)
+		if err != nil {
+			return 0, err
+		}
+		if len(podSets) == 0 {
+			klog.V(4).Infof("PodGroup <%s> has no pending pods to provision nodes for", key)
+			return provisioningCheckPeriod, nil
+		}
+		if err := pc.createProvisioningRequest(pg, podSets); err != nil {
+			return 0, err
+		}
+		count := int32(0)
+		for _, podSet := range podSets {
+			count += podSet.count
+		}
+		msg := fmt.Sprintf("requested nodes for %d pods in %d pod sets of class %s", count, len(podSets), pc.provisioningClassName)
+		pc.recorder.Event(pg, v1.EventTypeNormal, ProvisioningRequestedReason, msg)
+		return provisioningCheckPeriod, pc.updateCondition(pg, v1.ConditionTrue, ProvisioningRequestedReason, msg)
+	}
+
+	switch state, message := provisioningState(request); state {
+	case provisioningFailed:
+		if err := pc.deleteProvisioningRequest(ns, name); err != nil {
+			return 0, err
+		}
+		pc.recorder.Event(pg, v1.EventTypeWarning, ProvisioningFailedReason, message)
+		return provisioningRetryPeriod, pc.updateCondition(pg, v1.ConditionFalse, ProvisioningFailedReason, message)
+	case provisioningDone:
+		return provisioningCheckPeriod, pc.updateCondition(pg, v1.ConditionTrue, ProvisionedReason,
+			"the nodes are provisioned, waiting for the podgroup to be scheduled")
+	default:
+		return provisioningCheckPeriod, nil
+	}
+}
+
+// updateCondition sets the WaitingForProvisioning condition of the podgroup.
+func (pc *provisioningcontroller) updateCondition(pg *scheduling.PodGroup, status v1.ConditionStatus, reason, message string) error {
+	return util.UpdatePodGroupCondition(pc.vcClient, pg, scheduling.PodGroupCondition{
+		Type:               scheduling.PodGroupWaitingForProvisioningType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
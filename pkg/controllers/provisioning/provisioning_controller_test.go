@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+func newFakeController() *provisioningcontroller {
+	kubeClient := kubeclient.NewSimpleClientset()
+	vcClient := vcclient.NewSimpleClientset()
+	controller := &provisioningcontroller{
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{provisioningRequestGVR: "ProvisioningRequestList"}),
+	}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: informerfactory.NewSharedInformerFactory(vcClient, 0),
+	}
+	controller.Initialize(opt)
+	controller.recorder = record.NewFakeRecorder(10)
+	return controller
+}
+
+func newPod(name, group, cpu string, nodeName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{scheduling.KubeGroupNameAnnotationKey: group},
+		},
+		Spec: v1.PodSpec{
+			NodeName: nodeName,
+			Containers: []v1.Container{{
+				Name:  "main",
+				Image: "busybox",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodPending},
+	}
+}
+
+func newUnschedulablePodGroup() *scheduling.PodGroup {
+	return &scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "default", UID: "pg1-uid"},
+		Spec:       scheduling.PodGroupSpec{MinMember: 3},
+		Status: scheduling.PodGroupStatus{
+			Phase: scheduling.PodGroupInqueue,
+			Conditions: []scheduling.PodGroupCondition{{
+				Type:               scheduling.PodGroupUnschedulableType,
+				Status:             v1.ConditionTrue,
+				Reason:             scheduling.NotEnoughResourcesReason,
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+}
+
+func TestSyncProvisioning(t *testing.T) {
+	pc := newFakeController()
+	ctx := context.TODO()
+
+	pg := newUnschedulablePodGroup()
+	setPodGroup := func(pg *scheduling.PodGroup) {
+		require.NoError(t, pc.vcInformerFactory.Scheduling().V1beta1().PodGroups().Informer().GetIndexer().Update(pg))
+	}
+	_, err := pc.vcClient.SchedulingV1beta1().PodGroups("default").Create(ctx, pg, metav1.CreateOptions{})
+	require.NoError(t, err)
+	setPodGroup(pg)
+	for _, pod := range []*v1.Pod{
+		newPod("master", "pg1", "1", ""),
+		newPod("worker-0", "pg1", "4", ""),
+		newPod("worker-1", "pg1", "4", ""),
+		newPod("worker-2", "pg1", "4", "n1"),
+		newPod("other", "pg2", "4", ""),
+	} {
+		require.NoError(t, pc.informerFactory.Core().V1().Pods().Informer().GetIndexer().Add(pod))
+	}
+	latestPodGroup := func() *scheduling.PodGroup {
+		pg, err := pc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "pg1", metav1.GetOptions{})
+		require.NoError(t, err)
+		setPodGroup(pg)
+		return pg
+	}
+	requests := pc.dynamicClient.Resource(provisioningRequestGVR).Namespace("default")
+	setRequestCondition := func(condType string) {
+		request, err := requests.Get(ctx, "pg1", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, unstructured.SetNestedSlice(request.Object, []interface{}{
+			map[string]interface{}{"type": condType, "status": "True", "message": "no capacity"},
+		}, "status", "conditions"))
+		_, err = requests.Update(ctx, request, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	// The pending pods of the unschedulable gang are requested by shape.
+	requeue, err := pc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Equal(t, provisioningCheckPeriod, requeue)
+	request, err := requests.Get(ctx, "pg1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "pg1", request.GetOwnerReferences()[0].Name)
+	className, _, _ := unstructured.NestedString(request.Object, "spec", "provisioningClassName")
+	assert.Equal(t, defaultProvisioningClassName, className)
+	podSets, _, _ := unstructured.NestedSlice(request.Object, "spec", "podSets")
+	counts := map[int64]int{}
+	for _, set := range podSets {
+		count, _, _ := unstructured.NestedInt64(set.(map[string]interface{}), "count")
+		name, _, _ := unstructured.NestedString(set.(map[string]interface{}), "podTemplateRef", "name")
+		template, err := pc.kubeClient.CoreV1().PodTemplates("default").Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "pg1", template.Labels[podGroupLabelKey])
+		counts[count]++
+	}
+	assert.Equal(t, map[int64]int{1: 1, 2: 1}, counts)
+	cond := util.GetPodGroupCondition(latestPodGroup(), scheduling.PodGroupWaitingForProvisioningType)
+	require.NotNil(t, cond)
+	assert.Equal(t, v1.ConditionTrue, cond.Status)
+	assert.Equal(t, ProvisioningRequestedReason, cond.Reason)
+
+	// The podgroup waits for the provisioned nodes.
+	setRequestCondition("Provisioned")
+	_, err = pc.sync("default/pg1")
+	require.NoError(t, err)
+	cond = util.GetPodGroupCondition(latestPodGroup(), scheduling.PodGroupWaitingForProvisioningType)
+	assert.Equal(t, v1.ConditionTrue, cond.Status)
+	assert.Equal(t, ProvisionedReason, cond.Reason)
+
+	// The request is cleaned up once the gang is scheduled.
+	pg = latestPodGroup()
+	pg.Status.Phase = scheduling.PodGroupRunning
+	_, err = pc.vcClient.SchedulingV1beta1().PodGroups("default").Update(ctx, pg, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	setPodGroup(pg)
+	requeue, err = pc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), requeue)
+	cond = util.GetPodGroupCondition(latestPodGroup(), scheduling.PodGroupWaitingForProvisioningType)
+	assert.Equal(t, v1.ConditionFalse, cond.Status)
+	assert.Equal(t, ScheduledReason, cond.Reason)
+	templates, err := pc.kubeClient.CoreV1().PodTemplates("default").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, templates.Items)
+
+	// The gang becomes unschedulable again and its failed provisioning is requested again after the retry period.
+	pg = latestPodGroup()
+	pg.Status.Phase = scheduling.PodGroupInqueue
+	_, err = pc.vcClient.SchedulingV1beta1().PodGroups("default").Update(ctx, pg, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	setPodGroup(pg)
+	_, err = pc.sync("default/pg1")
+	require.NoError(t, err)
+	setRequestCondition("BookingExpired")
+	requeue, err = pc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Equal(t, provisioningRetryPeriod, requeue)
+	_, err = requests.Get(ctx, "pg1", metav1.GetOptions{})
+	assert.Error(t, err)
+	cond = util.GetPodGroupCondition(latestPodGroup(), scheduling.PodGroupWaitingForProvisioningType)
+	assert.Equal(t, v1.ConditionFalse, cond.Status)
+	assert.Equal(t, ProvisioningFailedReason, cond.Reason)
+	requeue, err = pc.sync("default/pg1")
+	require.NoError(t, err)
+	assert.Greater(t, requeue, provisioningCheckPeriod)
+	_, err = requests.Get(ctx, "pg1", metav1.GetOptions{})
+	assert.Error(t, err)
+}
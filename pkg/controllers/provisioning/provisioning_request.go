@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+const (
+	// podGroupLabelKey is the label of the ProvisioningRequests and PodTemplates naming the podgroup they are
+	// created for.
+	podGroupLabelKey = "volcano.sh/podgroup"
+
+	provisioningRequestKind = "ProvisioningRequest"
+)
+
+// provisioningRequestGVR is the resource of the ProvisioningRequests of the cluster autoscaler, served by its CRD.
+var provisioningRequestGVR = schema.GroupVersionResource{
+	Group:    "autoscaling.x-k8s.io",
+	Version:  "v1",
+	Resource: "provisioningrequests",
+}
+
+// provisioning states of a ProvisioningRequest, by its conditions
+const (
+	provisioningPending = iota
+	provisioningDone
+	provisioningFailed
+)
+
+// podSet is a group of pending pods of the same shape.
+type podSet struct {
+	template v1.PodTemplateSpec
+	// name is the name of the PodTemplate of the pod set, derived from the podgroup and the shape.
+	name  string
+	count int32
+}
+
+// buildPodSets groups the pending pods of the podgroup by the part of their spec the autoscaler simulates the
+// scheduling of, in the order of the names of their templates.
+func (pc *provisioningcontroller) buildPodSets(pg *scheduling.PodGroup) ([]*podSet, error) {
+	pods, err := pc.podLister.Pods(pg.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	podSets := map[string]*podSet{}
+	for _, pod := range pods {
+		if pod.Annotations[scheduling.KubeGroupNameAnnotationKey] != pg.Name || pod.DeletionTimestamp != nil ||
+			pod.Status.Phase != v1.PodPending || pod.Spec.NodeName != "" {
+			continue
+		}
+		template := podShape(pod)
+		data, err := json.Marshal(template.Spec)
+		if err != nil {
+			return nil, err
+		}
+		hash := fnv.New32a()
+		hash.Write(data)
+		name := fmt.Sprintf("%s-%08x", pg.Name, hash.Sum32())
+		if set, found := podSets[name]; found {
+			set.count++
+			continue
+		}
+		podSets[name] = &podSet{template: template, name: name, count: 1}
+	}
+
+	result := make([]*podSet, 0, len(podSets))
+	for _, set := range podSets {
+		result = append(result, set)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result, nil
+}
+
+// podShape is the template of the pod keeping what the autoscaler needs to simulate its scheduling: the requests of
+// its containers and its constraints of placement.
+func podShape(pod *v1.Pod) v1.PodTemplateSpec {
+	shapeContainers := func(containers []v1.Container) []v1.Container {
+		var result []v1.Container
+		for _, c := range containers {
+			result = append(result, v1.Container{
+				Name:          c.Name,
+				Image:         c.Image,
+				Resources:     c.Resources,
+				Ports:         c.Ports,
+				RestartPolicy: c.RestartPolicy,
+			})
+		}
+		return result
+	}
+	return v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			InitContainers:            shapeContainers(pod.Spec.InitContainers),
+			Containers:                shapeContainers(pod.Spec.Containers),
+			NodeSelector:              pod.Spec.NodeSelector,
+			Affinity:                  pod.Spec.Affinity,
+			Tolerations:               pod.Spec.Tolerations,
+			TopologySpreadConstraints: pod.Spec.TopologySpreadConstraints,
+			PriorityClassName:         pod.Spec.PriorityClassName,
+			RuntimeClassName:          pod.Spec.RuntimeClassName,
+			Overhead:                  pod.Spec.Overhead,
+			Resources:                 pod.Spec.Resources,
+		},
+	}
+}
+
+func (pc *provisioningcontroller) getProvisioningRequest(ns, name string) (*unstructured.Unstructured, error) {
+	request, err := pc.dynamicClient.Resource(provisioningRequestGVR).Namespace(ns).Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return request, err
+}
+
+// createProvisioningRequest creates the PodTemplates of the pod sets and the ProvisioningRequest referring to them,
+// named after the podgroup, which owns them all.
+func (pc *provisioningcontroller) createProvisioningRequest(pg *scheduling.PodGroup, podSets []*podSet) error {
+	owner := *metav1.NewControllerRef(pg, scheduling.SchemeGroupVersion.WithKind("PodGroup"))
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       pg.Namespace,
+			Labels:          map[string]string{podGroupLabelKey: pg.Name},
+			OwnerReferences: []metav1.OwnerReference{owner},
+		}
+	}
+
+	var specPodSets []interface{}
+	for _, set := range podSets {
+		template := &v1.PodTemplate{ObjectMeta: objectMeta(set.name), Template: set.template}
+		_, err := pc.kubeClient.CoreV1().PodTemplates(pg.Namespace).Create(context.TODO(), template, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		specPodSets = append(specPodSets, map[string]interface{}{
+			"podTemplateRef": map[string]interface{}{"name": set.name},
+			"count":          int64(set.count),
+		})
+	}
+
+	request := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"provisioningClassName": pc.provisioningClassName,
+			"podSets":               specPodSets,
+		},
+	}}
+	request.SetAPIVersion(provisioningRequestGVR.GroupVersion().String())
+	request.SetKind(provisioningRequestKind)
+	meta := objectMeta(pg.Name)
+	request.SetName(meta.Name)
+	request.SetNamespace(meta.Namespace)
+	request.SetLabels(meta.Labels)
+	request.SetOwnerReferences(meta.OwnerReferences)
+
+	_, err := pc.dynamicClient.Resource(provisioningRequestGVR).Namespace(pg.Namespace).Create(context.TODO(), request, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteProvisioningRequest deletes the ProvisioningRequest of the podgroup and its PodTemplates.
+func (pc *provisioningcontroller) deleteProvisioningRequest(ns, name string) error {
+	err := pc.dynamicClient.Resource(provisioningRequestGVR).Namespace(ns).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	selector := labels.SelectorFromSet(labels.Set{podGroupLabelKey: name}).String()
+	templates, err := pc.kubeClient.CoreV1().PodTemplates(ns).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	for _, template := range templates.Items {
+		err := pc.kubeClient.CoreV1().PodTemplates(ns).Delete(context.TODO(), template.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// provisioningState returns the state of the ProvisioningRequest by its conditions, and the message of the
+// condition of the failures. A request whose booked capacity expired or was revoked before the podgroup could be
+// scheduled has failed as well.
+func provisioningState(request *unstructured.Unstructured) (int, string) {
+	conditions, _, _ := unstructured.NestedSlice(request.Object, "status", "conditions")
+	state := provisioningPending
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["status"] != string(metav1.ConditionTrue) {
+			continue
+		}
+		switch cond["type"] {
+		case "Failed", "BookingExpired", "CapacityRevoked":
+			message, _ := cond["message"].(string)
+			return provisioningFailed, fmt.Sprintf("%v: %s", cond["type"], message)
+		case "Provisioned":
+			state = provisioningDone
+		}
+	}
+	return state, ""
+}
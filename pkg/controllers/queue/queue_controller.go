@@ -22,14 +22,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/spf13/pflag"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -74,11 +78,22 @@ type queuecontroller struct {
 	cmdLister   busv1alpha1lister.CommandLister
 	cmdSynced   cache.InformerSynced
 
+	nodeInformer coreinformers.NodeInformer
+	nodeLister   corelisters.NodeLister
+	nodeSynced   cache.InformerSynced
+
 	vcInformerFactory vcinformer.SharedInformerFactory
+	informerFactory   informers.SharedInformerFactory
 
 	// queues that need to be updated.
 	queue        workqueue.TypedRateLimitingInterface[*apis.Request]
 	commandQueue workqueue.TypedRateLimitingInterface[*busv1alpha1.Command]
+	// names of the queues whose quota schedules need to be applied.
+	scheduleQueue workqueue.TypedRateLimitingInterface[string]
+	// names of the queues whose deserved resources need to be synced with the nodes.
+	deservedQueue workqueue.TypedRateLimitingInterface[string]
+	// names of the queues whose deletion policy needs to be applied.
+	deletionQueue workqueue.TypedRateLimitingInterface[string]
 
 	pgMutex sync.RWMutex
 	// queue name -> podgroup namespace/name
@@ -92,12 +107,22 @@ type queuecontroller struct {
 	recorder      record.EventRecorder
 	workers       uint32
 	maxRequeueNum int
+
+	// syncRootQueueDeserved syncs the deserved resources of the root queue with all nodes, unless it selects them.
+	syncRootQueueDeserved bool
 }
 
 func (c *queuecontroller) Name() string {
 	return "queue-controller"
 }
 
+// AddFlags implements framework.FlagProvider.
+func (c *queuecontroller) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.syncRootQueueDeserved, "sync-root-queue-deserved", false,
+		"Keep the deserved resources of the root queue at the allocatable resources of all ready and schedulable nodes, "+
+			"unless it selects the nodes with the volcano.sh/deserved-node-selector annotation")
+}
+
 // Initialize creates  QueueController from option.
 func (c *queuecontroller) Initialize(opt *framework.ControllerOption) error {
 	c.vcClient = opt.VolcanoClient
@@ -112,6 +137,10 @@ func (c *queuecontroller) Initialize(opt *framework.ControllerOption) error {
 	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: c.kubeClient.CoreV1().Events("")})
 
 	c.vcInformerFactory = factory
+	c.informerFactory = opt.SharedInformerFactory
+	c.nodeInformer = c.informerFactory.Core().V1().Nodes()
+	c.nodeLister = c.nodeInformer.Lister()
+	c.nodeSynced = c.nodeInformer.Informer().HasSynced
 	c.queueInformer = queueInformer
 	c.pgInformer = pgInformer
 	c.queueLister = queueInformer.Lister()
@@ -120,6 +149,9 @@ func (c *queuecontroller) Initialize(opt *framework.ControllerOption) error {
 	c.pgSynced = pgInformer.Informer().HasSynced
 	c.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[*apis.Request]())
 	c.commandQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[*busv1alpha1.Command]())
+	c.scheduleQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	c.deservedQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	c.deletionQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
 	c.podGroups = make(map[string]map[string]struct{})
 	c.recorder = eventBroadcaster.NewRecorder(versionedscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
 	c.maxRequeueNum = opt.MaxRequeueNum
@@ -140,6 +172,12 @@ func (c *queuecontroller) Initialize(opt *framework.ControllerOption) error {
 		DeleteFunc: c.deletePodGroup,
 	})
 
+	c.nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addNode,
+		UpdateFunc: c.updateNode,
+		DeleteFunc: c.deleteNode,
+	})
+
 	if utilfeature.DefaultFeatureGate.Enabled(features.QueueCommandSync) {
 		c.cmdInformer = factory.Bus().V1alpha1().Commands()
 		c.cmdInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
@@ -162,6 +200,7 @@ func (c *queuecontroller) Initialize(opt *framework.ControllerOption) error {
 	queuestate.SyncQueue = c.syncQueue
 	queuestate.OpenQueue = c.openQueue
 	queuestate.CloseQueue = c.closeQueue
+	queuestate.DrainQueue = c.drainQueue
 
 	c.syncHandler = c.handleQueue
 	c.syncCommandHandler = c.handleCommand
@@ -176,6 +215,9 @@ func (c *queuecontroller) Run(stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 	defer c.queue.ShutDown()
 	defer c.commandQueue.ShutDown()
+	defer c.scheduleQueue.ShutDown()
+	defer c.deservedQueue.ShutDown()
+	defer c.deletionQueue.ShutDown()
 
 	klog.Infof("Starting queue controller.")
 	defer klog.Infof("Shutting down queue controller.")
@@ -188,11 +230,21 @@ func (c *queuecontroller) Run(stopCh <-chan struct{}) {
 			return
 		}
 	}
+	c.informerFactory.Start(stopCh)
+	for informerType, ok := range c.informerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.Errorf("caches failed to sync: %v", informerType)
+			return
+		}
+	}
 
 	for i := 0; i < int(c.workers); i++ {
 		go wait.Until(c.worker, 0, stopCh)
 		go wait.Until(c.commandWorker, 0, stopCh)
 	}
+	go wait.Until(c.scheduleWorker, 0, stopCh)
+	go wait.Until(c.deservedWorker, 0, stopCh)
+	go wait.Until(c.deletionWorker, 0, stopCh)
 
 	<-stopCh
 }
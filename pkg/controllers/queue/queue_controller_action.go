@@ -138,7 +138,12 @@ func (c *queuecontroller) openQueue(queue *schedulingv1beta1.Queue, updateStateF
 		}
 	}
 
-	_, err := c.updateQueueAnnotation(queue, ClosedByParentAnnotationKey, ClosedByParentAnnotationFalseValue)
+	queue, err := c.updateQueueAnnotation(queue, ClosedByParentAnnotationKey, ClosedByParentAnnotationFalseValue)
+	if err != nil {
+		return err
+	}
+	// the drain deadline of the queue does not apply to the next drain
+	_, err = c.removeQueueAnnotation(queue, schedulingv1beta1.DrainDeadlineAnnotationKey)
 	return err
 }
 
@@ -202,8 +207,13 @@ func (c *queuecontroller) syncHierarchicalQueue(queue *schedulingv1beta1.Queue)
 			c.enqueue(req)
 			klog.V(3).Infof("Closing queue %s because its parent queue %s is closing or closed.", queue.Name, parentQueue.Name)
 		}
+	case schedulingv1beta1.QueueStateDraining:
+		// consider the case where the open queue is updated and the parent queue is in the draining state.
+		if !isQueueStopping(queue.Status.State) {
+			return c.drainChildQueue(queue, parentQueue)
+		}
 	case schedulingv1beta1.QueueStateOpen:
-		if queue.Status.State == schedulingv1beta1.QueueStateClosed || queue.Status.State == schedulingv1beta1.QueueStateClosing {
+		if isQueueStopping(queue.Status.State) {
 			// consider the scenario where the parent queue of a queue, which has transitioned to a closed or closing state due to the closure of its parent queue, is updated, and the state of the updated parent queue is open.
 			if queue.Annotations[ClosedByParentAnnotationKey] == ClosedByParentAnnotationTrueValue {
 				req := &apis.Request{
@@ -226,9 +236,9 @@ func (c *queuecontroller) openHierarchicalQueue(queue *schedulingv1beta1.Queue)
 		if err != nil {
 			return fmt.Errorf("Failed to get parent queue %s of queue %s: %v", queue.Spec.Parent, queue.Name, err)
 		}
-		if parentQueue.Status.State == schedulingv1beta1.QueueStateClosing || parentQueue.Status.State == schedulingv1beta1.QueueStateClosed {
+		if isQueueStopping(parentQueue.Status.State) {
 			// the parent queue may be being opened, and it may take a few attempts to open the child queue.
-			return fmt.Errorf("Failed to open queue %s because its parent queue %s is closing or closed. Open the parent queue first.", queue.Name, queue.Spec.Parent)
+			return fmt.Errorf("Failed to open queue %s because its parent queue %s is closing, closed or draining. Open the parent queue first.", queue.Name, queue.Spec.Parent)
 		}
 	}
 
@@ -334,3 +344,23 @@ func (c *queuecontroller) updateQueueAnnotation(queue *schedulingv1beta1.Queue,
 
 	return c.vcClient.SchedulingV1beta1().Queues().Patch(context.TODO(), queue.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
 }
+
+func (c *queuecontroller) removeQueueAnnotation(queue *schedulingv1beta1.Queue, key string) (*schedulingv1beta1.Queue, error) {
+	if _, found := queue.Annotations[key]; !found {
+		return queue, nil
+	}
+
+	patch := []patchOperation{
+		{
+			Op:   "remove",
+			Path: fmt.Sprintf("/metadata/annotations/%s", strings.ReplaceAll(key, "/", "~1")),
+		},
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.vcClient.SchedulingV1beta1().Queues().Patch(context.TODO(), queue.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+const (
+	// queueDeletionFinalizer keeps a deleted queue until its jobs are deleted or moved to its fallback queue, as set by
+	// its deletion policy.
+	queueDeletionFinalizer = "volcano.sh/queue-deletion-policy"
+
+	// QueueDeletedReason is the reason of the event when the jobs of a deleted queue are deleted or moved.
+	QueueDeletedReason = "QueueDeleted"
+)
+
+// needsDeletionFinalizer returns whether the jobs of the queue are deleted or moved by the controller when it is
+// deleted. The Forbid policy is enforced by the admission webhook only.
+func needsDeletionFinalizer(queue *schedulingv1beta1.Queue) bool {
+	policy := queue.Spec.DeletionPolicy
+	return policy != nil && (policy.Type == schedulingv1beta1.QueueDeletionCascade || policy.Type == schedulingv1beta1.QueueDeletionOrphan)
+}
+
+// enqueueDeletion enqueues the queue if its deletion finalizer needs to be added, removed or run.
+func (c *queuecontroller) enqueueDeletion(queue *schedulingv1beta1.Queue) {
+	if !needsDeletionFinalizer(queue) && !slices.Contains(queue.Finalizers, queueDeletionFinalizer) {
+		return
+	}
+	c.deletionQueue.Add(queue.Name)
+}
+
+func (c *queuecontroller) deletionWorker() {
+	for c.processNextDeletion() {
+	}
+}
+
+func (c *queuecontroller) processNextDeletion() bool {
+	name, shutdown := c.deletionQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.deletionQueue.Done(name)
+
+	if err := c.syncDeletion(name); err != nil {
+		if c.maxRequeueNum == -1 || c.deletionQueue.NumRequeues(name) < c.maxRequeueNum {
+			klog.V(4).Infof("Error syncing deletion policy of queue %s for %v.", name, err)
+			c.deletionQueue.AddRateLimited(name)
+			return true
+		}
+		c.recordEventsForQueue(name, v1.EventTypeWarning, "DeletionPolicyFailed",
+			fmt.Sprintf("apply deletion policy failed for %v", err))
+		klog.V(2).Infof("Dropping deletion policy of queue %s out of the queue for %v.", name, err)
+	}
+	c.deletionQueue.Forget(name)
+	return true
+}
+
+// syncDeletion keeps the deletion finalizer on the queues whose jobs are deleted or moved along with them. Once such a
+// queue is deleted, its jobs are deleted with the Cascade policy, or moved to its fallback queue with the Orphan
+// policy, before its finalizer is removed.
+func (c *queuecontroller) syncDeletion(name string) error {
+	queue, err := c.queueLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	hasFinalizer := slices.Contains(queue.Finalizers, queueDeletionFinalizer)
+	if queue.DeletionTimestamp == nil {
+		if needsDeletionFinalizer(queue) == hasFinalizer {
+			return nil
+		}
+		queue = queue.DeepCopy()
+		if hasFinalizer {
+			queue.Finalizers = slices.DeleteFunc(queue.Finalizers, func(finalizer string) bool {
+				return finalizer == queueDeletionFinalizer
+			})
+		} else {
+			queue.Finalizers = append(queue.Finalizers, queueDeletionFinalizer)
+		}
+		_, err = c.vcClient.SchedulingV1beta1().Queues().Update(context.TODO(), queue, metav1.UpdateOptions{})
+		return err
+	}
+	if !hasFinalizer {
+		return nil
+	}
+
+	// The jobs are left in place if the policy was removed since the queue was deleted.
+	if policy := queue.Spec.DeletionPolicy; needsDeletionFinalizer(queue) {
+		count := 0
+		for _, pgKey := range c.getPodGroups(queue.Name) {
+			// Ignore error here, tt can not occur.
+			ns, pgName, _ := cache.SplitMetaNamespaceKey(pgKey)
+
+			pg, err := c.pgLister.PodGroups(ns).Get(pgName)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if pg.Spec.Queue != queue.Name {
+				continue
+			}
+			if policy.Type == schedulingv1beta1.QueueDeletionCascade {
+				err = c.cascadePodGroup(pg)
+			} else {
+				err = c.orphanPodGroup(pg, policy.FallbackQueue)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to apply %s deletion policy to podgroup %s/%s: %v", policy.Type, pg.Namespace, pg.Name, err)
+			}
+			count++
+		}
+		if count > 0 {
+			c.recorder.Event(queue, v1.EventTypeNormal, QueueDeletedReason,
+				fmt.Sprintf("Applied %s deletion policy to %d jobs", policy.Type, count))
+		}
+	}
+
+	queue = queue.DeepCopy()
+	queue.Finalizers = slices.DeleteFunc(queue.Finalizers, func(finalizer string) bool {
+		return finalizer == queueDeletionFinalizer
+	})
+	_, err = c.vcClient.SchedulingV1beta1().Queues().Update(context.TODO(), queue, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// cascadePodGroup deletes the job of the podgroup: the volcano job owning it, or the podgroup and its pods otherwise.
+func (c *queuecontroller) cascadePodGroup(pg *schedulingv1beta1.PodGroup) error {
+	if jobName, found := jobOfPodGroup(pg); found {
+		propagation := metav1.DeletePropagationBackground
+		err := c.vcClient.BatchV1alpha1().Jobs(pg.Namespace).Delete(context.TODO(), jobName, metav1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		klog.V(3).Infof("Deleted job %s/%s along with its queue %s.", pg.Namespace, jobName, pg.Spec.Queue)
+		return nil
+	}
+
+	if err := c.deletePodGroupPods(pg, true); err != nil {
+		return err
+	}
+	err := c.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Delete(context.TODO(), pg.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	klog.V(3).Infof("Deleted podgroup %s/%s along with its queue %s.", pg.Namespace, pg.Name, pg.Spec.Queue)
+	return nil
+}
+
+// orphanPodGroup moves the job of the podgroup to the fallback queue: the volcano job owning it, and the podgroup
+// itself, as the job controller does not update the queue of the podgroups it created.
+func (c *queuecontroller) orphanPodGroup(pg *schedulingv1beta1.PodGroup, fallbackQueue string) error {
+	if jobName, found := jobOfPodGroup(pg); found {
+		job, err := c.vcClient.BatchV1alpha1().Jobs(pg.Namespace).Get(context.TODO(), jobName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil && job.Spec.Queue != fallbackQueue {
+			job.Spec.Queue = fallbackQueue
+			if _, err := c.vcClient.BatchV1alpha1().Jobs(pg.Namespace).Update(context.TODO(), job, metav1.UpdateOptions{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"queue":%q}}`, fallbackQueue))
+	_, err := c.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Patch(context.TODO(), pg.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	klog.V(3).Infof("Moved podgroup %s/%s of deleted queue %s to queue %s.", pg.Namespace, pg.Name, pg.Spec.Queue, fallbackQueue)
+	return nil
+}
+
+// jobOfPodGroup returns the name of the volcano job owning the podgroup, if any.
+func jobOfPodGroup(pg *schedulingv1beta1.PodGroup) (string, bool) {
+	ref := metav1.GetControllerOf(pg)
+	if ref == nil || ref.APIVersion != helpers.JobKind.GroupVersion().String() || ref.Kind != helpers.JobKind.Kind {
+		return "", false
+	}
+	return ref.Name, true
+}
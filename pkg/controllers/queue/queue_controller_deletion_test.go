@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestSyncDeletionFinalizer(t *testing.T) {
+	testCases := []struct {
+		name            string
+		policy          *schedulingv1beta1.QueueDeletionPolicy
+		finalizers      []string
+		expectFinalizer bool
+	}{
+		{
+			name: "queue without deletion policy",
+		},
+		{
+			name:   "forbid policy is enforced by the webhook",
+			policy: &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionForbid},
+		},
+		{
+			name:            "finalizer is added with the cascade policy",
+			policy:          &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionCascade},
+			expectFinalizer: true,
+		},
+		{
+			name:            "finalizer is added with the orphan policy",
+			policy:          &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan, FallbackQueue: "default"},
+			expectFinalizer: true,
+		},
+		{
+			name:       "finalizer is removed along with the policy",
+			finalizers: []string{queueDeletionFinalizer},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeController()
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "q1", Finalizers: tc.finalizers},
+				Spec:       schedulingv1beta1.QueueSpec{DeletionPolicy: tc.policy},
+			}
+			_, err := c.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), queue, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(queue))
+
+			assert.NoError(t, c.syncDeletion(queue.Name))
+
+			updated, err := c.vcClient.SchedulingV1beta1().Queues().Get(context.TODO(), queue.Name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectFinalizer, len(updated.Finalizers) == 1 && updated.Finalizers[0] == queueDeletionFinalizer)
+		})
+	}
+}
+
+func TestSyncDeletion(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         *schedulingv1beta1.QueueDeletionPolicy
+		expectJobQueue string
+		expectPGQueue  map[string]string
+		expectPods     int
+	}{
+		{
+			name:           "jobs are deleted with the cascade policy",
+			policy:         &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionCascade},
+			expectPGQueue:  map[string]string{"job1-pg": "q1"},
+			expectPods:     0,
+			expectJobQueue: "",
+		},
+		{
+			name:           "jobs are moved to the fallback queue with the orphan policy",
+			policy:         &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan, FallbackQueue: "fallback"},
+			expectPGQueue:  map[string]string{"job1-pg": "fallback", "pg1": "fallback"},
+			expectPods:     1,
+			expectJobQueue: "fallback",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeController()
+			now := metav1.Now()
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "q1", Finalizers: []string{queueDeletionFinalizer}, DeletionTimestamp: &now},
+				Spec:       schedulingv1beta1.QueueSpec{DeletionPolicy: tc.policy},
+			}
+			_, err := c.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), queue, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(queue))
+
+			job := &batchv1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "ns1"},
+				Spec:       batchv1alpha1.JobSpec{Queue: "q1"},
+			}
+			_, err = c.vcClient.BatchV1alpha1().Jobs("ns1").Create(context.TODO(), job, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			for _, pg := range []*schedulingv1beta1.PodGroup{
+				buildDrainPodGroup("job1-pg", "q1", schedulingv1beta1.PodGroupRunning, "job1"),
+				buildDrainPodGroup("pg1", "q1", schedulingv1beta1.PodGroupCompleted, ""),
+			} {
+				_, err = c.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Create(context.TODO(), pg, metav1.CreateOptions{})
+				assert.NoError(t, err)
+				assert.NoError(t, c.pgInformer.Informer().GetIndexer().Add(pg))
+				c.addPodGroup(pg)
+			}
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pg1-0",
+					Namespace:   "ns1",
+					Annotations: map[string]string{schedulingv1beta1.KubeGroupNameAnnotationKey: "pg1"},
+				},
+				Status: v1.PodStatus{Phase: v1.PodSucceeded},
+			}
+			_, err = c.kubeClient.CoreV1().Pods("ns1").Create(context.TODO(), pod, metav1.CreateOptions{})
+			assert.NoError(t, err)
+
+			assert.NoError(t, c.syncDeletion(queue.Name))
+
+			updated, err := c.vcClient.SchedulingV1beta1().Queues().Get(context.TODO(), queue.Name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Empty(t, updated.Finalizers)
+
+			updatedJob, err := c.vcClient.BatchV1alpha1().Jobs("ns1").Get(context.TODO(), job.Name, metav1.GetOptions{})
+			if tc.expectJobQueue == "" {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectJobQueue, updatedJob.Spec.Queue)
+			}
+
+			pgs, err := c.vcClient.SchedulingV1beta1().PodGroups("ns1").List(context.TODO(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			pgQueues := map[string]string{}
+			for _, pg := range pgs.Items {
+				pgQueues[pg.Name] = pg.Spec.Queue
+			}
+			assert.Equal(t, tc.expectPGQueue, pgQueues)
+
+			pods, err := c.kubeClient.CoreV1().Pods("ns1").List(context.TODO(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectPods, len(pods.Items))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// deservedNodeSelector returns the label selector of the nodes the deserved resources of the queue are synced with,
+// and whether they are synced: the selector of its annotation, or all nodes for the root queue if
+// --sync-root-queue-deserved is set.
+func (c *queuecontroller) deservedNodeSelector(queue *schedulingv1beta1.Queue) (labels.Selector, bool, error) {
+	value, found := queue.Annotations[schedulingv1beta1.DeservedNodeSelectorAnnotationKey]
+	if !found {
+		return labels.Everything(), c.syncRootQueueDeserved && queue.Name == "root", nil
+	}
+	selector, err := labels.Parse(value)
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid %s annotation of queue %s: %v",
+			schedulingv1beta1.DeservedNodeSelectorAnnotationKey, queue.Name, err)
+	}
+	return selector, true, nil
+}
+
+// enqueueDeserved enqueues the queue if its deserved resources are synced with the nodes.
+func (c *queuecontroller) enqueueDeserved(queue *schedulingv1beta1.Queue) {
+	if _, synced, _ := c.deservedNodeSelector(queue); synced {
+		c.deservedQueue.Add(queue.Name)
+	}
+}
+
+// enqueueAllDeserved enqueues every queue whose deserved resources are synced with the nodes.
+func (c *queuecontroller) enqueueAllDeserved() {
+	queues, err := c.queueLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list queues to sync their deserved resources: %v", err)
+		return
+	}
+	for _, queue := range queues {
+		c.enqueueDeserved(queue)
+	}
+}
+
+func (c *queuecontroller) addNode(obj interface{}) {
+	c.enqueueAllDeserved()
+}
+
+func (c *queuecontroller) updateNode(oldObj, newObj interface{}) {
+	oldNode, ok := oldObj.(*v1.Node)
+	if !ok {
+		return
+	}
+	newNode, ok := newObj.(*v1.Node)
+	if !ok {
+		return
+	}
+	if nodeCounted(oldNode) == nodeCounted(newNode) &&
+		equality.Semantic.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable) &&
+		equality.Semantic.DeepEqual(oldNode.Labels, newNode.Labels) {
+		return
+	}
+	c.enqueueAllDeserved()
+}
+
+func (c *queuecontroller) deleteNode(obj interface{}) {
+	if _, ok := obj.(*v1.Node); !ok {
+		if _, ok := obj.(cache.DeletedFinalStateUnknown); !ok {
+			klog.Errorf("Couldn't get object from tombstone %#v.", obj)
+			return
+		}
+	}
+	c.enqueueAllDeserved()
+}
+
+func (c *queuecontroller) deservedWorker() {
+	for c.processNextDeserved() {
+	}
+}
+
+func (c *queuecontroller) processNextDeserved() bool {
+	name, shutdown := c.deservedQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.deservedQueue.Done(name)
+
+	if err := c.syncDeserved(name); err != nil {
+		if c.maxRequeueNum == -1 || c.deservedQueue.NumRequeues(name) < c.maxRequeueNum {
+			klog.V(4).Infof("Error syncing deserved resources of queue %s for %v.", name, err)
+			c.deservedQueue.AddRateLimited(name)
+			return true
+		}
+		c.recordEventsForQueue(name, v1.EventTypeWarning, "DeservedSyncFailed",
+			fmt.Sprintf("sync deserved resources with the nodes failed for %v", err))
+		klog.V(2).Infof("Dropping deserved resources of queue %s out of the queue for %v.", name, err)
+	}
+	c.deservedQueue.Forget(name)
+	return true
+}
+
+// syncDeserved sets the deserved resources of the queue to the allocatable resources of the ready and schedulable
+// nodes selected by its deserved node selector. The queues whose quota schedules apply are left to them.
+func (c *queuecontroller) syncDeserved(name string) error {
+	queue, err := c.queueLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("get queue %s failed for %v", name, err)
+	}
+	selector, synced, err := c.deservedNodeSelector(queue)
+	if !synced {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if window := queue.Annotations[schedulingv1beta1.ActiveQuotaScheduleAnnotationKey]; window != "" {
+		klog.V(4).Infof("Skip syncing deserved resources of queue %s within quota schedule %s.", name, window)
+		return nil
+	}
+
+	nodes, err := c.nodeLister.List(selector)
+	if err != nil {
+		return fmt.Errorf("list nodes failed for %v", err)
+	}
+	deserved := nodesAllocatable(nodes)
+	if equality.Semantic.DeepEqual(queue.Spec.Deserved, deserved) {
+		return nil
+	}
+
+	newQueue := queue.DeepCopy()
+	newQueue.Spec.Deserved = deserved
+	if _, err := c.vcClient.SchedulingV1beta1().Queues().Update(context.TODO(), newQueue, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update queue %s failed for %v", name, err)
+	}
+	message := fmt.Sprintf("Synced the deserved resources with %d nodes: %v", len(nodes), deserved)
+	klog.V(3).Infof("Queue %s: %s.", name, message)
+	c.recorder.Event(newQueue, v1.EventTypeNormal, "DeservedSynced", message)
+	return nil
+}
+
+// nodesAllocatable returns the sum of the allocatable resources of the ready and schedulable nodes, but the pods and
+// the ephemeral storage.
+func nodesAllocatable(nodes []*v1.Node) v1.ResourceList {
+	total := v1.ResourceList{}
+	for _, node := range nodes {
+		if !nodeCounted(node) {
+			continue
+		}
+		for name, quantity := range node.Status.Allocatable {
+			if name == v1.ResourcePods || name == v1.ResourceEphemeralStorage {
+				continue
+			}
+			sum := total[name]
+			sum.Add(quantity)
+			total[name] = sum
+		}
+	}
+	return total
+}
+
+// nodeCounted returns whether the allocatable resources of the node are counted in the deserved resources of the
+// queues: whether it is ready and schedulable.
+func nodeCounted(node *v1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
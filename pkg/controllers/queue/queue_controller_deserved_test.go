@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func buildDeservedNode(name, pool, cpu string, ready, unschedulable bool) *v1.Node {
+	status := v1.ConditionTrue
+	if !ready {
+		status = v1.ConditionFalse
+	}
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": pool}},
+		Spec:       v1.NodeSpec{Unschedulable: unschedulable},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse("8Gi"),
+				v1.ResourcePods:   resource.MustParse("110"),
+			},
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: status}},
+		},
+	}
+}
+
+func TestSyncDeserved(t *testing.T) {
+	testCases := []struct {
+		name           string
+		syncRoot       bool
+		queue          *schedulingv1beta1.Queue
+		expectDeserved v1.ResourceList
+	}{
+		{
+			name:     "root queue follows all ready and schedulable nodes",
+			syncRoot: true,
+			queue:    &schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "root"}},
+			expectDeserved: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("12"),
+				v1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		},
+		{
+			name:  "root queue is not synced without the flag",
+			queue: &schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "root"}},
+		},
+		{
+			name: "queue follows the nodes it selects",
+			queue: &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "gpu",
+					Annotations: map[string]string{schedulingv1beta1.DeservedNodeSelectorAnnotationKey: "pool=gpu"},
+				},
+				Spec: schedulingv1beta1.QueueSpec{
+					Parent:   "root",
+					Deserved: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+			expectDeserved: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("8"),
+				v1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+		{
+			name: "queue within a quota schedule is left to it",
+			queue: &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "gpu",
+					Annotations: map[string]string{
+						schedulingv1beta1.DeservedNodeSelectorAnnotationKey: "pool=gpu",
+						schedulingv1beta1.ActiveQuotaScheduleAnnotationKey:  "night",
+					},
+				},
+				Spec: schedulingv1beta1.QueueSpec{
+					Parent:   "root",
+					Deserved: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+				},
+			},
+			expectDeserved: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+
+	nodes := []*v1.Node{
+		buildDeservedNode("n1", "cpu", "4", true, false),
+		buildDeservedNode("n2", "gpu", "8", true, false),
+		buildDeservedNode("n3", "gpu", "8", false, false),
+		buildDeservedNode("n4", "cpu", "4", true, true),
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeController()
+			c.syncRootQueueDeserved = tc.syncRoot
+			for _, node := range nodes {
+				assert.NoError(t, c.nodeInformer.Informer().GetIndexer().Add(node))
+			}
+			_, err := c.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), tc.queue, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(tc.queue))
+
+			assert.NoError(t, c.syncDeserved(tc.queue.Name))
+			updated, err := c.vcClient.SchedulingV1beta1().Queues().Get(context.TODO(), tc.queue.Name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.expectDeserved), len(updated.Spec.Deserved))
+			for name, expect := range tc.expectDeserved {
+				actual := updated.Spec.Deserved[name]
+				assert.True(t, expect.Equal(actual), "expected %s %v, got %v", name, expect.String(), actual.String())
+			}
+		})
+	}
+}
+
+func TestUpdateNodeEnqueuesDeserved(t *testing.T) {
+	c := newFakeController()
+	c.syncRootQueueDeserved = true
+	assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(&schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "root"}}))
+	assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(&schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+
+	node := buildDeservedNode("n1", "cpu", "4", true, false)
+	heartbeat := node.DeepCopy()
+	heartbeat.ResourceVersion = "2"
+	c.updateNode(node, heartbeat)
+	assert.Equal(t, 0, c.deservedQueue.Len())
+
+	cordoned := node.DeepCopy()
+	cordoned.Spec.Unschedulable = true
+	c.updateNode(node, cordoned)
+	assert.Equal(t, 1, c.deservedQueue.Len())
+	name, _ := c.deservedQueue.Get()
+	assert.Equal(t, "root", name)
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	"volcano.sh/apis/pkg/apis/helpers"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	v1beta1apply "volcano.sh/apis/pkg/client/applyconfiguration/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/controllers/apis"
+	"volcano.sh/volcano/pkg/controllers/queue/state"
+)
+
+// drainQueue sets the state of the queue to draining: no job is admitted to it anymore, and the jobs already admitted
+// are left to finish until the drain deadline of the queue, after which they are evicted. The queue is closed once no
+// job admitted is left. Its child queues are drained along with it.
+func (c *queuecontroller) drainQueue(queue *schedulingv1beta1.Queue, updateStateFn state.UpdateQueueStatusFn) error {
+	klog.V(4).Infof("Begin to drain queue %s.", queue.Name)
+
+	if queue.Name == "root" {
+		klog.Errorf("Root queue cannot be drained")
+		return nil
+	}
+	if !isQueueStopping(queue.Status.State) {
+		if err := c.drainHierarchicalQueue(queue); err != nil {
+			return err
+		}
+	}
+
+	admitted, err := c.admittedPodGroups(queue.Name)
+	if err != nil {
+		return err
+	}
+	if len(admitted) != 0 {
+		if deadline, found := drainDeadline(queue); found {
+			if now := time.Now(); now.Before(deadline) {
+				c.queue.AddAfter(&apis.Request{QueueName: queue.Name, Action: busv1alpha1.SyncQueueAction}, deadline.Sub(now))
+			} else {
+				for _, pg := range admitted {
+					if err := c.evictPodGroup(pg); err != nil {
+						return fmt.Errorf("failed to evict podgroup %s/%s: %v", pg.Namespace, pg.Name, err)
+					}
+				}
+				c.recorder.Event(queue, v1.EventTypeNormal, string(busv1alpha1.DrainQueueAction),
+					fmt.Sprintf("Evicted %d jobs still running after the drain deadline", len(admitted)))
+				admitted = nil
+			}
+		}
+	}
+
+	podGroups := make([]string, 0, len(admitted))
+	for _, pg := range admitted {
+		key, _ := cache.MetaNamespaceKeyFunc(pg)
+		podGroups = append(podGroups, key)
+	}
+	newQueue := queue.DeepCopy()
+	if updateStateFn != nil {
+		updateStateFn(&newQueue.Status, podGroups)
+	}
+
+	if queue.Status.State != newQueue.Status.State {
+		queueStatusApply := v1beta1apply.QueueStatus().WithState(newQueue.Status.State)
+		queueApply := v1beta1apply.Queue(queue.Name).WithStatus(queueStatusApply)
+		if _, err := c.vcClient.SchedulingV1beta1().Queues().ApplyStatus(context.TODO(), queueApply, metav1.ApplyOptions{FieldManager: controllerName}); err != nil {
+			c.recorder.Event(newQueue, v1.EventTypeWarning, string(busv1alpha1.DrainQueueAction),
+				fmt.Sprintf("Drain queue failed for %v", err))
+			return err
+		}
+		c.recorder.Event(newQueue, v1.EventTypeNormal, string(busv1alpha1.DrainQueueAction),
+			fmt.Sprintf("Queue is %s", strings.ToLower(string(newQueue.Status.State))))
+	}
+
+	return nil
+}
+
+// isQueueStopping returns whether a queue in the state admits no job anymore.
+func isQueueStopping(queueState schedulingv1beta1.QueueState) bool {
+	return queueState == schedulingv1beta1.QueueStateClosed || queueState == schedulingv1beta1.QueueStateClosing ||
+		queueState == schedulingv1beta1.QueueStateDraining
+}
+
+// drainHierarchicalQueue drains the child queues of the queue which still admit jobs, with the drain deadline of the
+// queue.
+func (c *queuecontroller) drainHierarchicalQueue(queue *schedulingv1beta1.Queue) error {
+	queueList, err := c.queueLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	for _, childQueue := range queueList {
+		if childQueue.Spec.Parent != queue.Name || isQueueStopping(childQueue.Status.State) {
+			continue
+		}
+		if err := c.drainChildQueue(childQueue, queue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drainChildQueue drains the child queue of a draining queue, with the drain deadline of the parent queue.
+func (c *queuecontroller) drainChildQueue(childQueue, queue *schedulingv1beta1.Queue) error {
+	childQueue, err := c.updateQueueAnnotation(childQueue, ClosedByParentAnnotationKey, ClosedByParentAnnotationTrueValue)
+	if err != nil {
+		return fmt.Errorf("Failed to update annotations of queue %s: %v", childQueue.Name, err)
+	}
+	if deadline, found := queue.Annotations[schedulingv1beta1.DrainDeadlineAnnotationKey]; found {
+		if _, err = c.updateQueueAnnotation(childQueue, schedulingv1beta1.DrainDeadlineAnnotationKey, deadline); err != nil {
+			return fmt.Errorf("Failed to update annotations of queue %s: %v", childQueue.Name, err)
+		}
+	}
+
+	c.enqueue(&apis.Request{
+		QueueName: childQueue.Name,
+		Action:    busv1alpha1.DrainQueueAction,
+	})
+	klog.V(3).Infof("Draining child queue %s because its parent queue %s is draining.", childQueue.Name, queue.Name)
+	return nil
+}
+
+// drainDeadline returns the drain deadline of the queue, and whether it is set.
+func drainDeadline(queue *schedulingv1beta1.Queue) (time.Time, bool) {
+	value, found := queue.Annotations[schedulingv1beta1.DrainDeadlineAnnotationKey]
+	if !found {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.Warningf("Invalid drain deadline %q of queue %s, its jobs are left to finish: %v", value, queue.Name, err)
+		return time.Time{}, false
+	}
+	return deadline, true
+}
+
+// admittedPodGroups returns the podgroups of the queue which were admitted and did not finish yet.
+func (c *queuecontroller) admittedPodGroups(queueName string) ([]*schedulingv1beta1.PodGroup, error) {
+	var admitted []*schedulingv1beta1.PodGroup
+	for _, pgKey := range c.getPodGroups(queueName) {
+		// Ignore error here, tt can not occur.
+		ns, name, _ := cache.SplitMetaNamespaceKey(pgKey)
+
+		pg, err := c.pgLister.PodGroups(ns).Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		switch pg.Status.Phase {
+		case schedulingv1beta1.PodGroupInqueue, schedulingv1beta1.PodGroupRunning, schedulingv1beta1.PodGroupUnknown:
+			admitted = append(admitted, pg)
+		}
+	}
+	return admitted, nil
+}
+
+// evictPodGroup evicts the job of the podgroup: a volcano job is aborted, the pods of the other podgroups are deleted.
+func (c *queuecontroller) evictPodGroup(pg *schedulingv1beta1.PodGroup) error {
+	if ref := metav1.GetControllerOf(pg); ref != nil && ref.APIVersion == helpers.JobKind.GroupVersion().String() && ref.Kind == helpers.JobKind.Kind {
+		cmd := &busv1alpha1.Command{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("%s-%s", ref.Name, strings.ToLower(string(busv1alpha1.AbortJobAction))),
+				Namespace:       pg.Namespace,
+				OwnerReferences: []metav1.OwnerReference{*ref},
+			},
+			TargetObject: ref,
+			Action:       string(busv1alpha1.AbortJobAction),
+			Reason:       "QueueDrained",
+			Message:      fmt.Sprintf("Job was still running after the drain deadline of queue %s", pg.Spec.Queue),
+		}
+		if _, err := c.vcClient.BusV1alpha1().Commands(pg.Namespace).Create(context.TODO(), cmd, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		klog.V(3).Infof("Aborting job %s/%s after the drain deadline of queue %s.", pg.Namespace, ref.Name, pg.Spec.Queue)
+		return nil
+	}
+
+	return c.deletePodGroupPods(pg, false)
+}
+
+// deletePodGroupPods deletes the pods of the podgroup, terminated pods included if all is set.
+func (c *queuecontroller) deletePodGroupPods(pg *schedulingv1beta1.PodGroup, all bool) error {
+	pods, err := c.kubeClient.CoreV1().Pods(pg.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		if pod.Annotations[schedulingv1beta1.KubeGroupNameAnnotationKey] != pg.Name ||
+			(!all && (pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed)) {
+			continue
+		}
+		if err := c.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		klog.V(3).Infof("Deleted pod %s/%s of podgroup %s in queue %s.", pod.Namespace, pod.Name, pg.Name, pg.Spec.Queue)
+	}
+	return nil
+}
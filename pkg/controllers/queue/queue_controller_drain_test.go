@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	"volcano.sh/apis/pkg/apis/helpers"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func buildDrainPodGroup(name, queue string, phase schedulingv1beta1.PodGroupPhase, ownerJob string) *schedulingv1beta1.PodGroup {
+	pg := &schedulingv1beta1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+		Spec:       schedulingv1beta1.PodGroupSpec{Queue: queue},
+		Status:     schedulingv1beta1.PodGroupStatus{Phase: phase},
+	}
+	if ownerJob != "" {
+		controller := true
+		pg.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: helpers.JobKind.GroupVersion().String(),
+			Kind:       helpers.JobKind.Kind,
+			Name:       ownerJob,
+			Controller: &controller,
+		}}
+	}
+	return pg
+}
+
+func TestDrainQueue(t *testing.T) {
+	past := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	testCases := []struct {
+		name            string
+		deadline        string
+		podGroups       []*schedulingv1beta1.PodGroup
+		expectState     schedulingv1beta1.QueueState
+		expectCommands  []string
+		expectPodsAfter int
+	}{
+		{
+			name: "queue without admitted jobs is closed",
+			podGroups: []*schedulingv1beta1.PodGroup{
+				buildDrainPodGroup("pending", "q1", schedulingv1beta1.PodGroupPending, ""),
+				buildDrainPodGroup("completed", "q1", schedulingv1beta1.PodGroupCompleted, ""),
+			},
+			expectState:     schedulingv1beta1.QueueStateClosed,
+			expectPodsAfter: 1,
+		},
+		{
+			name: "running jobs are left to finish without deadline",
+			podGroups: []*schedulingv1beta1.PodGroup{
+				buildDrainPodGroup("running", "q1", schedulingv1beta1.PodGroupRunning, ""),
+			},
+			expectState:     schedulingv1beta1.QueueStateDraining,
+			expectPodsAfter: 1,
+		},
+		{
+			name:     "running jobs are left to finish before the deadline",
+			deadline: future,
+			podGroups: []*schedulingv1beta1.PodGroup{
+				buildDrainPodGroup("running", "q1", schedulingv1beta1.PodGroupRunning, ""),
+			},
+			expectState:     schedulingv1beta1.QueueStateDraining,
+			expectPodsAfter: 1,
+		},
+		{
+			name:     "running jobs are evicted after the deadline",
+			deadline: past,
+			podGroups: []*schedulingv1beta1.PodGroup{
+				buildDrainPodGroup("running", "q1", schedulingv1beta1.PodGroupRunning, ""),
+				buildDrainPodGroup("inqueue", "q1", schedulingv1beta1.PodGroupInqueue, "job1"),
+			},
+			expectState:     schedulingv1beta1.QueueStateClosed,
+			expectCommands:  []string{"job1-abortjob"},
+			expectPodsAfter: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newFakeController()
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "q1"},
+				Spec:       schedulingv1beta1.QueueSpec{Parent: "root"},
+				Status:     schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateDraining},
+			}
+			if tc.deadline != "" {
+				queue.Annotations = map[string]string{schedulingv1beta1.DrainDeadlineAnnotationKey: tc.deadline}
+			}
+			_, err := c.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), queue, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			for _, pg := range tc.podGroups {
+				assert.NoError(t, c.pgInformer.Informer().GetIndexer().Add(pg))
+				c.addPodGroup(pg)
+			}
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "running-0",
+					Namespace:   "ns1",
+					Annotations: map[string]string{schedulingv1beta1.KubeGroupNameAnnotationKey: "running"},
+				},
+				Status: v1.PodStatus{Phase: v1.PodRunning},
+			}
+			_, err = c.kubeClient.CoreV1().Pods("ns1").Create(context.TODO(), pod, metav1.CreateOptions{})
+			assert.NoError(t, err)
+
+			err = c.drainQueue(queue, func(status *schedulingv1beta1.QueueStatus, podGroupList []string) {
+				if len(podGroupList) == 0 {
+					status.State = schedulingv1beta1.QueueStateClosed
+					return
+				}
+				status.State = schedulingv1beta1.QueueStateDraining
+			})
+			assert.NoError(t, err)
+
+			updated, err := c.vcClient.SchedulingV1beta1().Queues().Get(context.TODO(), queue.Name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectState, updated.Status.State)
+
+			commands, err := c.vcClient.BusV1alpha1().Commands("ns1").List(context.TODO(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			var names []string
+			for _, cmd := range commands.Items {
+				assert.Equal(t, string(busv1alpha1.AbortJobAction), cmd.Action)
+				names = append(names, cmd.Name)
+			}
+			assert.Equal(t, tc.expectCommands, names)
+
+			pods, err := c.kubeClient.CoreV1().Pods("ns1").List(context.TODO(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectPodsAfter, len(pods.Items))
+		})
+	}
+}
+
+func TestDrainHierarchicalQueue(t *testing.T) {
+	c := newFakeController()
+	deadline := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	parent := &schedulingv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "parent",
+			Annotations: map[string]string{schedulingv1beta1.DrainDeadlineAnnotationKey: deadline},
+		},
+		Spec:   schedulingv1beta1.QueueSpec{Parent: "root"},
+		Status: schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateOpen},
+	}
+	open := &schedulingv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "open"},
+		Spec:       schedulingv1beta1.QueueSpec{Parent: "parent"},
+		Status:     schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateOpen},
+	}
+	closed := &schedulingv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "closed"},
+		Spec:       schedulingv1beta1.QueueSpec{Parent: "parent"},
+		Status:     schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateClosed},
+	}
+	for _, queue := range []*schedulingv1beta1.Queue{parent, open, closed} {
+		_, err := c.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), queue, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(queue))
+	}
+
+	assert.NoError(t, c.drainQueue(parent, func(status *schedulingv1beta1.QueueStatus, podGroupList []string) {
+		status.State = schedulingv1beta1.QueueStateClosed
+	}))
+
+	assert.Equal(t, 1, c.queue.Len())
+	req, _ := c.queue.Get()
+	assert.Equal(t, "open", req.QueueName)
+	assert.Equal(t, busv1alpha1.DrainQueueAction, req.Action)
+	child, err := c.vcClient.SchedulingV1beta1().Queues().Get(context.TODO(), "open", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ClosedByParentAnnotationTrueValue, child.Annotations[ClosedByParentAnnotationKey])
+	assert.Equal(t, deadline, child.Annotations[schedulingv1beta1.DrainDeadlineAnnotationKey])
+}
@@ -41,6 +41,9 @@ func (c *queuecontroller) addQueue(obj interface{}) {
 	}
 
 	c.enqueue(req)
+	c.enqueueQuotaSchedule(queue)
+	c.enqueueDeserved(queue)
+	c.enqueueDeletion(queue)
 }
 
 func (c *queuecontroller) deleteQueue(obj interface{}) {
@@ -70,7 +73,11 @@ func (c *queuecontroller) updateQueue(oldObj, newObj interface{}) {
 
 	if oldQueue.Spec.Parent != newQueue.Spec.Parent {
 		c.addQueue(newObj)
+		return
 	}
+	c.enqueueQuotaSchedule(newQueue)
+	c.enqueueDeserved(newQueue)
+	c.enqueueDeletion(newQueue)
 }
 
 func (c *queuecontroller) addPodGroup(obj interface{}) {
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// quotaBaseline is the capability and the deserved resources of the spec of a queue, applied out of the windows of
+// its quota schedules.
+type quotaBaseline struct {
+	Capability v1.ResourceList `json:"capability,omitempty"`
+	Deserved   v1.ResourceList `json:"deserved,omitempty"`
+}
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// enqueueQuotaSchedule enqueues the queue if it has quota schedules, or a window of them is still applied.
+func (c *queuecontroller) enqueueQuotaSchedule(queue *schedulingv1beta1.Queue) {
+	if len(queue.Spec.QuotaSchedules) == 0 && queue.Annotations[schedulingv1beta1.ActiveQuotaScheduleAnnotationKey] == "" {
+		return
+	}
+	c.scheduleQueue.Add(queue.Name)
+}
+
+func (c *queuecontroller) scheduleWorker() {
+	for c.processNextSchedule() {
+	}
+}
+
+func (c *queuecontroller) processNextSchedule() bool {
+	name, shutdown := c.scheduleQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.scheduleQueue.Done(name)
+
+	next, err := c.syncQuotaSchedule(name, time.Now())
+	if err != nil {
+		if c.maxRequeueNum == -1 || c.scheduleQueue.NumRequeues(name) < c.maxRequeueNum {
+			klog.V(4).Infof("Error syncing quota schedules of queue %s for %v.", name, err)
+			c.scheduleQueue.AddRateLimited(name)
+			return true
+		}
+		c.recordEventsForQueue(name, v1.EventTypeWarning, "QuotaScheduleFailed",
+			fmt.Sprintf("apply quota schedules failed for %v", err))
+		klog.V(2).Infof("Dropping quota schedules of queue %s out of the queue for %v.", name, err)
+	}
+	c.scheduleQueue.Forget(name)
+
+	if !next.IsZero() {
+		c.scheduleQueue.AddAfter(name, time.Until(next))
+	}
+	return true
+}
+
+// syncQuotaSchedule applies the quotas of the window of the quota schedules of the queue containing now, or restores
+// the quotas of the spec once no window contains it. It returns the time of the next start or end of a window, zero
+// if none.
+func (c *queuecontroller) syncQuotaSchedule(name string, now time.Time) (time.Time, error) {
+	queue, err := c.queueLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("get queue %s failed for %v", name, err)
+	}
+
+	next := nextQuotaScheduleBoundary(queue.Spec.QuotaSchedules, now)
+	applied := queue.Annotations[schedulingv1beta1.ActiveQuotaScheduleAnnotationKey]
+	window := activeQuotaSchedule(queue.Spec.QuotaSchedules, now)
+	if window == nil && applied == "" {
+		return next, nil
+	}
+
+	newQueue := queue.DeepCopy()
+	if newQueue.Annotations == nil {
+		newQueue.Annotations = map[string]string{}
+	}
+	baseline, found, err := quotaScheduleBaseline(queue)
+	if err != nil {
+		return next, err
+	}
+
+	var reason, message string
+	if window != nil {
+		if !found {
+			baseline = quotaBaseline{Capability: queue.Spec.Capability, Deserved: queue.Spec.Deserved}
+			data, err := json.Marshal(baseline)
+			if err != nil {
+				return next, err
+			}
+			newQueue.Annotations[schedulingv1beta1.QuotaScheduleBaselineAnnotationKey] = string(data)
+		}
+		newQueue.Spec.Capability = baseline.Capability
+		if window.Capability != nil {
+			newQueue.Spec.Capability = window.Capability
+		}
+		newQueue.Spec.Deserved = baseline.Deserved
+		if window.Deserved != nil {
+			newQueue.Spec.Deserved = window.Deserved
+		}
+		newQueue.Annotations[schedulingv1beta1.ActiveQuotaScheduleAnnotationKey] = window.Name
+		reason, message = "QuotaScheduleApplied", fmt.Sprintf("Applied the quotas of quota schedule %s", window.Name)
+	} else {
+		if found {
+			newQueue.Spec.Capability = baseline.Capability
+			newQueue.Spec.Deserved = baseline.Deserved
+		} else {
+			klog.Warningf("Queue %s has no quota schedule baseline, keeping the quotas of quota schedule %s.", name, applied)
+		}
+		delete(newQueue.Annotations, schedulingv1beta1.ActiveQuotaScheduleAnnotationKey)
+		delete(newQueue.Annotations, schedulingv1beta1.QuotaScheduleBaselineAnnotationKey)
+		reason, message = "QuotaScheduleRestored", fmt.Sprintf("Restored the quotas of the spec after quota schedule %s", applied)
+	}
+
+	if equality.Semantic.DeepEqual(queue.Spec, newQueue.Spec) && equality.Semantic.DeepEqual(queue.Annotations, newQueue.Annotations) {
+		return next, nil
+	}
+	if _, err := c.vcClient.SchedulingV1beta1().Queues().Update(context.TODO(), newQueue, metav1.UpdateOptions{}); err != nil {
+		return next, fmt.Errorf("update queue %s failed for %v", name, err)
+	}
+	klog.V(3).Infof("Queue %s: %s.", name, message)
+	c.recorder.Event(newQueue, v1.EventTypeNormal, reason, message)
+
+	return next, nil
+}
+
+// quotaScheduleBaseline returns the quotas of the spec of the queue saved once a window of its quota schedules was
+// applied, and whether they were saved.
+func quotaScheduleBaseline(queue *schedulingv1beta1.Queue) (quotaBaseline, bool, error) {
+	baseline := quotaBaseline{}
+	data, found := queue.Annotations[schedulingv1beta1.QuotaScheduleBaselineAnnotationKey]
+	if !found {
+		return baseline, false, nil
+	}
+	if err := json.Unmarshal([]byte(data), &baseline); err != nil {
+		return baseline, false, fmt.Errorf("invalid quota schedule baseline of queue %s: %v", queue.Name, err)
+	}
+	return baseline, true, nil
+}
+
+// activeQuotaSchedule returns the first window of the schedules containing now, nil if none.
+func activeQuotaSchedule(schedules []schedulingv1beta1.QueueQuotaSchedule, now time.Time) *schedulingv1beta1.QueueQuotaSchedule {
+	for i := range schedules {
+		// A window spanning midnight contains now if it started the day before.
+		for _, offset := range []int{0, -1} {
+			start, end, ok := quotaScheduleWindow(&schedules[i], now, offset)
+			if ok && !now.Before(start) && now.Before(end) {
+				return &schedules[i]
+			}
+		}
+	}
+	return nil
+}
+
+// nextQuotaScheduleBoundary returns the first start or end of a window of the schedules after now, zero if none.
+func nextQuotaScheduleBoundary(schedules []schedulingv1beta1.QueueQuotaSchedule, now time.Time) time.Time {
+	next := time.Time{}
+	for i := range schedules {
+		for offset := -1; offset <= 7; offset++ {
+			start, end, ok := quotaScheduleWindow(&schedules[i], now, offset)
+			if !ok {
+				continue
+			}
+			for _, boundary := range []time.Time{start, end} {
+				if boundary.After(now) && (next.IsZero() || boundary.Before(next)) {
+					next = boundary
+				}
+			}
+		}
+	}
+	return next
+}
+
+// quotaScheduleWindow returns the start and the end of the window of the schedule starting offset days from the day
+// of now, in the time zone of the schedule, and false if the window does not start on that day or the schedule is
+// invalid.
+func quotaScheduleWindow(schedule *schedulingv1beta1.QueueQuotaSchedule, now time.Time, offset int) (time.Time, time.Time, bool) {
+	location := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+	from, err := time.Parse("15:04", schedule.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	to, err := time.Parse("15:04", schedule.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	local := now.In(location)
+	year, month, day := local.Date()
+	start := time.Date(year, month, day+offset, from.Hour(), from.Minute(), 0, 0, location)
+	if len(schedule.Days) != 0 {
+		startsOn := false
+		for _, d := range schedule.Days {
+			if weekday, found := weekdays[d]; found && weekday == start.Weekday() {
+				startsOn = true
+				break
+			}
+		}
+		if !startsOn {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+	endDay := day + offset
+	if !to.After(from) {
+		endDay++
+	}
+	end := time.Date(year, month, endDay, to.Hour(), to.Minute(), 0, 0, location)
+	return start, end, true
+}
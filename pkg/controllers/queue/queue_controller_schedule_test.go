@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestActiveQuotaSchedule(t *testing.T) {
+	schedules := []schedulingv1beta1.QueueQuotaSchedule{
+		{Name: "office", Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}, Start: "09:00", End: "18:00", TimeZone: "Europe/Budapest"},
+		{Name: "night", Start: "22:00", End: "06:00"},
+	}
+
+	// 2026-10-12 is a Monday, Budapest is UTC+2 until 2026-10-25.
+	testCases := []struct {
+		name           string
+		now            time.Time
+		expectWindow   string
+		expectBoundary time.Time
+	}{
+		{
+			name:           "within the office hours in the time zone of the window",
+			now:            time.Date(2026, 10, 12, 7, 30, 0, 0, time.UTC),
+			expectWindow:   "office",
+			expectBoundary: time.Date(2026, 10, 12, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "before the office hours",
+			now:            time.Date(2026, 10, 12, 6, 30, 0, 0, time.UTC),
+			expectBoundary: time.Date(2026, 10, 12, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "after midnight within the night started the day before",
+			now:            time.Date(2026, 10, 13, 3, 0, 0, 0, time.UTC),
+			expectWindow:   "night",
+			expectBoundary: time.Date(2026, 10, 13, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "office hours do not apply on weekends",
+			now:            time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			expectBoundary: time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:           "office hours on Friday are followed by the night",
+			now:            time.Date(2026, 10, 16, 17, 0, 0, 0, time.UTC),
+			expectBoundary: time.Date(2026, 10, 16, 22, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			window := activeQuotaSchedule(schedules, tc.now)
+			if tc.expectWindow == "" {
+				assert.Nil(t, window)
+			} else if assert.NotNil(t, window) {
+				assert.Equal(t, tc.expectWindow, window.Name)
+			}
+			assert.True(t, tc.expectBoundary.Equal(nextQuotaScheduleBoundary(schedules, tc.now)),
+				"expected boundary %v, got %v", tc.expectBoundary, nextQuotaScheduleBoundary(schedules, tc.now))
+		})
+	}
+}
+
+func TestSyncQuotaSchedule(t *testing.T) {
+	c := newFakeController()
+	queue := &schedulingv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "batch"},
+		Spec: schedulingv1beta1.QueueSpec{
+			Weight:     1,
+			Capability: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+			Deserved:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+			QuotaSchedules: []schedulingv1beta1.QueueQuotaSchedule{
+				{
+					Name:       "night",
+					Start:      "22:00",
+					End:        "06:00",
+					Capability: v1.ResourceList{v1.ResourceCPU: resource.MustParse("16")},
+				},
+			},
+		},
+	}
+	sync := func(now time.Time) *schedulingv1beta1.Queue {
+		next, err := c.syncQuotaSchedule(queue.Name, now)
+		assert.NoError(t, err)
+		assert.False(t, next.IsZero())
+		updated, err := c.vcClient.SchedulingV1beta1().Queues().Get(context.TODO(), queue.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, c.queueInformer.Informer().GetIndexer().Update(updated))
+		return updated
+	}
+
+	_, err := c.vcClient.SchedulingV1beta1().Queues().Create(context.TODO(), queue, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, c.queueInformer.Informer().GetIndexer().Add(queue))
+
+	night := sync(time.Date(2026, 10, 12, 23, 0, 0, 0, time.UTC))
+	assert.Equal(t, "night", night.Annotations[schedulingv1beta1.ActiveQuotaScheduleAnnotationKey])
+	assert.True(t, night.Spec.Capability.Cpu().Equal(resource.MustParse("16")))
+	assert.True(t, night.Spec.Deserved.Cpu().Equal(resource.MustParse("2")))
+
+	day := sync(time.Date(2026, 10, 13, 12, 0, 0, 0, time.UTC))
+	assert.NotContains(t, day.Annotations, schedulingv1beta1.ActiveQuotaScheduleAnnotationKey)
+	assert.NotContains(t, day.Annotations, schedulingv1beta1.QuotaScheduleBaselineAnnotationKey)
+	assert.True(t, day.Spec.Capability.Cpu().Equal(resource.MustParse("4")))
+	assert.True(t, day.Spec.Deserved.Cpu().Equal(resource.MustParse("2")))
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
 	kubeclient "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
@@ -39,12 +40,14 @@ func newFakeController() *queuecontroller {
 	KubeClientSet := kubeclient.NewSimpleClientset()
 
 	vcSharedInformers := informerfactory.NewSharedInformerFactory(KubeBatchClientSet, 0)
+	sharedInformers := informers.NewSharedInformerFactory(KubeClientSet, 0)
 
 	controller := &queuecontroller{}
 	opt := framework.ControllerOption{
 		VolcanoClient:           KubeBatchClientSet,
 		KubeClient:              KubeClientSet,
 		VCSharedInformerFactory: vcSharedInformers,
+		SharedInformerFactory:   sharedInformers,
 	}
 
 	controller.Initialize(&opt)
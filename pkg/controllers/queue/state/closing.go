@@ -39,6 +39,14 @@ func (cs *closingState) Execute(action v1alpha1.Action) error {
 			}
 			status.State = v1beta1.QueueStateClosing
 		})
+	case v1alpha1.DrainQueueAction:
+		return DrainQueue(cs.queue, func(status *v1beta1.QueueStatus, podGroupList []string) {
+			if len(podGroupList) == 0 {
+				status.State = v1beta1.QueueStateClosed
+				return
+			}
+			status.State = v1beta1.QueueStateDraining
+		})
 	default:
 		return SyncQueue(cs.queue, func(status *v1beta1.QueueStatus, podGroupList []string) {
 			specState := cs.queue.Status.State
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestDrainingState(t *testing.T) {
+	testcases := []struct {
+		name          string
+		action        busv1alpha1.Action
+		podGroups     []string
+		expectedFn    string
+		expectedState schedulingv1beta1.QueueState
+	}{
+		{
+			name:          "OpenQueueAction: Draining queue",
+			action:        busv1alpha1.OpenQueueAction,
+			podGroups:     []string{"pg1"},
+			expectedFn:    "open",
+			expectedState: schedulingv1beta1.QueueStateOpen,
+		},
+		{
+			name:          "CloseQueueAction: Draining queue with running podgroup",
+			action:        busv1alpha1.CloseQueueAction,
+			podGroups:     []string{"pg1"},
+			expectedFn:    "close",
+			expectedState: schedulingv1beta1.QueueStateClosing,
+		},
+		{
+			name:          "DrainQueueAction: Draining queue with running podgroup",
+			action:        busv1alpha1.DrainQueueAction,
+			podGroups:     []string{"pg1"},
+			expectedFn:    "drain",
+			expectedState: schedulingv1beta1.QueueStateDraining,
+		},
+		{
+			name:          "SyncQueueAction: Draining queue with no podgroup left",
+			action:        busv1alpha1.SyncQueueAction,
+			podGroups:     []string{},
+			expectedFn:    "drain",
+			expectedState: schedulingv1beta1.QueueStateClosed,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			origOpenQueue, origCloseQueue, origDrainQueue := OpenQueue, CloseQueue, DrainQueue
+			t.Cleanup(func() { OpenQueue, CloseQueue, DrainQueue = origOpenQueue, origCloseQueue, origDrainQueue })
+
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-queue"},
+				Status:     schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateDraining},
+			}
+			var calledFn string
+			var capturedState schedulingv1beta1.QueueState
+			fake := func(name string) QueueActionFn {
+				return func(q *schedulingv1beta1.Queue, fn UpdateQueueStatusFn) error {
+					calledFn = name
+					fakeStatus := &schedulingv1beta1.QueueStatus{}
+					fn(fakeStatus, tc.podGroups)
+					capturedState = fakeStatus.State
+					return nil
+				}
+			}
+			OpenQueue, CloseQueue, DrainQueue = fake("open"), fake("close"), fake("drain")
+
+			s := NewState(queue)
+			if err := s.Execute(tc.action); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if calledFn != tc.expectedFn {
+				t.Errorf("expected %s queue, got %s", tc.expectedFn, calledFn)
+			}
+			if capturedState != tc.expectedState {
+				t.Errorf("expected state %q got %q", tc.expectedState, capturedState)
+			}
+		})
+	}
+}
+
+func TestOpenState_DrainQueueAction(t *testing.T) {
+	testcases := []struct {
+		name          string
+		podGroups     []string
+		expectedState schedulingv1beta1.QueueState
+	}{
+		{
+			name:          "DrainQueueAction: Open queue with no podgroup",
+			podGroups:     []string{},
+			expectedState: schedulingv1beta1.QueueStateClosed,
+		},
+		{
+			name:          "DrainQueueAction: Open queue with running podgroup",
+			podGroups:     []string{"pg1"},
+			expectedState: schedulingv1beta1.QueueStateDraining,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			origDrainQueue := DrainQueue
+			t.Cleanup(func() { DrainQueue = origDrainQueue })
+
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-queue"},
+				Status:     schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateOpen},
+			}
+			var capturedState schedulingv1beta1.QueueState
+			DrainQueue = func(q *schedulingv1beta1.Queue, fn UpdateQueueStatusFn) error {
+				if q != queue {
+					t.Errorf("expected queue %v, got %v", queue, q)
+				}
+				fakeStatus := &schedulingv1beta1.QueueStatus{}
+				fn(fakeStatus, tc.podGroups)
+				capturedState = fakeStatus.State
+				return nil
+			}
+
+			s := &openState{queue: queue}
+			if err := s.Execute(busv1alpha1.DrainQueueAction); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if capturedState != tc.expectedState {
+				t.Errorf("expected state %q got %q", tc.expectedState, capturedState)
+			}
+		})
+	}
+}
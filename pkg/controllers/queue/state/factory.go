@@ -30,7 +30,7 @@ type State interface {
 // UpdateQueueStatusFn updates the queue status.
 type UpdateQueueStatusFn func(status *v1beta1.QueueStatus, podGroupList []string)
 
-// QueueActionFn will open, close or sync queue.
+// QueueActionFn will open, close, drain or sync queue.
 type QueueActionFn func(queue *v1beta1.Queue, fn UpdateQueueStatusFn) error
 
 var (
@@ -40,6 +40,8 @@ var (
 	OpenQueue QueueActionFn
 	// CloseQueue will set state of queue to close
 	CloseQueue QueueActionFn
+	// DrainQueue will set state of queue to draining, then to closed once its running jobs finished or were evicted
+	DrainQueue QueueActionFn
 )
 
 // NewState gets the state from queue status.
@@ -51,6 +53,8 @@ func NewState(queue *v1beta1.Queue) State {
 		return &closedState{queue: queue}
 	case v1beta1.QueueStateClosing:
 		return &closingState{queue: queue}
+	case v1beta1.QueueStateDraining:
+		return &drainingState{queue: queue}
 	case v1beta1.QueueStateUnknown:
 		return &unknownState{queue: queue}
 	}
@@ -50,6 +50,11 @@ func TestNewState(t *testing.T) {
 			queueState:   schedulingv1beta1.QueueStateClosing,
 			expectedType: "*state.closingState",
 		},
+		{
+			name:         "Draining state returns drainingState",
+			queueState:   schedulingv1beta1.QueueStateDraining,
+			expectedType: "*state.drainingState",
+		},
 		{
 			name:         "Unknown state returns unknownState",
 			queueState:   schedulingv1beta1.QueueStateUnknown,
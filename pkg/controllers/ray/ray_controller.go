@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+const (
+	controllerName = "ray-controller"
+)
+
+func init() {
+	framework.RegisterController(&raycontroller{})
+}
+
+// raycontroller gang schedules the RayClusters scheduled by Volcano: every RayCluster gets a PodGroup of its head and
+// the minimum replicas of its worker groups, which its pods are bound to by the podgroup controller, and the RayJobs
+// surface the PodGroup of their RayCluster in their annotations.
+type raycontroller struct {
+	vcClient      vcclientset.Interface
+	dynamicClient dynamic.Interface
+
+	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	vcInformerFactory      vcinformer.SharedInformerFactory
+	rayClusterLister       cache.GenericLister
+	rayClusterSynced       func() bool
+	rayJobLister           cache.GenericLister
+	rayJobSynced           func() bool
+	pgLister               schedulinglister.PodGroupLister
+	pgSynced               func() bool
+
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	schedulerNames []string
+}
+
+func (rc *raycontroller) Name() string {
+	return controllerName
+}
+
+func (rc *raycontroller) Initialize(opt *framework.ControllerOption) error {
+	rc.vcClient = opt.VolcanoClient
+	if rc.dynamicClient == nil {
+		dynamicClient, err := dynamic.NewForConfig(opt.Config)
+		if err != nil {
+			return fmt.Errorf("failed to create dynamic client: %v", err)
+		}
+		rc.dynamicClient = dynamicClient
+	}
+	rc.schedulerNames = opt.SchedulerNames
+	rc.vcInformerFactory = opt.VCSharedInformerFactory
+	rc.dynamicInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(rc.dynamicClient, 0)
+	rc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	rayClusterInformer := rc.dynamicInformerFactory.ForResource(rayClusterGVR)
+	rayClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: rc.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			rc.enqueue(newObj)
+		},
+	})
+	rc.rayClusterLister = rayClusterInformer.Lister()
+	rc.rayClusterSynced = rayClusterInformer.Informer().HasSynced
+
+	rayJobInformer := rc.dynamicInformerFactory.ForResource(rayJobGVR)
+	rayJobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			rc.updateRayJob(newObj)
+		},
+	})
+	rc.rayJobLister = rayJobInformer.Lister()
+	rc.rayJobSynced = rayJobInformer.Informer().HasSynced
+
+	pgInformer := rc.vcInformerFactory.Scheduling().V1beta1().PodGroups()
+	pgInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			rc.updatePodGroup(newObj)
+		},
+	})
+	rc.pgLister = pgInformer.Lister()
+	rc.pgSynced = pgInformer.Informer().HasSynced
+	return nil
+}
+
+// Run starts the RayController.
+func (rc *raycontroller) Run(stopCh <-chan struct{}) {
+	defer rc.queue.ShutDown()
+
+	rc.dynamicInformerFactory.Start(stopCh)
+	rc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, rc.rayClusterSynced, rc.rayJobSynced, rc.pgSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(rc.worker, 0, stopCh)
+	klog.Infof("RayController is running ...... ")
+	<-stopCh
+}
+
+func (rc *raycontroller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		klog.Errorf("Failed to get key of RayCluster: %v", err)
+		return
+	}
+	rc.queue.Add(key)
+}
+
+// updateRayJob enqueues the RayCluster of a RayJob, whose queue may be set by the RayJob.
+func (rc *raycontroller) updateRayJob(obj interface{}) {
+	rayJob, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		klog.Errorf("obj is not RayJob")
+		return
+	}
+	if cluster, _, _ := unstructured.NestedString(rayJob.Object, "status", "rayClusterName"); cluster != "" {
+		rc.queue.Add(rayJob.GetNamespace() + "/" + cluster)
+	}
+}
+
+// updatePodGroup enqueues the RayCluster of a PodGroup, whose phase is surfaced on the RayJob of the RayCluster.
+func (rc *raycontroller) updatePodGroup(obj interface{}) {
+	pg, ok := obj.(*scheduling.PodGroup)
+	if !ok {
+		klog.Errorf("obj is not PodGroup")
+		return
+	}
+	if cluster, found := pg.Labels[rayClusterLabelKey]; found {
+		rc.queue.Add(pg.Namespace + "/" + cluster)
+	}
+}
+
+func (rc *raycontroller) worker() {
+	for rc.processNextReq() {
+	}
+}
+
+func (rc *raycontroller) processNextReq() bool {
+	key, shutdown := rc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer rc.queue.Done(key)
+
+	if err := rc.sync(key); err != nil {
+		klog.V(2).Infof("Failed to sync RayCluster <%s>: %v", key, err)
+		rc.queue.AddRateLimited(key)
+		return true
+	}
+	rc.queue.Forget(key)
+	return true
+}
+
+// sync keeps the PodGroup of the RayCluster in line with its worker groups, and surfaces it on the RayJob the
+// RayCluster is created for.
+func (rc *raycontroller) sync(key string) error {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	obj, err := rc.rayClusterLister.ByNamespace(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		// The PodGroup is garbage collected with the RayCluster.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	cluster, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("obj of RayCluster <%s> is not unstructured", key)
+	}
+	if cluster.GetDeletionTimestamp() != nil || !scheduledBy(cluster, rc.schedulerNames) {
+		return nil
+	}
+	if _, found := cluster.GetLabels()[kubeRaySchedulerLabelKey]; found {
+		klog.V(4).Infof("The PodGroup of RayCluster <%s> is managed by KubeRay, skip it", key)
+		return nil
+	}
+	rayJob, err := rc.rayJobOf(cluster)
+	if err != nil {
+		return err
+	}
+
+	// The pods of the RayCluster are bound by the podgroup controller to the PodGroup named after the RayCluster
+	// owning them.
+	pgName := vcbatch.PodgroupNamePrefix + string(cluster.GetUID())
+	newPodGroup, err := buildPodGroup(cluster, pgName, queueOf(cluster, rayJob))
+	if err != nil {
+		return err
+	}
+	pg, err := rc.pgLister.PodGroups(ns).Get(pgName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if pg == nil {
+		pg, err = rc.vcClient.SchedulingV1beta1().PodGroups(ns).Create(context.TODO(), newPodGroup, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+	} else {
+		if pg.Status.Phase != scheduling.PodGroupPending || newPodGroup.Spec.Queue == "" {
+			// The PodGroup stays in the queue it is admitted into.
+			newPodGroup.Spec.Queue = pg.Spec.Queue
+		}
+		if !reflect.DeepEqual(pg.Spec, newPodGroup.Spec) || pg.Labels[rayClusterLabelKey] != name {
+			pg = pg.DeepCopy()
+			pg.Spec = newPodGroup.Spec
+			if pg.Labels == nil {
+				pg.Labels = map[string]string{}
+			}
+			pg.Labels[rayClusterLabelKey] = name
+			if pg, err = rc.vcClient.SchedulingV1beta1().PodGroups(ns).Update(context.TODO(), pg, metav1.UpdateOptions{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if rayJob == nil {
+		return nil
+	}
+	return rc.surfacePodGroup(rayJob, pg)
+}
+
+// rayJobOf returns the RayJob the RayCluster is created for, or nil.
+func (rc *raycontroller) rayJobOf(cluster *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	owner := metav1.GetControllerOf(cluster)
+	if owner == nil || owner.Kind != rayJobKind {
+		return nil, nil
+	}
+	obj, err := rc.rayJobLister.ByNamespace(cluster.GetNamespace()).Get(owner.Name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rayJob, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("obj of RayJob <%s/%s> is not unstructured", cluster.GetNamespace(), owner.Name)
+	}
+	return rayJob, nil
+}
+
+// surfacePodGroup annotates the RayJob with the PodGroup of its RayCluster, its queue and its phase.
+func (rc *raycontroller) surfacePodGroup(rayJob *unstructured.Unstructured, pg *scheduling.PodGroup) error {
+	annotations := map[string]string{
+		podGroupAnnotationKey:      pg.Name,
+		vcbatch.QueueNameKey:       pg.Spec.Queue,
+		podGroupPhaseAnnotationKey: string(pg.Status.Phase),
+	}
+	current := rayJob.GetAnnotations()
+	changed := false
+	for k, v := range annotations {
+		if current[k] != v {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"annotations": annotations}})
+	if err != nil {
+		return err
+	}
+	_, err = rc.dynamicClient.Resource(rayJobGVR).Namespace(rayJob.GetNamespace()).Patch(context.TODO(), rayJob.GetName(),
+		types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
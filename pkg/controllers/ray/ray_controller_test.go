@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newTemplate(schedulerName, cpu string) map[string]interface{} {
+	template, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			SchedulerName:     schedulerName,
+			PriorityClassName: "high",
+			Containers: []v1.Container{{
+				Name: "ray",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+	})
+	return template
+}
+
+func newRayCluster(name, schedulerName string) *unstructured.Unstructured {
+	cluster := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "ray.io/v1",
+		"kind":       "RayCluster",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default", "uid": name + "-uid"},
+		"spec": map[string]interface{}{
+			"enableInTreeAutoscaling": true,
+			"headGroupSpec":           map[string]interface{}{"template": newTemplate(schedulerName, "1")},
+			"workerGroupSpecs": []interface{}{
+				map[string]interface{}{
+					"groupName": "tpu", "replicas": int64(4), "minReplicas": int64(2), "maxReplicas": int64(8),
+					"numOfHosts": int64(2), "template": newTemplate(schedulerName, "2"),
+				},
+				map[string]interface{}{
+					"groupName": "cpu", "minReplicas": int64(1), "template": newTemplate(schedulerName, "4"),
+				},
+				map[string]interface{}{
+					"groupName": "suspended", "minReplicas": int64(3), "suspend": true, "template": newTemplate(schedulerName, "4"),
+				},
+			},
+		},
+	}}
+	cluster.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: "ray.io/v1", Kind: rayJobKind, Name: "job", UID: "job-uid", Controller: ptr.To(true),
+	}})
+	return cluster
+}
+
+func newRayJob() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "ray.io/v1",
+		"kind":       rayJobKind,
+		"metadata": map[string]interface{}{
+			"name":        "job",
+			"namespace":   "default",
+			"annotations": map[string]interface{}{scheduling.QueueNameAnnotationKey: "ml"},
+		},
+		"status": map[string]interface{}{"rayClusterName": "cluster"},
+	}}
+}
+
+func newFakeController(objects ...runtime.Object) *raycontroller {
+	kubeClient := kubeclient.NewSimpleClientset()
+	vcClient := vcclient.NewSimpleClientset()
+	controller := &raycontroller{
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				rayClusterGVR: "RayClusterList",
+				rayJobGVR:     "RayJobList",
+			}, objects...),
+	}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: informerfactory.NewSharedInformerFactory(vcClient, 0),
+		SchedulerNames:          []string{"volcano"},
+	}
+	controller.Initialize(opt)
+	return controller
+}
+
+func TestSyncRayCluster(t *testing.T) {
+	ctx := context.TODO()
+	cluster := newRayCluster("cluster", "volcano")
+	rc := newFakeController(cluster, newRayJob())
+	require.NoError(t, rc.dynamicInformerFactory.ForResource(rayClusterGVR).Informer().GetIndexer().Add(cluster))
+	require.NoError(t, rc.dynamicInformerFactory.ForResource(rayJobGVR).Informer().GetIndexer().Add(newRayJob()))
+	pgIndexer := rc.vcInformerFactory.Scheduling().V1beta1().PodGroups().Informer().GetIndexer()
+	latestPodGroup := func() *scheduling.PodGroup {
+		pg, err := rc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "podgroup-cluster-uid", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, pgIndexer.Update(pg))
+		return pg
+	}
+	rayJobAnnotations := func() map[string]string {
+		rayJob, err := rc.dynamicClient.Resource(rayJobGVR).Namespace("default").Get(ctx, "job", metav1.GetOptions{})
+		require.NoError(t, err)
+		return rayJob.GetAnnotations()
+	}
+
+	// The head and the minimum replicas of the workers are gang scheduled in the queue of the RayJob.
+	require.NoError(t, rc.sync("default/cluster"))
+	pg := latestPodGroup()
+	assert.Equal(t, int32(1+2*2+1), pg.Spec.MinMember)
+	assert.True(t, resource.MustParse("13").Equal((*pg.Spec.MinResources)[v1.ResourceCPU]))
+	assert.Equal(t, "ml", pg.Spec.Queue)
+	assert.Equal(t, "high", pg.Spec.PriorityClassName)
+	assert.Equal(t, "cluster", pg.Labels[rayClusterLabelKey])
+	assert.Equal(t, "RayCluster", pg.OwnerReferences[0].Kind)
+	assert.Equal(t, map[string]string{
+		scheduling.QueueNameAnnotationKey: "ml",
+		podGroupAnnotationKey:             "podgroup-cluster-uid",
+		vcbatch.QueueNameKey:              "ml",
+		podGroupPhaseAnnotationKey:        string(scheduling.PodGroupPending),
+	}, rayJobAnnotations())
+
+	// The minimum replicas follow the worker groups, the queue stays once the PodGroup is admitted.
+	pg.Status.Phase = scheduling.PodGroupRunning
+	_, err := rc.vcClient.SchedulingV1beta1().PodGroups("default").UpdateStatus(ctx, pg, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	latestPodGroup()
+	cluster = cluster.DeepCopy()
+	cluster.SetAnnotations(map[string]string{scheduling.QueueNameAnnotationKey: "other"})
+	workerGroups, _, _ := unstructured.NestedSlice(cluster.Object, "spec", "workerGroupSpecs")
+	workerGroups[1].(map[string]interface{})["minReplicas"] = int64(2)
+	require.NoError(t, unstructured.SetNestedSlice(cluster.Object, workerGroups, "spec", "workerGroupSpecs"))
+	require.NoError(t, rc.dynamicInformerFactory.ForResource(rayClusterGVR).Informer().GetIndexer().Update(cluster))
+	require.NoError(t, rc.sync("default/cluster"))
+	pg = latestPodGroup()
+	assert.Equal(t, int32(1+2*2+2), pg.Spec.MinMember)
+	assert.True(t, resource.MustParse("17").Equal((*pg.Spec.MinResources)[v1.ResourceCPU]))
+	assert.Equal(t, "ml", pg.Spec.Queue)
+	assert.Equal(t, string(scheduling.PodGroupRunning), rayJobAnnotations()[podGroupPhaseAnnotationKey])
+}
+
+func TestSyncSkippedRayClusters(t *testing.T) {
+	managedByKubeRay := newRayCluster("kuberay", "volcano")
+	managedByKubeRay.SetLabels(map[string]string{kubeRaySchedulerLabelKey: "volcano"})
+	clusters := []*unstructured.Unstructured{newRayCluster("default-scheduler", "default-scheduler"), managedByKubeRay}
+
+	rc := newFakeController()
+	for _, cluster := range clusters {
+		require.NoError(t, rc.dynamicInformerFactory.ForResource(rayClusterGVR).Informer().GetIndexer().Add(cluster))
+		require.NoError(t, rc.sync("default/"+cluster.GetName()))
+	}
+	require.NoError(t, rc.sync("default/missing"))
+	pgs, err := rc.vcClient.SchedulingV1beta1().PodGroups("default").List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pgs.Items)
+}
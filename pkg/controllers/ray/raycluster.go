@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ray
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	// rayClusterLabelKey is the label of the PodGroups naming the RayCluster they are created for.
+	rayClusterLabelKey = "volcano.sh/ray-cluster"
+	// kubeRaySchedulerLabelKey is the label of the RayClusters whose PodGroup is created by the batch scheduler
+	// integration of KubeRay, which are left to KubeRay.
+	kubeRaySchedulerLabelKey = "ray.io/scheduler-name"
+
+	// podGroupAnnotationKey is the annotation of the RayJobs naming the PodGroup of their RayCluster.
+	podGroupAnnotationKey = "volcano.sh/podgroup"
+	// podGroupPhaseAnnotationKey is the annotation of the RayJobs surfacing the phase of the PodGroup of their
+	// RayCluster.
+	podGroupPhaseAnnotationKey = "volcano.sh/podgroup-phase"
+
+	rayJobKind = "RayJob"
+)
+
+var (
+	rayClusterGVR = schema.GroupVersionResource{Group: "ray.io", Version: "v1", Resource: "rayclusters"}
+	rayJobGVR     = schema.GroupVersionResource{Group: "ray.io", Version: "v1", Resource: "rayjobs"}
+
+	rayClusterGVK = schema.GroupVersionKind{Group: "ray.io", Version: "v1", Kind: "RayCluster"}
+)
+
+// podTemplate converts the pod template at the given fields of the object.
+func podTemplate(obj map[string]interface{}, fields ...string) (*v1.PodTemplateSpec, error) {
+	templateObj, _, _ := unstructured.NestedMap(obj, fields...)
+	template := &v1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateObj, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// scheduledBy returns whether the head of the RayCluster is scheduled by one of the schedulers.
+func scheduledBy(cluster *unstructured.Unstructured, schedulerNames []string) bool {
+	schedulerName, _, _ := unstructured.NestedString(cluster.Object, "spec", "headGroupSpec", "template", "spec", "schedulerName")
+	return slices.Contains(schedulerNames, schedulerName)
+}
+
+// queueOf returns the queue of the RayCluster: the queue of its annotations or labels, else the queue of the RayJob
+// it is created for.
+func queueOf(cluster, rayJob *unstructured.Unstructured) string {
+	objects := []*unstructured.Unstructured{cluster}
+	if rayJob != nil {
+		objects = append(objects, rayJob)
+	}
+	for _, obj := range objects {
+		if queue := obj.GetAnnotations()[scheduling.QueueNameAnnotationKey]; queue != "" {
+			return queue
+		}
+		if queue := obj.GetLabels()[vcbatch.QueueNameKey]; queue != "" {
+			return queue
+		}
+	}
+	return ""
+}
+
+// buildPodGroup builds the PodGroup of the RayCluster, gang scheduling its head and the minimum replicas of its worker
+// groups. The workers the autoscaler adds above the minimum replicas are elastic, and are scheduled as long as the
+// queue has room for them.
+func buildPodGroup(cluster *unstructured.Unstructured, pgName, queue string) (*scheduling.PodGroup, error) {
+	head, err := podTemplate(cluster.Object, "spec", "headGroupSpec", "template")
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the head template of RayCluster <%s/%s>: %v",
+			cluster.GetNamespace(), cluster.GetName(), err)
+	}
+	minMember := int32(1)
+	minResources := util.CalTaskRequests(&v1.Pod{Spec: head.Spec}, 1)
+
+	workerGroups, _, _ := unstructured.NestedSlice(cluster.Object, "spec", "workerGroupSpecs")
+	for _, wg := range workerGroups {
+		workerGroup, ok := wg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if suspended, _, _ := unstructured.NestedBool(workerGroup, "suspend"); suspended {
+			continue
+		}
+		minReplicas, _, _ := unstructured.NestedInt64(workerGroup, "minReplicas")
+		numOfHosts, found, _ := unstructured.NestedInt64(workerGroup, "numOfHosts")
+		if !found || numOfHosts < 1 {
+			numOfHosts = 1
+		}
+		worker, err := podTemplate(workerGroup, "template")
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the worker template of RayCluster <%s/%s>: %v",
+				cluster.GetNamespace(), cluster.GetName(), err)
+		}
+		count := int32(minReplicas * numOfHosts)
+		minMember += count
+		minResources = quotav1.Add(minResources, util.CalTaskRequests(&v1.Pod{Spec: worker.Spec}, count))
+	}
+
+	return &scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       cluster.GetNamespace(),
+			Name:            pgName,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cluster, rayClusterGVK)},
+			Labels:          map[string]string{rayClusterLabelKey: cluster.GetName()},
+		},
+		Spec: scheduling.PodGroupSpec{
+			MinMember:         minMember,
+			Queue:             queue,
+			PriorityClassName: head.Spec.PriorityClassName,
+			MinResources:      &minResources,
+		},
+		Status: scheduling.PodGroupStatus{
+			Phase: scheduling.PodGroupPending,
+		},
+	}, nil
+}
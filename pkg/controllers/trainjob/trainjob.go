@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trainjob
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	quotav1 "k8s.io/apiserver/pkg/quota/v1"
+
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/util"
+)
+
+const (
+	// trainJobLabelKey is the label of the PodGroups naming the TrainJob they are created for.
+	trainJobLabelKey = "volcano.sh/trainjob"
+
+	trainJobKind                    = "TrainJob"
+	trainingRuntimeKind             = "TrainingRuntime"
+	nodeReplicatedJobName           = "node"
+	defaultReplicatedJobCount       = 1
+	defaultReplicatedJobParallelism = 1
+)
+
+var (
+	trainJobGVR               = schema.GroupVersionResource{Group: "trainer.kubeflow.org", Version: "v1alpha1", Resource: "trainjobs"}
+	trainingRuntimeGVR        = schema.GroupVersionResource{Group: "trainer.kubeflow.org", Version: "v1alpha1", Resource: "trainingruntimes"}
+	clusterTrainingRuntimeGVR = schema.GroupVersionResource{Group: "trainer.kubeflow.org", Version: "v1alpha1", Resource: "clustertrainingruntimes"}
+	jobSetGVR                 = schema.GroupVersionResource{Group: "jobset.x-k8s.io", Version: "v1alpha2", Resource: "jobsets"}
+
+	jobSetGVK = schema.GroupVersionKind{Group: "jobset.x-k8s.io", Version: "v1alpha2", Kind: "JobSet"}
+)
+
+// elasticMinNodes returns the minimum number of nodes of the elastic policy of the runtime, or 0 if the training is
+// not elastic.
+func elasticMinNodes(trainingRuntime *unstructured.Unstructured) int64 {
+	if trainingRuntime == nil {
+		return 0
+	}
+	minNodes, _, _ := unstructured.NestedInt64(trainingRuntime.Object, "spec", "mlPolicy", "torch", "elasticPolicy", "minNodes")
+	return minNodes
+}
+
+// buildPodGroup builds the PodGroup of the JobSet of the TrainJob, gang scheduling all its pods. The nodes of an
+// elastic training are gang scheduled down to the minimum nodes of its elastic policy, the nodes above are elastic
+// members scheduled as long as the queue has room for them.
+func buildPodGroup(jobSet, trainJob *unstructured.Unstructured, minNodes int64) (*scheduling.PodGroup, error) {
+	replicatedJobs, _, _ := unstructured.NestedSlice(jobSet.Object, "spec", "replicatedJobs")
+	minMember := int32(0)
+	minResources := v1.ResourceList{}
+	for _, rj := range replicatedJobs {
+		replicatedJob, ok := rj.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		replicas, found, _ := unstructured.NestedInt64(replicatedJob, "replicas")
+		if !found {
+			replicas = defaultReplicatedJobCount
+		}
+		parallelism, found, _ := unstructured.NestedInt64(replicatedJob, "template", "spec", "parallelism")
+		if !found {
+			parallelism = defaultReplicatedJobParallelism
+		}
+		count := replicas * parallelism
+		if name, _, _ := unstructured.NestedString(replicatedJob, "name"); name == nodeReplicatedJobName && minNodes > 0 && minNodes < count {
+			count = minNodes
+		}
+
+		templateObj, _, _ := unstructured.NestedMap(replicatedJob, "template", "spec", "template")
+		template := &v1.PodTemplateSpec{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateObj, template); err != nil {
+			return nil, fmt.Errorf("failed to convert the pod template of JobSet <%s/%s>: %v",
+				jobSet.GetNamespace(), jobSet.GetName(), err)
+		}
+		minMember += int32(count)
+		minResources = quotav1.Add(minResources, util.CalTaskRequests(&v1.Pod{Spec: template.Spec}, int32(count)))
+	}
+
+	pg := &scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       jobSet.GetNamespace(),
+			Name:            vcbatch.PodgroupNamePrefix + string(jobSet.GetUID()),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(jobSet, jobSetGVK)},
+			Labels:          map[string]string{trainJobLabelKey: trainJob.GetName()},
+		},
+		Spec: scheduling.PodGroupSpec{
+			MinMember:    minMember,
+			MinResources: &minResources,
+		},
+		Status: scheduling.PodGroupStatus{
+			Phase: scheduling.PodGroupPending,
+		},
+	}
+	// The queue and the priority of the TrainJob are set by its labels, or its annotations.
+	for _, metadata := range []map[string]string{trainJob.GetLabels(), trainJob.GetAnnotations()} {
+		if pg.Spec.Queue == "" {
+			pg.Spec.Queue = metadata[vcbatch.QueueNameKey]
+		}
+		if pg.Spec.PriorityClassName == "" {
+			pg.Spec.PriorityClassName = metadata[vcbatch.PriorityClassKey]
+		}
+	}
+	if pg.Spec.Queue == "" {
+		pg.Spec.Queue = trainJob.GetAnnotations()[scheduling.QueueNameAnnotationKey]
+	}
+	return pg, nil
+}
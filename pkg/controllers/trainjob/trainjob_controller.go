@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trainjob
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+const (
+	controllerName = "trainjob-controller"
+)
+
+func init() {
+	framework.RegisterController(&trainjobcontroller{})
+}
+
+// trainjobcontroller gang schedules the Kubeflow TrainJobs: the JobSet of every TrainJob gets a PodGroup of all its
+// pods, in the queue and with the priority of the TrainJob, which the podgroup controller binds the pods of the JobSet
+// to.
+type trainjobcontroller struct {
+	vcClient      vcclientset.Interface
+	dynamicClient dynamic.Interface
+
+	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	vcInformerFactory      vcinformer.SharedInformerFactory
+	jobSetLister           cache.GenericLister
+	jobSetSynced           func() bool
+	trainJobLister         cache.GenericLister
+	trainJobSynced         func() bool
+	pgLister               schedulinglister.PodGroupLister
+	pgSynced               func() bool
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+func (tc *trainjobcontroller) Name() string {
+	return controllerName
+}
+
+func (tc *trainjobcontroller) Initialize(opt *framework.ControllerOption) error {
+	tc.vcClient = opt.VolcanoClient
+	if tc.dynamicClient == nil {
+		dynamicClient, err := dynamic.NewForConfig(opt.Config)
+		if err != nil {
+			return fmt.Errorf("failed to create dynamic client: %v", err)
+		}
+		tc.dynamicClient = dynamicClient
+	}
+	tc.vcInformerFactory = opt.VCSharedInformerFactory
+	tc.dynamicInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(tc.dynamicClient, 0)
+	tc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	// The JobSet of a TrainJob is named after the TrainJob, both are synced by the same key.
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: tc.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			tc.enqueue(newObj)
+		},
+	}
+	jobSetInformer := tc.dynamicInformerFactory.ForResource(jobSetGVR)
+	jobSetInformer.Informer().AddEventHandler(handler)
+	tc.jobSetLister = jobSetInformer.Lister()
+	tc.jobSetSynced = jobSetInformer.Informer().HasSynced
+
+	trainJobInformer := tc.dynamicInformerFactory.ForResource(trainJobGVR)
+	trainJobInformer.Informer().AddEventHandler(handler)
+	tc.trainJobLister = trainJobInformer.Lister()
+	tc.trainJobSynced = trainJobInformer.Informer().HasSynced
+
+	pgInformer := tc.vcInformerFactory.Scheduling().V1beta1().PodGroups()
+	tc.pgLister = pgInformer.Lister()
+	tc.pgSynced = pgInformer.Informer().HasSynced
+	return nil
+}
+
+// Run starts the TrainJobController.
+func (tc *trainjobcontroller) Run(stopCh <-chan struct{}) {
+	defer tc.queue.ShutDown()
+
+	tc.dynamicInformerFactory.Start(stopCh)
+	tc.vcInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, tc.jobSetSynced, tc.trainJobSynced, tc.pgSynced) {
+		klog.Errorf("caches failed to sync for %s", controllerName)
+		return
+	}
+
+	go wait.Until(tc.worker, 0, stopCh)
+	klog.Infof("TrainJobController is running ...... ")
+	<-stopCh
+}
+
+func (tc *trainjobcontroller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		klog.Errorf("Failed to get key of TrainJob: %v", err)
+		return
+	}
+	tc.queue.Add(key)
+}
+
+func (tc *trainjobcontroller) worker() {
+	for tc.processNextReq() {
+	}
+}
+
+func (tc *trainjobcontroller) processNextReq() bool {
+	key, shutdown := tc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer tc.queue.Done(key)
+
+	if err := tc.sync(key); err != nil {
+		klog.V(2).Infof("Failed to sync TrainJob <%s>: %v", key, err)
+		tc.queue.AddRateLimited(key)
+		return true
+	}
+	tc.queue.Forget(key)
+	return true
+}
+
+// sync keeps the PodGroup of the JobSet of the TrainJob in line with the TrainJob and its runtime.
+func (tc *trainjobcontroller) sync(key string) error {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	jobSet, err := getUnstructured(tc.jobSetLister, ns, name)
+	if err != nil || jobSet == nil {
+		// The PodGroup is garbage collected with the JobSet.
+		return err
+	}
+	owner := metav1.GetControllerOf(jobSet)
+	if owner == nil || owner.Kind != trainJobKind || jobSet.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	trainJob, err := getUnstructured(tc.trainJobLister, ns, owner.Name)
+	if err != nil || trainJob == nil {
+		return err
+	}
+	trainingRuntime, err := tc.runtimeOf(trainJob)
+	if err != nil {
+		return err
+	}
+
+	newPodGroup, err := buildPodGroup(jobSet, trainJob, elasticMinNodes(trainingRuntime))
+	if err != nil {
+		return err
+	}
+	pg, err := tc.pgLister.PodGroups(ns).Get(newPodGroup.Name)
+	if apierrors.IsNotFound(err) {
+		_, err = tc.vcClient.SchedulingV1beta1().PodGroups(ns).Create(context.TODO(), newPodGroup, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pg.Status.Phase != scheduling.PodGroupPending || newPodGroup.Spec.Queue == "" {
+		// The PodGroup stays in the queue it is admitted into.
+		newPodGroup.Spec.Queue = pg.Spec.Queue
+	}
+	if reflect.DeepEqual(pg.Spec, newPodGroup.Spec) && pg.Labels[trainJobLabelKey] == trainJob.GetName() {
+		return nil
+	}
+	pg = pg.DeepCopy()
+	pg.Spec = newPodGroup.Spec
+	if pg.Labels == nil {
+		pg.Labels = map[string]string{}
+	}
+	pg.Labels[trainJobLabelKey] = trainJob.GetName()
+	_, err = tc.vcClient.SchedulingV1beta1().PodGroups(ns).Update(context.TODO(), pg, metav1.UpdateOptions{})
+	return err
+}
+
+// runtimeOf returns the (Cluster)TrainingRuntime of the TrainJob, or nil if it is gone.
+func (tc *trainjobcontroller) runtimeOf(trainJob *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	name, _, _ := unstructured.NestedString(trainJob.Object, "spec", "runtimeRef", "name")
+	kind, _, _ := unstructured.NestedString(trainJob.Object, "spec", "runtimeRef", "kind")
+	if name == "" {
+		return nil, nil
+	}
+	var trainingRuntime *unstructured.Unstructured
+	var err error
+	if kind == trainingRuntimeKind {
+		trainingRuntime, err = tc.dynamicClient.Resource(trainingRuntimeGVR).Namespace(trainJob.GetNamespace()).Get(context.TODO(), name, metav1.GetOptions{})
+	} else {
+		trainingRuntime, err = tc.dynamicClient.Resource(clusterTrainingRuntimeGVR).Get(context.TODO(), name, metav1.GetOptions{})
+	}
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return trainingRuntime, err
+}
+
+// getUnstructured returns the object of the lister, or nil if it is not found.
+func getUnstructured(lister cache.GenericLister, ns, name string) (*unstructured.Unstructured, error) {
+	obj, err := lister.ByNamespace(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("obj <%s/%s> is not unstructured", ns, name)
+	}
+	return u, nil
+}
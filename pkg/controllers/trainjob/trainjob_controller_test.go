@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trainjob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newReplicatedJob(name string, parallelism int64, cpu string) map[string]interface{} {
+	template, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: name,
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+	})
+	return map[string]interface{}{
+		"name":     name,
+		"replicas": int64(1),
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{"parallelism": parallelism, "template": template},
+		},
+	}
+}
+
+func newJobSet(owner string) *unstructured.Unstructured {
+	jobSet := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "jobset.x-k8s.io/v1alpha2",
+		"kind":       "JobSet",
+		"metadata":   map[string]interface{}{"name": "train", "namespace": "default", "uid": "jobset-uid"},
+		"spec": map[string]interface{}{
+			"replicatedJobs": []interface{}{
+				newReplicatedJob("dataset-initializer", 1, "1"),
+				newReplicatedJob(nodeReplicatedJobName, 4, "2"),
+			},
+		},
+	}}
+	if owner != "" {
+		jobSet.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: "trainer.kubeflow.org/v1alpha1", Kind: owner, Name: "train", UID: "train-uid", Controller: ptr.To(true),
+		}})
+	}
+	return jobSet
+}
+
+func newTrainJob() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "trainer.kubeflow.org/v1alpha1",
+		"kind":       trainJobKind,
+		"metadata": map[string]interface{}{
+			"name":      "train",
+			"namespace": "default",
+			"labels":    map[string]interface{}{vcbatch.QueueNameKey: "ml", vcbatch.PriorityClassKey: "high"},
+		},
+		"spec": map[string]interface{}{
+			"runtimeRef": map[string]interface{}{"name": "torch-elastic"},
+			"trainer":    map[string]interface{}{"numNodes": int64(4)},
+		},
+	}}
+}
+
+func newClusterTrainingRuntime() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "trainer.kubeflow.org/v1alpha1",
+		"kind":       "ClusterTrainingRuntime",
+		"metadata":   map[string]interface{}{"name": "torch-elastic"},
+		"spec": map[string]interface{}{
+			"mlPolicy": map[string]interface{}{
+				"torch": map[string]interface{}{
+					"elasticPolicy": map[string]interface{}{"minNodes": int64(2), "maxNodes": int64(4)},
+				},
+			},
+		},
+	}}
+}
+
+func newFakeController(objects ...runtime.Object) *trainjobcontroller {
+	kubeClient := kubeclient.NewSimpleClientset()
+	vcClient := vcclient.NewSimpleClientset()
+	controller := &trainjobcontroller{
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				jobSetGVR:                 "JobSetList",
+				trainJobGVR:               "TrainJobList",
+				trainingRuntimeGVR:        "TrainingRuntimeList",
+				clusterTrainingRuntimeGVR: "ClusterTrainingRuntimeList",
+			}, objects...),
+	}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: informerfactory.NewSharedInformerFactory(vcClient, 0),
+	}
+	controller.Initialize(opt)
+	return controller
+}
+
+func TestSyncTrainJob(t *testing.T) {
+	testCases := []struct {
+		name              string
+		jobSetOwner       string
+		runtimes          []runtime.Object
+		expectedPodGroup  bool
+		expectedMinMember int32
+		expectedCPU       string
+	}{
+		{
+			name:              "the nodes of an elastic training are gang scheduled down to the minimum nodes",
+			jobSetOwner:       trainJobKind,
+			runtimes:          []runtime.Object{newClusterTrainingRuntime()},
+			expectedPodGroup:  true,
+			expectedMinMember: 1 + 2,
+			expectedCPU:       "5",
+		},
+		{
+			name:              "all the nodes are gang scheduled without elastic policy",
+			jobSetOwner:       trainJobKind,
+			expectedPodGroup:  true,
+			expectedMinMember: 1 + 4,
+			expectedCPU:       "9",
+		},
+		{
+			name:        "the JobSets of no TrainJob are skipped",
+			jobSetOwner: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jobSet := newJobSet(tc.jobSetOwner)
+			c := newFakeController(tc.runtimes...)
+			require.NoError(t, c.dynamicInformerFactory.ForResource(jobSetGVR).Informer().GetIndexer().Add(jobSet))
+			require.NoError(t, c.dynamicInformerFactory.ForResource(trainJobGVR).Informer().GetIndexer().Add(newTrainJob()))
+
+			require.NoError(t, c.sync("default/train"))
+			pgs, err := c.vcClient.SchedulingV1beta1().PodGroups("default").List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			if !tc.expectedPodGroup {
+				assert.Empty(t, pgs.Items)
+				return
+			}
+			require.Len(t, pgs.Items, 1)
+			pg := pgs.Items[0]
+			assert.Equal(t, "podgroup-jobset-uid", pg.Name)
+			assert.Equal(t, "JobSet", pg.OwnerReferences[0].Kind)
+			assert.Equal(t, "train", pg.Labels[trainJobLabelKey])
+			assert.Equal(t, tc.expectedMinMember, pg.Spec.MinMember)
+			assert.True(t, resource.MustParse(tc.expectedCPU).Equal((*pg.Spec.MinResources)[v1.ResourceCPU]))
+			assert.Equal(t, "ml", pg.Spec.Queue)
+			assert.Equal(t, "high", pg.Spec.PriorityClassName)
+		})
+	}
+}
+
+func TestSyncTrainJobUpdatesPodGroup(t *testing.T) {
+	ctx := context.TODO()
+	c := newFakeController(newClusterTrainingRuntime())
+	jobSetIndexer := c.dynamicInformerFactory.ForResource(jobSetGVR).Informer().GetIndexer()
+	trainJobIndexer := c.dynamicInformerFactory.ForResource(trainJobGVR).Informer().GetIndexer()
+	require.NoError(t, jobSetIndexer.Add(newJobSet(trainJobKind)))
+	require.NoError(t, trainJobIndexer.Add(newTrainJob()))
+	require.NoError(t, c.sync("default/train"))
+
+	// The PodGroup is admitted, its queue no longer changes, its members follow the JobSet.
+	pg, err := c.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "podgroup-jobset-uid", metav1.GetOptions{})
+	require.NoError(t, err)
+	pg.Status.Phase = scheduling.PodGroupRunning
+	require.NoError(t, c.vcInformerFactory.Scheduling().V1beta1().PodGroups().Informer().GetIndexer().Add(pg))
+	trainJob := newTrainJob()
+	trainJob.SetLabels(map[string]string{vcbatch.QueueNameKey: "other"})
+	require.NoError(t, trainJobIndexer.Update(trainJob))
+	jobSet := newJobSet(trainJobKind)
+	require.NoError(t, unstructured.SetNestedSlice(jobSet.Object, []interface{}{newReplicatedJob(nodeReplicatedJobName, 8, "2")}, "spec", "replicatedJobs"))
+	require.NoError(t, jobSetIndexer.Update(jobSet))
+
+	require.NoError(t, c.sync("default/train"))
+	pg, err = c.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "podgroup-jobset-uid", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), pg.Spec.MinMember)
+	assert.Equal(t, "ml", pg.Spec.Queue)
+	assert.Empty(t, pg.Spec.PriorityClassName)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+)
+
+// UnschedulableForCapacity checks whether the podgroup was admitted by its queue, but could not be scheduled for lack
+// of resources in its last scheduling cycle.
+func UnschedulableForCapacity(pg *scheduling.PodGroup) bool {
+	if pg.Status.Phase != scheduling.PodGroupInqueue {
+		return false
+	}
+	unschedulable := GetPodGroupCondition(pg, scheduling.PodGroupUnschedulableType)
+	if unschedulable == nil || unschedulable.Status != v1.ConditionTrue ||
+		unschedulable.Reason != scheduling.NotEnoughResourcesReason {
+		return false
+	}
+	scheduled := GetPodGroupCondition(pg, scheduling.PodGroupScheduled)
+	return scheduled == nil || !scheduled.LastTransitionTime.After(unschedulable.LastTransitionTime.Time)
+}
+
+// GetPodGroupCondition returns the condition of the podgroup of the given type, nil if it has none.
+func GetPodGroupCondition(pg *scheduling.PodGroup, condType scheduling.PodGroupConditionType) *scheduling.PodGroupCondition {
+	for i := range pg.Status.Conditions {
+		if pg.Status.Conditions[i].Type == condType {
+			return &pg.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// UpdatePodGroupCondition sets the condition of the podgroup, the last transition time is kept if neither the status
+// nor the reason of the condition change.
+func UpdatePodGroupCondition(vcClient vcclientset.Interface, pg *scheduling.PodGroup, cond scheduling.PodGroupCondition) error {
+	pg = pg.DeepCopy()
+	if old := GetPodGroupCondition(pg, cond.Type); old != nil {
+		if old.Status == cond.Status && old.Reason == cond.Reason && old.Message == cond.Message {
+			return nil
+		}
+		if old.Status == cond.Status && old.Reason == cond.Reason {
+			cond.LastTransitionTime = old.LastTransitionTime
+		}
+		*old = cond
+	} else {
+		pg.Status.Conditions = append(pg.Status.Conditions, cond)
+	}
+
+	_, err := vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Update(context.TODO(), pg, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestUnschedulableForCapacity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modify   func(pg *scheduling.PodGroup)
+		expected bool
+	}{
+		{
+			name:     "admitted gang lacking resources",
+			modify:   func(pg *scheduling.PodGroup) {},
+			expected: true,
+		},
+		{
+			name:   "pending gang not admitted by its queue",
+			modify: func(pg *scheduling.PodGroup) { pg.Status.Phase = scheduling.PodGroupPending },
+		},
+		{
+			name:   "gang lacking pods",
+			modify: func(pg *scheduling.PodGroup) { pg.Status.Conditions[0].Reason = scheduling.NotEnoughPodsReason },
+		},
+		{
+			name: "gang scheduled since",
+			modify: func(pg *scheduling.PodGroup) {
+				pg.Status.Conditions = append(pg.Status.Conditions, scheduling.PodGroupCondition{
+					Type:               scheduling.PodGroupScheduled,
+					Status:             v1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(pg.Status.Conditions[0].LastTransitionTime.Add(time.Second)),
+				})
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pg := &scheduling.PodGroup{
+				Status: scheduling.PodGroupStatus{
+					Phase: scheduling.PodGroupInqueue,
+					Conditions: []scheduling.PodGroupCondition{{
+						Type:               scheduling.PodGroupUnschedulableType,
+						Status:             v1.ConditionTrue,
+						Reason:             scheduling.NotEnoughResourcesReason,
+						LastTransitionTime: metav1.Now(),
+					}},
+				},
+			}
+			tc.modify(pg)
+			assert.Equal(t, tc.expected, UnschedulableForCapacity(pg))
+		})
+	}
+}
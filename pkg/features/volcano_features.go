@@ -53,6 +53,18 @@ const (
 	// capacity, preventing cluster autoscalers from triggering unnecessary
 	// scale-ups for pods that are simply waiting for queue admission.
 	SchedulingGatesQueueAdmission featuregate.Feature = "SchedulingGatesQueueAdmission"
+
+	// SchedulingGatesGangAdmission uses Kubernetes schedulingGates to hold the
+	// pods of a job until its queue admits it and its gang minimums are met,
+	// instead of leaving them pending and unschedulable in the meantime.
+	SchedulingGatesGangAdmission featuregate.Feature = "SchedulingGatesGangAdmission"
+
+	// Reservation supports holding capacity for jobs scheduled to start later by Reservations.
+	Reservation featuregate.Feature = "Reservation"
+
+	// PodMigration migrates the annotated pods moved by the defrag action to their new node by checkpoint
+	// and restore, instead of evicting them.
+	PodMigration featuregate.Feature = "PodMigration"
 )
 
 func init() {
@@ -70,4 +82,7 @@ var defaultVolcanoFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec
 	ResourceTopology:              {Default: true, PreRelease: featuregate.Alpha},
 	CronVolcanoJobSupport:         {Default: true, PreRelease: featuregate.Alpha},
 	SchedulingGatesQueueAdmission: {Default: false, PreRelease: featuregate.Alpha},
+	SchedulingGatesGangAdmission:  {Default: false, PreRelease: featuregate.Alpha},
+	Reservation:                   {Default: false, PreRelease: featuregate.Alpha},
+	PodMigration:                  {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -28,7 +28,6 @@ import (
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
-	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/features"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
@@ -43,6 +42,9 @@ type allocateContext struct {
 	jobsByQueue         map[api.QueueID]*util.PriorityQueue // queue of *api.JobInfo
 	jobWorksheet        map[api.JobID]*JobWorksheet
 	tasksNoHardTopology map[api.JobID]*util.PriorityQueue // queue of *api.TaskInfo, job without any hard network topology policy use this queue
+	gangGroups          map[string]*gangGroup             // the jobs in gang groups, by the name of their group
+	queueTurns          map[api.QueueID]int               // the turns served to the queues, when served in turns
+	queuePods           map[api.QueueID]int               // the pods allocated to the queues in the cycle
 }
 
 type JobWorksheet struct {
@@ -98,6 +100,14 @@ type Action struct {
 	session *framework.Session
 	// configured flag for error cache
 	enablePredicateErrorCache bool
+	// queueArguments are the arguments overridden for the queues
+	queueArguments framework.QueueArguments
+	// parallelTasks is the number of the tasks of a job placed concurrently
+	parallelTasks int
+	// roundRobinQueues serves the queues in turns
+	roundRobinQueues bool
+	// maxPodsPerQueue is the number of the pods allocated to a queue per cycle, 0 if unlimited
+	maxPodsPerQueue int
 
 	recorder *Recorder
 }
@@ -105,6 +115,7 @@ type Action struct {
 func New() *Action {
 	return &Action{
 		enablePredicateErrorCache: true, // default to enable it
+		parallelTasks:             1,
 	}
 }
 
@@ -117,6 +128,19 @@ func (alloc *Action) Initialize() {}
 func (alloc *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, alloc.Name())
 	arguments.GetBool(&alloc.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	arguments.GetInt(&alloc.parallelTasks, ParallelTasksKey)
+	arguments.GetBool(&alloc.roundRobinQueues, RoundRobinQueuesKey)
+	arguments.GetInt(&alloc.maxPodsPerQueue, MaxPodsPerQueueKey)
+	alloc.queueArguments = ssn.GetQueueArgsOfAction(alloc.Name())
+}
+
+// predicateErrorCacheEnabled returns whether the predicate error cache is enabled for the task, overridden by its queue.
+func (alloc *Action) predicateErrorCacheEnabled(task *api.TaskInfo) bool {
+	job, found := alloc.session.Jobs[task.Job]
+	if !found {
+		return alloc.enablePredicateErrorCache
+	}
+	return alloc.queueArguments.GetBool(job.Queue, conf.EnablePredicateErrCacheKey, alloc.enablePredicateErrorCache)
 }
 
 func (alloc *Action) Execute(ssn *framework.Session) {
@@ -137,19 +161,26 @@ func (alloc *Action) Execute(ssn *framework.Session) {
 	actx := alloc.buildAllocateContext()
 	klog.V(3).Infof("Try to allocate resource to %d Queues", actx.queues.Len())
 	alloc.allocateResources(actx)
+	alloc.discardGangGroups(actx)
 }
 
 func (alloc *Action) buildAllocateContext() *allocateContext {
 	ssn := alloc.session
 
 	actx := &allocateContext{
-		queues:              util.NewPriorityQueue(ssn.QueueOrderFn), // queues sort queues by QueueOrderFn.
 		jobsByQueue:         make(map[api.QueueID]*util.PriorityQueue),
 		jobWorksheet:        make(map[api.JobID]*JobWorksheet),
 		tasksNoHardTopology: make(map[api.JobID]*util.PriorityQueue),
+		gangGroups:          buildGangGroups(ssn),
+		queueTurns:          make(map[api.QueueID]int),
+		queuePods:           make(map[api.QueueID]int),
 	}
+	actx.queues = util.NewPriorityQueue(alloc.queueOrderFn(actx)) // queues sort queues by QueueOrderFn, in turns if configured.
 
 	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
 		// If not config enqueue action, change Pending pg into Inqueue state to avoid blocking job scheduling.
 		if job.IsPending() {
 			if conf.EnabledActionMap["enqueue"] {
@@ -296,6 +327,11 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 			continue
 		}
 
+		if alloc.queueExhausted(actx, queue) {
+			klog.V(3).Infof("Queue <%s> allocated %d pods in this cycle, ignore it.", queue.Name, actx.queuePods[queue.UID])
+			continue
+		}
+
 		jobs, found := actx.jobsByQueue[queue.UID]
 		if !found || jobs.Empty() {
 			klog.V(4).Infof("Can not find jobs for queue %s.", queue.Name)
@@ -311,8 +347,9 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 			klog.V(3).InfoS("Try to allocate resource for job contains hard topology or subjob policy", "queue", queue.Name, "job", job.UID,
 				"allocatedHyperNode", job.AllocatedHyperNode, "subJobNum", jobWorksheet.subJobs.Len())
 			stmt := alloc.allocateForJob(job, jobWorksheet, ssn.HyperNodes[framework.ClusterTopHyperNode])
-			if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
-				stmt.Commit()
+			pods := allocatedPods(stmt)
+			alloc.commit(actx, job, stmt, func() {
+				actx.queuePods[queue.UID] += pods
 				ssn.MarkJobDirty(job.UID)
 				alloc.recorder.UpdateDecisionToJob(job, ssn.HyperNodes)
 
@@ -320,7 +357,7 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 				if !jobWorksheet.Empty() {
 					jobs.Push(job)
 				}
-			}
+			})
 		} else {
 			subJob, sjExist := job.SubJobs[job.DefaultSubJobID()]
 			tasks, tasksExist := actx.tasksNoHardTopology[job.UID]
@@ -340,9 +377,9 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 					stmt = alloc.allocateResourcesForTasks(subJob, tasks, framework.ClusterTopHyperNode)
 				}
 
-				if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
-					stmt.Commit()
-
+				pods := allocatedPods(stmt)
+				alloc.commit(actx, job, stmt, func() {
+					actx.queuePods[queue.UID] += pods
 					// Mirror recorder.UpdateDecisionToJob: clear the redeemed nomination.
 					if subJob.NominatedHyperNode != "" {
 						klog.V(3).InfoS("clear nominated hyperNode for committed subJob",
@@ -354,7 +391,7 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 					if tasks.Len() > 0 {
 						jobs.Push(job)
 					}
-				}
+				})
 			} else {
 				klog.ErrorS(nil, "Can not find default subJob or tasks for job", "job", job.UID,
 					"subJobExist", sjExist, "tasksExist", tasksExist)
@@ -363,6 +400,7 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 
 		// Put back the queue to priority queue after job's resource allocating finished,
 		// To ensure that the priority of the queue is calculated based on the latest resource allocation situation.
+		actx.queueTurns[queue.UID]++
 		queues.Push(queue)
 	}
 }
@@ -691,7 +729,7 @@ func (alloc *Action) validateNomination(subJob *api.SubJobInfo, subJobWorksheet
 				"subJob", subJob.UID, "task", task.UID, "node", nominated, "err", err)
 			return nil, false
 		}
-		predicateNodes, _ := ph.PredicateNodes(task, []*api.NodeInfo{nodeInfo}, alloc.predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
+		predicateNodes, _ := ph.PredicateNodes(task, []*api.NodeInfo{nodeInfo}, alloc.predicate, alloc.predicateErrorCacheEnabled(task), ssn.NodesInShard)
 		if len(predicateNodes) == 0 {
 			klog.V(3).InfoS("Predicate failed against nominated node, falling back to normal allocation process",
 				"subJob", subJob.UID, "task", task.UID, "node", nominated)
@@ -739,7 +777,15 @@ func (alloc *Action) allocateResourcesForTasks(subJob *api.SubJobInfo, tasks *ut
 
 	allocatedHyperNode := subJob.AllocatedHyperNode
 
-	for !tasks.Empty() {
+	// The tasks of a job with network topology are placed one by one as every placement narrows the hyperNode of the job,
+	// and so are the tasks of a gang with a composition as every placement narrows the nodes of the other tasks.
+	_, composed := job.PodGroup.Annotations[schedulingv1beta1.GangCompositionKey]
+	ready := false
+	if alloc.parallelTasks > 1 && !subJob.WithNetworkTopology() && !composed && !ssn.SubJobReady(job, subJob) {
+		ready = alloc.allocateTasksInParallel(subJob, tasks, nodes, stmt)
+	}
+
+	for !ready && !tasks.Empty() {
 		task := tasks.Pop().(*api.TaskInfo)
 		if !ssn.Allocatable(queue, task) {
 			klog.V(3).Infof("Queue <%s> is overused when considering task <%s>, ignore it.", queue.Name, task.Name)
@@ -798,14 +844,14 @@ func (alloc *Action) allocateResourcesForTasks(subJob *api.SubJobInfo, tasks *ut
 		if nominated := task.Pod.Status.NominatedNodeName; len(nominated) > 0 {
 			if _, inLeafSet := nodeNameSet[nominated]; inLeafSet {
 				if nominatedNodeInfo, ok := ssn.Nodes[nominated]; ok && task.InitResreq.LessEqual(nominatedNodeInfo.FutureIdle(), api.Zero) {
-					predicateNodes, fitErrors = ph.PredicateNodes(task, []*api.NodeInfo{nominatedNodeInfo}, alloc.predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
+					predicateNodes, fitErrors = ph.PredicateNodes(task, []*api.NodeInfo{nominatedNodeInfo}, alloc.predicate, alloc.predicateErrorCacheEnabled(task), ssn.NodesInShard)
 				}
 			}
 		}
 
 		// If the nominated node is not found or the nominated node is not suitable for the task, we need to find a suitable node for the task from all nodes.
 		if len(predicateNodes) == 0 {
-			predicateNodes, fitErrors = ph.PredicateNodes(task, nodes, alloc.predicate, alloc.enablePredicateErrorCache, ssn.NodesInShard)
+			predicateNodes, fitErrors = ph.PredicateNodes(task, nodes, alloc.predicate, alloc.predicateErrorCacheEnabled(task), ssn.NodesInShard)
 		}
 
 		if len(predicateNodes) == 0 {
@@ -885,7 +931,7 @@ func (alloc *Action) prioritizeNodes(ssn *framework.Session, task *api.TaskInfo,
 	// - The second gradient node: the node list whose sum of node idle resources and future idle meets the task resource request;
 	// Score the first gradient node first. If the first gradient node meets the requirements, ignore the second gradient node list,
 	// otherwise, score the second gradient node and select the appropriate node.
-	shardingMode := options.ServerOpts.ShardingMode
+	shardingMode := util.TaskShardingMode(task)
 	var candidateNodes [][]*api.NodeInfo
 	var idleCandidateNodes []*api.NodeInfo
 	var futureIdleCandidateNodes []*api.NodeInfo
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// gangGroup is the jobs in the same gang group, whose allocations are committed together.
+type gangGroup struct {
+	members []*api.JobInfo
+	// spansPipelines is set when a job of the group is not scheduled by the pipeline, the group is never committed then.
+	spansPipelines bool
+	// pending are the allocations of the members held until every member is ready or pipelined.
+	pending []pendingAllocation
+}
+
+// pendingAllocation is the allocation of a member of a gang group, committed is called once it is committed.
+type pendingAllocation struct {
+	job       *api.JobInfo
+	stmt      *framework.Statement
+	committed func()
+}
+
+// buildGangGroups groups the jobs of the session by their gang group, the members are the jobs scheduled by the
+// pipeline and the groups with a job scheduled by another pipeline or scheduler are marked as spanning pipelines.
+func buildGangGroups(ssn *framework.Session) map[string]*gangGroup {
+	groups := map[string]*gangGroup{}
+	for _, job := range ssn.Jobs {
+		name := job.GangGroup()
+		if name == "" {
+			continue
+		}
+		if _, found := groups[name]; !found {
+			groups[name] = &gangGroup{}
+		}
+		if !ssn.JobInPipeline(job) {
+			groups[name].spansPipelines = true
+			continue
+		}
+		groups[name].members = append(groups[name].members, job)
+	}
+	return groups
+}
+
+// commit commits the allocation of the job when it is ready, and calls committed. The allocation of a job in a gang
+// group is held until every member of the group is ready or pipelined, and the allocations of the ready members are
+// committed then; the allocations held are discarded as soon as a member can not be pipelined.
+func (alloc *Action) commit(actx *allocateContext, job *api.JobInfo, stmt *framework.Statement, committed func()) {
+	ssn := alloc.session
+
+	group, found := actx.gangGroups[job.GangGroup()]
+	if !found {
+		if stmt != nil && ssn.JobReady(job) { // do not commit stmt when job is pipelined
+			stmt.Commit()
+			committed()
+		}
+		return
+	}
+
+	if group.spansPipelines {
+		klog.V(3).InfoS("Discard the allocation of job, its gang group spans several pipelines", "gangGroup", job.GangGroup(), "job", job.UID)
+		if stmt != nil {
+			stmt.Discard()
+		}
+		return
+	}
+	if stmt == nil {
+		klog.V(3).InfoS("Discard the allocations of gang group, a member can not be pipelined", "gangGroup", job.GangGroup(), "job", job.UID)
+		group.discard()
+		return
+	}
+	group.pending = append(group.pending, pendingAllocation{job: job, stmt: stmt, committed: committed})
+
+	for _, member := range group.members {
+		if !ssn.JobReady(member) && !ssn.JobPipelined(member) {
+			klog.V(4).InfoS("Hold the allocation of job until every member of its gang group is pipelined",
+				"gangGroup", job.GangGroup(), "job", job.UID, "member", member.UID)
+			return
+		}
+	}
+
+	klog.V(3).InfoS("Commit the allocations of gang group", "gangGroup", job.GangGroup(), "members", len(group.members))
+	for _, p := range group.pending {
+		if ssn.JobReady(p.job) { // do not commit stmt when job is pipelined
+			p.stmt.Commit()
+			p.committed()
+		}
+	}
+	group.pending = nil
+}
+
+// discard discards the allocations held for the members of the gang group.
+func (g *gangGroup) discard() {
+	for i := len(g.pending) - 1; i >= 0; i-- {
+		g.pending[i].stmt.Discard()
+	}
+	g.pending = nil
+}
+
+// discardGangGroups discards the allocations held for the gang groups which are not pipelined in the cycle.
+func (alloc *Action) discardGangGroups(actx *allocateContext) {
+	for name, group := range actx.gangGroups {
+		if len(group.pending) != 0 {
+			klog.V(3).InfoS("Discard the allocations of gang group, not every member is pipelined", "gangGroup", name)
+			group.discard()
+		}
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestAllocateGangGroups(t *testing.T) {
+	buildPodGroup := func(name, group string, minMember int32, phase schedulingv1.PodGroupPhase) *schedulingv1.PodGroup {
+		pg := util.BuildPodGroup(name, "c1", "c1", minMember, nil, phase)
+		if group != "" {
+			pg.Labels = map[string]string{schedulingv1.GangGroupKey: group}
+		}
+		return pg
+	}
+	nodes := []*v1.Node{
+		util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+	}
+	queues := []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name: "every member of the gang group is ready",
+			PodGroups: []*schedulingv1.PodGroup{
+				buildPodGroup("trainer", "g1", 2, schedulingv1.PodGroupInqueue),
+				buildPodGroup("ps", "g1", 1, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "trainer-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "trainer", nil, nil),
+				util.BuildPod("c1", "trainer-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "trainer", nil, nil),
+				util.BuildPod("c1", "ps-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "ps", nil, nil),
+			},
+			Nodes:  nodes,
+			Queues: queues,
+			ExpectBindMap: map[string]string{
+				"c1/trainer-0": "n1",
+				"c1/trainer-1": "n1",
+				"c1/ps-0":      "n1",
+			},
+			ExpectBindsNum: 3,
+		},
+		{
+			Name: "a member of the gang group does not fit, none is committed",
+			PodGroups: []*schedulingv1.PodGroup{
+				buildPodGroup("trainer", "g1", 2, schedulingv1.PodGroupInqueue),
+				buildPodGroup("ps", "g1", 1, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "trainer-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "trainer", nil, nil),
+				util.BuildPod("c1", "trainer-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "trainer", nil, nil),
+				util.BuildPod("c1", "ps-0", "", v1.PodPending, api.BuildResourceList("3", "1G"), "ps", nil, nil),
+			},
+			Nodes:          nodes,
+			Queues:         queues,
+			ExpectBindMap:  map[string]string{},
+			ExpectBindsNum: 0,
+		},
+		{
+			Name: "a member of the gang group can not be placed, the jobs in no gang group are committed",
+			PodGroups: []*schedulingv1.PodGroup{
+				buildPodGroup("trainer", "g1", 1, schedulingv1.PodGroupInqueue),
+				buildPodGroup("ps", "g1", 1, schedulingv1.PodGroupInqueue),
+				buildPodGroup("standalone", "", 1, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "trainer-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "trainer", nil, nil),
+				util.BuildPod("c1", "ps-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "ps", nil, map[string]string{"nodeRole": "ps"}),
+				util.BuildPod("c1", "standalone-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "standalone", nil, nil),
+			},
+			Nodes:  nodes,
+			Queues: queues,
+			ExpectBindMap: map[string]string{
+				"c1/standalone-0": "n1",
+			},
+			ExpectBindsNum: 1,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledAllocatable: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = map[string]framework.PluginBuilder{
+				gang.PluginName:       gang.New,
+				proportion.PluginName: proportion.New,
+				predicates.PluginName: predicates.New,
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestAllocateGangGroupSpanningPipelines(t *testing.T) {
+	trueValue := true
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{
+		{Name: gang.PluginName, EnabledJobReady: &trueValue, EnabledJobPipelined: &trueValue},
+		{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+	}}}
+	trainer := util.BuildPodGroup("trainer", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue)
+	trainer.Labels = map[string]string{schedulingv1.GangGroupKey: "g1"}
+	ps := util.BuildPodGroup("ps", "c1", "realtime", 1, nil, schedulingv1.PodGroupRunning)
+	ps.Labels = map[string]string{schedulingv1.GangGroupKey: "g1"}
+	realtime := util.BuildQueue("realtime", 1, nil)
+	realtime.Labels = map[string]string{api.QueueClassLabel: "realtime"}
+
+	test := uthelper.TestCommonStruct{
+		Name: "gang group spanning pipelines is not committed, even with its members of the other pipeline running",
+		Plugins: map[string]framework.PluginBuilder{
+			gang.PluginName:       gang.New,
+			predicates.PluginName: predicates.New,
+		},
+		PodGroups: []*schedulingv1.PodGroup{
+			trainer,
+			ps,
+			util.BuildPodGroup("standalone", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "trainer-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "trainer", nil, nil),
+			util.BuildPod("c1", "ps-0", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "ps", nil, nil),
+			util.BuildPod("c1", "standalone-0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "standalone", nil, nil),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil), realtime},
+		ExpectBindMap:  map[string]string{"c1/standalone-0": "n1"},
+		ExpectBindsNum: 1,
+	}
+	ssn := test.RegisterSession(tiers, nil)
+	defer test.Close()
+	ssn.SetPipelineClasses(sets.New("realtime"))
+	test.Run([]framework.Action{New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Fatal(err)
+	}
+}
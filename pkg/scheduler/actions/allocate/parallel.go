@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// ParallelTasksKey is the number of the tasks of a job which are predicated and scored concurrently, 1 by default,
+// i.e. the tasks are placed one by one.
+const ParallelTasksKey = "parallelTasks"
+
+// parallelizable returns whether the task can be placed concurrently with the other tasks of its job: it has no
+// constraint on the placement of the other pods, i.e. no pod (anti-)affinity, topology spread constraint or resource
+// claim, and no nominated node.
+func parallelizable(task *api.TaskInfo) bool {
+	pod := task.Pod
+	if pod == nil || len(pod.Status.NominatedNodeName) > 0 {
+		return false
+	}
+	if affinity := pod.Spec.Affinity; affinity != nil && (affinity.PodAffinity != nil || affinity.PodAntiAffinity != nil) {
+		return false
+	}
+	return len(pod.Spec.TopologySpreadConstraints) == 0 && len(pod.Spec.ResourceClaims) == 0
+}
+
+// splitNodes splits the nodes into n disjoint sets.
+func splitNodes(nodes []*api.NodeInfo, n int) [][]*api.NodeInfo {
+	sets := make([][]*api.NodeInfo, n)
+	for i, node := range nodes {
+		if node != nil {
+			sets[i%n] = append(sets[i%n], node)
+		}
+	}
+	return sets
+}
+
+// allocateTasksInParallel places the tasks of the subJob by batches of parallelTasks tasks: every task of a batch is
+// predicated and scored concurrently on its own disjoint set of the nodes, then the placements are checked again and
+// allocated to the statement one by one in task order. The tasks which can not be placed concurrently, or which do not
+// fit their set of nodes, are left in tasks for the sequential allocation. It returns whether the subJob is ready.
+func (alloc *Action) allocateTasksInParallel(subJob *api.SubJobInfo, tasks *util.PriorityQueue, nodes []*api.NodeInfo,
+	stmt *framework.Statement) bool {
+	ssn := alloc.session
+	job := ssn.Jobs[subJob.Job]
+	queue := ssn.Queues[job.Queue]
+
+	var deferred []*api.TaskInfo
+	defer func() {
+		for _, task := range deferred {
+			tasks.Push(task)
+		}
+	}()
+
+	for !tasks.Empty() {
+		batch := make([]*api.TaskInfo, 0, alloc.parallelTasks)
+		for !tasks.Empty() && len(batch) < alloc.parallelTasks {
+			task := tasks.Pop().(*api.TaskInfo)
+			if task.SchGated || !parallelizable(task) || job.TaskHasFitErrors(subJob.UID, task) || !ssn.Allocatable(queue, task) {
+				deferred = append(deferred, task)
+				continue
+			}
+			if err := ssn.PrePredicateFn(task); err != nil {
+				deferred = append(deferred, task)
+				continue
+			}
+			batch = append(batch, task)
+		}
+		if len(batch) < 2 {
+			deferred = append(deferred, batch...)
+			return false
+		}
+
+		nodeSets := splitNodes(nodes, len(batch))
+		placements := make([]*api.NodeInfo, len(batch))
+		workqueue.ParallelizeUntil(context.TODO(), len(batch), len(batch), func(i int) {
+			// the predicate error cache of the helper is not safe for concurrent use
+			predicateNodes, _ := util.NewPredicateHelper().PredicateNodes(batch[i], nodeSets[i], alloc.predicate, false, ssn.NodesInShard)
+			if len(predicateNodes) > 0 {
+				placements[i], _ = alloc.prioritizeNodes(ssn, batch[i], predicateNodes)
+			}
+		})
+
+		allocated := 0
+		for i, task := range batch {
+			node := placements[i]
+			// the former tasks of the batch are allocated on other nodes, but they may have changed the queue
+			if node == nil || !ssn.Allocatable(queue, task) || alloc.predicate(task, node) != nil {
+				deferred = append(deferred, task)
+				continue
+			}
+			if err := alloc.allocateResourcesForTask(stmt, task, node, job); err != nil {
+				klog.ErrorS(err, "Allocate resources for task fail", "task", task.Name)
+				deferred = append(deferred, task)
+				continue
+			}
+			allocated++
+			if ssn.SubJobReady(job, subJob) {
+				deferred = append(deferred, batch[i+1:]...)
+				return true
+			}
+		}
+		klog.V(4).InfoS("Allocated tasks in parallel", "job", job.UID, "batch", len(batch), "allocated", allocated)
+		if allocated == 0 {
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestAllocateTasksInParallel(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		proportion.PluginName: proportion.New,
+		predicates.PluginName: predicates.New,
+		gang.PluginName:       gang.New,
+	}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledAllocatable: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+	buildPods := func(n int, cpu string) []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < n; i++ {
+			pods = append(pods, util.BuildPod("c1", "p"+string(rune('0'+i)), "", v1.PodPending, api.BuildResourceList(cpu, "1G"), "pg1",
+				map[string]string{"volcano.sh/task-spec": "worker"}, make(map[string]string)))
+		}
+		return pods
+	}
+	node := func(name, cpu string) *v1.Node {
+		return util.BuildNode(name, api.BuildResourceList(cpu, "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string))
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name: "the tasks are placed on disjoint sets of nodes",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "c1", 6, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods:             buildPods(6, "1"),
+			Nodes:            []*v1.Node{node("n1", "2"), node("n2", "2"), node("n3", "2")},
+			Queues:           []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+			ExpectBindsNum:   6,
+			MinimalBindCheck: true,
+		},
+		{
+			Name: "the tasks which do not fit their set of nodes are placed sequentially",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "c1", 2, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods:  buildPods(2, "2"),
+			Nodes: []*v1.Node{node("n1", "4"), node("n2", "1"), node("n3", "1")},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueue("c1", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p0": "n1", "c1/p1": "n1"},
+			ExpectBindsNum: 2,
+		},
+		{
+			Name: "the gang is not allocated if it does not fit",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "c1", 4, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods:           buildPods(4, "2"),
+			Nodes:          []*v1.Node{node("n1", "4"), node("n2", "2")},
+			Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+			ExpectBindsNum: 0,
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = plugins
+			test.RegisterSession(tiers, []conf.Configuration{{Name: "allocate",
+				Arguments: map[string]interface{}{ParallelTasksKey: 2}}})
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestParallelizable(t *testing.T) {
+	pod := util.BuildPod("c1", "p0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil)
+	if !parallelizable(api.NewTaskInfo(pod)) {
+		t.Errorf("expected a pod without constraints to be placed in parallel")
+	}
+	pod.Spec.Affinity = &v1.Affinity{PodAntiAffinity: &v1.PodAntiAffinity{}}
+	if parallelizable(api.NewTaskInfo(pod)) {
+		t.Errorf("expected a pod with anti-affinity to be placed sequentially")
+	}
+	pod.Spec.Affinity = nil
+	pod.Status.NominatedNodeName = "n1"
+	if parallelizable(api.NewTaskInfo(pod)) {
+		t.Errorf("expected a pod with a nominated node to be placed sequentially")
+	}
+
+	nodes := []*api.NodeInfo{{Name: "n1"}, {Name: "n2"}, {Name: "n3"}}
+	sets := splitNodes(nodes, 2)
+	if len(sets) != 2 || len(sets[0]) != 2 || len(sets[1]) != 1 || sets[1][0].Name != "n2" {
+		t.Errorf("unexpected node sets %v", sets)
+	}
+}
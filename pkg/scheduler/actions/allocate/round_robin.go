@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// RoundRobinQueuesKey serves the queues in turns, false by default: every queue allocates a job, or a task of a
+	// ready job, per round, in the order of the queue order plugins within the round. Otherwise the queue first in the
+	// queue order is served again and again until its order changes, e.g. a queue keeping the lowest share as it
+	// deserves most of the cluster.
+	RoundRobinQueuesKey = "roundRobinQueues"
+	// MaxPodsPerQueueKey is the number of the pods allocated to the jobs of a queue per cycle, 0, i.e. unlimited, by
+	// default. The queue is not served any more in the cycle once it allocated as many pods, the allocation of a gang
+	// in progress is not split. It can be overridden by queue.
+	MaxPodsPerQueueKey = "maxPodsPerQueue"
+)
+
+// queueOrderFn returns the order of the queues of the context: the queue order of the session, served in turns if
+// roundRobinQueues is set.
+func (alloc *Action) queueOrderFn(actx *allocateContext) api.LessFn {
+	ssn := alloc.session
+	if !alloc.roundRobinQueues {
+		return ssn.QueueOrderFn
+	}
+	return func(l, r interface{}) bool {
+		lv := l.(*api.QueueInfo)
+		rv := r.(*api.QueueInfo)
+		if lt, rt := actx.queueTurns[lv.UID], actx.queueTurns[rv.UID]; lt != rt {
+			return lt < rt
+		}
+		return ssn.QueueOrderFn(l, r)
+	}
+}
+
+// queueExhausted returns whether the queue allocated its maximum number of pods in the cycle.
+func (alloc *Action) queueExhausted(actx *allocateContext, queue *api.QueueInfo) bool {
+	maxPods := alloc.queueArguments.GetInt(queue.UID, MaxPodsPerQueueKey, alloc.maxPodsPerQueue)
+	return maxPods > 0 && actx.queuePods[queue.UID] >= maxPods
+}
+
+// allocatedPods returns the number of the pods allocated by the statement.
+func allocatedPods(stmt *framework.Statement) int {
+	if stmt == nil {
+		return 0
+	}
+	count := 0
+	for _, op := range stmt.Operations() {
+		if op.Type == framework.Allocate {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allocate
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestAllocateQueuesInTurns(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		predicates.PluginName: predicates.New,
+		gang.PluginName:       gang.New,
+	}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+	buildPods := func(group string, n int) []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < n; i++ {
+			pods = append(pods, util.BuildPod("c1", fmt.Sprintf("%s-%d", group, i), "", v1.PodPending, api.BuildResourceList("1", "1G"), group,
+				make(map[string]string), make(map[string]string)))
+		}
+		return pods
+	}
+	buildTest := func(name string, nodeCPU string, expectBinds int, expectBindMap map[string]string) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("big", "c1", "big", 1, nil, schedulingv1.PodGroupInqueue),
+				util.BuildPodGroup("small", "c1", "small", 1, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods: append(buildPods("big", 6), buildPods("small", 1)...),
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList(nodeCPU, "16Gi", []api.ScalarResource{{Name: "pods", Value: "20"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueue("big", 1, nil),
+				util.BuildQueue("small", 1, nil),
+			},
+			ExpectBindsNum: expectBinds,
+			ExpectBindMap:  expectBindMap,
+		}
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments      framework.Arguments
+		queueArguments map[string]map[string]interface{}
+	}{
+		{
+			TestCommonStruct: buildTest("the queue first in the queue order takes the whole cluster", "6", 6,
+				map[string]string{"c1/big-0": "n1", "c1/big-1": "n1", "c1/big-2": "n1", "c1/big-3": "n1", "c1/big-4": "n1", "c1/big-5": "n1"}),
+		},
+		{
+			TestCommonStruct: buildTest("the queues are served in turns", "6", 6,
+				map[string]string{"c1/big-0": "n1", "c1/big-1": "n1", "c1/big-2": "n1", "c1/big-3": "n1", "c1/big-4": "n1", "c1/small-0": "n1"}),
+			arguments: framework.Arguments{RoundRobinQueuesKey: true},
+		},
+		{
+			TestCommonStruct: buildTest("the pods of a queue are limited per cycle", "8", 3,
+				map[string]string{"c1/big-0": "n1", "c1/big-1": "n1", "c1/small-0": "n1"}),
+			arguments: framework.Arguments{MaxPodsPerQueueKey: 2},
+		},
+		{
+			TestCommonStruct: buildTest("the pods of a queue are limited per cycle by queue", "8", 3,
+				map[string]string{"c1/big-0": "n1", "c1/big-1": "n1", "c1/small-0": "n1"}),
+			queueArguments: map[string]map[string]interface{}{"big": {MaxPodsPerQueueKey: 2}},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			config := []conf.Configuration{{
+				Name:           "allocate",
+				Arguments:      test.arguments,
+				QueueArguments: test.queueArguments,
+			}}
+			test.RegisterSession(tiers, config)
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
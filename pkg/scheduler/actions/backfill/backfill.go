@@ -36,6 +36,8 @@ import (
 
 type Action struct {
 	enablePredicateErrorCache bool
+	// queueArguments are the arguments overridden for the queues
+	queueArguments framework.QueueArguments
 }
 
 func New() *Action {
@@ -53,6 +55,7 @@ func (backfill *Action) Initialize() {}
 func (backfill *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, backfill.Name())
 	arguments.GetBool(&backfill.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	backfill.queueArguments = ssn.GetQueueArgsOfAction(backfill.Name())
 }
 
 func (backfill *Action) Execute(ssn *framework.Session) {
@@ -61,13 +64,12 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 
 	backfill.parseArguments(ssn)
 
-	predicateFunc := ssn.PredicateForAllocateAction
+	predicateFunc := ssn.PredicateForBackfillAction
 
 	// TODO (k82cn): When backfill, it's also need to balance between Queues.
 	pendingTasks := backfill.pickUpPendingTasks(ssn)
 	for _, task := range pendingTasks {
 		job := ssn.Jobs[task.Job]
-		ph := util.NewPredicateHelper()
 		fe := api.NewFitErrors()
 
 		if err := ssn.PrePredicateFn(task); err != nil {
@@ -79,27 +81,12 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 			continue
 		}
 
-		predicateNodes, fitErrors := ph.PredicateNodes(task, ssn.NodeList, predicateFunc, backfill.enablePredicateErrorCache, ssn.NodesInShard)
-		if len(predicateNodes) == 0 {
+		node, fitErrors := backfill.selectNode(ssn, task, job, predicateFunc)
+		if node == nil {
 			job.NodesFitErrors[task.UID] = fitErrors
 			continue
 		}
 
-		node := predicateNodes[0]
-		if len(predicateNodes) > 1 {
-			candidateNodes := util.GetPredicatedNodeByShard(predicateNodes, ssn.NodesInShard)
-			for _, nodes := range candidateNodes {
-				nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
-				node = ssn.BestNodeFn(task, nodeScores)
-				if node == nil {
-					node, _ = util.SelectBestNodeAndScore(nodeScores)
-				}
-				if node != nil {
-					break
-				}
-			}
-		}
-
 		klog.V(3).Infof("Binding Task <%v/%v> to node <%v>", task.Namespace, task.Name, node.Name)
 		if err := ssn.Allocate(task, node); err != nil {
 			klog.Errorf("Failed to bind Task %v on %v in Session %v", task.UID, node.Name, ssn.UID)
@@ -113,6 +100,106 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 
 		// TODO (k82cn): backfill for other case.
 	}
+
+	backfill.backfillShortJobs(ssn, predicateFunc)
+}
+
+// selectNode returns the best node which the task fits, or the fit errors of the nodes if there is none.
+func (backfill *Action) selectNode(ssn *framework.Session, task *api.TaskInfo, job *api.JobInfo, predicateFunc api.PredicateFn) (*api.NodeInfo, *api.FitErrors) {
+	ph := util.NewPredicateHelper()
+	predicateNodes, fitErrors := ph.PredicateNodes(task, ssn.NodeList, predicateFunc,
+		backfill.queueArguments.GetBool(job.Queue, conf.EnablePredicateErrCacheKey, backfill.enablePredicateErrorCache), ssn.NodesInShard)
+	if len(predicateNodes) == 0 {
+		return nil, fitErrors
+	}
+
+	node := predicateNodes[0]
+	if len(predicateNodes) > 1 {
+		candidateNodes := util.GetPredicatedNodeByShard(task, predicateNodes, ssn.NodesInShard)
+		for _, nodes := range candidateNodes {
+			nodeScores := util.PrioritizeNodes(task, nodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
+			node = ssn.BestNodeFn(task, nodeScores)
+			if node == nil {
+				node, _ = util.SelectBestNodeAndScore(nodeScores)
+			}
+			if node != nil {
+				break
+			}
+		}
+	}
+	return node, fitErrors
+}
+
+// backfillShortJobs allocates the short jobs left pending by allocate, which end before the start time
+// of a Reservation, to the capacity held by the Reservation. A job is allocated only if it gets ready.
+func (backfill *Action) backfillShortJobs(ssn *framework.Session, predicateFunc api.PredicateFn) {
+	now := time.Now()
+	var startTimes []time.Time
+	for _, reservation := range ssn.Reservations {
+		if now.Before(reservation.StartTime) && !reservation.Held().IsEmpty() {
+			startTimes = append(startTimes, reservation.StartTime)
+		}
+	}
+	if len(startTimes) == 0 {
+		return
+	}
+
+	// Unlike best-effort tasks, the tasks of short jobs request resources, so check them first.
+	shortJobPredicate := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if ok, resources := task.InitResreq.LessEqualWithResourcesName(node.Idle, api.Zero); !ok {
+			return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: api.WrapInsufficientResourceReason(resources)})
+		}
+		return predicateFunc(task, node)
+	}
+
+	jobs := util.NewPriorityQueue(ssn.JobOrderFn)
+	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) || job.IsPending() || ssn.JobReady(job) {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			continue
+		}
+		for _, startTime := range startTimes {
+			if framework.IsShortJob(job, ssn.Queues[job.Queue], now, startTime) {
+				jobs.Push(job)
+				break
+			}
+		}
+	}
+
+	for !jobs.Empty() {
+		job := jobs.Pop().(*api.JobInfo)
+		tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+		for _, task := range job.TaskStatusIndex[api.Pending] {
+			if !task.BestEffort && !task.SchGated {
+				tasks.Push(task)
+			}
+		}
+
+		stmt := framework.NewStatement(ssn)
+		for !tasks.Empty() {
+			task := tasks.Pop().(*api.TaskInfo)
+			if err := ssn.PrePredicateFn(task); err != nil {
+				break
+			}
+			node, _ := backfill.selectNode(ssn, task, job, shortJobPredicate)
+			if node == nil {
+				break
+			}
+			if err := stmt.Allocate(task, node); err != nil {
+				klog.Errorf("Failed to allocate Task %v on %v in Session %v: %v", task.UID, node.Name, ssn.UID, err)
+				break
+			}
+		}
+
+		if ssn.JobReady(job) {
+			klog.V(3).Infof("Backfill short job <%s/%s> to reserved capacity", job.Namespace, job.Name)
+			stmt.Commit()
+		} else {
+			stmt.Discard()
+		}
+	}
 }
 
 func (backfill *Action) UnInitialize() {}
@@ -123,6 +210,9 @@ func (backfill *Action) pickUpPendingTasks(ssn *framework.Session) []*api.TaskIn
 	tasks := map[api.JobID]*util.PriorityQueue{}
 	var pendingTasks []*api.TaskInfo
 	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
 		if job.IsPending() {
 			continue
 		}
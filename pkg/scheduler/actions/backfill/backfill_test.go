@@ -18,6 +18,7 @@ package backfill
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
+	"volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -32,7 +34,9 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins/drf"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
 	"volcano.sh/volcano/pkg/scheduler/plugins/priority"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
@@ -176,3 +180,86 @@ func TestPickUpPendingTasks(t *testing.T) {
 		}
 	}
 }
+
+func TestBackfillShortJobs(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		gang.PluginName: gang.New,
+	}
+	deadline := int64(600)
+	shortPod := util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil)
+	shortPod.Spec.ActiveDeadlineSeconds = &deadline
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "short job is backfilled to the reserved capacity",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{shortPod},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+			ExpectBindsNum: 1,
+		},
+		{
+			Name:    "job without deadline is not backfilled to the reserved capacity",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindsNum: 0,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobReady:     &trueValue,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			ssn.Reservations = map[string]*api.ReservationInfo{
+				"rsv": api.NewReservationInfo(&v1alpha1.Reservation{
+					ObjectMeta: metav1.ObjectMeta{Name: "rsv"},
+					Spec: v1alpha1.ReservationSpec{
+						Queue:     "q1",
+						Resources: api.BuildResourceList("2", "2Gi"),
+						StartTime: &metav1.Time{Time: time.Now().Add(2 * time.Hour)},
+					},
+					Status: v1alpha1.ReservationStatus{
+						Nodes: []v1alpha1.ReservedNode{{NodeName: "n1", Resources: api.BuildResourceList("2", "2Gi")}},
+					},
+				}),
+			}
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package burst
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/actions/utils"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// Name is the name of the burst action.
+	Name = "burst"
+
+	// BurstQueuesKey is the list of queues served by the burst lane.
+	BurstQueuesKey = "burstQueues"
+	// VictimQueuesKey is the list of queues the burst lane is allowed to reclaim resources from.
+	VictimQueuesKey = "victimQueues"
+	// LatencySLOKey is the target latency from pod creation to pipeline for the tasks of the burst queues.
+	LatencySLOKey = "latencySLO"
+
+	defaultLatencySLO = 5 * time.Second
+)
+
+// Config is the burst lane configuration, read from the "burst" entry of the scheduler configurations.
+type Config struct {
+	BurstQueues  sets.Set[string]
+	VictimQueues sets.Set[string]
+	LatencySLO   time.Duration
+}
+
+// ParseConfig returns the burst lane configuration in the given scheduler configurations.
+func ParseConfig(configurations []conf.Configuration) *Config {
+	c := &Config{
+		BurstQueues:  sets.New[string](),
+		VictimQueues: sets.New[string](),
+		LatencySLO:   defaultLatencySLO,
+	}
+
+	arguments := framework.GetArgOfActionFromConf(configurations, Name)
+	if queues, found := framework.Get[[]string](arguments, BurstQueuesKey); found {
+		c.BurstQueues.Insert(queues...)
+	}
+	if queues, found := framework.Get[[]string](arguments, VictimQueuesKey); found {
+		c.VictimQueues.Insert(queues...)
+	}
+	var slo string
+	arguments.GetString(&slo, LatencySLOKey)
+	if slo != "" {
+		if d, err := time.ParseDuration(slo); err != nil || d <= 0 {
+			klog.Warningf("Invalid %s <%s> in action %s, using default %v", LatencySLOKey, slo, Name, defaultLatencySLO)
+		} else {
+			c.LatencySLO = d
+		}
+	}
+
+	return c
+}
+
+// Enabled returns whether any queue is served by the burst lane.
+func (c *Config) Enabled() bool {
+	return c.BurstQueues.Len() > 0
+}
+
+// Action places the starving jobs of the burst queues, on idle resources first, and by
+// reclaiming the running tasks of the victim queues otherwise.
+type Action struct {
+	enablePredicateErrorCache bool
+	config                    *Config
+	// queueArguments are the arguments overridden for the queues
+	queueArguments framework.QueueArguments
+}
+
+func New() *Action {
+	return &Action{
+		enablePredicateErrorCache: true,
+	}
+}
+
+func (ba *Action) Name() string {
+	return Name
+}
+
+func (ba *Action) Initialize() {}
+
+func (ba *Action) parseArguments(ssn *framework.Session) {
+	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, ba.Name())
+	arguments.GetBool(&ba.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	ba.queueArguments = ssn.GetQueueArgsOfAction(ba.Name())
+	ba.config = ParseConfig(ssn.Configurations)
+}
+
+func (ba *Action) Execute(ssn *framework.Session) {
+	klog.V(5).Infof("Enter Burst ...")
+	defer klog.V(5).Infof("Leaving Burst ...")
+
+	ba.parseArguments(ssn)
+	if !ba.config.Enabled() {
+		klog.V(4).Infof("No burst queues configured, skip burst.")
+		return
+	}
+
+	jobs := util.NewPriorityQueue(ssn.JobOrderFn)
+	for _, job := range ssn.Jobs {
+		queue, found := ssn.Queues[job.Queue]
+		if !found || !ba.config.BurstQueues.Has(queue.Name) {
+			continue
+		}
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
+		if job.IsPending() {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			klog.V(4).Infof("Job <%s/%s> Queue <%s> skip burst, reason: %v, message %v", job.Namespace, job.Name, job.Queue, vr.Reason, vr.Message)
+			continue
+		}
+		if ssn.JobStarving(job) {
+			jobs.Push(job)
+		}
+	}
+
+	for !jobs.Empty() {
+		job := jobs.Pop().(*api.JobInfo)
+		queue := ssn.Queues[job.Queue]
+		stmt := framework.NewStatement(ssn)
+
+		tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+		for _, task := range job.TaskStatusIndex[api.Pending] {
+			if task.SchGated {
+				continue
+			}
+			tasks.Push(task)
+		}
+
+		var placed []*api.TaskInfo
+		for !tasks.Empty() && ssn.JobStarving(job) {
+			task := tasks.Pop().(*api.TaskInfo)
+			if err := ssn.PrePredicateFn(task); err != nil {
+				klog.V(3).Infof("PrePredicate failed for task %s/%s: %v", task.Namespace, task.Name, err)
+				continue
+			}
+			if ba.placeTask(ssn, stmt, queue, task, job) {
+				placed = append(placed, task)
+			}
+		}
+
+		if !ssn.JobPipelined(job) {
+			stmt.Discard()
+			continue
+		}
+		stmt.Commit()
+
+		now := time.Now()
+		for _, task := range placed {
+			latency := now.Sub(task.Pod.CreationTimestamp.Time)
+			metrics.UpdateBurstLatency(queue.Name, latency)
+			if latency > ba.config.LatencySLO {
+				metrics.RegisterBurstSLOViolation(queue.Name)
+				klog.V(3).Infof("Task <%s/%s> of burst queue <%s> was pipelined after %v, exceeding the SLO %v",
+					task.Namespace, task.Name, queue.Name, latency, ba.config.LatencySLO)
+			}
+		}
+	}
+}
+
+// placeTask places the task on the first node with enough idle resources, or on the first node
+// where reclaiming the running tasks of the victim queues frees enough resources for it.
+func (ba *Action) placeTask(ssn *framework.Session, stmt *framework.Statement, queue *api.QueueInfo, task *api.TaskInfo, job *api.JobInfo) bool {
+	totalNodes := ssn.FilterOutUnschedulableAndUnresolvableNodesForTask(task)
+	predicateHelper := util.NewPredicateHelper()
+	predicateNodes, _ := predicateHelper.PredicateNodes(task, totalNodes, ssn.PredicateForPreemptAction,
+		ba.queueArguments.GetBool(queue.UID, conf.EnablePredicateErrCacheKey, ba.enablePredicateErrorCache), ssn.NodesInShard)
+
+	if ssn.Allocatable(queue, task) {
+		for _, n := range predicateNodes {
+			if !task.InitResreq.LessEqual(n.Idle, api.Zero) {
+				continue
+			}
+			if err := stmt.Allocate(task, n); err != nil {
+				klog.Errorf("Failed to allocate Task <%s/%s> on Node <%s>: %v", task.Namespace, task.Name, n.Name, err)
+				continue
+			}
+			return true
+		}
+	}
+
+	if task.Pod.Spec.PreemptionPolicy != nil && *task.Pod.Spec.PreemptionPolicy == v1.PreemptNever {
+		klog.V(3).Infof("Task %s/%s cannot reclaim (policy Never)", task.Namespace, task.Name)
+		return false
+	}
+	if ba.config.VictimQueues.Len() == 0 || !ssn.Preemptive(queue, []*api.TaskInfo{task}) {
+		return false
+	}
+
+	for _, n := range predicateNodes {
+		var victims []*api.TaskInfo
+		for _, taskOnNode := range n.Tasks {
+			if taskOnNode.Status != api.Running || !taskOnNode.Preemptable || ssn.IsVictimClaimed(taskOnNode) {
+				continue
+			}
+			j, found := ssn.Jobs[taskOnNode.Job]
+			if !found || j.Queue == job.Queue {
+				continue
+			}
+			// Only the victim tier is reclaimed, regardless of the share of the victim queues,
+			// the burst queues are expected to get resources within the latency SLO.
+			if q, found := ssn.Queues[j.Queue]; !found || !ba.config.VictimQueues.Has(q.Name) {
+				continue
+			}
+			victims = append(victims, taskOnNode.Clone())
+		}
+		victims = ssn.Reclaimable(task, victims)
+		if err := util.ValidateVictims(task, n, victims); err != nil {
+			klog.V(4).Infof("No validated victims on Node <%s>: %v", n.Name, err)
+			continue
+		}
+
+		victimsQueue := ssn.BuildVictimsPriorityQueue(victims, task)
+		resreq := task.InitResreq.Clone()
+		availableResources := n.FutureIdle()
+
+		sp := stmt.Savepoint(n.Name)
+		evictionOccurred := false
+		for !victimsQueue.Empty() && !resreq.LessEqual(availableResources, api.Zero) {
+			victim := victimsQueue.Pop().(*api.TaskInfo)
+			klog.V(3).Infof("Try to reclaim Task <%s/%s> for burst Task <%s/%s>",
+				victim.Namespace, victim.Name, task.Namespace, task.Name)
+			stmt.Evict(victim, "burst")
+			availableResources.Add(victim.Resreq)
+			evictionOccurred = true
+		}
+
+		if !resreq.LessEqual(availableResources, api.Zero) {
+			utils.RollbackToSavepoint(stmt, sp)
+			continue
+		}
+		if err := stmt.Pipeline(task, n.Name, evictionOccurred); err != nil {
+			klog.Errorf("Failed to pipeline Task <%s/%s> on Node <%s>: %v", task.Namespace, task.Name, n.Name, err)
+			utils.RollbackToSavepoint(stmt, sp)
+			continue
+		}
+		if err := stmt.ReleaseSavepoint(sp); err != nil {
+			klog.Errorf("Failed to release savepoint of Node <%s>: %v", n.Name, err)
+		}
+		return true
+	}
+
+	return false
+}
+
+func (ba *Action) UnInitialize() {}
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package burst
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestParseConfig(t *testing.T) {
+	c := ParseConfig([]conf.Configuration{{
+		Name: Name,
+		Arguments: map[string]interface{}{
+			BurstQueuesKey:  []interface{}{"inference"},
+			VictimQueuesKey: []interface{}{"batch", "best-effort"},
+			LatencySLOKey:   "3s",
+		},
+	}})
+	if !c.Enabled() || !c.BurstQueues.Has("inference") {
+		t.Errorf("expected burst queue inference, got %v", c.BurstQueues)
+	}
+	if c.VictimQueues.Len() != 2 || !c.VictimQueues.Has("batch") || !c.VictimQueues.Has("best-effort") {
+		t.Errorf("expected victim queues batch and best-effort, got %v", c.VictimQueues)
+	}
+	if c.LatencySLO != 3*time.Second {
+		t.Errorf("expected latency SLO 3s, got %v", c.LatencySLO)
+	}
+
+	c = ParseConfig(nil)
+	if c.Enabled() || c.LatencySLO != defaultLatencySLO {
+		t.Errorf("expected disabled burst lane with default SLO, got %+v", c)
+	}
+}
+
+func TestBurst(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+	}
+	preemptable := map[string]string{schedulingv1beta1.PodPreemptable: "true"}
+	configurations := []conf.Configuration{{
+		Name: Name,
+		Arguments: map[string]interface{}{
+			BurstQueuesKey:  []interface{}{"inference"},
+			VictimQueuesKey: []interface{}{"batch"},
+		},
+	}}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "burst task is allocated on idle resources",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "infer", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("inference", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/infer": "n1"},
+			ExpectBindsNum: 1,
+		},
+		{
+			Name:    "burst task only reclaims from the victim queues",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "c1", "batch", 1, nil, schedulingv1beta1.PodGroupRunning),
+				util.BuildPodGroup("pg3", "c1", "other", 1, nil, schedulingv1beta1.PodGroupRunning),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "infer", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				util.BuildPod("c1", "batch-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg2", preemptable, nil),
+				util.BuildPod("c1", "other-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg3", preemptable, nil),
+				util.BuildPod("c1", "other-2", "n2", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg3", preemptable, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				util.BuildNode("n2", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("inference", 1, nil),
+				util.BuildQueue("batch", 1, nil),
+				util.BuildQueue("other", 1, nil),
+			},
+			ExpectPipeLined: map[string][]string{"c1/pg1": {"n1"}},
+			ExpectEvictNum:  1,
+			ExpectEvicted:   []string{"c1/batch-1"},
+		},
+		{
+			Name:    "burst task does not reclaim outside of the victim queues",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroup("pg3", "c1", "other", 1, nil, schedulingv1beta1.PodGroupRunning),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "infer", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				util.BuildPod("c1", "other-1", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg3", preemptable, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("inference", 1, nil),
+				util.BuildQueue("other", 1, nil),
+			},
+			ExpectEvictNum: 0,
+		},
+		{
+			Name:    "burst task does not reclaim the victims vetoed by the plugins",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "kube-system", "batch", 1, nil, schedulingv1beta1.PodGroupRunning),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "infer", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				util.BuildPod("kube-system", "batch-1", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg2", preemptable, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("inference", 1, nil),
+				util.BuildQueue("batch", 1, nil),
+			},
+			ExpectEvictNum: 0,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               conformance.PluginName,
+					EnabledReclaimable: &trueValue,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledJobStarving:  &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobReady:     &trueValue,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, configurations)
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestBurstSkipsOtherPipelines(t *testing.T) {
+	trueValue := true
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{
+		Name:                gang.PluginName,
+		EnabledJobStarving:  &trueValue,
+		EnabledJobPipelined: &trueValue,
+		EnabledJobReady:     &trueValue,
+	}}}}
+	configurations := []conf.Configuration{{
+		Name:      Name,
+		Arguments: map[string]interface{}{BurstQueuesKey: []interface{}{"inference"}},
+	}}
+	queue := util.BuildQueue("inference", 1, nil)
+	queue.Labels = map[string]string{api.QueueClassLabel: "realtime"}
+	test := uthelper.TestCommonStruct{
+		Name:    "burst task of a queue class scheduled by another pipeline is not placed",
+		Plugins: map[string]framework.PluginBuilder{gang.PluginName: gang.New},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroup("pg1", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "infer", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues:         []*schedulingv1beta1.Queue{queue},
+		ExpectBindsNum: 0,
+	}
+	ssn := test.RegisterSession(tiers, configurations)
+	defer test.Close()
+	ssn.SetPipelineClasses(sets.New("realtime"))
+	test.Run([]framework.Action{New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Fatal(err)
+	}
+}
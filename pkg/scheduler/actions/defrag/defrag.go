@@ -0,0 +1,376 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defrag
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/features"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// Name is the name of the defrag action.
+	Name = "defrag"
+
+	// FragmentationTargetKey is the fragmentation score the action tolerates, in [0, 1). The score is the share of the
+	// free GPUs on the nodes which can not run a task of the blocked gang job, the cluster is only defragmented above it.
+	FragmentationTargetKey = "fragmentationTarget"
+	// EvictionBudgetKey is the maximum number of pods evicted by the action in a scheduling cycle.
+	EvictionBudgetKey = "evictionBudget"
+	// GPUResourceKey is the name of the GPU resource the action consolidates.
+	GPUResourceKey = "gpuResource"
+	// MigrationTimeoutKey is the time the checkpoint of a migrated pod is waited for, before it is only evicted.
+	MigrationTimeoutKey = "migrationTimeout"
+
+	defaultFragmentationTarget = 0.2
+	defaultEvictionBudget      = 4
+	defaultGPUResource         = "nvidia.com/gpu"
+	defaultMigrationTimeout    = 5 * time.Minute
+
+	evictReason = "defrag"
+)
+
+// Action consolidates the free GPUs scattered over the nodes by small pods, so the gang jobs needing whole nodes can
+// start: it evicts few movable preemptable pods blocking a node, which fit on the other nodes, and pipelines the tasks
+// of the gang job to the freed nodes. With the PodMigration feature, the pods annotated with volcano.sh/migratable
+// are migrated to the other nodes by checkpoint and restore instead.
+type Action struct {
+	fragmentationTarget float64
+	evictionBudget      int
+	gpuResource         v1.ResourceName
+	migrationTimeout    time.Duration
+}
+
+func New() *Action {
+	return &Action{
+		fragmentationTarget: defaultFragmentationTarget,
+		evictionBudget:      defaultEvictionBudget,
+		gpuResource:         defaultGPUResource,
+		migrationTimeout:    defaultMigrationTimeout,
+	}
+}
+
+func (defrag *Action) Name() string {
+	return Name
+}
+
+func (defrag *Action) Initialize() {}
+
+func (defrag *Action) parseArguments(ssn *framework.Session) {
+	defrag.fragmentationTarget = defaultFragmentationTarget
+	defrag.evictionBudget = defaultEvictionBudget
+	defrag.gpuResource = defaultGPUResource
+	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, defrag.Name())
+	arguments.GetFloat64(&defrag.fragmentationTarget, FragmentationTargetKey)
+	if defrag.fragmentationTarget < 0 || defrag.fragmentationTarget >= 1 {
+		klog.Warningf("Invalid %s <%v> in action %s, using default %v", FragmentationTargetKey, defrag.fragmentationTarget, Name, defaultFragmentationTarget)
+		defrag.fragmentationTarget = defaultFragmentationTarget
+	}
+	arguments.GetInt(&defrag.evictionBudget, EvictionBudgetKey)
+	if defrag.evictionBudget < 0 {
+		klog.Warningf("Invalid %s <%d> in action %s, using default %d", EvictionBudgetKey, defrag.evictionBudget, Name, defaultEvictionBudget)
+		defrag.evictionBudget = defaultEvictionBudget
+	}
+	var gpuResource string
+	arguments.GetString(&gpuResource, GPUResourceKey)
+	if gpuResource != "" {
+		defrag.gpuResource = v1.ResourceName(gpuResource)
+	}
+	defrag.migrationTimeout = defaultMigrationTimeout
+	var migrationTimeout string
+	arguments.GetString(&migrationTimeout, MigrationTimeoutKey)
+	if migrationTimeout != "" {
+		if d, err := time.ParseDuration(migrationTimeout); err != nil || d <= 0 {
+			klog.Warningf("Invalid %s <%s> in action %s, using default %v", MigrationTimeoutKey, migrationTimeout, Name, defaultMigrationTimeout)
+		} else {
+			defrag.migrationTimeout = d
+		}
+	}
+}
+
+func (defrag *Action) Execute(ssn *framework.Session) {
+	klog.V(5).Infof("Enter Defrag ...")
+	defer klog.V(5).Infof("Leaving Defrag ...")
+
+	defrag.parseArguments(ssn)
+	if defrag.evictionBudget == 0 {
+		return
+	}
+
+	jobs := util.NewPriorityQueue(ssn.JobOrderFn)
+	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) || job.IsPending() || job.MinAvailable <= 1 || ssn.JobReady(job) || ssn.JobPipelined(job) {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			continue
+		}
+		jobs.Push(job)
+	}
+
+	nodes := make([]*api.NodeInfo, 0, len(ssn.Nodes))
+	for _, node := range ssn.Nodes {
+		if node.Ready() {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	budget := defrag.evictionBudget
+	for !jobs.Empty() && budget > 0 {
+		job := jobs.Pop().(*api.JobInfo)
+		tasks := defrag.blockedTasks(ssn, job)
+		if len(tasks) == 0 || fitIdle(tasks, nodes) {
+			continue
+		}
+
+		largest := tasks[0].InitResreq.Get(defrag.gpuResource)
+		need := 0.0
+		for _, task := range tasks {
+			need += task.InitResreq.Get(defrag.gpuResource)
+		}
+		free, score := defrag.fragmentation(nodes, largest)
+		if need > free || score <= defrag.fragmentationTarget {
+			klog.V(4).Infof("Defrag: job <%s/%s> needs %v of %v free %s, fragmentation score %.2f, target %.2f",
+				job.Namespace, job.Name, need, free, defrag.gpuResource, score, defrag.fragmentationTarget)
+			continue
+		}
+
+		stmt := framework.NewStatement(ssn)
+		evicted := defrag.consolidate(ssn, stmt, nodes, tasks, budget)
+		if ssn.JobPipelined(job) {
+			klog.V(3).Infof("Defrag: job <%s/%s> pipelined evicting %d pods, fragmentation score %.2f",
+				job.Namespace, job.Name, evicted, score)
+			stmt.Commit()
+			budget -= evicted
+		} else {
+			stmt.Discard()
+		}
+	}
+}
+
+// blockedTasks returns the pending GPU tasks the job needs to get ready, the largest first.
+func (defrag *Action) blockedTasks(ssn *framework.Session, job *api.JobInfo) []*api.TaskInfo {
+	missing := int(job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum())
+	pending := util.NewPriorityQueue(ssn.TaskOrderFn)
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if !task.BestEffort && !task.SchGated {
+			pending.Push(task)
+		}
+	}
+	var tasks []*api.TaskInfo
+	for ; missing > 0 && !pending.Empty(); missing-- {
+		task := pending.Pop().(*api.TaskInfo)
+		if task.InitResreq.Get(defrag.gpuResource) <= 0 {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].InitResreq.Get(defrag.gpuResource) > tasks[j].InitResreq.Get(defrag.gpuResource)
+	})
+	return tasks
+}
+
+// fitIdle returns whether the tasks, the largest first, fit on the idle resources of the nodes without evictions.
+func fitIdle(tasks []*api.TaskInfo, nodes []*api.NodeInfo) bool {
+	idle := make([]*api.Resource, len(nodes))
+	for i, node := range nodes {
+		idle[i] = node.FutureIdle()
+	}
+	for _, task := range tasks {
+		fit := false
+		for i := range idle {
+			if task.InitResreq.LessEqual(idle[i], api.Zero) {
+				idle[i].Sub(task.InitResreq)
+				fit = true
+				break
+			}
+		}
+		if !fit {
+			return false
+		}
+	}
+	return true
+}
+
+// fragmentation returns the free GPUs of the nodes, and the share of them on the nodes with less free GPUs than the
+// task needs.
+func (defrag *Action) fragmentation(nodes []*api.NodeInfo, request float64) (float64, float64) {
+	free, unusable := 0.0, 0.0
+	for _, node := range nodes {
+		idle := node.FutureIdle().Get(defrag.gpuResource)
+		free += idle
+		if idle < request {
+			unusable += idle
+		}
+	}
+	if free == 0 {
+		return 0, 0
+	}
+	return free, unusable / free
+}
+
+// consolidate pipelines the tasks to the nodes, evicting the fewest pods blocking them within the budget. The evicted
+// pods must fit on the other nodes, where they are recreated. It returns the number of evicted pods.
+func (defrag *Action) consolidate(ssn *framework.Session, stmt *framework.Statement, nodes []*api.NodeInfo,
+	tasks []*api.TaskInfo, budget int) int {
+	// moved are the resources of the other nodes taken by the evicted pods
+	moved := map[string]*api.Resource{}
+	evicted := 0
+	for _, task := range tasks {
+		var target *api.NodeInfo
+		var victims []*api.TaskInfo
+		var destinations map[api.TaskID]string
+		for _, node := range nodes {
+			nodeVictims, nodeDestinations, ok := defrag.plan(ssn, task, node, nodes, moved)
+			if !ok || evicted+len(nodeVictims) > budget {
+				continue
+			}
+			if target == nil || len(nodeVictims) < len(victims) {
+				target, victims, destinations = node, nodeVictims, nodeDestinations
+			}
+			if len(victims) == 0 {
+				break
+			}
+		}
+		if target == nil {
+			klog.V(4).Infof("Defrag: no node can be freed for task <%s/%s> within the eviction budget", task.Namespace, task.Name)
+			return evicted
+		}
+
+		for _, victim := range victims {
+			klog.V(3).Infof("Defrag: moving task <%s/%s> from node <%s> to node <%s> for task <%s/%s>",
+				victim.Namespace, victim.Name, victim.NodeName, destinations[victim.UID], task.Namespace, task.Name)
+			if moved[destinations[victim.UID]] == nil {
+				moved[destinations[victim.UID]] = api.EmptyResource()
+			}
+			moved[destinations[victim.UID]].Add(victim.InitResreq)
+			if migratable(ssn, victim) {
+				stmt.Migrate(victim, &api.Migration{NodeName: destinations[victim.UID], Timeout: defrag.migrationTimeout}, evictReason)
+			} else {
+				stmt.Evict(victim, evictReason)
+			}
+		}
+		evicted += len(victims)
+		if err := stmt.Pipeline(task, target.Name, len(victims) != 0); err != nil {
+			klog.Errorf("Failed to pipeline task <%s/%s> to node <%s>: %v", task.Namespace, task.Name, target.Name, err)
+			return evicted
+		}
+	}
+	return evicted
+}
+
+// plan returns the movable pods to evict from the node so the task fits on it, the pods taking the most GPUs first,
+// with the other nodes they fit on.
+func (defrag *Action) plan(ssn *framework.Session, task *api.TaskInfo, node *api.NodeInfo, nodes []*api.NodeInfo,
+	moved map[string]*api.Resource) ([]*api.TaskInfo, map[api.TaskID]string, bool) {
+	if err := ssn.PredicateForPreemptAction(task, node); err != nil {
+		return nil, nil, false
+	}
+	available := availableOn(node, moved)
+	if task.InitResreq.LessEqual(available, api.Zero) {
+		return nil, nil, true
+	}
+
+	var candidates []*api.TaskInfo
+	for _, running := range node.Tasks {
+		if running.Status != api.Running || !running.Preemptable || running.Job == task.Job || ssn.IsVictimClaimed(running) {
+			continue
+		}
+		candidates = append(candidates, running.Clone())
+	}
+	candidates = ssn.Preemptable(task, candidates)
+	// the pods taking the most GPUs are evicted first, so the fewest pods are moved
+	sort.Slice(candidates, func(i, j int) bool {
+		li, ri := candidates[i].InitResreq.Get(defrag.gpuResource), candidates[j].InitResreq.Get(defrag.gpuResource)
+		if li != ri {
+			return li > ri
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	taken := map[string]*api.Resource{}
+	destinations := map[api.TaskID]string{}
+	var victims []*api.TaskInfo
+	for _, candidate := range candidates {
+		destination := ""
+		for _, other := range nodes {
+			if other.Name == node.Name {
+				continue
+			}
+			free := availableOn(other, moved)
+			if used, found := taken[other.Name]; found {
+				free = api.ExceededPart(free, used)
+			}
+			if candidate.InitResreq.LessEqual(free, api.Zero) {
+				destination = other.Name
+				break
+			}
+		}
+		if destination == "" {
+			continue
+		}
+		if taken[destination] == nil {
+			taken[destination] = api.EmptyResource()
+		}
+		taken[destination].Add(candidate.InitResreq)
+		destinations[candidate.UID] = destination
+		victims = append(victims, candidate)
+		available.Add(candidate.Resreq)
+		if task.InitResreq.LessEqual(available, api.Zero) {
+			return victims, destinations, true
+		}
+	}
+	return nil, nil, false
+}
+
+// migratable returns whether the task is migrated to its new node by checkpoint and restore instead of evicted:
+// the PodMigration feature is enabled, and the task or its podgroup is annotated with volcano.sh/migratable.
+func migratable(ssn *framework.Session, task *api.TaskInfo) bool {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.PodMigration) {
+		return false
+	}
+	if task.Pod != nil && task.Pod.Annotations[v1beta1.MigratableKey] == "true" {
+		return true
+	}
+	job, found := ssn.Jobs[task.Job]
+	return found && job.PodGroup != nil && job.PodGroup.Annotations[v1beta1.MigratableKey] == "true"
+}
+
+// availableOn returns the future idle resources of the node not taken by the pods moved to it.
+func availableOn(node *api.NodeInfo, moved map[string]*api.Resource) *api.Resource {
+	available := node.FutureIdle()
+	if taken, found := moved[node.Name]; found {
+		available = api.ExceededPart(available, taken)
+	}
+	return available
+}
+
+func (defrag *Action) UnInitialize() {}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defrag
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/features"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func gpuResources(cpu, memory, gpu string) v1.ResourceList {
+	return api.BuildResourceList(cpu, memory, []api.ScalarResource{{Name: "nvidia.com/gpu", Value: gpu}}...)
+}
+
+func gpuNode(name string) *v1.Node {
+	return util.BuildNode(name, api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "nvidia.com/gpu", Value: "4"}, {Name: "pods", Value: "10"}}...), nil)
+}
+
+func TestDefrag(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+	}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               conformance.PluginName,
+					EnabledPreemptable: &trueValue,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledPreemptable:  &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobReady:     &trueValue,
+				},
+			},
+		},
+	}
+	preemptable := map[string]string{schedulingv1beta1.PodPreemptable: "true"}
+	buildCommon := func(name string) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+				util.BuildPodGroup("pg2", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "small-1", "n1", v1.PodRunning, gpuResources("1", "1Gi", "1"), "pg1", preemptable, nil),
+				util.BuildPod("c1", "small-2", "n2", v1.PodRunning, gpuResources("1", "1Gi", "1"), "pg1", preemptable, nil),
+				util.BuildPod("c1", "gang-1", "", v1.PodPending, gpuResources("4", "4Gi", "4"), "pg2", nil, nil),
+				util.BuildPod("c1", "gang-2", "", v1.PodPending, gpuResources("4", "4Gi", "4"), "pg2", nil, nil),
+			},
+			Nodes: []*v1.Node{
+				gpuNode("n1"),
+				gpuNode("n2"),
+				gpuNode("n3"),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+		}
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+	}{
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildCommon("small pod blocking a node is moved for the gang")
+				test.ExpectEvicted = []string{"c1/small-1"}
+				test.ExpectEvictNum = 1
+				test.ExpectPipeLined = map[string][]string{"c1/pg2": {"n1", "n3"}}
+				return test
+			}(),
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildCommon("no eviction without budget")
+				test.ExpectEvictNum = 0
+				return test
+			}(),
+			arguments: framework.Arguments{EvictionBudgetKey: 0},
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildCommon("fragmentation below the target")
+				test.ExpectEvictNum = 0
+				return test
+			}(),
+			arguments: framework.Arguments{FragmentationTargetKey: 0.9},
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildCommon("pods which do not fit on the other nodes are not moved")
+				test.Pods = append(test.Pods,
+					util.BuildPod("c1", "small-3", "n2", v1.PodRunning, gpuResources("7", "1Gi", "0"), "pg1", preemptable, nil))
+				test.ExpectEvictNum = 0
+				return test
+			}(),
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildCommon("not enough free GPUs for the gang")
+				test.Pods = append(test.Pods,
+					util.BuildPod("c1", "small-3", "n2", v1.PodRunning, gpuResources("1", "1Gi", "3"), "pg1", preemptable, nil))
+				test.ExpectEvictNum = 0
+				return test
+			}(),
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildCommon("non preemptable pods are not evicted")
+				notPreemptable := map[string]string{schedulingv1beta1.PodPreemptable: "false"}
+				test.Pods[0] = util.BuildPod("c1", "small-1", "n1", v1.PodRunning, gpuResources("1", "1Gi", "1"), "pg1", notPreemptable, nil)
+				test.Pods[1] = util.BuildPod("c1", "small-2", "n2", v1.PodRunning, gpuResources("1", "1Gi", "1"), "pg1", notPreemptable, nil)
+				test.ExpectEvictNum = 0
+				return test
+			}(),
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, []conf.Configuration{{Name: Name, Arguments: test.arguments}})
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestMigratable(t *testing.T) {
+	plainPod := util.BuildPod("c1", "p1", "n1", v1.PodRunning, gpuResources("1", "1Gi", "1"), "pg1", nil, nil)
+	migratablePod := plainPod.DeepCopy()
+	migratablePod.Annotations[schedulingv1beta1.MigratableKey] = "true"
+	job := api.NewJobInfo("c1/pg1")
+	ssn := &framework.Session{Jobs: map[api.JobID]*api.JobInfo{job.UID: job}}
+
+	if migratable(ssn, api.NewTaskInfo(migratablePod)) {
+		t.Errorf("expected no migration without the PodMigration feature")
+	}
+
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.PodMigration, true)
+	if !migratable(ssn, api.NewTaskInfo(migratablePod)) {
+		t.Errorf("expected the annotated pod to be migrated")
+	}
+	if migratable(ssn, api.NewTaskInfo(plainPod)) {
+		t.Errorf("expected the pod not annotated to be evicted")
+	}
+	job.SetPodGroup(&api.PodGroup{PodGroup: scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "c1", Name: "pg1", Annotations: map[string]string{schedulingv1beta1.MigratableKey: "true"}}}})
+	if !migratable(ssn, api.NewTaskInfo(plainPod)) {
+		t.Errorf("expected the pod of the annotated podgroup to be migrated")
+	}
+}
@@ -23,11 +23,14 @@ package actions
 import (
 	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
 	"volcano.sh/volcano/pkg/scheduler/actions/backfill"
+	"volcano.sh/volcano/pkg/scheduler/actions/burst"
+	"volcano.sh/volcano/pkg/scheduler/actions/defrag"
 	"volcano.sh/volcano/pkg/scheduler/actions/enqueue"
 	"volcano.sh/volcano/pkg/scheduler/actions/gangpreempt"
 	"volcano.sh/volcano/pkg/scheduler/actions/gangreclaim"
 	"volcano.sh/volcano/pkg/scheduler/actions/preempt"
 	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
+	"volcano.sh/volcano/pkg/scheduler/actions/reserve"
 	"volcano.sh/volcano/pkg/scheduler/actions/shuffle"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 )
@@ -41,4 +44,7 @@ func init() {
 	framework.RegisterAction(gangreclaim.New())
 	framework.RegisterAction(enqueue.New())
 	framework.RegisterAction(shuffle.New())
+	framework.RegisterAction(burst.New())
+	framework.RegisterAction(reserve.New())
+	framework.RegisterAction(defrag.New())
 }
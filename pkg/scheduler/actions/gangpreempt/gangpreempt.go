@@ -44,6 +44,8 @@ type Action struct {
 	allowWholeBundle bool
 	// configured flag for predicate error cache
 	enablePredicateErrorCache bool
+	// queueArguments are the arguments of allocate overridden for the queues
+	queueArguments framework.QueueArguments
 }
 
 func New() *Action {
@@ -73,6 +75,7 @@ func (gp *Action) parseArguments(ssn *framework.Session) {
 	// enabled when allocate is not configured.
 	gp.enablePredicateErrorCache = true
 	framework.GetArgOfActionFromConf(ssn.Configurations, "allocate").GetBool(&gp.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	gp.queueArguments = ssn.GetQueueArgsOfAction("allocate")
 }
 
 func (gp *Action) Execute(ssn *framework.Session) {
@@ -85,6 +88,9 @@ func (gp *Action) Execute(ssn *framework.Session) {
 	queueMap := map[api.QueueID]*api.QueueInfo{}
 
 	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
 		if job.IsPending() {
 			continue
 		}
@@ -168,13 +174,20 @@ func (gp *Action) preemptJobInDomains(ssn *framework.Session, stmt *framework.St
 			if jobHN == nil {
 				jobHN = ssn.HyperNodes[framework.ClusterTopHyperNode]
 			}
-			plan, subJobHyperNodes, ok := utils.BuildNominationPlanInDomain(ssn, queue, preemptorJob, jobHN, attemptVictims, utils.ReasonGangPreempt, gp.enablePredicateErrorCache)
+			plan, subJobHyperNodes, ok := utils.BuildNominationPlanInDomain(ssn, queue, preemptorJob, jobHN, attemptVictims, utils.ReasonGangPreempt,
+				gp.queueArguments.GetBool(queue.UID, conf.EnablePredicateErrCacheKey, gp.enablePredicateErrorCache))
 			if !ok {
 				continue
 			}
+			// Replay the plan after a savepoint, so that a partially replayed plan is rolled back.
+			sp := stmt.Savepoint(domain)
 			if err := stmt.RecoverOperations(plan); err != nil {
+				utils.RollbackToSavepoint(stmt, sp)
 				continue
 			}
+			if err := stmt.ReleaseSavepoint(sp); err != nil {
+				klog.Errorf("Failed to release savepoint of domain <%s>: %v", domain, err)
+			}
 			return subJobHyperNodes
 		}
 	}
@@ -193,6 +206,9 @@ func (gp *Action) selectDomainBundles(ssn *framework.Session, preemptorJob *api.
 			if !api.PreemptableStatus(task.Status) || !task.Preemptable {
 				continue
 			}
+			if ssn.IsVictimClaimed(task) {
+				continue
+			}
 			// NOTE: legacy preempt filters out non-BestEffort victims when
 			// the preemptor is BestEffort. That check is intentionally
 			// removed here; UnifiedEvictableFn plugins handle eligibility.
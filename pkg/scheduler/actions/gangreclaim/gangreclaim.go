@@ -44,6 +44,8 @@ type Action struct {
 	allowWholeBundle bool
 	// configured flag for predicate error cache
 	enablePredicateErrorCache bool
+	// queueArguments are the arguments of allocate overridden for the queues
+	queueArguments framework.QueueArguments
 }
 
 func New() *Action {
@@ -73,6 +75,7 @@ func (gr *Action) parseArguments(ssn *framework.Session) {
 	// enabled when allocate is not configured.
 	gr.enablePredicateErrorCache = true
 	framework.GetArgOfActionFromConf(ssn.Configurations, "allocate").GetBool(&gr.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	gr.queueArguments = ssn.GetQueueArgsOfAction("allocate")
 }
 
 func (gr *Action) Execute(ssn *framework.Session) {
@@ -83,8 +86,13 @@ func (gr *Action) Execute(ssn *framework.Session) {
 	queues := util.NewPriorityQueue(ssn.QueueOrderFn)
 	queueMap := map[api.QueueID]*api.QueueInfo{}
 	preemptorsMap := map[api.QueueID]*util.PriorityQueue{}
+	// escalatedQueues are the queues with escalated starving jobs, which reclaim even if the queue is overused.
+	escalatedQueues := map[api.QueueID]bool{}
 
 	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
 		if job.IsPending() {
 			continue
 		}
@@ -107,11 +115,15 @@ func (gr *Action) Execute(ssn *framework.Session) {
 			preemptorsMap[job.Queue] = util.NewPriorityQueue(ssn.JobOrderFn)
 		}
 		preemptorsMap[job.Queue].Push(job)
+		if ssn.JobEscalated(job) {
+			escalatedQueues[job.Queue] = true
+		}
 	}
 
 	for !queues.Empty() {
 		queue := queues.Pop().(*api.QueueInfo)
-		if ssn.Overused(queue) {
+		overused := ssn.Overused(queue)
+		if overused && !escalatedQueues[queue.UID] {
 			continue
 		}
 
@@ -121,6 +133,9 @@ func (gr *Action) Execute(ssn *framework.Session) {
 				break
 			}
 			job := jobsQ.Pop().(*api.JobInfo)
+			if overused && !ssn.JobEscalated(job) {
+				continue
+			}
 			stmt := framework.NewStatement(ssn)
 			subJobHyperNodes := gr.reclaimJobInDomains(ssn, stmt, queue, job)
 
@@ -142,7 +157,7 @@ func (gr *Action) reclaimJobInDomains(ssn *framework.Session, stmt *framework.St
 	if len(pending) == 0 {
 		return nil
 	}
-	if queue != nil && !ssn.Preemptive(queue, pending) {
+	if queue != nil && !ssn.JobEscalated(job) && !ssn.Preemptive(queue, pending) {
 		klog.V(3).Infof("Queue <%s> cannot reclaim for job <%s/%s>, skip", queue.Name, job.Namespace, job.Name)
 		return nil
 	}
@@ -173,13 +188,20 @@ func (gr *Action) reclaimJobInDomains(ssn *framework.Session, stmt *framework.St
 			if jobHN == nil {
 				jobHN = ssn.HyperNodes[framework.ClusterTopHyperNode]
 			}
-			plan, subJobHyperNodes, ok := utils.BuildNominationPlanInDomain(ssn, queue, job, jobHN, attemptVictims, utils.ReasonGangReclaim, gr.enablePredicateErrorCache)
+			plan, subJobHyperNodes, ok := utils.BuildNominationPlanInDomain(ssn, queue, job, jobHN, attemptVictims, utils.ReasonGangReclaim,
+				gr.queueArguments.GetBool(queue.UID, conf.EnablePredicateErrCacheKey, gr.enablePredicateErrorCache))
 			if !ok {
 				continue
 			}
+			// Replay the plan after a savepoint, so that a partially replayed plan is rolled back.
+			sp := stmt.Savepoint(domain)
 			if err := stmt.RecoverOperations(plan); err != nil {
+				utils.RollbackToSavepoint(stmt, sp)
 				continue
 			}
+			if err := stmt.ReleaseSavepoint(sp); err != nil {
+				klog.Errorf("Failed to release savepoint of domain <%s>: %v", domain, err)
+			}
 			return subJobHyperNodes
 		}
 	}
@@ -198,6 +220,9 @@ func (gr *Action) selectDomainBundles(ssn *framework.Session, lessQueueFn func(l
 			if taskOnNode.Status != api.Running || !taskOnNode.Preemptable {
 				continue
 			}
+			if ssn.IsVictimClaimed(taskOnNode) {
+				continue
+			}
 			victimJob, found := ssn.Jobs[taskOnNode.Job]
 			if !found || victimJob.Queue == reclaimerJob.Queue {
 				continue
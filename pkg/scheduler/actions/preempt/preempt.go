@@ -40,6 +40,7 @@ import (
 
 	fwk "k8s.io/kube-scheduler/framework"
 
+	"volcano.sh/volcano/pkg/scheduler/actions/utils"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -61,6 +62,8 @@ type Action struct {
 	ssn *framework.Session
 
 	enablePredicateErrorCache bool
+	// queueArguments are the arguments overridden for the queues
+	queueArguments framework.QueueArguments
 
 	enableTopologyAwarePreemption bool
 
@@ -90,6 +93,7 @@ func (pmpt *Action) Initialize() {}
 func (pmpt *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, pmpt.Name())
 	arguments.GetBool(&pmpt.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	pmpt.queueArguments = ssn.GetQueueArgsOfAction(pmpt.Name())
 	arguments.GetBool(&pmpt.enableTopologyAwarePreemption, EnableTopologyAwarePreemptionKey)
 	arguments.GetInt(&pmpt.topologyAwarePreemptWorkerNum, TopologyAwarePreemptWorkerNumKey)
 	arguments.GetInt(&pmpt.minCandidateNodesPercentage, MinCandidateNodesPercentageKey)
@@ -110,6 +114,9 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 	underRequestByQueue := map[api.QueueID][]*api.JobInfo{}
 
 	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
 		if job.IsPending() {
 			continue
 		}
@@ -207,6 +214,10 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 					if !task.Preemptable {
 						return false
 					}
+					// Skip the victims already claimed by other actions in this session.
+					if ssn.IsVictimClaimed(task) {
+						return false
+					}
 					job, found := ssn.Jobs[task.Job]
 					if !found {
 						return false
@@ -268,6 +279,9 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 					if !task.Preemptable {
 						return false
 					}
+					if ssn.IsVictimClaimed(task) {
+						return false
+					}
 
 					// Preempt tasks within job.
 					return preemptor.Job == task.Job
@@ -308,9 +322,13 @@ func (pmpt *Action) preempt(
 
 	// we should filter out those nodes that are UnschedulableAndUnresolvable status got in allocate action
 	allNodes := ssn.FilterOutUnschedulableAndUnresolvableNodesForTask(preemptor)
-	predicateNodes, _ := predicateHelper.PredicateNodes(preemptor, allNodes, ssn.PredicateForPreemptAction, pmpt.enablePredicateErrorCache, ssn.NodesInShard)
+	enablePredicateErrorCache := pmpt.enablePredicateErrorCache
+	if job, found := ssn.Jobs[preemptor.Job]; found {
+		enablePredicateErrorCache = pmpt.queueArguments.GetBool(job.Queue, conf.EnablePredicateErrCacheKey, enablePredicateErrorCache)
+	}
+	predicateNodes, _ := predicateHelper.PredicateNodes(preemptor, allNodes, ssn.PredicateForPreemptAction, enablePredicateErrorCache, ssn.NodesInShard)
 
-	candidateNodes := util.GetPredicatedNodeByShard(predicateNodes, ssn.NodesInShard)
+	candidateNodes := util.GetPredicatedNodeByShard(preemptor, predicateNodes, ssn.NodesInShard)
 	var preemptSuccess bool
 	var err error
 	//try to preempt in order if multiple candidate Nodes group with priority exist
@@ -366,11 +384,10 @@ func (pmpt *Action) normalPreempt(
 			continue
 		}
 
-		// Use a temporary statement per node attempt so that eviction operations
-		// are isolated. On success the operations are merged into the caller's
-		// statement; on failure they are discarded, so evictions are only committed
-		// when preemption succeeds.
-		nodeStmt := framework.NewStatement(ssn)
+		// Mark a savepoint per node attempt so that eviction operations are isolated.
+		// On failure the operations are rolled back to the savepoint, so evictions are
+		// only committed when preemption succeeds.
+		sp := stmt.Savepoint(node.Name)
 
 		victimsQueue := ssn.BuildVictimsPriorityQueue(victims, preemptor)
 		// Preempt victims for tasks, pick lowest priority task first.
@@ -393,7 +410,7 @@ func (pmpt *Action) normalPreempt(
 			preemptee := victimsQueue.Pop().(*api.TaskInfo)
 			klog.V(3).Infof("Try to preempt Task <%s/%s> for Task <%s/%s>",
 				preemptee.Namespace, preemptee.Name, preemptor.Namespace, preemptor.Name)
-			nodeStmt.Evict(preemptee, "preempt")
+			stmt.Evict(preemptee, "preempt")
 			preempted.Add(preemptee.Resreq)
 		}
 
@@ -408,22 +425,24 @@ func (pmpt *Action) normalPreempt(
 
 		// If preemptor's queue is not allocatable, it means preemptor cannot be allocated. So no need care about the node idle resource
 		if ssn.Allocatable(currentQueue, preemptor) && preemptor.InitResreq.LessEqual(node.FutureIdle(), api.Zero) {
-			if err := nodeStmt.Pipeline(preemptor, node.Name, evictionOccurred); err != nil {
+			if err := stmt.Pipeline(preemptor, node.Name, evictionOccurred); err != nil {
 				klog.Errorf("Failed to pipeline Task <%s/%s> on Node <%s>",
 					preemptor.Namespace, preemptor.Name, node.Name)
-				// Pipeline failed: discard all evictions for this node and try the next one.
-				nodeStmt.Discard()
+				// Pipeline failed: roll back all evictions for this node and try the next one.
+				utils.RollbackToSavepoint(stmt, sp)
 				continue
 			}
 
-			// Pipeline succeeded: merge this node's operations into the caller's statement.
-			stmt.Merge(nodeStmt)
+			// Pipeline succeeded: keep this node's operations in the caller's statement.
+			if err := stmt.ReleaseSavepoint(sp); err != nil {
+				klog.Errorf("Failed to release savepoint of Node <%s>: %v", node.Name, err)
+			}
 			assigned = true
 			break
 		}
 
-		// Not enough resources on this node even after evictions: discard and try next node.
-		nodeStmt.Discard()
+		// Not enough resources on this node even after evictions: roll back and try next node.
+		utils.RollbackToSavepoint(stmt, sp)
 	}
 
 	return assigned, nil
@@ -493,21 +512,22 @@ func (pmpt *Action) topologyAwarePreempt(
 		return false, fmt.Errorf("no candidate node for preemption")
 	}
 
-	// Use a temporary statement so that eviction side effects are only applied
+	// Mark a savepoint so that eviction side effects are only kept
 	// after the entire preemption attempt (evictions + pipeline) succeeds.
-	tmpStmt := framework.NewStatement(ssn)
+	sp := stmt.Savepoint(bestCandidate.Name())
 
-	prepareCandidate(bestCandidate, preemptor.Pod, tmpStmt)
-	if err := tmpStmt.Pipeline(preemptor, bestCandidate.Name(), true); err != nil {
+	prepareCandidate(bestCandidate, preemptor.Pod, stmt)
+	if err := stmt.Pipeline(preemptor, bestCandidate.Name(), true); err != nil {
 		klog.Errorf("Failed to pipeline Task <%s/%s> on Node <%s>",
 			preemptor.Namespace, preemptor.Name, bestCandidate.Name())
-		// Pipeline failed: discard all evictions to prevent side effects.
-		tmpStmt.Discard()
+		// Pipeline failed: roll back all evictions to prevent side effects.
+		utils.RollbackToSavepoint(stmt, sp)
 		return false, err
 	}
 
-	// Success: merge temporary statement into the caller's statement.
-	stmt.Merge(tmpStmt)
+	if err := stmt.ReleaseSavepoint(sp); err != nil {
+		klog.Errorf("Failed to release savepoint of Node <%s>: %v", bestCandidate.Name(), err)
+	}
 	return true, nil
 }
 
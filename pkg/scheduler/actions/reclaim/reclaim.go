@@ -23,9 +23,15 @@ limitations under the License.
 package reclaim
 
 import (
+	"errors"
+	"strings"
+	"time"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/names"
 
+	"volcano.sh/volcano/pkg/scheduler/actions/utils"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -34,11 +40,17 @@ import (
 
 type Action struct {
 	enablePredicateErrorCache bool
+	// queueArguments are the arguments overridden for the queues
+	queueArguments framework.QueueArguments
+	// scaleDownDeadline is the time an elastic job is given to shrink before its members are reclaimed
+	scaleDownDeadline time.Duration
+	negotiator        *scaleDownNegotiator
 }
 
 func New() *Action {
 	return &Action{
 		enablePredicateErrorCache: true,
+		scaleDownDeadline:         defaultScaleDownDeadline,
 	}
 }
 
@@ -51,6 +63,17 @@ func (ra *Action) Initialize() {}
 func (ra *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, ra.Name())
 	arguments.GetBool(&ra.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	ra.queueArguments = ssn.GetQueueArgsOfAction(ra.Name())
+	ra.scaleDownDeadline = defaultScaleDownDeadline
+	var deadline string
+	arguments.GetString(&deadline, scaleDownDeadlineKey)
+	if deadline != "" {
+		if d, err := time.ParseDuration(deadline); err != nil || d < 0 {
+			klog.Warningf("Invalid %s <%s> in action %s, using default %v", scaleDownDeadlineKey, deadline, ra.Name(), defaultScaleDownDeadline)
+		} else {
+			ra.scaleDownDeadline = d
+		}
+	}
 }
 
 func (ra *Action) Execute(ssn *framework.Session) {
@@ -58,17 +81,23 @@ func (ra *Action) Execute(ssn *framework.Session) {
 	defer klog.V(5).Infof("Leaving Reclaim ...")
 
 	ra.parseArguments(ssn)
+	ra.negotiator = newScaleDownNegotiator(ra.scaleDownDeadline)
 
 	queues := util.NewPriorityQueue(ssn.QueueOrderFn)
 	queueMap := map[api.QueueID]*api.QueueInfo{}
 
 	preemptorsMap := map[api.QueueID]*util.PriorityQueue{}
 	preemptorTasks := map[api.JobID]*util.PriorityQueue{}
+	// escalatedQueues are the queues with escalated starving jobs, which reclaim even if the queue is overused.
+	escalatedQueues := map[api.QueueID]bool{}
 
 	klog.V(3).Infof("There are <%d> Jobs and <%d> Queues in total for scheduling.",
 		len(ssn.Jobs), len(ssn.Queues))
 
 	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) {
+			continue
+		}
 		if job.IsPending() {
 			continue
 		}
@@ -92,6 +121,9 @@ func (ra *Action) Execute(ssn *framework.Session) {
 				preemptorsMap[job.Queue] = util.NewPriorityQueue(ssn.JobOrderFn)
 			}
 			preemptorsMap[job.Queue].Push(job)
+			if ssn.JobEscalated(job) {
+				escalatedQueues[job.Queue] = true
+			}
 			preemptorTasks[job.UID] = util.NewPriorityQueue(ssn.TaskOrderFn)
 			for _, task := range job.TaskStatusIndex[api.Pending] {
 				if task.SchGated {
@@ -108,7 +140,8 @@ func (ra *Action) Execute(ssn *framework.Session) {
 		}
 
 		queue := queues.Pop().(*api.QueueInfo)
-		if ssn.Overused(queue) {
+		overused := ssn.Overused(queue)
+		if overused && !escalatedQueues[queue.UID] {
 			klog.V(3).Infof("Queue <%s> is overused, ignore it.", queue.Name)
 			continue
 		}
@@ -121,6 +154,12 @@ func (ra *Action) Execute(ssn *framework.Session) {
 				break
 			}
 			job := jobsQ.Pop().(*api.JobInfo)
+			escalated := ssn.JobEscalated(job)
+			if overused && !escalated {
+				klog.V(3).Infof("Queue <%s> is overused, only escalated jobs reclaim, skip job <%s/%s>.",
+					queue.Name, job.Namespace, job.Name)
+				continue
+			}
 			stmt := framework.NewStatement(ssn)
 
 			for {
@@ -146,7 +185,7 @@ func (ra *Action) Execute(ssn *framework.Session) {
 					continue
 				}
 
-				if !ssn.Preemptive(queue, []*api.TaskInfo{task}) {
+				if !escalated && !ssn.Preemptive(queue, []*api.TaskInfo{task}) {
 					klog.V(3).Infof("Queue <%s> cannot reclaim for task <%s>, skip", queue.Name, task.Name)
 					continue
 				}
@@ -175,10 +214,13 @@ func (ra *Action) Execute(ssn *framework.Session) {
 func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Statement, task *api.TaskInfo, job *api.JobInfo) {
 	totalNodes := ssn.FilterOutUnschedulableAndUnresolvableNodesForTask(task)
 	predicateHelper := util.NewPredicateHelper()
-	predicateNodes, _ := predicateHelper.PredicateNodes(task, totalNodes, ssn.PredicateForPreemptAction, ra.enablePredicateErrorCache, ssn.NodesInShard)
-	predicateNodesByShard := util.GetPredicatedNodeByShard(predicateNodes, ssn.NodesInShard)
+	predicateNodes, _ := predicateHelper.PredicateNodes(task, totalNodes, ssn.PredicateForPreemptAction,
+		ra.queueArguments.GetBool(job.Queue, conf.EnablePredicateErrCacheKey, ra.enablePredicateErrorCache), ssn.NodesInShard)
+	predicateNodesByShard := util.GetPredicatedNodeByShard(task, predicateNodes, ssn.NodesInShard)
 	var predicateNodesByShardFlattened []*api.NodeInfo
 	for _, nodes := range predicateNodesByShard {
+		// the nodes are not scored in reclaim, they are all tied
+		util.OrderTiedNodes(nodes)
 		predicateNodesByShardFlattened = append(predicateNodesByShardFlattened, nodes...)
 	}
 	for _, n := range predicateNodesByShardFlattened {
@@ -190,6 +232,12 @@ func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Stateme
 				continue
 			}
 
+			// Skip the victims already claimed by other actions in this session,
+			// their freed resources have already been counted in the node's FutureIdle.
+			if ssn.IsVictimClaimed(taskOnNode) {
+				continue
+			}
+
 			if j, found := ssn.Jobs[taskOnNode.Job]; !found {
 				continue
 			} else if j.Queue != job.Queue {
@@ -218,20 +266,34 @@ func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Stateme
 
 		// The reclaimed resources should be added to the remaining available resources of the nodes to avoid over-reclaiming.
 		availableResources := n.FutureIdle()
+		// The DRA devices the task requests must be deallocated with the claims of the victims when its claims
+		// are not allocated yet and the DRA filter finds no free device for them on the node.
+		draShort := len(task.DRAResreq) > 0 && !draClaimsAllocated(ssn, task) && draFilterFailed(ssn.PredicateFn(task, n))
+		draFits := func() bool {
+			return !draShort || draDevicesFit(task, n.ReleasingDRADevices())
+		}
 
-		// Use a per-node statement so that evictions are isolated to this node.
-		// Only merge into the caller's stmt if Pipeline succeeds; otherwise discard
-		// so victims on nodes that end up unused are never committed to Kubernetes.
-		nodeStmt := framework.NewStatement(ssn)
+		// Mark a savepoint before evicting on this node, so that the evictions are rolled back
+		// if the task can not be pipelined, and victims on nodes that end up unused are never
+		// committed to Kubernetes.
+		sp := stmt.Savepoint(n.Name)
 		evictionOccurred := false
 		for !victimsQueue.Empty() {
-			if resreq.LessEqual(availableResources, api.Zero) {
+			resourcesFit := resreq.LessEqual(availableResources, api.Zero)
+			if resourcesFit && draFits() {
 				break
 			}
 			reclaimee := victimsQueue.Pop().(*api.TaskInfo)
+			if resourcesFit && !holdsDRADevices(reclaimee, task) {
+				continue
+			}
+			// The elastic jobs are asked to shrink first, and only reclaimed once they miss the deadline.
+			if ra.negotiator.spare(ssn, reclaimee, task) {
+				continue
+			}
 			klog.V(3).Infof("Try to reclaim Task <%s/%s> for Tasks <%s/%s>",
 				reclaimee.Namespace, reclaimee.Name, task.Namespace, task.Name)
-			nodeStmt.Evict(reclaimee, "reclaim")
+			stmt.Evict(reclaimee, "reclaim")
 			reclaimed.Add(reclaimee.Resreq)
 			availableResources.Add(reclaimee.Resreq)
 			evictionOccurred = true
@@ -239,21 +301,73 @@ func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Stateme
 
 		klog.V(3).Infof("Reclaimed <%v> for task <%s/%s> requested <%v>, and Node <%s> availableResources <%v>.", reclaimed, task.Namespace, task.Name, task.InitResreq, n.Name, availableResources)
 
-		if !resreq.LessEqual(availableResources, api.Zero) {
-			nodeStmt.Discard()
+		if !resreq.LessEqual(availableResources, api.Zero) || !draFits() {
+			utils.RollbackToSavepoint(stmt, sp)
 			continue
 		}
 
-		if err := nodeStmt.Pipeline(task, n.Name, evictionOccurred); err != nil {
+		if err := stmt.Pipeline(task, n.Name, evictionOccurred); err != nil {
 			klog.Errorf("Failed to pipeline Task <%s/%s> on Node <%s>",
 				task.Namespace, task.Name, n.Name)
-			nodeStmt.Discard()
+			utils.RollbackToSavepoint(stmt, sp)
 			continue
 		}
-		stmt.Merge(nodeStmt)
+		if err := stmt.ReleaseSavepoint(sp); err != nil {
+			klog.Errorf("Failed to release savepoint of Node <%s>: %v", n.Name, err)
+		}
 		break
 	}
 }
 
+// draClaimsAllocated returns whether all the ResourceClaims of the task are allocated, their devices are reserved then.
+func draClaimsAllocated(ssn *framework.Session, task *api.TaskInfo) bool {
+	manager := ssn.SharedDRAManager()
+	if manager == nil {
+		return false
+	}
+	for _, key := range task.ResourceClaimKeys {
+		namespace, name, _ := strings.Cut(key, "/")
+		claim, err := manager.ResourceClaims().Get(namespace, name)
+		if err != nil || claim.Status.Allocation == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// draFilterFailed returns whether the predicate failed because the DRA filter found no device for the claims of the task.
+func draFilterFailed(err error) bool {
+	var fitErr *api.FitError
+	if !errors.As(err, &fitErr) {
+		return false
+	}
+	for _, status := range fitErr.Status {
+		if status != nil && status.Plugin == names.DynamicResources && status.Code == api.Unschedulable {
+			return true
+		}
+	}
+	return false
+}
+
+// draDevicesFit returns whether the released DRA devices cover the devices of every device class the task requests.
+func draDevicesFit(task *api.TaskInfo, released map[string]int64) bool {
+	for deviceClass, r := range task.DRAResreq {
+		if released[deviceClass] < r.Count {
+			return false
+		}
+	}
+	return true
+}
+
+// holdsDRADevices returns whether the victim holds DRA devices of a device class the task requests.
+func holdsDRADevices(victim, task *api.TaskInfo) bool {
+	for deviceClass := range task.DRAResreq {
+		if r, found := victim.DRAResreq[deviceClass]; found && r.Count > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (ra *Action) UnInitialize() {
 }
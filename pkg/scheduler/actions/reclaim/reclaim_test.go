@@ -24,7 +24,10 @@ import (
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	resourcev1 "k8s.io/api/resource/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/names"
 
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/cmd/scheduler/app/options"
@@ -386,3 +389,127 @@ func TestReclaim(t *testing.T) {
 		})
 	}
 }
+
+// draShortPlugin fails the predicate of the tasks requesting DRA devices, as if the DRA filter found the devices of
+// the nodes in use, or as if another filter rejected the node when plugin is set.
+type draShortPlugin struct {
+	plugin string
+}
+
+func (dp *draShortPlugin) Name() string {
+	return "dra-short"
+}
+
+func (dp *draShortPlugin) OnSessionOpen(ssn *framework.Session) {
+	ssn.AddPredicateFn(dp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if len(task.DRAResreq) == 0 {
+			return nil
+		}
+		plugin := dp.plugin
+		if plugin == "" {
+			plugin = names.DynamicResources
+		}
+		return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: "node rejected", Plugin: plugin})
+	})
+}
+
+func (dp *draShortPlugin) OnSessionClose(ssn *framework.Session) {}
+
+func TestReclaimDRADevices(t *testing.T) {
+	const deviceClass = "gpu.example.com"
+	for _, tc := range []struct {
+		name string
+		// plugin is the plugin failing the predicate, the DRA filter if empty
+		plugin string
+		// allocated allocates the ResourceClaim of the preemptor
+		allocated     bool
+		expectEvicted []string
+	}{
+		{
+			name: "reclaim evicts the victims holding the DRA devices the task requests",
+			// the node has the cpu the preemptor requests free, but not the device held by preemptee2
+			expectEvicted: []string{"c1/preemptee2"},
+		},
+		{
+			name:   "reclaim does not evict for the DRA devices when another filter rejects the node",
+			plugin: "other",
+		},
+		{
+			name:      "reclaim does not evict for the DRA devices when the claims of the task are allocated",
+			allocated: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testReclaimDRADevices(t, deviceClass, tc.plugin, tc.allocated, tc.expectEvicted)
+		})
+	}
+}
+
+func testReclaimDRADevices(t *testing.T, deviceClass, plugin string, allocated bool, expectEvicted []string) {
+	claim := &resourcev1.ResourceClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "c1", Name: "preemptor-gpu"}}
+	if allocated {
+		claim.Status.Allocation = &resourcev1.AllocationResult{}
+	}
+	test := uthelper.TestCommonStruct{
+		Name: "reclaim DRA devices",
+		Plugins: map[string]framework.PluginBuilder{
+			conformance.PluginName: conformance.New,
+			gang.PluginName:        gang.New,
+			capacity.PluginName:    capacity.New,
+			"dra-short":            func(framework.Arguments) framework.Plugin { return &draShortPlugin{plugin: plugin} },
+		},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+			util.BuildPodGroup("pg2", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "preemptee1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: "true"}, make(map[string]string)),
+			util.BuildPod("c1", "preemptee2", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: "true"}, make(map[string]string)),
+			util.BuildPod("c1", "preemptee3", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: "false"}, make(map[string]string)),
+			util.BuildPod("c1", "preemptor1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+		},
+		Queues: []*schedulingv1beta1.Queue{
+			util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("0", "0"), nil),
+			util.BuildQueueWithResourcesQuantity("q2", api.BuildResourceList("2", "2Gi"), nil),
+		},
+		ResourceClaims: []*resourcev1.ResourceClaim{claim},
+		ExpectEvictNum: len(expectEvicted),
+		ExpectEvicted:  expectEvicted,
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{
+			{Name: conformance.PluginName, EnabledReclaimable: &trueValue},
+			{Name: gang.PluginName, EnabledReclaimable: &trueValue, EnabledJobStarving: &trueValue},
+			{Name: capacity.PluginName, EnabledReclaimable: &trueValue, EnabledQueueOrder: &trueValue, EnablePreemptive: &trueValue},
+			{Name: "dra-short", EnabledPredicate: &trueValue},
+		},
+	}}
+
+	ssn := test.RegisterSession(tiers, nil)
+	defer test.Close()
+	devices := map[string]*api.DRAResource{deviceClass: {Count: 1}}
+	for _, job := range ssn.Jobs {
+		for _, task := range job.Tasks {
+			if task.Name == "preemptor1" || task.Name == "preemptee2" {
+				task.DRAResreq = devices
+			}
+			if task.Name == "preemptor1" {
+				task.ResourceClaimKeys = []string{"c1/preemptor-gpu"}
+			}
+		}
+	}
+	for _, task := range ssn.Nodes["n1"].Tasks {
+		if task.Name == "preemptee2" {
+			task.DRAResreq = devices
+		}
+	}
+	test.Run([]framework.Action{New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Fatal(err)
+	}
+}
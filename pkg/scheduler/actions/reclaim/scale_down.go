@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reclaim
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// scaleDownDeadlineKey is the argument of the time an elastic job is given to shrink before its members are reclaimed.
+	scaleDownDeadlineKey = "elasticScaleDownDeadline"
+
+	defaultScaleDownDeadline = 2 * time.Minute
+)
+
+// scaleDownNegotiator asks the elastic jobs to shrink instead of evicting their members,
+// a zero deadline disables it.
+type scaleDownNegotiator struct {
+	deadline time.Duration
+	now      time.Time
+	// requested is the number of members each elastic job was asked to give up in this session.
+	requested map[api.JobID]int32
+}
+
+func newScaleDownNegotiator(deadline time.Duration) *scaleDownNegotiator {
+	return &scaleDownNegotiator{
+		deadline:  deadline,
+		now:       time.Now(),
+		requested: map[api.JobID]int32{},
+	}
+}
+
+// spare returns whether the reclaimee must not be evicted for the task, because its elastic job is asked to
+// shrink by the scaleDownRequest of its podgroup and the deadline of the request has not passed yet.
+// The request is made, or lowered by one member if it was made in this session, when the job is above its minimum.
+func (n *scaleDownNegotiator) spare(ssn *framework.Session, reclaimee, task *api.TaskInfo) bool {
+	if n.deadline == 0 {
+		return false
+	}
+	job, found := ssn.Jobs[reclaimee.Job]
+	if !found || job.PodGroup == nil {
+		return false
+	}
+	minReplicas, elastic := job.ElasticMinReplicas()
+	if !elastic {
+		return false
+	}
+
+	status := &job.PodGroup.Status
+	requested := n.requested[job.UID]
+	if req := status.ScaleDownRequest; req != nil && requested == 0 {
+		if n.now.Before(req.Deadline.Time) {
+			klog.V(3).Infof("Spare Task <%s/%s>, Job <%s/%s> is asked to shrink to %d replicas until %s.",
+				reclaimee.Namespace, reclaimee.Name, job.Namespace, job.Name, req.Replicas, req.Deadline)
+			return true
+		}
+		klog.V(3).Infof("Job <%s/%s> has not shrunk to %d replicas by %s, reclaim Task <%s/%s>.",
+			job.Namespace, job.Name, req.Replicas, req.Deadline, reclaimee.Namespace, reclaimee.Name)
+		return false
+	}
+
+	replicas := int32(len(job.TaskStatusIndex[api.Running])) - requested - 1
+	if replicas < minReplicas {
+		return false
+	}
+	if status.ScaleDownRequest == nil {
+		status.ScaleDownRequest = &scheduling.ScaleDownRequest{
+			RequestTime: metav1.NewTime(n.now),
+			Deadline:    metav1.NewTime(n.now.Add(n.deadline)),
+		}
+	}
+	status.ScaleDownRequest.Replicas = replicas
+	status.ScaleDownRequest.Reason = fmt.Sprintf("reclaimed by task <%s/%s>", task.Namespace, task.Name)
+	n.requested[job.UID] = requested + 1
+
+	klog.V(3).Infof("Ask Job <%s/%s> to shrink to %d replicas instead of reclaiming Task <%s/%s>.",
+		job.Namespace, job.Name, replicas, reclaimee.Namespace, reclaimee.Name)
+	return true
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reclaim
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestReclaimElasticScaleDown(t *testing.T) {
+	buildElasticPodGroup := func(minReplicas string, req *schedulingv1beta1.ScaleDownRequest) *schedulingv1beta1.PodGroup {
+		pg := util.BuildPodGroupWithPrio("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "")
+		pg.Annotations = map[string]string{
+			schedulingv1beta1.ElasticMinReplicasKey: minReplicas,
+			schedulingv1beta1.ElasticMaxReplicasKey: "3",
+		}
+		pg.Status.ScaleDownRequest = req
+		return pg
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		// expectReplicas is the replicas of the scaleDownRequest of pg1, 0 if there should be none
+		expectReplicas int32
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "elastic job above its minimum is asked to shrink instead of being reclaimed",
+				PodGroups:      []*schedulingv1beta1.PodGroup{buildElasticPodGroup("1", nil)},
+				ExpectEvictNum: 0,
+			},
+			expectReplicas: 2,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "elastic job is spared until the deadline of the request",
+				PodGroups: []*schedulingv1beta1.PodGroup{buildElasticPodGroup("1", &schedulingv1beta1.ScaleDownRequest{
+					Replicas: 2,
+					Deadline: metav1.NewTime(time.Now().Add(time.Minute)),
+				})},
+				ExpectEvictNum: 0,
+			},
+			expectReplicas: 2,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "elastic job not shrunk by the deadline of the request is reclaimed",
+				PodGroups: []*schedulingv1beta1.PodGroup{buildElasticPodGroup("1", &schedulingv1beta1.ScaleDownRequest{
+					Replicas: 2,
+					Deadline: metav1.NewTime(time.Now().Add(-time.Minute)),
+				})},
+				ExpectEvictNum: 1,
+				ExpectEvicted:  []string{"c1/preemptee2"},
+			},
+			// the request is fulfilled by the eviction
+			expectReplicas: 0,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "elastic job at its minimum is reclaimed",
+				PodGroups:      []*schedulingv1beta1.PodGroup{buildElasticPodGroup("3", nil)},
+				ExpectEvictNum: 1,
+				ExpectEvicted:  []string{"c1/preemptee2"},
+			},
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               conformance.PluginName,
+					EnabledReclaimable: &trueValue,
+				},
+				{
+					Name:               gang.PluginName,
+					EnabledReclaimable: &trueValue,
+					EnabledJobStarving: &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledReclaimable: &trueValue,
+					EnabledQueueOrder:  &trueValue,
+					EnablePreemptive:   &trueValue,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = map[string]framework.PluginBuilder{
+				conformance.PluginName: conformance.New,
+				gang.PluginName:        gang.New,
+				proportion.PluginName:  proportion.New,
+			}
+			test.PodGroups = append(test.PodGroups,
+				util.BuildPodGroupWithPrio("pg2", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue, ""))
+			preemptable := map[string]string{schedulingv1beta1.PodPreemptable: "true"}
+			nonPreemptable := map[string]string{schedulingv1beta1.PodPreemptable: "false"}
+			test.Pods = []*v1.Pod{
+				util.BuildPod("c1", "preemptee1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nonPreemptable, make(map[string]string)),
+				util.BuildPod("c1", "preemptee2", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", preemptable, make(map[string]string)),
+				util.BuildPod("c1", "preemptee3", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nonPreemptable, make(map[string]string)),
+				util.BuildPod("c1", "preemptor1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+			}
+			test.Nodes = []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "3Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			}
+			test.Queues = []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			}
+
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			req := ssn.Jobs["c1/pg1"].PodGroup.Status.ScaleDownRequest
+			if test.expectReplicas == 0 {
+				if req != nil {
+					t.Errorf("expected no scale down request, got %v", req)
+				}
+				return
+			}
+			if req == nil || req.Replicas != test.expectReplicas {
+				t.Fatalf("expected a scale down request to %d replicas, got %v", test.expectReplicas, req)
+			}
+			if !req.RequestTime.IsZero() && !req.Deadline.After(req.RequestTime.Time) {
+				t.Errorf("expected the deadline %v after the request time %v", req.Deadline, req.RequestTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reserve
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// Name is the name of the reserve action.
+	Name = "reserve"
+
+	// ConservativeBackfillKey enables the shadow reservation of the starving gang job first in order, which holds
+	// the idle capacity for the job until it starts. Only the jobs finishing before the time the job is expected to
+	// start can be backfilled to the capacity, like the conservative backfill of Slurm.
+	ConservativeBackfillKey = "conservativeBackfill"
+	// ShadowWindowKey is the start time of the shadow reservation from now if the time the job is expected to start
+	// is unknown, i.e. the running tasks freeing the capacity it needs have no runtime estimate.
+	ShadowWindowKey = "shadowWindow"
+
+	defaultShadowWindow = 24 * time.Hour
+)
+
+// Action holds the idle capacity of the nodes for the Reservations, so the jobs scheduled
+// to start later are guaranteed space. The capacity is held until the Reservation expires.
+type Action struct {
+	conservativeBackfill bool
+	shadowWindow         time.Duration
+}
+
+func New() *Action {
+	return &Action{
+		shadowWindow: defaultShadowWindow,
+	}
+}
+
+func (reserve *Action) Name() string {
+	return Name
+}
+
+func (reserve *Action) Initialize() {}
+
+func (reserve *Action) parseArguments(ssn *framework.Session) {
+	reserve.conservativeBackfill = false
+	reserve.shadowWindow = defaultShadowWindow
+	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, reserve.Name())
+	arguments.GetBool(&reserve.conservativeBackfill, ConservativeBackfillKey)
+	var window string
+	arguments.GetString(&window, ShadowWindowKey)
+	if window != "" {
+		if d, err := time.ParseDuration(window); err != nil || d <= 0 {
+			klog.Warningf("Invalid %s <%s> in action %s, using default %v", ShadowWindowKey, window, Name, defaultShadowWindow)
+		} else {
+			reserve.shadowWindow = d
+		}
+	}
+}
+
+func (reserve *Action) Execute(ssn *framework.Session) {
+	klog.V(5).Infof("Enter Reserve ...")
+	defer klog.V(5).Infof("Leaving Reserve ...")
+
+	reserve.parseArguments(ssn)
+	now := time.Now()
+	if reserve.conservativeBackfill {
+		if shadow := reserve.shadowReservation(ssn, now); shadow != nil {
+			if ssn.Reservations == nil {
+				ssn.Reservations = map[string]*api.ReservationInfo{}
+			}
+			ssn.Reservations[shadow.Name] = shadow
+		}
+	}
+
+	if len(ssn.Reservations) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(ssn.Reservations))
+	for name := range ssn.Reservations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodeNames := make([]string, 0, len(ssn.Nodes))
+	for name := range ssn.Nodes {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Strings(nodeNames)
+
+	for _, name := range names {
+		reservation := ssn.Reservations[name]
+		previous := reservation.Clone()
+
+		if reservation.Expired(now) {
+			reservation.Nodes = map[string]*api.Resource{}
+			reservation.Phase = v1alpha1.ReservationExpired
+		} else {
+			reserve.hold(ssn, reservation, nodeNames, now)
+		}
+
+		if !reservation.Shadow() && statusChanged(previous, reservation) {
+			klog.V(3).Infof("Reservation <%s> is %s, holding <%v>", reservation.Name, reservation.Phase, reservation.Held())
+			if err := ssn.UpdateReservationStatus(reservation); err != nil {
+				klog.Errorf("Failed to update status of Reservation <%s>: %v", reservation.Name, err)
+			}
+		}
+	}
+}
+
+// hold releases the capacity held on the nodes which are gone or not selected any more,
+// then holds the idle capacity of the selected nodes until the requested resources are held.
+func (reserve *Action) hold(ssn *framework.Session, reservation *api.ReservationInfo, nodeNames []string, now time.Time) {
+	for nodeName := range reservation.Nodes {
+		if node, found := ssn.Nodes[nodeName]; !found || !reservation.Selects(node) {
+			delete(reservation.Nodes, nodeName)
+		}
+	}
+
+	for _, nodeName := range nodeNames {
+		need := api.ExceededPart(reservation.Resource, reservation.Held())
+		if need.IsEmpty() {
+			break
+		}
+
+		node := ssn.Nodes[nodeName]
+		if !reservation.Selects(node) {
+			continue
+		}
+		free := api.ExceededPart(node.Idle, heldOnNode(ssn, node, now))
+		take := need.Clone().MinDimensionResource(free, api.Zero)
+		if take.IsEmpty() {
+			continue
+		}
+
+		if held, found := reservation.Nodes[nodeName]; found {
+			held.Add(take)
+		} else {
+			reservation.Nodes[nodeName] = take
+		}
+	}
+
+	if reservation.Resource.LessEqual(reservation.Held(), api.Zero) {
+		reservation.Phase = v1alpha1.ReservationReady
+	} else {
+		reservation.Phase = v1alpha1.ReservationPending
+	}
+}
+
+// shadowReservation returns the shadow reservation of the starving gang job first in order which can not start with
+// the idle capacity, nil if there is none. The reservation starts when the running tasks are expected to free enough
+// capacity for the job, by their runtime estimates.
+func (reserve *Action) shadowReservation(ssn *framework.Session, now time.Time) *api.ReservationInfo {
+	jobs := util.NewPriorityQueue(ssn.JobOrderFn)
+	for _, job := range ssn.Jobs {
+		if !ssn.JobInPipeline(job) || job.IsPending() || job.MinAvailable <= 1 || ssn.JobReady(job) {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			continue
+		}
+		jobs.Push(job)
+	}
+	if jobs.Empty() {
+		return nil
+	}
+	job := jobs.Pop().(*api.JobInfo)
+
+	need := minRequest(ssn, job)
+	if need.IsEmpty() {
+		return nil
+	}
+
+	available := api.EmptyResource()
+	for _, node := range ssn.Nodes {
+		available.Add(api.ExceededPart(node.Idle, heldOnNode(ssn, node, now)))
+	}
+	if need.LessEqual(available, api.Zero) {
+		// the job can start now, there is nothing to hold capacity for
+		return nil
+	}
+
+	startTime := now.Add(reserve.shadowWindow)
+	type release struct {
+		end      time.Time
+		resource *api.Resource
+	}
+	var releases []release
+	for _, running := range ssn.Jobs {
+		for _, task := range running.TaskStatusIndex[api.Running] {
+			if end, found := framework.ExpectedEndTime(running, task); found {
+				releases = append(releases, release{end: end, resource: task.Resreq})
+			}
+		}
+	}
+	sort.Slice(releases, func(i, j int) bool { return releases[i].end.Before(releases[j].end) })
+	for _, r := range releases {
+		available.Add(r.resource)
+		if need.LessEqual(available, api.Zero) {
+			startTime = r.end
+			break
+		}
+	}
+	if startTime.Before(now) {
+		startTime = now
+	}
+
+	klog.V(3).Infof("Shadow reservation of job <%s/%s> starts at %v, holding <%v>", job.Namespace, job.Name, startTime, need)
+	return &api.ReservationInfo{
+		Name:           "shadow/" + string(job.UID),
+		Queue:          job.Queue,
+		Resource:       need,
+		NodeSelector:   labels.Everything(),
+		StartTime:      startTime,
+		ExpirationTime: startTime.Add(api.DefaultReservationTTL),
+		Phase:          v1alpha1.ReservationPending,
+		Nodes:          map[string]*api.Resource{},
+		Job:            job.UID,
+	}
+}
+
+// minRequest returns the resources requested by the pending tasks the job needs to get ready.
+func minRequest(ssn *framework.Session, job *api.JobInfo) *api.Resource {
+	need := api.EmptyResource()
+	missing := job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum()
+	tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if !task.BestEffort && !task.SchGated {
+			tasks.Push(task)
+		}
+	}
+	for ; missing > 0 && !tasks.Empty(); missing-- {
+		need.Add(tasks.Pop().(*api.TaskInfo).InitResreq)
+	}
+	return need
+}
+
+func (reserve *Action) UnInitialize() {}
+
+// heldOnNode returns the capacity held on the node by all the Reservations and not used by their consumers.
+func heldOnNode(ssn *framework.Session, node *api.NodeInfo, now time.Time) *api.Resource {
+	held := api.EmptyResource()
+	for _, reservation := range ssn.Reservations {
+		if reservation.Expired(now) {
+			continue
+		}
+		held.Add(ssn.ReservationHeldOnNode(reservation, node))
+	}
+	return held
+}
+
+func statusChanged(previous, current *api.ReservationInfo) bool {
+	if previous.Phase != current.Phase || len(previous.Nodes) != len(current.Nodes) {
+		return true
+	}
+	for name, resource := range current.Nodes {
+		if held, found := previous.Nodes[name]; !found || !held.Equal(resource, api.Zero) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reserve
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/backfill"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func buildReservation(name, queue string, resources v1.ResourceList, nodeSelector map[string]string, startTime time.Time, nodes ...v1alpha1.ReservedNode) *api.ReservationInfo {
+	return api.NewReservationInfo(&v1alpha1.Reservation{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ReservationSpec{
+			Queue:        queue,
+			Resources:    resources,
+			NodeSelector: nodeSelector,
+			StartTime:    &metav1.Time{Time: startTime},
+		},
+		Status: v1alpha1.ReservationStatus{Nodes: nodes},
+	})
+}
+
+func TestReserve(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+	}
+	gpuPool := map[string]string{"pool": "gpu"}
+	now := time.Now()
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		reservation   *api.ReservationInfo
+		expectedPhase v1alpha1.ReservationPhase
+		expectedNodes map[string]*api.Resource
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "idle capacity of the selected nodes is held",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "n3", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), gpuPool),
+					util.BuildNode("n2", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+					util.BuildNode("n3", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), gpuPool),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+			},
+			reservation:   buildReservation("rsv", "q1", api.BuildResourceList("3", "3Gi"), gpuPool, now.Add(time.Hour)),
+			expectedPhase: v1alpha1.ReservationReady,
+			expectedNodes: map[string]*api.Resource{
+				"n1": api.NewResource(api.BuildResourceList("2", "2Gi")),
+				"n3": api.NewResource(api.BuildResourceList("1", "1Gi")),
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "reservation is pending until enough capacity is held",
+				Plugins: plugins,
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+			},
+			reservation:   buildReservation("rsv", "q1", api.BuildResourceList("4", "4Gi"), nil, now.Add(time.Hour)),
+			expectedPhase: v1alpha1.ReservationPending,
+			expectedNodes: map[string]*api.Resource{
+				"n1": api.NewResource(api.BuildResourceList("2", "2Gi")),
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "capacity of an expired reservation is released",
+				Plugins: plugins,
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+			},
+			reservation: buildReservation("rsv", "q1", api.BuildResourceList("2", "2Gi"), nil, now.Add(-2*api.DefaultReservationTTL),
+				v1alpha1.ReservedNode{NodeName: "n1", Resources: api.BuildResourceList("2", "2Gi")}),
+			expectedPhase: v1alpha1.ReservationExpired,
+			expectedNodes: map[string]*api.Resource{},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ssn := test.RegisterSession(nil, nil)
+			defer test.Close()
+			ssn.Reservations = map[string]*api.ReservationInfo{test.reservation.Name: test.reservation}
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			reservation := ssn.Reservations[test.reservation.Name]
+			if reservation.Phase != test.expectedPhase {
+				t.Errorf("expected phase %s, got %s", test.expectedPhase, reservation.Phase)
+			}
+			if len(reservation.Nodes) != len(test.expectedNodes) {
+				t.Fatalf("expected capacity held on nodes %v, got %v", test.expectedNodes, reservation.Nodes)
+			}
+			for name, expected := range test.expectedNodes {
+				if held, found := reservation.Nodes[name]; !found || !held.Equal(expected, api.Zero) {
+					t.Errorf("expected capacity %v held on node %s, got %v", expected, name, held)
+				}
+			}
+		})
+	}
+}
+
+func TestReserveBeforeAllocate(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+	}
+	consumer := map[string]string{schedulingv1beta1.ReservationKey: "rsv"}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "reserved capacity is not allocated to other jobs",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindsNum: 0,
+		},
+		{
+			Name:    "reserved capacity is allocated to the jobs of the reservation",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithAnno("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, consumer),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+			ExpectBindsNum: 1,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name: conformance.PluginName,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledJobStarving:  &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobReady:     &trueValue,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			ssn.Reservations = map[string]*api.ReservationInfo{
+				"rsv": buildReservation("rsv", "q1", api.BuildResourceList("2", "2Gi"), nil, time.Now()),
+			}
+			test.Run([]framework.Action{New(), allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestConservativeBackfill(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+	}
+	estimate := func(value string) map[string]string {
+		return map[string]string{schedulingv1beta1.RuntimeEstimateKey: value}
+	}
+	maxRun := func(queue *schedulingv1beta1.Queue, seconds int64) *schedulingv1beta1.Queue {
+		queue.Spec.MaxRunSeconds = &seconds
+		return queue
+	}
+	running := util.BuildPod("c1", "running", "n1", v1.PodRunning, api.BuildResourceList("2", "2Gi"), "pg-running", nil, nil)
+	running.Status.StartTime = &metav1.Time{Time: time.Now()}
+	pods := func(small *v1.Pod) []*v1.Pod {
+		return []*v1.Pod{
+			running,
+			util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg-big", nil, nil),
+			util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg-big", nil, nil),
+			small,
+		}
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "job finishing before the gang job starts is backfilled",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithAnno("pg-running", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, estimate("1h")),
+				util.BuildPodGroup("pg-big", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroup("pg-small", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: pods(util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg-small", nil, nil)),
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				maxRun(util.BuildQueue("q2", 1, nil), 1800),
+			},
+			ExpectBindMap:  map[string]string{"c1/small": "n1"},
+			ExpectBindsNum: 1,
+		},
+		{
+			Name:    "job finishing after the gang job starts is not backfilled",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithAnno("pg-running", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, estimate("1h")),
+				util.BuildPodGroup("pg-big", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroup("pg-small", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: pods(util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg-small", nil, nil)),
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				maxRun(util.BuildQueue("q2", 1, nil), 7200),
+			},
+			ExpectBindsNum: 0,
+		},
+		{
+			Name:    "job with an advisory runtime estimate only is not backfilled",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithAnno("pg-running", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, estimate("1h")),
+				util.BuildPodGroup("pg-big", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroupWithAnno("pg-small", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue, estimate("30m")),
+			},
+			Pods: pods(util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg-small", nil, nil)),
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectBindsNum: 0,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name: conformance.PluginName,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobStarving:  &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobReady:     &trueValue,
+				},
+			},
+		},
+	}
+	configurations := []conf.Configuration{{
+		Name:      Name,
+		Arguments: map[string]interface{}{ConservativeBackfillKey: true},
+	}}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, configurations)
+			defer test.Close()
+			test.Run([]framework.Action{New(), allocate.New(), backfill.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@
 package shuffle
 
 import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -26,10 +29,21 @@ import (
 const (
 	// Shuffle indicates the action name
 	Shuffle = "shuffle"
+
+	// maxEvictionsKey is the argument bounding the victims evicted in a session, 0 for no bound
+	maxEvictionsKey = "maxEvictions"
+	// maxNodeEvictionsKey is the argument bounding the victims evicted from a node in a session, 0 for no bound
+	maxNodeEvictionsKey = "maxNodeEvictions"
+	// respectDisruptionBudgetKey is the argument keeping the jobs at their minAvailable and disruption budget
+	respectDisruptionBudgetKey = "respectDisruptionBudget"
 )
 
 // Action defines the action
-type Action struct{}
+type Action struct {
+	maxEvictions            int
+	maxNodeEvictions        int
+	respectDisruptionBudget bool
+}
 
 // New returns the action instance
 func New() *Action {
@@ -49,9 +63,14 @@ func (shuffle *Action) Execute(ssn *framework.Session) {
 	klog.V(5).Infoln("Enter Shuffle ...")
 	defer klog.V(5).Infoln("Leaving Shuffle ...")
 
+	shuffle.parseArguments(ssn)
+
 	// select pods that may be evicted
 	tasks := make([]*api.TaskInfo, 0)
 	for _, jobInfo := range ssn.Jobs {
+		if !ssn.JobInPipeline(jobInfo) {
+			continue
+		}
 		for _, taskInfo := range jobInfo.Tasks {
 			if taskInfo.Status == api.Running {
 				tasks = append(tasks, taskInfo)
@@ -60,14 +79,103 @@ func (shuffle *Action) Execute(ssn *framework.Session) {
 	}
 
 	// Evict target workloads
-	victims := ssn.VictimTasks(tasks)
-	for victim := range victims {
+	evictions := 0
+	nodeEvictions := map[string]int{}
+	jobBudgets := map[api.JobID]int{}
+	for _, victim := range shuffle.orderVictims(ssn.VictimTasks(tasks)) {
+		if shuffle.maxEvictions > 0 && evictions >= shuffle.maxEvictions {
+			klog.V(3).Infof("The eviction budget %d of the session is used up.", shuffle.maxEvictions)
+			break
+		}
+		if shuffle.maxNodeEvictions > 0 && nodeEvictions[victim.NodeName] >= shuffle.maxNodeEvictions {
+			klog.V(4).Infof("The eviction budget %d of node %s is used up, skip pod %s/%s.", shuffle.maxNodeEvictions, victim.NodeName, victim.Namespace, victim.Name)
+			continue
+		}
+		if shuffle.respectDisruptionBudget {
+			if _, found := jobBudgets[victim.Job]; !found {
+				jobBudgets[victim.Job] = disruptionBudget(ssn.Jobs[victim.Job])
+			}
+			if jobBudgets[victim.Job] <= 0 {
+				klog.V(4).Infof("The disruption budget of job %s is used up, skip pod %s/%s.", victim.Job, victim.Namespace, victim.Name)
+				continue
+			}
+		}
 		klog.V(3).Infof("pod %s from namespace %s and job %s will be evicted.\n", victim.Name, victim.Namespace, string(victim.Job))
 		if err := ssn.Evict(victim, "shuffle"); err != nil {
 			klog.Errorf("Failed to evict Task <%s/%s>: %v\n", victim.Namespace, victim.Name, err)
 			continue
 		}
+		evictions++
+		nodeEvictions[victim.NodeName]++
+		jobBudgets[victim.Job]--
+	}
+}
+
+// parseArguments reads the eviction budgets of the action, negative budgets are ignored.
+func (shuffle *Action) parseArguments(ssn *framework.Session) {
+	shuffle.maxEvictions, shuffle.maxNodeEvictions, shuffle.respectDisruptionBudget = 0, 0, false
+	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, shuffle.Name())
+	arguments.GetInt(&shuffle.maxEvictions, maxEvictionsKey)
+	arguments.GetInt(&shuffle.maxNodeEvictions, maxNodeEvictionsKey)
+	arguments.GetBool(&shuffle.respectDisruptionBudget, respectDisruptionBudgetKey)
+	if shuffle.maxEvictions < 0 {
+		klog.Warningf("Invalid %s <%d> in action %s, using no budget", maxEvictionsKey, shuffle.maxEvictions, shuffle.Name())
+		shuffle.maxEvictions = 0
 	}
+	if shuffle.maxNodeEvictions < 0 {
+		klog.Warningf("Invalid %s <%d> in action %s, using no budget", maxNodeEvictionsKey, shuffle.maxNodeEvictions, shuffle.Name())
+		shuffle.maxNodeEvictions = 0
+	}
+}
+
+// disruptionBudget returns the number of running tasks of the job which can be evicted without taking the job under
+// the minAvailable of its gang, nor under the minAvailable or over the maxUnavailable of its disruption budget.
+func disruptionBudget(job *api.JobInfo) int {
+	if job == nil {
+		return 0
+	}
+	running := len(job.TaskStatusIndex[api.Running])
+	budget := running - int(job.MinAvailable)
+	if job.Budget == nil {
+		return budget
+	}
+	if job.Budget.MinAvailable != "" {
+		budget = min(budget, running-parseIntOrPercent(job.Budget.MinAvailable, len(job.Tasks)))
+	} else if job.Budget.MaxUnavailable != "" {
+		finished := len(job.TaskStatusIndex[api.Succeeded]) + len(job.TaskStatusIndex[api.Failed])
+		unavailable := len(job.Tasks) - finished - running
+		budget = min(budget, parseIntOrPercent(job.Budget.MaxUnavailable, len(job.Tasks))-unavailable)
+	}
+	return budget
+}
+
+// parseIntOrPercent parses a number of tasks, or a percentage of the total tasks rounded up.
+func parseIntOrPercent(value string, total int) int {
+	parsed := intstr.Parse(value)
+	result, err := intstr.GetScaledValueFromIntOrPercent(&parsed, total, true)
+	if err != nil {
+		klog.Warningf("Invalid disruption budget <%s>: %v", value, err)
+		return 0
+	}
+	return result
+}
+
+// orderVictims returns the victims from the lowest priority, so that the budgets spare the most important pods.
+func (shuffle *Action) orderVictims(victims map[*api.TaskInfo]bool) []*api.TaskInfo {
+	ordered := make([]*api.TaskInfo, 0, len(victims))
+	for victim := range victims {
+		ordered = append(ordered, victim)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		if ordered[i].Namespace != ordered[j].Namespace {
+			return ordered[i].Namespace < ordered[j].Namespace
+		}
+		return ordered[i].Name < ordered[j].Name
+	})
+	return ordered
 }
 
 // UnInitialize releases resource which is not useful.
@@ -40,17 +40,17 @@ func TestShuffle(t *testing.T) {
 	ctl := gomock.NewController(t)
 	fakePlugin := mock_framework.NewMockPlugin(ctl)
 	fakePlugin.EXPECT().Name().AnyTimes().Return("fake")
-	fakePlugin.EXPECT().OnSessionOpen(gomock.Any()).Return()
-	fakePlugin.EXPECT().OnSessionClose(gomock.Any()).Return()
+	fakePlugin.EXPECT().OnSessionOpen(gomock.Any()).AnyTimes().Return()
+	fakePlugin.EXPECT().OnSessionClose(gomock.Any()).AnyTimes().Return()
 	fakePluginBuilder := func(arguments framework.Arguments) framework.Plugin {
 		return fakePlugin
 	}
 
 	plugins := map[string]framework.PluginBuilder{"fake": fakePluginBuilder}
 
-	tests := []uthelper.TestCommonStruct{
-		{
-			Name:    "select pods with low priority and evict them",
+	fixture := func(name string, evicted ...string) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
 			Plugins: plugins,
 			Nodes: []*v1.Node{
 				util.BuildNode("node1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
@@ -73,8 +73,40 @@ func TestShuffle(t *testing.T) {
 				util.BuildPodWithPriority("test", "pod3-1", "node2", v1.PodRunning, api.BuildResourceList("1", "2G"), "pg3", make(map[string]string), make(map[string]string), &lowPriority),
 				util.BuildPodWithPriority("test", "pod3-2", "node2", v1.PodRunning, api.BuildResourceList("1", "2G"), "pg3", make(map[string]string), make(map[string]string), &highPriority),
 			},
-			ExpectEvictNum: 3,
-			ExpectEvicted:  []string{"test/pod1-1", "test/pod2-1", "test/pod3-1"},
+			ExpectEvictNum: len(evicted),
+			ExpectEvicted:  evicted,
+		}
+	}
+
+	// gangFixture keeps pg1 at its minMember and pg3 at the minAvailable of its disruption budget
+	gangFixture := func(name string, evicted ...string) uthelper.TestCommonStruct {
+		test := fixture(name, evicted...)
+		test.PodGroups[0].Spec.MinMember = 3
+		test.PodGroups[2].Annotations = map[string]string{schedulingv1beta1.JDBMinAvailable: "100%"}
+		return test
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+	}{
+		{
+			TestCommonStruct: fixture("select pods with low priority and evict them", "test/pod1-1", "test/pod2-1", "test/pod3-1"),
+		},
+		{
+			TestCommonStruct: fixture("evict no more pods than the budget of the session", "test/pod1-1", "test/pod2-1"),
+			arguments:        framework.Arguments{"maxEvictions": 2},
+		},
+		{
+			TestCommonStruct: fixture("evict no more pods from a node than its budget", "test/pod1-1", "test/pod3-1"),
+			arguments:        framework.Arguments{"maxNodeEvictions": 1},
+		},
+		{
+			TestCommonStruct: gangFixture("evict the pods of gangs and disruption budgets by default", "test/pod1-1", "test/pod2-1", "test/pod3-1"),
+		},
+		{
+			TestCommonStruct: gangFixture("keep the jobs at their minAvailable and disruption budget", "test/pod2-1"),
+			arguments:        framework.Arguments{"respectDisruptionBudget": true},
 		},
 	}
 	shuffle := New()
@@ -109,7 +141,7 @@ func TestShuffle(t *testing.T) {
 		}
 
 		t.Run(test.Name, func(t *testing.T) {
-			ssn := test.RegisterSession(tiers, nil)
+			ssn := test.RegisterSession(tiers, []conf.Configuration{{Name: shuffle.Name(), Arguments: test.arguments}})
 			defer test.Close()
 			ssn.AddVictimTasksFns("fake", fakePluginVictimFns())
 			test.Run([]framework.Action{shuffle})
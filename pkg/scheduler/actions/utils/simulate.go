@@ -22,7 +22,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
-	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/util"
@@ -320,7 +319,7 @@ func intersectNodesByName(primary, filter []*api.NodeInfo) []*api.NodeInfo {
 
 // prioritizeNodesForSimulate mirrors allocate.prioritizeNodes (idle / future-idle gradients and soft sharding).
 func prioritizeNodesForSimulate(ssn *framework.Session, task *api.TaskInfo, predicateNodes []*api.NodeInfo) *api.NodeInfo {
-	shardingMode := options.ServerOpts.ShardingMode
+	shardingMode := util.TaskShardingMode(task)
 	var idleCandidateNodes []*api.NodeInfo
 	var futureIdleCandidateNodes []*api.NodeInfo
 	var idleCandidateNodesInOtherShards []*api.NodeInfo
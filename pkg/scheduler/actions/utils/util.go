@@ -17,11 +17,25 @@ limitations under the License.
 package utils
 
 import (
+	"k8s.io/klog/v2"
+
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 )
 
+// RollbackToSavepoint reverts the operations of stmt made since the savepoint,
+// and releases the savepoint.
+func RollbackToSavepoint(stmt *framework.Statement, sp framework.SavepointID) {
+	if err := stmt.RollbackTo(sp); err != nil {
+		klog.Errorf("Failed to rollback to savepoint <%s>: %v", sp, err)
+		return
+	}
+	if err := stmt.ReleaseSavepoint(sp); err != nil {
+		klog.Errorf("Failed to release savepoint <%s>: %v", sp, err)
+	}
+}
+
 // SumInitResreq sums InitResreq across the given tasks.
 func SumInitResreq(tasks []*api.TaskInfo) *api.Resource {
 	demand := api.EmptyResource()
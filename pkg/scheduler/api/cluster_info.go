@@ -42,6 +42,7 @@ type ClusterInfo struct {
 	NodeList                  []string
 	CSINodesStatus            map[string]*CSINodeStatusInfo
 	NodesInShard              sets.Set[string]
+	Reservations              map[string]*ReservationInfo
 }
 
 func (ci ClusterInfo) String() string {
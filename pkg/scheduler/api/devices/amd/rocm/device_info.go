@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rocm
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api/devices"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/nodelock"
+	"volcano.sh/volcano/third_party/hami/util"
+)
+
+// ROCmDevice is an AMD GPU shared by the pods by fractions of its memory and compute units.
+type ROCmDevice struct {
+	DeviceInfo  *devices.DeviceInfo
+	DeviceUsage *devices.DeviceUsage
+	// PodMap is the usage of the device by the pods, by pod UID
+	PodMap map[string]*devices.DeviceUsage
+}
+
+// ROCmDevices are the AMD GPUs of a node.
+type ROCmDevices struct {
+	NodeName string
+	Devices  map[string]*ROCmDevice
+	// Score is the score of the node computed by the last FilterNode
+	Score float64
+}
+
+// NewROCmDevices returns the AMD GPUs registered by the ROCm device plugin on the node, or nil if there are none.
+func NewROCmDevices(name string, node *v1.Node) *ROCmDevices {
+	if node == nil {
+		return nil
+	}
+	num, ok := node.Status.Allocatable[ResourceCountName]
+	if !ok || num.IsZero() {
+		return nil
+	}
+	anno, ok := node.Annotations[NodeRegisterAnnotation]
+	if !ok {
+		klog.V(4).Infof("Node %s has no annotation %s", name, NodeRegisterAnnotation)
+		return nil
+	}
+	nodeDevices, err := devices.UnMarshalNodeDevices(anno)
+	if err != nil {
+		klog.ErrorS(err, "Failed to unmarshal rocm devices", "node", name, "annotation", anno)
+		return nil
+	}
+	rds := &ROCmDevices{
+		NodeName: name,
+		Devices:  make(map[string]*ROCmDevice, len(nodeDevices)),
+	}
+	for _, nd := range nodeDevices {
+		if nd.Devcore == 0 {
+			nd.Devcore = fullCores
+		}
+		rds.Devices[nd.ID] = &ROCmDevice{
+			DeviceInfo:  nd,
+			DeviceUsage: &devices.DeviceUsage{},
+			PodMap:      make(map[string]*devices.DeviceUsage),
+		}
+	}
+	return rds
+}
+
+func (rds *ROCmDevices) AddResource(pod *v1.Pod) {
+	if rds == nil || pod == nil {
+		return
+	}
+	rds.addResource(pod.Annotations, pod)
+}
+
+func (rds *ROCmDevices) addResource(annotations map[string]string, pod *v1.Pod) {
+	for _, ctrDevs := range decodePodDevices(annotations[AllocatedDevicesAnnotation]) {
+		for _, ctrDev := range ctrDevs {
+			dev, ok := rds.Devices[ctrDev.UUID]
+			if !ok {
+				continue
+			}
+			usage, found := dev.PodMap[string(pod.UID)]
+			if !found {
+				usage = &devices.DeviceUsage{}
+				dev.PodMap[string(pod.UID)] = usage
+			}
+			usage.Used++
+			usage.Usedmem += ctrDev.Usedmem
+			usage.Usedcores += ctrDev.Usedcores
+			dev.DeviceUsage.Used++
+			dev.DeviceUsage.Usedmem += ctrDev.Usedmem
+			dev.DeviceUsage.Usedcores += ctrDev.Usedcores
+		}
+	}
+}
+
+func (rds *ROCmDevices) SubResource(pod *v1.Pod) {
+	if rds == nil || pod == nil {
+		return
+	}
+	for _, dev := range rds.Devices {
+		usage, found := dev.PodMap[string(pod.UID)]
+		if !found {
+			continue
+		}
+		delete(dev.PodMap, string(pod.UID))
+		dev.DeviceUsage.Used -= usage.Used
+		dev.DeviceUsage.Usedmem -= usage.Usedmem
+		dev.DeviceUsage.Usedcores -= usage.Usedcores
+	}
+}
+
+func (rds *ROCmDevices) AddQueueResource(pod *v1.Pod) map[string]float64 {
+	res := map[string]float64{}
+	if rds == nil || pod == nil {
+		return res
+	}
+	for _, ctrDevs := range decodePodDevices(pod.Annotations[AllocatedDevicesAnnotation]) {
+		for _, ctrDev := range ctrDevs {
+			if _, ok := rds.Devices[ctrDev.UUID]; ok {
+				res[ResourceMemoryName] += float64(ctrDev.Usedmem * 1000)
+				res[ResourceCoreName] += float64(ctrDev.Usedcores * 1000)
+			}
+		}
+	}
+	return res
+}
+
+func (rds *ROCmDevices) HasDeviceRequest(pod *v1.Pod) bool {
+	if !ROCmEnable || pod == nil {
+		return false
+	}
+	for _, container := range pod.Spec.Containers {
+		if _, ok := container.Resources.Limits[ResourceCountName]; ok {
+			return true
+		}
+		if _, ok := container.Resources.Limits[ResourceMemoryName]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (rds *ROCmDevices) FilterNode(pod *v1.Pod, schedulePolicy string) (int, string, error) {
+	if !ROCmEnable {
+		return devices.Success, "", nil
+	}
+	_, score, err := rds.selectDevices(pod, schedulePolicy)
+	if err != nil {
+		klog.V(4).Infof("Failed to filter node %s for rocm task %s/%s: %v", rds.NodeName, pod.Namespace, pod.Name, err)
+		return devices.Unschedulable, "rocm DeviceSharing error", err
+	}
+	rds.Score = score
+	return devices.Success, "", nil
+}
+
+func (rds *ROCmDevices) ScoreNode(pod *v1.Pod, schedulePolicy string) float64 {
+	// Use the score cached by FilterNode in order to avoid recalculating.
+	return rds.Score
+}
+
+func (rds *ROCmDevices) Allocate(kubeClient kubernetes.Interface, pod *v1.Pod) error {
+	if !ROCmEnable {
+		return nil
+	}
+	if pod.Annotations[util.AssignedNodeAnnotations] == rds.NodeName && pod.Annotations[AllocatedDevicesAnnotation] != "" {
+		klog.V(4).Infof("Skip duplicate rocm allocation of pod %s/%s on node %s", pod.Namespace, pod.Name, rds.NodeName)
+		return nil
+	}
+	podDevs, _, err := rds.selectDevices(pod, SchedulePolicy)
+	if err != nil {
+		return errors.Errorf("failed to select rocm devices for pod %s: %v", pod.Name, err)
+	}
+	if NodeLockEnable {
+		nodelock.UseClient(kubeClient)
+		if err := nodelock.LockNode(rds.NodeName, NodeLockROCm); err != nil {
+			return errors.Errorf("node %s locked for %s. err: %s", rds.NodeName, pod.Name, err.Error())
+		}
+	}
+
+	annotations := make(map[string]string)
+	annotations[InRequestDevicesAnnotation] = devices.EncodePodSingleDevice(podDevs)
+	annotations[AllocatedDevicesAnnotation] = annotations[InRequestDevicesAnnotation]
+	annotations[util.AssignedNodeAnnotations] = rds.NodeName
+	annotations[util.AssignedTimeAnnotations] = strconv.FormatInt(time.Now().Unix(), 10)
+	annotations[util.DeviceBindPhase] = "allocating"
+	annotations[util.BindTimeAnnotations] = strconv.FormatInt(time.Now().Unix(), 10)
+	// Keep in-memory pod object in sync so rollback paths can see the allocated devices
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		pod.Annotations[k] = v
+	}
+	rds.addResource(annotations, pod)
+	if err := devices.PatchPodAnnotations(kubeClient, pod, annotations); err != nil {
+		return err
+	}
+	klog.V(3).Infof("Allocate rocm devices %s to pod %s/%s", annotations[AllocatedDevicesAnnotation], pod.Namespace, pod.Name)
+	return nil
+}
+
+func (rds *ROCmDevices) Release(kubeClient kubernetes.Interface, pod *v1.Pod) error {
+	if rds == nil || pod == nil || pod.Annotations == nil {
+		return nil
+	}
+	rds.SubResource(pod)
+	if pod.Annotations[util.DeviceBindPhase] == "success" {
+		return nil
+	}
+	keys := []string{
+		InRequestDevicesAnnotation,
+		AllocatedDevicesAnnotation,
+		util.AssignedNodeAnnotations,
+		util.AssignedTimeAnnotations,
+		util.DeviceBindPhase,
+		util.BindTimeAnnotations,
+	}
+	if err := devices.RemovePodAnnotations(kubeClient, pod, keys); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		delete(pod.Annotations, k)
+	}
+	return nil
+}
+
+func (rds *ROCmDevices) GetIgnoredDevices() []string {
+	return []string{ResourceMemoryName, ResourceMemoryPercentageName, ResourceCoreName}
+}
+
+func (rds *ROCmDevices) GetStatus() string {
+	return ""
+}
+
+// DeepCopy returns a deep copy of ROCmDevices for use in dry-run simulation.
+func (rds *ROCmDevices) DeepCopy() interface{} {
+	if rds == nil {
+		return nil
+	}
+	cp := &ROCmDevices{
+		NodeName: rds.NodeName,
+		Score:    rds.Score,
+		Devices:  make(map[string]*ROCmDevice, len(rds.Devices)),
+	}
+	for id, dev := range rds.Devices {
+		cp.Devices[id] = dev.deepCopy()
+	}
+	return cp
+}
+
+func (dev *ROCmDevice) deepCopy() *ROCmDevice {
+	usage := *dev.DeviceUsage
+	cp := &ROCmDevice{
+		DeviceInfo:  dev.DeviceInfo,
+		DeviceUsage: &usage,
+		PodMap:      make(map[string]*devices.DeviceUsage, len(dev.PodMap)),
+	}
+	for uid, u := range dev.PodMap {
+		podUsage := *u
+		cp.PodMap[uid] = &podUsage
+	}
+	return cp
+}
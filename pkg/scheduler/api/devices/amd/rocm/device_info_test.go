@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rocm
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/volcano/pkg/scheduler/api/devices"
+	"volcano.sh/volcano/third_party/hami/util"
+)
+
+func newTestNode() *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "n1",
+			Annotations: map[string]string{NodeRegisterAnnotation: `[
+				{"id":"gpu-0","count":4,"devmem":65536,"devcore":100,"type":"MI210","health":true},
+				{"id":"gpu-1","count":4,"devmem":65536,"devcore":100,"type":"MI210","health":true},
+				{"id":"gpu-2","count":4,"devmem":65536,"devcore":100,"type":"MI210","health":false}]`},
+		},
+		Status: v1.NodeStatus{Allocatable: v1.ResourceList{ResourceCountName: resource.MustParse("3")}},
+	}
+}
+
+func newTestPod(name string, limits ...v1.ResourceList) *v1.Pod {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, UID: types.UID("uid-" + name)}}
+	for _, l := range limits {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Resources: v1.ResourceRequirements{Limits: l}})
+	}
+	return pod
+}
+
+func gpus(num, mem, cores string) v1.ResourceList {
+	l := v1.ResourceList{ResourceCountName: resource.MustParse(num)}
+	if mem != "" {
+		l[ResourceMemoryName] = resource.MustParse(mem)
+	}
+	if cores != "" {
+		l[ResourceCoreName] = resource.MustParse(cores)
+	}
+	return l
+}
+
+func TestFilterNode(t *testing.T) {
+	ROCmEnable = true
+	defer func() { ROCmEnable = false }()
+
+	testCases := []struct {
+		name string
+		used *v1.Pod
+		pod  *v1.Pod
+		code int
+	}{
+		{
+			name: "fraction of memory and cores fits",
+			pod:  newTestPod("p", gpus("1", "16384", "30")),
+			code: devices.Success,
+		},
+		{
+			name: "unhealthy devices are not allocated",
+			pod:  newTestPod("p", gpus("3", "1024", "")),
+			code: devices.Unschedulable,
+		},
+		{
+			name: "two containers share the devices",
+			pod:  newTestPod("p", gpus("2", "32768", "50"), gpus("2", "32768", "50")),
+			code: devices.Success,
+		},
+		{
+			name: "memory does not fit",
+			used: newTestPod("used", gpus("2", "49152", "")),
+			pod:  newTestPod("p", gpus("1", "32768", "")),
+			code: devices.Unschedulable,
+		},
+		{
+			name: "all the compute units are exclusive",
+			used: newTestPod("used", gpus("2", "1024", "10")),
+			pod:  newTestPod("p", gpus("1", "1024", "100")),
+			code: devices.Unschedulable,
+		},
+		{
+			name: "memory percentage",
+			used: newTestPod("used", gpus("2", "32768", "")),
+			pod: newTestPod("p", v1.ResourceList{
+				ResourceCountName:            resource.MustParse("2"),
+				ResourceMemoryPercentageName: resource.MustParse("50"),
+			}),
+			code: devices.Success,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rds := NewROCmDevices("n1", newTestNode())
+			if rds == nil || len(rds.Devices) != 3 {
+				t.Fatalf("expected the 3 registered devices, got %v", rds)
+			}
+			if tc.used != nil {
+				podDevs, _, err := rds.selectDevices(tc.used, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				tc.used.Annotations = map[string]string{AllocatedDevicesAnnotation: devices.EncodePodSingleDevice(podDevs)}
+				rds.AddResource(tc.used)
+			}
+			if !rds.HasDeviceRequest(tc.pod) {
+				t.Fatalf("expected the pod to request rocm devices")
+			}
+			if code, _, err := rds.FilterNode(tc.pod, ""); code != tc.code {
+				t.Errorf("expected code %d, got %d: %v", tc.code, code, err)
+			}
+		})
+	}
+}
+
+func TestSchedulePolicy(t *testing.T) {
+	rds := NewROCmDevices("n1", newTestNode())
+	used := newTestPod("used", gpus("1", "16384", ""))
+	used.Annotations = map[string]string{AllocatedDevicesAnnotation: "gpu-1,AMD,16384,0:;"}
+	rds.AddResource(used)
+
+	pod := newTestPod("p", gpus("1", "16384", ""))
+	podDevs, binpackScore, err := rds.selectDevices(pod, binpackPolicy)
+	if err != nil || podDevs[0][0].UUID != "gpu-1" {
+		t.Errorf("expected binpack to pick the used device, got %v: %v", podDevs, err)
+	}
+	podDevs, spreadScore, err := rds.selectDevices(pod, spreadPolicy)
+	if err != nil || podDevs[0][0].UUID != "gpu-0" {
+		t.Errorf("expected spread to pick the idle device, got %v: %v", podDevs, err)
+	}
+	if binpackScore != 25 || spreadScore != spreadMultiplier {
+		t.Errorf("expected scores 25 and %d, got %v and %v", spreadMultiplier, binpackScore, spreadScore)
+	}
+
+	rds.SubResource(used)
+	if usage := rds.Devices["gpu-1"].DeviceUsage; usage.Used != 0 || usage.Usedmem != 0 {
+		t.Errorf("expected the usage of the pod to be released, got %+v", usage)
+	}
+}
+
+func TestAllocateAndRelease(t *testing.T) {
+	ROCmEnable = true
+	defer func() { ROCmEnable = false }()
+
+	pod := newTestPod("p", gpus("1", "8192", "25"))
+	client := fake.NewSimpleClientset(pod)
+	rds := NewROCmDevices("n1", newTestNode())
+	if err := rds.Allocate(client, pod); err != nil {
+		t.Fatal(err)
+	}
+	patched, err := client.CoreV1().Pods("ns").Get(context.TODO(), "p", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocated := patched.Annotations[AllocatedDevicesAnnotation]
+	if allocated == "" || patched.Annotations[InRequestDevicesAnnotation] != allocated ||
+		patched.Annotations[util.AssignedNodeAnnotations] != "n1" || patched.Annotations[util.DeviceBindPhase] != "allocating" {
+		t.Fatalf("unexpected allocation annotations %v", patched.Annotations)
+	}
+	podDevs := decodePodDevices(allocated)
+	if len(podDevs) != 1 || len(podDevs[0]) != 1 || podDevs[0][0].Usedmem != 8192 || podDevs[0][0].Usedcores != 25 {
+		t.Fatalf("unexpected allocated devices %v", podDevs)
+	}
+	if usage := rds.Devices[podDevs[0][0].UUID].DeviceUsage; usage.Used != 1 || usage.Usedmem != 8192 || usage.Usedcores != 25 {
+		t.Errorf("expected the allocation to be accounted, got %+v", usage)
+	}
+	if res := rds.AddQueueResource(pod); res[ResourceMemoryName] != 8192*1000 || res[ResourceCoreName] != 25*1000 {
+		t.Errorf("unexpected queue resources %v", res)
+	}
+
+	if err := rds.Release(client, pod); err != nil {
+		t.Fatal(err)
+	}
+	if usage := rds.Devices[podDevs[0][0].UUID].DeviceUsage; usage.Used != 0 {
+		t.Errorf("expected the allocation to be released, got %+v", usage)
+	}
+	if _, found := pod.Annotations[AllocatedDevicesAnnotation]; found {
+		t.Errorf("expected the allocation annotations to be removed, got %v", pod.Annotations)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rocm
+
+const (
+	// DeviceName used to indicate this device
+	DeviceName = "rocm"
+
+	// ResourceCountName is the number of AMD GPUs requested by a container
+	ResourceCountName = "amd.com/gpu"
+	// ResourceMemoryName is the device memory in MiB requested by a container on every GPU
+	ResourceMemoryName = "amd.com/gpumem"
+	// ResourceMemoryPercentageName is the percentage of the device memory requested by a container on every GPU
+	ResourceMemoryPercentageName = "amd.com/gpumem-percentage"
+	// ResourceCoreName is the percentage of the compute units requested by a container on every GPU
+	ResourceCoreName = "amd.com/gpucores"
+
+	// AMDGPUDevice is the type of the devices in the allocation annotations
+	AMDGPUDevice = "AMD"
+
+	// NodeRegisterAnnotation is the node annotation of the GPUs registered by the ROCm device plugin,
+	// a JSON list of devices.DeviceInfo.
+	NodeRegisterAnnotation = "hami.io/node-amd-register"
+	// InRequestDevicesAnnotation is the pod annotation of the GPUs to allocate read by the ROCm device plugin
+	InRequestDevicesAnnotation = "hami.io/amd-devices-to-allocate"
+	// AllocatedDevicesAnnotation is the pod annotation of the GPUs allocated to the containers of the pod
+	AllocatedDevicesAnnotation = "hami.io/amd-devices-allocated"
+	// NodeLockROCm is the name of the node lock taken while allocating
+	NodeLockROCm = "hami.io/mutex.lock"
+
+	// binpack means the lower device memory remained after this allocation, the better
+	binpackPolicy = "binpack"
+	// spread means better put this task into an idle GPU card than a shared GPU card
+	spreadPolicy = "spread"
+	// 101 means wo don't assign defaultMemPercentage value
+	defaultMemPercentage = 101
+	binpackMultiplier    = 100
+	spreadMultiplier     = 100
+	// fullCores is the total of the compute units of a GPU in percentage
+	fullCores = 100
+)
+
+var (
+	ROCmEnable     bool
+	NodeLockEnable bool
+	SchedulePolicy string
+)
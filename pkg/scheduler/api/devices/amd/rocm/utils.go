@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rocm
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api/devices"
+)
+
+// selectDevices picks the GPUs of every container of the pod on a snapshot of the devices, preferring the GPUs with
+// the highest score of the policy, and returns them with the score of the node.
+func (rds *ROCmDevices) selectDevices(pod *v1.Pod, schedulePolicy string) (devices.PodSingleDevice, float64, error) {
+	snapshot := make([]*ROCmDevice, 0, len(rds.Devices))
+	for _, dev := range rds.Devices {
+		snapshot = append(snapshot, dev.deepCopy())
+	}
+	reqs := devices.ExtractResourceRequest(pod, AMDGPUDevice, ResourceCountName, ResourceMemoryName,
+		ResourceMemoryPercentageName, ResourceCoreName)
+
+	var podDevs devices.PodSingleDevice
+	score := 0.0
+	for _, req := range reqs {
+		if int(req.Nums) > len(snapshot) {
+			return nil, 0, errors.Errorf("requested %d rocm devices, node %s has %d", req.Nums, rds.NodeName, len(snapshot))
+		}
+		sort.SliceStable(snapshot, func(i, j int) bool {
+			si, sj := calScore(schedulePolicy, snapshot[i]), calScore(schedulePolicy, snapshot[j])
+			if si != sj {
+				return si > sj
+			}
+			return snapshot[i].DeviceInfo.ID < snapshot[j].DeviceInfo.ID
+		})
+		var ctrDevs devices.ContainerDevices
+		for _, dev := range snapshot {
+			if len(ctrDevs) == int(req.Nums) {
+				break
+			}
+			memreq := memoryRequest(req, dev)
+			if !fit(req, memreq, dev) {
+				klog.V(5).Infof("rocm device %s of node %s does not fit %+v", dev.DeviceInfo.ID, rds.NodeName, req)
+				continue
+			}
+			score += calScore(schedulePolicy, dev)
+			ctrDevs = append(ctrDevs, devices.ContainerDevice{
+				UUID:      dev.DeviceInfo.ID,
+				Type:      AMDGPUDevice,
+				Usedmem:   memreq,
+				Usedcores: req.Coresreq,
+			})
+		}
+		if len(ctrDevs) < int(req.Nums) {
+			return nil, 0, errors.Errorf("no enough rocm device available on node %s", rds.NodeName)
+		}
+		// the devices picked for a container are accounted for the following containers
+		for _, ctrDev := range ctrDevs {
+			for _, dev := range snapshot {
+				if dev.DeviceInfo.ID == ctrDev.UUID {
+					dev.DeviceUsage.Used++
+					dev.DeviceUsage.Usedmem += ctrDev.Usedmem
+					dev.DeviceUsage.Usedcores += ctrDev.Usedcores
+				}
+			}
+		}
+		podDevs = append(podDevs, ctrDevs)
+	}
+	return podDevs, score, nil
+}
+
+// memoryRequest returns the device memory requested on the device, the whole memory if neither the memory nor its
+// percentage is requested.
+func memoryRequest(req devices.ContainerDeviceRequest, dev *ROCmDevice) int32 {
+	if req.Memreq > 0 {
+		return req.Memreq
+	}
+	if req.MemPercentagereq != defaultMemPercentage {
+		return dev.DeviceInfo.Devmem * req.MemPercentagereq / 100
+	}
+	return 0
+}
+
+func fit(req devices.ContainerDeviceRequest, memreq int32, dev *ROCmDevice) bool {
+	info, usage := dev.DeviceInfo, dev.DeviceUsage
+	if !info.Health || info.Count <= usage.Used {
+		return false
+	}
+	if info.Devmem-usage.Usedmem < memreq {
+		return false
+	}
+	if info.Devcore-usage.Usedcores < req.Coresreq {
+		return false
+	}
+	// a container requesting all the compute units gets the device exclusively
+	if req.Coresreq == info.Devcore && usage.Used > 0 {
+		return false
+	}
+	if usage.Usedcores == info.Devcore && req.Coresreq == 0 {
+		return false
+	}
+	return true
+}
+
+func calScore(schedulePolicy string, dev *ROCmDevice) float64 {
+	if dev.DeviceInfo.Devmem == 0 {
+		return 0
+	}
+	switch schedulePolicy {
+	case binpackPolicy:
+		return binpackMultiplier * float64(dev.DeviceUsage.Usedmem) / float64(dev.DeviceInfo.Devmem)
+	case spreadPolicy:
+		if dev.DeviceUsage.Used == 0 {
+			return spreadMultiplier
+		}
+	}
+	return 0
+}
+
+// decodePodDevices decodes the devices of the containers of the allocation annotation.
+func decodePodDevices(anno string) devices.PodSingleDevice {
+	var podDevs devices.PodSingleDevice
+	if anno == "" {
+		return podDevs
+	}
+	for _, s := range strings.Split(anno, devices.OnePodMultiContainerSplitSymbol) {
+		ctrDevs, err := devices.DecodeContainerDevices(s)
+		if err != nil {
+			klog.ErrorS(err, "Failed to decode rocm devices", "annotation", anno)
+			continue
+		}
+		if len(ctrDevs) > 0 {
+			podDevs = append(podDevs, ctrDevs)
+		}
+	}
+	return podDevs
+}
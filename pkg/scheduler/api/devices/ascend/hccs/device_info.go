@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hccs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api/devices"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/nodelock"
+)
+
+// NPUDevices are the Ascend 910 NPUs of a node, connected by HCCS rings of RingSize NPUs: the NPUs with the
+// physical IDs 0 to RingSize-1 are the first ring, and so on. A pod gets the full HCCS bandwidth only if its NPUs
+// are in the same ring, or if it gets whole rings.
+type NPUDevices struct {
+	NodeName string
+	RingSize int
+	// NPUs are the physical IDs of the healthy NPUs
+	NPUs []int
+	// Used are the NPUs allocated, by physical ID, to the pods by pod UID
+	Used map[int]string
+	// Score is the score of the node computed by the last FilterNode
+	Score float64
+}
+
+// NewNPUDevices returns the Ascend 910 NPUs of the node, or nil if there are none.
+func NewNPUDevices(name string, node *v1.Node) *NPUDevices {
+	if node == nil {
+		return nil
+	}
+	num, ok := node.Status.Allocatable[ResourceName]
+	if !ok || num.IsZero() {
+		return nil
+	}
+	nds := &NPUDevices{
+		NodeName: name,
+		RingSize: defaultRingSize,
+		NPUs:     decodeNPUs(node.Annotations[NodeNPUsAnnotation]),
+		Used:     map[int]string{},
+	}
+	if size, err := strconv.Atoi(node.Annotations[RingSizeAnnotation]); err == nil && size > 0 {
+		nds.RingSize = size
+	}
+	if len(nds.NPUs) == 0 {
+		klog.V(4).Infof("Node %s has no NPU in annotation %s", name, NodeNPUsAnnotation)
+		return nil
+	}
+	return nds
+}
+
+func (nds *NPUDevices) AddResource(pod *v1.Pod) {
+	if nds == nil || pod == nil {
+		return
+	}
+	for _, id := range decodeNPUs(pod.Annotations[NodeNPUsAnnotation]) {
+		nds.Used[id] = string(pod.UID)
+	}
+}
+
+func (nds *NPUDevices) SubResource(pod *v1.Pod) {
+	if nds == nil || pod == nil {
+		return
+	}
+	for id, uid := range nds.Used {
+		if uid == string(pod.UID) {
+			delete(nds.Used, id)
+		}
+	}
+}
+
+func (nds *NPUDevices) AddQueueResource(pod *v1.Pod) map[string]float64 {
+	return map[string]float64{}
+}
+
+func (nds *NPUDevices) HasDeviceRequest(pod *v1.Pod) bool {
+	return AscendHCCSEnable && pod != nil && npuRequest(pod) > 0
+}
+
+func (nds *NPUDevices) FilterNode(pod *v1.Pod, schedulePolicy string) (int, string, error) {
+	if !AscendHCCSEnable {
+		return devices.Success, "", nil
+	}
+	_, score, err := nds.selectNPUs(npuRequest(pod))
+	if err != nil {
+		klog.V(4).Infof("Failed to filter node %s for NPU task %s/%s: %v", nds.NodeName, pod.Namespace, pod.Name, err)
+		return devices.Unschedulable, "ascend910 HCCS topology error", err
+	}
+	nds.Score = score
+	return devices.Success, "", nil
+}
+
+func (nds *NPUDevices) ScoreNode(pod *v1.Pod, schedulePolicy string) float64 {
+	// Use the score cached by FilterNode in order to avoid recalculating.
+	return nds.Score
+}
+
+func (nds *NPUDevices) Allocate(kubeClient kubernetes.Interface, pod *v1.Pod) error {
+	if !AscendHCCSEnable {
+		return nil
+	}
+	npus, _, err := nds.selectNPUs(npuRequest(pod))
+	if err != nil {
+		return errors.Errorf("failed to select NPUs for pod %s: %v", pod.Name, err)
+	}
+	if NodeLockEnable {
+		nodelock.UseClient(kubeClient)
+		if err := nodelock.LockNode(nds.NodeName, NodeLockHCCS); err != nil {
+			return errors.Errorf("node %s locked for %s. err: %s", nds.NodeName, pod.Name, err.Error())
+		}
+	}
+	annotations := map[string]string{
+		NodeNPUsAnnotation:      encodeNPUs(npus),
+		AssignedRingsAnnotation: nds.encodeRings(npus),
+		PredicateTimeAnnotation: strconv.FormatInt(time.Now().UnixNano(), 10),
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		pod.Annotations[k] = v
+	}
+	nds.AddResource(pod)
+	if err := devices.PatchPodAnnotations(kubeClient, pod, annotations); err != nil {
+		return err
+	}
+	klog.V(3).Infof("Allocate NPUs %s of rings %s to pod %s/%s", annotations[NodeNPUsAnnotation],
+		annotations[AssignedRingsAnnotation], pod.Namespace, pod.Name)
+	return nil
+}
+
+func (nds *NPUDevices) Release(kubeClient kubernetes.Interface, pod *v1.Pod) error {
+	if nds == nil || pod == nil || pod.Annotations == nil {
+		return nil
+	}
+	nds.SubResource(pod)
+	keys := []string{NodeNPUsAnnotation, AssignedRingsAnnotation, PredicateTimeAnnotation}
+	if err := devices.RemovePodAnnotations(kubeClient, pod, keys); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		delete(pod.Annotations, k)
+	}
+	return nil
+}
+
+func (nds *NPUDevices) GetIgnoredDevices() []string {
+	return []string{}
+}
+
+func (nds *NPUDevices) GetStatus() string {
+	return ""
+}
+
+// DeepCopy returns a deep copy of NPUDevices for use in dry-run simulation.
+func (nds *NPUDevices) DeepCopy() interface{} {
+	if nds == nil {
+		return nil
+	}
+	cp := &NPUDevices{
+		NodeName: nds.NodeName,
+		RingSize: nds.RingSize,
+		NPUs:     append([]int(nil), nds.NPUs...),
+		Used:     make(map[int]string, len(nds.Used)),
+		Score:    nds.Score,
+	}
+	for id, uid := range nds.Used {
+		cp.Used[id] = uid
+	}
+	return cp
+}
+
+// selectNPUs picks the NPUs of a request of n NPUs preserving the full HCCS bandwidth: a request of less than a ring
+// gets NPUs of the same ring, the ring with the fewest free NPUs fitting the request to keep the other rings whole,
+// and a request of whole rings gets free rings. It returns the NPUs with the score of the placement, higher when the
+// ring is fuller.
+func (nds *NPUDevices) selectNPUs(n int) ([]int, float64, error) {
+	if n <= 0 {
+		return nil, 0, nil
+	}
+	free := nds.freeNPUsByRing()
+	rings := make([]int, 0, len(free))
+	for ring := range free {
+		rings = append(rings, ring)
+	}
+	sort.Ints(rings)
+
+	if n%nds.RingSize == 0 {
+		var npus []int
+		for _, ring := range rings {
+			if len(free[ring]) == nds.RingSize {
+				npus = append(npus, free[ring]...)
+				if len(npus) == n {
+					return npus, ringMultiplier, nil
+				}
+			}
+		}
+		return nil, 0, fmt.Errorf("node %s has not %d free HCCS rings of %d NPUs", nds.NodeName, n/nds.RingSize, nds.RingSize)
+	}
+	if n > nds.RingSize || nds.RingSize%n != 0 {
+		return nil, 0, fmt.Errorf("%d NPUs can not get the full HCCS bandwidth with rings of %d NPUs", n, nds.RingSize)
+	}
+	best := -1
+	for _, ring := range rings {
+		if len(free[ring]) >= n && (best < 0 || len(free[ring]) < len(free[best])) {
+			best = ring
+		}
+	}
+	if best < 0 {
+		return nil, 0, fmt.Errorf("node %s has no HCCS ring with %d free NPUs", nds.NodeName, n)
+	}
+	left := len(free[best]) - n
+	return free[best][:n], ringMultiplier * float64(nds.RingSize-left) / float64(nds.RingSize), nil
+}
+
+// freeNPUsByRing returns the free healthy NPUs by ring, sorted by physical ID.
+func (nds *NPUDevices) freeNPUsByRing() map[int][]int {
+	free := map[int][]int{}
+	npus := append([]int(nil), nds.NPUs...)
+	sort.Ints(npus)
+	for _, id := range npus {
+		if _, used := nds.Used[id]; !used {
+			free[id/nds.RingSize] = append(free[id/nds.RingSize], id)
+		}
+	}
+	return free
+}
+
+func (nds *NPUDevices) encodeRings(npus []int) string {
+	var rings []string
+	for _, id := range npus {
+		ring := strconv.Itoa(id / nds.RingSize)
+		if len(rings) == 0 || rings[len(rings)-1] != ring {
+			rings = append(rings, ring)
+		}
+	}
+	return strings.Join(rings, ",")
+}
+
+// npuRequest returns the number of NPUs requested by the containers of the pod.
+func npuRequest(pod *v1.Pod) int {
+	n := int64(0)
+	for _, container := range pod.Spec.Containers {
+		if quantity, ok := container.Resources.Limits[ResourceName]; ok {
+			n += quantity.Value()
+		}
+	}
+	return int(n)
+}
+
+func decodeNPUs(anno string) []int {
+	var npus []int
+	for _, name := range strings.Split(anno, ",") {
+		id, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(name), NPUPrefix))
+		if err == nil && strings.HasPrefix(strings.TrimSpace(name), NPUPrefix) {
+			npus = append(npus, id)
+		}
+	}
+	return npus
+}
+
+func encodeNPUs(npus []int) string {
+	names := make([]string, 0, len(npus))
+	for _, id := range npus {
+		names = append(names, NPUPrefix+strconv.Itoa(id))
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hccs
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/volcano/pkg/scheduler/api/devices"
+)
+
+func newTestNPUDevices(used ...int) *NPUDevices {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1", Annotations: map[string]string{
+			NodeNPUsAnnotation: "Ascend910-0,Ascend910-1,Ascend910-2,Ascend910-3,Ascend910-4,Ascend910-5,Ascend910-6,Ascend910-7",
+		}},
+		Status: v1.NodeStatus{Allocatable: v1.ResourceList{ResourceName: resource.MustParse("8")}},
+	}
+	nds := NewNPUDevices("n1", node)
+	for _, id := range used {
+		nds.Used[id] = "used"
+	}
+	return nds
+}
+
+func newNPUPod(n string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "p", UID: types.UID("p")},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{
+			Limits: v1.ResourceList{ResourceName: resource.MustParse(n)},
+		}}}},
+	}
+}
+
+func TestSelectNPUs(t *testing.T) {
+	testCases := []struct {
+		name  string
+		used  []int
+		n     int
+		npus  []int
+		score float64
+		fail  bool
+	}{
+		{
+			name:  "one NPU on the fullest ring",
+			used:  []int{4, 5},
+			n:     1,
+			npus:  []int{6},
+			score: 75,
+		},
+		{
+			name:  "two NPUs on the same ring",
+			used:  []int{0, 4},
+			n:     2,
+			npus:  []int{1, 2},
+			score: 75,
+		},
+		{
+			name: "two NPUs are not split across the rings",
+			used: []int{0, 1, 2, 4, 5, 6},
+			n:    2,
+			fail: true,
+		},
+		{
+			name:  "a whole ring",
+			used:  []int{0},
+			n:     4,
+			npus:  []int{4, 5, 6, 7},
+			score: 100,
+		},
+		{
+			name:  "two whole rings",
+			n:     8,
+			npus:  []int{0, 1, 2, 3, 4, 5, 6, 7},
+			score: 100,
+		},
+		{
+			name: "three NPUs can not get the full bandwidth",
+			n:    3,
+			fail: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			npus, score, err := newTestNPUDevices(tc.used...).selectNPUs(tc.n)
+			if tc.fail {
+				if err == nil {
+					t.Errorf("expected no NPU set, got %v", npus)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(npus, tc.npus) || score != tc.score {
+				t.Errorf("expected NPUs %v with score %v, got %v with score %v: %v", tc.npus, tc.score, npus, score, err)
+			}
+		})
+	}
+}
+
+func TestAllocateAndRelease(t *testing.T) {
+	AscendHCCSEnable = true
+	defer func() { AscendHCCSEnable = false }()
+
+	nds := newTestNPUDevices(0)
+	pod := newNPUPod("4")
+	if !nds.HasDeviceRequest(pod) {
+		t.Fatalf("expected the pod to request NPUs")
+	}
+	if code, _, err := nds.FilterNode(pod, ""); code != devices.Success {
+		t.Fatalf("expected the pod to fit, got %v", err)
+	}
+	client := fake.NewSimpleClientset(pod)
+	if err := nds.Allocate(client, pod); err != nil {
+		t.Fatal(err)
+	}
+	patched, err := client.CoreV1().Pods("ns").Get(context.TODO(), "p", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patched.Annotations[NodeNPUsAnnotation] != "Ascend910-4,Ascend910-5,Ascend910-6,Ascend910-7" ||
+		patched.Annotations[AssignedRingsAnnotation] != "1" {
+		t.Errorf("unexpected allocation annotations %v", patched.Annotations)
+	}
+	if code, _, _ := nds.FilterNode(newNPUPod("4"), ""); code != devices.Unschedulable {
+		t.Errorf("expected no whole ring left")
+	}
+
+	if err := nds.Release(client, pod); err != nil {
+		t.Fatal(err)
+	}
+	if len(nds.Used) != 1 {
+		t.Errorf("expected the NPUs of the pod to be released, got %v", nds.Used)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hccs
+
+const (
+	// DeviceName used to indicate this device
+	DeviceName = "ascend910-hccs"
+
+	// ResourceName is the number of Ascend 910 NPUs requested by a container
+	ResourceName = "huawei.com/Ascend910"
+	// NPUPrefix is the prefix of the names of the NPUs, followed by their physical ID, e.g. "Ascend910-3"
+	NPUPrefix = "Ascend910-"
+
+	// NodeNPUsAnnotation is the node annotation of the healthy NPUs reported by the Ascend device plugin,
+	// e.g. "Ascend910-0,Ascend910-1,...", and the pod annotation of the NPUs allocated to the pod.
+	NodeNPUsAnnotation = "huawei.com/Ascend910"
+	// RingSizeAnnotation is the node annotation of the number of NPUs of an HCCS ring, 4 by default like on the
+	// Ascend 910 servers, where the NPUs 0-3 and 4-7 are two rings.
+	RingSizeAnnotation = "volcano.sh/npu-hccs-ring-size"
+	// AssignedRingsAnnotation is the topology hint of the pod, the HCCS rings of the NPUs allocated to the pod,
+	// e.g. "0" or "0,1".
+	AssignedRingsAnnotation = "volcano.sh/npu-hccs-rings"
+	// PredicateTimeAnnotation is the time when the NPUs were allocated to the pod
+	PredicateTimeAnnotation = "predicate-time"
+	// NodeLockHCCS is the name of the node lock taken while allocating
+	NodeLockHCCS = "volcano.sh/npu-hccs"
+
+	defaultRingSize = 4
+	// ringMultiplier scales the score of how full the ring of the NPUs allocated is
+	ringMultiplier = 100
+)
+
+var (
+	AscendHCCSEnable bool
+	NodeLockEnable   bool
+)
@@ -107,6 +107,7 @@ func GetDefaultDevicesConfig() *Config {
 			DeviceMemoryScaling: 1,
 			DeviceCoreScaling:   1,
 			DisableCoreLimit:    false,
+			MPSMaxClients:       48,
 		},
 		VNPUs: VNPUsConfig{
 			Configs: []VNPUConfig{
@@ -110,4 +110,6 @@ type NvidiaConfig struct {
 	MigGeometriesList []AllowedMigGeometries `yaml:"knownMigGeometries"`
 	// GPUMemoryFactor is the multiplier to every unit of device memory
 	GPUMemoryFactor uint `yaml:"gpuMemoryFactor"`
+	// MPSMaxClients is the maximum number of pods sharing one GPU in MPS mode
+	MPSMaxClients uint `yaml:"mpsMaxClients"`
 }
@@ -35,6 +35,8 @@ type GPUUsage struct {
 	UsedMem     uint
 	UsedCore    uint
 	PodGroupKey string // namespace/name of PodGroup, or "" if pod has no group
+	Queue       string // queue of the pod, or "" if unknown
+	SLOClass    string // SLO class of the pod sharing the GPU by MPS, or "" if not set
 }
 
 // GPUDevice include gpu id, memory and the pods that are sharing it.
@@ -77,6 +79,8 @@ type GPUDevices struct {
 	Device map[int]*GPUDevice
 	// Sharing sharing handler
 	Sharing SharingFactory
+	// Topology is the interconnect topology of the GPUs, nil if the node does not register it
+	Topology *GPUTopology
 }
 
 // NewGPUDevice creates a device
@@ -136,6 +140,12 @@ func NewGPUDevices(name string, node *v1.Node) *GPUDevices {
 	}
 
 	nodedevices.Sharing = sharingHandler
+	if topology, found := node.Annotations[GPUTopologyAnnotation]; found {
+		var err error
+		if nodedevices.Topology, err = decodeGPUTopology(topology); err != nil {
+			klog.Warningf("Failed to decode GPU topology of node %s: %v", node.Name, err)
+		}
+	}
 	return nodedevices
 }
 
@@ -201,6 +211,7 @@ func (gs *GPUDevices) addResource(annotations map[string]string, pod *v1.Pod) {
 					if err == nil {
 						if u := gsdevice.PodMap[string(pod.UID)]; u != nil {
 							u.PodGroupKey = getPodGroupKey(pod)
+							u.Queue, u.SLOClass = getPodQueue(pod), pod.Annotations[MPSSLOClassAnnotation]
 						}
 						gs.AddPodMetrics(index, string(pod.UID), pod.Name)
 					} else {
@@ -237,6 +248,8 @@ func (gs *GPUDevices) addToPodMap(annotations map[string]string, pod *v1.Pod) {
 					gsdevice.PodMap[podUID].UsedMem += deviceused.Usedmem
 					gsdevice.PodMap[podUID].UsedCore += deviceused.Usedcores
 					gsdevice.PodMap[podUID].PodGroupKey = getPodGroupKey(pod)
+					gsdevice.PodMap[podUID].Queue = getPodQueue(pod)
+					gsdevice.PodMap[podUID].SLOClass = pod.Annotations[MPSSLOClassAnnotation]
 				}
 			}
 		}
@@ -312,6 +325,7 @@ func (gs *GPUDevices) FilterNode(pod *v1.Pod, schedulePolicy string) (int, strin
 		fit, _, score, err := checkNodeGPUSharingPredicateAndScore(pod, gs, true, schedulePolicy)
 		if err != nil || !fit {
 			klog.ErrorS(err, "Failed to fitler node to vgpu task", "pod", pod.Name)
+			requestMIGReconfigure(gs, pod.Annotations[MIGProfileAnnotation])
 			return devices.Unschedulable, "hami-vgpuDeviceSharing error", err
 		}
 		gs.Score = score
@@ -346,6 +360,11 @@ func (gs *GPUDevices) Allocate(kubeClient kubernetes.Interface, pod *v1.Pod) err
 		annotations[AssignedTimeAnnotations] = strconv.FormatInt(time.Now().Unix(), 10)
 		annotations[AssignedIDsAnnotations] = encodePodDevices(device)
 		annotations[AssignedIDsToAllocateAnnotations] = annotations[AssignedIDsAnnotations]
+		if gs.Topology != nil {
+			if links := encodeContainerLinks(gs.Topology, device); links != "" {
+				annotations[AssignedLinksAnnotations] = links
+			}
+		}
 
 		annotations[DeviceBindPhase] = "allocating"
 		annotations[BindTimeAnnotations] = strconv.FormatInt(time.Now().Unix(), 10)
@@ -376,11 +395,12 @@ func (gs *GPUDevices) DeepCopy() interface{} {
 		return nil
 	}
 	cp := &GPUDevices{
-		Name:    gs.Name,
-		Mode:    gs.Mode,
-		Score:   gs.Score,
-		Sharing: gs.Sharing,
-		Device:  make(map[int]*GPUDevice, len(gs.Device)),
+		Name:     gs.Name,
+		Mode:     gs.Mode,
+		Score:    gs.Score,
+		Sharing:  gs.Sharing,
+		Topology: gs.Topology,
+		Device:   make(map[int]*GPUDevice, len(gs.Device)),
 	}
 	for id, dev := range gs.Device {
 		newDev := &GPUDevice{
@@ -18,6 +18,7 @@ package vgpu
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,13 +35,19 @@ func init() {
 }
 
 func (f MIGFactory) TryAddPod(gd *GPUDevice, mem uint, core uint) (bool, string) {
+	return f.TryAddPodWithProfile(gd, mem, core, "")
+}
+
+// TryAddPodWithProfile is TryAddPod picking only the mig-instances of the given profile, e.g. '1g.10gb',
+// any profile is picked if it is empty.
+func (f MIGFactory) TryAddPodWithProfile(gd *GPUDevice, mem uint, core uint, profile string) (bool, string) {
 	requestMemory := mem
 	memoryFactor := getConfig().GPUMemoryFactor
 	if memoryFactor > 1 {
 		requestMemory = requestMemory * memoryFactor
 		klog.V(5).Infof("rawRequestMemory: %d, realRequestMemory: %d, memoryFactor: %d", mem, requestMemory, memoryFactor)
 	}
-	found, dev, usedMem := findMatch(gd.UUID, requestMemory, gd.MigUsage, gd.MigTemplate)
+	found, dev, usedMem := findMatch(gd.UUID, requestMemory, gd.MigUsage, gd.MigTemplate, profile)
 	if !found {
 		return false, ""
 	}
@@ -123,11 +130,12 @@ func findMatch(
 	requestMem uint,
 	usage config.MigInUse,
 	allowedGeometries []config.Geometry,
+	profile string,
 ) (bool, string, uint) {
 	// If a group is already in use
 	if usage.Index >= 0 {
 		group := allowedGeometries[usage.Index]
-		fitted, position, realMem := pickFromGroup(group, usage.UsageList, requestMem)
+		fitted, position, realMem := pickFromGroup(group, usage.UsageList, requestMem, profile)
 		if fitted {
 			MIGID := encodeMIGID(uuid, group.Group, position)
 			return true, MIGID, realMem
@@ -138,7 +146,7 @@ func findMatch(
 
 	// No group in use yet, try groups in order
 	for _, group := range allowedGeometries {
-		fitted, position, realMem := pickFromGroup(group, nil, requestMem)
+		fitted, position, realMem := pickFromGroup(group, nil, requestMem, profile)
 		if fitted {
 			MIGID := encodeMIGID(uuid, group.Group, position)
 			return true, MIGID, realMem
@@ -168,7 +176,7 @@ For example: the group define like this:
 The position of "1g.10gb" in group2 is 3 + 1 - 1. "3" is the resource count before
 "1g.10gb", the "1" is in-resource index.
 */
-func pickFromGroup(group config.Geometry, usage config.MIGS, requestMemory uint) (bool, int, uint) {
+func pickFromGroup(group config.Geometry, usage config.MIGS, requestMemory uint, profile string) (bool, int, uint) {
 	type MigTemplateWithIndex struct {
 		Index    int
 		Instance config.MigTemplate
@@ -186,6 +194,9 @@ func pickFromGroup(group config.Geometry, usage config.MIGS, requestMemory uint)
 	})
 
 	for _, inst := range instances {
+		if profile != "" && inst.Instance.Name != profile {
+			continue
+		}
 		if len(usage) == 0 && inst.Instance.Memory >= requestMemory {
 			position := getPosition(group, inst.Instance.Count, []int{}, inst.Index)
 			klog.V(4).Infoln("pick mig group with no used group: ", inst.Index, inst.Instance.Memory, group.Group)
@@ -268,6 +279,76 @@ func decodeMIGID(id string) (group string, position int, err error) {
 	return group, position, nil
 }
 
+// migFitScore scores how well the mig-instance picked by devID fits the requested memory,
+// the less device memory of the instance is left unused by the request, the higher the score.
+func migFitScore(gd *GPUDevice, devID string, mem uint) float64 {
+	groupName, position, err := decodeMIGID(devID)
+	if err != nil {
+		return 0
+	}
+	requestMemory := mem
+	if memoryFactor := getConfig().GPUMemoryFactor; memoryFactor > 1 {
+		requestMemory = requestMemory * memoryFactor
+	}
+	for _, group := range gd.MigTemplate {
+		if group.Group != groupName {
+			continue
+		}
+		instanceIndex, _ := findPosition(group, position)
+		if instanceIndex < 0 || group.Instances[instanceIndex].Memory == 0 {
+			return 0
+		}
+		return migFitMultiplier * math.Min(1, float64(requestMemory)/float64(group.Instances[instanceIndex].Memory))
+	}
+	return 0
+}
+
+// MIGReconfigureHook is called with the GPUs of a node in mig mode which do not provide a mig-instance of the
+// requested profile by the geometry in use, but do by another allowed geometry, so that an agent on the node
+// can repartition them.
+type MIGReconfigureHook func(node, uuid, profile string)
+
+var migReconfigureHook MIGReconfigureHook
+
+// SetMIGReconfigureHook sets the hook requesting the dynamic mig reconfiguration, nil disables it.
+func SetMIGReconfigureHook(hook MIGReconfigureHook) {
+	migReconfigureHook = hook
+}
+
+// requestMIGReconfigure calls the reconfiguration hook for the GPUs of the node which can be repartitioned
+// to provide the mig-instance of the profile.
+func requestMIGReconfigure(gs *GPUDevices, profile string) {
+	if migReconfigureHook == nil || profile == "" || gs.Mode != vGPUControllerMIG {
+		return
+	}
+	for _, gd := range gs.Device {
+		// an idle GPU is partitioned by any allowed geometry already
+		if !gd.Health || gd.MigUsage.Index < 0 || gd.MigUsage.Index >= len(gd.MigTemplate) {
+			continue
+		}
+		// all instances of the profile are used, repartitioning would not add any
+		if geometryHasProfile(gd.MigTemplate[gd.MigUsage.Index], profile) {
+			continue
+		}
+		for _, group := range gd.MigTemplate {
+			if geometryHasProfile(group, profile) {
+				klog.V(3).Infof("Request mig reconfiguration of GPU %s on node %s for profile %s", gd.UUID, gs.Name, profile)
+				migReconfigureHook(gs.Name, gd.UUID, profile)
+				break
+			}
+		}
+	}
+}
+
+func geometryHasProfile(group config.Geometry, profile string) bool {
+	for _, inst := range group.Instances {
+		if inst.Name == profile {
+			return true
+		}
+	}
+	return false
+}
+
 func addMigUsed(gd *GPUDevice, groupName string, position int) uint {
 	for groupIndex, group := range gd.MigTemplate {
 		if group.Group == groupName {
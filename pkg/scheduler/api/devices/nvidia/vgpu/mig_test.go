@@ -17,6 +17,7 @@ limitations under the License.
 package vgpu
 
 import (
+	"reflect"
 	"testing"
 
 	"volcano.sh/volcano/pkg/scheduler/api/devices/config"
@@ -237,7 +238,7 @@ func TestFindMatch(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gotFit, gotUUID, gotMem := findMatch(tc.uuid, tc.requestMem, tc.usage, tc.allowedGeometries)
+			gotFit, gotUUID, gotMem := findMatch(tc.uuid, tc.requestMem, tc.usage, tc.allowedGeometries, "")
 
 			if gotFit != tc.wantFit {
 				t.Errorf("findMatch() gotFit = %v, want %v", gotFit, tc.wantFit)
@@ -782,3 +783,88 @@ func TestMIGFactory_SubPod_WithMemoryFactor(t *testing.T) {
 		})
 	}
 }
+
+func TestMIGProfile(t *testing.T) {
+	config.InitDevicesConfig("", "")
+	config.GetConfig().NvidiaConfig.GPUMemoryFactor = 1
+	geometries := []config.Geometry{
+		{
+			Group: "group1",
+			Instances: []config.MigTemplate{
+				{Name: "1g.10gb", Memory: 10240, Count: 7},
+			},
+		},
+		{
+			Group: "group2",
+			Instances: []config.MigTemplate{
+				{Name: "3g.40gb", Memory: 40960, Count: 2},
+			},
+		},
+	}
+	newDevice := func(uuid string, usage config.MigInUse) *GPUDevice {
+		return &GPUDevice{UUID: uuid, Health: true, PodMap: map[string]*GPUUsage{}, MigTemplate: geometries, MigUsage: usage}
+	}
+
+	// the smallest fitting instance is picked without profile
+	device := newDevice("gpu-1", config.MigInUse{Index: -1})
+	found, devID := MIGFactory{}.TryAddPod(device, 5120, 0)
+	if !found || devID != "gpu-1[group1-0]" {
+		t.Errorf("expected gpu-1[group1-0] to be picked, got %v %s", found, devID)
+	}
+	if score := migFitScore(device, devID, 5120); score != migFitMultiplier/2 {
+		t.Errorf("expected mig fit score %v, got %v", migFitMultiplier/2, score)
+	}
+
+	// only the instances of the profile are picked with profile
+	device = newDevice("gpu-1", config.MigInUse{Index: -1})
+	found, devID = MIGFactory{}.TryAddPodWithProfile(device, 5120, 0, "3g.40gb")
+	if !found || devID != "gpu-1[group2-0]" {
+		t.Errorf("expected gpu-1[group2-0] to be picked, got %v %s", found, devID)
+	}
+
+	// the GPU partitioned by group1 has no instance of the profile
+	used := config.MigInUse{Index: 0, UsageList: config.MIGS{{Name: "1g.10gb", Memory: 10240, InUse: true, UsedIndex: []int{0}}}}
+	if found, _ := (MIGFactory{}).TryAddPodWithProfile(newDevice("gpu-2", used), 5120, 0, "3g.40gb"); found {
+		t.Errorf("expected no instance of profile 3g.40gb on GPU partitioned by group1")
+	}
+
+	// the nodes are scored by the fit of the picked instance, and only the nodes in mig mode provide a profile
+	gs := makeGPUDevices("n1", 1, 81920, 10)
+	gs.Mode, gs.Sharing = vGPUControllerMIG, MIGFactory{}
+	gs.Device[0].MigTemplate, gs.Device[0].MigUsage = geometries, config.MigInUse{Index: -1}
+	pod := makeVGPUPod("p1", "default", "p1", 10240, false, "")
+	if fit, _, score, err := checkNodeGPUSharingPredicateAndScore(pod, gs, true, ""); !fit || score != migFitMultiplier {
+		t.Errorf("expected pod to fit with score %v, got %v %v: %v", migFitMultiplier, fit, score, err)
+	}
+	pod.Annotations[MIGProfileAnnotation] = "3g.40gb"
+	if fit, _, score, err := checkNodeGPUSharingPredicateAndScore(pod, gs, true, ""); !fit || score != migFitMultiplier/4 {
+		t.Errorf("expected pod to fit with score %v, got %v %v: %v", migFitMultiplier/4, fit, score, err)
+	}
+	if fit, _, _, _ := checkNodeGPUSharingPredicateAndScore(pod, makeGPUDevices("n2", 1, 81920, 10), true, ""); fit {
+		t.Errorf("expected pod requesting mig profile not to fit the node in hami-core mode")
+	}
+
+	var requested []string
+	SetMIGReconfigureHook(func(node, uuid, profile string) {
+		requested = append(requested, node+"/"+uuid+"="+profile)
+	})
+	defer SetMIGReconfigureHook(nil)
+	gs = &GPUDevices{
+		Name: "n1",
+		Mode: vGPUControllerMIG,
+		Device: map[int]*GPUDevice{
+			0: newDevice("gpu-1", config.MigInUse{Index: -1}),
+			1: newDevice("gpu-2", used),
+		},
+	}
+	requestMIGReconfigure(gs, "3g.40gb")
+	if expected := []string{"n1/gpu-2=3g.40gb"}; !reflect.DeepEqual(requested, expected) {
+		t.Errorf("expected reconfiguration requests %v, got %v", expected, requested)
+	}
+	// the profile provided by the geometry in use is not reconfigured for
+	requested = nil
+	requestMIGReconfigure(gs, "1g.10gb")
+	if len(requested) != 0 {
+		t.Errorf("expected no reconfiguration requests, got %v", requested)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vgpu
+
+// MPSFactory shares the GPU by the Multi-Process Service of NVIDIA. The pods are accounted like in hami-core mode,
+// but the number of pods sharing one GPU is limited by the MPS clients the GPU supports.
+type MPSFactory struct {
+	HAMICoreFactory
+}
+
+func init() {
+	RegisterFactory(vGPUControllerMPS, MPSFactory{})
+}
+
+func (f MPSFactory) TryAddPod(gd *GPUDevice, mem uint, core uint) (bool, string) {
+	if gd.UsedNum >= mpsMaxClients() {
+		return false, ""
+	}
+	return f.HAMICoreFactory.TryAddPod(gd, mem, core)
+}
+
+// mpsMaxClients returns the maximum number of pods sharing one GPU in MPS mode.
+func mpsMaxClients() uint {
+	if limit := getConfig().MPSMaxClients; limit > 0 {
+		return limit
+	}
+	return defaultMPSMaxClients
+}
+
+// mpsConflicts returns whether the GPU is shared with a pod of another queue with a different SLO class,
+// which must not be placed on the same physical GPU in MPS mode.
+func mpsConflicts(gd *GPUDevice, queue, sloClass string) bool {
+	for _, usage := range gd.PodMap {
+		if usage == nil {
+			continue
+		}
+		if usage.Queue != queue && usage.SLOClass != sloClass {
+			return true
+		}
+	}
+	return false
+}
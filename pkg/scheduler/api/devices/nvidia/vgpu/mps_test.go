@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vgpu
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+)
+
+func makeMPSPod(name, queue, sloClass string) *v1.Pod {
+	pod := makeVGPUPod(name, "default", name, 1024, false, "")
+	pod.Annotations[batch.QueueNameKey] = queue
+	if sloClass != "" {
+		pod.Annotations[MPSSLOClassAnnotation] = sloClass
+	}
+	return pod
+}
+
+func TestMPSFactory_TryAddPodHonorsClientLimit(t *testing.T) {
+	gd := newHAMITestDevice()
+	gd.UsedNum = defaultMPSMaxClients - 1
+	if fit, _ := (MPSFactory{}).TryAddPod(gd, 1000, 0); !fit {
+		t.Fatalf("expected the last MPS client to fit")
+	}
+	if fit, _ := (MPSFactory{}).TryAddPod(gd, 1000, 0); fit {
+		t.Errorf("expected no more MPS clients than %d to fit", defaultMPSMaxClients)
+	}
+	if gd.UsedNum != defaultMPSMaxClients {
+		t.Errorf("expected %d clients, got %d", defaultMPSMaxClients, gd.UsedNum)
+	}
+}
+
+func TestDecodeNodeDevicesMPSMode(t *testing.T) {
+	gs, mode := decodeNodeDevices("node-1", "GPU-0,10,16384,NVIDIA-A100,true,mps:")
+	if gs == nil || mode != vGPUControllerMPS || gs.Mode != vGPUControllerMPS {
+		t.Fatalf("expected devices in mps mode, got %v", mode)
+	}
+	if sharing, found := GetSharingHandler(mode); !found {
+		t.Errorf("expected sharing handler of mps mode")
+	} else if _, ok := sharing.(MPSFactory); !ok {
+		t.Errorf("expected MPSFactory, got %T", sharing)
+	}
+}
+
+func TestMPSSLOClassPlacement(t *testing.T) {
+	VGPUEnable = true
+	defer func() { VGPUEnable = false }()
+
+	tests := []struct {
+		name    string
+		gpus    int
+		placed  []*v1.Pod
+		pod     *v1.Pod
+		fit     bool
+		avoided []int
+	}{
+		{
+			name:   "same queue shares the GPU with any SLO class",
+			gpus:   1,
+			placed: []*v1.Pod{makeMPSPod("a", "q1", "latency-critical")},
+			pod:    makeMPSPod("b", "q1", "batch"),
+			fit:    true,
+		},
+		{
+			name:   "other queue shares the GPU with the same SLO class",
+			gpus:   1,
+			placed: []*v1.Pod{makeMPSPod("a", "q1", "latency-critical")},
+			pod:    makeMPSPod("b", "q2", "latency-critical"),
+			fit:    true,
+		},
+		{
+			name:   "other queue with conflicting SLO class does not share the GPU",
+			gpus:   1,
+			placed: []*v1.Pod{makeMPSPod("a", "q1", "latency-critical")},
+			pod:    makeMPSPod("b", "q2", "batch"),
+			fit:    false,
+		},
+		{
+			name:    "other queue with conflicting SLO class is placed on another GPU",
+			gpus:    2,
+			placed:  []*v1.Pod{makeMPSPod("a", "q1", "latency-critical")},
+			pod:     makeMPSPod("b", "q2", ""),
+			fit:     true,
+			avoided: []int{0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gs := makeGPUDevices("node-1", test.gpus, 16384, 10)
+			gs.Mode, gs.Sharing = vGPUControllerMPS, MPSFactory{}
+			// binpack prefers GPU 0 used by the placed pods
+			for _, pod := range test.placed {
+				gs.addToPodMap(map[string]string{AssignedIDsAnnotations: encodePodDevices([]ContainerDevices{{
+					{UUID: gs.Device[0].UUID, Type: NvidiaGPUDevice, Usedmem: 1024, Usedcores: 25},
+				}})}, pod)
+				gs.Device[0].UsedNum++
+				gs.Device[0].UsedMem += 1024
+			}
+
+			fit, devs, _, err := checkNodeGPUSharingPredicateAndScore(test.pod, gs, true, binpackPolicy)
+			if fit != test.fit {
+				t.Fatalf("expected fit %v, got %v: %v", test.fit, fit, err)
+			}
+			for _, index := range test.avoided {
+				if getDeviceUUID(devs) == gs.Device[index].UUID {
+					t.Errorf("expected the pod not to share GPU %d with a conflicting SLO class", index)
+				}
+			}
+		})
+	}
+}
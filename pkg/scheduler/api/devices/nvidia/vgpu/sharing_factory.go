@@ -25,6 +25,12 @@ type SharingFactory interface {
 	SubPod(gd *GPUDevice, mem uint, core uint, podUID string, devID string) error
 }
 
+// ProfileSharingFactory is implemented by the sharing modes partitioning the device by profiles, e.g. mig
+type ProfileSharingFactory interface {
+	// TryAddPodWithProfile is TryAddPod picking only the partitions of the given profile
+	TryAddPodWithProfile(gd *GPUDevice, mem uint, core uint, profile string) (bool, string)
+}
+
 var sharingRegistry = make(map[string]SharingFactory)
 
 func RegisterFactory(mode string, factory SharingFactory) {
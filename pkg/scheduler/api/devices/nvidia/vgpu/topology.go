@@ -0,0 +1,244 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vgpu
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// gpuLink is the kind of interconnect between two GPUs, the higher the faster.
+type gpuLink int
+
+const (
+	// linkSystem is the link across the PCIe host bridges or the CPU sockets
+	linkSystem gpuLink = iota + 1
+	// linkPCIe is the link through a PCIe switch
+	linkPCIe
+	// linkNVLink is the NVLink
+	linkNVLink
+)
+
+// String returns the name of the link set in the AssignedLinksAnnotations of the pod.
+func (l gpuLink) String() string {
+	switch l {
+	case linkSystem:
+		return "system"
+	case linkPCIe:
+		return "pcie"
+	case linkNVLink:
+		return "nvlink"
+	default:
+		return ""
+	}
+}
+
+// GPUTopology is the interconnect topology of the GPUs of a node registered by the device plugin in the
+// GPUTopologyAnnotation of the node, e.g. {"nvlinkIslands":[["GPU-0","GPU-1"]],"pcieSwitches":[["GPU-0","GPU-2"]]}.
+type GPUTopology struct {
+	// NVLinkIslands are the sets of GPU UUIDs connected with each other by NVLink
+	NVLinkIslands [][]string `json:"nvlinkIslands,omitempty"`
+	// PCIeSwitches are the sets of GPU UUIDs attached to the same PCIe switch
+	PCIeSwitches [][]string `json:"pcieSwitches,omitempty"`
+
+	nvlinkIsland map[string]int
+	pcieSwitch   map[string]int
+}
+
+func decodeGPUTopology(str string) (*GPUTopology, error) {
+	topology := &GPUTopology{}
+	if err := json.Unmarshal([]byte(str), topology); err != nil {
+		return nil, err
+	}
+	topology.nvlinkIsland = indexGPUSets(topology.NVLinkIslands)
+	topology.pcieSwitch = indexGPUSets(topology.PCIeSwitches)
+	return topology, nil
+}
+
+func indexGPUSets(sets [][]string) map[string]int {
+	index := map[string]int{}
+	for i, set := range sets {
+		for _, uuid := range set {
+			index[uuid] = i
+		}
+	}
+	return index
+}
+
+// link returns the link between the GPUs, the UUIDs of mig-instances are resolved to their GPUs.
+func (t *GPUTopology) link(a, b string) gpuLink {
+	a, b = gpuOfUUID(a), gpuOfUUID(b)
+	if sameGPUSet(t.nvlinkIsland, a, b) {
+		return linkNVLink
+	}
+	if sameGPUSet(t.pcieSwitch, a, b) {
+		return linkPCIe
+	}
+	return linkSystem
+}
+
+func sameGPUSet(index map[string]int, a, b string) bool {
+	ia, foundA := index[a]
+	ib, foundB := index[b]
+	return foundA && foundB && ia == ib
+}
+
+func gpuOfUUID(uuid string) string {
+	if i := strings.Index(uuid, "["); i >= 0 {
+		return uuid[:i]
+	}
+	return uuid
+}
+
+// weakestLink returns the slowest link between any two of the GPUs, or 0 if there are less than two GPUs.
+func (t *GPUTopology) weakestLink(uuids []string) gpuLink {
+	var weakest gpuLink
+	for i := range uuids {
+		for j := i + 1; j < len(uuids); j++ {
+			if l := t.link(uuids[i], uuids[j]); weakest == 0 || l < weakest {
+				weakest = l
+			}
+		}
+	}
+	return weakest
+}
+
+// preferredOrder reorders the device indices so that the set of num fitting devices with the fastest interconnect
+// comes first, the other devices follow in their order. The set is picked greedily from every fitting device, by the
+// weakest link and then the total links of the set, the sets of the devices coming first win the ties.
+func (t *GPUTopology) preferredOrder(gs *GPUDevices, order []int, num int, fits func(i int) bool) []int {
+	var candidates []int
+	for _, i := range order {
+		if fits(i) {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) <= num {
+		return order
+	}
+
+	var best []int
+	var bestWeakest gpuLink
+	bestTotal := -1
+	for _, seed := range candidates {
+		set := []int{seed}
+		for len(set) < num {
+			next, nextWeakest, nextTotal := -1, gpuLink(0), -1
+			for _, c := range candidates {
+				if containsIndex(set, c) {
+					continue
+				}
+				weakest, total := t.linksTo(gs, set, c)
+				if weakest > nextWeakest || (weakest == nextWeakest && total > nextTotal) {
+					next, nextWeakest, nextTotal = c, weakest, total
+				}
+			}
+			set = append(set, next)
+		}
+		weakest, total := t.weakestLink(deviceUUIDs(gs, set)), t.setLinks(gs, set)
+		if weakest > bestWeakest || (weakest == bestWeakest && total > bestTotal) {
+			best, bestWeakest, bestTotal = set, weakest, total
+		}
+	}
+
+	preferred := make([]int, 0, len(order))
+	for _, i := range candidates {
+		if containsIndex(best, i) {
+			preferred = append(preferred, i)
+		}
+	}
+	for _, i := range order {
+		if !containsIndex(best, i) {
+			preferred = append(preferred, i)
+		}
+	}
+	return preferred
+}
+
+// linksTo returns the slowest and the total links between the device and the set.
+func (t *GPUTopology) linksTo(gs *GPUDevices, set []int, device int) (gpuLink, int) {
+	var weakest gpuLink
+	total := 0
+	for _, i := range set {
+		l := t.link(gs.Device[i].UUID, gs.Device[device].UUID)
+		if weakest == 0 || l < weakest {
+			weakest = l
+		}
+		total += int(l)
+	}
+	return weakest, total
+}
+
+func (t *GPUTopology) setLinks(gs *GPUDevices, set []int) int {
+	total := 0
+	for i := range set {
+		for j := i + 1; j < len(set); j++ {
+			total += int(t.link(gs.Device[set[i]].UUID, gs.Device[set[j]].UUID))
+		}
+	}
+	return total
+}
+
+func deviceUUIDs(gs *GPUDevices, set []int) []string {
+	uuids := make([]string, 0, len(set))
+	for _, i := range set {
+		uuids = append(uuids, gs.Device[i].UUID)
+	}
+	return uuids
+}
+
+func containsIndex(list []int, value int) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// topologyScore scores the interconnect of the GPUs assigned to a container, the faster the higher.
+func topologyScore(t *GPUTopology, devs []ContainerDevice) float64 {
+	uuids := make([]string, 0, len(devs))
+	for _, dev := range devs {
+		uuids = append(uuids, dev.UUID)
+	}
+	weakest := t.weakestLink(uuids)
+	if weakest == 0 {
+		return 0
+	}
+	return topologyMultiplier * float64(weakest) / float64(linkNVLink)
+}
+
+// encodeContainerLinks encodes the slowest link between the GPUs assigned to every container, which is empty for the
+// containers with less than two GPUs, or returns empty if no container is assigned more than one GPU.
+func encodeContainerLinks(t *GPUTopology, pd []ContainerDevices) string {
+	links := make([]string, 0, len(pd))
+	multiGPU := false
+	for _, cd := range pd {
+		uuids := make([]string, 0, len(cd))
+		for _, dev := range cd {
+			uuids = append(uuids, dev.UUID)
+		}
+		weakest := t.weakestLink(uuids)
+		multiGPU = multiGPU || weakest != 0
+		links = append(links, weakest.String())
+	}
+	if !multiGPU {
+		return ""
+	}
+	return strings.Join(links, ";")
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vgpu
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"volcano.sh/volcano/pkg/scheduler/api/devices/config"
+)
+
+func TestGPUTopologyPlacement(t *testing.T) {
+	config.InitDevicesConfig("", "")
+	gs := makeGPUDevices("n1", 4, 16384, 10)
+	uuid := func(i int) string { return gs.Device[i].UUID }
+	topology, err := decodeGPUTopology(`{"nvlinkIslands":[["` + uuid(0) + `","` + uuid(1) + `"],["` + uuid(2) + `","` + uuid(3) + `"]],` +
+		`"pcieSwitches":[["` + uuid(0) + `","` + uuid(2) + `"]]}`)
+	if err != nil {
+		t.Fatalf("failed to decode GPU topology: %v", err)
+	}
+	if l := topology.link(uuid(0), uuid(2)+"[group1-0]"); l != linkPCIe {
+		t.Errorf("expected pcie link between GPU 0 and the mig-instance of GPU 2, got %v", l)
+	}
+	if l := topology.link(uuid(1), uuid(3)); l != linkSystem {
+		t.Errorf("expected system link between GPU 1 and GPU 3, got %v", l)
+	}
+
+	gs.Topology = topology
+	// GPU 2 is full, the NVLink island of GPU 0 and 1 is picked instead of GPU 3 and 1 coming first by the policy
+	gs.Device[2].UsedNum = gs.Device[2].Number
+	pod := makeVGPUPod("p1", "default", "p1", 1024, false, "")
+	pod.Spec.Containers[0].Resources.Limits[v1.ResourceName(config.VolcanoVGPUNumber)] = resource.MustParse("2")
+
+	fit, devs, score, err := checkNodeGPUSharingPredicateAndScore(pod, gs, true, "")
+	if !fit {
+		t.Fatalf("expected pod to fit: %v", err)
+	}
+	if len(devs) != 1 || len(devs[0]) != 2 || devs[0][0].UUID != uuid(1) || devs[0][1].UUID != uuid(0) {
+		t.Fatalf("expected GPU 1 and 0 to be assigned, got %v", devs)
+	}
+	if score != topologyMultiplier {
+		t.Errorf("expected score %v of NVLink, got %v", topologyMultiplier, score)
+	}
+
+	// without topology the GPUs are picked by the policy
+	gs.Topology = nil
+	if _, devs, _, _ := checkNodeGPUSharingPredicateAndScore(pod, gs, true, ""); devs[0][0].UUID != uuid(3) {
+		t.Errorf("expected GPU 3 to be assigned first without topology, got %v", devs)
+	}
+
+	links := encodeContainerLinks(topology, []ContainerDevices{{{UUID: uuid(0)}}, {{UUID: uuid(0)}, {UUID: uuid(2)}}})
+	if links != ";pcie" {
+		t.Errorf("expected links %q, got %q", ";pcie", links)
+	}
+	if links := encodeContainerLinks(topology, []ContainerDevices{{{UUID: uuid(0)}}}); links != "" {
+		t.Errorf("expected no links of single GPU containers, got %q", links)
+	}
+}
@@ -55,6 +55,23 @@ const (
 	vGPUControllerHAMICore        = "hami-core"
 	vGPUControllerMIG             = "mig"
 	vGPUControllerMPS             = "mps"
+
+	// MPSSLOClassAnnotation is the SLO class of the pod sharing the GPU by MPS, e.g. "latency-critical".
+	// The pods of different queues with different SLO classes are not placed on the same GPU in MPS mode.
+	MPSSLOClassAnnotation = "volcano.sh/vgpu-slo-class"
+	// MIGProfileAnnotation is the mig-instance profile requested by the pod in mig mode, e.g. "1g.10gb".
+	MIGProfileAnnotation = "volcano.sh/vgpu-mig-profile"
+	// migFitMultiplier scales the score of the mig-instance fit of the request
+	migFitMultiplier = 100
+	// GPUTopologyAnnotation is the node annotation of the interconnect topology of the GPUs registered by the device plugin
+	GPUTopologyAnnotation = "volcano.sh/gpu-topology"
+	// AssignedLinksAnnotations is the slowest link between the GPUs assigned to every container of the pod, i.e.
+	// "nvlink", "pcie" or "system", separated by ";" like the AssignedIDsAnnotations.
+	AssignedLinksAnnotations = "volcano.sh/vgpu-links"
+	// topologyMultiplier scales the score of the interconnect of the GPUs assigned to a container
+	topologyMultiplier = 100
+	// defaultMPSMaxClients is the maximum number of MPS clients of one GPU since Volta
+	defaultMPSMaxClients = 48
 )
 
 var (
@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api/devices"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/config"
@@ -189,6 +190,17 @@ func getPodGroupKey(pod *v1.Pod) string {
 	return pod.Namespace + "/" + groupName
 }
 
+// getPodQueue returns the queue of the pod set by its annotations, "" if unknown.
+func getPodQueue(pod *v1.Pod) string {
+	if pod == nil || pod.Annotations == nil {
+		return ""
+	}
+	if queue := pod.Annotations[batch.QueueNameKey]; queue != "" {
+		return queue
+	}
+	return pod.Annotations[v1beta1.QueueNameAnnotationKey]
+}
+
 // deviceHasPodFromSameGroup returns true if the device already has a pod from the same
 // PodGroup as currentKey (and that pod has non-zero usage), so we should not place
 // another pod from the same group on this device.
@@ -278,10 +290,11 @@ func checkType(annos map[string]string, d GPUDevice, n devices.ContainerDeviceRe
 // getGPUDeviceSnapShot is not a strict deep copy, the pointer item is same with origin.
 func getGPUDeviceSnapShot(snap *GPUDevices) *GPUDevices {
 	ret := GPUDevices{
-		Name:    snap.Name,
-		Device:  make(map[int]*GPUDevice),
-		Score:   float64(0),
-		Sharing: snap.Sharing,
+		Name:     snap.Name,
+		Device:   make(map[int]*GPUDevice),
+		Score:    float64(0),
+		Sharing:  snap.Sharing,
+		Topology: snap.Topology,
 	}
 	for index, val := range snap.Device {
 		if val != nil {
@@ -338,6 +351,8 @@ func getSharingMode(mode string) string {
 	switch mode {
 	case vGPUControllerMIG:
 		return vGPUControllerMIG
+	case vGPUControllerMPS:
+		return vGPUControllerMPS
 	default:
 		return vGPUControllerHAMICore
 	}
@@ -357,6 +372,11 @@ func checkNodeGPUSharingPredicateAndScore(pod *v1.Pod, gssnap *GPUDevices, repli
 	if ok && podSharingMode != gssnap.Mode {
 		return false, []ContainerDevices{}, 0, fmt.Errorf("pod required sharing mode %s is not the same as the node mode %s", podSharingMode, gssnap.Mode)
 	}
+	// the mig profile can only be requested from the devices in mig mode
+	migProfile := pod.Annotations[MIGProfileAnnotation]
+	if migProfile != "" && gssnap.Mode != vGPUControllerMIG {
+		return false, []ContainerDevices{}, 0, fmt.Errorf("pod required mig profile %s but the node mode is %s", migProfile, gssnap.Mode)
+	}
 
 	ctrReq := resourcereqs(pod)
 	if len(ctrReq) == 0 {
@@ -373,6 +393,8 @@ func checkNodeGPUSharingPredicateAndScore(pod *v1.Pod, gssnap *GPUDevices, repli
 	if pod.Annotations[VGPUPodGroupPolicyAnnotation] == VGPUPodGroupPolicySpreadValue {
 		currentPodGroupKey = getPodGroupKey(pod)
 	}
+	mps := gssnap.Mode == vGPUControllerMPS
+	queue, sloClass := getPodQueue(pod), pod.Annotations[MPSSLOClassAnnotation]
 
 	type tentativeAlloc struct {
 		device *GPUDevice
@@ -410,14 +432,17 @@ func checkNodeGPUSharingPredicateAndScore(pod *v1.Pod, gssnap *GPUDevices, repli
 		}
 		klog.V(3).InfoS("Allocating device for container", "request", val)
 
-		for _, i := range sortedDeviceIndicesByPolicy(gs, schedulePolicy) {
-			klog.V(3).InfoS("Scoring pod request", "memReq", val.Memreq, "memPercentageReq", val.MemPercentagereq, "coresReq", val.Coresreq, "Nums", val.Nums, "Index", i, "ID", gs.Device[i].ID)
-			klog.V(3).InfoS("Current Device", "Index", i, "TotalMemory", gs.Device[i].Memory, "UsedMemory", gs.Device[i].UsedMem, "UsedCores", gs.Device[i].UsedCore, "UsedNum", gs.Device[i].UsedNum, "Number", gs.Device[i].Number, "replicate", replicate)
+		// deviceFits returns the memory requested from the device if the container request fits it
+		deviceFits := func(i int) (uint, bool) {
 			if gs.Device[i].Number <= uint(gs.Device[i].UsedNum) {
-				continue
+				return 0, false
 			}
 			if currentPodGroupKey != "" && deviceHasPodFromSameGroup(gs.Device[i], currentPodGroupKey) {
-				continue
+				return 0, false
+			}
+			if mps && mpsConflicts(gs.Device[i], queue, sloClass) {
+				klog.V(3).InfoS("SLO class conflicts with the MPS clients of the device", "pod", pod.Name, "sloClass", sloClass, "ID", gs.Device[i].ID)
+				return 0, false
 			}
 			memreqForCard := uint(0)
 			// if we have mempercentage request, we ignore the mem request for every cards
@@ -427,24 +452,48 @@ func checkNodeGPUSharingPredicateAndScore(pod *v1.Pod, gssnap *GPUDevices, repli
 				memreqForCard = uint(val.Memreq)
 			}
 			if int(gs.Device[i].Memory)-int(gs.Device[i].UsedMem) < int(memreqForCard) {
-				continue
+				return 0, false
 			}
 			if gs.Device[i].UsedCore+uint(val.Coresreq) > 100 {
-				continue
+				return 0, false
 			}
 			// Coresreq=100 indicates it want this card exclusively
 			if val.Coresreq == 100 && gs.Device[i].UsedNum > 0 {
-				continue
+				return 0, false
 			}
 			// You can't allocate core=0 job to an already full GPU
 			if gs.Device[i].UsedCore == 100 && val.Coresreq == 0 {
-				continue
+				return 0, false
 			}
 			if !checkType(pod.Annotations, *gs.Device[i], val) {
 				klog.Errorln("failed checktype", gs.Device[i].Type, val.Type)
+				return 0, false
+			}
+			return memreqForCard, true
+		}
+		order := sortedDeviceIndicesByPolicy(gs, schedulePolicy)
+		// the GPUs of a multi-GPU container are picked by their interconnect first
+		if val.Nums > 1 && gs.Topology != nil {
+			order = gs.Topology.preferredOrder(gs, order, int(val.Nums), func(i int) bool {
+				_, fits := deviceFits(i)
+				return fits
+			})
+		}
+
+		for _, i := range order {
+			klog.V(3).InfoS("Scoring pod request", "memReq", val.Memreq, "memPercentageReq", val.MemPercentagereq, "coresReq", val.Coresreq, "Nums", val.Nums, "Index", i, "ID", gs.Device[i].ID)
+			klog.V(3).InfoS("Current Device", "Index", i, "TotalMemory", gs.Device[i].Memory, "UsedMemory", gs.Device[i].UsedMem, "UsedCores", gs.Device[i].UsedCore, "UsedNum", gs.Device[i].UsedNum, "Number", gs.Device[i].Number, "replicate", replicate)
+			memreqForCard, fits := deviceFits(i)
+			if !fits {
 				continue
 			}
-			fit, uuid := gs.Sharing.TryAddPod(gs.Device[i], memreqForCard, uint(val.Coresreq))
+			var fit bool
+			var uuid string
+			if profileSharing, ok := gs.Sharing.(ProfileSharingFactory); ok && migProfile != "" {
+				fit, uuid = profileSharing.TryAddPodWithProfile(gs.Device[i], memreqForCard, uint(val.Coresreq), migProfile)
+			} else {
+				fit, uuid = gs.Sharing.TryAddPod(gs.Device[i], memreqForCard, uint(val.Coresreq))
+			}
 			if !fit {
 				klog.V(3).Info(gs.Device[i].ID, "not fit")
 				continue
@@ -466,6 +515,9 @@ func checkNodeGPUSharingPredicateAndScore(pod *v1.Pod, gssnap *GPUDevices, repli
 					Usedcores: uint(val.Coresreq),
 				})
 				score += GPUScore(schedulePolicy, gs.Device[i])
+				if gssnap.Mode == vGPUControllerMIG {
+					score += migFitScore(gs.Device[i], uuid, memreqForCard)
+				}
 			}
 			if val.Nums == 0 {
 				break
@@ -475,6 +527,9 @@ func checkNodeGPUSharingPredicateAndScore(pod *v1.Pod, gssnap *GPUDevices, repli
 			rollbackTentative(tentativeAllocs)
 			return false, []ContainerDevices{}, 0, fmt.Errorf("not enough gpu fitted on this node")
 		}
+		if gs.Topology != nil {
+			score += topologyScore(gs.Topology, devs)
+		}
 		ctrdevs = append(ctrdevs, devs)
 	}
 	return true, ctrdevs, score, nil
@@ -145,6 +145,18 @@ func HasOnlyVolcanoSchedulingGate(pod *v1.Pod) bool {
 		pod.Spec.SchedulingGates[0].Name == schedulingv1beta1.QueueAllocationGateKey
 }
 
+// HasOnlyGangAdmissionGate checks if a Pod has only the Volcano gang admission gate
+func HasOnlyGangAdmissionGate(pod *v1.Pod) bool {
+	return len(pod.Spec.SchedulingGates) == 1 &&
+		pod.Spec.SchedulingGates[0].Name == schedulingv1beta1.GangAdmissionGateKey
+}
+
+// HasGangAdmissionGateAnnotation checks if a Pod has the gang admission gate annotation
+func HasGangAdmissionGateAnnotation(pod *v1.Pod) bool {
+	return pod.Annotations != nil &&
+		pod.Annotations[schedulingv1beta1.GangAdmissionGateKey] == "true"
+}
+
 // HasQueueAllocationGateAnnotation checks if a Pod has the queue allocation gate annotation
 func HasQueueAllocationGateAnnotation(pod *v1.Pod) bool {
 	return pod.Annotations != nil &&
@@ -96,6 +96,48 @@ func (ctx *TransactionContext) Clone() *TransactionContext {
 	return &clone
 }
 
+// VictimContext is the context of the eviction of a task by reclaim or preempt,
+// it is recorded on the evicted pod and its podgroup before the pod is deleted.
+type VictimContext struct {
+	// AggressorJob is the job which the resources of the task are freed for, empty if unknown
+	AggressorJob    JobID
+	AggressorJobUID types.UID
+	AggressorQueue  QueueID
+	// SessionID is the ID of the scheduling session evicting the task
+	SessionID string
+	// Checkpoint is the policy of the checkpoint of the task before it is evicted, nil if it is evicted at once
+	Checkpoint *CheckpointPolicy
+	// Migration is the migration of the task to another node, nil if it is only evicted
+	Migration *Migration
+}
+
+// Migration is the move of a running task to another node by checkpoint and restore.
+type Migration struct {
+	// NodeName is the node the task is restored on
+	NodeName string
+	// Timeout is the time the checkpoint of the task is waited for, the task is only evicted after it
+	Timeout time.Duration
+}
+
+// CheckpointPolicy is how a victim is given the chance to checkpoint before it is evicted.
+type CheckpointPolicy struct {
+	// Timeout is the time the victim is given to checkpoint
+	Timeout time.Duration
+	// Webhook is the URL called to checkpoint the victim, the victim acknowledges by annotating its pod if empty
+	Webhook string
+}
+
+// VictimGuard ties the capacity freed on a node by evicting a victim to the job it was evicted for, so that the other
+// jobs, e.g. the recreated pods of the victim, do not take it before the job binds a task to the node.
+type VictimGuard struct {
+	// Beneficiary is the job the victim was evicted for
+	Beneficiary JobID
+	// Resreq is the resources freed by the victim
+	Resreq *Resource
+	// Expiry is the time the guard expires
+	Expiry metav1.Time
+}
+
 type TopologyInfo struct {
 	Policy string
 	ResMap map[int]v1.ResourceList // key: numa ID
@@ -127,6 +169,9 @@ type TaskInfo struct {
 	Resreq *Resource
 	// InitResreq is the resource that used to launch a task.
 	InitResreq *Resource
+	// ResizeReq is the resource added by the pending in-place resize of the running task, already accounted in
+	// Resreq, nil if the task has no resize pending.
+	ResizeReq *Resource
 	// DRAResreq aggregates DRA resource requests per DeviceClass
 	DRAResreq map[string]*DRAResource
 	// ResourceClaimKeys lists namespaced ResourceClaims referenced by this task.
@@ -144,6 +189,8 @@ type TaskInfo struct {
 	BestEffort                  bool
 	HasRestartableInitContainer bool
 	SchGated                    bool
+	// GateRemovedTime is the time the scheduling gates of the pod were removed, zero if the pod was never seen gated.
+	GateRemovedTime metav1.Time
 
 	// RevocableZone supports setting volcano.sh/revocable-zone annotation or label for pod/podgroup
 	// we only support empty value or * value for this version and we will support specify revocable zone name for future releases
@@ -151,6 +198,13 @@ type TaskInfo struct {
 	// * value means workload can use all the revocable node for during node active revocable time.
 	RevocableZone string
 
+	// PredicateOverrides supports overriding the node search of the actions by annotations for pod/job,
+	// nil if the task uses the arguments of the actions.
+	PredicateOverrides *PredicateOverrides
+
+	// VictimContext is set when the task is evicted for another job.
+	VictimContext *VictimContext
+
 	NumaInfo *TopologyInfo
 	Pod      *v1.Pod
 
@@ -194,6 +248,7 @@ func NewTaskInfo(pod *v1.Pod) *TaskInfo {
 	bestEffort := initResReq.IsEmpty()
 	preemptable := GetPodPreemptable(pod)
 	revocableZone := GetPodRevocableZone(pod)
+	predicateOverrides := GetPodPredicateOverrides(pod)
 	topologyInfo := GetPodTopologyInfo(pod)
 	role := getTaskRole(pod)
 	hasRestartableInitContainer := hasRestartableInitContainer(pod)
@@ -211,10 +266,12 @@ func NewTaskInfo(pod *v1.Pod) *TaskInfo {
 		Pod:                         pod,
 		Resreq:                      resReq,
 		InitResreq:                  initResReq,
+		ResizeReq:                   GetPodResizeRequest(pod),
 		Preemptable:                 preemptable,
 		BestEffort:                  bestEffort,
 		HasRestartableInitContainer: hasRestartableInitContainer,
 		RevocableZone:               revocableZone,
+		PredicateOverrides:          predicateOverrides,
 		NumaInfo:                    topologyInfo,
 		SchGated:                    schGated,
 		TransactionContext: TransactionContext{
@@ -302,8 +359,11 @@ func (ti *TaskInfo) Clone() *TaskInfo {
 		BestEffort:                  ti.BestEffort,
 		HasRestartableInitContainer: ti.HasRestartableInitContainer,
 		RevocableZone:               ti.RevocableZone,
+		PredicateOverrides:          ti.PredicateOverrides,
+		VictimContext:               ti.VictimContext,
 		NumaInfo:                    ti.NumaInfo.Clone(),
 		SchGated:                    ti.SchGated,
+		GateRemovedTime:             ti.GateRemovedTime,
 		TransactionContext: TransactionContext{
 			NodeName: ti.NodeName,
 			Status:   ti.Status,
@@ -311,6 +371,9 @@ func (ti *TaskInfo) Clone() *TaskInfo {
 		LastTransaction: ti.LastTransaction.Clone(),
 	}
 
+	if ti.ResizeReq != nil {
+		res.ResizeReq = ti.ResizeReq.Clone()
+	}
 	if ti.DRAResreq != nil {
 		res.DRAResreq = make(map[string]*DRAResource, len(ti.DRAResreq))
 		for k, v := range ti.DRAResreq {
@@ -605,17 +668,68 @@ func (ji *JobInfo) GetMinResources() *Resource {
 	return NewResource(*ji.PodGroup.Spec.MinResources)
 }
 
+// RuntimeEstimate returns the estimated runtime of the job set by the volcano.sh/runtime-estimate annotation of its
+// podgroup, false if it is not set or invalid.
+func (ji *JobInfo) RuntimeEstimate() (time.Duration, bool) {
+	if ji.PodGroup == nil {
+		return 0, false
+	}
+	value, found := ji.PodGroup.Annotations[v1beta1.RuntimeEstimateKey]
+	if !found {
+		return 0, false
+	}
+	estimate, err := time.ParseDuration(value)
+	if err != nil || estimate <= 0 {
+		klog.V(4).Infof("Invalid runtime estimate <%s> of job <%s/%s>", value, ji.Namespace, ji.Name)
+		return 0, false
+	}
+	return estimate, true
+}
+
+// RunningSince returns the earliest start time of the running tasks of the job, false if none is running.
+func (ji *JobInfo) RunningSince() (time.Time, bool) {
+	var since time.Time
+	for _, task := range ji.TaskStatusIndex[Running] {
+		if task.Pod == nil || task.Pod.Status.StartTime == nil {
+			continue
+		}
+		if start := task.Pod.Status.StartTime.Time; since.IsZero() || start.Before(since) {
+			since = start
+		}
+	}
+	return since, !since.IsZero()
+}
+
+// Deadline returns the time the job must complete by set by the volcano.sh/deadline annotation of its podgroup,
+// false if it is not set or invalid.
+func (ji *JobInfo) Deadline() (time.Time, bool) {
+	if ji.PodGroup == nil {
+		return time.Time{}, false
+	}
+	value, found := ji.PodGroup.Annotations[v1beta1.DeadlineKey]
+	if !found {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		klog.V(4).Infof("Invalid deadline <%s> of job <%s/%s>", value, ji.Namespace, ji.Name)
+		return time.Time{}, false
+	}
+	return deadline, true
+}
+
 // Get the total resources of tasks whose pod is scheduling gated
 // By definition, if a pod is scheduling gated, it's status is Pending
-// Note: Tasks that are only Volcano scheduling gated (scheduling.volcano.sh/queue-allocation-gate)
-// are excluded from this calculation, as they should be counted in inqueue resources.
+// Note: Tasks that are only Volcano scheduling gated (scheduling.volcano.sh/queue-allocation-gate or
+// scheduling.volcano.sh/gang-admission-gate) are excluded from this calculation, as they should be
+// counted in inqueue resources.
 func (ji *JobInfo) GetSchGatedPodResources() *Resource {
 	res := EmptyResource()
 	for _, task := range ji.Tasks {
 		if task.SchGated {
 			// Exclude tasks that are only Volcano scheduling gated
 			// These should be counted in inqueue resources, not deducted
-			if HasOnlyVolcanoSchedulingGate(task.Pod) {
+			if HasOnlyVolcanoSchedulingGate(task.Pod) || HasOnlyGangAdmissionGate(task.Pod) {
 				continue
 			}
 			res.Add(task.Resreq)
@@ -1080,6 +1194,22 @@ func (ji *JobInfo) CheckTaskReady() bool {
 	return true
 }
 
+// UnreadyTaskRoles returns the tasks of job whose occupied members are fewer than their
+// minimum in minTaskMember, with the number of occupied members of each.
+func (ji *JobInfo) UnreadyTaskRoles() map[string]int32 {
+	if ji.MinAvailable < ji.TaskMinAvailableTotal {
+		return nil
+	}
+	occupiedMap := ji.getJobAllocatedRoles()
+	unready := map[string]int32{}
+	for taskSpec, minNum := range ji.TaskMinAvailable {
+		if occupiedMap[taskSpec] < minNum {
+			unready[taskSpec] = occupiedMap[taskSpec]
+		}
+	}
+	return unready
+}
+
 // CheckTaskPipelined return whether each task of job is pipelined.
 func (ji *JobInfo) CheckTaskPipelined() bool {
 	if ji.MinAvailable < ji.TaskMinAvailableTotal {
@@ -1370,6 +1500,44 @@ func (ji *JobInfo) ContainsNetworkTopology() bool {
 	return ji.WithNetworkTopology() || ji.ContainsNetworkTopologyInSubJob()
 }
 
+// GangGroup returns the gang group of the job given by the volcano.sh/gang-group label of its podgroup,
+// prefixed with its namespace, or "" when the job is in no gang group.
+func (ji *JobInfo) GangGroup() string {
+	if ji.PodGroup == nil {
+		return ""
+	}
+	group := ji.PodGroup.Labels[v1beta1.GangGroupKey]
+	if group == "" {
+		return ""
+	}
+	return ji.Namespace + "/" + group
+}
+
+// ElasticMinReplicas returns the minimum number of replicas of an elastic job given by the
+// volcano.sh/elastic-min-replicas and volcano.sh/elastic-max-replicas annotations of its podgroup,
+// and false when the job is not elastic or the annotations are invalid.
+func (ji *JobInfo) ElasticMinReplicas() (int32, bool) {
+	if ji.PodGroup == nil {
+		return 0, false
+	}
+	minValue, minFound := ji.PodGroup.Annotations[v1beta1.ElasticMinReplicasKey]
+	maxValue, maxFound := ji.PodGroup.Annotations[v1beta1.ElasticMaxReplicasKey]
+	if !minFound || !maxFound {
+		return 0, false
+	}
+	minReplicas, err := strconv.ParseInt(minValue, 10, 32)
+	if err != nil || minReplicas < 1 {
+		klog.Warningf("Invalid %s <%s> of job <%s/%s>", v1beta1.ElasticMinReplicasKey, minValue, ji.Namespace, ji.Name)
+		return 0, false
+	}
+	maxReplicas, err := strconv.ParseInt(maxValue, 10, 32)
+	if err != nil || maxReplicas < minReplicas {
+		klog.Warningf("Invalid %s <%s> of job <%s/%s>", v1beta1.ElasticMaxReplicasKey, maxValue, ji.Namespace, ji.Name)
+		return 0, false
+	}
+	return int32(minReplicas), true
+}
+
 // DRAResource represents aggregated DRA resource request for a single DeviceClass
 type DRAResource struct {
 	// Count is the total number of devices requested
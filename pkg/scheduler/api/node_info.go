@@ -24,12 +24,15 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	fwk "k8s.io/kube-scheduler/framework"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api/devices/amd/rocm"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/ascend/hami"
+	"volcano.sh/volcano/pkg/scheduler/api/devices/ascend/hccs"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/ascend/mindcluster/ascend310p/vnpu"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/nvidia/gpushare"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/nvidia/vgpu"
@@ -76,6 +79,12 @@ type NodeInfo struct {
 	NumaSchedulerInfo  *NumatopoInfo
 	RevocableZone      string
 
+	// Interruptible true means the node is a spot or preemptible instance, which the provider can reclaim at any time
+	Interruptible bool
+	// TerminationNotice true means the termination of the node has been announced, e.g. the interruption of a spot
+	// instance
+	TerminationNotice bool
+
 	// Used to store custom information
 	Others map[string]interface{}
 	//SharedDevices map[string]SharedDevicePool
@@ -85,6 +94,17 @@ type NodeInfo struct {
 	// OfflineJobEvicting true means node resource usage too high then dispatched pod can not use oversubscription resource
 	OfflineJobEvicting bool
 
+	// GPUResetPending true means the GPU tasks of another queue were evicted from the node, and the GPU tasks can not
+	// be placed on it until the volcano agent of the node confirms the GPU cleanup or the request times out
+	GPUResetPending bool
+
+	// VictimGuards hold the capacity freed on the node by evicting victims for the jobs they were evicted for
+	VictimGuards []*VictimGuard
+
+	// LastBound is the last time a task was bound to the node by the scheduler, used by the least-recently-bound
+	// node tie-breaker
+	LastBound metav1.Time
+
 	// Resource Oversubscription feature: the Oversubscription Resource reported in annotation
 	OversubscriptionResource *Resource
 
@@ -116,6 +136,52 @@ func (ni *NodeInfo) FutureIdle() *Resource {
 	return ni.Idle.Clone().Add(ni.Releasing).SubWithoutAssert(ni.Pipelined)
 }
 
+// ReleasingDRADevices returns the number of DRA devices by device class which will be deallocated in the future:
+//
+// That is the devices of the ResourceClaims of the releasing tasks on the node which are not shared with the other tasks
+// on the node.
+func (ni *NodeInfo) ReleasingDRADevices() map[string]int64 {
+	var devices map[string]int64
+	add := func(resources map[string]*DRAResource) {
+		if devices == nil {
+			devices = map[string]int64{}
+		}
+		for deviceClass, r := range resources {
+			devices[deviceClass] += r.Count
+		}
+	}
+
+	// the claims still reserved by the tasks which are not releasing are not deallocated
+	inUse := map[string]bool{}
+	for _, task := range ni.Tasks {
+		if task.Status == Releasing {
+			continue
+		}
+		for claimKey := range task.ResourceClaimDRAResreq {
+			inUse[claimKey] = true
+		}
+	}
+
+	released := map[string]bool{}
+	for _, task := range ni.Tasks {
+		if task.Status != Releasing {
+			continue
+		}
+		if len(task.ResourceClaimDRAResreq) == 0 {
+			add(task.DRAResreq)
+			continue
+		}
+		for claimKey, resources := range task.ResourceClaimDRAResreq {
+			if inUse[claimKey] || released[claimKey] {
+				continue
+			}
+			released[claimKey] = true
+			add(resources)
+		}
+	}
+	return devices
+}
+
 // GetNodeAllocatable return node Allocatable without OversubscriptionResource resource
 func (ni *NodeInfo) GetNodeAllocatable() *Resource {
 	return NewResource(ni.Node.Status.Allocatable)
@@ -132,12 +198,14 @@ type NodeUsage struct {
 	MetricsTime time.Time
 	CPUUsageAvg map[string]float64
 	MEMUsageAvg map[string]float64
+	GPUUsageAvg map[string]float64
 }
 
 func (nu *NodeUsage) DeepCopy() *NodeUsage {
 	newUsage := &NodeUsage{
 		CPUUsageAvg: make(map[string]float64),
 		MEMUsageAvg: make(map[string]float64),
+		GPUUsageAvg: make(map[string]float64),
 	}
 	newUsage.MetricsTime = nu.MetricsTime
 	for k, v := range nu.CPUUsageAvg {
@@ -146,6 +214,9 @@ func (nu *NodeUsage) DeepCopy() *NodeUsage {
 	for k, v := range nu.MEMUsageAvg {
 		newUsage.MEMUsageAvg[k] = v
 	}
+	for k, v := range nu.GPUUsageAvg {
+		newUsage.GPUUsageAvg[k] = v
+	}
 	return newUsage
 }
 
@@ -180,6 +251,7 @@ func NewNodeInfo(node *v1.Node) *NodeInfo {
 	nodeInfo.setNodeOthersResource(node)
 	nodeInfo.setNodeState(node)
 	nodeInfo.setRevocableZone(node)
+	nodeInfo.setInterruptible(node)
 
 	return nodeInfo
 }
@@ -250,6 +322,9 @@ func (ni *NodeInfo) Clone() *NodeInfo {
 	res.Others = ni.CloneOthers()
 	res.ImageStates = ni.CloneImageSummary()
 	res.BindGeneration = ni.BindGeneration
+	res.GPUResetPending = ni.GPUResetPending
+	res.VictimGuards = ni.VictimGuards
+	res.LastBound = ni.LastBound
 	return res
 }
 
@@ -273,6 +348,34 @@ func (ni *NodeInfo) setRevocableZone(node *v1.Node) {
 	ni.RevocableZone = revocableZone
 }
 
+// setInterruptible sets whether the node is interruptible, and whether its interruption is announced, from its
+// labels and taints
+func (ni *NodeInfo) setInterruptible(node *v1.Node) {
+	ni.Interruptible, ni.TerminationNotice = false, false
+	if node == nil {
+		return
+	}
+	for key, value := range interruptibleNodeLabels {
+		if node.Labels[key] == value {
+			ni.Interruptible = true
+			break
+		}
+	}
+	if node.Labels[TerminationNoticeKey] == "true" {
+		ni.TerminationNotice = true
+	}
+	for _, taint := range node.Spec.Taints {
+		for _, key := range terminationNoticeTaints {
+			if taint.Key == key {
+				ni.TerminationNotice = true
+			}
+		}
+		if value, found := interruptibleNodeLabels[taint.Key]; found && (taint.Value == value || taint.Value == "") {
+			ni.Interruptible = true
+		}
+	}
+}
+
 // Check node if enable Oversubscription and set Oversubscription resources
 // Only support oversubscription cpu and memory resource for this version
 func (ni *NodeInfo) setOversubscription(node *v1.Node) {
@@ -387,10 +490,27 @@ func (ni *NodeInfo) setNodeOthersResource(node *v1.Node) {
 			delete(ni.Others, vgpu.DeviceName)
 		}
 	}
+	if rocm.ROCmEnable {
+		rocmDevices := rocm.NewROCmDevices(ni.Name, node)
+		if rocmDevices != nil {
+			ni.Others[rocm.DeviceName] = rocmDevices
+			ignored_list = append(ignored_list, rocmDevices.GetIgnoredDevices()...)
+		} else {
+			delete(ni.Others, rocm.DeviceName)
+		}
+	}
 	if vnpu.AscendMindClusterVNPUEnable {
 		ni.Others[vnpu.DeviceName] = vnpu.NewNPUDevices(ni.Name, node)
 		ignored_list = append(ignored_list, vnpu.NewNPUDevices(ni.Name, node).GetIgnoredDevices()...)
 	}
+	if hccs.AscendHCCSEnable {
+		npuDevices := hccs.NewNPUDevices(ni.Name, node)
+		if npuDevices != nil {
+			ni.Others[hccs.DeviceName] = npuDevices
+		} else {
+			delete(ni.Others, hccs.DeviceName)
+		}
+	}
 	if hami.AscendHAMiVNPUEnable {
 		for deviceName, devices := range hami.NewAscendDevices(ni.Name, node) {
 			ni.Others[deviceName] = devices
@@ -411,6 +531,7 @@ func (ni *NodeInfo) setNode(node *v1.Node) {
 
 	ni.setOversubscription(node)
 	ni.setRevocableZone(node)
+	ni.setInterruptible(node)
 	ni.setNodeOthersResource(node)
 
 	ni.Allocatable = NewResource(node.Status.Allocatable).Add(ni.OversubscriptionResource)
@@ -548,6 +669,13 @@ func (ni *NodeInfo) addResource(pod *v1.Pod) {
 			}
 		}
 	}
+	if rocm.ROCmEnable {
+		if other, exists := ni.Others[rocm.DeviceName]; exists {
+			if devices, ok := other.(Devices); ok {
+				devices.AddResource(pod)
+			}
+		}
+	}
 	if vnpu.AscendMindClusterVNPUEnable {
 		if other, exists := ni.Others[vnpu.DeviceName]; exists {
 			if devices, ok := other.(Devices); ok {
@@ -555,6 +683,13 @@ func (ni *NodeInfo) addResource(pod *v1.Pod) {
 			}
 		}
 	}
+	if hccs.AscendHCCSEnable {
+		if other, exists := ni.Others[hccs.DeviceName]; exists {
+			if devices, ok := other.(Devices); ok {
+				devices.AddResource(pod)
+			}
+		}
+	}
 	if hami.AscendHAMiVNPUEnable {
 		for _, name := range hami.GetAscendDeviceNames() {
 			if other, exists := ni.Others[name]; exists {
@@ -582,6 +717,13 @@ func (ni *NodeInfo) subResource(pod *v1.Pod) {
 			}
 		}
 	}
+	if rocm.ROCmEnable {
+		if other, exists := ni.Others[rocm.DeviceName]; exists {
+			if devices, ok := other.(Devices); ok {
+				devices.SubResource(pod)
+			}
+		}
+	}
 	if vnpu.AscendMindClusterVNPUEnable {
 		if other, exists := ni.Others[vnpu.DeviceName]; exists {
 			if devices, ok := other.(Devices); ok {
@@ -589,6 +731,13 @@ func (ni *NodeInfo) subResource(pod *v1.Pod) {
 			}
 		}
 	}
+	if hccs.AscendHCCSEnable {
+		if other, exists := ni.Others[hccs.DeviceName]; exists {
+			if devices, ok := other.(Devices); ok {
+				devices.SubResource(pod)
+			}
+		}
+	}
 	if hami.AscendHAMiVNPUEnable {
 		for _, name := range hami.GetAscendDeviceNames() {
 			if other, exists := ni.Others[name]; exists {
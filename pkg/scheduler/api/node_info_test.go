@@ -454,3 +454,107 @@ func TestNodeInfoClonePreservesUnassignedNumaPods(t *testing.T) {
 		t.Errorf("Clone mutation leaked back into original: %v", ni.UnassignedNumaPods)
 	}
 }
+
+func TestNodeInfo_ReleasingDRADevices(t *testing.T) {
+	gpu := func(count int64) map[string]*DRAResource {
+		return map[string]*DRAResource{"gpu.example.com": {Count: count}}
+	}
+	task := func(name string, status TaskStatus, claims map[string]map[string]*DRAResource, resreq map[string]*DRAResource) *TaskInfo {
+		return &TaskInfo{
+			Name:                   name,
+			DRAResreq:              resreq,
+			ResourceClaimDRAResreq: claims,
+			TransactionContext:     TransactionContext{Status: status},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		tasks    []*TaskInfo
+		expected map[string]int64
+	}{
+		{
+			name:     "no releasing task",
+			tasks:    []*TaskInfo{task("t1", Running, map[string]map[string]*DRAResource{"c1/claim1": gpu(1)}, gpu(1))},
+			expected: nil,
+		},
+		{
+			name: "releasing task claims are counted once",
+			tasks: []*TaskInfo{
+				task("t1", Releasing, map[string]map[string]*DRAResource{"c1/claim1": gpu(1), "c1/claim2": gpu(2)}, gpu(3)),
+				task("t2", Releasing, map[string]map[string]*DRAResource{"c1/claim2": gpu(2)}, gpu(2)),
+			},
+			expected: map[string]int64{"gpu.example.com": 3},
+		},
+		{
+			name: "claims shared with a running task are not released",
+			tasks: []*TaskInfo{
+				task("t1", Releasing, map[string]map[string]*DRAResource{"c1/claim1": gpu(1), "c1/claim2": gpu(2)}, gpu(3)),
+				task("t2", Running, map[string]map[string]*DRAResource{"c1/claim2": gpu(2)}, gpu(2)),
+			},
+			expected: map[string]int64{"gpu.example.com": 1},
+		},
+		{
+			name:     "releasing task without per claim info",
+			tasks:    []*TaskInfo{task("t1", Releasing, nil, gpu(2))},
+			expected: map[string]int64{"gpu.example.com": 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ni := &NodeInfo{Tasks: map[TaskID]*TaskInfo{}}
+			for _, task := range test.tasks {
+				ni.Tasks[TaskID(task.Name)] = task
+			}
+			if got := ni.ReleasingDRADevices(); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestNodeInfoInterruptible(t *testing.T) {
+	tests := []struct {
+		name              string
+		labels            map[string]string
+		taints            []v1.Taint
+		interruptible     bool
+		terminationNotice bool
+	}{
+		{name: "on-demand node"},
+		{name: "karpenter spot node", labels: map[string]string{"karpenter.sh/capacity-type": "spot"}, interruptible: true},
+		{name: "karpenter on-demand node", labels: map[string]string{"karpenter.sh/capacity-type": "on-demand"}},
+		{name: "labeled interruptible node", labels: map[string]string{InterruptibleNodeKey: "true"}, interruptible: true},
+		{
+			name:          "azure spot node by taint",
+			taints:        []v1.Taint{{Key: "kubernetes.azure.com/scalesetpriority", Value: "spot", Effect: v1.TaintEffectNoSchedule}},
+			interruptible: true,
+		},
+		{
+			name:              "spot node with interruption notice",
+			labels:            map[string]string{"cloud.google.com/gke-spot": "true"},
+			taints:            []v1.Taint{{Key: "cloud.google.com/impending-node-termination", Effect: v1.TaintEffectNoSchedule}},
+			interruptible:     true,
+			terminationNotice: true,
+		},
+		{name: "labeled termination notice", labels: map[string]string{TerminationNoticeKey: "true"}, terminationNotice: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: test.labels},
+				Spec:       v1.NodeSpec{Taints: test.taints},
+			}
+			ni := NewNodeInfo(node)
+			if ni.Interruptible != test.interruptible || ni.TerminationNotice != test.terminationNotice {
+				t.Errorf("expected interruptible %t and termination notice %t, got %t and %t",
+					test.interruptible, test.terminationNotice, ni.Interruptible, ni.TerminationNotice)
+			}
+			ni.SetNode(node.DeepCopy())
+			if ni.Interruptible != test.interruptible || ni.TerminationNotice != test.terminationNotice {
+				t.Errorf("expected the same interruptibility after SetNode")
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/kubernetes/pkg/features"
 
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/util"
 )
 
 // Refer k8s.io/kubernetes/pkg/api/v1/resource/helpers.go#PodRequests.
@@ -220,6 +221,59 @@ func GetPodRevocableZone(pod *v1.Pod) string {
 	return ""
 }
 
+// PredicateOverrides overrides the node search of the actions for the tasks of one job,
+// the unset fields fall back to the arguments of the actions and the options of the scheduler.
+type PredicateOverrides struct {
+	// EnablePredicateErrorCache overrides the enablePredicateErrorCache argument of the actions.
+	EnablePredicateErrorCache *bool
+	// PercentageOfNodesToFind overrides the --percentage-nodes-to-find option of the scheduler.
+	PercentageOfNodesToFind *int32
+	// ShardingMode overrides the --scheduler-sharding-mode option of the scheduler.
+	ShardingMode string
+}
+
+// GetPodPredicateOverrides return the volcano.sh/enable-predicate-error-cache, volcano.sh/percentage-of-nodes-to-find
+// and volcano.sh/sharding-mode values for pod, nil if none of them is set. The invalid values are ignored.
+func GetPodPredicateOverrides(pod *v1.Pod) *PredicateOverrides {
+	if len(pod.Annotations) == 0 {
+		return nil
+	}
+
+	overrides := &PredicateOverrides{}
+	found := false
+	if value, ok := pod.Annotations[v1beta1.EnablePredicateErrorCacheKey]; ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			overrides.EnablePredicateErrorCache = &b
+			found = true
+		} else {
+			klog.Warningf("invalid %s=%s", v1beta1.EnablePredicateErrorCacheKey, value)
+		}
+	}
+	if value, ok := pod.Annotations[v1beta1.PercentageOfNodesToFindKey]; ok {
+		if p, err := strconv.ParseInt(value, 10, 32); err == nil && p >= 0 && p <= 100 {
+			percentage := int32(p)
+			overrides.PercentageOfNodesToFind = &percentage
+			found = true
+		} else {
+			klog.Warningf("invalid %s=%s", v1beta1.PercentageOfNodesToFindKey, value)
+		}
+	}
+	if value, ok := pod.Annotations[v1beta1.ShardingModeKey]; ok {
+		switch value {
+		case util.HardShardingMode, util.SoftShardingMode, util.NoneShardingMode:
+			overrides.ShardingMode = value
+			found = true
+		default:
+			klog.Warningf("invalid %s=%s", v1beta1.ShardingModeKey, value)
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return overrides
+}
+
 // GetPodTopologyInfo return volcano.sh/numa-topology-policy value for pod
 func GetPodTopologyInfo(pod *v1.Pod) *TopologyInfo {
 	info := TopologyInfo{
@@ -243,6 +297,50 @@ func GetPodTopologyInfo(pod *v1.Pod) *TopologyInfo {
 	return &info
 }
 
+// GetPodResizeRequest returns the resources added by the pending in-place resize of the pod: the requests of its
+// containers beyond the resources allocated to them by the kubelet. It returns nil if the pod is not bound, has no
+// resize pending, or its resize is infeasible on the node, as the kubelet will not apply it.
+func GetPodResizeRequest(pod *v1.Pod) *Resource {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.InPlacePodVerticalScaling) ||
+		len(pod.Spec.NodeName) == 0 || helpers.IsPodResizeInfeasible(pod) {
+		return nil
+	}
+
+	containerStatuses := make(map[string]*v1.ContainerStatus, len(pod.Status.ContainerStatuses))
+	for i := range pod.Status.ContainerStatuses {
+		containerStatuses[pod.Status.ContainerStatuses[i].Name] = &pod.Status.ContainerStatuses[i]
+	}
+
+	result := EmptyResource()
+	for _, container := range pod.Spec.Containers {
+		cs, found := containerStatuses[container.Name]
+		if !found {
+			continue
+		}
+		allocated := cs.AllocatedResources
+		if allocated == nil && cs.Resources != nil {
+			allocated = cs.Resources.Requests
+		}
+		if allocated == nil {
+			continue
+		}
+		increase := v1.ResourceList{}
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := allocated[name]; !ok || quantity.Cmp(current) > 0 {
+				delta := quantity.DeepCopy()
+				delta.Sub(current)
+				increase[name] = delta
+			}
+		}
+		result.Add(NewResource(increase))
+	}
+
+	if result.IsEmpty() {
+		return nil
+	}
+	return result
+}
+
 // GetPodResourceWithoutInitContainers returns Pod's resource request, it does not contain
 // init containers' resource request.
 func GetPodResourceWithoutInitContainers(pod *v1.Pod) *Resource {
@@ -32,6 +32,7 @@ import (
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	k8sfeature "k8s.io/kubernetes/pkg/features"
 
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/nvidia/gpushare"
 )
 
@@ -1002,3 +1003,119 @@ func TestGetPodResourceWithResize(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodResizeRequest(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, k8sfeature.InPlacePodVerticalScaling, true)
+
+	buildResizedPod := func(nodeName string, conditions ...v1.PodCondition) *v1.Pod {
+		return &v1.Pod{
+			Spec: v1.PodSpec{
+				NodeName: nodeName,
+				Containers: []v1.Container{
+					{Name: "c1", Resources: v1.ResourceRequirements{Requests: BuildResourceList("3", "1Gi")}},
+					{Name: "c2", Resources: v1.ResourceRequirements{Requests: BuildResourceList("1", "4Gi")}},
+				},
+			},
+			Status: v1.PodStatus{
+				Conditions: conditions,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", AllocatedResources: BuildResourceList("1", "1Gi")},
+					{Name: "c2", Resources: &v1.ResourceRequirements{Requests: BuildResourceList("2", "2Gi")}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		expected *Resource
+	}{
+		{
+			name:     "increase of the requests beyond the allocated resources",
+			pod:      buildResizedPod("n1", v1.PodCondition{Type: v1.PodResizePending, Reason: v1.PodReasonDeferred}),
+			expected: NewResource(BuildResourceList("2", "2Gi")),
+		},
+		{
+			name: "pod not bound",
+			pod:  buildResizedPod(""),
+		},
+		{
+			name: "infeasible resize",
+			pod:  buildResizedPod("n1", v1.PodCondition{Type: v1.PodResizePending, Reason: v1.PodReasonInfeasible}),
+		},
+		{
+			name: "no pending resize",
+			pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					NodeName:   "n1",
+					Containers: []v1.Container{{Name: "c1", Resources: v1.ResourceRequirements{Requests: BuildResourceList("1", "1Gi")}}},
+				},
+				Status: v1.PodStatus{
+					ContainerStatuses: []v1.ContainerStatus{{Name: "c1", AllocatedResources: BuildResourceList("1", "1Gi")}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetPodResizeRequest(tt.pod)
+			if tt.expected == nil {
+				if got != nil {
+					t.Errorf("GetPodResizeRequest() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil || !got.Equal(tt.expected, Zero) {
+				t.Errorf("GetPodResizeRequest() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetPodPredicateOverrides(t *testing.T) {
+	disabled := false
+	percentage := int32(100)
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *PredicateOverrides
+	}{
+		{
+			name:     "no annotations",
+			expected: nil,
+		},
+		{
+			name: "all overrides",
+			annotations: map[string]string{
+				v1beta1.EnablePredicateErrorCacheKey: "false",
+				v1beta1.PercentageOfNodesToFindKey:   "100",
+				v1beta1.ShardingModeKey:              "soft",
+			},
+			expected: &PredicateOverrides{
+				EnablePredicateErrorCache: &disabled,
+				PercentageOfNodesToFind:   &percentage,
+				ShardingMode:              "soft",
+			},
+		},
+		{
+			name: "invalid values are ignored",
+			annotations: map[string]string{
+				v1beta1.EnablePredicateErrorCacheKey: "maybe",
+				v1beta1.PercentageOfNodesToFindKey:   "101",
+				v1beta1.ShardingModeKey:              "strict",
+			},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			if diff := cmp.Diff(test.expected, GetPodPredicateOverrides(pod)); diff != "" {
+				t.Errorf("unexpected predicate overrides (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,12 @@ limitations under the License.
 package api
 
 import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
@@ -92,3 +98,65 @@ func (q *QueueInfo) Reclaimable() bool {
 
 	return *q.Queue.Spec.Reclaimable
 }
+
+// MaxRunDuration returns the maximum time the jobs of the queue may run, false if they are not limited.
+func (q *QueueInfo) MaxRunDuration() (time.Duration, bool) {
+	if q == nil || q.Queue == nil || q.Queue.Spec.MaxRunSeconds == nil || *q.Queue.Spec.MaxRunSeconds <= 0 {
+		return 0, false
+	}
+	return time.Duration(*q.Queue.Spec.MaxRunSeconds) * time.Second, true
+}
+
+// MaxRunEnforced returns whether the jobs of the queue are terminated once they run longer than its maximum run time.
+// With the Reclaim policy, they keep running and are only preferred as victims.
+func (q *QueueInfo) MaxRunEnforced() bool {
+	_, limited := q.MaxRunDuration()
+	return limited && q.Queue.Spec.MaxRunPolicy != scheduling.MaxRunPolicyReclaim
+}
+
+// NodeSelected returns whether the node belongs to the node pool the queue is bound to, true if it is not bound.
+func (q *QueueInfo) NodeSelected(node *v1.Node) bool {
+	if q == nil || q.Queue == nil || len(q.Queue.Spec.NodeSelector) == 0 {
+		return true
+	}
+	if node == nil {
+		return false
+	}
+	return labels.SelectorFromSet(q.Queue.Spec.NodeSelector).Matches(labels.Set(node.Labels))
+}
+
+// PoolResource returns the allocatable resources of the nodes of the node pool the queue is bound to, nil if it is
+// not bound.
+func (q *QueueInfo) PoolResource(nodes map[string]*NodeInfo) *Resource {
+	if q == nil || q.Queue == nil || len(q.Queue.Spec.NodeSelector) == 0 {
+		return nil
+	}
+	pool := EmptyResource()
+	for _, node := range nodes {
+		if q.NodeSelected(node.Node) {
+			pool.Add(node.Allocatable)
+		}
+	}
+	return pool
+}
+
+// CheckpointWebhookArgument is the argument of the actions of the URL the scheduler posts to for their victims to
+// checkpoint. It is only set by the scheduler configuration, the annotation of a queue may not override it.
+const CheckpointWebhookArgument = "checkpointWebhook"
+
+// ParseQueueActionArguments parses the value of the QueueActionArgumentsKey annotation of a queue,
+// the arguments of the actions by action name.
+func ParseQueueActionArguments(value string) (map[string]map[string]interface{}, error) {
+	arguments := map[string]map[string]interface{}{}
+	// the annotation is JSON, parsed as YAML to keep the integers of the arguments as int like the configuration
+	if err := yaml.Unmarshal([]byte(value), &arguments); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", QueueActionArgumentsKey, err)
+	}
+	for action, args := range arguments {
+		if _, found := args[CheckpointWebhookArgument]; found {
+			return nil, fmt.Errorf("invalid %s annotation: %s of action %s is only set by the scheduler configuration",
+				QueueActionArgumentsKey, CheckpointWebhookArgument, action)
+		}
+	}
+	return arguments, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// DefaultReservationTTL is the duration the capacity of a Reservation is held after its start time if its TTL is not set.
+const DefaultReservationTTL = time.Hour
+
+// ReservationInfo is the capacity held by a Reservation.
+type ReservationInfo struct {
+	Name string
+	// Queue is the owner queue of the Reservation
+	Queue QueueID
+	// Resource is the capacity to reserve
+	Resource     *Resource
+	NodeSelector labels.Selector
+	// StartTime is the time the jobs using the Reservation start
+	StartTime time.Time
+	// ExpirationTime is the time the capacity of the Reservation is released
+	ExpirationTime time.Time
+	Phase          v1alpha1.ReservationPhase
+	// Nodes is the capacity held on each node
+	Nodes map[string]*Resource
+	// Job is the only consumer of a shadow reservation, which holds capacity for a starving job in a session
+	Job JobID
+
+	// Reservation is nil for shadow reservations
+	Reservation *v1alpha1.Reservation
+}
+
+// NewReservationInfo creates the ReservationInfo of a Reservation.
+func NewReservationInfo(reservation *v1alpha1.Reservation) *ReservationInfo {
+	startTime := reservation.CreationTimestamp.Time
+	if reservation.Spec.StartTime != nil {
+		startTime = reservation.Spec.StartTime.Time
+	}
+	ttl := DefaultReservationTTL
+	if reservation.Spec.TTL != nil {
+		ttl = reservation.Spec.TTL.Duration
+	}
+
+	ri := &ReservationInfo{
+		Name:           reservation.Name,
+		Queue:          QueueID(reservation.Spec.Queue),
+		Resource:       NewResource(reservation.Spec.Resources),
+		NodeSelector:   labels.SelectorFromSet(reservation.Spec.NodeSelector),
+		StartTime:      startTime,
+		ExpirationTime: startTime.Add(ttl),
+		Phase:          reservation.Status.Phase,
+		Nodes:          make(map[string]*Resource, len(reservation.Status.Nodes)),
+		Reservation:    reservation,
+	}
+	if ri.Phase == "" {
+		ri.Phase = v1alpha1.ReservationPending
+	}
+	for _, node := range reservation.Status.Nodes {
+		ri.Nodes[node.NodeName] = NewResource(node.Resources)
+	}
+	return ri
+}
+
+// Clone is used to clone the ReservationInfo.
+func (ri *ReservationInfo) Clone() *ReservationInfo {
+	clone := &ReservationInfo{
+		Name:           ri.Name,
+		Queue:          ri.Queue,
+		Resource:       ri.Resource.Clone(),
+		NodeSelector:   ri.NodeSelector,
+		StartTime:      ri.StartTime,
+		ExpirationTime: ri.ExpirationTime,
+		Phase:          ri.Phase,
+		Nodes:          make(map[string]*Resource, len(ri.Nodes)),
+		Job:            ri.Job,
+		Reservation:    ri.Reservation,
+	}
+	for name, resource := range ri.Nodes {
+		clone.Nodes[name] = resource.Clone()
+	}
+	return clone
+}
+
+// Held returns the capacity held on all the nodes.
+func (ri *ReservationInfo) Held() *Resource {
+	held := EmptyResource()
+	for _, resource := range ri.Nodes {
+		held.Add(resource)
+	}
+	return held
+}
+
+// Expired returns whether the capacity of the Reservation is released at the time.
+func (ri *ReservationInfo) Expired(now time.Time) bool {
+	return !now.Before(ri.ExpirationTime)
+}
+
+// Consumer returns whether the job uses the capacity of the Reservation: it references the Reservation by the
+// volcano.sh/reservation annotation of its podgroup, and belongs to the owner queue of the Reservation.
+// Only the job of a shadow reservation uses its capacity.
+func (ri *ReservationInfo) Consumer(job *JobInfo) bool {
+	if job == nil {
+		return false
+	}
+	if ri.Job != "" {
+		return job.UID == ri.Job
+	}
+	if job.PodGroup == nil || job.Queue != ri.Queue {
+		return false
+	}
+	return job.PodGroup.Annotations[v1beta1.ReservationKey] == ri.Name
+}
+
+// Shadow returns whether the reservation is a shadow reservation.
+func (ri *ReservationInfo) Shadow() bool {
+	return ri.Reservation == nil
+}
+
+// Selects returns whether the capacity of the Reservation can be held on the node.
+func (ri *ReservationInfo) Selects(node *NodeInfo) bool {
+	if node == nil || node.Node == nil {
+		return false
+	}
+	return ri.NodeSelector.Matches(labels.Set(node.Node.Labels))
+}
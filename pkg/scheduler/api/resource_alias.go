@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"math"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// ResourceAlias accounts the quota of a resource in another resource, e.g. nvidia.com/A100 in nvidia.com/gpu, so
+// that the quota of a queue in the other resource covers all its aliases.
+type ResourceAlias struct {
+	// Resource is the name of the resource the alias is accounted in.
+	Resource v1.ResourceName
+	// Ratio is the quantity of Resource accounted for one unit of the alias.
+	Ratio float64
+}
+
+var resourceAliases atomic.Pointer[map[v1.ResourceName]ResourceAlias]
+
+// SetResourceAliases sets the aliases the quotas of the queues are accounted with, by the names of the aliases.
+func SetResourceAliases(aliases map[v1.ResourceName]ResourceAlias) {
+	if len(aliases) == 0 {
+		resourceAliases.Store(nil)
+		return
+	}
+	resourceAliases.Store(&aliases)
+}
+
+// GetResourceAliases returns the aliases the quotas of the queues are accounted with, nil if there is none.
+func GetResourceAliases() map[v1.ResourceName]ResourceAlias {
+	aliases := resourceAliases.Load()
+	if aliases == nil {
+		return nil
+	}
+	return *aliases
+}
+
+// Normalized returns the resource with the quantities of the aliases accounted in the resources they alias, as used
+// by the quotas of the queues. It returns the resource itself if it has no alias.
+func (r *Resource) Normalized() *Resource {
+	aliases := GetResourceAliases()
+	if r == nil || len(aliases) == 0 {
+		return r
+	}
+
+	var normalized *Resource
+	for name, quant := range r.ScalarResources {
+		alias, found := aliases[name]
+		if !found {
+			continue
+		}
+		if normalized == nil {
+			normalized = r.Clone()
+		}
+		delete(normalized.ScalarResources, name)
+		if quant == math.MaxFloat64 {
+			normalized.ScalarResources[alias.Resource] = quant
+		} else if normalized.ScalarResources[alias.Resource] != math.MaxFloat64 {
+			normalized.ScalarResources[alias.Resource] += quant * alias.Ratio
+		}
+	}
+	if normalized == nil {
+		return r
+	}
+	return normalized
+}
+
+// NormalizedResourceName returns the name of the resource the named resource is accounted in by the quotas of the
+// queues, the name itself if it is not an alias.
+func NormalizedResourceName(name v1.ResourceName) v1.ResourceName {
+	if alias, found := GetResourceAliases()[name]; found {
+		return alias.Resource
+	}
+	return name
+}
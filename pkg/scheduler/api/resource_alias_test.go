@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"math"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+func TestResourceNormalized(t *testing.T) {
+	SetResourceAliases(map[v1.ResourceName]ResourceAlias{
+		"nvidia.com/A100": {Resource: "nvidia.com/gpu", Ratio: 1},
+		"nvidia.com/H100": {Resource: "nvidia.com/gpu", Ratio: 2},
+	})
+	defer SetResourceAliases(nil)
+
+	tests := []struct {
+		name     string
+		resource *Resource
+		expected *Resource
+	}{
+		{
+			name:     "no alias",
+			resource: &Resource{MilliCPU: 1000, ScalarResources: map[v1.ResourceName]float64{"nvidia.com/gpu": 1000}},
+			expected: &Resource{MilliCPU: 1000, ScalarResources: map[v1.ResourceName]float64{"nvidia.com/gpu": 1000}},
+		},
+		{
+			name: "aliases accounted with their ratio",
+			resource: &Resource{MilliCPU: 1000, ScalarResources: map[v1.ResourceName]float64{
+				"nvidia.com/gpu": 1000, "nvidia.com/A100": 2000, "nvidia.com/H100": 1000,
+			}},
+			expected: &Resource{MilliCPU: 1000, ScalarResources: map[v1.ResourceName]float64{"nvidia.com/gpu": 5000}},
+		},
+		{
+			name:     "unlimited alias",
+			resource: &Resource{ScalarResources: map[v1.ResourceName]float64{"nvidia.com/gpu": 1000, "nvidia.com/A100": math.MaxFloat64}},
+			expected: &Resource{ScalarResources: map[v1.ResourceName]float64{"nvidia.com/gpu": math.MaxFloat64}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := test.resource.Clone()
+			normalized := test.resource.Normalized()
+			if !equality.Semantic.DeepEqual(normalized, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, normalized)
+			}
+			if !equality.Semantic.DeepEqual(test.resource, original) {
+				t.Errorf("expected the resource not to be modified, got %v", test.resource)
+			}
+		})
+	}
+
+	SetResourceAliases(nil)
+	resource := &Resource{ScalarResources: map[v1.ResourceName]float64{"nvidia.com/A100": 1000}}
+	if resource.Normalized() != resource {
+		t.Errorf("expected the resource itself without aliases")
+	}
+}
@@ -246,6 +246,19 @@ func (r ResourceNameList) FilteredIgnoredScalarResources() ResourceNameList {
 	return filtered
 }
 
+// HasGPU returns whether the resource has any GPU resource, e.g. nvidia.com/gpu or volcano.sh/vgpu-number
+func (r *Resource) HasGPU() bool {
+	if r == nil {
+		return false
+	}
+	for name, quant := range r.ScalarResources {
+		if quant >= minResource && strings.Contains(strings.ToLower(string(name)), "gpu") {
+			return true
+		}
+	}
+	return false
+}
+
 // IsEmpty returns false if any kind of resource other than IgnoredResources is not less than min value, otherwise returns true
 func (r *Resource) IsEmpty() bool {
 	if !(r.MilliCPU < minResource && r.Memory < minResource) {
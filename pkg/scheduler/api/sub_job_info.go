@@ -215,6 +215,11 @@ func getSubJobMatchValues(policy scheduling.SubGroupPolicySpec, pod *v1.Pod) []s
 	return matchValues
 }
 
+// SubJobGIDOf returns the SubJobGID of the subGroups of the subGroupPolicy of the job.
+func SubJobGIDOf(job JobID, policy string) SubJobGID {
+	return getSubJobGID(job, policy)
+}
+
 func getSubJobGID(job JobID, policy string) SubJobGID {
 	return SubJobGID(fmt.Sprintf("%s/%s", job, policy))
 }
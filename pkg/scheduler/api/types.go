@@ -384,6 +384,10 @@ type VictimTasksFn func([]*TaskInfo) []*TaskInfo
 // AllocatableFn is the func declaration used to check whether the task can be allocated
 type AllocatableFn func(*QueueInfo, *TaskInfo) bool
 
+// ResizableFn is the func declaration used to check whether the pending in-place resize of the running task,
+// TaskInfo.ResizeReq, fits the quota of its queue
+type ResizableFn func(*QueueInfo, *TaskInfo) bool
+
 // SimulateRemoveTaskFn is the func declaration used to simulate the result of removing a task from a node.
 type SimulateRemoveTaskFn func(ctx context.Context, state fwk.CycleState, taskToSchedule *TaskInfo, taskInfoToRemove *TaskInfo, nodeInfo *NodeInfo) error
 
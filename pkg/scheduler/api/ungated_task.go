@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// UngatedTaskOrder is how a task is ordered among the pending tasks once the scheduling gates of its pod are removed.
+type UngatedTaskOrder string
+
+const (
+	// UngatedOrderCreationTime orders the task by the creation time of its pod, as if it had never been gated.
+	UngatedOrderCreationTime UngatedTaskOrder = "CreationTime"
+	// UngatedOrderGateRemovalTime orders the task by the time its gates were removed, behind the tasks pending before.
+	UngatedOrderGateRemovalTime UngatedTaskOrder = "GateRemovalTime"
+	// UngatedOrderBoost orders the task ahead of the tasks which are not boosted.
+	UngatedOrderBoost UngatedTaskOrder = "Boost"
+)
+
+// GetUngatedTaskOrder returns the order of the task of the pod once its gates are removed,
+// UngatedOrderCreationTime if the annotation is not set or invalid.
+func GetUngatedTaskOrder(pod *v1.Pod) UngatedTaskOrder {
+	if pod == nil {
+		return UngatedOrderCreationTime
+	}
+	switch order := UngatedTaskOrder(pod.Annotations[v1beta1.UngatedTaskOrderKey]); order {
+	case UngatedOrderGateRemovalTime, UngatedOrderBoost:
+		return order
+	default:
+		return UngatedOrderCreationTime
+	}
+}
+
+// ungatedOrder returns the order of the task, UngatedOrderCreationTime if the task was never un-gated.
+func (ti *TaskInfo) ungatedOrder() UngatedTaskOrder {
+	if ti.GateRemovedTime.IsZero() {
+		return UngatedOrderCreationTime
+	}
+	return GetUngatedTaskOrder(ti.Pod)
+}
+
+// orderTime returns the time the task is ordered by among the tasks of the same priority.
+func (ti *TaskInfo) orderTime() time.Time {
+	if ti.ungatedOrder() == UngatedOrderGateRemovalTime {
+		return ti.GateRemovedTime.Time
+	}
+	if ti.Pod == nil {
+		return time.Time{}
+	}
+	return ti.Pod.CreationTimestamp.Time
+}
+
+// CompareUngatedTask compares the tasks by the order of the un-gated tasks: the boosted tasks go first,
+// then the tasks ordered by gate removal time are compared by that time with the creation time of the others.
+// It returns 0 when the order of the tasks is left to the default rules, e.g. when none of them was un-gated.
+func CompareUngatedTask(l, r *TaskInfo) int {
+	lOrder, rOrder := l.ungatedOrder(), r.ungatedOrder()
+	if lBoost, rBoost := lOrder == UngatedOrderBoost, rOrder == UngatedOrderBoost; lBoost != rBoost {
+		if lBoost {
+			return -1
+		}
+		return 1
+	}
+	if lOrder != UngatedOrderGateRemovalTime && rOrder != UngatedOrderGateRemovalTime {
+		return 0
+	}
+	lTime, rTime := l.orderTime(), r.orderTime()
+	if lTime.Before(rTime) {
+		return -1
+	}
+	if rTime.Before(lTime) {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestCompareUngatedTask(t *testing.T) {
+	now := time.Now()
+	buildTask := func(created time.Time, removed time.Time, order UngatedTaskOrder) *TaskInfo {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+		if order != "" {
+			pod.Annotations = map[string]string{v1beta1.UngatedTaskOrderKey: string(order)}
+		}
+		return &TaskInfo{Pod: pod, GateRemovedTime: metav1.NewTime(removed)}
+	}
+
+	tests := []struct {
+		name     string
+		l, r     *TaskInfo
+		expected int
+	}{
+		{
+			name:     "never gated tasks are left to the default order",
+			l:        buildTask(now, time.Time{}, ""),
+			r:        buildTask(now.Add(-time.Minute), time.Time{}, ""),
+			expected: 0,
+		},
+		{
+			name:     "un-gated task keeps its creation time by default",
+			l:        buildTask(now.Add(-time.Hour), now, ""),
+			r:        buildTask(now.Add(-time.Minute), time.Time{}, ""),
+			expected: 0,
+		},
+		{
+			name:     "un-gated task ordered by gate removal time goes behind the tasks created before",
+			l:        buildTask(now.Add(-time.Hour), now, UngatedOrderGateRemovalTime),
+			r:        buildTask(now.Add(-time.Minute), time.Time{}, ""),
+			expected: 1,
+		},
+		{
+			name:     "un-gated task ordered by gate removal time goes ahead of the tasks created after",
+			l:        buildTask(now.Add(-time.Hour), now.Add(-time.Minute), UngatedOrderGateRemovalTime),
+			r:        buildTask(now, time.Time{}, ""),
+			expected: -1,
+		},
+		{
+			name:     "boosted task goes first",
+			l:        buildTask(now, time.Time{}, ""),
+			r:        buildTask(now, now, UngatedOrderBoost),
+			expected: 1,
+		},
+		{
+			name:     "still gated task is not boosted",
+			l:        buildTask(now, time.Time{}, UngatedOrderBoost),
+			r:        buildTask(now, time.Time{}, ""),
+			expected: 0,
+		},
+		{
+			name:     "invalid order is the default order",
+			l:        buildTask(now.Add(-time.Hour), now, "Unknown"),
+			r:        buildTask(now, time.Time{}, ""),
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := CompareUngatedTask(test.l, test.r); got != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
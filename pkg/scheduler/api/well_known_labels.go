@@ -47,4 +47,66 @@ const (
 	// to which the job is allocated. This typically represents the lowest common ancestor
 	// HyperNode in the scheduling hierarchy.
 	JobAllocatedHyperNode = "volcano.sh/job-allocated-hypernode"
+
+	// QueueClassLabel is the label of the queues selecting the action pipeline of their class,
+	// the child queues without the label inherit the class of their parent.
+	QueueClassLabel = "volcano.sh/queue-class"
+
+	// SchedulerProfileKey is the label of the pods, or the annotation of the queues, selecting the scheduler profile
+	// scheduling the pods. The label of the pods takes precedence, the child queues without the annotation inherit
+	// the profile of their parent.
+	SchedulerProfileKey = "volcano.sh/scheduler-profile"
+
+	// QueueActionArgumentsKey is the annotation of the queues overriding the arguments of the actions for their jobs,
+	// e.g. `{"allocate": {"predicateErrorCacheEnable": false}}`. The child queues without the annotation inherit
+	// the overrides of their parent.
+	QueueActionArgumentsKey = "volcano.sh/action-arguments"
+
+	// EvictedByJobKey is the annotation of the pods evicted by reclaim or preempt, and of their podgroups,
+	// recording the job (namespace/name) the resources were freed for.
+	EvictedByJobKey = "volcano.sh/evicted-by-job"
+	// EvictedByJobUIDKey records the UID of the podgroup of the job the resources were freed for.
+	EvictedByJobUIDKey = "volcano.sh/evicted-by-job-uid"
+	// EvictedByQueueKey records the queue of the job the resources were freed for.
+	EvictedByQueueKey = "volcano.sh/evicted-by-queue"
+	// EvictedInSessionKey records the ID of the scheduling session evicting the pod.
+	EvictedInSessionKey = "volcano.sh/evicted-in-session"
+	// EvictedReasonKey records the action evicting the pod, e.g. reclaim or preempt.
+	EvictedReasonKey = "volcano.sh/evicted-reason"
+	// EvictedResourcesKey records the resources freed by the eviction, e.g. `cpu=2,memory=4Gi`.
+	// On a podgroup, it records the resources freed by the last eviction of its pods.
+	EvictedResourcesKey = "volcano.sh/evicted-resources"
+
+	// CheckpointRequestedKey is the annotation of the pods asked to checkpoint before they are evicted,
+	// recording the deadline of the checkpoint in RFC 3339 format.
+	CheckpointRequestedKey = "volcano.sh/checkpoint-requested"
+	// CheckpointAcknowledgedKey is the annotation set on the pods by the workloads once they have checkpointed,
+	// so that they are evicted before the deadline.
+	CheckpointAcknowledgedKey = "volcano.sh/checkpoint-acknowledged"
+
+	// InterruptibleNodeKey is the label of the nodes the provider can reclaim at any time, e.g. spot or preemptible
+	// instances, besides the labels and taints set by the cloud providers.
+	InterruptibleNodeKey = "volcano.sh/interruptible"
+	// TerminationNoticeKey is the label, or taint, of the interruptible nodes whose interruption is announced,
+	// besides the taints set by the termination handlers.
+	TerminationNoticeKey = "volcano.sh/termination-notice"
 )
+
+// interruptibleNodeLabels are the labels, and their values, of the spot and preemptible nodes of the cloud providers.
+var interruptibleNodeLabels = map[string]string{
+	InterruptibleNodeKey:                    "true",
+	"karpenter.sh/capacity-type":            "spot",
+	"eks.amazonaws.com/capacityType":        "SPOT",
+	"cloud.google.com/gke-spot":             "true",
+	"cloud.google.com/gke-preemptible":      "true",
+	"kubernetes.azure.com/scalesetpriority": "spot",
+}
+
+// terminationNoticeTaints are the taints set on the nodes whose interruption is announced.
+var terminationNoticeTaints = []string{
+	TerminationNoticeKey,
+	"aws-node-termination-handler/spot-itn",
+	"aws-node-termination-handler/rebalance-recommendation",
+	"cloud.google.com/impending-node-termination",
+	"karpenter.sh/disrupted",
+}
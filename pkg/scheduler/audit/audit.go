@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the scheduling decisions about pods in a tamper-evident log: every record
+// carries the hash of the previous one, so that removing or modifying a record breaks the chain.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// queueSize is the number of batches of records waiting to be written to the sinks.
+const queueSize = 64
+
+// Record is one scheduling decision about a pod.
+type Record struct {
+	// Sequence is the position of the record in the chain, starting at 1.
+	Sequence uint64 `json:"seq"`
+	// Time is the time the decision was committed.
+	Time time.Time `json:"time"`
+	// CorrelationID identifies the scheduling cycle the decision was made in.
+	CorrelationID string `json:"correlationID"`
+	// Action is the action which made the decision.
+	Action string `json:"action,omitempty"`
+	// Decision is the kind of the decision, e.g. Bind, Pipeline or Evict.
+	Decision string `json:"decision"`
+	Pod      string `json:"pod"`
+	Node     string `json:"node,omitempty"`
+	Job      string `json:"job,omitempty"`
+	Queue    string `json:"queue,omitempty"`
+	// Reason is the reason of the eviction.
+	Reason string `json:"reason,omitempty"`
+	// Plugins are the plugins which voted for the decision.
+	Plugins []string `json:"plugins,omitempty"`
+	// Victims are the pods evicted on the node for the pod.
+	Victims []string `json:"victims,omitempty"`
+	// Requests are the resources requested by the pod.
+	Requests v1.ResourceList `json:"requests,omitempty"`
+	// Created is the creation time of the pod, the pod waited from Created to Time for the decision.
+	Created *time.Time `json:"created,omitempty"`
+	// PrevHash is the hash of the previous record in the chain, empty for the first record.
+	PrevHash string `json:"prevHash"`
+	// Hash is the hash of the record, including PrevHash.
+	Hash string `json:"hash"`
+}
+
+// Hash returns the hash of the record, computed over all its fields but Hash.
+func Hash(record Record) string {
+	record.Hash = ""
+	data, err := json.Marshal(record)
+	if err != nil {
+		// a Record is always marshalable
+		panic(err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks that the records form an unbroken chain.
+func Verify(records []Record) error {
+	for i, record := range records {
+		if i > 0 {
+			prev := records[i-1]
+			if record.Sequence != prev.Sequence+1 {
+				return fmt.Errorf("record %d follows record %d", record.Sequence, prev.Sequence)
+			}
+			if record.PrevHash != prev.Hash {
+				return fmt.Errorf("record %d does not chain to record %d", record.Sequence, prev.Sequence)
+			}
+		}
+		if Hash(record) != record.Hash {
+			return fmt.Errorf("record %d has been modified", record.Sequence)
+		}
+	}
+	return nil
+}
+
+// Sink writes the audit records to a storage.
+type Sink interface {
+	// Write writes the records in order.
+	Write(records []Record) error
+	// Close flushes and releases the sink.
+	Close() error
+}
+
+// resumableSink is a sink which already holds a chain, the chain is continued from its last record.
+type resumableSink interface {
+	Last() (Record, bool)
+}
+
+// Logger chains the records and writes them to the sinks in the background.
+type Logger struct {
+	sinks []Sink
+
+	mutex    sync.Mutex
+	sequence uint64
+	lastHash string
+	closed   bool
+
+	queue chan []Record
+	done  chan struct{}
+}
+
+// NewLogger returns a logger writing to the sinks, continuing the chain of the first resumable sink.
+func NewLogger(sinks ...Sink) *Logger {
+	l := &Logger{
+		sinks: sinks,
+		queue: make(chan []Record, queueSize),
+		done:  make(chan struct{}),
+	}
+	for _, sink := range sinks {
+		if rs, ok := sink.(resumableSink); ok {
+			if last, found := rs.Last(); found {
+				l.sequence, l.lastHash = last.Sequence, last.Hash
+			}
+			break
+		}
+	}
+	go l.run()
+	return l
+}
+
+// Log chains the records and queues them to be written, it blocks when the sinks fall behind.
+func (l *Logger) Log(records []Record) {
+	if l == nil || len(records) == 0 {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.closed {
+		// the session was opened before the audit configuration changed
+		klog.Warningf("Dropped %d audit records of a closed audit logger", len(records))
+		return
+	}
+	chained := make([]Record, len(records))
+	for i, record := range records {
+		l.sequence++
+		record.Sequence = l.sequence
+		record.PrevHash = l.lastHash
+		record.Hash = Hash(record)
+		l.lastHash = record.Hash
+		chained[i] = record
+	}
+	l.queue <- chained
+}
+
+func (l *Logger) run() {
+	defer close(l.done)
+	for records := range l.queue {
+		for _, sink := range l.sinks {
+			if err := sink.Write(records); err != nil {
+				klog.Errorf("Failed to write %d audit records: %v", len(records), err)
+			}
+		}
+	}
+}
+
+// Close writes the queued records and closes the sinks.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mutex.Lock()
+	if l.closed {
+		l.mutex.Unlock()
+		return nil
+	}
+	l.closed = true
+	close(l.queue)
+	l.mutex.Unlock()
+	<-l.done
+
+	var errs []error
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to close audit sinks: %v", errs)
+	}
+	return nil
+}
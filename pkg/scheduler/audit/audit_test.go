@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readFile(t *testing.T, path string) []Record {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open audit file: %v", err)
+	}
+	defer file.Close()
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := Record{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("failed to parse audit record: %v", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestFileSinkChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("failed to open file sink: %v", err)
+	}
+	logger := NewLogger(sink)
+	logger.Log([]Record{{Decision: "Evict", Pod: "ns/p1"}, {Decision: "Pipeline", Pod: "ns/p2", Victims: []string{"ns/p1"}}})
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %v", err)
+	}
+
+	// the chain is continued after a restart
+	sink, err = NewFileSink(path)
+	if err != nil {
+		t.Fatalf("failed to reopen file sink: %v", err)
+	}
+	logger = NewLogger(sink)
+	logger.Log([]Record{{Decision: "Bind", Pod: "ns/p3"}})
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %v", err)
+	}
+	// records of a closed logger are dropped
+	logger.Log([]Record{{Decision: "Bind", Pod: "ns/p4"}})
+
+	records := readFile(t, path)
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+	if records[0].Sequence != 1 || records[0].PrevHash != "" {
+		t.Errorf("expected the chain to start at the first record, got %+v", records[0])
+	}
+	if err := Verify(records); err != nil {
+		t.Errorf("expected an unbroken chain, got %v", err)
+	}
+
+	tampered := append([]Record{}, records...)
+	tampered[1].Pod = "ns/other"
+	if err := Verify(tampered); err == nil {
+		t.Errorf("expected the modified record to be detected")
+	}
+	if err := Verify([]Record{records[0], records[2]}); err == nil {
+		t.Errorf("expected the removed record to be detected")
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan []Record, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var records []Record
+		if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- records
+	}))
+	defer server.Close()
+
+	logger := NewLogger(NewWebhookSink(server.URL, time.Second))
+	logger.Log([]Record{{Decision: "Bind", Pod: "ns/p1", Node: "n1"}})
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %v", err)
+	}
+
+	select {
+	case records := <-received:
+		if len(records) != 1 || records[0].Pod != "ns/p1" || records[0].Hash == "" {
+			t.Errorf("unexpected records posted: %+v", records)
+		}
+	default:
+		t.Fatalf("expected the records to be posted")
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := NewWebhookSink(failing.URL, time.Second).Write([]Record{{Pod: "ns/p1"}}); err == nil {
+		t.Errorf("expected the error response to fail the write")
+	}
+}
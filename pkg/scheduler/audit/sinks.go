@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// DefaultWebhookTimeout is the timeout of the requests of the webhook sink.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// FileSink appends the records to a file, one JSON object per line.
+type FileSink struct {
+	file *os.File
+	last *Record
+}
+
+// NewFileSink opens the file in append mode, the last record of the file is read to continue its chain.
+func NewFileSink(path string) (*FileSink, error) {
+	sink := &FileSink{}
+	if existing, err := os.Open(path); err == nil {
+		err = scanRecords(existing, func(record *Record) {
+			sink.last = record
+		})
+		existing.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read audit file %s: %v", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	sink.file = file
+	return sink, nil
+}
+
+// ReadRecords reads the records written by a FileSink, one JSON object per line.
+func ReadRecords(reader io.Reader) ([]Record, error) {
+	var records []Record
+	err := scanRecords(reader, func(record *Record) {
+		records = append(records, *record)
+	})
+	return records, err
+}
+
+func scanRecords(reader io.Reader, fn func(*Record)) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return fmt.Errorf("failed to parse audit record: %v", err)
+		}
+		fn(record)
+	}
+	return scanner.Err()
+}
+
+// Last returns the last record of the file when it was opened.
+func (fs *FileSink) Last() (Record, bool) {
+	if fs.last == nil {
+		return Record{}, false
+	}
+	return *fs.last, true
+}
+
+func (fs *FileSink) Write(records []Record) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	if _, err := fs.file.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return fs.file.Sync()
+}
+
+func (fs *FileSink) Close() error {
+	return fs.file.Close()
+}
+
+// WebhookSink posts the records to a URL as a JSON array.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a sink posting the records to the URL.
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (ws *WebhookSink) Write(records []Record) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, ws.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := ws.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook %s responded %s", ws.url, resp.Status)
+	}
+	return nil
+}
+
+func (ws *WebhookSink) Close() error {
+	return nil
+}
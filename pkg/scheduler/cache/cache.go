@@ -18,12 +18,14 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -33,7 +35,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -61,11 +65,13 @@ import (
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	"volcano.sh/apis/pkg/apis/scheduling"
 	schedulingscheme "volcano.sh/apis/pkg/apis/scheduling/scheme"
+	schedulingv1alpha1 "volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	vcclient "volcano.sh/apis/pkg/client/clientset/versioned"
 	"volcano.sh/apis/pkg/client/clientset/versioned/scheme"
 	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
 	cpuinformerv1 "volcano.sh/apis/pkg/client/informers/externalversions/nodeinfo/v1alpha1"
+	schedulinginformerv1alpha1 "volcano.sh/apis/pkg/client/informers/externalversions/scheduling/v1alpha1"
 	vcinformerv1 "volcano.sh/apis/pkg/client/informers/externalversions/scheduling/v1beta1"
 	shardinformerv1alpha1 "volcano.sh/apis/pkg/client/informers/externalversions/shard/v1alpha1"
 	topologyinformerv1alpha1 "volcano.sh/apis/pkg/client/informers/externalversions/topology/v1alpha1"
@@ -75,6 +81,7 @@ import (
 	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/metrics/source"
+	schedulerutil "volcano.sh/volcano/pkg/scheduler/util"
 	schedulercache "volcano.sh/volcano/pkg/schedulercommon/cache"
 	"volcano.sh/volcano/pkg/util"
 )
@@ -104,6 +111,12 @@ func New(config *rest.Config, schedulerNames []string, defaultQueue string, node
 	return newSchedulerCache(config, schedulerNames, defaultQueue, nodeSelectors, nodeWorkers, ignoredProvisioners, resyncPeriod, resourceSyncTimeout)
 }
 
+// NewWithClients returns a Cache implementation watching the cluster through the given clients,
+// e.g. the fake clientsets of a simulated cluster when the scheduler is embedded in another program.
+func NewWithClients(kubeClient kubernetes.Interface, vcClient vcclient.Interface, schedulerNames []string, defaultQueue string, nodeSelectors []string, nodeWorkers uint32, ignoredProvisioners []string, resyncPeriod time.Duration, resourceSyncTimeout time.Duration) Cache {
+	return newSchedulerCacheWithClients(kubeClient, vcClient, kubeClient, nil, schedulerNames, defaultQueue, nodeSelectors, nodeWorkers, ignoredProvisioners, resyncPeriod, resourceSyncTimeout)
+}
+
 // SchedulerCache cache for the kube batch
 type SchedulerCache struct {
 	sync.Mutex
@@ -116,6 +129,10 @@ type SchedulerCache struct {
 	schedulerNames     []string
 	nodeSelectorLabels map[string]sets.Empty
 	metricsConf        map[string]string
+	// cycleID is the ID of the running scheduling cycle, the events are annotated with it
+	cycleID atomic.Value
+	// gateRemovals are the times the scheduling gates of the pending pods were removed, by pod UID
+	gateRemovals map[types.UID]metav1.Time
 
 	resyncPeriod               time.Duration
 	podInformer                infov1.PodInformer
@@ -134,6 +151,7 @@ type SchedulerCache struct {
 	csiStorageCapacityInformer storagev1.CSIStorageCapacityInformer
 	cpuInformer                cpuinformerv1.NumatopologyInformer
 	nodeShardInformer          shardinformerv1alpha1.NodeShardInformer
+	reservationInformer        schedulinginformerv1alpha1.ReservationInformer
 
 	Binder         Binder
 	Evictor        Evictor
@@ -198,6 +216,27 @@ type SchedulerCache struct {
 	resourceSyncTimeout time.Duration
 	// resourceClaimCache is a cache for ResourceClaims, used for DRA
 	resourceClaimCache *assumecache.AssumeCache
+
+	// burstQueues are the queues whose pending pods trigger a burst session without waiting for the schedule period.
+	burstQueues sets.Set[string]
+	// burstCh is notified when a pod of a burst queue is waiting for scheduling.
+	burstCh chan struct{}
+
+	// nodeQuarantine quarantines the nodes failing binds or evictions repeatedly, nil if disabled.
+	nodeQuarantine *nodeQuarantine
+
+	// gpuResetTimeout is the longest time the GPU tasks are not placed on a node waiting for the GPU cleanup,
+	// 0 disables the GPU cleanup requests.
+	gpuResetTimeout time.Duration
+	// gpuResetRequests are the GPU cleanup requests of the nodes not confirmed by their volcano agents yet,
+	// so the nodes wait for the cleanup before the requests are seen on them.
+	gpuResetRequests map[string]string
+
+	// victimGuardPeriod is the longest time the capacity freed by evicting victims is held for the job they were
+	// evicted for, 0 disables the guards.
+	victimGuardPeriod time.Duration
+	// victimGuards are the guards of the capacity freed by evicting victims by node.
+	victimGuards map[string][]*schedulingapi.VictimGuard
 }
 
 type multiSchedulerInfo struct {
@@ -216,6 +255,9 @@ type BindContextExtension interface{}
 
 type BindContext struct {
 	TaskInfo *schedulingapi.TaskInfo
+	// SessionUID is the UID of the session which allocated the task, it is the transition ID of the
+	// conditions recorded on the PodGroup when the task fails to bind.
+	SessionUID types.UID
 	// Extensions stores extra bind context information of each plugin
 	Extensions map[string]BindContextExtension
 }
@@ -390,6 +432,11 @@ func (su *defaultStatusUpdater) UpdateQueueStatus(queue *schedulingapi.QueueInfo
 	return nil
 }
 
+// UpdateReservationStatus will update the status of reservation
+func (su *defaultStatusUpdater) UpdateReservationStatus(reservation *schedulingv1alpha1.Reservation) (*schedulingv1alpha1.Reservation, error) {
+	return su.vcclient.SchedulingV1alpha1().Reservations().UpdateStatus(context.TODO(), reservation, metav1.UpdateOptions{})
+}
+
 type podgroupBinder struct {
 	kubeclient kubernetes.Interface
 	vcclient   vcclient.Interface
@@ -532,6 +579,10 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 		panic(fmt.Sprintf("failed init eventClient, with err: %v", err))
 	}
 
+	return newSchedulerCacheWithClients(kubeClient, vcClient, eventClient, config, schedulerNames, defaultQueue, nodeSelectors, nodeWorkers, ignoredProvisioners, resyncPeriod, resourceSyncTimeout)
+}
+
+func newSchedulerCacheWithClients(kubeClient kubernetes.Interface, vcClient vcclient.Interface, eventClient kubernetes.Interface, config *rest.Config, schedulerNames []string, defaultQueue string, nodeSelectors []string, nodeWorkers uint32, ignoredProvisioners []string, resyncPeriod time.Duration, resourceSyncTimeout time.Duration) *SchedulerCache {
 	// create default queue and root queue
 	klog.Infof("Creating default queue and root queue")
 	newDefaultAndRootQueue(vcClient, defaultQueue)
@@ -569,11 +620,20 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 		NodeList:            []string{},
 		nodeWorkers:         nodeWorkers,
 		resourceSyncTimeout: resourceSyncTimeout,
+		burstQueues:         sets.New[string](),
+		burstCh:             make(chan struct{}, 1),
 	}
 
 	if options.ServerOpts.ShardingMode == util.HardShardingMode || options.ServerOpts.ShardingMode == util.SoftShardingMode {
 		sc.shardUpdateCoordinator = NewShardUpdateCoordinator()
 	}
+	sc.nodeQuarantine = newNodeQuarantine(options.ServerOpts.NodeQuarantineThreshold,
+		options.ServerOpts.NodeQuarantineBackoff, options.ServerOpts.NodeQuarantineMaxBackoff)
+
+	sc.gpuResetTimeout = options.ServerOpts.GPUResetTimeout
+	sc.gpuResetRequests = map[string]string{}
+	sc.victimGuardPeriod = options.ServerOpts.VictimGuardPeriod
+	sc.victimGuards = map[string][]*schedulingapi.VictimGuard{}
 
 	sc.resyncPeriod = resyncPeriod
 	sc.schedulerPodName, sc.c = getMultiSchedulerInfo()
@@ -589,7 +649,8 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 	// Prepare event clients.
 	broadcaster := record.NewBroadcaster()
 	broadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: eventClient.CoreV1().Events("")})
-	sc.Recorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: util.GenerateComponentName(sc.schedulerNames)})
+	sc.Recorder = newCorrelatedRecorder(
+		broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: util.GenerateComponentName(sc.schedulerNames)}), &sc.cycleID)
 
 	// set concurrency configuration when binding
 	sc.setBatchBindParallel()
@@ -827,6 +888,12 @@ func (sc *SchedulerCache) addEventHandler() {
 		handlers["nodeShard"] = handlerRegistration
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(features.Reservation) {
+		// Reservations are listed when taking the snapshot, no event handler is needed.
+		sc.reservationInformer = sc.vcInformerFactory.Scheduling().V1alpha1().Reservations()
+		sc.reservationInformer.Informer()
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(kubefeatures.DynamicResourceAllocation) {
 		ctx := context.TODO()
 		logger := klog.FromContext(ctx)
@@ -967,11 +1034,46 @@ func (sc *SchedulerCache) Evict(taskInfo *schedulingapi.TaskInfo, reason string)
 	node.UpdateTask(task)
 
 	p := task.Pod
+	nodeName := task.NodeName
+	var victimAnnotations map[string]string
+	if taskInfo.VictimContext != nil {
+		victimAnnotations = buildVictimAnnotations(taskInfo.VictimContext, reason, task.Resreq)
+	}
+	var gpuResetRequest string
+	if sc.gpuResetRequired(job, task, taskInfo.VictimContext, node) {
+		gpuResetRequest = sc.newGPUResetRequest(nodeName, node.Node, time.Now())
+	}
+	guard := sc.guardVictim(nodeName, task, taskInfo.VictimContext, time.Now())
+	var checkpoint *schedulingapi.CheckpointPolicy
+	var migration *schedulingapi.Migration
+	if taskInfo.VictimContext != nil {
+		checkpoint = taskInfo.VictimContext.Checkpoint
+		migration = taskInfo.VictimContext.Migration
+	}
 
 	go func() {
-		err := sc.Evictor.Evict(p, reason)
+		// the migrated pods are checkpointed by the checkpoint/restore agent of their node
+		if checkpoint != nil && migration == nil {
+			sc.checkpointVictim(p, reason, checkpoint)
+		}
+		if len(victimAnnotations) != 0 {
+			p = sc.annotateVictim(p, podgroup, victimAnnotations)
+		}
+		var err error
+		if migration != nil {
+			err = sc.migrateVictim(p, reason, migration)
+		} else {
+			err = sc.Evictor.Evict(p, reason)
+		}
 		if err != nil {
 			sc.resyncTask(task)
+			sc.releaseVictimGuard(nodeName, guard)
+		}
+		if gpuResetRequest != "" {
+			sc.requestGPUReset(nodeName, gpuResetRequest, err)
+		}
+		if err == nil || evictionFailedOnNode(err) {
+			sc.recordNodeOperation(nodeName, nodeOperationEvict, err)
 		}
 	}()
 
@@ -979,6 +1081,63 @@ func (sc *SchedulerCache) Evict(taskInfo *schedulingapi.TaskInfo, reason string)
 	return nil
 }
 
+// buildVictimAnnotations returns the annotations recording the context of the eviction on the victim pod and its podgroup.
+func buildVictimAnnotations(victimContext *schedulingapi.VictimContext, reason string, freed *schedulingapi.Resource) map[string]string {
+	annotations := map[string]string{
+		schedulingapi.EvictedInSessionKey: victimContext.SessionID,
+		schedulingapi.EvictedReasonKey:    reason,
+	}
+	if victimContext.AggressorJob != "" {
+		annotations[schedulingapi.EvictedByJobKey] = string(victimContext.AggressorJob)
+	}
+	if victimContext.AggressorJobUID != "" {
+		annotations[schedulingapi.EvictedByJobUIDKey] = string(victimContext.AggressorJobUID)
+	}
+	if victimContext.AggressorQueue != "" {
+		annotations[schedulingapi.EvictedByQueueKey] = string(victimContext.AggressorQueue)
+	}
+
+	resources := schedulerutil.ConvertRes2ResList(freed)
+	names := make([]string, 0, len(resources))
+	for name, quantity := range resources {
+		if !quantity.IsZero() {
+			names = append(names, string(name))
+		}
+	}
+	sort.Strings(names)
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := resources[v1.ResourceName(name)]
+		pairs = append(pairs, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	annotations[schedulingapi.EvictedResourcesKey] = strings.Join(pairs, ",")
+	return annotations
+}
+
+// annotateVictim records the context of the eviction on the victim pod and its podgroup before the pod is deleted,
+// it returns the annotated pod, or the pod as is if it failed to be annotated.
+func (sc *SchedulerCache) annotateVictim(pod *v1.Pod, podgroup *vcv1beta1.PodGroup, annotations map[string]string) *v1.Pod {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		klog.Errorf("Failed to build victim annotations of pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+		return pod
+	}
+
+	if _, err := sc.vcClient.SchedulingV1beta1().PodGroups(podgroup.Namespace).Patch(context.TODO(),
+		podgroup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.Warningf("Failed to annotate podgroup <%s/%s> of victim pod <%s>: %v", podgroup.Namespace, podgroup.Name, pod.Name, err)
+	}
+	patched, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(),
+		pod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		klog.Warningf("Failed to annotate victim pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+		return pod
+	}
+	return patched
+}
+
 // Bind binds task to the target host.
 func (sc *SchedulerCache) Bind(ctx context.Context, bindContexts []*BindContext, preBinders map[string]PreBinder) {
 	readyToBindTasks := make([]*schedulingapi.TaskInfo, len(bindContexts))
@@ -993,11 +1152,16 @@ func (sc *SchedulerCache) Bind(ctx context.Context, bindContexts []*BindContext,
 		klog.V(3).Infof("There are %d tasks in total and %d binds failed, latency %v", len(readyToBindTasks), len(errMsg), time.Since(tmp))
 	}
 
+	var failedJobs []schedulingapi.JobID
+	bindFailures := map[schedulingapi.JobID][]*bindFailure{}
 	for _, bindContext := range bindContexts {
 		if reason, ok := errMsg[bindContext.TaskInfo.UID]; !ok {
+			sc.recordNodeOperation(bindContext.TaskInfo.NodeName, nodeOperationBind, nil)
+			sc.recordNodeBound(bindContext.TaskInfo.NodeName, tmp)
 			sc.Recorder.Eventf(bindContext.TaskInfo.Pod, v1.EventTypeNormal, "Scheduled", "Successfully assigned %v/%v to %v", bindContext.TaskInfo.Namespace, bindContext.TaskInfo.Name, bindContext.TaskInfo.NodeName)
 		} else {
 			unschedulableMsg := fmt.Sprintf("failed to bind to node %s: %s", bindContext.TaskInfo.NodeName, reason)
+			sc.recordNodeOperation(bindContext.TaskInfo.NodeName, nodeOperationBind, fmt.Errorf("%s", reason))
 			if err := sc.taskUnschedulable(bindContext.TaskInfo, schedulingapi.PodReasonSchedulerError, unschedulableMsg, ""); err != nil {
 				klog.ErrorS(err, "Failed to update pod status when bind task error", "task", bindContext.TaskInfo.Name)
 			}
@@ -1008,10 +1172,115 @@ func (sc *SchedulerCache) Bind(ctx context.Context, bindContexts []*BindContext,
 				}
 			}
 
+			if sc.rollbackBindTask(bindContext.TaskInfo) {
+				jobID := bindContext.TaskInfo.Job
+				if _, found := bindFailures[jobID]; !found {
+					failedJobs = append(failedJobs, jobID)
+				}
+				bindFailures[jobID] = append(bindFailures[jobID], &bindFailure{
+					task:       bindContext.TaskInfo,
+					sessionUID: bindContext.SessionUID,
+					message:    unschedulableMsg,
+				})
+			}
+
 			klog.V(2).Infof("resyncTask task %s", bindContext.TaskInfo.Name)
 			sc.resyncTask(bindContext.TaskInfo)
 		}
 	}
+
+	for _, jobID := range failedJobs {
+		sc.recordBindFailed(jobID, bindFailures[jobID])
+	}
+}
+
+// recordNodeBound records the time a task was bound to the node for the least-recently-bound node tie-breaker.
+func (sc *SchedulerCache) recordNodeBound(nodeName string, bound time.Time) {
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+	if node, found := sc.Nodes[nodeName]; found {
+		node.LastBound = metav1.NewTime(bound)
+	}
+}
+
+// bindFailure is a task of the job which failed to bind in a batch of binds.
+type bindFailure struct {
+	task       *schedulingapi.TaskInfo
+	sessionUID types.UID
+	message    string
+}
+
+// rollbackBindTask restores the task which failed to bind back to Pending in the cache, and releases
+// the resources it holds on the node, so that the node and queue accounting of the next session do not
+// count the task as allocated while it waits to be resynced from the api server.
+// It returns whether the task was rolled back.
+func (sc *SchedulerCache) rollbackBindTask(taskInfo *schedulingapi.TaskInfo) bool {
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+
+	job, task, err := sc.findJobAndTask(taskInfo)
+	if err != nil {
+		klog.ErrorS(err, "Failed to rollback bind task", "task", klog.KRef(taskInfo.Namespace, taskInfo.Name))
+		return false
+	}
+
+	// The task may have been updated by the informer in the meantime, only roll back a task which is still binding.
+	if task.Status != schedulingapi.Binding {
+		klog.V(3).Infof("Skip rolling back bind task <%s/%s> in status <%s>", task.Namespace, task.Name, task.Status)
+		return false
+	}
+
+	nodeName := task.NodeName
+	if node, found := sc.Nodes[nodeName]; found {
+		node.RemoveTask(task)
+	}
+	job.UpdateTaskStatus(task, schedulingapi.Pending)
+	task.NodeName = ""
+	klog.V(3).Infof("Rolled back bind task <%s/%s> on node <%s> to Pending", task.Namespace, task.Name, nodeName)
+	return true
+}
+
+// recordBindFailed records a single BindFailed condition on the PodGroup of the job for all its tasks which
+// failed to bind in the batch, the transition ID of the condition is the session which allocated them.
+func (sc *SchedulerCache) recordBindFailed(jobID schedulingapi.JobID, failures []*bindFailure) {
+	sc.Mutex.Lock()
+	var pg *schedulingapi.PodGroup
+	if job, found := sc.Jobs[jobID]; found && job.PodGroup != nil {
+		pg = job.PodGroup.Clone()
+	}
+	sc.Mutex.Unlock()
+
+	if pg == nil {
+		return
+	}
+	messages := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		messages = append(messages, fmt.Sprintf("task %s/%s: %s", failure.task.Namespace, failure.task.Name, failure.message))
+	}
+	cond := scheduling.PodGroupCondition{
+		Type:               scheduling.PodGroupBindFailedType,
+		Status:             v1.ConditionTrue,
+		TransitionID:       string(failures[0].sessionUID),
+		LastTransitionTime: metav1.Now(),
+		Reason:             scheduling.BindFailedReason,
+		Message:            strings.Join(messages, "; "),
+	}
+	setPodGroupCondition(pg, cond)
+	if _, err := sc.StatusUpdater.UpdatePodGroup(pg); err != nil {
+		klog.ErrorS(err, "Failed to record bind failed condition", "podGroup", klog.KRef(pg.Namespace, pg.Name))
+	}
+	sc.recordPodGroupEvent(pg, v1.EventTypeWarning, string(scheduling.PodGroupBindFailedType), cond.Message)
+}
+
+// setPodGroupCondition adds the condition to the status of pod group, or replaces the existing condition of the same type.
+func setPodGroupCondition(pg *schedulingapi.PodGroup, cond scheduling.PodGroupCondition) {
+	for i, c := range pg.Status.Conditions {
+		if c.Type == cond.Type {
+			pg.Status.Conditions[i] = cond
+			return
+		}
+	}
+	pg.Status.Conditions = append(pg.Status.Conditions, cond)
 }
 
 // BindPodGroup binds job to silo cluster
@@ -1494,6 +1763,7 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 		NodeList:             make([]string, len(sc.NodeList)),
 		CSINodesStatus:       make(map[string]*schedulingapi.CSINodeStatusInfo),
 		NodesInShard:         sets.Set[string]{},
+		Reservations:         make(map[string]*schedulingapi.ReservationInfo),
 	}
 
 	copy(snapshot.NodeList, sc.NodeList)
@@ -1506,12 +1776,22 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 		snapshot.CSINodesStatus[value.CSINodeName] = value.Clone()
 	}
 
+	now := time.Now()
 	for _, value := range sc.Nodes {
 		if !value.Ready() {
 			continue
 		}
+		if sc.nodeQuarantine.quarantined(value.Name, now) {
+			klog.V(4).Infof("Node <%s> is quarantined from placement, skip it in snapshot", value.Name)
+			continue
+		}
 
 		snapshot.Nodes[value.Name] = value.Clone()
+		if sc.gpuResetPending(value.Name, value.Node, now) {
+			klog.V(4).Infof("Node <%s> is waiting for the GPU cleanup, GPU tasks are not placed on it", value.Name)
+			snapshot.Nodes[value.Name].GPUResetPending = true
+		}
+		snapshot.Nodes[value.Name].VictimGuards = sc.activeVictimGuards(value.Name, now)
 
 		if value.RevocableZone != "" {
 			snapshot.RevocableNodes[value.Name] = snapshot.Nodes[value.Name]
@@ -1531,6 +1811,16 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 		snapshot.Queues[value.UID] = value.Clone()
 	}
 
+	if sc.reservationInformer != nil {
+		reservations, err := sc.reservationInformer.Lister().List(labels.Everything())
+		if err != nil {
+			klog.Errorf("Failed to list reservations: %v", err)
+		}
+		for _, reservation := range reservations {
+			snapshot.Reservations[reservation.Name] = schedulingapi.NewReservationInfo(reservation)
+		}
+	}
+
 	var cloneJobLock sync.Mutex
 	var wg sync.WaitGroup
 
@@ -1751,6 +2041,31 @@ func (sc *SchedulerCache) UpdateQueueStatus(queue *schedulingapi.QueueInfo) erro
 	return sc.StatusUpdater.UpdateQueueStatus(queue)
 }
 
+// UpdateReservationStatus update the status of reservation.
+func (sc *SchedulerCache) UpdateReservationStatus(reservation *schedulingapi.ReservationInfo) error {
+	newReservation := reservation.Reservation.DeepCopy()
+	if newReservation.Status.Phase != reservation.Phase {
+		newReservation.Status.LastTransitionTime = metav1.Now()
+	}
+	newReservation.Status.Phase = reservation.Phase
+
+	nodeNames := make([]string, 0, len(reservation.Nodes))
+	for name := range reservation.Nodes {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Strings(nodeNames)
+	newReservation.Status.Nodes = make([]schedulingv1alpha1.ReservedNode, 0, len(nodeNames))
+	for _, name := range nodeNames {
+		newReservation.Status.Nodes = append(newReservation.Status.Nodes, schedulingv1alpha1.ReservedNode{
+			NodeName:  name,
+			Resources: schedulerutil.ConvertRes2ResList(reservation.Nodes[name]),
+		})
+	}
+
+	_, err := sc.StatusUpdater.UpdateReservationStatus(newReservation)
+	return err
+}
+
 func (sc *SchedulerCache) recordPodGroupEvent(podGroup *schedulingapi.PodGroup, eventType, reason, msg string) {
 	if podGroup == nil {
 		return
@@ -1812,10 +2127,12 @@ func (sc *SchedulerCache) setMetricsData(usageInfo map[string]*source.NodeMetric
 		nodeUsage := &schedulingapi.NodeUsage{
 			CPUUsageAvg: make(map[string]float64),
 			MEMUsageAvg: make(map[string]float64),
+			GPUUsageAvg: make(map[string]float64),
 		}
 		nodeUsage.MetricsTime = nodeMetric.MetricsTime
 		nodeUsage.CPUUsageAvg[source.NODE_METRICS_PERIOD] = nodeMetric.CPU
 		nodeUsage.MEMUsageAvg[source.NODE_METRICS_PERIOD] = nodeMetric.Memory
+		nodeUsage.GPUUsageAvg[source.NODE_METRICS_PERIOD] = nodeMetric.GPU
 
 		nodeInfo, ok := sc.Nodes[nodeName]
 		if !ok {
@@ -1855,6 +2172,35 @@ func (sc *SchedulerCache) OnSessionClose() {
 	}
 }
 
+// SetBurstQueues sets the queues whose pending pods trigger a burst session.
+func (sc *SchedulerCache) SetBurstQueues(queues sets.Set[string]) {
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+	sc.burstQueues = queues
+}
+
+// BurstTrigger returns the channel notified when a pod of a burst queue is waiting for scheduling.
+func (sc *SchedulerCache) BurstTrigger() <-chan struct{} {
+	return sc.burstCh
+}
+
+// triggerBurst notifies the burst trigger if the job belongs to a burst queue and has pending tasks.
+// Assumes that lock is already acquired.
+func (sc *SchedulerCache) triggerBurst(jobID schedulingapi.JobID) {
+	job, found := sc.Jobs[jobID]
+	if !found || job.PodGroup == nil || !sc.burstQueues.Has(string(job.Queue)) {
+		return
+	}
+	if len(job.TaskStatusIndex[schedulingapi.Pending]) == 0 && job.PodGroup.Status.Phase != scheduling.PodGroupPending {
+		return
+	}
+	select {
+	case sc.burstCh <- struct{}{}:
+	default:
+		// A burst session is already pending, it will handle this job too.
+	}
+}
+
 func resolvePodClaimName(pod *v1.Pod, podClaim v1.PodResourceClaim) string {
 	if podClaim.ResourceClaimName != nil {
 		return *podClaim.ResourceClaimName
@@ -36,6 +36,7 @@ import (
 	kcache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
+	"volcano.sh/apis/pkg/apis/scheduling"
 	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	vcclient "volcano.sh/apis/pkg/client/clientset/versioned"
 	vcclientfake "volcano.sh/apis/pkg/client/clientset/versioned/fake"
@@ -221,6 +222,92 @@ func TestSchedulerCache_Bind_NodeWithInsufficientResources(t *testing.T) {
 	}
 }
 
+// podGroupStatusRecorder records the pod groups updated through the status updater.
+type podGroupStatusRecorder struct {
+	util.FakeStatusUpdater
+	podGroups []*api.PodGroup
+}
+
+func (r *podGroupStatusRecorder) UpdatePodGroup(pg *api.PodGroup) (*api.PodGroup, error) {
+	r.podGroups = append(r.podGroups, pg)
+	return pg, nil
+}
+
+func TestSchedulerCache_RollbackBindTask(t *testing.T) {
+	owner := buildOwnerReference("j1")
+	statusUpdater := &podGroupStatusRecorder{}
+
+	cache := &SchedulerCache{
+		Jobs:            make(map[api.JobID]*api.JobInfo),
+		Nodes:           make(map[string]*api.NodeInfo),
+		Binder:          util.NewFakeBinder(0),
+		BindFlowChannel: make(chan *BindContext, 5000),
+		StatusUpdater:   statusUpdater,
+		Recorder:        record.NewFakeRecorder(10),
+	}
+
+	pod := buildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1000m", "1G"),
+		[]metav1.OwnerReference{owner}, make(map[string]string))
+	cache.AddPod(pod)
+
+	node := buildNode("n1", api.BuildResourceList("2000m", "10G", []api.ScalarResource{{Name: "pods", Value: "10"}}...))
+	cache.AddOrUpdateNode(node)
+
+	task := api.NewTaskInfo(pod)
+	task.Job = "j1"
+	if err := cache.addTask(task); err != nil {
+		t.Fatalf("failed to add task %v", err)
+	}
+	cache.Jobs["j1"].SetPodGroup(&api.PodGroup{
+		PodGroup: scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "c1"}},
+		Version:  api.PodGroupVersionV1Beta1,
+	})
+
+	nodeBeforeBind := cache.Nodes["n1"].Clone()
+	task.NodeName = "n1"
+	if err := cache.AddBindTask(&BindContext{TaskInfo: task}); err != nil {
+		t.Fatalf("failed to bind pod to node: %v", err)
+	}
+
+	if !cache.rollbackBindTask(task) {
+		t.Fatalf("expected the binding task to be rolled back")
+	}
+
+	_, taskAfterRollback, err := cache.findJobAndTask(task)
+	if err != nil {
+		t.Fatalf("expected to find task after rollback: %v", err)
+	}
+	if taskAfterRollback.Status != api.Pending || taskAfterRollback.NodeName != "" {
+		t.Errorf("expected task to be Pending without node, got status <%v> node <%s>",
+			taskAfterRollback.Status, taskAfterRollback.NodeName)
+	}
+	nodeAfterRollback := cache.Nodes["n1"]
+	if !nodeAfterRollback.Idle.Equal(nodeBeforeBind.Idle, api.Zero) || !nodeAfterRollback.Used.Equal(nodeBeforeBind.Used, api.Zero) {
+		t.Errorf("expected node resources to be restored, idle <%v> used <%v>", nodeAfterRollback.Idle, nodeAfterRollback.Used)
+	}
+
+	// A second rollback of the same task is a no-op since the task is no longer binding.
+	if cache.rollbackBindTask(task) {
+		t.Errorf("expected the pending task not to be rolled back again")
+	}
+
+	// The failures of the tasks of the job are recorded in a single update of its pod group.
+	other := &api.TaskInfo{Namespace: "c1", Name: "p2"}
+	cache.recordBindFailed("j1", []*bindFailure{
+		{task: task, sessionUID: "s1", message: "bind failed"},
+		{task: other, sessionUID: "s1", message: "bind failed"},
+	})
+	if len(statusUpdater.podGroups) != 1 {
+		t.Fatalf("expected one pod group update, got %d", len(statusUpdater.podGroups))
+	}
+	conditions := statusUpdater.podGroups[0].Status.Conditions
+	if len(conditions) != 1 || conditions[0].Type != scheduling.PodGroupBindFailedType ||
+		conditions[0].Reason != scheduling.BindFailedReason || conditions[0].TransitionID != "s1" ||
+		conditions[0].Message != "task c1/p1: bind failed; task c1/p2: bind failed" {
+		t.Errorf("expected BindFailed condition of both tasks, got %v", conditions)
+	}
+}
+
 func TestNodeOperation(t *testing.T) {
 	// case 1
 	node1 := buildNode("n1", api.BuildResourceList("2000m", "10G"))
@@ -799,3 +886,73 @@ func TestAddUnassignedNumaPods_NilNodeValueSkips(t *testing.T) {
 	}
 	// No panic is the success condition.
 }
+
+// podRecordingEvictor records the pods passed to the evictor.
+type podRecordingEvictor struct {
+	pods chan *v1.Pod
+}
+
+func (e *podRecordingEvictor) Evict(pod *v1.Pod, reason string) error {
+	e.pods <- pod
+	return nil
+}
+
+func TestEvictAnnotatesVictim(t *testing.T) {
+	sc := NewDefaultMockSchedulerCache("volcano")
+	evictor := &podRecordingEvictor{pods: make(chan *v1.Pod, 1)}
+	sc.Evictor = evictor
+
+	pg := util.BuildPodGroup("pg1", "c1", "q1", 1, nil, vcv1beta1.PodGroupRunning)
+	pod := util.BuildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil)
+	if _, err := sc.vcClient.SchedulingV1beta1().PodGroups("c1").Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sc.kubeClient.CoreV1().Pods("c1").Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.AddOrUpdateNode(util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)); err != nil {
+		t.Fatal(err)
+	}
+	sc.AddPodGroupV1beta1(pg)
+	sc.AddPod(pod)
+
+	task := api.NewTaskInfo(pod)
+	task.VictimContext = &api.VictimContext{
+		AggressorJob:    "c1/pg2",
+		AggressorJobUID: "pg2-uid",
+		AggressorQueue:  "q2",
+		SessionID:       "session-1",
+	}
+	if err := sc.Evict(task, "reclaim"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		api.EvictedByJobKey:     "c1/pg2",
+		api.EvictedByJobUIDKey:  "pg2-uid",
+		api.EvictedByQueueKey:   "q2",
+		api.EvictedInSessionKey: "session-1",
+		api.EvictedReasonKey:    "reclaim",
+		api.EvictedResourcesKey: "cpu=2,memory=4Gi,pods=1",
+	}
+	select {
+	case evicted := <-evictor.pods:
+		for key, value := range expected {
+			if evicted.Annotations[key] != value {
+				t.Errorf("expected annotation %s=%s on the evicted pod, got %q", key, value, evicted.Annotations[key])
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the eviction")
+	}
+
+	annotated, err := sc.vcClient.SchedulingV1beta1().PodGroups("c1").Get(context.TODO(), "pg1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range expected {
+		if annotated.Annotations[key] != value {
+			t.Errorf("expected annotation %s=%s on the podgroup, got %q", key, value, annotated.Annotations[key])
+		}
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// checkpointPollInterval is the interval the victims are checked for the acknowledgment of their checkpoint.
+var checkpointPollInterval = time.Second
+
+// checkpointClient is the client of the checkpoint webhooks. It does not follow redirects, so that the scheduler only
+// posts to the webhooks of its configuration; a redirect fails the checkpoint.
+var checkpointClient = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// CheckpointRequest is the body of the request posted to the checkpoint webhook.
+type CheckpointRequest struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	Reason    string    `json:"reason"`
+	Deadline  time.Time `json:"deadline"`
+}
+
+// checkpointVictim gives the victim pod the chance to checkpoint before it is evicted: it annotates the pod with
+// the deadline of the checkpoint, then waits for the webhook of the policy to answer, or for the workload to
+// acknowledge by annotating the pod, until the deadline. The victim is evicted whatever the outcome.
+func (sc *SchedulerCache) checkpointVictim(pod *v1.Pod, reason string, policy *schedulingapi.CheckpointPolicy) {
+	deadline := time.Now().Add(policy.Timeout)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{
+			schedulingapi.CheckpointRequestedKey:    deadline.UTC().Format(time.RFC3339),
+			schedulingapi.CheckpointAcknowledgedKey: nil,
+		}},
+	})
+	if err != nil {
+		klog.Errorf("Failed to build checkpoint request of pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+		return
+	}
+	if _, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(),
+		pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.Warningf("Failed to request checkpoint of pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+		return
+	}
+
+	ctx, cancel := context.WithDeadline(context.TODO(), deadline)
+	defer cancel()
+	if policy.Webhook != "" {
+		err = callCheckpointWebhook(ctx, policy.Webhook, &CheckpointRequest{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			UID:       pod.UID,
+			Reason:    reason,
+			Deadline:  deadline,
+		})
+	} else {
+		err = sc.waitCheckpointAcknowledged(ctx, pod)
+	}
+	if err != nil {
+		klog.Warningf("Pod <%s/%s> did not checkpoint before it is evicted: %v", pod.Namespace, pod.Name, err)
+		sc.Recorder.Eventf(pod, v1.EventTypeWarning, "CheckpointTimeout", "Evicted without checkpoint: %v", err)
+		return
+	}
+	klog.V(3).Infof("Pod <%s/%s> checkpointed before it is evicted", pod.Namespace, pod.Name)
+}
+
+// waitCheckpointAcknowledged waits for the pod to be annotated with the acknowledgment of its checkpoint, or to be gone.
+func (sc *SchedulerCache) waitCheckpointAcknowledged(ctx context.Context, pod *v1.Pod) error {
+	return wait.PollUntilContextCancel(ctx, checkpointPollInterval, false, func(ctx context.Context) (bool, error) {
+		current, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			klog.V(4).Infof("Failed to get pod <%s/%s> waiting for its checkpoint: %v", pod.Namespace, pod.Name, err)
+			return false, nil
+		}
+		_, acknowledged := current.Annotations[schedulingapi.CheckpointAcknowledgedKey]
+		return acknowledged, nil
+	})
+}
+
+// callCheckpointWebhook posts the checkpoint request to the webhook, the checkpoint is done once it answers with success.
+func callCheckpointWebhook(ctx context.Context, url string, request *CheckpointRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := checkpointClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("checkpoint webhook answered %s", resp.Status)
+	}
+	return nil
+}
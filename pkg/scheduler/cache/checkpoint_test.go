@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestCheckpointVictim(t *testing.T) {
+	checkpointPollInterval = 10 * time.Millisecond
+	defer func() { checkpointPollInterval = time.Second }()
+
+	var requested *CheckpointRequest
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = &CheckpointRequest{}
+		if err := json.NewDecoder(r.Body).Decode(requested); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer webhook.Close()
+	failingWebhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingWebhook.Close()
+	redirectingWebhook := httptest.NewServer(http.RedirectHandler(webhook.URL, http.StatusTemporaryRedirect))
+	defer redirectingWebhook.Close()
+
+	tests := []struct {
+		name        string
+		policy      *api.CheckpointPolicy
+		acknowledge bool
+		expectEvent bool
+	}{
+		{
+			name:   "the webhook checkpoints the victim",
+			policy: &api.CheckpointPolicy{Timeout: time.Minute, Webhook: webhook.URL},
+		},
+		{
+			name:        "the failing webhook does not hold the eviction",
+			policy:      &api.CheckpointPolicy{Timeout: time.Minute, Webhook: failingWebhook.URL},
+			expectEvent: true,
+		},
+		{
+			name:        "the redirecting webhook is not followed",
+			policy:      &api.CheckpointPolicy{Timeout: time.Minute, Webhook: redirectingWebhook.URL},
+			expectEvent: true,
+		},
+		{
+			name:        "the victim acknowledges the checkpoint",
+			policy:      &api.CheckpointPolicy{Timeout: time.Minute},
+			acknowledge: true,
+		},
+		{
+			name:        "the victim not acknowledging is evicted after the timeout",
+			policy:      &api.CheckpointPolicy{Timeout: 100 * time.Millisecond},
+			expectEvent: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "c1", Name: "p1", UID: "p1-uid",
+				Annotations: map[string]string{api.CheckpointAcknowledgedKey: "stale"}}}
+			kubeClient := fake.NewSimpleClientset(pod)
+			recorder := record.NewFakeRecorder(10)
+			sc := &SchedulerCache{kubeClient: kubeClient, Recorder: recorder}
+			requested = nil
+
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				sc.checkpointVictim(pod, "reclaim", test.policy)
+				close(done)
+			}()
+
+			if test.acknowledge {
+				time.Sleep(50 * time.Millisecond)
+				current, err := kubeClient.CoreV1().Pods("c1").Get(context.TODO(), "p1", metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, found := current.Annotations[api.CheckpointRequestedKey]; !found {
+					t.Errorf("expected the pod annotated with the checkpoint request")
+				}
+				current.Annotations[api.CheckpointAcknowledgedKey] = "true"
+				if _, err := kubeClient.CoreV1().Pods("c1").Update(context.TODO(), current, metav1.UpdateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			select {
+			case <-done:
+			case <-time.After(10 * time.Second):
+				t.Fatalf("the checkpoint of the victim did not end")
+			}
+			if elapsed := time.Since(start); elapsed > test.policy.Timeout+time.Second {
+				t.Errorf("expected the checkpoint to end by its timeout, took %v", elapsed)
+			}
+			if test.policy.Webhook == webhook.URL && (requested == nil || requested.Name != "p1" || requested.Reason != "reclaim") {
+				t.Errorf("expected the webhook to be called for the victim, got %v", requested)
+			}
+			if test.policy.Webhook == redirectingWebhook.URL && requested != nil {
+				t.Errorf("expected the redirect of the webhook not to be followed, got %v", requested)
+			}
+			if events := len(recorder.Events); (events != 0) != test.expectEvent {
+				t.Errorf("expected event %v, got %d events", test.expectEvent, events)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// correlatedRecorder annotates the events with the ID of the running scheduling cycle,
+// so that the events of one cycle can be stitched together with its logs and audit records.
+type correlatedRecorder struct {
+	record.EventRecorder
+	cycleID *atomic.Value
+}
+
+// newCorrelatedRecorder wraps the recorder to annotate the events with the cycle ID stored in cycleID.
+func newCorrelatedRecorder(recorder record.EventRecorder, cycleID *atomic.Value) record.EventRecorder {
+	return &correlatedRecorder{
+		EventRecorder: recorder,
+		cycleID:       cycleID,
+	}
+}
+
+func (cr *correlatedRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	cr.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (cr *correlatedRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	cr.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (cr *correlatedRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	id, _ := cr.cycleID.Load().(string)
+	if id == "" {
+		if annotations == nil {
+			cr.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+			return
+		}
+		cr.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+		return
+	}
+
+	correlated := make(map[string]string, len(annotations)+1)
+	for k, v := range annotations {
+		correlated[k] = v
+	}
+	correlated[v1beta1.SchedulingCycleAnnotationKey] = id
+	cr.EventRecorder.AnnotatedEventf(object, correlated, eventtype, reason, messageFmt, args...)
+}
+
+// SetCorrelationID sets the ID of the running scheduling cycle, empty when no cycle is running.
+func (sc *SchedulerCache) SetCorrelationID(id string) {
+	sc.cycleID.Store(id)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"sync/atomic"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestCorrelatedRecorder(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	cycleID := &atomic.Value{}
+	recorder := newCorrelatedRecorder(fake, cycleID)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "p1"}}
+
+	tests := []struct {
+		name     string
+		cycleID  string
+		record   func()
+		expected string
+	}{
+		{
+			name:     "no cycle running",
+			cycleID:  "",
+			record:   func() { recorder.Event(pod, v1.EventTypeNormal, "Scheduled", "bound") },
+			expected: "Normal Scheduled bound",
+		},
+		{
+			name:     "event in a cycle",
+			cycleID:  "cycle-1",
+			record:   func() { recorder.Eventf(pod, v1.EventTypeWarning, "FailedScheduling", "%d nodes", 0) },
+			expected: "Warning FailedScheduling 0 nodes map[volcano.sh/scheduling-cycle:cycle-1]",
+		},
+		{
+			name:    "annotated event in a cycle",
+			cycleID: "cycle-2",
+			record: func() {
+				recorder.AnnotatedEventf(pod, map[string]string{"foo": "bar"}, v1.EventTypeNormal, "Evict", "preempted")
+			},
+			expected: "Normal Evict preempted map[foo:bar volcano.sh/scheduling-cycle:cycle-2]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cycleID.Store(test.cycleID)
+			test.record()
+			if event := <-fake.Events; event != test.expected {
+				t.Errorf("expected event %q, got %q", test.expected, event)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/component-helpers/storage/ephemeral"
@@ -244,6 +246,7 @@ func (sc *SchedulerCache) addTask(pi *schedulingapi.TaskInfo) error {
 
 func (sc *SchedulerCache) NewTaskInfo(pod *v1.Pod) (*schedulingapi.TaskInfo, error) {
 	taskInfo := schedulingapi.NewTaskInfo(pod)
+	taskInfo.GateRemovedTime = sc.gateRemovals[pod.UID]
 	if err := sc.addPodCSIVolumesToTask(taskInfo); err != nil {
 		return taskInfo, err
 	}
@@ -316,6 +319,30 @@ func (sc *SchedulerCache) updateTask(oldTask, newTask *schedulingapi.TaskInfo) e
 	return sc.addTask(newTask)
 }
 
+// ungatePod records the time the scheduling gates of the pod were removed,
+// and tells the users where its task is ordered among the pending tasks.
+func (sc *SchedulerCache) ungatePod(pod *v1.Pod) {
+	if sc.gateRemovals == nil {
+		sc.gateRemovals = map[types.UID]metav1.Time{}
+	}
+	sc.gateRemovals[pod.UID] = metav1.Now()
+
+	if sc.Recorder == nil {
+		return
+	}
+	var msg string
+	switch schedulingapi.GetUngatedTaskOrder(pod) {
+	case schedulingapi.UngatedOrderGateRemovalTime:
+		msg = "Scheduling gates removed, the task is ordered by the time its gates were removed, behind the tasks of the same priority pending before"
+	case schedulingapi.UngatedOrderBoost:
+		msg = "Scheduling gates removed, the task is ordered ahead of the tasks of the same priority"
+	default:
+		msg = fmt.Sprintf("Scheduling gates removed, the task is ordered by the creation time of the pod %s among the tasks of the same priority",
+			pod.CreationTimestamp.UTC().Format(time.RFC3339))
+	}
+	sc.Recorder.Event(pod, v1.EventTypeNormal, "SchedulingGatesRemoved", msg)
+}
+
 // Check the pod allocated status in cache
 func (sc *SchedulerCache) allocatedPodInCache(pod *v1.Pod) bool {
 	pi := schedulingapi.NewTaskInfo(pod)
@@ -340,6 +367,9 @@ func (sc *SchedulerCache) updatePod(oldPod, newPod *v1.Pod) error {
 	if err := sc.deletePod(oldPod); err != nil {
 		return err
 	}
+	if len(oldPod.Spec.SchedulingGates) != 0 && len(newPod.Spec.SchedulingGates) == 0 {
+		sc.ungatePod(newPod)
+	}
 	//when delete pod, the ownerreference of pod will be set nil, just as orphan pod
 	if len(utils.GetController(newPod)) == 0 {
 		newPod.OwnerReferences = oldPod.OwnerReferences
@@ -443,6 +473,9 @@ func (sc *SchedulerCache) AddPod(obj interface{}) {
 	}
 	if pod.Spec.NodeName == "" {
 		metrics.UpdateTaskScheduleDuration(metrics.TaskStageWatched, metrics.Duration(pod.CreationTimestamp.Time))
+		if gn := pod.Annotations[schedulingv1beta1.KubeGroupNameAnnotationKey]; gn != "" {
+			sc.triggerBurst(schedulingapi.JobID(fmt.Sprintf("%s/%s", pod.Namespace, gn)))
+		}
 	}
 	klog.V(3).Infof("Added pod <%s/%v> into cache.", pod.Namespace, pod.Name)
 }
@@ -494,6 +527,7 @@ func (sc *SchedulerCache) DeletePod(obj interface{}) {
 	defer sc.Mutex.Unlock()
 
 	sc.clearUnassignedNumaPod(pod)
+	delete(sc.gateRemovals, pod.UID)
 	err := sc.deletePod(pod)
 	if err != nil {
 		klog.Errorf("Failed to delete pod %v from cache: %v", pod.Name, err)
@@ -596,6 +630,8 @@ func (sc *SchedulerCache) RemoveNode(nodeName string) error {
 		}
 	}
 	delete(sc.Nodes, nodeName)
+	sc.nodeQuarantine.forget(nodeName)
+	delete(sc.gpuResetRequests, nodeName)
 	return nil
 }
 
@@ -898,6 +934,7 @@ func (sc *SchedulerCache) AddPodGroupV1beta1(obj interface{}) {
 		klog.Errorf("Failed to add PodGroup %s into cache: %v", ss.Name, err)
 		return
 	}
+	sc.triggerBurst(getJobID(pg))
 }
 
 // UpdatePodGroupV1beta1 add podgroup to scheduler cache
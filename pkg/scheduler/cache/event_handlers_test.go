@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/cpuset"
 
@@ -149,6 +150,40 @@ func TestSchedulerCache_UpdatePod(t *testing.T) {
 	}
 }
 
+func TestSchedulerCache_UngatePod(t *testing.T) {
+	owner := buildOwnerReference("j1")
+	oldPod := buildPod("test", "p1", "", v1.PodPending, api.BuildResourceList("1000m", "1G"), []metav1.OwnerReference{owner}, make(map[string]string))
+	oldPod.Annotations = map[string]string{
+		schedulingv1.KubeGroupNameAnnotationKey: "pg1",
+		schedulingv1.UngatedTaskOrderKey:        string(api.UngatedOrderBoost),
+	}
+	oldPod.Spec.SchedulingGates = []v1.PodSchedulingGate{{Name: "example.com/gate"}}
+	newPod := oldPod.DeepCopy()
+	newPod.Spec.SchedulingGates = nil
+
+	recorder := record.NewFakeRecorder(10)
+	cache := newMockSchedulerCache("volcano")
+	cache.Recorder = recorder
+	cache.AddPod(oldPod)
+	if err := cache.updatePod(oldPod, newPod); err != nil {
+		t.Fatalf("failed to update pod: %v", err)
+	}
+
+	task := cache.Jobs["test/pg1"].Tasks[api.TaskID(newPod.UID)]
+	assert.False(t, task.GateRemovedTime.IsZero(), "expected the gate removal time to be recorded")
+	assert.Contains(t, <-recorder.Events, "SchedulingGatesRemoved")
+
+	// the gate removal time is kept by the later updates of the pod
+	removed := task.GateRemovedTime
+	if err := cache.updatePod(newPod, newPod.DeepCopy()); err != nil {
+		t.Fatalf("failed to update pod: %v", err)
+	}
+	assert.Equal(t, removed, cache.Jobs["test/pg1"].Tasks[api.TaskID(newPod.UID)].GateRemovedTime)
+
+	cache.DeletePod(newPod)
+	assert.Empty(t, cache.gateRemovals)
+}
+
 func TestSchedulerCache_AddPodGroupV1beta1(t *testing.T) {
 	namespace := "test"
 	owner := buildOwnerReference("j1")
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// gpuResetRequired returns whether the GPUs of the node must be cleaned up before they are used by the GPU tasks
+// of other queues after evicting the task: the task uses GPUs, it is evicted for a task of another queue, and the
+// volcano agent of the node cleans up the GPUs on request.
+func (sc *SchedulerCache) gpuResetRequired(job *schedulingapi.JobInfo, task *schedulingapi.TaskInfo,
+	victimContext *schedulingapi.VictimContext, node *schedulingapi.NodeInfo) bool {
+	if sc.gpuResetTimeout <= 0 || victimContext == nil || node.Node == nil {
+		return false
+	}
+	if victimContext.AggressorQueue == "" || victimContext.AggressorQueue == job.Queue || !task.Resreq.HasGPU() {
+		return false
+	}
+	_, found := node.Node.Annotations[vcv1beta1.GPUResetAgentAnnotationKey]
+	return found
+}
+
+// newGPUResetRequest returns the GPU cleanup request of the node. The request not confirmed yet is reused,
+// as the volcano agent cleans up the GPUs once all the evicted GPU tasks are gone.
+func (sc *SchedulerCache) newGPUResetRequest(nodeName string, node *v1.Node, now time.Time) string {
+	if request, found := sc.gpuResetRequests[nodeName]; found && sc.gpuResetWaiting(request, node, now) {
+		return request
+	}
+	request := now.UTC().Format(time.RFC3339Nano)
+	sc.gpuResetRequests[nodeName] = request
+	return request
+}
+
+// requestGPUReset annotates the node with the GPU cleanup request for its volcano agent,
+// the request is dropped if the eviction failed.
+func (sc *SchedulerCache) requestGPUReset(nodeName, request string, evictErr error) {
+	if evictErr != nil {
+		sc.Mutex.Lock()
+		if sc.gpuResetRequests[nodeName] == request {
+			delete(sc.gpuResetRequests, nodeName)
+		}
+		sc.Mutex.Unlock()
+		return
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{vcv1beta1.GPUResetRequestAnnotationKey: request},
+		},
+	})
+	if err != nil {
+		klog.Errorf("Failed to build GPU cleanup request of node <%s>: %v", nodeName, err)
+		return
+	}
+	if _, err := sc.kubeClient.CoreV1().Nodes().Patch(context.TODO(), nodeName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.Warningf("Failed to request GPU cleanup of node <%s>: %v", nodeName, err)
+		return
+	}
+	klog.V(3).Infof("Requested GPU cleanup <%s> of node <%s>", request, nodeName)
+}
+
+// gpuResetPending returns whether the node is waiting for its volcano agent to confirm the GPU cleanup,
+// by the request annotated on the node or the request not seen on the node yet.
+func (sc *SchedulerCache) gpuResetPending(nodeName string, node *v1.Node, now time.Time) bool {
+	if sc.gpuResetTimeout <= 0 || node == nil {
+		return false
+	}
+	if request, found := sc.gpuResetRequests[nodeName]; found {
+		if sc.gpuResetWaiting(request, node, now) {
+			return true
+		}
+		delete(sc.gpuResetRequests, nodeName)
+	}
+	return sc.gpuResetWaiting(node.Annotations[vcv1beta1.GPUResetRequestAnnotationKey], node, now)
+}
+
+// gpuResetWaiting returns whether the GPU cleanup request is neither confirmed by the volcano agent of the node
+// nor timed out.
+func (sc *SchedulerCache) gpuResetWaiting(request string, node *v1.Node, now time.Time) bool {
+	if request == "" || node.Annotations[vcv1beta1.GPUResetDoneAnnotationKey] == request {
+		return false
+	}
+	requestTime, err := time.Parse(time.RFC3339Nano, request)
+	if err != nil {
+		klog.V(4).Infof("Invalid GPU cleanup request <%s> of node <%s>: %v", request, node.Name, err)
+		return false
+	}
+	return now.Before(requestTime.Add(sc.gpuResetTimeout))
+}
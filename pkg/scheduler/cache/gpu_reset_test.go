@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestGPUResetRequired(t *testing.T) {
+	sc := &SchedulerCache{gpuResetTimeout: time.Minute, gpuResetRequests: map[string]string{}}
+	agentNode := api.NewNodeInfo(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1",
+		Annotations: map[string]string{vcv1beta1.GPUResetAgentAnnotationKey: "true"}}})
+	plainNode := api.NewNodeInfo(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n2"}})
+	job := &api.JobInfo{Queue: "q1"}
+	gpuTask := &api.TaskInfo{Resreq: api.NewResource(api.BuildResourceListWithGPU("1", "1Gi", "1"))}
+	cpuTask := &api.TaskInfo{Resreq: api.NewResource(api.BuildResourceList("1", "1Gi"))}
+
+	tests := []struct {
+		name     string
+		task     *api.TaskInfo
+		victim   *api.VictimContext
+		node     *api.NodeInfo
+		expected bool
+	}{
+		{name: "gpu task evicted for another queue", task: gpuTask, victim: &api.VictimContext{AggressorQueue: "q2"}, node: agentNode, expected: true},
+		{name: "gpu task evicted for the same queue", task: gpuTask, victim: &api.VictimContext{AggressorQueue: "q1"}, node: agentNode, expected: false},
+		{name: "gpu task evicted without aggressor", task: gpuTask, victim: nil, node: agentNode, expected: false},
+		{name: "cpu task evicted for another queue", task: cpuTask, victim: &api.VictimContext{AggressorQueue: "q2"}, node: agentNode, expected: false},
+		{name: "node without gpu reset agent", task: gpuTask, victim: &api.VictimContext{AggressorQueue: "q2"}, node: plainNode, expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if required := sc.gpuResetRequired(job, test.task, test.victim, test.node); required != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, required)
+			}
+		})
+	}
+}
+
+func TestGPUResetPending(t *testing.T) {
+	sc := &SchedulerCache{gpuResetTimeout: time.Minute, gpuResetRequests: map[string]string{}}
+	now := time.Now()
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Annotations: map[string]string{}}}
+
+	request := sc.newGPUResetRequest("n1", node, now)
+	if !sc.gpuResetPending("n1", node, now) {
+		t.Fatalf("expected the node to wait for the request not seen on it yet")
+	}
+	if reused := sc.newGPUResetRequest("n1", node, now.Add(time.Second)); reused != request {
+		t.Errorf("expected the pending request %s to be reused, got %s", request, reused)
+	}
+
+	node.Annotations[vcv1beta1.GPUResetRequestAnnotationKey] = request
+	if !sc.gpuResetPending("n1", node, now.Add(30*time.Second)) {
+		t.Errorf("expected the node to wait for the confirmation of the request")
+	}
+	if sc.gpuResetPending("n1", node, now.Add(2*time.Minute)) {
+		t.Errorf("expected the request to time out")
+	}
+
+	sc.newGPUResetRequest("n1", node, now)
+	node.Annotations[vcv1beta1.GPUResetDoneAnnotationKey] = request
+	if sc.gpuResetPending("n1", node, now.Add(time.Second)) {
+		t.Errorf("expected the node not to wait after the request is confirmed")
+	}
+	if _, found := sc.gpuResetRequests["n1"]; found {
+		t.Errorf("expected the confirmed request to be dropped")
+	}
+
+	sc.gpuResetTimeout = 0
+	node.Annotations[vcv1beta1.GPUResetRequestAnnotationKey] = now.UTC().Format(time.RFC3339Nano)
+	if sc.gpuResetPending("n1", node, now) {
+		t.Errorf("expected no waiting when the gpu reset is disabled")
+	}
+}
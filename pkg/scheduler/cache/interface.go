@@ -25,12 +25,14 @@ import (
 	"context"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	fwk "k8s.io/kube-scheduler/framework"
 
+	schedulingv1alpha1 "volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	nodeshardv1alpha1 "volcano.sh/apis/pkg/apis/shard/v1alpha1"
 	vcclient "volcano.sh/apis/pkg/client/clientset/versioned"
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -68,6 +70,9 @@ type Cache interface {
 	// UpdateQueueStatus update queue status.
 	UpdateQueueStatus(queue *api.QueueInfo) error
 
+	// UpdateReservationStatus update reservation status.
+	UpdateReservationStatus(reservation *api.ReservationInfo) error
+
 	// Client returns the kubernetes clientSet, which can be used by plugins
 	Client() kubernetes.Interface
 
@@ -107,6 +112,15 @@ type Cache interface {
 
 	//OnSessionClose is called after session close
 	OnSessionClose()
+
+	// SetCorrelationID sets the ID of the running scheduling cycle, which annotates the events recorded during the cycle
+	SetCorrelationID(id string)
+
+	// SetBurstQueues sets the queues whose pending pods trigger a burst session
+	SetBurstQueues(queues sets.Set[string])
+
+	// BurstTrigger returns the channel notified when a pod of a burst queue is waiting for scheduling
+	BurstTrigger() <-chan struct{}
 }
 
 // Binder interface for binding task and hostname
@@ -124,6 +138,7 @@ type StatusUpdater interface {
 	UpdatePodStatus(pod *v1.Pod) (*v1.Pod, error)
 	UpdatePodGroup(pg *api.PodGroup) (*api.PodGroup, error)
 	UpdateQueueStatus(queue *api.QueueInfo) error
+	UpdateReservationStatus(reservation *schedulingv1alpha1.Reservation) (*schedulingv1alpha1.Reservation, error)
 	UpdateNodeShardStatus(nodeshard *nodeshardv1alpha1.NodeShard) (*nodeshardv1alpha1.NodeShard, error)
 }
 
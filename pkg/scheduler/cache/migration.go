@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// migrationPollInterval is the interval the victims are checked for their checkpoint during a migration.
+var migrationPollInterval = time.Second
+
+// migrateVictim moves the victim pod to the node of the migration: the checkpoint/restore agent of its node is asked
+// to checkpoint it, then the pod is evicted with the target node and the checkpoint in its annotations. The job
+// controller recreates it bound to the target node, for the agent of the node to restore it from the checkpoint,
+// without firing the lifecycle policies of the eviction. The pod is only evicted when it is not checkpointed in time,
+// or when it is not controlled by a volcano job.
+func (sc *SchedulerCache) migrateVictim(pod *v1.Pod, reason string, migration *schedulingapi.Migration) error {
+	if !controlledByJob(pod) {
+		klog.V(3).Infof("Pod <%s/%s> is not controlled by a volcano job, evict it instead of migrating it", pod.Namespace, pod.Name)
+		return sc.Evictor.Evict(pod, reason)
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), migration.Timeout)
+	defer cancel()
+
+	if _, err := sc.checkpointForMigration(ctx, pod, migration.NodeName); err != nil {
+		klog.Warningf("Failed to checkpoint pod <%s/%s> for its migration to node <%s>, evict it: %v",
+			pod.Namespace, pod.Name, migration.NodeName, err)
+		sc.Recorder.Eventf(pod, v1.EventTypeWarning, "MigrationFailed", "Evicted without checkpoint: %v", err)
+		return sc.Evictor.Evict(pod, reason)
+	}
+	if err := sc.Evictor.Evict(pod, reason); err != nil {
+		return err
+	}
+	klog.V(3).Infof("Evicted checkpointed pod <%s/%s> for its migration from node <%s> to node <%s>",
+		pod.Namespace, pod.Name, pod.Spec.NodeName, migration.NodeName)
+	sc.Recorder.Eventf(pod, v1.EventTypeNormal, "Migrating", "Checkpointed for its migration from node %s to node %s", pod.Spec.NodeName, migration.NodeName)
+	return nil
+}
+
+// controlledByJob returns whether the pod is controlled by a volcano job, whose controller recreates the migrated pods.
+func controlledByJob(pod *v1.Pod) bool {
+	owner := metav1.GetControllerOf(pod)
+	return owner != nil && owner.Kind == helpers.JobKind.Kind && owner.APIVersion == helpers.JobKind.GroupVersion().String()
+}
+
+// checkpointForMigration asks the checkpoint/restore agent of the node of the pod to checkpoint it, and returns the
+// reference of the checkpoint once the agent annotates the pod with it.
+func (sc *SchedulerCache) checkpointForMigration(ctx context.Context, pod *v1.Pod, nodeName string) (string, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": map[string]interface{}{
+			vcv1beta1.MigrationTargetKey:     nodeName,
+			vcv1beta1.MigrationCheckpointKey: nil,
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	if _, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Patch(ctx,
+		pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return "", err
+	}
+
+	var checkpoint string
+	err = wait.PollUntilContextCancel(ctx, migrationPollInterval, false, func(ctx context.Context) (bool, error) {
+		current, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, fmt.Errorf("pod deleted before it is checkpointed")
+			}
+			return false, nil
+		}
+		checkpoint = current.Annotations[vcv1beta1.MigrationCheckpointKey]
+		return checkpoint != "", nil
+	})
+	return checkpoint, err
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/apis/pkg/apis/helpers"
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// deletingEvictor deletes the evicted pods, and keeps them as they were when evicted.
+type deletingEvictor struct {
+	kubeClient kubernetes.Interface
+	evicted    []*v1.Pod
+}
+
+func (e *deletingEvictor) Evict(pod *v1.Pod, reason string) error {
+	current, err := e.kubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	e.evicted = append(e.evicted, current)
+	return e.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+}
+
+func TestMigrateVictim(t *testing.T) {
+	migrationPollInterval = 10 * time.Millisecond
+	defer func() { migrationPollInterval = time.Second }()
+
+	jobOwner := *metav1.NewControllerRef(&metav1.ObjectMeta{Namespace: "c1", Name: "j1", UID: "j1-uid"}, helpers.JobKind)
+	tests := []struct {
+		name       string
+		owners     []metav1.OwnerReference
+		checkpoint string
+		// expectTarget is the migration target annotated on the evicted pod, empty if it is only evicted
+		expectTarget string
+	}{
+		{
+			name:         "the checkpointed pod is evicted for its job controller to recreate it on the target node",
+			owners:       []metav1.OwnerReference{jobOwner},
+			checkpoint:   "registry.local/checkpoints/p1:1",
+			expectTarget: "n2",
+		},
+		{
+			name:   "the pod not checkpointed in time is only evicted",
+			owners: []metav1.OwnerReference{jobOwner},
+		},
+		{
+			name: "the pod not controlled by a volcano job is only evicted",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "c1", Name: "p1", UID: "p1-uid", OwnerReferences: test.owners,
+					Annotations: map[string]string{vcv1beta1.MigratableKey: "true"}},
+				Spec: v1.PodSpec{NodeName: "n1"},
+			}
+			kubeClient := fake.NewSimpleClientset(pod)
+			evictor := &deletingEvictor{kubeClient: kubeClient}
+			sc := &SchedulerCache{kubeClient: kubeClient, Recorder: record.NewFakeRecorder(10), Evictor: evictor}
+
+			done := make(chan error)
+			go func() {
+				done <- sc.migrateVictim(pod, "defrag", &api.Migration{NodeName: "n2", Timeout: 200 * time.Millisecond})
+			}()
+			if test.checkpoint != "" {
+				// the checkpoint/restore agent checkpoints the pod on request
+				time.Sleep(50 * time.Millisecond)
+				current, err := kubeClient.CoreV1().Pods("c1").Get(context.TODO(), "p1", metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if target := current.Annotations[vcv1beta1.MigrationTargetKey]; target != "n2" {
+					t.Fatalf("expected the checkpoint requested for the migration to n2, got %q", target)
+				}
+				current.Annotations[vcv1beta1.MigrationCheckpointKey] = test.checkpoint
+				if _, err := kubeClient.CoreV1().Pods("c1").Update(context.TODO(), current, metav1.UpdateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			case <-time.After(10 * time.Second):
+				t.Fatalf("the migration did not end")
+			}
+
+			if _, err := kubeClient.CoreV1().Pods("c1").Get(context.TODO(), "p1", metav1.GetOptions{}); err == nil {
+				t.Fatalf("expected the pod evicted")
+			}
+			if len(evictor.evicted) != 1 {
+				t.Fatalf("expected the pod evicted once, got %d evictions", len(evictor.evicted))
+			}
+			evicted := evictor.evicted[0]
+			if test.expectTarget == "" {
+				if test.owners == nil && evicted.Annotations[vcv1beta1.MigrationTargetKey] != "" {
+					t.Errorf("expected no checkpoint requested for the pod not controlled by a volcano job")
+				}
+				return
+			}
+			if target := evicted.Annotations[vcv1beta1.MigrationTargetKey]; target != test.expectTarget {
+				t.Errorf("expected the pod evicted for its migration to %s, got %q", test.expectTarget, target)
+			}
+			if checkpoint := evicted.Annotations[vcv1beta1.MigrationCheckpointKey]; checkpoint != test.checkpoint {
+				t.Errorf("expected the pod evicted with checkpoint %s, got %q", test.checkpoint, checkpoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	nodeOperationBind  = "bind"
+	nodeOperationEvict = "evict"
+
+	// NodeQuarantinedReason is the reason of the event recorded on a node quarantined from placement.
+	NodeQuarantinedReason = "NodeQuarantined"
+)
+
+// nodeFailures are the failed binds and evictions of a node.
+type nodeFailures struct {
+	// failures is the number of consecutive failures since the last success or quarantine.
+	failures    int
+	lastFailure time.Time
+	// quarantines is the number of consecutive quarantines, the backoff doubles with each one.
+	quarantines int
+	// until is the end of the quarantine, zero if the node is not quarantined.
+	until time.Time
+}
+
+// nodeQuarantine quarantines the nodes from placement when their binds or evictions fail repeatedly,
+// e.g. when the kubelet is wedged or an admission webhook rejects the pods of the node, so that the
+// sessions do not keep placing tasks on a broken node.
+type nodeQuarantine struct {
+	sync.Mutex
+	// threshold is the number of consecutive failures quarantining a node.
+	threshold int
+	// backoff is the duration of the first quarantine of a node, doubled for each following quarantine
+	// up to maxBackoff until a bind or an eviction succeeds on the node.
+	backoff    time.Duration
+	maxBackoff time.Duration
+	nodes      map[string]*nodeFailures
+}
+
+// newNodeQuarantine returns the quarantine of the nodes, nil if the threshold is not positive.
+func newNodeQuarantine(threshold int, backoff, maxBackoff time.Duration) *nodeQuarantine {
+	if threshold <= 0 || backoff <= 0 {
+		return nil
+	}
+	if maxBackoff < backoff {
+		maxBackoff = backoff
+	}
+	return &nodeQuarantine{
+		threshold:  threshold,
+		backoff:    backoff,
+		maxBackoff: maxBackoff,
+		nodes:      map[string]*nodeFailures{},
+	}
+}
+
+// recordFailure records a failed operation on the node, and returns the duration of the quarantine
+// if the node is quarantined by this failure, 0 otherwise.
+func (nq *nodeQuarantine) recordFailure(nodeName string, now time.Time) time.Duration {
+	if nq == nil || nodeName == "" {
+		return 0
+	}
+	nq.Lock()
+	defer nq.Unlock()
+
+	record, found := nq.nodes[nodeName]
+	if !found {
+		record = &nodeFailures{}
+		nq.nodes[nodeName] = record
+	}
+	if now.Before(record.until) {
+		// the operations decided before the quarantine are still failing
+		return 0
+	}
+	// the failures too far apart are not consecutive failures of a broken node
+	if now.Sub(record.lastFailure) > nq.maxBackoff {
+		record.failures = 0
+	}
+	record.failures++
+	record.lastFailure = now
+	if record.failures < nq.threshold {
+		return 0
+	}
+
+	backoff := nq.backoff
+	for i := 0; i < record.quarantines && backoff < nq.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > nq.maxBackoff {
+		backoff = nq.maxBackoff
+	}
+	record.failures = 0
+	record.quarantines++
+	record.until = now.Add(backoff)
+	return backoff
+}
+
+// recordSuccess records a successful operation on the node, which resets its failures and backoff.
+// The current quarantine of the node is kept until it expires.
+func (nq *nodeQuarantine) recordSuccess(nodeName string) {
+	if nq == nil {
+		return
+	}
+	nq.Lock()
+	defer nq.Unlock()
+
+	record, found := nq.nodes[nodeName]
+	if !found {
+		return
+	}
+	if record.until.IsZero() {
+		delete(nq.nodes, nodeName)
+		return
+	}
+	record.failures = 0
+	record.quarantines = 0
+}
+
+// quarantined returns whether the node is quarantined at the time, the expired quarantines are lifted.
+func (nq *nodeQuarantine) quarantined(nodeName string, now time.Time) bool {
+	if nq == nil {
+		return false
+	}
+	nq.Lock()
+	defer nq.Unlock()
+
+	record, found := nq.nodes[nodeName]
+	if !found || record.until.IsZero() {
+		return false
+	}
+	if now.Before(record.until) {
+		return true
+	}
+	klog.V(2).Infof("Quarantine of node <%s> is lifted", nodeName)
+	metrics.UpdateNodeQuarantined(nodeName, false)
+	record.until = time.Time{}
+	if record.quarantines == 0 {
+		// the node has succeeded since it was quarantined
+		delete(nq.nodes, nodeName)
+	}
+	return false
+}
+
+// forget drops the records of a deleted node.
+func (nq *nodeQuarantine) forget(nodeName string) {
+	if nq == nil {
+		return
+	}
+	nq.Lock()
+	defer nq.Unlock()
+
+	if record, found := nq.nodes[nodeName]; found && !record.until.IsZero() {
+		metrics.UpdateNodeQuarantined(nodeName, false)
+	}
+	delete(nq.nodes, nodeName)
+}
+
+// evictionFailedOnNode returns whether the failed eviction points at the node rather than at the pod or at a policy:
+// the pods already deleted, the evictions rejected by a disruption budget or an admission webhook, and the conflicting
+// updates of the pod fail the same on any node.
+func evictionFailedOnNode(err error) bool {
+	return !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) && !apierrors.IsConflict(err) &&
+		!apierrors.IsForbidden(err) && !apierrors.IsInvalid(err) && !apierrors.IsBadRequest(err)
+}
+
+// recordNodeOperation records the result of a bind or an eviction on the node, and quarantines the node
+// from placement when its operations fail repeatedly.
+func (sc *SchedulerCache) recordNodeOperation(nodeName, operation string, err error) {
+	if err == nil {
+		sc.nodeQuarantine.recordSuccess(nodeName)
+		return
+	}
+	metrics.RegisterNodeOperationFailure(operation)
+	backoff := sc.nodeQuarantine.recordFailure(nodeName, time.Now())
+	if backoff == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("Node is quarantined from placement for %v, %d consecutive binds or evictions failed, last %s failure: %v",
+		backoff, sc.nodeQuarantine.threshold, operation, err)
+	klog.Warningf("Node <%s>: %s", nodeName, message)
+	metrics.UpdateNodeQuarantined(nodeName, true)
+
+	sc.Mutex.Lock()
+	var node *v1.Node
+	if nodeInfo, found := sc.Nodes[nodeName]; found {
+		node = nodeInfo.Node
+	}
+	sc.Mutex.Unlock()
+	if node != nil && sc.Recorder != nil {
+		sc.Recorder.Eventf(node, v1.EventTypeWarning, NodeQuarantinedReason, "%s", message)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestNodeQuarantine(t *testing.T) {
+	nq := newNodeQuarantine(2, time.Minute, 3*time.Minute)
+	now := time.Now()
+
+	if backoff := nq.recordFailure("n1", now); backoff != 0 {
+		t.Fatalf("expected no quarantine below the threshold, got %v", backoff)
+	}
+	if backoff := nq.recordFailure("n1", now.Add(time.Second)); backoff != time.Minute {
+		t.Fatalf("expected the first quarantine to last 1m, got %v", backoff)
+	}
+	if !nq.quarantined("n1", now.Add(30*time.Second)) || nq.quarantined("n2", now) {
+		t.Errorf("expected only n1 to be quarantined")
+	}
+	// the failures of the operations decided before the quarantine are not counted
+	if backoff := nq.recordFailure("n1", now.Add(30*time.Second)); backoff != 0 {
+		t.Errorf("expected no quarantine during the quarantine, got %v", backoff)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if nq.quarantined("n1", now) {
+		t.Fatalf("expected the quarantine of n1 to be lifted")
+	}
+	nq.recordFailure("n1", now)
+	if backoff := nq.recordFailure("n1", now); backoff != 2*time.Minute {
+		t.Fatalf("expected the second quarantine to last 2m, got %v", backoff)
+	}
+	now = now.Add(3 * time.Minute)
+	nq.recordFailure("n1", now)
+	if backoff := nq.recordFailure("n1", now); backoff != 3*time.Minute {
+		t.Fatalf("expected the third quarantine to be capped to 3m, got %v", backoff)
+	}
+
+	// a success resets the backoff, the current quarantine is kept
+	nq.recordSuccess("n1")
+	if !nq.quarantined("n1", now) {
+		t.Errorf("expected n1 to stay quarantined after a success")
+	}
+	now = now.Add(4 * time.Minute)
+	if nq.quarantined("n1", now) {
+		t.Errorf("expected the quarantine of n1 to be lifted")
+	}
+	if _, found := nq.nodes["n1"]; found {
+		t.Errorf("expected the records of n1 to be dropped once the quarantine is lifted")
+	}
+
+	// the failures too far apart are not consecutive
+	nq.recordFailure("n2", now)
+	if backoff := nq.recordFailure("n2", now.Add(10*time.Minute)); backoff != 0 {
+		t.Errorf("expected no quarantine for failures too far apart, got %v", backoff)
+	}
+
+	if newNodeQuarantine(0, time.Minute, time.Minute) != nil {
+		t.Errorf("expected the quarantine to be disabled with a threshold 0")
+	}
+}
+
+func TestRecordNodeOperation(t *testing.T) {
+	cache := newMockSchedulerCache("volcano")
+	recorder := record.NewFakeRecorder(10)
+	cache.Recorder = recorder
+	cache.nodeQuarantine = newNodeQuarantine(2, time.Minute, time.Minute)
+	cache.HyperNodesInfo = api.NewHyperNodesInfo(cache.nodeInformer.Lister())
+	for _, name := range []string{"n1", "n2"} {
+		cache.AddOrUpdateNode(buildNode(name, api.BuildResourceList("2000m", "10G", []api.ScalarResource{{Name: "pods", Value: "10"}}...)))
+	}
+
+	cache.recordNodeOperation("n1", nodeOperationBind, fmt.Errorf("admission webhook denied the request"))
+	cache.recordNodeOperation("n2", nodeOperationBind, fmt.Errorf("admission webhook denied the request"))
+	// the success of n2 resets its failures
+	cache.recordNodeOperation("n2", nodeOperationEvict, nil)
+	cache.recordNodeOperation("n1", nodeOperationEvict, fmt.Errorf("kubelet is not responding"))
+	cache.recordNodeOperation("n2", nodeOperationBind, fmt.Errorf("admission webhook denied the request"))
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, NodeQuarantinedReason) || !strings.Contains(event, "kubelet is not responding") {
+			t.Errorf("unexpected event %s", event)
+		}
+	default:
+		t.Fatalf("expected an event for the quarantined node")
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("expected only n1 to be quarantined, got event %s", <-recorder.Events)
+	}
+
+	snapshot := cache.Snapshot()
+	if _, found := snapshot.Nodes["n1"]; found {
+		t.Errorf("expected the quarantined node n1 to be excluded from the snapshot")
+	}
+	if _, found := snapshot.Nodes["n2"]; !found {
+		t.Errorf("expected node n2 in the snapshot")
+	}
+
+	if err := cache.RemoveNode("n1"); err != nil {
+		t.Fatalf("failed to remove node: %v", err)
+	}
+	if _, found := cache.nodeQuarantine.nodes["n1"]; found {
+		t.Errorf("expected the records of the deleted node to be dropped")
+	}
+}
+
+func TestEvictionFailedOnNode(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+	tests := []struct {
+		name   string
+		err    error
+		onNode bool
+	}{
+		{name: "pod already deleted", err: apierrors.NewNotFound(pods, "p1")},
+		{name: "rejected by a disruption budget", err: apierrors.NewTooManyRequests("disruption budget", 0)},
+		{name: "conflicting update", err: apierrors.NewConflict(pods, "p1", fmt.Errorf("modified"))},
+		{name: "rejected by an admission webhook", err: apierrors.NewForbidden(pods, "p1", fmt.Errorf("denied"))},
+		{name: "timeout", err: apierrors.NewTimeoutError("pod did not terminate", 0), onNode: true},
+		{name: "other error", err: fmt.Errorf("kubelet is not responding"), onNode: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if onNode := evictionFailedOnNode(test.err); onNode != test.onNode {
+				t.Errorf("expected failure on node %v, got %v", test.onNode, onNode)
+			}
+		})
+	}
+}
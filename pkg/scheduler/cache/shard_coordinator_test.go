@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	schedulingv1alpha1 "volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	nodeshardv1alpha1 "volcano.sh/apis/pkg/apis/shard/v1alpha1"
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -51,6 +52,10 @@ func (c *countingStatusUpdater) UpdateQueueStatus(queue *api.QueueInfo) error {
 	return nil
 }
 
+func (c *countingStatusUpdater) UpdateReservationStatus(reservation *schedulingv1alpha1.Reservation) (*schedulingv1alpha1.Reservation, error) {
+	return reservation, nil
+}
+
 func (c *countingStatusUpdater) UpdateNodeShardStatus(nodeshard *nodeshardv1alpha1.NodeShard) (*nodeshardv1alpha1.NodeShard, error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
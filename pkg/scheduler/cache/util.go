@@ -141,6 +141,12 @@ func getHyperNodeEventSource(source string) []string {
 // RemoveVolcanoSchGate removes the Volcano scheduling gate from a pod by namespace and name.
 // Returns nil if gate is successfully removed or already removed (idempotent).
 func RemoveVolcanoSchGate(kubeClient kubernetes.Interface, namespace, name string) error {
+	return RemoveSchGate(kubeClient, namespace, name, scheduling.QueueAllocationGateKey)
+}
+
+// RemoveSchGate removes the scheduling gate named gateName from a pod by namespace and name.
+// Returns nil if gate is successfully removed or already removed (idempotent).
+func RemoveSchGate(kubeClient kubernetes.Interface, namespace, name, gateName string) error {
 	// We only need to specify the gate we want to remove.
 	// The "$patch": "delete" directive tells the Strategic Merge Patcher
 	// to find the gate with this name and remove it.
@@ -148,7 +154,7 @@ func RemoveVolcanoSchGate(kubeClient kubernetes.Interface, namespace, name strin
 		"spec": map[string]interface{}{
 			"schedulingGates": []map[string]interface{}{
 				{
-					"name":   scheduling.QueueAllocationGateKey,
+					"name":   gateName,
 					"$patch": "delete",
 				},
 			},
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// guardVictim holds the capacity freed by evicting the task on the node for the job it is evicted for, so that the
+// other jobs do not take it in the following sessions before the job binds a task to the node. It returns nil if the
+// task is not evicted for another job or the guards are disabled.
+func (sc *SchedulerCache) guardVictim(nodeName string, task *schedulingapi.TaskInfo,
+	victimContext *schedulingapi.VictimContext, now time.Time) *schedulingapi.VictimGuard {
+	if sc.victimGuardPeriod <= 0 || victimContext == nil || victimContext.AggressorJob == "" || victimContext.AggressorJob == task.Job {
+		return nil
+	}
+	guard := &schedulingapi.VictimGuard{
+		Beneficiary: victimContext.AggressorJob,
+		Resreq:      task.Resreq.Clone(),
+		Expiry:      metav1.NewTime(now.Add(sc.victimGuardPeriod)),
+	}
+	if sc.victimGuards == nil {
+		sc.victimGuards = map[string][]*schedulingapi.VictimGuard{}
+	}
+	sc.victimGuards[nodeName] = append(sc.victimGuards[nodeName], guard)
+	klog.V(4).Infof("Guard <%v> freed by evicting <%s/%s> on node <%s> for job <%s> until %v",
+		guard.Resreq, task.Namespace, task.Name, nodeName, guard.Beneficiary, guard.Expiry)
+	return guard
+}
+
+// releaseVictimGuard removes the guard of the node, e.g. when the eviction failed.
+func (sc *SchedulerCache) releaseVictimGuard(nodeName string, guard *schedulingapi.VictimGuard) {
+	if guard == nil {
+		return
+	}
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+	guards := sc.victimGuards[nodeName]
+	for i, g := range guards {
+		if g == guard {
+			sc.victimGuards[nodeName] = append(guards[:i:i], guards[i+1:]...)
+			break
+		}
+	}
+	if len(sc.victimGuards[nodeName]) == 0 {
+		delete(sc.victimGuards, nodeName)
+	}
+}
+
+// activeVictimGuards returns the guards of the node which are still active, and removes the others: the guards expired,
+// the guards of the jobs which are gone, and the guards of the jobs which bound a task to the node.
+func (sc *SchedulerCache) activeVictimGuards(nodeName string, now time.Time) []*schedulingapi.VictimGuard {
+	var active []*schedulingapi.VictimGuard
+	for _, guard := range sc.victimGuards[nodeName] {
+		if !now.Before(guard.Expiry.Time) {
+			klog.V(4).Infof("Guard of node <%s> for job <%s> expired", nodeName, guard.Beneficiary)
+			continue
+		}
+		job, found := sc.Jobs[guard.Beneficiary]
+		if !found || boundToNode(job, nodeName) {
+			klog.V(4).Infof("Release guard of node <%s> for job <%s>", nodeName, guard.Beneficiary)
+			continue
+		}
+		active = append(active, guard)
+	}
+	if len(active) == 0 {
+		delete(sc.victimGuards, nodeName)
+	} else {
+		sc.victimGuards[nodeName] = active
+	}
+	return active
+}
+
+// boundToNode returns whether a task of the job is bound to the node.
+func boundToNode(job *schedulingapi.JobInfo, nodeName string) bool {
+	for _, task := range job.Tasks {
+		if task.NodeName == nodeName && schedulingapi.AllocatedStatus(task.Status) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestVictimGuards(t *testing.T) {
+	now := time.Now()
+	victim := api.NewTaskInfo(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "victim", UID: "victim"},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Resources: v1.ResourceRequirements{
+			Requests: api.BuildResourceList("2", "2Gi"),
+		}}}},
+	})
+	victim.Job = "ns/victim-job"
+	context := &api.VictimContext{AggressorJob: "ns/beneficiary"}
+
+	sc := &SchedulerCache{Jobs: map[api.JobID]*api.JobInfo{}}
+	if guard := sc.guardVictim("n1", victim, context, now); guard != nil {
+		t.Errorf("expected no guard when the guards are disabled, got %v", guard)
+	}
+	sc.victimGuardPeriod = time.Minute
+	if guard := sc.guardVictim("n1", victim, &api.VictimContext{AggressorJob: victim.Job}, now); guard != nil {
+		t.Errorf("expected no guard for a task evicted for its own job, got %v", guard)
+	}
+	if guard := sc.guardVictim("n1", victim, nil, now); guard != nil {
+		t.Errorf("expected no guard for a task evicted without aggressor, got %v", guard)
+	}
+
+	guard := sc.guardVictim("n1", victim, context, now)
+	if guard == nil || guard.Beneficiary != context.AggressorJob || !guard.Resreq.Equal(victim.Resreq, api.Zero) {
+		t.Fatalf("expected the resources of the victim to be guarded for the beneficiary, got %v", guard)
+	}
+	// the guards of the jobs which are gone are released
+	if active := sc.activeVictimGuards("n1", now); len(active) != 0 {
+		t.Errorf("expected the guard of a job which is gone to be released, got %v", active)
+	}
+
+	beneficiary := api.NewJobInfo(context.AggressorJob)
+	sc.Jobs[beneficiary.UID] = beneficiary
+	guard = sc.guardVictim("n1", victim, context, now)
+	if active := sc.activeVictimGuards("n1", now.Add(30*time.Second)); len(active) != 1 || active[0] != guard {
+		t.Errorf("expected the guard to be active, got %v", active)
+	}
+	if active := sc.activeVictimGuards("n1", now.Add(time.Minute)); len(active) != 0 {
+		t.Errorf("expected the guard to expire, got %v", active)
+	}
+	if _, found := sc.victimGuards["n1"]; found {
+		t.Errorf("expected the guards of n1 to be dropped")
+	}
+
+	// the guard is released once the beneficiary binds a task to the node
+	sc.guardVictim("n1", victim, context, now)
+	starving := api.NewTaskInfo(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "starving", UID: "starving"}})
+	starving.NodeName, starving.Status = "n2", api.Bound
+	beneficiary.AddTaskInfo(starving)
+	if active := sc.activeVictimGuards("n1", now); len(active) != 1 {
+		t.Errorf("expected the guard to be active while the beneficiary is bound to another node, got %v", active)
+	}
+	starving.NodeName = "n1"
+	if active := sc.activeVictimGuards("n1", now); len(active) != 0 {
+		t.Errorf("expected the guard to be released once the beneficiary is bound to the node, got %v", active)
+	}
+
+	// the guard of a failed eviction is released
+	guard = sc.guardVictim("n1", victim, context, now)
+	sc.releaseVictimGuard("n1", guard)
+	if _, found := sc.victimGuards["n1"]; found {
+		t.Errorf("expected the guard of the failed eviction to be released")
+	}
+}
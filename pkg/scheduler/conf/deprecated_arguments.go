@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conf
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ArgumentAlias maps a deprecated argument key of an action or a plugin to the key replacing it,
+// which may belong to another action or plugin.
+type ArgumentAlias struct {
+	// Name is the name of the action or plugin of the deprecated key
+	Name string
+	// Key is the deprecated key
+	Key string
+	// NewName is the name of the action or plugin of the key replacing it, Name if empty
+	NewName string
+	// NewKey is the key replacing the deprecated key
+	NewKey string
+}
+
+// DeprecatedArguments are the argument keys renamed across releases, the arguments of the deprecated keys are
+// moved to the new keys when the configuration is loaded, so that the renames do not silently disable features.
+// Append the renames here, and keep the entries for at least two releases.
+var DeprecatedArguments = []ArgumentAlias{
+	{Name: "allocate", Key: "enablePredicateErrorCache", NewKey: EnablePredicateErrCacheKey},
+	{Name: "backfill", Key: "enablePredicateErrorCache", NewKey: EnablePredicateErrCacheKey},
+	{Name: "preempt", Key: "enablePredicateErrorCache", NewKey: EnablePredicateErrCacheKey},
+	{Name: "reclaim", Key: "enablePredicateErrorCache", NewKey: EnablePredicateErrCacheKey},
+	{Name: "burst", Key: "enablePredicateErrorCache", NewKey: EnablePredicateErrCacheKey},
+	{Name: "predicates", Key: "predicate.GPUSharingEnable", NewName: "deviceshare", NewKey: "deviceshare.GPUSharingEnable"},
+	{Name: "predicates", Key: "predicate.GPUNumberEnable", NewName: "deviceshare", NewKey: "deviceshare.GPUNumberEnable"},
+}
+
+// ResolveDeprecatedArguments moves the arguments of the deprecated keys of the actions and plugins, of the
+// configuration and of its profiles, to the keys replacing them, and returns a warning for each deprecated key.
+// The argument of the new key is kept if both keys are set.
+func (c *SchedulerConfiguration) ResolveDeprecatedArguments() []string {
+	warnings := resolveDeprecatedArguments(c.Tiers, &c.Configurations)
+	for i := range c.Profiles {
+		for _, warning := range resolveDeprecatedArguments(c.Profiles[i].Tiers, &c.Profiles[i].Configurations) {
+			warnings = append(warnings, fmt.Sprintf("profile %s: %s", c.Profiles[i].Name, warning))
+		}
+	}
+	return warnings
+}
+
+func resolveDeprecatedArguments(tiers []Tier, configurations *[]Configuration) []string {
+	var warnings []string
+	for _, alias := range DeprecatedArguments {
+		newName := alias.NewName
+		if newName == "" {
+			newName = alias.Name
+		}
+
+		for i := range tiers {
+			for j := range tiers[i].Plugins {
+				plugin := &tiers[i].Plugins[j]
+				value, found := plugin.Arguments[alias.Key]
+				if plugin.Name != alias.Name || !found {
+					continue
+				}
+				target := findPlugin(tiers, newName)
+				if target == nil {
+					warnings = append(warnings, fmt.Sprintf("argument %s of plugin %s is deprecated and has no effect, use %s of plugin %s, which is not enabled",
+						alias.Key, alias.Name, alias.NewKey, newName))
+					continue
+				}
+				delete(plugin.Arguments, alias.Key)
+				if target.Arguments == nil {
+					target.Arguments = map[string]interface{}{}
+				}
+				warnings = append(warnings, moveArgument(target.Arguments, alias, "plugin", newName, value))
+			}
+		}
+
+		for i := range *configurations {
+			configuration := &(*configurations)[i]
+			if configuration.Name == alias.Name && newName == alias.Name {
+				// the overrides of the queues are renamed within the action
+				queues := make([]string, 0, len(configuration.QueueArguments))
+				for queue := range configuration.QueueArguments {
+					queues = append(queues, queue)
+				}
+				sort.Strings(queues)
+				for _, queue := range queues {
+					arguments := configuration.QueueArguments[queue]
+					value, found := arguments[alias.Key]
+					if !found {
+						continue
+					}
+					delete(arguments, alias.Key)
+					warnings = append(warnings, "queue "+queue+": "+moveArgument(arguments, alias, "action", newName, value))
+				}
+			}
+			value, found := configuration.Arguments[alias.Key]
+			if configuration.Name != alias.Name || !found {
+				continue
+			}
+			delete(configuration.Arguments, alias.Key)
+			// the actions are configured on demand, the configuration of the new action is added if missing
+			target := findConfiguration(*configurations, newName)
+			if target == nil {
+				*configurations = append(*configurations, Configuration{Name: newName})
+				target = &(*configurations)[len(*configurations)-1]
+			}
+			if target.Arguments == nil {
+				target.Arguments = map[string]interface{}{}
+			}
+			warnings = append(warnings, moveArgument(target.Arguments, alias, "action", newName, value))
+		}
+	}
+	return warnings
+}
+
+// moveArgument sets the new key of the alias to the value of the deprecated key, unless the new key is already set,
+// and returns the warning of the deprecated key.
+func moveArgument(arguments map[string]interface{}, alias ArgumentAlias, kind, newName string, value interface{}) string {
+	if _, found := arguments[alias.NewKey]; found {
+		return fmt.Sprintf("argument %s of %s %s is deprecated and ignored, %s of %s %s is set",
+			alias.Key, kind, alias.Name, alias.NewKey, kind, newName)
+	}
+	arguments[alias.NewKey] = value
+	return fmt.Sprintf("argument %s of %s %s is deprecated, use %s of %s %s instead",
+		alias.Key, kind, alias.Name, alias.NewKey, kind, newName)
+}
+
+func findPlugin(tiers []Tier, name string) *PluginOption {
+	for i := range tiers {
+		for j := range tiers[i].Plugins {
+			if tiers[i].Plugins[j].Name == name {
+				return &tiers[i].Plugins[j]
+			}
+		}
+	}
+	return nil
+}
+
+func findConfiguration(configurations []Configuration, name string) *Configuration {
+	for i := range configurations {
+		if configurations[i].Name == name {
+			return &configurations[i]
+		}
+	}
+	return nil
+}
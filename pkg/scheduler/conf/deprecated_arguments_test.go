@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolveDeprecatedArguments(t *testing.T) {
+	tests := []struct {
+		name                   string
+		conf                   *SchedulerConfiguration
+		expectedTiers          []Tier
+		expectedConfigurations []Configuration
+		expectedWarnings       []string
+	}{
+		{
+			name: "deprecated key of an action is renamed",
+			conf: &SchedulerConfiguration{
+				Configurations: []Configuration{
+					{Name: "allocate", Arguments: map[string]interface{}{"enablePredicateErrorCache": false}},
+				},
+			},
+			expectedConfigurations: []Configuration{
+				{Name: "allocate", Arguments: map[string]interface{}{EnablePredicateErrCacheKey: false}},
+			},
+			expectedWarnings: []string{"argument enablePredicateErrorCache of action allocate is deprecated, use predicateErrorCacheEnable of action allocate instead"},
+		},
+		{
+			name: "deprecated key of the queues of an action is renamed",
+			conf: &SchedulerConfiguration{
+				Configurations: []Configuration{
+					{Name: "reclaim", QueueArguments: map[string]map[string]interface{}{"batch": {"enablePredicateErrorCache": false}}},
+				},
+			},
+			expectedConfigurations: []Configuration{
+				{Name: "reclaim", QueueArguments: map[string]map[string]interface{}{"batch": {EnablePredicateErrCacheKey: false}}},
+			},
+			expectedWarnings: []string{"queue batch: argument enablePredicateErrorCache of action reclaim is deprecated, use predicateErrorCacheEnable of action reclaim instead"},
+		},
+		{
+			name: "new key wins over the deprecated key",
+			conf: &SchedulerConfiguration{
+				Configurations: []Configuration{
+					{Name: "preempt", Arguments: map[string]interface{}{"enablePredicateErrorCache": false, EnablePredicateErrCacheKey: true}},
+				},
+			},
+			expectedConfigurations: []Configuration{
+				{Name: "preempt", Arguments: map[string]interface{}{EnablePredicateErrCacheKey: true}},
+			},
+			expectedWarnings: []string{"argument enablePredicateErrorCache of action preempt is deprecated and ignored, predicateErrorCacheEnable of action preempt is set"},
+		},
+		{
+			name: "deprecated key of a plugin is moved to another plugin",
+			conf: &SchedulerConfiguration{
+				Tiers: []Tier{
+					{Plugins: []PluginOption{
+						{Name: "predicates", Arguments: map[string]interface{}{"predicate.GPUSharingEnable": true}},
+						{Name: "deviceshare"},
+					}},
+				},
+			},
+			expectedTiers: []Tier{
+				{Plugins: []PluginOption{
+					{Name: "predicates", Arguments: map[string]interface{}{}},
+					{Name: "deviceshare", Arguments: map[string]interface{}{"deviceshare.GPUSharingEnable": true}},
+				}},
+			},
+			expectedWarnings: []string{"argument predicate.GPUSharingEnable of plugin predicates is deprecated, use deviceshare.GPUSharingEnable of plugin deviceshare instead"},
+		},
+		{
+			name: "deprecated key is kept if the new plugin is not enabled",
+			conf: &SchedulerConfiguration{
+				Tiers: []Tier{
+					{Plugins: []PluginOption{
+						{Name: "predicates", Arguments: map[string]interface{}{"predicate.GPUNumberEnable": true}},
+					}},
+				},
+			},
+			expectedTiers: []Tier{
+				{Plugins: []PluginOption{
+					{Name: "predicates", Arguments: map[string]interface{}{"predicate.GPUNumberEnable": true}},
+				}},
+			},
+			expectedWarnings: []string{"argument predicate.GPUNumberEnable of plugin predicates is deprecated and has no effect, use deviceshare.GPUNumberEnable of plugin deviceshare, which is not enabled"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			warnings := test.conf.ResolveDeprecatedArguments()
+			if !reflect.DeepEqual(warnings, test.expectedWarnings) {
+				t.Errorf("expected warnings %v, got %v", test.expectedWarnings, warnings)
+			}
+			if !reflect.DeepEqual(test.conf.Tiers, test.expectedTiers) {
+				t.Errorf("expected tiers %+v, got %+v", test.expectedTiers, test.conf.Tiers)
+			}
+			if !reflect.DeepEqual(test.conf.Configurations, test.expectedConfigurations) {
+				t.Errorf("expected configurations %+v, got %+v", test.expectedConfigurations, test.conf.Configurations)
+			}
+		})
+	}
+}
+
+func TestResolveDeprecatedArgumentsOfProfiles(t *testing.T) {
+	c := &SchedulerConfiguration{
+		Profiles: []ProfileConfiguration{
+			{
+				Name: "batch",
+				Configurations: []Configuration{
+					{Name: "backfill", Arguments: map[string]interface{}{"enablePredicateErrorCache": true}},
+				},
+			},
+		},
+	}
+	warnings := c.ResolveDeprecatedArguments()
+	if len(warnings) != 1 || !strings.HasPrefix(warnings[0], "profile batch: ") {
+		t.Fatalf("expected one warning of profile batch, got %v", warnings)
+	}
+	arguments := c.Profiles[0].Configurations[0].Arguments
+	if _, found := arguments["enablePredicateErrorCache"]; found || arguments[EnablePredicateErrCacheKey] != true {
+		t.Errorf("expected the argument of profile batch to be renamed, got %v", arguments)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conf
+
+const (
+	// EffectiveConfigurationAPIVersion is the version of the schema of EffectiveConfiguration.
+	// The fields are only added in a version, a new version is introduced for incompatible changes.
+	EffectiveConfigurationAPIVersion = "scheduler.volcano.sh/v1"
+	// EffectiveConfigurationKind is the kind of EffectiveConfiguration.
+	EffectiveConfigurationKind = "EffectiveSchedulerConfiguration"
+)
+
+// EffectiveConfiguration is the configuration the scheduler uses: the configuration applied by the scheduler with the
+// default settings of the plugins applied, the deprecated arguments moved to the keys replacing them and the action
+// lists normalized, for the scheduler and each profile.
+type EffectiveConfiguration struct {
+	// APIVersion is the version of the schema, EffectiveConfigurationAPIVersion
+	APIVersion string `yaml:"apiVersion"`
+	// Kind is EffectiveConfigurationKind
+	Kind string `yaml:"kind"`
+	// Generation is incremented every time a new configuration is applied, starting from 1
+	Generation int64 `yaml:"generation"`
+	// Hash is the SHA-256 of the configuration as it is applied
+	Hash string `yaml:"hash"`
+	// AppliedTime is the time the configuration was applied, in RFC 3339
+	AppliedTime string `yaml:"appliedTime"`
+	// Warnings are the deprecated arguments of the configuration, which are moved to the keys replacing them
+	Warnings []string `yaml:"warnings,omitempty"`
+
+	SchedulerConfiguration `yaml:",inline"`
+}
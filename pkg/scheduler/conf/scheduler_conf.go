@@ -28,11 +28,91 @@ var EnabledActionMap map[string]bool
 type SchedulerConfiguration struct {
 	// Actions defines the actions list of scheduler in order
 	Actions string `yaml:"actions"`
+	// Pipelines defines the actions lists of the queue classes, the Actions are run for the queues of the other classes
+	Pipelines []PipelineConfiguration `yaml:"pipelines"`
 	// Tiers defines plugins in different tiers
 	Tiers []Tier `yaml:"tiers"`
 	// Configurations is configuration for actions
 	Configurations       []Configuration   `yaml:"configurations"`
 	MetricsConfiguration map[string]string `yaml:"metrics"`
+	// Profiles defines the named profiles of the scheduler, selected by the jobs with the volcano.sh/scheduler-profile
+	// label of their pods or annotation of their queue, the other jobs are scheduled by the Actions and Tiers
+	Profiles []ProfileConfiguration `yaml:"profiles"`
+	// Tracing configures the export of the spans of the scheduling cycles, the tracing is disabled if not set
+	Tracing *TracingConfiguration `yaml:"tracing"`
+	// Audit configures the audit log of the scheduling decisions, the audit is disabled if not set
+	Audit *AuditConfiguration `yaml:"audit"`
+	// Starvation configures the detection of the jobs pending too long, the detection is disabled if not set
+	Starvation *StarvationConfiguration `yaml:"starvation"`
+	// ResourceAliases defines the resources accounted in other resources by the quotas of the queues, e.g. the models
+	// of GPUs in nvidia.com/gpu
+	ResourceAliases []ResourceAliasConfiguration `yaml:"resourceAliases"`
+	// Resize configures the review of the pending in-place resizes of the running pods against the quotas of their
+	// queues, the resizes are not reviewed if not set
+	Resize *ResizeConfiguration `yaml:"resize"`
+}
+
+// ProfileConfiguration defines the actions and plugins of a named profile of the scheduler
+type ProfileConfiguration struct {
+	// Name is the name of the profile
+	Name string `yaml:"name"`
+	// Actions defines the actions list of the profile in order
+	Actions string `yaml:"actions"`
+	// Pipelines defines the actions lists of the queue classes in the profile
+	Pipelines []PipelineConfiguration `yaml:"pipelines"`
+	// Tiers defines plugins in different tiers of the profile
+	Tiers []Tier `yaml:"tiers"`
+	// Configurations is configuration for actions of the profile, the configurations of the scheduler are not inherited
+	Configurations []Configuration `yaml:"configurations"`
+}
+
+// PipelineConfiguration defines the actions list of the queues of a class
+type PipelineConfiguration struct {
+	// QueueClass is the class of the queues, given by their volcano.sh/queue-class label
+	QueueClass string `yaml:"queueClass"`
+	// Actions defines the actions list of the queues of the class in order
+	Actions string `yaml:"actions"`
+}
+
+// AuditConfiguration defines the sinks of the audit log of the scheduling decisions
+type AuditConfiguration struct {
+	// File is the path of the file the records are appended to, one JSON object per line
+	File string `yaml:"file"`
+	// Webhook is the URL the records of every scheduling cycle are posted to as a JSON array
+	Webhook string `yaml:"webhook"`
+}
+
+// StarvationConfiguration defines when the pending jobs are starving
+type StarvationConfiguration struct {
+	// Threshold is how long a job waits for resources before it is starving, e.g. 30m
+	Threshold string `yaml:"threshold"`
+	// TriggerPlugins lets the aging and sla plugins act on the starving jobs as if they were overdue
+	TriggerPlugins bool `yaml:"triggerPlugins"`
+}
+
+// ResizeConfiguration defines the review of the pending in-place resizes of the running pods
+type ResizeConfiguration struct {
+	// RevertDenied reverts the resizes denied by the plugins to the resources allocated to the pods, the denied
+	// resizes are only reported by events otherwise
+	RevertDenied bool `yaml:"revertDenied"`
+}
+
+// ResourceAliasConfiguration defines a resource accounted in another resource by the quotas of the queues
+type ResourceAliasConfiguration struct {
+	// Name is the name of the alias, e.g. nvidia.com/A100
+	Name string `yaml:"name"`
+	// Resource is the name of the resource the alias is accounted in, e.g. nvidia.com/gpu
+	Resource string `yaml:"resource"`
+	// Ratio is the quantity of Resource accounted for one unit of the alias, 1 if not set
+	Ratio *float64 `yaml:"ratio"`
+}
+
+// TracingConfiguration defines the OTLP export of the spans of the scheduling cycles
+type TracingConfiguration struct {
+	// Endpoint is the OTLP gRPC endpoint of the collector, localhost:4317 if not set
+	Endpoint string `yaml:"endpoint"`
+	// SamplingRatePerMillion is the number of cycles sampled per million, all the cycles are sampled if not set
+	SamplingRatePerMillion *int32 `yaml:"samplingRatePerMillion"`
 }
 
 // Tier defines plugin tier
@@ -46,6 +126,8 @@ type Configuration struct {
 	Name string `yaml:"name"`
 	// Arguments defines the different arguments that can be given to specified action
 	Arguments map[string]interface{} `yaml:"arguments"`
+	// QueueArguments overrides the arguments of the action for the jobs of the queues, by queue name
+	QueueArguments map[string]map[string]interface{} `yaml:"queueArguments"`
 }
 
 // PluginOption defines the options of plugin
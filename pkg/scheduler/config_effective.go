@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// ConfigEffectivePath is the path of the endpoint responding the configuration the scheduler uses.
+const ConfigEffectivePath = "/scheduler/config/effective"
+
+// effectiveSchedulerConf resolves the configuration as the scheduler applies it: the deprecated arguments are moved,
+// the default settings of the plugins are applied and the action lists are normalized.
+func effectiveSchedulerConf(config string, version ConfigVersion) (*conf.EffectiveConfiguration, error) {
+	effective := &conf.EffectiveConfiguration{
+		APIVersion:  conf.EffectiveConfigurationAPIVersion,
+		Kind:        conf.EffectiveConfigurationKind,
+		Generation:  version.Generation,
+		Hash:        version.Hash,
+		AppliedTime: version.AppliedTime.UTC().Format(time.RFC3339),
+	}
+	if err := yaml.Unmarshal([]byte(config), &effective.SchedulerConfiguration); err != nil {
+		return nil, err
+	}
+	schedulerConf := &effective.SchedulerConfiguration
+	effective.Warnings = schedulerConf.ResolveDeprecatedArguments()
+
+	if err := applyTierDefaults(schedulerConf.Tiers); err != nil {
+		return nil, err
+	}
+	schedulerConf.Actions = normalizeActions(schedulerConf.Actions)
+	normalizePipelines(schedulerConf.Pipelines)
+	for i := range schedulerConf.Profiles {
+		profile := &schedulerConf.Profiles[i]
+		if err := applyTierDefaults(profile.Tiers); err != nil {
+			return nil, err
+		}
+		profile.Actions = normalizeActions(profile.Actions)
+		normalizePipelines(profile.Pipelines)
+	}
+	return effective, nil
+}
+
+// normalizeActions returns the comma separated list of action names with the spaces trimmed.
+func normalizeActions(actionNames string) string {
+	names := strings.Split(actionNames, ",")
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+	}
+	return strings.Join(names, ", ")
+}
+
+func normalizePipelines(pipelines []conf.PipelineConfiguration) {
+	for i := range pipelines {
+		pipelines[i].Actions = normalizeActions(pipelines[i].Actions)
+	}
+}
+
+// setEffectiveConf resolves the configuration applied as the effective configuration. It must be called with
+// the mutex held.
+func (pc *Scheduler) setEffectiveConf(config string) {
+	effective, err := effectiveSchedulerConf(config, pc.confVersion)
+	if err != nil {
+		// the configuration is validated before it is applied
+		klog.Errorf("Failed to resolve the effective scheduler configuration: %v", err)
+		return
+	}
+	pc.effectiveConf = effective
+}
+
+// EffectiveConf returns the configuration the scheduler uses, nil before the first configuration is applied.
+func (pc *Scheduler) EffectiveConf() *conf.EffectiveConfiguration {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+	return pc.effectiveConf
+}
+
+// EffectiveConfigHandler returns the handler of ConfigEffectivePath. It responds the configuration the scheduler
+// uses as YAML, with the status 503 before the first configuration is applied.
+func (pc *Scheduler) EffectiveConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		effective := pc.EffectiveConf()
+		if effective == nil {
+			http.Error(w, "no scheduler configuration is applied yet", http.StatusServiceUnavailable)
+			return
+		}
+		data, err := yaml.Marshal(effective)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml")
+		if _, err := w.Write(data); err != nil {
+			klog.Errorf("Failed to write the effective scheduler configuration: %v", err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestEffectiveConfigHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scheduler.conf")
+	pc := &Scheduler{schedulerConf: path}
+	server := httptest.NewServer(pc.EffectiveConfigHandler())
+	defer server.Close()
+
+	get := func() (*http.Response, []byte) {
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatalf("failed to get the effective configuration: %v", err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("failed to read the effective configuration: %v", err)
+		}
+		return resp, data
+	}
+
+	if resp, _ := get(); resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503 before the configuration is applied, got %d", resp.StatusCode)
+	}
+
+	config := `
+actions: "enqueue,allocate ,  backfill"
+tiers:
+- plugins:
+  - name: gang
+    enableJobOrder: false
+configurations:
+- name: allocate
+  arguments:
+    enablePredicateErrorCache: false
+profiles:
+- name: batch
+  actions: "allocate,backfill"
+  tiers:
+  - plugins:
+    - name: binpack
+`
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatalf("failed to write configuration: %v", err)
+	}
+	pc.loadSchedulerConf()
+
+	resp, data := get()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.StatusCode, data)
+	}
+	effective := &conf.EffectiveConfiguration{}
+	if err := yaml.UnmarshalStrict(data, effective); err != nil {
+		t.Fatalf("invalid effective configuration: %v", err)
+	}
+
+	version := pc.ConfigVersion()
+	if effective.APIVersion != conf.EffectiveConfigurationAPIVersion || effective.Kind != conf.EffectiveConfigurationKind {
+		t.Errorf("expected %s %s, got %s %s", conf.EffectiveConfigurationAPIVersion, conf.EffectiveConfigurationKind,
+			effective.APIVersion, effective.Kind)
+	}
+	if effective.Generation != version.Generation || effective.Hash != version.Hash {
+		t.Errorf("expected generation %d (%s), got %d (%s)", version.Generation, version.Hash, effective.Generation, effective.Hash)
+	}
+	if effective.Actions != "enqueue, allocate, backfill" || effective.Profiles[0].Actions != "allocate, backfill" {
+		t.Errorf("expected the actions to be normalized, got %q and %q", effective.Actions, effective.Profiles[0].Actions)
+	}
+
+	gang := effective.Tiers[0].Plugins[0]
+	if *gang.EnabledJobOrder || gang.EnabledJobReady == nil || !*gang.EnabledJobReady {
+		t.Errorf("expected the configured settings kept and the defaults applied, got %+v", gang)
+	}
+	binpack := effective.Profiles[0].Tiers[0].Plugins[0]
+	if binpack.EnabledNodeOrder == nil || !*binpack.EnabledNodeOrder {
+		t.Errorf("expected the defaults applied to the plugins of the profiles, got %+v", binpack)
+	}
+
+	arguments := effective.Configurations[0].Arguments
+	if _, found := arguments["enablePredicateErrorCache"]; found || arguments[conf.EnablePredicateErrCacheKey] != false {
+		t.Errorf("expected the deprecated argument to be moved, got %v", arguments)
+	}
+	if len(effective.Warnings) != 1 {
+		t.Errorf("expected one warning of the deprecated argument, got %v", effective.Warnings)
+	}
+
+	resp, err := http.Post(server.URL, "application/yaml", nil)
+	if err != nil {
+		t.Fatalf("failed to post: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected status 405 of POST, got %d", resp.StatusCode)
+	}
+}
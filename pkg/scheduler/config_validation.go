@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// ConfigValidatePath is the path of the endpoint validating a scheduler configuration posted in the request body,
+	// without applying it.
+	ConfigValidatePath = "/scheduler/config/validate"
+
+	// ConfigRollbackReason is the reason of the event recorded on the scheduler pod when a new configuration
+	// fails to load and the last good configuration is kept.
+	ConfigRollbackReason = "SchedulerConfigRollback"
+
+	// maxConfigSize is the maximum size of the configurations posted to the validation endpoint, 1MB.
+	maxConfigSize = 1 << 20
+
+	configReloadApplied   = "applied"
+	configReloadUnchanged = "unchanged"
+	configReloadRollback  = "rollback"
+)
+
+// ConfigVersion identifies a configuration applied by the scheduler.
+type ConfigVersion struct {
+	// Generation is incremented every time a new configuration is applied, starting from 1.
+	Generation int64 `json:"generation"`
+	// Hash is the SHA-256 of the configuration.
+	Hash string `json:"hash"`
+	// AppliedTime is the time the configuration was applied.
+	AppliedTime time.Time `json:"appliedTime"`
+}
+
+// ConfigValidationResult is the response of the validation endpoint.
+type ConfigValidationResult struct {
+	Valid bool `json:"valid"`
+	// Hash is the SHA-256 of the configuration, to be compared with the hash of the applied configuration in the logs.
+	Hash string `json:"hash"`
+	// Errors are the reasons the configuration is invalid.
+	Errors []string `json:"errors,omitempty"`
+	// Warnings are the deprecated arguments of the configuration, which are moved to the keys replacing them.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+func configHash(config string) string {
+	sum := sha256.Sum256([]byte(config))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateConfigHandler returns the handler of ConfigValidatePath. It validates the scheduler configuration posted
+// in the request body with the actions and plugins registered in the scheduler, and responds a ConfigValidationResult,
+// with the status 200 if the configuration is valid and 422 otherwise.
+func ValidateConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxConfigSize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read the configuration: %v", err), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		config := strings.TrimSpace(string(data))
+		result := ConfigValidationResult{Valid: true, Hash: configHash(config)}
+		status := http.StatusOK
+		if err := ValidateSchedulerConf(config); err != nil {
+			result.Valid = false
+			result.Errors = strings.Split(err.Error(), "\n")
+			status = http.StatusUnprocessableEntity
+		}
+		schedulerConf := &conf.SchedulerConfiguration{}
+		if err := yaml.Unmarshal([]byte(config), schedulerConf); err == nil {
+			result.Warnings = schedulerConf.ResolveDeprecatedArguments()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			klog.Errorf("Failed to write the validation result of the scheduler configuration: %v", err)
+		}
+	})
+}
+
+// ConfigVersion returns the version of the configuration applied, the zero value before the first configuration
+// is applied.
+func (pc *Scheduler) ConfigVersion() ConfigVersion {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+	return pc.confVersion
+}
+
+// nextConfigVersion returns the version of the configuration to apply, and false if it is the applied configuration.
+// It must be called with the mutex held.
+func (pc *Scheduler) nextConfigVersion(config string) (ConfigVersion, bool) {
+	hash := configHash(config)
+	if pc.confVersion.Hash == hash {
+		return pc.confVersion, false
+	}
+	return ConfigVersion{Generation: pc.confVersion.Generation + 1, Hash: hash, AppliedTime: time.Now()}, true
+}
+
+// rollbackSchedulerConf keeps the last good configuration when the new configuration fails to load,
+// and records a warning event on the scheduler pod. The config is empty when it could not be read.
+func (pc *Scheduler) rollbackSchedulerConf(config string, err error) {
+	metrics.RegisterConfigReload(configReloadRollback)
+	version := pc.ConfigVersion()
+	name := "Scheduler configuration"
+	if config != "" {
+		name += " " + shortHash(configHash(config))
+	}
+	message := fmt.Sprintf("%s failed to load, rolled back to generation %d (%s): %v",
+		name, version.Generation, shortHash(version.Hash), err)
+	klog.Error(message)
+	if pc.cache != nil {
+		framework.RecordSchedulerEvent(pc.cache.EventRecorder(), v1.EventTypeWarning, ConfigRollbackReason, message)
+	}
+}
+
+// shortHash abbreviates the hash of a configuration in the logs and events.
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateConfigHandler(t *testing.T) {
+	server := httptest.NewServer(ValidateConfigHandler())
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		config string
+		status int
+		valid  bool
+		// warnings is the number of expected warnings
+		warnings int
+	}{
+		{
+			name:   "valid configuration",
+			method: http.MethodPost,
+			config: DefaultSchedulerConf,
+			status: http.StatusOK,
+			valid:  true,
+		},
+		{
+			name:   "invalid configuration",
+			method: http.MethodPost,
+			config: `actions: "allocate, backfil"`,
+			status: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "deprecated argument",
+			method: http.MethodPost,
+			config: `
+actions: "allocate"
+configurations:
+- name: allocate
+  arguments:
+    enablePredicateErrorCache: false
+`,
+			status:   http.StatusOK,
+			valid:    true,
+			warnings: 1,
+		},
+		{
+			name:   "configuration must be posted",
+			method: http.MethodGet,
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(test.method, server.URL+ConfigValidatePath, strings.NewReader(test.config))
+			if err != nil {
+				t.Fatalf("failed to build request: %v", err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("failed to validate configuration: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.status {
+				t.Fatalf("expected status %d, got %d", test.status, resp.StatusCode)
+			}
+			if test.method != http.MethodPost {
+				return
+			}
+
+			result := ConfigValidationResult{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode result: %v", err)
+			}
+			if result.Valid != test.valid || result.Hash != configHash(strings.TrimSpace(test.config)) {
+				t.Errorf("unexpected result %+v", result)
+			}
+			if !test.valid && len(result.Errors) == 0 {
+				t.Errorf("expected the errors of the invalid configuration")
+			}
+			if len(result.Warnings) != test.warnings {
+				t.Errorf("expected %d warnings, got %v", test.warnings, result.Warnings)
+			}
+		})
+	}
+}
+
+func TestLoadSchedulerConfRollback(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scheduler.conf")
+	pc := &Scheduler{schedulerConf: path}
+	write := func(config string) {
+		if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+			t.Fatalf("failed to write configuration: %v", err)
+		}
+	}
+
+	good := `
+actions: "allocate, backfill"
+tiers:
+- plugins:
+  - name: gang
+`
+	write(good)
+	pc.loadSchedulerConf()
+	version := pc.ConfigVersion()
+	// the default configuration is generation 1
+	if version.Generation != 2 || version.Hash != configHash(strings.TrimSpace(good)) || len(pc.actions) != 2 {
+		t.Fatalf("expected the configuration to be applied as generation 2, got %+v with %d actions", version, len(pc.actions))
+	}
+
+	pc.loadSchedulerConf()
+	if pc.ConfigVersion() != version {
+		t.Errorf("expected the unchanged configuration to keep generation 2, got %+v", pc.ConfigVersion())
+	}
+
+	write(`
+actions: "allocate"
+tiers:
+- plugins:
+  - name: unknown
+`)
+	pc.loadSchedulerConf()
+	if pc.ConfigVersion() != version || len(pc.actions) != 2 {
+		t.Errorf("expected the invalid configuration to be rolled back to generation 2, got %+v with %d actions", pc.ConfigVersion(), len(pc.actions))
+	}
+
+	write(`actions: "allocate"`)
+	pc.loadSchedulerConf()
+	if version := pc.ConfigVersion(); version.Generation != 3 || len(pc.actions) != 1 {
+		t.Errorf("expected the new configuration to be applied as generation 3, got %+v with %d actions", version, len(pc.actions))
+	}
+
+	version = pc.ConfigVersion()
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	pc.loadSchedulerConf()
+	if pc.ConfigVersion() != version {
+		t.Errorf("expected the unreadable configuration to be rolled back to generation 3, got %+v", pc.ConfigVersion())
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+
+	vcclient "volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	schedcache "volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+// EmbeddedConfig is the configuration of a scheduler embedded in another program.
+type EmbeddedConfig struct {
+	// KubeClient and VCClient are the clients of the cluster, e.g. the fake clientsets of a simulated cluster.
+	KubeClient kubernetes.Interface
+	VCClient   vcclient.Interface
+	// Options are the options of the scheduler, the default values of the flags if nil.
+	Options *options.ServerOption
+	// SchedulerConf is the scheduler configuration in YAML, the default configuration if empty.
+	SchedulerConf string
+}
+
+// NewEmbeddedScheduler returns a Scheduler running the sessions in-process against the clients of the configuration,
+// without leader election, HTTP endpoints, configuration file watching, klog socket nor cache dumper.
+// The actions and plugins must be registered by the program, e.g. by importing
+// volcano.sh/volcano/pkg/scheduler/actions and volcano.sh/volcano/pkg/scheduler/plugins.
+// Run the sessions on demand with Start then RunOnce, or periodically with Run.
+func NewEmbeddedScheduler(config EmbeddedConfig) (*Scheduler, error) {
+	if config.KubeClient == nil || config.VCClient == nil {
+		return nil, fmt.Errorf("both kube client and volcano client are required")
+	}
+	opt := config.Options
+	if opt == nil {
+		opt = options.NewDefaultServerOption()
+	}
+	// the options are read globally by the cache, the actions and the plugins
+	opt.RegisterOptions()
+	// the metrics used by the Kubernetes scheduler framework plugins must be initialized
+	metrics.InitKubeSchedulerRelatedMetrics()
+
+	cache := schedcache.NewWithClients(config.KubeClient, config.VCClient, opt.SchedulerNames, opt.DefaultQueue, opt.NodeSelector,
+		opt.NodeWorkerThreads, opt.IgnoredCSIProvisioners, opt.ResyncPeriod, opt.ResourceSyncTimeout)
+	scheduler := newScheduler(cache, opt)
+	scheduler.schedulerConfData = config.SchedulerConf
+	scheduler.embedded = true
+	return scheduler, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakevcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestEmbeddedScheduler(t *testing.T) {
+	if _, err := NewEmbeddedScheduler(EmbeddedConfig{}); err == nil {
+		t.Errorf("expected the clients to be required")
+	}
+
+	node := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	pod := util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	pod.Spec.SchedulerName = "volcano"
+	pg := util.BuildPodGroup("pg1", "ns1", "default", 1, nil, schedulingv1beta1.PodGroupPending)
+	kubeClient := fake.NewSimpleClientset(node, pod)
+	vcClient := fakevcclient.NewSimpleClientset(pg)
+
+	opt := options.NewDefaultServerOption()
+	opt.ResourceSyncTimeout = 0
+	sched, err := NewEmbeddedScheduler(EmbeddedConfig{
+		KubeClient: kubeClient,
+		VCClient:   vcClient,
+		Options:    opt,
+		SchedulerConf: `
+actions: "enqueue, allocate"
+tiers:
+- plugins:
+  - name: gang
+  - name: predicates
+`,
+	})
+	if err != nil {
+		t.Fatalf("failed to create embedded scheduler: %v", err)
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	sched.Start(stopCh)
+
+	bound := func() bool {
+		for _, action := range kubeClient.Actions() {
+			if create, ok := action.(k8stesting.CreateAction); ok && action.GetSubresource() == "binding" &&
+				create.GetObject().(*v1.Binding).Target.Name == "n1" {
+				return true
+			}
+		}
+		return false
+	}
+	err = wait.PollUntilContextTimeout(context.TODO(), 100*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		sched.RunOnce()
+		return bound(), nil
+	})
+	if err != nil {
+		t.Errorf("expected pod ns1/p1 to be bound to n1 by the embedded scheduler")
+	}
+}
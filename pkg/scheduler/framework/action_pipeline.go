@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// ActionPipeline is the ordered list of the actions scheduling the jobs of the queues of a class.
+type ActionPipeline struct {
+	// Class is the queue class of the pipeline, empty for the default pipeline, which schedules
+	// the jobs of the queues whose class has no pipeline.
+	Class   string
+	Actions []Action
+}
+
+// SetPipelineClasses sets the queue classes of the pipelines executed in the session.
+// All the queues belong to the default pipeline if it is not set.
+func (ssn *Session) SetPipelineClasses(classes sets.Set[string]) {
+	ssn.pipelineClasses = classes
+}
+
+// ExecutePipeline executes the actions of the pipeline in order, restricted to the jobs of the queues of its class.
+// The observe function, if not nil, is called after each action with the time it started.
+func (ssn *Session) ExecutePipeline(pipeline *ActionPipeline, observe func(action Action, start time.Time)) {
+	ssn.pipelineClass = pipeline.Class
+	defer func() {
+		ssn.pipelineClass = ""
+	}()
+	for _, action := range pipeline.Actions {
+		start := time.Now()
+		ssn.ExecuteAction(action)
+		if observe != nil {
+			observe(action, start)
+		}
+	}
+}
+
+// QueueInPipeline returns whether the jobs of the queue are scheduled by the pipeline being executed.
+func (ssn *Session) QueueInPipeline(queueID api.QueueID) bool {
+	if ssn.pipelineClasses.Len() == 0 {
+		return true
+	}
+	class := ssn.QueueClass(queueID)
+	if !ssn.pipelineClasses.Has(class) {
+		class = ""
+	}
+	return class == ssn.pipelineClass
+}
+
+// JobInPipeline returns whether the job is scheduled by the pipeline being executed, i.e. whether the job belongs
+// to the profile of the session and its queue to the class of the pipeline.
+func (ssn *Session) JobInPipeline(job *api.JobInfo) bool {
+	return ssn.JobInProfile(job) && ssn.QueueInPipeline(job.Queue)
+}
+
+// QueueClass returns the class of the queue given by the QueueClassLabel, inherited from the closest ancestor
+// with the label if the queue has none, empty if no ancestor has the label.
+func (ssn *Session) QueueClass(queueID api.QueueID) string {
+	return ssn.inheritedQueueValue(queueID, func(queue *scheduling.Queue) (string, bool) {
+		class, found := queue.Labels[api.QueueClassLabel]
+		return class, found
+	})
+}
+
+// inheritedQueueValue returns the value of the queue, or of its closest ancestor with a value, empty if none has one.
+func (ssn *Session) inheritedQueueValue(queueID api.QueueID, value func(queue *scheduling.Queue) (string, bool)) string {
+	visited := sets.New[api.QueueID]()
+	for !visited.Has(queueID) {
+		visited.Insert(queueID)
+		queue, found := ssn.Queues[queueID]
+		if !found || queue.Queue == nil {
+			return ""
+		}
+		if v, found := value(queue.Queue); found {
+			return v
+		}
+		if queue.Queue.Spec.Parent == "" {
+			return ""
+		}
+		queueID = api.QueueID(queue.Queue.Spec.Parent)
+	}
+	return ""
+}
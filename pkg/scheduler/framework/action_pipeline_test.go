@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+)
+
+// queuesAction records the queues scheduled by the pipeline being executed.
+type queuesAction struct {
+	queues []string
+}
+
+func (qa *queuesAction) Name() string  { return "queues" }
+func (qa *queuesAction) Initialize()   {}
+func (qa *queuesAction) UnInitialize() {}
+func (qa *queuesAction) Execute(ssn *Session) {
+	qa.queues = nil
+	for queueID := range ssn.Queues {
+		if ssn.QueueInPipeline(queueID) {
+			qa.queues = append(qa.queues, string(queueID))
+		}
+	}
+	sort.Strings(qa.queues)
+}
+
+func buildClassQueue(name, parent, class string) *api.QueueInfo {
+	queue := &scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       scheduling.QueueSpec{Parent: parent},
+	}
+	if class != "" {
+		queue.Labels = map[string]string{api.QueueClassLabel: class}
+	}
+	return api.NewQueueInfo(queue)
+}
+
+func TestExecutePipeline(t *testing.T) {
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, nil)
+	defer CloseSession(ssn)
+	ssn.Queues = map[api.QueueID]*api.QueueInfo{}
+	for _, queue := range []*api.QueueInfo{
+		buildClassQueue("root", "", ""),
+		buildClassQueue("prod", "root", "prod"),
+		// the child queues inherit the class of their parent
+		buildClassQueue("prod-web", "prod", ""),
+		buildClassQueue("batch", "root", "batch"),
+		buildClassQueue("dev", "root", ""),
+		// the classes without pipeline are scheduled by the default pipeline
+		buildClassQueue("test", "root", "test"),
+	} {
+		ssn.Queues[queue.UID] = queue
+	}
+
+	action := &queuesAction{}
+	ssn.ExecutePipeline(&ActionPipeline{Actions: []Action{action}}, nil)
+	if expected := []string{"batch", "dev", "prod", "prod-web", "root", "test"}; !reflect.DeepEqual(action.queues, expected) {
+		t.Errorf("expected all the queues without pipeline classes, got %v", action.queues)
+	}
+
+	ssn.SetPipelineClasses(sets.New("prod", "batch"))
+	tests := []struct {
+		class    string
+		expected []string
+	}{
+		{class: "prod", expected: []string{"prod", "prod-web"}},
+		{class: "batch", expected: []string{"batch"}},
+		{class: "", expected: []string{"dev", "root", "test"}},
+	}
+	for _, test := range tests {
+		var observed []string
+		ssn.ExecutePipeline(&ActionPipeline{Class: test.class, Actions: []Action{action}}, func(action Action, start time.Time) {
+			observed = append(observed, action.Name())
+		})
+		if !reflect.DeepEqual(action.queues, test.expected) {
+			t.Errorf("expected the pipeline of class %q to schedule %v, got %v", test.class, test.expected, action.queues)
+		}
+		if !reflect.DeepEqual(observed, []string{"queues"}) {
+			t.Errorf("expected the action to be observed, got %v", observed)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/audit"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+var (
+	auditMutex  sync.RWMutex
+	auditLogger *audit.Logger
+)
+
+// SetAuditLogger sets the logger of the decisions of the sessions opened afterwards, nil disables the audit.
+func SetAuditLogger(l *audit.Logger) {
+	auditMutex.Lock()
+	defer auditMutex.Unlock()
+	auditLogger = l
+}
+
+func getAuditLogger() *audit.Logger {
+	auditMutex.RLock()
+	defer auditMutex.RUnlock()
+	return auditLogger
+}
+
+// sessionAudit collects the decisions of one session, they are logged when the session is closed.
+type sessionAudit struct {
+	logger  *audit.Logger
+	records []audit.Record
+	// voters are the plugins which selected the victims of the session.
+	voters map[api.TaskID][]string
+	// placementVoters are the plugins filtering and scoring the nodes of the session, computed once.
+	placementVoters []string
+}
+
+// newSessionAudit returns the audit of a new session, nil if the audit is disabled.
+func newSessionAudit() *sessionAudit {
+	logger := getAuditLogger()
+	if logger == nil {
+		return nil
+	}
+	return &sessionAudit{
+		logger: logger,
+		voters: map[api.TaskID][]string{},
+	}
+}
+
+// recordVictimVoters remembers the plugins which selected the victims, for the audit of their eviction.
+func (ssn *Session) recordVictimVoters(victims []*api.TaskInfo, plugins []string) {
+	if ssn.audit == nil || len(plugins) == 0 {
+		return
+	}
+	for _, victim := range victims {
+		voters := sets.New(ssn.audit.voters[victim.UID]...).Insert(plugins...)
+		ssn.audit.voters[victim.UID] = sets.List(voters)
+	}
+}
+
+// getPlacementVoters returns the plugins with enabled predicate or node order functions.
+func (ssn *Session) getPlacementVoters() []string {
+	if ssn.audit.placementVoters != nil {
+		return ssn.audit.placementVoters
+	}
+	voters := []string{}
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			_, predicate := ssn.predicateFns[plugin.Name]
+			_, nodeOrder := ssn.nodeOrderFns[plugin.Name]
+			_, batchNodeOrder := ssn.batchNodeOrderFns[plugin.Name]
+			if (predicate && isEnabled(plugin.EnabledPredicate)) ||
+				((nodeOrder || batchNodeOrder) && isEnabled(plugin.EnabledNodeOrder)) {
+				voters = append(voters, plugin.Name)
+			}
+		}
+	}
+	ssn.audit.placementVoters = voters
+	return voters
+}
+
+// recordDecision traces the decision about the task, and records it in the audit log if enabled.
+// The victims are the tasks evicted on the node for the task.
+func (ssn *Session) recordDecision(decision string, task *api.TaskInfo, reason string, victims []*api.TaskInfo) {
+	var attrs []attribute.KeyValue
+	if reason != "" {
+		attrs = append(attrs, attribute.String("reason", reason))
+	}
+	if len(victims) != 0 {
+		attrs = append(attrs, attribute.Int("victims", len(victims)))
+	}
+	ssn.TraceDecision(decision, task, attrs...)
+
+	if ssn.audit == nil {
+		return
+	}
+	record := audit.Record{
+		Time:          time.Now(),
+		CorrelationID: string(ssn.UID),
+		Action:        ssn.currentAction,
+		Decision:      decision,
+		Pod:           task.Namespace + "/" + task.Name,
+		Node:          task.NodeName,
+		Job:           string(task.Job),
+		Reason:        reason,
+	}
+	if job, found := ssn.Jobs[task.Job]; found {
+		record.Queue = string(job.Queue)
+	}
+	if decision == DecisionEvict {
+		record.Plugins = ssn.audit.voters[task.UID]
+	} else {
+		record.Plugins = ssn.getPlacementVoters()
+	}
+	for _, victim := range victims {
+		record.Victims = append(record.Victims, victim.Namespace+"/"+victim.Name)
+	}
+	if task.InitResreq != nil {
+		record.Requests = util.ConvertRes2ResList(task.InitResreq)
+	}
+	if task.Pod != nil && !task.Pod.CreationTimestamp.IsZero() {
+		created := task.Pod.CreationTimestamp.Time
+		record.Created = &created
+	}
+	ssn.audit.records = append(ssn.audit.records, record)
+}
+
+// flushAudit logs the decisions of the session.
+func (ssn *Session) flushAudit() {
+	if ssn.audit == nil {
+		return
+	}
+	ssn.audit.logger.Log(ssn.audit.records)
+	ssn.audit.records = nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"reflect"
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/audit"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// memorySink keeps the audit records in memory.
+type memorySink struct {
+	records []audit.Record
+}
+
+func (ms *memorySink) Write(records []audit.Record) error {
+	ms.records = append(ms.records, records...)
+	return nil
+}
+
+func (ms *memorySink) Close() error { return nil }
+
+func TestSessionAudit(t *testing.T) {
+	sink := &memorySink{}
+	logger := audit.NewLogger(sink)
+	SetAuditLogger(logger)
+	defer SetAuditLogger(nil)
+
+	trueValue := true
+	preemptable := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return preemptees, 1
+	}
+	abstain := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return nil, 0
+	}
+	ssn := &Session{
+		UID: "cycle-1",
+		Jobs: map[api.JobID]*api.JobInfo{
+			"ns/job1": {UID: "ns/job1", Queue: "q1"},
+		},
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{
+			{Name: "gang", EnabledPreemptable: &trueValue},
+			{Name: "priority", EnabledPreemptable: &trueValue},
+			{Name: "drf", EnabledPreemptable: &trueValue},
+		}}},
+		preemptableFns: map[string]api.EvictableFn{
+			"gang":     preemptable,
+			"priority": preemptable,
+			"drf":      abstain,
+		},
+		audit:         newSessionAudit(),
+		currentAction: "preempt",
+	}
+
+	preemptor := &api.TaskInfo{UID: "preemptor", Namespace: "ns", Name: "p1", Job: "ns/job1", TransactionContext: api.TransactionContext{NodeName: "n1"}}
+	victim := &api.TaskInfo{UID: "victim", Namespace: "ns", Name: "p2", Job: "ns/job2", TransactionContext: api.TransactionContext{NodeName: "n1"}}
+	if victims := ssn.Preemptable(preemptor, []*api.TaskInfo{victim}); len(victims) != 1 {
+		t.Fatalf("expected 1 victim, got %d", len(victims))
+	}
+	ssn.recordDecision(DecisionEvict, victim, "preempt", nil)
+	ssn.recordDecision(DecisionPipeline, preemptor, "", []*api.TaskInfo{victim})
+	ssn.flushAudit()
+	if err := logger.Close(); err != nil {
+		t.Fatalf("failed to close logger: %v", err)
+	}
+
+	if len(sink.records) != 2 {
+		t.Fatalf("expected 2 audit records, got %d", len(sink.records))
+	}
+	evict, pipeline := sink.records[0], sink.records[1]
+	if evict.Decision != DecisionEvict || evict.Pod != "ns/p2" || evict.Node != "n1" || evict.Reason != "preempt" ||
+		evict.Action != "preempt" || evict.CorrelationID != "cycle-1" {
+		t.Errorf("unexpected eviction record: %+v", evict)
+	}
+	if !reflect.DeepEqual(evict.Plugins, []string{"gang", "priority"}) {
+		t.Errorf("expected the non abstaining plugins to vote for the victim, got %v", evict.Plugins)
+	}
+	if pipeline.Decision != DecisionPipeline || pipeline.Queue != "q1" || !reflect.DeepEqual(pipeline.Victims, []string{"ns/p2"}) {
+		t.Errorf("unexpected pipeline record: %+v", pipeline)
+	}
+	if err := audit.Verify(sink.records); err != nil {
+		t.Errorf("expected an unbroken chain, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"net/url"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// CheckpointTimeoutKey is the argument of the actions, overridable per queue, of the time the victims of the
+	// queue are given to checkpoint before they are evicted. The victims are evicted at once if it is not set.
+	CheckpointTimeoutKey = "checkpointTimeout"
+	// CheckpointWebhookKey is the argument of the actions, overridable per queue by the queueArguments of the
+	// configuration only, of the URL called for the victims of the queue to checkpoint. Without webhook, the victims
+	// acknowledge the checkpoint by annotating their pods.
+	CheckpointWebhookKey = api.CheckpointWebhookArgument
+)
+
+// checkpointPolicies are the checkpoint policies of the victims of an action per queue.
+type checkpointPolicies struct {
+	action   string
+	defaults Arguments
+	queues   QueueArguments
+	// configured are the queueArguments of the configuration of the action, by queue name
+	configured map[string]map[string]interface{}
+}
+
+func newCheckpointPolicies(ssn *Session) *checkpointPolicies {
+	cp := &checkpointPolicies{
+		action:   ssn.currentAction,
+		defaults: GetArgOfActionFromConf(ssn.Configurations, ssn.currentAction),
+		queues:   ssn.GetQueueArgsOfAction(ssn.currentAction),
+	}
+	for _, c := range ssn.Configurations {
+		if c.Name == ssn.currentAction {
+			cp.configured = c.QueueArguments
+			break
+		}
+	}
+	return cp
+}
+
+// policyOf returns the checkpoint policy of the victims of the queue, nil if they are evicted at once. The webhook is
+// only taken from the configuration of the scheduler, as the scheduler posts to it.
+func (cp *checkpointPolicies) policyOf(queue api.QueueID) *api.CheckpointPolicy {
+	var timeout, webhook string
+	cp.defaults.GetString(&timeout, CheckpointTimeoutKey)
+	cp.defaults.GetString(&webhook, CheckpointWebhookKey)
+	timeout = cp.queues.GetString(queue, CheckpointTimeoutKey, timeout)
+	Arguments(cp.configured[string(queue)]).GetString(&webhook, CheckpointWebhookKey)
+	if timeout == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(timeout)
+	if err != nil || d <= 0 {
+		klog.Warningf("Invalid %s <%s> of action %s for queue <%s>, evict the victims at once",
+			CheckpointTimeoutKey, timeout, cp.action, queue)
+		return nil
+	}
+	if webhook != "" {
+		if u, err := url.Parse(webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			klog.Warningf("Invalid %s <%s> of action %s for queue <%s>, evict the victims at once",
+				CheckpointWebhookKey, webhook, cp.action, queue)
+			return nil
+		}
+	}
+	return &api.CheckpointPolicy{Timeout: d, Webhook: webhook}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestCheckpointPolicies(t *testing.T) {
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, []conf.Configuration{
+		{
+			Name:      "reclaim",
+			Arguments: map[string]interface{}{CheckpointTimeoutKey: "1m"},
+			QueueArguments: map[string]map[string]interface{}{
+				"training": {CheckpointTimeoutKey: "5m", CheckpointWebhookKey: "http://checkpointer/checkpoint"},
+				"invalid":  {CheckpointTimeoutKey: "soon"},
+				"local":    {CheckpointTimeoutKey: "5m", CheckpointWebhookKey: "file:///etc/passwd"},
+			},
+		},
+	})
+	defer CloseSession(ssn)
+	ssn.Queues = map[api.QueueID]*api.QueueInfo{}
+	for _, queue := range []*api.QueueInfo{
+		buildArgumentsQueue("default", "", ""),
+		buildArgumentsQueue("training", "", ""),
+		buildArgumentsQueue("invalid", "", ""),
+		// the annotation of the queue overrides the checkpoint of the action
+		buildArgumentsQueue("inference", "", `{"reclaim": {"checkpointTimeout": "0s"}}`),
+		// the annotation of the queue may not set the webhook
+		buildArgumentsQueue("annotated", "", `{"reclaim": {"checkpointWebhook": "http://169.254.169.254/"}}`),
+		buildArgumentsQueue("local", "", ""),
+	} {
+		ssn.Queues[queue.UID] = queue
+	}
+
+	tests := []struct {
+		action   string
+		queue    api.QueueID
+		expected *api.CheckpointPolicy
+	}{
+		{action: "reclaim", queue: "default", expected: &api.CheckpointPolicy{Timeout: time.Minute}},
+		{action: "reclaim", queue: "training", expected: &api.CheckpointPolicy{Timeout: 5 * time.Minute, Webhook: "http://checkpointer/checkpoint"}},
+		{action: "reclaim", queue: "invalid", expected: nil},
+		{action: "reclaim", queue: "inference", expected: nil},
+		{action: "reclaim", queue: "annotated", expected: &api.CheckpointPolicy{Timeout: time.Minute}},
+		{action: "reclaim", queue: "local", expected: nil},
+		{action: "preempt", queue: "training", expected: nil},
+	}
+	for _, test := range tests {
+		ssn.currentAction = test.action
+		if policy := newCheckpointPolicies(ssn).policyOf(test.queue); !reflect.DeepEqual(policy, test.expected) {
+			t.Errorf("expected checkpoint policy %v of action %s for queue %s, got %v", test.expected, test.action, test.queue, policy)
+		}
+	}
+}
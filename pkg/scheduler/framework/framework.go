@@ -21,6 +21,7 @@ limitations under the License.
 package framework
 
 import (
+	"fmt"
 	"time"
 
 	"k8s.io/klog/v2"
@@ -33,11 +34,18 @@ import (
 // OpenSession start the session
 func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Configuration) *Session {
 	openStart := time.Now()
+	trace := startCycleSpan()
 	ssn := openSession(cache)
+	ssn.trace = trace
+	ssn.audit = newSessionAudit()
+	ssn.setCycleAttributes()
+	_, endOpenSpan := ssn.startSpan("OpenSession")
+	defer endOpenSpan()
 	ssn.Tiers = tiers
 	ssn.Configurations = configurations
 	ssn.NodeMap = GenerateNodeMapAndSlice(ssn.Nodes)
 	ssn.PodLister = NewPodLister(ssn)
+	prunePluginStates(ssn)
 
 	for _, tier := range tiers {
 		for _, plugin := range tier.Plugins {
@@ -46,12 +54,18 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 			} else {
 				plugin := pb(plugin.Arguments)
 				ssn.plugins[plugin.Name()] = plugin
+				metrics.UpdatePluginDisabled(plugin.Name(), false)
 				onSessionOpenStart := time.Now()
-				plugin.OnSessionOpen(ssn)
+				if !ssn.callPlugin(plugin.Name(), metrics.OnSessionOpen, func() { plugin.OnSessionOpen(ssn) }) {
+					// The functions of a plugin failing to open may be partially registered, disable it at once.
+					ssn.disablePlugin(plugin.Name(), fmt.Sprintf("plugin %s panicked in %s", plugin.Name(), metrics.OnSessionOpen))
+				}
 				metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenStart))
 			}
 		}
 	}
+	ssn.PruneDisabledPlugins()
+	ssn.reviewResizes()
 
 	ssn.InitCycleState()
 	metrics.UpdateOpenSessionDuration(time.Since(openStart))
@@ -61,9 +75,14 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 
 // CloseSession close the session
 func CloseSession(ssn *Session) {
+	defer ssn.endCycleSpan()
+	defer ssn.flushAudit()
+	_, endCloseSpan := ssn.startSpan("CloseSession")
+	defer endCloseSpan()
+
 	for _, plugin := range ssn.plugins {
 		onSessionCloseStart := time.Now()
-		plugin.OnSessionClose(ssn)
+		ssn.callPlugin(plugin.Name(), metrics.OnSessionClose, func() { plugin.OnSessionClose(ssn) })
 		metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionClose, metrics.Duration(onSessionCloseStart))
 	}
 
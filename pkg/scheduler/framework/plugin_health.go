@@ -0,0 +1,253 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// PluginDisabledReason is the reason of the event recorded when a plugin is disabled in a session.
+	PluginDisabledReason = "PluginDisabled"
+	// PluginFailedReason is the reason of the validate result of a job when a plugin panics validating it.
+	PluginFailedReason = "PluginFailed"
+
+	// voteAbstain and voteReject are the votes of the guarded functions when the plugin panics,
+	// the same as Abstain and Reject of the plugins.
+	voteAbstain = 0
+	voteReject  = -1
+
+	// schedulerPodNameEnv and schedulerPodNamespaceEnv identify the scheduler pod the plugin events are recorded on.
+	schedulerPodNameEnv      = "SCHEDULER_POD_NAME"
+	schedulerPodNamespaceEnv = "SCHEDULER_POD_NAMESPACE"
+)
+
+// PluginPanicThreshold is the number of panics of a plugin in a session after which the plugin
+// is disabled for the rest of the session.
+var PluginPanicThreshold = 3
+
+// pluginHealth records the failures of the plugins in a session. Plugin functions may be invoked
+// concurrently, e.g. the predicates, so the records are guarded by a mutex.
+type pluginHealth struct {
+	sync.Mutex
+	panics   map[string]int
+	disabled sets.Set[string]
+	// pruned are the disabled plugins already removed from the tiers of the session.
+	pruned sets.Set[string]
+	// anyDisabled avoids locking in the hot path of the plugin functions while all plugins are healthy.
+	anyDisabled atomic.Bool
+}
+
+func newPluginHealth() *pluginHealth {
+	return &pluginHealth{
+		panics:   map[string]int{},
+		disabled: sets.New[string](),
+		pruned:   sets.New[string](),
+	}
+}
+
+// PluginFailures returns the number of panics of the plugin in this session.
+func (ssn *Session) PluginFailures(name string) int {
+	if ssn.pluginHealth == nil {
+		return 0
+	}
+	ssn.pluginHealth.Lock()
+	defer ssn.pluginHealth.Unlock()
+	return ssn.pluginHealth.panics[name]
+}
+
+// IsPluginDisabled returns whether the plugin has been disabled in this session.
+func (ssn *Session) IsPluginDisabled(name string) bool {
+	if ssn.pluginHealth == nil || !ssn.pluginHealth.anyDisabled.Load() {
+		return false
+	}
+	ssn.pluginHealth.Lock()
+	defer ssn.pluginHealth.Unlock()
+	return ssn.pluginHealth.disabled.Has(name)
+}
+
+// pluginPanicked records a panic of the plugin in the given extension point,
+// and disables the plugin once it panicked PluginPanicThreshold times in this session.
+func (ssn *Session) pluginPanicked(name, extensionPoint string, r interface{}) {
+	metrics.RegisterPluginPanic(name, extensionPoint)
+	klog.Errorf("Plugin <%s> panicked in %s in Session <%s>: %v\n%s", name, extensionPoint, ssn.UID, r, debug.Stack())
+	if ssn.pluginHealth == nil {
+		return
+	}
+
+	ssn.pluginHealth.Lock()
+	ssn.pluginHealth.panics[name]++
+	panics := ssn.pluginHealth.panics[name]
+	ssn.pluginHealth.Unlock()
+
+	if panics >= PluginPanicThreshold {
+		ssn.disablePlugin(name, fmt.Sprintf("plugin %s panicked %d times, last in %s", name, panics, extensionPoint))
+	}
+}
+
+// disablePlugin disables the plugin for the rest of the session. Until it is pruned from the tiers,
+// the functions of the disabled plugin return the same results as when they panic.
+func (ssn *Session) disablePlugin(name, message string) {
+	ssn.pluginHealth.Lock()
+	if ssn.pluginHealth.disabled.Has(name) {
+		ssn.pluginHealth.Unlock()
+		return
+	}
+	ssn.pluginHealth.disabled.Insert(name)
+	ssn.pluginHealth.anyDisabled.Store(true)
+	ssn.pluginHealth.Unlock()
+
+	metrics.UpdatePluginDisabled(name, true)
+	klog.Errorf("Plugin <%s> is disabled in Session <%s>: %s", name, ssn.UID, message)
+	ssn.recordSchedulerEvent(v1.EventTypeWarning, PluginDisabledReason, message)
+}
+
+// PruneDisabledPlugins removes the disabled plugins from the tiers of the session, so that the remaining
+// plugins of the tiers keep making the decisions. It must not be called while plugin functions are running,
+// ExecuteAction calls it after every action.
+func (ssn *Session) PruneDisabledPlugins() {
+	if ssn.pluginHealth == nil {
+		return
+	}
+	ssn.pluginHealth.Lock()
+	defer ssn.pluginHealth.Unlock()
+	if ssn.pluginHealth.disabled.Len() == ssn.pluginHealth.pruned.Len() {
+		return
+	}
+
+	// The tiers are shared with the scheduler configuration, so they are copied instead of being updated in place.
+	tiers := make([]conf.Tier, 0, len(ssn.Tiers))
+	for _, tier := range ssn.Tiers {
+		plugins := make([]conf.PluginOption, 0, len(tier.Plugins))
+		for _, plugin := range tier.Plugins {
+			if !ssn.pluginHealth.disabled.Has(plugin.Name) {
+				plugins = append(plugins, plugin)
+			}
+		}
+		tiers = append(tiers, conf.Tier{Plugins: plugins})
+	}
+	ssn.Tiers = tiers
+	ssn.pluginHealth.pruned = ssn.pluginHealth.disabled.Clone()
+}
+
+// recordSchedulerEvent records an event on the scheduler pod, if it is known.
+func (ssn *Session) recordSchedulerEvent(eventType, reason, message string) {
+	RecordSchedulerEvent(ssn.recorder, eventType, reason, message)
+}
+
+// RecordSchedulerEvent records an event on the scheduler pod, identified by the SCHEDULER_POD_NAME and
+// SCHEDULER_POD_NAMESPACE environment variables. The event is dropped if the pod is unknown.
+func RecordSchedulerEvent(recorder record.EventRecorder, eventType, reason, message string) {
+	name, namespace := os.Getenv(schedulerPodNameEnv), os.Getenv(schedulerPodNamespaceEnv)
+	if recorder == nil || name == "" || namespace == "" {
+		return
+	}
+	ref := &v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Name: name, Namespace: namespace}
+	recorder.Event(ref, eventType, reason, message)
+}
+
+// callPlugin invokes the callback of the plugin, and returns false if the plugin is disabled or the callback panics.
+func (ssn *Session) callPlugin(name, extensionPoint string, fn func()) (ok bool) {
+	if ssn.IsPluginDisabled(name) {
+		return false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ssn.pluginPanicked(name, extensionPoint, r)
+			ok = false
+		}
+	}()
+	fn()
+	return true
+}
+
+// guard invokes fn on behalf of the plugin, and returns fallback if the plugin is disabled or fn panics.
+func guard[T any](ssn *Session, name, extensionPoint string, fallback T, fn func() T) (result T) {
+	if ssn.IsPluginDisabled(name) {
+		return fallback
+	}
+	if EnablePluginProfiling {
+		defer ssn.observePluginFn(name, extensionPoint, time.Now())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ssn.pluginPanicked(name, extensionPoint, r)
+			result = fallback
+		}
+	}()
+	return fn()
+}
+
+// guardLazy is guard for the fallbacks which are costly to build, e.g. errors: fallback is only called if the plugin
+// is disabled or fn panics, so that nothing is allocated on the hot path.
+func guardLazy[T any](ssn *Session, name, extensionPoint string, fallback func() T, fn func() T) (result T) {
+	if ssn.IsPluginDisabled(name) {
+		return fallback()
+	}
+	if EnablePluginProfiling {
+		defer ssn.observePluginFn(name, extensionPoint, time.Now())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ssn.pluginPanicked(name, extensionPoint, r)
+			result = fallback()
+		}
+	}()
+	return fn()
+}
+
+// guard2 is guard for the functions with two results.
+func guard2[T1, T2 any](ssn *Session, name, extensionPoint string, fallback1 T1, fallback2 T2, fn func() (T1, T2)) (result1 T1, result2 T2) {
+	if ssn.IsPluginDisabled(name) {
+		return fallback1, fallback2
+	}
+	if EnablePluginProfiling {
+		defer ssn.observePluginFn(name, extensionPoint, time.Now())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ssn.pluginPanicked(name, extensionPoint, r)
+			result1, result2 = fallback1, fallback2
+		}
+	}()
+	return fn()
+}
+
+// pluginPanicError is returned by the guarded functions with an error result when the plugin panics,
+// so that a panicking plugin fails closed, e.g. a node is filtered out rather than accepted.
+func pluginPanicError(name, extensionPoint string) error {
+	return fmt.Errorf("plugin %s failed in %s", name, extensionPoint)
+}
+
+// pluginPanicErrorFn returns the lazy fallback of guardLazy returning pluginPanicError, to be built once per plugin
+// function rather than on every call.
+func pluginPanicErrorFn(name, extensionPoint string) func() error {
+	return func() error { return pluginPanicError(name, extensionPoint) }
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// fakePlugin registers a predicate, which panics if the plugin is faulty.
+type fakePlugin struct {
+	name        string
+	panicOnOpen bool
+	faulty      bool
+	predicates  int
+}
+
+func (fp *fakePlugin) Name() string { return fp.name }
+
+func (fp *fakePlugin) OnSessionOpen(ssn *Session) {
+	if fp.panicOnOpen {
+		panic("open failed")
+	}
+	ssn.AddPredicateFn(fp.name, func(task *api.TaskInfo, node *api.NodeInfo) error {
+		fp.predicates++
+		if fp.faulty {
+			panic("predicate failed")
+		}
+		return nil
+	})
+}
+
+func (fp *fakePlugin) OnSessionClose(ssn *Session) {}
+
+func TestPluginPanicIsolation(t *testing.T) {
+	faulty := &fakePlugin{name: "faulty", faulty: true}
+	healthy := &fakePlugin{name: "healthy"}
+	broken := &fakePlugin{name: "broken", panicOnOpen: true}
+	for _, p := range []*fakePlugin{faulty, healthy, broken} {
+		RegisterPluginBuilder(p.name, func(Arguments) Plugin { return p })
+	}
+	defer CleanupPluginBuilders()
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{Plugins: []conf.PluginOption{
+			{Name: "broken", EnabledPredicate: &trueValue},
+			{Name: "faulty", EnabledPredicate: &trueValue},
+		}},
+		{Plugins: []conf.PluginOption{
+			{Name: "healthy", EnabledPredicate: &trueValue},
+		}},
+	}
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), tiers, nil)
+	defer CloseSession(ssn)
+
+	if !ssn.IsPluginDisabled("broken") {
+		t.Errorf("expected plugin panicking on session open to be disabled")
+	}
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			if plugin.Name == "broken" {
+				t.Errorf("expected plugin broken to be pruned from the tiers")
+			}
+		}
+	}
+	if len(tiers[0].Plugins) != 2 {
+		t.Errorf("expected the configured tiers to be untouched, got %v", tiers[0].Plugins)
+	}
+
+	task, node := &api.TaskInfo{Name: "t1"}, &api.NodeInfo{Name: "n1"}
+	for i := 1; i <= PluginPanicThreshold; i++ {
+		if err := ssn.PredicateFn(task, node); err == nil {
+			t.Fatalf("expected predicate to fail when the plugin panics")
+		}
+		if failures := ssn.PluginFailures("faulty"); failures != i {
+			t.Errorf("expected %d failures of plugin faulty, got %d", i, failures)
+		}
+	}
+	if !ssn.IsPluginDisabled("faulty") {
+		t.Fatalf("expected plugin faulty to be disabled after %d panics", PluginPanicThreshold)
+	}
+	if healthy.predicates != 0 {
+		t.Errorf("expected the lower tier not to be called while the faulty plugin fails, got %d calls", healthy.predicates)
+	}
+
+	// The disabled plugin keeps failing closed until it is pruned from the tiers.
+	if err := ssn.PredicateFn(task, node); err == nil {
+		t.Errorf("expected predicate of disabled plugin to fail before pruning")
+	}
+	ssn.PruneDisabledPlugins()
+	if err := ssn.PredicateFn(task, node); err != nil {
+		t.Errorf("expected predicate to pass with the remaining plugins, got %v", err)
+	}
+	if healthy.predicates != 1 {
+		t.Errorf("expected the healthy plugin to be called once, got %d", healthy.predicates)
+	}
+	if faulty.predicates != PluginPanicThreshold {
+		t.Errorf("expected the disabled plugin not to be called anymore, got %d calls", faulty.predicates)
+	}
+}
+
+func TestGuardedPredicateDoesNotAllocate(t *testing.T) {
+	healthy := &fakePlugin{name: "healthy"}
+	RegisterPluginBuilder(healthy.name, func(Arguments) Plugin { return healthy })
+	defer CleanupPluginBuilders()
+
+	trueValue := true
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{Name: "healthy", EnabledPredicate: &trueValue}}}}
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), tiers, nil)
+	defer CloseSession(ssn)
+
+	task, node := &api.TaskInfo{Name: "t1"}, &api.NodeInfo{Name: "n1"}
+	predicate := ssn.predicateFns["healthy"]
+	if allocs := testing.AllocsPerRun(100, func() { _ = predicate(task, node) }); allocs != 0 {
+		t.Errorf("expected the predicate of a healthy plugin not to allocate, got %v allocations", allocs)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sync"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+var (
+	pluginStatesMutex sync.Mutex
+	// pluginStates are the states the plugins keep across the sessions, by plugin then by name.
+	pluginStates = map[string]map[string]pluginState{}
+)
+
+// pluginState is a state a plugin keeps across the sessions, as the plugins are rebuilt in every session.
+type pluginState interface {
+	// prune forgets the values of the jobs and queues which are not in the session.
+	prune(ssn *Session)
+}
+
+// StateMap is a map a plugin keeps across the sessions for its jobs or its queues. The framework owns it: the map is
+// locked by its methods, and the values of the jobs or queues deleted from the cluster are forgotten when a session
+// opens.
+type StateMap[K api.JobID | api.QueueID, V any] struct {
+	mutex  sync.Mutex
+	values map[K]V
+	// exists returns whether the job or queue of the key is in the session.
+	exists func(ssn *Session, key K) bool
+}
+
+// StateValue is a value a plugin keeps across the sessions, locked by its methods.
+type StateValue[V any] struct {
+	mutex sync.Mutex
+	value V
+}
+
+// JobStates returns the map the plugin keeps for its jobs under the name, created on the first call.
+func JobStates[V any](plugin, name string) *StateMap[api.JobID, V] {
+	return registerPluginState(plugin, name, func() *StateMap[api.JobID, V] {
+		return &StateMap[api.JobID, V]{
+			values: map[api.JobID]V{},
+			exists: func(ssn *Session, jobID api.JobID) bool {
+				_, found := ssn.Jobs[jobID]
+				return found
+			},
+		}
+	})
+}
+
+// QueueStates returns the map the plugin keeps for its queues under the name, created on the first call.
+func QueueStates[V any](plugin, name string) *StateMap[api.QueueID, V] {
+	return registerPluginState(plugin, name, func() *StateMap[api.QueueID, V] {
+		return &StateMap[api.QueueID, V]{
+			values: map[api.QueueID]V{},
+			exists: func(ssn *Session, queueID api.QueueID) bool {
+				_, found := ssn.Queues[queueID]
+				return found
+			},
+		}
+	})
+}
+
+// PluginValue returns the value the plugin keeps under the name, set to initial on the first call.
+func PluginValue[V any](plugin, name string, initial V) *StateValue[V] {
+	return registerPluginState(plugin, name, func() *StateValue[V] {
+		return &StateValue[V]{value: initial}
+	})
+}
+
+func registerPluginState[S pluginState](plugin, name string, create func() S) S {
+	pluginStatesMutex.Lock()
+	defer pluginStatesMutex.Unlock()
+	if state, found := pluginStates[plugin][name]; found {
+		typed, ok := state.(S)
+		if !ok {
+			panic(fmt.Sprintf("state %s of plugin %s is registered with type %T", name, plugin, state))
+		}
+		return typed
+	}
+	if pluginStates[plugin] == nil {
+		pluginStates[plugin] = map[string]pluginState{}
+	}
+	state := create()
+	pluginStates[plugin][name] = state
+	return state
+}
+
+// prunePluginStates forgets the values the plugins keep for the jobs and queues which are not in the session.
+func prunePluginStates(ssn *Session) {
+	pluginStatesMutex.Lock()
+	defer pluginStatesMutex.Unlock()
+	for _, states := range pluginStates {
+		for _, state := range states {
+			state.prune(ssn)
+		}
+	}
+}
+
+// Get returns the value of the key, false if it has none.
+func (m *StateMap[K, V]) Get(key K) (V, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	value, found := m.values[key]
+	return value, found
+}
+
+// Set sets the value of the key.
+func (m *StateMap[K, V]) Set(key K, value V) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.values[key] = value
+}
+
+// Delete forgets the value of the key.
+func (m *StateMap[K, V]) Delete(key K) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.values, key)
+}
+
+// Len returns the number of values in the map.
+func (m *StateMap[K, V]) Len() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.values)
+}
+
+// Reset forgets all the values.
+func (m *StateMap[K, V]) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.values = map[K]V{}
+}
+
+// Update calls fn with the values, which it reads and writes at once while the map is locked. fn must not call the
+// other methods of the map.
+func (m *StateMap[K, V]) Update(fn func(values map[K]V)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	fn(m.values)
+}
+
+func (m *StateMap[K, V]) prune(ssn *Session) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for key := range m.values {
+		if !m.exists(ssn, key) {
+			delete(m.values, key)
+		}
+	}
+}
+
+// Load returns the value.
+func (v *StateValue[V]) Load() V {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	return v.value
+}
+
+// Store sets the value.
+func (v *StateValue[V]) Store(value V) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.value = value
+}
+
+// Update calls fn with the value, which it reads and writes at once while the value is locked. fn must not call the
+// other methods of the value.
+func (v *StateValue[V]) Update(fn func(value *V)) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	fn(&v.value)
+}
+
+func (v *StateValue[V]) prune(*Session) {}
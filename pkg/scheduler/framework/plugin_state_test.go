@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestPluginStates(t *testing.T) {
+	jobs := JobStates[int]("test-plugin-states", "jobs")
+	queues := QueueStates[string]("test-plugin-states", "queues")
+	value := PluginValue("test-plugin-states", "value", 1)
+
+	if JobStates[int]("test-plugin-states", "jobs") != jobs {
+		t.Errorf("expected the same job states for the same plugin and name")
+	}
+	if PluginValue("test-plugin-states", "value", 2).Load() != 1 {
+		t.Errorf("expected the value kept from the first call")
+	}
+
+	jobs.Set("j1", 1)
+	jobs.Set("j2", 2)
+	queues.Set("q1", "a")
+	queues.Set("q2", "b")
+	value.Update(func(v *int) { *v++ })
+
+	prunePluginStates(&Session{
+		Jobs:   map[api.JobID]*api.JobInfo{"j1": {}},
+		Queues: map[api.QueueID]*api.QueueInfo{"q2": {}},
+	})
+	if _, found := jobs.Get("j1"); !found || jobs.Len() != 1 {
+		t.Errorf("expected only the job of the session kept, got %d jobs", jobs.Len())
+	}
+	if _, found := queues.Get("q2"); !found || queues.Len() != 1 {
+		t.Errorf("expected only the queue of the session kept, got %d queues", queues.Len())
+	}
+	if value.Load() != 2 {
+		t.Errorf("expected the value kept by the pruning, got %d", value.Load())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic registering the state with another type")
+		}
+	}()
+	JobStates[string]("test-plugin-states", "jobs")
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// SetProfile sets the scheduler profile of the session, empty for the default profile, and the names of all
+// the profiles of the scheduler. The session schedules all the jobs if no profile is set.
+func (ssn *Session) SetProfile(profile string, profiles sets.Set[string]) {
+	ssn.profile = profile
+	ssn.profiles = profiles
+}
+
+// Profile returns the scheduler profile of the session, empty for the default profile.
+func (ssn *Session) Profile() string {
+	return ssn.profile
+}
+
+// JobProfile returns the scheduler profile of the job, given by the SchedulerProfileKey label of its pods,
+// or else by the SchedulerProfileKey annotation of its queue or of the closest ancestor of its queue.
+// It returns empty for the jobs of the default profile.
+func (ssn *Session) JobProfile(job *api.JobInfo) string {
+	for _, task := range job.Tasks {
+		if task.Pod == nil {
+			continue
+		}
+		if profile, found := task.Pod.Labels[api.SchedulerProfileKey]; found {
+			return profile
+		}
+	}
+	return ssn.inheritedQueueValue(job.Queue, func(queue *scheduling.Queue) (string, bool) {
+		profile, found := queue.Annotations[api.SchedulerProfileKey]
+		return profile, found
+	})
+}
+
+// JobInProfile returns whether the job is scheduled by the profile of the session, the jobs of unknown
+// profiles are scheduled by the default profile.
+func (ssn *Session) JobInProfile(job *api.JobInfo) bool {
+	if ssn.profiles.Len() == 0 {
+		return true
+	}
+	profile := ssn.JobProfile(job)
+	if !ssn.profiles.Has(profile) {
+		profile = ""
+	}
+	return profile == ssn.profile
+}
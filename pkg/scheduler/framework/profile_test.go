@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+)
+
+func buildProfileJob(uid, queue, profile string) *api.JobInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: uid}}
+	if profile != "" {
+		pod.Labels = map[string]string{api.SchedulerProfileKey: profile}
+	}
+	job := api.NewJobInfo(api.JobID(uid), api.NewTaskInfo(pod))
+	job.Queue = api.QueueID(queue)
+	job.PodGroup = &api.PodGroup{}
+	return job
+}
+
+func TestJobInProfile(t *testing.T) {
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, nil)
+	defer CloseSession(ssn)
+
+	training := buildClassQueue("training", "", "")
+	training.Queue.Annotations = map[string]string{api.SchedulerProfileKey: "training"}
+	ssn.Queues = map[api.QueueID]*api.QueueInfo{
+		"training": training,
+		// the child queues inherit the profile of their parent
+		"training-child": buildClassQueue("training-child", "training", ""),
+		"web":            buildClassQueue("web", "", ""),
+	}
+	jobs := map[string]*api.JobInfo{
+		"queue-profile":       buildProfileJob("queue-profile", "training-child", ""),
+		"pod-profile":         buildProfileJob("pod-profile", "web", "training"),
+		"pod-profile-wins":    buildProfileJob("pod-profile-wins", "training", "web"),
+		"default-profile":     buildProfileJob("default-profile", "web", ""),
+		"unknown-pod-profile": buildProfileJob("unknown-pod-profile", "training", "unknown"),
+	}
+	ssn.Jobs = map[api.JobID]*api.JobInfo{}
+	for _, job := range jobs {
+		ssn.Jobs[job.UID] = job
+	}
+
+	for name, job := range jobs {
+		if !ssn.JobInProfile(job) {
+			t.Errorf("expected job %s to be scheduled without profiles", name)
+		}
+	}
+
+	ssn.SetProfile("training", sets.New("training", "web"))
+	expected := map[string]bool{
+		"queue-profile":       true,
+		"pod-profile":         true,
+		"pod-profile-wins":    false,
+		"default-profile":     false,
+		"unknown-pod-profile": false,
+	}
+	for name, job := range jobs {
+		if ssn.JobInProfile(job) != expected[name] {
+			t.Errorf("expected job %s in the training profile to be %v", name, expected[name])
+		}
+	}
+
+	// the conditions of the jobs of the other profiles are not updated
+	cond := &scheduling.PodGroupCondition{Type: scheduling.PodGroupUnschedulableType, Status: v1.ConditionTrue}
+	for _, name := range []string{"queue-profile", "default-profile"} {
+		if err := ssn.UpdatePodGroupCondition(jobs[name], cond.DeepCopy()); err != nil {
+			t.Fatalf("failed to update the condition of job %s: %v", name, err)
+		}
+	}
+	if len(jobs["queue-profile"].PodGroup.Status.Conditions) != 1 || len(jobs["default-profile"].PodGroup.Status.Conditions) != 0 {
+		t.Errorf("expected only the condition of the job of the training profile to be updated")
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+// noAction is the action label of the plugin functions invoked out of any action, e.g. on session close.
+const noAction = "none"
+
+// EnablePluginProfiling enables the execution time metrics of the plugin functions registered in the sessions.
+// It is disabled by default, as timing every plugin function adds overhead to the hot paths, e.g. the predicates.
+var EnablePluginProfiling = false
+
+// ExecuteAction executes the action in the session, the plugin functions invoked by the action are
+// profiled with the name of the action, and the action is traced as a child span of the session.
+// The plugins disabled during the action are pruned after it.
+func (ssn *Session) ExecuteAction(action Action) {
+	ssn.currentAction = action.Name()
+	ssn.Logger().V(4).Info("Execute action", "action", action.Name())
+	defer ssn.startActionSpan(action.Name())()
+	defer func() {
+		ssn.currentAction = ""
+		ssn.PruneDisabledPlugins()
+	}()
+	action.Execute(ssn)
+}
+
+// CurrentAction returns the name of the action being executed in the session.
+func (ssn *Session) CurrentAction() string {
+	return ssn.currentAction
+}
+
+// observePluginFn records the execution time of a plugin function.
+func (ssn *Session) observePluginFn(name, extensionPoint string, start time.Time) {
+	action := ssn.currentAction
+	if action == "" {
+		action = noAction
+	}
+	metrics.UpdatePluginFnDuration(name, extensionPoint, action, metrics.Duration(start))
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// predicateAction invokes the predicates of the session once.
+type predicateAction struct {
+	action string
+}
+
+func (pa *predicateAction) Name() string  { return pa.action }
+func (pa *predicateAction) Initialize()   {}
+func (pa *predicateAction) UnInitialize() {}
+func (pa *predicateAction) Execute(ssn *Session) {
+	if ssn.CurrentAction() != pa.action {
+		panic("unexpected current action " + ssn.CurrentAction())
+	}
+	_ = ssn.PredicateFn(&api.TaskInfo{Name: "t1"}, &api.NodeInfo{Name: "n1"})
+}
+
+func TestPluginProfiling(t *testing.T) {
+	EnablePluginProfiling = true
+	defer func() { EnablePluginProfiling = false }()
+
+	RegisterPluginBuilder("profiled", func(Arguments) Plugin { return &fakePlugin{name: "profiled"} })
+	defer CleanupPluginBuilders()
+
+	trueValue := true
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{Name: "profiled", EnabledPredicate: &trueValue}}}}
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), tiers, nil)
+	defer CloseSession(ssn)
+
+	ssn.ExecuteAction(&predicateAction{action: "profiling-test"})
+	if ssn.CurrentAction() != "" {
+		t.Errorf("expected no current action after the action, got %s", ssn.CurrentAction())
+	}
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	var count uint64
+	for _, family := range families {
+		if family.GetName() != "volcano_plugin_fn_latency_microseconds" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["plugin"] == "profiled" && labels["extension_point"] == "Predicate" && labels["action"] == "profiling-test" {
+				count += m.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	if count != 1 {
+		t.Errorf("expected 1 profiled predicate call, got %d", count)
+	}
+}
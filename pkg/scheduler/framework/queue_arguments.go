@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// QueueArguments are the arguments of an action overridden for the jobs of the queues, by queue.
+type QueueArguments map[api.QueueID]Arguments
+
+// GetBool returns the bool argument of the key for the queue, defaultValue if the queue does not override it.
+func (qa QueueArguments) GetBool(queueID api.QueueID, key string, defaultValue bool) bool {
+	value := defaultValue
+	qa[queueID].GetBool(&value, key)
+	return value
+}
+
+// GetInt returns the integer argument of the key for the queue, defaultValue if the queue does not override it.
+func (qa QueueArguments) GetInt(queueID api.QueueID, key string, defaultValue int) int {
+	value := defaultValue
+	qa[queueID].GetInt(&value, key)
+	return value
+}
+
+// GetString returns the string argument of the key for the queue, defaultValue if the queue does not override it.
+func (qa QueueArguments) GetString(queueID api.QueueID, key string, defaultValue string) string {
+	value := defaultValue
+	qa[queueID].GetString(&value, key)
+	return value
+}
+
+// GetQueueArgsOfAction returns the arguments of the action overridden for the queues of the session, the queues
+// without overrides are absent. The arguments are overridden by the queueArguments of the configuration of the
+// action, then by the volcano.sh/action-arguments annotation of the queue, inherited from its parent if absent.
+func (ssn *Session) GetQueueArgsOfAction(actionName string) QueueArguments {
+	var configured map[string]map[string]interface{}
+	for _, c := range ssn.Configurations {
+		if c.Name == actionName {
+			configured = c.QueueArguments
+			break
+		}
+	}
+
+	queueArgs := QueueArguments{}
+	for queueID, queue := range ssn.Queues {
+		arguments := Arguments{}
+		for key, value := range configured[queue.Name] {
+			arguments[key] = value
+		}
+		annotation := ssn.inheritedQueueValue(queueID, func(queue *scheduling.Queue) (string, bool) {
+			value, found := queue.Annotations[api.QueueActionArgumentsKey]
+			return value, found
+		})
+		if annotation != "" {
+			annotated, err := api.ParseQueueActionArguments(annotation)
+			if err != nil {
+				klog.Warningf("Ignore the action arguments of queue <%s>: %v", queue.Name, err)
+			}
+			for key, value := range annotated[actionName] {
+				arguments[key] = value
+			}
+		}
+		if len(arguments) > 0 {
+			queueArgs[queueID] = arguments
+		}
+	}
+	return queueArgs
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func buildArgumentsQueue(name, parent, arguments string) *api.QueueInfo {
+	queue := &scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       scheduling.QueueSpec{Parent: parent},
+	}
+	if arguments != "" {
+		queue.Annotations = map[string]string{api.QueueActionArgumentsKey: arguments}
+	}
+	return api.NewQueueInfo(queue)
+}
+
+func TestGetQueueArgsOfAction(t *testing.T) {
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, []conf.Configuration{
+		{
+			Name:      "allocate",
+			Arguments: map[string]interface{}{conf.EnablePredicateErrCacheKey: true},
+			QueueArguments: map[string]map[string]interface{}{
+				"batch": {conf.EnablePredicateErrCacheKey: false},
+				"dev":   {conf.EnablePredicateErrCacheKey: false, "limit": 3},
+			},
+		},
+	})
+	defer CloseSession(ssn)
+	ssn.Queues = map[api.QueueID]*api.QueueInfo{}
+	for _, queue := range []*api.QueueInfo{
+		buildArgumentsQueue("root", "", ""),
+		buildArgumentsQueue("batch", "root", ""),
+		// the annotation of the queue takes precedence over the configuration
+		buildArgumentsQueue("dev", "root", `{"allocate": {"predicateErrorCacheEnable": true}}`),
+		// the child queues inherit the annotation of their parent
+		buildArgumentsQueue("dev-team", "dev", ""),
+		// the invalid annotations are ignored
+		buildArgumentsQueue("test", "root", `{"allocate": false}`),
+	} {
+		ssn.Queues[queue.UID] = queue
+	}
+
+	queueArgs := ssn.GetQueueArgsOfAction("allocate")
+	tests := []struct {
+		queue    api.QueueID
+		expected bool
+	}{
+		{queue: "root", expected: true},
+		{queue: "batch", expected: false},
+		{queue: "dev", expected: true},
+		{queue: "dev-team", expected: true},
+		{queue: "test", expected: true},
+	}
+	for _, test := range tests {
+		if enabled := queueArgs.GetBool(test.queue, conf.EnablePredicateErrCacheKey, true); enabled != test.expected {
+			t.Errorf("queue %s: expected %s %v, got %v", test.queue, conf.EnablePredicateErrCacheKey, test.expected, enabled)
+		}
+	}
+	if limit := queueArgs.GetInt("dev", "limit", 1); limit != 3 {
+		t.Errorf("queue dev: expected the limit of the configuration to be kept, got %d", limit)
+	}
+	if _, found := queueArgs["root"]; found {
+		t.Errorf("expected queue root without overrides to be absent, got %v", queueArgs["root"])
+	}
+	if backfillArgs := ssn.GetQueueArgsOfAction("backfill"); len(backfillArgs) != 0 {
+		t.Errorf("expected no queue to override the arguments of backfill, got %v", backfillArgs)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// QuotaProvider computes the quotas of the queues used by the actions: the order of the queues by their share,
+// whether a queue is overused, and whether tasks can be allocated in a queue or reclaim resources of the other queues.
+// The proportion and capacity plugins are the in-tree providers. The sites with an external quota system, e.g. Slurm
+// accounts or an internal billing, implement their own provider and register it with NewQuotaProviderPlugin.
+type QuotaProvider interface {
+	// QueueOrder compares the queues, it returns a negative value if l is scheduled before r, e.g. if the share of l
+	// is lower, a positive value if r is scheduled before l and 0 if they are equal.
+	QueueOrder(l, r *api.QueueInfo) int
+	// Overused returns whether the queue uses more than its quota; no task is allocated in an overused queue.
+	Overused(queue *api.QueueInfo) bool
+	// Allocatable returns whether the task can be allocated in the queue.
+	Allocatable(queue *api.QueueInfo, task *api.TaskInfo) bool
+	// Preemptive returns whether the candidates of the queue can reclaim the resources of the other queues.
+	Preemptive(queue *api.QueueInfo, candidates []*api.TaskInfo) bool
+}
+
+// ResizeQuotaProvider is implemented by the quota providers reviewing the pending in-place resizes of the running
+// tasks, TaskInfo.ResizeReq, already accounted in the resources allocated in their queues.
+type ResizeQuotaProvider interface {
+	// Resizable returns whether the queue stays within its quota with the pending resize of the task.
+	Resizable(queue *api.QueueInfo, task *api.TaskInfo) bool
+}
+
+// QuotaProviderBuilder builds the quota provider of the session from the arguments of its plugin.
+type QuotaProviderBuilder = func(arguments Arguments, ssn *Session) QuotaProvider
+
+// AddQuotaProvider registers the provider as the QueueOrderFn, OverusedFn, AllocatableFn and PreemptiveFn of the plugin,
+// and as its ResizableFn if the provider implements ResizeQuotaProvider.
+func (ssn *Session) AddQuotaProvider(name string, provider QuotaProvider) {
+	ssn.AddQueueOrderFn(name, func(l, r interface{}) int {
+		return provider.QueueOrder(l.(*api.QueueInfo), r.(*api.QueueInfo))
+	})
+	ssn.AddOverusedFn(name, func(obj interface{}) bool {
+		return provider.Overused(obj.(*api.QueueInfo))
+	})
+	ssn.AddAllocatableFn(name, provider.Allocatable)
+	ssn.AddPreemptiveFn(name, func(obj interface{}, candidates []*api.TaskInfo) bool {
+		return provider.Preemptive(obj.(*api.QueueInfo), candidates)
+	})
+	if resizeProvider, ok := provider.(ResizeQuotaProvider); ok {
+		ssn.AddResizableFn(name, resizeProvider.Resizable)
+	}
+}
+
+// NewQuotaProviderPlugin returns the builder of a plugin named name which registers the quota provider built for
+// every session, e.g. RegisterPluginBuilder("slurm", NewQuotaProviderPlugin("slurm", newSlurmProvider)).
+func NewQuotaProviderPlugin(name string, builder QuotaProviderBuilder) PluginBuilder {
+	return func(arguments Arguments) Plugin {
+		return &quotaProviderPlugin{name: name, arguments: arguments, builder: builder}
+	}
+}
+
+type quotaProviderPlugin struct {
+	name      string
+	arguments Arguments
+	builder   QuotaProviderBuilder
+}
+
+func (qp *quotaProviderPlugin) Name() string {
+	return qp.name
+}
+
+func (qp *quotaProviderPlugin) OnSessionOpen(ssn *Session) {
+	ssn.AddQuotaProvider(qp.name, qp.builder(qp.arguments, ssn))
+}
+
+func (qp *quotaProviderPlugin) OnSessionClose(ssn *Session) {}
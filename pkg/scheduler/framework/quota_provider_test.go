@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// budgetProvider orders the queues by their spent budget, and stops the queues which spent it all.
+type budgetProvider struct {
+	budget int
+	spent  map[string]int
+}
+
+func (bp *budgetProvider) QueueOrder(l, r *api.QueueInfo) int {
+	return bp.spent[l.Name] - bp.spent[r.Name]
+}
+
+func (bp *budgetProvider) Overused(queue *api.QueueInfo) bool {
+	return bp.spent[queue.Name] >= bp.budget
+}
+
+func (bp *budgetProvider) Allocatable(queue *api.QueueInfo, task *api.TaskInfo) bool {
+	return !bp.Overused(queue)
+}
+
+func (bp *budgetProvider) Preemptive(queue *api.QueueInfo, candidates []*api.TaskInfo) bool {
+	return bp.spent[queue.Name]+len(candidates) <= bp.budget
+}
+
+func TestQuotaProviderPlugin(t *testing.T) {
+	RegisterPluginBuilder("budget", NewQuotaProviderPlugin("budget", func(arguments Arguments, ssn *Session) QuotaProvider {
+		bp := &budgetProvider{spent: map[string]int{"q1": 3, "q2": 1}}
+		arguments.GetInt(&bp.budget, "budget")
+		return bp
+	}))
+	defer CleanupPluginBuilders()
+
+	trueValue := true
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{
+		Name:               "budget",
+		EnabledQueueOrder:  &trueValue,
+		EnabledOverused:    &trueValue,
+		EnabledAllocatable: &trueValue,
+		EnablePreemptive:   &trueValue,
+		Arguments:          Arguments{"budget": 3},
+	}}}}
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), tiers, nil)
+	defer CloseSession(ssn)
+
+	queue := func(name string) *api.QueueInfo {
+		return api.NewQueueInfo(&scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     scheduling.QueueStatus{State: scheduling.QueueStateOpen},
+		})
+	}
+	q1, q2 := queue("q1"), queue("q2")
+	task := &api.TaskInfo{Name: "t1"}
+
+	if !ssn.QueueOrderFn(q2, q1) || ssn.QueueOrderFn(q1, q2) {
+		t.Errorf("expected queue q2 which spent less to be scheduled first")
+	}
+	if !ssn.Overused(q1) || ssn.Overused(q2) {
+		t.Errorf("expected only queue q1 which spent its budget to be overused")
+	}
+	if ssn.Allocatable(q1, task) || !ssn.Allocatable(q2, task) {
+		t.Errorf("expected tasks to be allocatable only in queue q2")
+	}
+	if !ssn.Preemptive(q2, []*api.TaskInfo{task, task}) || ssn.Preemptive(q2, []*api.TaskInfo{task, task, task}) {
+		t.Errorf("expected queue q2 to reclaim only for the candidates in its budget")
+	}
+}
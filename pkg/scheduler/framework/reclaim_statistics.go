@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// reclaimStatisticsWindow is the window over which the reclaims are counted in the queue status.
+	reclaimStatisticsWindow = time.Hour
+	// reclaimReason is the reason of the evictions of the reclaim action.
+	reclaimReason = "reclaim"
+)
+
+// reclaimRecord is a task evicted from the reclaimee queue to reclaim resources for the reclaimer queue,
+// the reclaimer is empty if the evicted task was not followed by a pipelined task.
+type reclaimRecord struct {
+	reclaimer api.QueueID
+	reclaimee api.QueueID
+	time      time.Time
+}
+
+var (
+	reclaimRecordsMutex sync.Mutex
+	// reclaimRecords are the reclaims of the window in time order, they outlive the sessions.
+	reclaimRecords []reclaimRecord
+)
+
+// recordReclaim records a task evicted from the reclaimee queue for the reclaimer queue.
+func recordReclaim(reclaimer, reclaimee api.QueueID, now time.Time) {
+	reclaimRecordsMutex.Lock()
+	defer reclaimRecordsMutex.Unlock()
+
+	// the status is stored with a precision of one second
+	reclaimRecords = append(reclaimRecords, reclaimRecord{reclaimer: reclaimer, reclaimee: reclaimee, time: now.Truncate(time.Second)})
+}
+
+// reclaimStatistics drops the reclaims older than the window and returns the statistics of the queues by ID,
+// the queues not involved in a reclaim of the window are absent.
+func reclaimStatistics(now time.Time) map[api.QueueID]*scheduling.QueueReclaimStatus {
+	reclaimRecordsMutex.Lock()
+	defer reclaimRecordsMutex.Unlock()
+
+	start := 0
+	for start < len(reclaimRecords) && now.Sub(reclaimRecords[start].time) > reclaimStatisticsWindow {
+		start++
+	}
+	reclaimRecords = reclaimRecords[start:]
+
+	statistics := map[api.QueueID]*scheduling.QueueReclaimStatus{}
+	getStatus := func(queueID api.QueueID, t time.Time) *scheduling.QueueReclaimStatus {
+		status, found := statistics[queueID]
+		if !found {
+			status = &scheduling.QueueReclaimStatus{}
+			statistics[queueID] = status
+		}
+		status.LastReclaimTime = &metav1.Time{Time: t}
+		return status
+	}
+	for _, record := range reclaimRecords {
+		if record.reclaimer != "" {
+			getStatus(record.reclaimer, record.time).Reclaiming++
+		}
+		getStatus(record.reclaimee, record.time).Reclaimed++
+	}
+	return statistics
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestReclaimStatistics(t *testing.T) {
+	defer func() { reclaimRecords = nil }()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	reclaimRecords = nil
+	recordReclaim("q1", "q2", now.Add(-2*time.Hour))
+	recordReclaim("q1", "q2", now.Add(-30*time.Minute))
+	recordReclaim("q1", "q3", now.Add(-20*time.Minute))
+	recordReclaim("", "q3", now.Add(-10*time.Minute+500*time.Millisecond))
+
+	expected := map[api.QueueID]*scheduling.QueueReclaimStatus{
+		"q1": {Reclaiming: 2, LastReclaimTime: &metav1.Time{Time: now.Add(-20 * time.Minute)}},
+		"q2": {Reclaimed: 1, LastReclaimTime: &metav1.Time{Time: now.Add(-30 * time.Minute)}},
+		"q3": {Reclaimed: 2, LastReclaimTime: &metav1.Time{Time: now.Add(-10 * time.Minute)}},
+	}
+	if got := reclaimStatistics(now); !equality.Semantic.DeepEqual(got, expected) {
+		t.Errorf("expected statistics %v, got %v", expected, got)
+	}
+	if len(reclaimRecords) != 3 {
+		t.Errorf("expected the reclaims out of the window to be dropped, got %d reclaims", len(reclaimRecords))
+	}
+
+	if got := reclaimStatistics(now.Add(2 * time.Hour)); len(got) != 0 {
+		t.Errorf("expected no statistics once the window has passed, got %v", got)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// ReservationHeldOnNode returns the capacity of the reservation held on the node which is not used by its consumers yet.
+func (ssn *Session) ReservationHeldOnNode(reservation *api.ReservationInfo, node *api.NodeInfo) *api.Resource {
+	hold, found := reservation.Nodes[node.Name]
+	if !found {
+		return api.EmptyResource()
+	}
+
+	used := api.EmptyResource()
+	for _, task := range node.Tasks {
+		if !api.AllocatedStatus(task.Status) && task.Status != api.Pipelined {
+			continue
+		}
+		if reservation.Consumer(ssn.Jobs[task.Job]) {
+			used.Add(task.Resreq)
+		}
+	}
+	return api.ExceededPart(hold, used)
+}
+
+// reservedOnNode returns the capacity held on the node by the reservations which the job can not use.
+// The reservations accepted by skip are not counted.
+func (ssn *Session) reservedOnNode(job *api.JobInfo, node *api.NodeInfo, now time.Time,
+	skip func(*api.ReservationInfo) bool) (*api.Resource, []string) {
+	reserved := api.EmptyResource()
+	var names []string
+	for _, reservation := range ssn.Reservations {
+		if reservation.Phase == v1alpha1.ReservationExpired || reservation.Expired(now) {
+			continue
+		}
+		if reservation.Consumer(job) || (skip != nil && skip(reservation)) {
+			continue
+		}
+		held := ssn.ReservationHeldOnNode(reservation, node)
+		if held.IsEmpty() {
+			continue
+		}
+		reserved.Add(held)
+		names = append(names, reservation.Name)
+	}
+	sort.Strings(names)
+	return reserved, names
+}
+
+// reservationPredicate checks whether the task fits the node without the capacity held by reservations.
+func (ssn *Session) reservationPredicate(task *api.TaskInfo, node *api.NodeInfo, skip func(*api.ReservationInfo) bool) error {
+	if len(ssn.Reservations) == 0 {
+		return nil
+	}
+
+	reserved, names := ssn.reservedOnNode(ssn.Jobs[task.Job], node, time.Now(), skip)
+	if len(names) == 0 {
+		return nil
+	}
+	if task.InitResreq.LessEqual(api.ExceededPart(node.FutureIdle(), reserved), api.Zero) {
+		return nil
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{
+		Code:   api.Unschedulable,
+		Reason: fmt.Sprintf("node capacity is reserved by %v", names),
+	})
+}
+
+// IsShortJob returns whether all the pending tasks of the job are guaranteed to end before the deadline: the enforced
+// maximum run time of its queue ends before it, or every pod sets an activeDeadlineSeconds that ends before it.
+// The runtime estimate of the job is not enforced by anything, so it is advisory and never makes a job short.
+func IsShortJob(job *api.JobInfo, queue *api.QueueInfo, now, deadline time.Time) bool {
+	if job == nil || len(job.TaskStatusIndex[api.Pending]) == 0 {
+		return false
+	}
+	if maxRun, limited := queue.MaxRunDuration(); limited && queue.MaxRunEnforced() && !now.Add(maxRun).After(deadline) {
+		return true
+	}
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if task.Pod == nil || task.Pod.Spec.ActiveDeadlineSeconds == nil {
+			return false
+		}
+		if now.Add(time.Duration(*task.Pod.Spec.ActiveDeadlineSeconds) * time.Second).After(deadline) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExpectedEndTime returns the time the running task is expected to end by the runtime estimate of its job,
+// or by the activeDeadlineSeconds of its pod, false if neither is known.
+func ExpectedEndTime(job *api.JobInfo, task *api.TaskInfo) (time.Time, bool) {
+	if task.Pod == nil || task.Pod.Status.StartTime == nil {
+		return time.Time{}, false
+	}
+	startTime := task.Pod.Status.StartTime.Time
+	var end time.Time
+	if estimate, found := job.RuntimeEstimate(); found {
+		end = startTime.Add(estimate)
+	}
+	if deadline := task.Pod.Spec.ActiveDeadlineSeconds; deadline != nil {
+		if deadlineEnd := startTime.Add(time.Duration(*deadline) * time.Second); end.IsZero() || deadlineEnd.Before(end) {
+			end = deadlineEnd
+		}
+	}
+	return end, !end.IsZero()
+}
+
+// UpdateReservationStatus updates the status of the reservation.
+func (ssn *Session) UpdateReservationStatus(reservation *api.ReservationInfo) error {
+	return ssn.cache.UpdateReservationStatus(reservation)
+}
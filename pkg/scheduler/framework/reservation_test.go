@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func buildReservationJob(uid, queue, reservation string, pods ...*v1.Pod) *api.JobInfo {
+	job := api.NewJobInfo(api.JobID(uid))
+	pg := &api.PodGroup{}
+	pg.Spec.Queue = queue
+	if reservation != "" {
+		pg.Annotations = map[string]string{schedulingv1beta1.ReservationKey: reservation}
+	}
+	job.SetPodGroup(pg)
+	for _, pod := range pods {
+		task := api.NewTaskInfo(pod)
+		task.Job = job.UID
+		job.AddTaskInfo(task)
+	}
+	return job
+}
+
+func TestReservationPredicate(t *testing.T) {
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, nil)
+	defer CloseSession(ssn)
+
+	node := api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	running := util.BuildPod("c1", "consumer-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	consumer := buildReservationJob("consumer", "q1", "rsv", running,
+		util.BuildPod("c1", "consumer-2", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil))
+	other := buildReservationJob("other", "q2", "",
+		util.BuildPod("c1", "other-1", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg2", nil, nil))
+	if err := node.AddTask(consumer.Tasks[api.TaskID(running.UID)]); err != nil {
+		t.Fatal(err)
+	}
+	ssn.Jobs = map[api.JobID]*api.JobInfo{consumer.UID: consumer, other.UID: other}
+	ssn.Queues = map[api.QueueID]*api.QueueInfo{}
+	for _, name := range []string{"q1", "q2"} {
+		queue := buildArgumentsQueue(name, "", "")
+		ssn.Queues[queue.UID] = queue
+	}
+	ssn.Reservations = map[string]*api.ReservationInfo{
+		"rsv": api.NewReservationInfo(&v1alpha1.Reservation{
+			ObjectMeta: metav1.ObjectMeta{Name: "rsv"},
+			Spec: v1alpha1.ReservationSpec{
+				Queue:     "q1",
+				Resources: api.BuildResourceList("3", "3Gi"),
+				StartTime: &metav1.Time{Time: time.Now().Add(2 * time.Hour)},
+			},
+			Status: v1alpha1.ReservationStatus{
+				Nodes: []v1alpha1.ReservedNode{{NodeName: "n1", Resources: api.BuildResourceList("3", "3Gi")}},
+			},
+		}),
+	}
+
+	// 1 of the 3 reserved cpus is used by the running task of the consumer
+	held := ssn.ReservationHeldOnNode(ssn.Reservations["rsv"], node)
+	if !held.Equal(api.NewResource(api.BuildResourceList("2", "2Gi")), api.Zero) {
+		t.Errorf("expected capacity <cpu 2, memory 2Gi> held, got %v", held)
+	}
+
+	for _, task := range consumer.TaskStatusIndex[api.Pending] {
+		if err := ssn.PredicateForAllocateAction(task, node); err != nil {
+			t.Errorf("expected task of the consumer to fit the reserved capacity, got %v", err)
+		}
+	}
+	for _, task := range other.TaskStatusIndex[api.Pending] {
+		if err := ssn.PredicateForAllocateAction(task, node); err == nil {
+			t.Errorf("expected task of other job not to fit the reserved capacity")
+		}
+		if err := ssn.PredicateForBackfillAction(task, node); err == nil {
+			t.Errorf("expected task of other job without deadline not to be backfilled to the reserved capacity")
+		}
+
+		deadline := int64(600)
+		task.Pod.Spec.ActiveDeadlineSeconds = &deadline
+		if err := ssn.PredicateForBackfillAction(task, node); err != nil {
+			t.Errorf("expected task of short job to be backfilled to the reserved capacity, got %v", err)
+		}
+	}
+}
+
+func TestIsShortJob(t *testing.T) {
+	now := time.Now()
+	short, long := int64(600), int64(7200)
+	shortPod := util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	shortPod.Spec.ActiveDeadlineSeconds = &short
+	longPod := util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	longPod.Spec.ActiveDeadlineSeconds = &long
+	noDeadlinePod := util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	withEstimate := func(job *api.JobInfo, estimate string) *api.JobInfo {
+		job.PodGroup.Annotations = map[string]string{schedulingv1beta1.RuntimeEstimateKey: estimate}
+		return job
+	}
+
+	withMaxRun := func(seconds int64, policy scheduling.MaxRunPolicy) *api.QueueInfo {
+		return api.NewQueueInfo(&scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "q1"},
+			Spec:       scheduling.QueueSpec{MaxRunSeconds: &seconds, MaxRunPolicy: policy},
+		})
+	}
+
+	tests := []struct {
+		name     string
+		job      *api.JobInfo
+		queue    *api.QueueInfo
+		expected bool
+	}{
+		{name: "all pods end before the deadline", job: buildReservationJob("j1", "q1", "", shortPod), expected: true},
+		{name: "a pod ends after the deadline", job: buildReservationJob("j2", "q1", "", shortPod.DeepCopy(), longPod), expected: false},
+		{name: "a pod has no deadline", job: buildReservationJob("j3", "q1", "", noDeadlinePod), expected: false},
+		{name: "no pending pods", job: buildReservationJob("j4", "q1", ""), expected: false},
+		{name: "runtime estimate ending before the deadline is advisory", job: withEstimate(buildReservationJob("j5", "q1", "", noDeadlinePod.DeepCopy()), "30m"), expected: false},
+		{name: "enforced max run time ends before the deadline", job: buildReservationJob("j6", "q1", "", noDeadlinePod.DeepCopy()),
+			queue: withMaxRun(1800, scheduling.MaxRunPolicyTerminate), expected: true},
+		{name: "enforced max run time ends after the deadline", job: buildReservationJob("j7", "q1", "", noDeadlinePod.DeepCopy()),
+			queue: withMaxRun(7200, scheduling.MaxRunPolicyTerminate), expected: false},
+		{name: "max run time with the reclaim policy is not enforced", job: buildReservationJob("j8", "q1", "", noDeadlinePod.DeepCopy()),
+			queue: withMaxRun(1800, scheduling.MaxRunPolicyReclaim), expected: false},
+		{name: "pods end before the deadline despite a long max run time", job: buildReservationJob("j9", "q1", "", shortPod.DeepCopy()),
+			queue: withMaxRun(7200, scheduling.MaxRunPolicyTerminate), expected: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if short := IsShortJob(test.job, test.queue, now, now.Add(time.Hour)); short != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, short)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// ResizeDeniedReason is the reason of the event of the pending in-place resizes denied by the plugins.
+const ResizeDeniedReason = "ResizeDenied"
+
+var (
+	resizeReviewMutex sync.Mutex
+	resizeReview      struct {
+		enabled      bool
+		revertDenied bool
+	}
+)
+
+// SetResizeReview sets whether the pending in-place resizes of the running pods are reviewed by the plugins in the
+// sessions opened afterwards, and whether the denied resizes are reverted to the resources allocated to the pods.
+func SetResizeReview(enabled, revertDenied bool) {
+	resizeReviewMutex.Lock()
+	defer resizeReviewMutex.Unlock()
+	resizeReview.enabled = enabled
+	resizeReview.revertDenied = enabled && revertDenied
+}
+
+// reviewResizes lets the plugins approve the pending in-place resizes of the running tasks against the quotas of
+// their queues. The resizes the kubelet is applying cannot be denied any more, the others are reported by an event
+// if any plugin denies them, and reverted if configured so.
+func (ssn *Session) reviewResizes() {
+	resizeReviewMutex.Lock()
+	enabled, revertDenied := resizeReview.enabled, resizeReview.revertDenied
+	resizeReviewMutex.Unlock()
+	if !enabled {
+		return
+	}
+
+	for _, job := range ssn.Jobs {
+		queue, found := ssn.Queues[job.Queue]
+		if !found {
+			continue
+		}
+		for _, task := range job.Tasks {
+			if task.ResizeReq == nil || podResizeInProgress(task.Pod) {
+				continue
+			}
+			if ssn.Resizable(queue, task) {
+				continue
+			}
+
+			msg := fmt.Sprintf("In-place resize of <%v> denied by the quota of queue %s", task.ResizeReq, queue.Name)
+			klog.V(3).Infof("Task <%s/%s>: %s", task.Namespace, task.Name, msg)
+			if ssn.recorder != nil {
+				ssn.recorder.Event(task.Pod, v1.EventTypeWarning, ResizeDeniedReason, msg)
+			}
+			if revertDenied && ssn.kubeClient != nil {
+				go revertResize(ssn.kubeClient, task.Pod.DeepCopy())
+			}
+		}
+	}
+}
+
+// podResizeInProgress returns whether the kubelet is applying a resize of the pod.
+func podResizeInProgress(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodResizeInProgress {
+			return true
+		}
+	}
+	return false
+}
+
+// revertResize sets the requests of the containers of the pod back to the resources allocated to them by the kubelet.
+func revertResize(kubeClient kubernetes.Interface, pod *v1.Pod) {
+	if !revertedResize(pod) {
+		return
+	}
+	if _, err := kubeClient.CoreV1().Pods(pod.Namespace).UpdateResize(context.TODO(), pod.Name, pod, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to revert the in-place resize of pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+		return
+	}
+	klog.V(3).Infof("Reverted the in-place resize of pod <%s/%s>", pod.Namespace, pod.Name)
+}
+
+// revertedResize lowers the requests of the containers of the pod beyond their allocated resources, it returns
+// whether any request was lowered.
+func revertedResize(pod *v1.Pod) bool {
+	allocated := make(map[string]v1.ResourceList, len(pod.Status.ContainerStatuses))
+	for _, cs := range pod.Status.ContainerStatuses {
+		switch {
+		case cs.AllocatedResources != nil:
+			allocated[cs.Name] = cs.AllocatedResources
+		case cs.Resources != nil:
+			allocated[cs.Name] = cs.Resources.Requests
+		}
+	}
+
+	reverted := false
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		resources, found := allocated[container.Name]
+		if !found {
+			continue
+		}
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := resources[name]; ok && quantity.Cmp(current) > 0 {
+				container.Resources.Requests[name] = current.DeepCopy()
+				reverted = true
+			}
+		}
+	}
+	return reverted
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestRevertedResize(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "c1",
+					Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("2"),
+						v1.ResourceMemory: resource.MustParse("1Gi"),
+					}},
+				},
+				{
+					Name: "c2",
+					Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+						v1.ResourceCPU: resource.MustParse("1"),
+					}},
+				},
+			},
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "c1",
+					AllocatedResources: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("1"),
+						v1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+				},
+				{
+					Name: "c2",
+					Resources: &v1.ResourceRequirements{Requests: v1.ResourceList{
+						v1.ResourceCPU: resource.MustParse("1"),
+					}},
+				},
+			},
+		},
+	}
+
+	if !revertedResize(pod) {
+		t.Fatalf("expected the resize of the pod to be reverted")
+	}
+	requests := pod.Spec.Containers[0].Resources.Requests
+	if cpu := requests[v1.ResourceCPU]; cpu.Cmp(resource.MustParse("1")) != 0 {
+		t.Errorf("expected cpu request of c1 reverted to 1, got %v", cpu.String())
+	}
+	if memory := requests[v1.ResourceMemory]; memory.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("expected memory request of c1 shrunk by the resize to be kept, got %v", memory.String())
+	}
+	if revertedResize(pod) {
+		t.Errorf("expected nothing left to revert")
+	}
+}
+
+func TestPodResizeInProgress(t *testing.T) {
+	pod := &v1.Pod{}
+	if podResizeInProgress(pod) {
+		t.Errorf("expected no resize in progress")
+	}
+	pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{Type: v1.PodResizeInProgress, Status: v1.ConditionTrue})
+	if !podResizeInProgress(pod) {
+		t.Errorf("expected the resize in progress")
+	}
+}
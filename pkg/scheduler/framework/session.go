@@ -28,6 +28,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -81,7 +82,7 @@ type Session struct {
 	DirtyJobs sets.Set[api.JobID]
 
 	// schGateManager is the scheduler gate manager, passed in from the Scheduler.
-	// Nil when SchedulingGatesQueueAdmission feature gate is disabled.
+	// Nil when both SchedulingGatesQueueAdmission and SchedulingGatesGangAdmission feature gates are disabled.
 	schGateManager *gate.SchGateManager
 
 	Jobs           map[api.JobID]*api.JobInfo
@@ -90,6 +91,8 @@ type Session struct {
 	RevocableNodes map[string]*api.NodeInfo
 	Queues         map[api.QueueID]*api.QueueInfo
 	NamespaceInfo  map[api.NamespaceName]*api.NamespaceInfo
+	// Reservations stores the capacity held for the jobs scheduled to start later
+	Reservations map[string]*api.ReservationInfo
 
 	// NodeMap is like Nodes except that it uses k8s NodeInfo api and should only
 	// be used in k8s compatible api scenarios such as in predicates and nodeorder plugins.
@@ -135,6 +138,7 @@ type Session struct {
 	// while reclaimableFns means whether current queue's resources can be reclaimed.
 	preemptiveFns                 map[string]api.ValidateWithCandidateFn
 	allocatableFns                map[string]api.AllocatableFn
+	resizableFns                  map[string]api.ResizableFn
 	jobReadyFns                   map[string]api.ValidateFn
 	jobPipelinedFns               map[string]api.VoteFn
 	jobValidFns                   map[string]api.ValidateExFn
@@ -153,6 +157,8 @@ type Session struct {
 	subJobOrderFns                map[string]api.CompareFn
 	hyperNodeGradientForJobFns    map[string]api.HyperNodeGradientForJobFn
 	hyperNodeGradientForSubJobFns map[string]api.HyperNodeGradientForSubJobFn
+	postCommitFns                 map[string]StatementFn
+	postDiscardFns                map[string]StatementFn
 
 	// cycleStatesMap is used to temporarily store the scheduling status of each pod, its life cycle is same as Session.
 	// Because state needs to be passed between different extension points (not only used in PreFilter and Filter),
@@ -161,6 +167,38 @@ type Session struct {
 	// The key is task's UID, value is the CycleState.
 	cycleStatesMap sync.Map
 
+	// victimLedger records the victims claimed by actions in this session,
+	// so that the same victim is not selected and counted twice by different actions.
+	victimLedger *VictimLedger
+
+	// escalatedJobs are the jobs escalated by the plugins in this session, they may reclaim from the other queues
+	// even if their queue is overused or has no deserved resources left for them.
+	escalatedJobs sets.Set[api.JobID]
+	// starvingJobs are the jobs found starving by the previous sessions, for the plugins to act on.
+	starvingJobs sets.Set[api.JobID]
+
+	// dirtyQueues are the queues whose conditions have been updated in this session,
+	// their status is written back when the session is closed.
+	dirtyQueues sets.Set[api.QueueID]
+
+	// pluginHealth records the panics of the plugins in this session, and the plugins disabled for panicking repeatedly.
+	pluginHealth *pluginHealth
+
+	// currentAction is the name of the action being executed, it labels the profiling metrics of the plugin functions.
+	currentAction string
+	// pipelineClass is the queue class of the action pipeline being executed, and pipelineClasses are the classes
+	// of all the pipelines of the session, the actions only schedule the jobs of the queues of the pipeline.
+	pipelineClass   string
+	pipelineClasses sets.Set[string]
+	// profile is the scheduler profile of the session, and profiles are the names of all the profiles,
+	// the session only schedules and updates the conditions of the jobs of its profile.
+	profile  string
+	profiles sets.Set[string]
+	// trace is the trace of the session, with the root span of the cycle and the span of the running action.
+	trace *sessionTrace
+	// audit collects the decisions of the session for the audit log, nil if the audit is disabled.
+	audit *sessionAudit
+
 	NodesInShard sets.Set[string]
 }
 
@@ -181,6 +219,10 @@ func openSession(cache cache.Cache) *Session {
 			Annotations: map[api.JobID]map[string]string{},
 		},
 		DirtyJobs:      sets.New[api.JobID](),
+		victimLedger:   NewVictimLedger(),
+		escalatedJobs:  sets.New[api.JobID](),
+		dirtyQueues:    sets.New[api.QueueID](),
+		pluginHealth:   newPluginHealth(),
 		Jobs:           map[api.JobID]*api.JobInfo{},
 		Nodes:          map[string]*api.NodeInfo{},
 		CSINodesStatus: map[string]*api.CSINodeStatusInfo{},
@@ -207,6 +249,7 @@ func openSession(cache cache.Cache) *Session {
 		overusedFns:                   map[string]api.ValidateFn{},
 		preemptiveFns:                 map[string]api.ValidateWithCandidateFn{},
 		allocatableFns:                map[string]api.AllocatableFn{},
+		resizableFns:                  map[string]api.ResizableFn{},
 		jobReadyFns:                   map[string]api.ValidateFn{},
 		jobPipelinedFns:               map[string]api.VoteFn{},
 		jobValidFns:                   map[string]api.ValidateExFn{},
@@ -225,6 +268,8 @@ func openSession(cache cache.Cache) *Session {
 		subJobOrderFns:                map[string]api.CompareFn{},
 		hyperNodeGradientForJobFns:    map[string]api.HyperNodeGradientForJobFn{},
 		hyperNodeGradientForSubJobFns: map[string]api.HyperNodeGradientForSubJobFn{},
+		postCommitFns:                 map[string]StatementFn{},
+		postDiscardFns:                map[string]StatementFn{},
 	}
 
 	snapshot := cache.Snapshot()
@@ -270,15 +315,18 @@ func openSession(cache cache.Cache) *Session {
 	ssn.RevocableNodes = snapshot.RevocableNodes
 	ssn.Queues = snapshot.Queues
 	ssn.NamespaceInfo = snapshot.NamespaceInfo
+	ssn.Reservations = snapshot.Reservations
 	// calculate all nodes' resource only once in each schedule cycle, other plugins can clone it when need
 	for _, n := range ssn.Nodes {
 		ssn.TotalResource.Add(n.Allocatable)
 	}
 
 	ssn.NodesInShard = snapshot.NodesInShard
+	ssn.starvingJobs = starvingJobs(ssn.Jobs, time.Now())
 
-	klog.V(3).Infof("Open Session %v with <%d> Job and <%d> Queues. HyperNodesReadyToSchedule: %v",
-		ssn.UID, len(ssn.Jobs), len(ssn.Queues), ssn.HyperNodesReadyToSchedule)
+	ssn.cache.SetCorrelationID(string(ssn.UID))
+	ssn.Logger().V(3).Info("Open Session", "jobs", len(ssn.Jobs), "queues", len(ssn.Queues),
+		"hyperNodesReadyToSchedule", ssn.HyperNodesReadyToSchedule)
 
 	return ssn
 }
@@ -537,12 +585,18 @@ func updateQueueStatus(ssn *Session) {
 	}
 
 	// update queue status
+	reclaims := reclaimStatistics(time.Now())
 	for queueID := range ssn.Queues {
+		if !equality.Semantic.DeepEqual(ssn.Queues[queueID].Queue.Status.Reclaim, reclaims[queueID]) {
+			ssn.Queues[queueID].Queue.Status.Reclaim = reclaims[queueID]
+			ssn.dirtyQueues.Insert(queueID)
+		}
+
 		// convert api.Resource to v1.ResourceList
 		var queueStatus = util.ConvertRes2ResList(allocatedResources[queueID]).DeepCopy()
 		queueStatus = mergeDRAAllocatedIntoResourceList(queueStatus, allocatedDRAResources[queueID])
 
-		if equality.Semantic.DeepEqual(ssn.Queues[queueID].Queue.Status.Allocated, queueStatus) {
+		if equality.Semantic.DeepEqual(ssn.Queues[queueID].Queue.Status.Allocated, queueStatus) && !ssn.dirtyQueues.Has(queueID) {
 			klog.V(5).Infof("Queue <%s> allocated resource keeps equal, no need to update queue status <%v>.",
 				queueID, ssn.Queues[queueID].Queue.Status.Allocated)
 			continue
@@ -561,6 +615,7 @@ func closeSession(ssn *Session) {
 	ju.UpdateAll()
 
 	updateQueueStatus(ssn)
+	ssn.detectStarvation(time.Now())
 
 	ssn.Jobs = nil
 	ssn.Nodes = nil
@@ -573,9 +628,19 @@ func closeSession(ssn *Session) {
 	ssn.NodeList = nil
 	ssn.TotalResource = nil
 
+	ssn.cache.SetCorrelationID("")
 	ssn.cache.OnSessionClose()
 
-	klog.V(3).Infof("Close Session %v", ssn.UID)
+	ssn.Logger().V(3).Info("Close Session")
+}
+
+// Logger returns the logger of the session, its lines carry the ID of the scheduling cycle
+// to be correlated with the events, PodGroup conditions and audit records of the cycle.
+func (ssn *Session) Logger() klog.Logger {
+	if ssn == nil {
+		return klog.Background()
+	}
+	return klog.LoggerWithValues(klog.Background(), "cycle", ssn.UID)
 }
 
 func getPodGroupPhase(jobInfo *api.JobInfo, unschedulable bool) scheduling.PodGroupPhase {
@@ -595,7 +660,7 @@ func getPodGroupPhase(jobInfo *api.JobInfo, unschedulable bool) scheduling.PodGr
 		}
 	}
 
-	if int32(scheduled) >= jobInfo.PodGroup.Spec.MinMember {
+	if int32(scheduled) >= jobInfo.MinAvailable && taskMembersScheduled(jobInfo) {
 		// If all scheduled tasks are completed, then the podgroup is completed
 		if scheduled == completed {
 			return scheduling.PodGroupCompleted
@@ -627,6 +692,12 @@ func jobStatus(ssn *Session, jobInfo *api.JobInfo) scheduling.PodGroupStatus {
 	status.Running = int32(len(jobInfo.TaskStatusIndex[api.Running]))
 	status.Failed = int32(len(jobInfo.TaskStatusIndex[api.Failed]))
 	status.Succeeded = int32(len(jobInfo.TaskStatusIndex[api.Succeeded]))
+	status.TopologyDecision = topologyDecision(ssn, jobInfo)
+	status.TaskMembers = taskMembers(jobInfo)
+	if req := status.ScaleDownRequest; req != nil && status.Running <= req.Replicas {
+		// The elastic job has shrunk as requested.
+		status.ScaleDownRequest = nil
+	}
 
 	return status
 }
@@ -662,6 +733,50 @@ func (ssn *Session) FilterOutUnschedulableAndUnresolvableNodesForTask(task *api.
 // - UnschedulableAndUnresolvable
 // - ErrorSkipOrWait
 func (ssn *Session) PredicateForAllocateAction(task *api.TaskInfo, node *api.NodeInfo) error {
+	if err := gpuResetPredicate(task, node); err != nil {
+		return err
+	}
+	if err := ssn.reservationPredicate(task, node, nil); err != nil {
+		return err
+	}
+	if err := ssn.victimGuardPredicate(task, node); err != nil {
+		return err
+	}
+	return ssn.predicateForAllocate(task, node)
+}
+
+// PredicateForBackfillAction is PredicateForAllocateAction except that the tasks of short jobs,
+// which end before the start time of a reservation, can use the capacity held by it.
+func (ssn *Session) PredicateForBackfillAction(task *api.TaskInfo, node *api.NodeInfo) error {
+	if err := gpuResetPredicate(task, node); err != nil {
+		return err
+	}
+	now := time.Now()
+	job := ssn.Jobs[task.Job]
+	if err := ssn.reservationPredicate(task, node, func(reservation *api.ReservationInfo) bool {
+		return now.Before(reservation.StartTime) && job != nil && IsShortJob(job, ssn.Queues[job.Queue], now, reservation.StartTime)
+	}); err != nil {
+		return err
+	}
+	if err := ssn.victimGuardPredicate(task, node); err != nil {
+		return err
+	}
+	return ssn.predicateForAllocate(task, node)
+}
+
+// gpuResetPredicate checks whether the GPU task can be placed on the node, i.e. the node is not waiting for its
+// volcano agent to clean up the GPUs after evicting the GPU tasks of another queue.
+func gpuResetPredicate(task *api.TaskInfo, node *api.NodeInfo) error {
+	if !node.GPUResetPending || !task.InitResreq.HasGPU() {
+		return nil
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{
+		Code:   api.Unschedulable,
+		Reason: "node is waiting for the GPU cleanup after evicting the GPU tasks of another queue",
+	})
+}
+
+func (ssn *Session) predicateForAllocate(task *api.TaskInfo, node *api.NodeInfo) error {
 	err := ssn.PredicateFn(task, node)
 	if err == nil {
 		return nil
@@ -745,6 +860,7 @@ func (ssn *Session) Pipeline(task *api.TaskInfo, hostname string) error {
 			})
 		}
 	}
+	ssn.recordDecision(DecisionPipeline, task, "", nil)
 
 	return nil
 }
@@ -816,6 +932,7 @@ func (ssn *Session) dispatch(task *api.TaskInfo) error {
 			task.Job, ssn.UID)
 		return fmt.Errorf("failed to find job %s", task.Job)
 	}
+	ssn.recordDecision(DecisionBind, task, "", nil)
 
 	return nil
 }
@@ -823,6 +940,7 @@ func (ssn *Session) dispatch(task *api.TaskInfo) error {
 func (ssn *Session) CreateBindContext(task *api.TaskInfo) *cache.BindContext {
 	bindContext := &cache.BindContext{
 		TaskInfo:   task,
+		SessionUID: ssn.UID,
 		Extensions: make(map[string]cache.BindContextExtension),
 	}
 
@@ -874,6 +992,10 @@ func (ssn *Session) GetCycleState(taskID api.TaskID) *k8sframework.CycleState {
 
 // Evict the task in the session
 func (ssn *Session) Evict(reclaimee *api.TaskInfo, reason string) error {
+	if ssn.victimLedger.IsClaimed(reclaimee.UID) {
+		return fmt.Errorf("task %s/%s has already been claimed as victim", reclaimee.Namespace, reclaimee.Name)
+	}
+
 	if err := ssn.cache.Evict(reclaimee, reason); err != nil {
 		return err
 	}
@@ -892,6 +1014,8 @@ func (ssn *Session) Evict(reclaimee *api.TaskInfo, reason string) error {
 	if node, found := ssn.Nodes[reclaimee.NodeName]; found {
 		node.UpdateTask(reclaimee)
 	}
+	ssn.victimLedger.Claim(reclaimee, reason)
+	ssn.recordDecision(DecisionEvict, reclaimee, reason, nil)
 
 	for _, eh := range ssn.eventHandlers {
 		if eh.DeallocateFunc != nil {
@@ -904,6 +1028,42 @@ func (ssn *Session) Evict(reclaimee *api.TaskInfo, reason string) error {
 	return nil
 }
 
+// VictimLedger returns the ledger of victims claimed in the session.
+func (ssn *Session) VictimLedger() *VictimLedger {
+	return ssn.victimLedger
+}
+
+// IsVictimClaimed returns whether the resources of the task have already been claimed
+// by an eviction in the session, actions should not select such task as victim again.
+func (ssn *Session) IsVictimClaimed(task *api.TaskInfo) bool {
+	return ssn.victimLedger.IsClaimed(task.UID)
+}
+
+// EscalateJob marks the job eligible for cross-queue reclaim in the session, even if its queue is overused
+// or has no deserved resources left for it, e.g. when the job waits longer than its SLA.
+func (ssn *Session) EscalateJob(job *api.JobInfo) {
+	if ssn.escalatedJobs == nil {
+		ssn.escalatedJobs = sets.New[api.JobID]()
+	}
+	ssn.escalatedJobs.Insert(job.UID)
+}
+
+// JobEscalated returns whether the job is escalated for cross-queue reclaim in the session.
+func (ssn *Session) JobEscalated(job *api.JobInfo) bool {
+	return ssn.escalatedJobs.Has(job.UID)
+}
+
+// JobExceedsMaxRun returns whether the job runs longer than the maximum run time of its queue,
+// the tasks of such job are preferred as victims of preempt and reclaim.
+func (ssn *Session) JobExceedsMaxRun(job *api.JobInfo) bool {
+	maxRun, limited := ssn.Queues[job.Queue].MaxRunDuration()
+	if !limited {
+		return false
+	}
+	since, running := job.RunningSince()
+	return running && time.Since(since) > maxRun
+}
+
 // BindPodGroup bind PodGroup to specified cluster
 func (ssn *Session) BindPodGroup(job *api.JobInfo, cluster string) error {
 	return ssn.cache.BindPodGroup(job, cluster)
@@ -915,6 +1075,15 @@ func (ssn *Session) UpdatePodGroupCondition(jobInfo *api.JobInfo, cond *scheduli
 	if !ok {
 		return fmt.Errorf("failed to find job <%s/%s>", jobInfo.Namespace, jobInfo.Name)
 	}
+	// the conditions of the jobs of the other profiles are set by the sessions of their profile
+	if !ssn.JobInProfile(job) {
+		return nil
+	}
+
+	// the condition is correlated with the scheduling cycle which set it
+	if cond.TransitionID == "" {
+		cond.TransitionID = string(ssn.UID)
+	}
 
 	index := -1
 	for i, c := range job.PodGroup.Status.Conditions {
@@ -934,6 +1103,52 @@ func (ssn *Session) UpdatePodGroupCondition(jobInfo *api.JobInfo, cond *scheduli
 	return nil
 }
 
+// UpdateQueueCondition updates the condition of queue, the status of the queue is written back when the session is closed.
+// The last transition time is kept if the status of the condition does not change.
+func (ssn *Session) UpdateQueueCondition(queueID api.QueueID, cond *scheduling.QueueCondition) error {
+	queue, ok := ssn.Queues[queueID]
+	if !ok {
+		return fmt.Errorf("failed to find queue <%s>", queueID)
+	}
+
+	conditions := queue.Queue.Status.Conditions
+	for i, c := range conditions {
+		if c.Type != cond.Type {
+			continue
+		}
+		if c.Status == cond.Status && c.Reason == cond.Reason && c.Message == cond.Message {
+			return nil
+		}
+		newCond := *cond
+		if c.Status == cond.Status {
+			newCond.LastTransitionTime = c.LastTransitionTime
+		}
+		conditions[i] = newCond
+		ssn.dirtyQueues.Insert(queueID)
+		return nil
+	}
+
+	queue.Queue.Status.Conditions = append(conditions, *cond)
+	ssn.dirtyQueues.Insert(queueID)
+	return nil
+}
+
+// UpdateQueueCost updates the cost spent by the jobs of queue, the status of the queue is written back when the session
+// is closed.
+func (ssn *Session) UpdateQueueCost(queueID api.QueueID, cost *scheduling.QueueCostStatus) error {
+	queue, ok := ssn.Queues[queueID]
+	if !ok {
+		return fmt.Errorf("failed to find queue <%s>", queueID)
+	}
+
+	if equality.Semantic.DeepEqual(queue.Queue.Status.Cost, cost) {
+		return nil
+	}
+	queue.Queue.Status.Cost = cost
+	ssn.dirtyQueues.Insert(queueID)
+	return nil
+}
+
 // AddEventHandler add event handlers
 func (ssn *Session) AddEventHandler(eh *EventHandler) {
 	ssn.eventHandlers = append(ssn.eventHandlers, eh)
@@ -950,7 +1165,7 @@ func (ssn *Session) KubeClient() kubernetes.Interface {
 }
 
 // SchGateManager returns the scheduler gate manager.
-// Returns nil when SchedulingGatesQueueAdmission feature gate is disabled.
+// Returns nil when both SchedulingGatesQueueAdmission and SchedulingGatesGangAdmission feature gates are disabled.
 func (ssn *Session) SchGateManager() *gate.SchGateManager {
 	return ssn.schGateManager
 }
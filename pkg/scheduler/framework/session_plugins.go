@@ -33,27 +33,37 @@ import (
 
 // AddJobOrderFn add job order function
 func (ssn *Session) AddJobOrderFn(name string, cf api.CompareFn) {
-	ssn.jobOrderFns[name] = cf
+	ssn.jobOrderFns[name] = func(l, r interface{}) int {
+		return guard(ssn, name, "JobOrder", 0, func() int { return cf(l, r) })
+	}
 }
 
 // AddQueueOrderFn add queue order function
 func (ssn *Session) AddQueueOrderFn(name string, qf api.CompareFn) {
-	ssn.queueOrderFns[name] = qf
+	ssn.queueOrderFns[name] = func(l, r interface{}) int {
+		return guard(ssn, name, "QueueOrder", 0, func() int { return qf(l, r) })
+	}
 }
 
 // AddVictimQueueOrderFn add victim job order function
 func (ssn *Session) AddVictimQueueOrderFn(name string, vcf api.VictimCompareFn) {
-	ssn.victimQueueOrderFns[name] = vcf
+	ssn.victimQueueOrderFns[name] = func(l, r, preemptor interface{}) int {
+		return guard(ssn, name, "VictimQueueOrder", 0, func() int { return vcf(l, r, preemptor) })
+	}
 }
 
 // AddClusterOrderFn add queue order function
 func (ssn *Session) AddClusterOrderFn(name string, qf api.CompareFn) {
-	ssn.clusterOrderFns[name] = qf
+	ssn.clusterOrderFns[name] = func(l, r interface{}) int {
+		return guard(ssn, name, "ClusterOrder", 0, func() int { return qf(l, r) })
+	}
 }
 
 // AddTaskOrderFn add task order function
 func (ssn *Session) AddTaskOrderFn(name string, cf api.CompareFn) {
-	ssn.taskOrderFns[name] = cf
+	ssn.taskOrderFns[name] = func(l, r interface{}) int {
+		return guard(ssn, name, "TaskOrder", 0, func() int { return cf(l, r) })
+	}
 }
 
 // AddPreemptableFn add preemptable function
@@ -61,7 +71,9 @@ func (ssn *Session) AddPreemptableFn(name string, cf api.EvictableFn) {
 	if ssn.preemptableFns == nil {
 		ssn.preemptableFns = map[string]api.EvictableFn{}
 	}
-	ssn.preemptableFns[name] = cf
+	ssn.preemptableFns[name] = func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return guard2(ssn, name, "Preemptable", nil, voteAbstain, func() ([]*api.TaskInfo, int) { return cf(preemptor, preemptees) })
+	}
 }
 
 // AddReclaimableFn add Reclaimable function
@@ -69,7 +81,9 @@ func (ssn *Session) AddReclaimableFn(name string, rf api.EvictableFn) {
 	if ssn.reclaimableFns == nil {
 		ssn.reclaimableFns = map[string]api.EvictableFn{}
 	}
-	ssn.reclaimableFns[name] = rf
+	ssn.reclaimableFns[name] = func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return guard2(ssn, name, "Reclaimable", nil, voteAbstain, func() ([]*api.TaskInfo, int) { return rf(reclaimer, reclaimees) })
+	}
 }
 
 // AddUnifiedEvictableFn registers a UnifiedEvictableFn for gang-aware victim filtering.
@@ -77,148 +91,251 @@ func (ssn *Session) AddUnifiedEvictableFn(name string, fn api.UnifiedEvictableFn
 	if ssn.unifiedEvictableFns == nil {
 		ssn.unifiedEvictableFns = map[string]api.UnifiedEvictableFn{}
 	}
-	ssn.unifiedEvictableFns[name] = fn
+	ssn.unifiedEvictableFns[name] = func(ctx *api.EvictionContext, candidates []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return guard2(ssn, name, "UnifiedEvictable", nil, voteAbstain, func() ([]*api.TaskInfo, int) { return fn(ctx, candidates) })
+	}
 }
 
 // AddJobReadyFn add JobReady function
 func (ssn *Session) AddJobReadyFn(name string, vf api.ValidateFn) {
-	ssn.jobReadyFns[name] = vf
+	ssn.jobReadyFns[name] = func(obj interface{}) bool {
+		return guard(ssn, name, "JobReady", false, func() bool { return vf(obj) })
+	}
 }
 
 // AddJobPipelinedFn add pipelined function
 func (ssn *Session) AddJobPipelinedFn(name string, vf api.VoteFn) {
-	ssn.jobPipelinedFns[name] = vf
+	ssn.jobPipelinedFns[name] = func(obj interface{}) int {
+		return guard(ssn, name, "JobPipelined", voteReject, func() int { return vf(obj) })
+	}
 }
 
 // AddPredicateFn add Predicate function
 func (ssn *Session) AddPredicateFn(name string, pf api.PredicateFn) {
-	ssn.predicateFns[name] = pf
+	fallback := pluginPanicErrorFn(name, "Predicate")
+	ssn.predicateFns[name] = func(task *api.TaskInfo, node *api.NodeInfo) error {
+		return guardLazy(ssn, name, "Predicate", fallback, func() error { return pf(task, node) })
+	}
 }
 
 // AddPrePredicateFn add PrePredicate function
 func (ssn *Session) AddPrePredicateFn(name string, pf api.PrePredicateFn) {
-	ssn.prePredicateFns[name] = pf
+	fallback := pluginPanicErrorFn(name, "PrePredicate")
+	ssn.prePredicateFns[name] = func(task *api.TaskInfo) error {
+		return guardLazy(ssn, name, "PrePredicate", fallback, func() error { return pf(task) })
+	}
 }
 
 // AddBestNodeFn add BestNode function
 func (ssn *Session) AddBestNodeFn(name string, pf api.BestNodeFn) {
-	ssn.bestNodeFns[name] = pf
+	ssn.bestNodeFns[name] = func(task *api.TaskInfo, nodeScores map[float64][]*api.NodeInfo) *api.NodeInfo {
+		return guard(ssn, name, "BestNode", nil, func() *api.NodeInfo { return pf(task, nodeScores) })
+	}
 }
 
 // AddNodeOrderFn add Node order function
 func (ssn *Session) AddNodeOrderFn(name string, pf api.NodeOrderFn) {
-	ssn.nodeOrderFns[name] = pf
+	ssn.nodeOrderFns[name] = func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		return guard2(ssn, name, "NodeOrder", 0, nil, func() (float64, error) { return pf(task, node) })
+	}
 }
 
 // AddHyperNodeOrderFn add hyperNode order function
 func (ssn *Session) AddHyperNodeOrderFn(name string, fn api.HyperNodeOrderFn) {
-	ssn.hyperNodeOrderFns[name] = fn
+	ssn.hyperNodeOrderFns[name] = func(subJob *api.SubJobInfo, hyperNodes map[string][]*api.NodeInfo) (map[string]float64, error) {
+		return guard2(ssn, name, "HyperNodeOrder", nil, nil, func() (map[string]float64, error) { return fn(subJob, hyperNodes) })
+	}
 }
 
 // AddBatchNodeOrderFn add Batch Node order function
 func (ssn *Session) AddBatchNodeOrderFn(name string, pf api.BatchNodeOrderFn) {
-	ssn.batchNodeOrderFns[name] = pf
+	ssn.batchNodeOrderFns[name] = func(task *api.TaskInfo, nodes []*api.NodeInfo) (map[string]float64, error) {
+		return guard2(ssn, name, "BatchNodeOrder", nil, nil, func() (map[string]float64, error) { return pf(task, nodes) })
+	}
 }
 
 // AddNodeMapFn add Node map function
 func (ssn *Session) AddNodeMapFn(name string, pf api.NodeMapFn) {
-	ssn.nodeMapFns[name] = pf
+	ssn.nodeMapFns[name] = func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		return guard2(ssn, name, "NodeMap", 0, nil, func() (float64, error) { return pf(task, node) })
+	}
 }
 
 // AddNodeReduceFn add Node reduce function
 func (ssn *Session) AddNodeReduceFn(name string, pf api.NodeReduceFn) {
-	ssn.nodeReduceFns[name] = pf
+	ssn.nodeReduceFns[name] = func(task *api.TaskInfo, scores fwk.NodeScoreList) error {
+		return guard(ssn, name, "NodeReduce", nil, func() error { return pf(task, scores) })
+	}
 }
 
 // AddOverusedFn add overused function
 func (ssn *Session) AddOverusedFn(name string, fn api.ValidateFn) {
-	ssn.overusedFns[name] = fn
+	ssn.overusedFns[name] = func(obj interface{}) bool {
+		return guard(ssn, name, "Overused", true, func() bool { return fn(obj) })
+	}
 }
 
 // AddPreemptiveFn add preemptive function
 func (ssn *Session) AddPreemptiveFn(name string, fn api.ValidateWithCandidateFn) {
-	ssn.preemptiveFns[name] = fn
+	ssn.preemptiveFns[name] = func(obj interface{}, candidates []*api.TaskInfo) bool {
+		return guard(ssn, name, "Preemptive", false, func() bool { return fn(obj, candidates) })
+	}
 }
 
 // AddAllocatableFn add allocatable function
 func (ssn *Session) AddAllocatableFn(name string, fn api.AllocatableFn) {
-	ssn.allocatableFns[name] = fn
+	ssn.allocatableFns[name] = func(queue *api.QueueInfo, task *api.TaskInfo) bool {
+		return guard(ssn, name, "Allocatable", false, func() bool { return fn(queue, task) })
+	}
+}
+
+// AddResizableFn add resizable function
+func (ssn *Session) AddResizableFn(name string, fn api.ResizableFn) {
+	ssn.resizableFns[name] = func(queue *api.QueueInfo, task *api.TaskInfo) bool {
+		return guard(ssn, name, "Resizable", false, func() bool { return fn(queue, task) })
+	}
 }
 
 // AddJobValidFn add jobvalid function
 func (ssn *Session) AddJobValidFn(name string, fn api.ValidateExFn) {
-	ssn.jobValidFns[name] = fn
+	fallback := func() *api.ValidateResult {
+		return &api.ValidateResult{
+			Pass:    false,
+			Reason:  PluginFailedReason,
+			Message: pluginPanicError(name, "JobValid").Error(),
+		}
+	}
+	ssn.jobValidFns[name] = func(obj interface{}) *api.ValidateResult {
+		return guardLazy(ssn, name, "JobValid", fallback, func() *api.ValidateResult { return fn(obj) })
+	}
 }
 
 // AddJobEnqueueableFn add jobenqueueable function
 func (ssn *Session) AddJobEnqueueableFn(name string, fn api.VoteFn) {
-	ssn.jobEnqueueableFns[name] = fn
+	ssn.jobEnqueueableFns[name] = func(obj interface{}) int {
+		return guard(ssn, name, "JobEnqueueable", voteReject, func() int { return fn(obj) })
+	}
 }
 
 // AddJobEnqueuedFn add jobEnqueued function
 func (ssn *Session) AddJobEnqueuedFn(name string, fn api.JobEnqueuedFn) {
-	ssn.jobEnqueuedFns[name] = fn
+	ssn.jobEnqueuedFns[name] = func(obj interface{}) {
+		guard(ssn, name, "JobEnqueued", struct{}{}, func() struct{} { fn(obj); return struct{}{} })
+	}
 }
 
 // AddTargetJobFn add targetjob function
 func (ssn *Session) AddTargetJobFn(name string, fn api.TargetJobFn) {
-	ssn.targetJobFns[name] = fn
+	ssn.targetJobFns[name] = func(jobs []*api.JobInfo) *api.JobInfo {
+		return guard(ssn, name, "TargetJob", nil, func() *api.JobInfo { return fn(jobs) })
+	}
 }
 
 // AddReservedNodesFn add reservedNodesFn function
 func (ssn *Session) AddReservedNodesFn(name string, fn api.ReservedNodesFn) {
-	ssn.reservedNodesFns[name] = fn
+	ssn.reservedNodesFns[name] = func() {
+		guard(ssn, name, "ReservedNodes", struct{}{}, func() struct{} { fn(); return struct{}{} })
+	}
 }
 
 // AddVictimTasksFns add victimTasksFns function
 func (ssn *Session) AddVictimTasksFns(name string, fns []api.VictimTasksFn) {
-	ssn.victimTasksFns[name] = fns
+	guarded := make([]api.VictimTasksFn, 0, len(fns))
+	for _, fn := range fns {
+		guarded = append(guarded, func(tasks []*api.TaskInfo) []*api.TaskInfo {
+			return guard(ssn, name, "VictimTasks", nil, func() []*api.TaskInfo { return fn(tasks) })
+		})
+	}
+	ssn.victimTasksFns[name] = guarded
 }
 
 // AddJobStarvingFns add jobStarvingFns function
 func (ssn *Session) AddJobStarvingFns(name string, fn api.ValidateFn) {
-	ssn.jobStarvingFns[name] = fn
+	ssn.jobStarvingFns[name] = func(obj interface{}) bool {
+		return guard(ssn, name, "JobStarving", false, func() bool { return fn(obj) })
+	}
 }
 
 func (ssn *Session) AddSimulateAddTaskFn(name string, fn api.SimulateAddTaskFn) {
-	ssn.simulateAddTaskFns[name] = fn
+	fallback := pluginPanicErrorFn(name, "SimulateAddTask")
+	ssn.simulateAddTaskFns[name] = func(ctx context.Context, state fwk.CycleState, taskToSchedule *api.TaskInfo, taskInfoToAdd *api.TaskInfo, nodeInfo *api.NodeInfo) error {
+		return guardLazy(ssn, name, "SimulateAddTask", fallback, func() error {
+			return fn(ctx, state, taskToSchedule, taskInfoToAdd, nodeInfo)
+		})
+	}
 }
 
 func (ssn *Session) AddSimulateRemoveTaskFn(name string, fn api.SimulateRemoveTaskFn) {
-	ssn.simulateRemoveTaskFns[name] = fn
+	fallback := pluginPanicErrorFn(name, "SimulateRemoveTask")
+	ssn.simulateRemoveTaskFns[name] = func(ctx context.Context, state fwk.CycleState, taskToSchedule *api.TaskInfo, taskInfoToRemove *api.TaskInfo, nodeInfo *api.NodeInfo) error {
+		return guardLazy(ssn, name, "SimulateRemoveTask", fallback, func() error {
+			return fn(ctx, state, taskToSchedule, taskInfoToRemove, nodeInfo)
+		})
+	}
 }
 
 func (ssn *Session) AddSimulateAllocatableFn(name string, fn api.SimulateAllocatableFn) {
-	ssn.simulateAllocatableFns[name] = fn
+	ssn.simulateAllocatableFns[name] = func(ctx context.Context, state fwk.CycleState, queue *api.QueueInfo, task *api.TaskInfo) bool {
+		return guard(ssn, name, "SimulateAllocatable", false, func() bool { return fn(ctx, state, queue, task) })
+	}
 }
 
 func (ssn *Session) AddSimulatePredicateFn(name string, fn api.SimulatePredicateFn) {
-	ssn.simulatePredicateFns[name] = fn
+	fallback := pluginPanicErrorFn(name, "SimulatePredicate")
+	ssn.simulatePredicateFns[name] = func(ctx context.Context, state fwk.CycleState, task *api.TaskInfo, nodeInfo *api.NodeInfo) error {
+		return guardLazy(ssn, name, "SimulatePredicate", fallback, func() error {
+			return fn(ctx, state, task, nodeInfo)
+		})
+	}
 }
 
 // AddSubJobReadyFn add SubJobReady function
 func (ssn *Session) AddSubJobReadyFn(name string, vf api.ValidateFn) {
-	ssn.subJobReadyFns[name] = vf
+	ssn.subJobReadyFns[name] = func(obj interface{}) bool {
+		return guard(ssn, name, "SubJobReady", false, func() bool { return vf(obj) })
+	}
 }
 
 // AddSubJobPipelinedFn add SubJobPipelined function
 func (ssn *Session) AddSubJobPipelinedFn(name string, vf api.VoteFn) {
-	ssn.subJobPipelinedFns[name] = vf
+	ssn.subJobPipelinedFns[name] = func(obj interface{}) int {
+		return guard(ssn, name, "SubJobPipelined", voteReject, func() int { return vf(obj) })
+	}
 }
 
 // AddSubJobOrderFn add SubJobOrderFn function
 func (ssn *Session) AddSubJobOrderFn(name string, fn api.CompareFn) {
-	ssn.subJobOrderFns[name] = fn
+	ssn.subJobOrderFns[name] = func(l, r interface{}) int {
+		return guard(ssn, name, "SubJobOrder", 0, func() int { return fn(l, r) })
+	}
 }
 
 // AddHyperNodeGradientForJobFn add HyperNodeGradientForJobFn function
 func (ssn *Session) AddHyperNodeGradientForJobFn(name string, fn api.HyperNodeGradientForJobFn) {
-	ssn.hyperNodeGradientForJobFns[name] = fn
+	ssn.hyperNodeGradientForJobFns[name] = func(job *api.JobInfo, hyperNode *api.HyperNodeInfo, purpose api.SearchPurpose) [][]*api.HyperNodeInfo {
+		return guard(ssn, name, "HyperNodeGradientForJob", nil, func() [][]*api.HyperNodeInfo { return fn(job, hyperNode, purpose) })
+	}
 }
 
 // AddHyperNodeGradientForSubJobFn add HyperNodeGradientForSubJobFn function
 func (ssn *Session) AddHyperNodeGradientForSubJobFn(name string, fn api.HyperNodeGradientForSubJobFn) {
-	ssn.hyperNodeGradientForSubJobFns[name] = fn
+	ssn.hyperNodeGradientForSubJobFns[name] = func(subJob *api.SubJobInfo, hyperNode *api.HyperNodeInfo, purpose api.SearchPurpose) [][]*api.HyperNodeInfo {
+		return guard(ssn, name, "HyperNodeGradientForSubJob", nil, func() [][]*api.HyperNodeInfo { return fn(subJob, hyperNode, purpose) })
+	}
+}
+
+// AddPostCommitFn add PostCommit function, it is called with the operations of a statement once they are committed
+func (ssn *Session) AddPostCommitFn(name string, fn StatementFn) {
+	ssn.postCommitFns[name] = func(ops []StagedOperation) {
+		guard(ssn, name, "PostCommit", struct{}{}, func() struct{} { fn(ops); return struct{}{} })
+	}
+}
+
+// AddPostDiscardFn add PostDiscard function, it is called with the operations of a statement once they are discarded
+func (ssn *Session) AddPostDiscardFn(name string, fn StatementFn) {
+	ssn.postDiscardFns[name] = func(ops []StagedOperation) {
+		guard(ssn, name, "PostDiscard", struct{}{}, func() struct{} { fn(ops); return struct{}{} })
+	}
 }
 
 // Reclaimable invoke reclaimable function of the plugins
@@ -226,6 +343,8 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 	var victims []*api.TaskInfo
 
 	for _, tier := range ssn.Tiers {
+		// voters are the plugins of the tier which did not abstain
+		var voters []string
 		for _, plugin := range tier.Plugins {
 			if !isEnabled(plugin.EnabledReclaimable) {
 				continue
@@ -239,6 +358,7 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 			if abstain == 0 {
 				continue
 			}
+			voters = append(voters, plugin.Name)
 			if len(candidates) == 0 {
 				victims = nil
 				break
@@ -263,6 +383,7 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 		}
 		// Plugins in this tier made decision if victims is not nil
 		if victims != nil {
+			ssn.recordVictimVoters(victims, voters)
 			return victims
 		}
 	}
@@ -275,6 +396,8 @@ func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskI
 	var victims []*api.TaskInfo
 
 	for _, tier := range ssn.Tiers {
+		// voters are the plugins of the tier which did not abstain
+		var voters []string
 		for _, plugin := range tier.Plugins {
 			if !isEnabled(plugin.EnabledPreemptable) {
 				continue
@@ -288,6 +411,7 @@ func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskI
 			if abstain == 0 {
 				continue
 			}
+			voters = append(voters, plugin.Name)
 			// intersection will be nil if length is 0, don't need to do any more check
 			if len(candidates) == 0 {
 				victims = nil
@@ -313,6 +437,7 @@ func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskI
 		}
 		// Plugins in this tier made decision if victims is not nil
 		if victims != nil {
+			ssn.recordVictimVoters(victims, voters)
 			return victims
 		}
 	}
@@ -326,6 +451,8 @@ func (ssn *Session) UnifiedEvictable(ctx *api.EvictionContext, candidates []*api
 	var victims []*api.TaskInfo
 
 	for _, tier := range ssn.Tiers {
+		// voters are the plugins of the tier which did not abstain
+		var voters []string
 		for _, plugin := range tier.Plugins {
 			fn, found := ssn.unifiedEvictableFns[plugin.Name]
 			if !found {
@@ -335,6 +462,7 @@ func (ssn *Session) UnifiedEvictable(ctx *api.EvictionContext, candidates []*api
 			if abstain == 0 {
 				continue
 			}
+			voters = append(voters, plugin.Name)
 			if len(result) == 0 {
 				victims = nil
 				break
@@ -354,6 +482,7 @@ func (ssn *Session) UnifiedEvictable(ctx *api.EvictionContext, candidates []*api
 			}
 		}
 		if victims != nil {
+			ssn.recordVictimVoters(victims, voters)
 			return victims
 		}
 	}
@@ -421,6 +550,24 @@ func (ssn *Session) Allocatable(queue *api.QueueInfo, candidate *api.TaskInfo) b
 	return true
 }
 
+// Resizable invoke resizable function of the plugins, the pending in-place resize of the task is denied if any of
+// them denies it
+func (ssn *Session) Resizable(queue *api.QueueInfo, task *api.TaskInfo) bool {
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			rf, found := ssn.resizableFns[plugin.Name]
+			if !found {
+				continue
+			}
+			if !rf(queue, task) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (ssn *Session) SubJobReady(job *api.JobInfo, subJob *api.SubJobInfo) bool {
 	if !job.ContainsSubJobPolicy() {
 		return ssn.JobReady(job)
@@ -661,6 +808,7 @@ func (ssn *Session) VictimTasks(tasks []*api.TaskInfo) map[*api.TaskInfo]bool {
 				for _, victim := range victimTasks {
 					victimSet[victim] = true
 				}
+				ssn.recordVictimVoters(victimTasks, []string{plugin.Name})
 			}
 		}
 		if len(victimSet) > 0 {
@@ -840,9 +988,12 @@ func (ssn *Session) TaskOrderFn(l, r interface{}) bool {
 		return res < 0
 	}
 
-	// If no task order funcs, order task by default func.
+	// If no task order funcs, order the un-gated tasks by their order, then task by default func.
 	lv := l.(*api.TaskInfo)
 	rv := r.(*api.TaskInfo)
+	if res := api.CompareUngatedTask(lv, rv); res != 0 {
+		return res < 0
+	}
 	return helpers.CompareTask(lv, rv)
 }
 
@@ -1152,6 +1303,31 @@ func (ssn *Session) HyperNodeGradientForSubJobFn(subJob *api.SubJobInfo, hyperNo
 	return [][]*api.HyperNodeInfo{{hyperNode}}
 }
 
+// PostCommit invoke postCommitFns function of the plugins with the committed operations
+func (ssn *Session) PostCommit(ops []StagedOperation) {
+	ssn.invokeStatementFns(ssn.postCommitFns, ops)
+}
+
+// PostDiscard invoke postDiscardFns function of the plugins with the discarded operations
+func (ssn *Session) PostDiscard(ops []StagedOperation) {
+	ssn.invokeStatementFns(ssn.postDiscardFns, ops)
+}
+
+func (ssn *Session) invokeStatementFns(fns map[string]StatementFn, ops []StagedOperation) {
+	if len(ops) == 0 {
+		return
+	}
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			fn, found := fns[plugin.Name]
+			if !found {
+				continue
+			}
+			fn(ops)
+		}
+	}
+}
+
 // BuildVictimsPriorityQueue returns a priority queue with victims sorted by:
 //  1. If victims belong to the same job, use !ssn.TaskOrderFn.
 //  2. If either victim's job is missing, evict orphaned tasks first; if both
@@ -1198,6 +1374,11 @@ func (ssn *Session) BuildVictimsPriorityQueue(victims []*api.TaskInfo, preemptor
 			return !lvJobFound
 		}
 
+		// The tasks of the jobs running longer than the maximum run time of their queue are evicted first.
+		if lvOverran, rvOverran := ssn.JobExceedsMaxRun(lvJob), ssn.JobExceedsMaxRun(rvJob); lvOverran != rvOverran {
+			return lvOverran
+		}
+
 		if !preemptorJobFound {
 			return jobThenTaskOrder(lvJob, rvJob, l, r)
 		}
@@ -125,3 +125,42 @@ func TestBuildVictimsPriorityQueueJobTieBreaksWithTaskOrder(t *testing.T) {
 		assert.Equal(t, "p-low", first.Name)
 	})
 }
+
+func TestBuildVictimsPriorityQueueMaxRunExceededFirst(t *testing.T) {
+	maxRun := int64(3600)
+	queueQ1 := util.BuildQueue("q1", 1, nil)
+	queueQ1.Spec.MaxRunSeconds = &maxRun
+	nodeN1 := util.BuildNode("n1", api.BuildResourceList("10", "10Gi", []api.ScalarResource{{Name: "pods", Value: "20"}}...), nil)
+	pgOld := util.BuildPodGroup("pg-old", "ns1", "q1", 1, nil, schedulingv1.PodGroupRunning)
+	pgNew := util.BuildPodGroup("pg-new", "ns1", "q1", 1, nil, schedulingv1.PodGroupRunning)
+	pOld := util.BuildPod("ns1", "p-old", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-old", nil, nil)
+	pOld.Status.StartTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+	pNew := util.BuildPod("ns1", "p-new", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-new", nil, nil)
+	pNew.Status.StartTime = &metav1.Time{Time: time.Now()}
+	// without the maximum run time, the task created later would be evicted first
+	pOld.CreationTimestamp = metav1.NewTime(time.Unix(10, 0))
+	pNew.CreationTimestamp = metav1.NewTime(time.Unix(20, 0))
+
+	tc := uthelper.TestCommonStruct{
+		Plugins:   map[string]framework.PluginBuilder{},
+		Queues:    []*schedulingv1.Queue{queueQ1},
+		Nodes:     []*v1.Node{nodeN1},
+		PodGroups: []*schedulingv1.PodGroup{pgOld, pgNew},
+		Pods:      []*v1.Pod{pNew, pOld},
+	}
+	ssn := tc.RegisterSession(nil, nil)
+	defer tc.Close()
+
+	var victims []*api.TaskInfo
+	for _, job := range ssn.Jobs {
+		for _, task := range job.Tasks {
+			victims = append(victims, task)
+		}
+	}
+	assert.Len(t, victims, 2)
+
+	pq := ssn.BuildVictimsPriorityQueue(victims, &api.TaskInfo{Job: api.JobID("missing")})
+	first := pq.Pop().(*api.TaskInfo)
+	assert.Equal(t, "p-old", first.Name)
+	assert.True(t, ssn.JobExceedsMaxRun(ssn.Jobs[first.Job]))
+}
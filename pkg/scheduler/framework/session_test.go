@@ -3,8 +3,12 @@ package framework
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
@@ -695,3 +699,73 @@ func TestAdjustNetworkTopologySpec_SoftToHardConversion(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateQueueCondition(t *testing.T) {
+	ssn := &Session{
+		Queues: map[api.QueueID]*api.QueueInfo{
+			"q1": {UID: "q1", Name: "q1", Queue: &scheduling.Queue{}},
+		},
+		dirtyQueues: sets.New[api.QueueID](),
+	}
+	transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	cond := &scheduling.QueueCondition{
+		Type:               scheduling.QueueFairShareViolated,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: transitionTime,
+		Reason:             "Violated",
+	}
+
+	if err := ssn.UpdateQueueCondition("unknown", cond); err == nil {
+		t.Errorf("expected error for unknown queue")
+	}
+	if err := ssn.UpdateQueueCondition("q1", cond); err != nil {
+		t.Fatalf("failed to update queue condition: %v", err)
+	}
+	if !ssn.dirtyQueues.Has("q1") || len(ssn.Queues["q1"].Queue.Status.Conditions) != 1 {
+		t.Fatalf("expected queue q1 to be dirty with one condition")
+	}
+
+	// Updating the message keeps the transition time of the condition with the same status.
+	ssn.dirtyQueues = sets.New[api.QueueID]()
+	updated := cond.DeepCopy()
+	updated.Message = "still violated"
+	updated.LastTransitionTime = metav1.Now()
+	if err := ssn.UpdateQueueCondition("q1", updated); err != nil {
+		t.Fatalf("failed to update queue condition: %v", err)
+	}
+	got := ssn.Queues["q1"].Queue.Status.Conditions
+	if len(got) != 1 || got[0].Message != "still violated" || !got[0].LastTransitionTime.Equal(&transitionTime) {
+		t.Errorf("unexpected conditions %v", got)
+	}
+	if !ssn.dirtyQueues.Has("q1") {
+		t.Errorf("expected queue q1 to be dirty after the condition changed")
+	}
+
+	// An unchanged condition does not make the queue dirty.
+	ssn.dirtyQueues = sets.New[api.QueueID]()
+	if err := ssn.UpdateQueueCondition("q1", updated); err != nil {
+		t.Fatalf("failed to update queue condition: %v", err)
+	}
+	if ssn.dirtyQueues.Has("q1") {
+		t.Errorf("expected queue q1 not to be dirty for an unchanged condition")
+	}
+}
+
+func TestUpdatePodGroupConditionTransitionID(t *testing.T) {
+	job := &api.JobInfo{UID: "ns/job1", Namespace: "ns", Name: "job1", PodGroup: &api.PodGroup{}}
+	ssn := &Session{
+		UID:  "cycle-1",
+		Jobs: map[api.JobID]*api.JobInfo{job.UID: job},
+	}
+
+	if err := ssn.UpdatePodGroupCondition(job, &scheduling.PodGroupCondition{Type: scheduling.PodGroupUnschedulableType}); err != nil {
+		t.Fatalf("failed to update podgroup condition: %v", err)
+	}
+	if err := ssn.UpdatePodGroupCondition(job, &scheduling.PodGroupCondition{Type: scheduling.PodGroupScheduled, TransitionID: "task-1"}); err != nil {
+		t.Fatalf("failed to update podgroup condition: %v", err)
+	}
+	conditions := job.PodGroup.Status.Conditions
+	assert.Equal(t, 2, len(conditions))
+	assert.Equal(t, "cycle-1", conditions[0].TransitionID, "the condition should be correlated with the cycle")
+	assert.Equal(t, "task-1", conditions[1].TransitionID, "the given transition id should be kept")
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+// JobStarvingReason is the reason of the event of the jobs waiting for resources longer than the starvation threshold.
+const JobStarvingReason = "JobStarving"
+
+var (
+	starvationMutex sync.Mutex
+	starvation      = &starvationDetector{
+		jobs:   map[api.JobID]*pendingRecord{},
+		queues: sets.New[string](),
+	}
+)
+
+// starvationDetector tracks across the sessions since when the jobs are waiting for resources and why they are rejected.
+type starvationDetector struct {
+	// threshold is how long a job waits before it is starving, zero if the detection is disabled.
+	threshold time.Duration
+	// triggerPlugins lets the plugins act on the starving jobs.
+	triggerPlugins bool
+	jobs           map[api.JobID]*pendingRecord
+	// queues are the queues with starvation metrics, which are deleted when the queues have no waiting jobs.
+	queues sets.Set[string]
+}
+
+// pendingRecord is the history of a job waiting for resources.
+type pendingRecord struct {
+	queue string
+	// since is the time the job is waiting since, zero while it is not waiting.
+	since time.Time
+	// reason is the last reason the job was rejected for, and rejections the number of sessions it was rejected in.
+	reason     string
+	rejections int
+	// warned is whether the starving event of the current wait has been recorded.
+	warned bool
+}
+
+// SetStarvationDetection sets how long the jobs wait for resources before they are starving in the sessions opened
+// afterwards, zero disables the detection. The plugins act on the starving jobs if triggerPlugins is set.
+// The jobs already tracked are kept when the detection stays enabled.
+func SetStarvationDetection(threshold time.Duration, triggerPlugins bool) {
+	starvationMutex.Lock()
+	defer starvationMutex.Unlock()
+	starvation.threshold = threshold
+	starvation.triggerPlugins = triggerPlugins
+	if threshold > 0 {
+		return
+	}
+	starvation.jobs = map[api.JobID]*pendingRecord{}
+	for queue := range starvation.queues {
+		metrics.DeleteQueueStarvation(queue)
+	}
+	starvation.queues = sets.New[string]()
+}
+
+// waitingForResources returns whether the job has pending tasks and not enough ready tasks to run.
+func waitingForResources(job *api.JobInfo) bool {
+	return job.PodGroup != nil && len(job.TaskStatusIndex[api.Pending]) > 0 &&
+		job.ReadyTaskNum()+job.WaitingTaskNum() < job.MinAvailable
+}
+
+// rejectionReason returns why the job was not scheduled in the session, empty if it was not tried.
+func rejectionReason(job *api.JobInfo) string {
+	if job.JobFitErrors != "" {
+		return job.JobFitErrors
+	}
+	var taskIDs []api.TaskID
+	for taskID := range job.TaskStatusIndex[api.Pending] {
+		if job.NodesFitErrors[taskID] != nil {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	if len(taskIDs) == 0 {
+		return ""
+	}
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	return job.NodesFitErrors[taskIDs[0]].Error()
+}
+
+// starvingJobs returns the jobs found starving by the previous sessions, if the plugins act on them.
+func starvingJobs(jobs map[api.JobID]*api.JobInfo, now time.Time) sets.Set[api.JobID] {
+	starvationMutex.Lock()
+	defer starvationMutex.Unlock()
+	starving := sets.New[api.JobID]()
+	if starvation.threshold <= 0 || !starvation.triggerPlugins {
+		return starving
+	}
+	for jobID := range jobs {
+		if record, found := starvation.jobs[jobID]; found && !record.since.IsZero() && now.Sub(record.since) >= starvation.threshold {
+			starving.Insert(jobID)
+		}
+	}
+	return starving
+}
+
+// StarvationDetected returns whether the job was found starving and the plugins are configured to act on it,
+// e.g. the sla plugin escalates it to reclaim and the aging plugin gives it the highest priority.
+func (ssn *Session) StarvationDetected(job *api.JobInfo) bool {
+	return ssn.starvingJobs.Has(job.UID)
+}
+
+// detectStarvation updates the waiting jobs of the profile of the session with their rejection reasons, records an
+// event of the jobs becoming starving and updates the starvation metrics. The jobs which are gone are forgotten.
+func (ssn *Session) detectStarvation(now time.Time) {
+	starvationMutex.Lock()
+	defer starvationMutex.Unlock()
+	threshold := starvation.threshold
+	if threshold <= 0 {
+		return
+	}
+
+	for jobID := range starvation.jobs {
+		if _, found := ssn.Jobs[jobID]; !found {
+			delete(starvation.jobs, jobID)
+		}
+	}
+	for jobID, job := range ssn.Jobs {
+		if !ssn.JobInProfile(job) {
+			continue
+		}
+		record, found := starvation.jobs[jobID]
+		if !waitingForResources(job) {
+			// the jobs seen not waiting are kept with the zero time, so they wait from the time they are pending again
+			starvation.jobs[jobID] = &pendingRecord{queue: ssn.queueName(job)}
+			continue
+		}
+		if !found {
+			// a job first seen waiting is waiting since its creation, e.g. after the scheduler restarts
+			record = &pendingRecord{since: job.CreationTimestamp.Time}
+			starvation.jobs[jobID] = record
+		}
+		if record.since.IsZero() {
+			record.since = now
+		}
+		record.queue = ssn.queueName(job)
+		if reason := rejectionReason(job); reason != "" {
+			record.reason = reason
+			record.rejections++
+		}
+
+		pending := now.Sub(record.since)
+		if record.warned || pending < threshold {
+			continue
+		}
+		record.warned = true
+		metrics.RegisterJobStarvation(record.queue)
+		msg := fmt.Sprintf("Job has been waiting for resources for %v, longer than the starvation threshold %v",
+			pending.Round(time.Second), threshold)
+		if record.reason != "" {
+			msg += fmt.Sprintf(", rejected in %d sessions, last reason: %s", record.rejections, record.reason)
+		}
+		ssn.Logger().V(3).Info("Job is starving", "job", klog.KRef(job.Namespace, job.Name),
+			"pending", pending, "rejections", record.rejections, "reason", record.reason)
+		ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeWarning, JobStarvingReason, msg)
+	}
+	starvation.updateMetrics(now)
+}
+
+// updateMetrics updates the number of starving jobs and the longest time a job is waiting of the queues.
+func (d *starvationDetector) updateMetrics(now time.Time) {
+	starving := map[string]int{}
+	longest := map[string]float64{}
+	for _, record := range d.jobs {
+		if record.since.IsZero() {
+			continue
+		}
+		pending := now.Sub(record.since)
+		if seconds, found := longest[record.queue]; !found || pending.Seconds() > seconds {
+			longest[record.queue] = pending.Seconds()
+		}
+		if pending >= d.threshold {
+			starving[record.queue]++
+		}
+	}
+	for queue := range d.queues {
+		if _, found := longest[queue]; !found {
+			metrics.DeleteQueueStarvation(queue)
+		}
+	}
+	d.queues = sets.New[string]()
+	for queue, seconds := range longest {
+		metrics.UpdateQueueStarvation(queue, starving[queue], seconds)
+		d.queues.Insert(queue)
+	}
+}
+
+// queueName returns the name of the queue of the job.
+func (ssn *Session) queueName(job *api.JobInfo) string {
+	if queue, found := ssn.Queues[job.Queue]; found {
+		return queue.Name
+	}
+	return string(job.Queue)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func buildStarvationJob(uid string, created time.Time, pods ...*v1.Pod) *api.JobInfo {
+	job := api.NewJobInfo(api.JobID(uid))
+	pg := &api.PodGroup{}
+	pg.Name = uid
+	pg.Namespace = "c1"
+	pg.CreationTimestamp = metav1.Time{Time: created}
+	pg.Spec.Queue = "q1"
+	pg.Spec.MinMember = 1
+	job.SetPodGroup(pg)
+	for _, pod := range pods {
+		task := api.NewTaskInfo(pod)
+		task.Job = job.UID
+		job.AddTaskInfo(task)
+	}
+	return job
+}
+
+func starvingEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			if strings.Contains(event, JobStarvingReason) {
+				events = append(events, event)
+			}
+		default:
+			return events
+		}
+	}
+}
+
+func TestDetectStarvation(t *testing.T) {
+	SetStarvationDetection(10*time.Minute, true)
+	defer SetStarvationDetection(0, false)
+
+	recorder := record.NewFakeRecorder(100)
+	ssn := OpenSession(cache.NewCustomMockSchedulerCache("test-scheduler", nil, nil, nil, nil, recorder), nil, nil)
+	defer CloseSession(ssn)
+
+	now := time.Now()
+	starving := buildStarvationJob("starving", now.Add(-time.Hour),
+		util.BuildPod("c1", "starving-1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "starving", nil, nil))
+	fitErrors := api.NewFitErrors()
+	fitErrors.SetError("0/1 nodes are unavailable: 1 Insufficient cpu")
+	for taskID := range starving.Tasks {
+		starving.NodesFitErrors[taskID] = fitErrors
+	}
+	recent := buildStarvationJob("recent", now.Add(-time.Minute),
+		util.BuildPod("c1", "recent-1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "recent", nil, nil))
+	running := buildStarvationJob("running", now.Add(-time.Hour),
+		util.BuildPod("c1", "running-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "running", nil, nil))
+	ssn.Jobs = map[api.JobID]*api.JobInfo{starving.UID: starving, recent.UID: recent, running.UID: running}
+	queue := buildArgumentsQueue("q1", "", "")
+	ssn.Queues = map[api.QueueID]*api.QueueInfo{queue.UID: queue}
+
+	ssn.detectStarvation(now)
+	events := starvingEvents(recorder)
+	if len(events) != 1 || !strings.Contains(events[0], "Insufficient cpu") {
+		t.Fatalf("expected one starving event with the rejection reason, got %v", events)
+	}
+	if record := starvation.jobs[running.UID]; record == nil || !record.since.IsZero() {
+		t.Errorf("expected running job tracked as not waiting, got %+v", record)
+	}
+	if jobs := starvingJobs(ssn.Jobs, now); !jobs.Equal(sets.New(starving.UID)) {
+		t.Errorf("expected starving jobs %v, got %v", []api.JobID{starving.UID}, sets.List(jobs))
+	}
+
+	// the starving event is recorded once per wait, the rejections are counted across sessions
+	ssn.detectStarvation(now.Add(10 * time.Minute))
+	events = starvingEvents(recorder)
+	if len(events) != 1 || !strings.Contains(events[0], "waiting for resources for 11m0s") {
+		t.Errorf("expected one starving event of the recent job, got %v", events)
+	}
+	if rejections := starvation.jobs[starving.UID].rejections; rejections != 2 {
+		t.Errorf("expected 2 rejections of the starving job, got %d", rejections)
+	}
+
+	// the jobs which are gone are forgotten
+	delete(ssn.Jobs, recent.UID)
+	ssn.detectStarvation(now.Add(10 * time.Minute))
+	if _, found := starvation.jobs[recent.UID]; found {
+		t.Errorf("expected the job which is gone to be forgotten")
+	}
+
+	SetStarvationDetection(10*time.Minute, false)
+	if jobs := starvingJobs(ssn.Jobs, now); jobs.Len() != 0 {
+		t.Errorf("expected no starving jobs for the plugins if not triggered, got %v", sets.List(jobs))
+	}
+}
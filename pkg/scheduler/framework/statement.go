@@ -25,6 +25,7 @@ package framework
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"k8s.io/klog/v2"
 
@@ -48,12 +49,35 @@ type operation struct {
 	name   Operation
 	task   *api.TaskInfo
 	reason string
+	// migration is the migration of the task evicted, nil if it is only evicted
+	migration *api.Migration
+}
+
+// savepoint marks a position in the operations of a statement.
+type savepoint struct {
+	SavepointID
+	index int
+}
+
+// SavepointID identifies a savepoint of a statement. It is unique within the statement, so that the savepoints of
+// nested or repeated attempts sharing a name, e.g. the name of a node, never collide.
+type SavepointID struct {
+	id   int
+	name string
+}
+
+// String returns the name of the savepoint along with its id, for logging.
+func (sp SavepointID) String() string {
+	return fmt.Sprintf("%s#%d", sp.name, sp.id)
 }
 
 // Statement structure
 type Statement struct {
 	operations []operation
-	ssn        *Session
+	savepoints []savepoint
+	// lastSavepoint is the id of the last savepoint handed out by the statement.
+	lastSavepoint int
+	ssn           *Session
 }
 
 // NewStatement returns new statement object
@@ -63,13 +87,83 @@ func NewStatement(ssn *Session) *Statement {
 	}
 }
 
-// AddOperation adds operation to statement
-func (s *Statement) Operations() []operation {
-	return s.operations
+// String returns the name of the operation type.
+func (o Operation) String() string {
+	switch o {
+	case Evict:
+		return "evict"
+	case Pipeline:
+		return "pipeline"
+	case Allocate:
+		return "allocate"
+	default:
+		return "unknown"
+	}
+}
+
+// StagedOperation is a read-only view of an operation staged in a statement which has not been committed yet.
+type StagedOperation struct {
+	// Type is the type of the operation.
+	Type Operation
+	// Task is the task of the operation, it must not be modified.
+	Task *api.TaskInfo
+	// NodeName is the node the task is evicted from, pipelined or allocated to.
+	NodeName string
+	// Reason is the reason of the eviction, it is empty for the other operations.
+	Reason string
+}
+
+// String returns a human-readable description of the operation, e.g. "evict ns/name from node n1 (reclaim)".
+func (op StagedOperation) String() string {
+	var desc string
+	switch op.Type {
+	case Evict:
+		desc = fmt.Sprintf("evict %s/%s from node %s", op.Task.Namespace, op.Task.Name, op.NodeName)
+	default:
+		desc = fmt.Sprintf("%s %s/%s to node %s", op.Type, op.Task.Namespace, op.Task.Name, op.NodeName)
+	}
+	if op.Reason != "" {
+		desc += fmt.Sprintf(" (%s)", op.Reason)
+	}
+	return desc
+}
+
+// StatementFn is the function called with the final operations of a statement once it is committed or discarded,
+// so that the stateful plugins can keep their state in line with the placements which actually took effect.
+type StatementFn func(ops []StagedOperation)
+
+// Operations returns the operations staged in the statement in the order they were made, so that plugins
+// and debugging tools can inspect what an action is about to commit.
+func (s *Statement) Operations() []StagedOperation {
+	return stagedOperations(s.operations)
+}
+
+func stagedOperations(operations []operation) []StagedOperation {
+	ops := make([]StagedOperation, 0, len(operations))
+	for _, op := range operations {
+		ops = append(ops, op.staged())
+	}
+	return ops
+}
+
+func (op operation) staged() StagedOperation {
+	return StagedOperation{
+		Type:     op.name,
+		Task:     op.task,
+		NodeName: op.task.NodeName,
+		Reason:   op.reason,
+	}
 }
 
 // Evict the pod
 func (s *Statement) Evict(reclaimee *api.TaskInfo, reason string) {
+	// Claim the victim in the session, so that its freed resources are not counted twice.
+	if !s.ssn.victimLedger.Claim(reclaimee, reason) {
+		klog.Errorf("Task <%v/%v> has already been claimed as victim in Session <%v>, skip evicting it for <%s>.",
+			reclaimee.Namespace, reclaimee.Name, s.ssn.UID, reason)
+		return
+	}
+
 	// Update status in session
 	if job, found := s.ssn.Jobs[reclaimee.Job]; found {
 		job.UpdateTaskStatus(reclaimee, api.Releasing)
@@ -99,6 +193,16 @@ func (s *Statement) Evict(reclaimee *api.TaskInfo, reason string) {
 	})
 }
 
+// Migrate evicts the task like Evict, and moves it to the node of the migration by checkpoint and restore
+// once the statement is committed.
+func (s *Statement) Migrate(reclaimee *api.TaskInfo, migration *api.Migration, reason string) {
+	count := len(s.operations)
+	s.Evict(reclaimee, reason)
+	if len(s.operations) > count {
+		s.operations[len(s.operations)-1].migration = migration
+	}
+}
+
 func (s *Statement) evict(reclaimee *api.TaskInfo, reason string) error {
 	if err := s.ssn.cache.Evict(reclaimee, reason); err != nil {
 		if e := s.unevict(reclaimee); e != nil {
@@ -106,11 +210,14 @@ func (s *Statement) evict(reclaimee *api.TaskInfo, reason string) error {
 		}
 		return err
 	}
+	s.ssn.recordDecision(DecisionEvict, reclaimee, reason, nil)
 
 	return nil
 }
 
 func (s *Statement) unevict(reclaimee *api.TaskInfo) error {
+	s.ssn.victimLedger.Release(reclaimee)
+
 	// Update status in session
 	job, found := s.ssn.Jobs[reclaimee.Job]
 	if found {
@@ -204,7 +311,8 @@ func (s *Statement) Pipeline(task *api.TaskInfo, hostname string, evictionOccurr
 	return nil
 }
 
-func (s *Statement) pipeline(task *api.TaskInfo) {
+func (s *Statement) pipeline(task *api.TaskInfo, victims []*api.TaskInfo) {
+	s.ssn.recordDecision(DecisionPipeline, task, "", victims)
 }
 
 func (s *Statement) UnPipeline(task *api.TaskInfo) error {
@@ -327,6 +435,7 @@ func (s *Statement) allocate(task *api.TaskInfo) error {
 			task.Job, s.ssn.UID)
 		return fmt.Errorf("failed to find job %s", task.Job)
 	}
+	s.ssn.recordDecision(DecisionBind, task, "", nil)
 
 	metrics.UpdateTaskScheduleDuration(metrics.TaskStageAssumed, metrics.Duration(task.Pod.CreationTimestamp.Time))
 	return nil
@@ -363,8 +472,18 @@ func (s *Statement) unallocate(task *api.TaskInfo) error {
 
 // Discard operation for evict, pipeline and allocate
 func (s *Statement) Discard() {
-	klog.V(3).Info("Discarding operations ...")
-	for i := len(s.operations) - 1; i >= 0; i-- {
+	s.ssn.Logger().V(3).Info("Discarding operations ...")
+	s.outputOperations("Discarding operations", 4)
+	s.rollbackOperations(0)
+	s.savepoints = nil
+}
+
+// rollbackOperations reverts the operations from the given index in reverse order,
+// and restores the resources accounted for them in the session.
+func (s *Statement) rollbackOperations(from int) {
+	discarded := stagedOperations(s.operations[from:])
+	defer s.ssn.PostDiscard(discarded)
+	for i := len(s.operations) - 1; i >= from; i-- {
 		op := s.operations[i]
 		op.task.GenerateLastTxContext()
 		switch op.name {
@@ -385,22 +504,87 @@ func (s *Statement) Discard() {
 			}
 		}
 	}
-	s.operations = nil
+	if from == 0 {
+		s.operations = nil
+		return
+	}
+	s.operations = s.operations[:from]
+}
+
+// Savepoint marks the current position of the statement, so that the operations made after it can be reverted by
+// RollbackTo without discarding the whole statement. The name only describes the savepoint in logs and errors, the
+// returned id identifies it.
+func (s *Statement) Savepoint(name string) SavepointID {
+	s.lastSavepoint++
+	id := SavepointID{id: s.lastSavepoint, name: name}
+	s.savepoints = append(s.savepoints, savepoint{
+		SavepointID: id,
+		index:       len(s.operations),
+	})
+	klog.V(5).Infof("Statement savepoint <%s> at operation %d", id, len(s.operations))
+	return id
+}
+
+// RollbackTo reverts the operations made after the savepoint in reverse order, restoring
+// the resources of nodes, jobs and victims accounted for them. The savepoint itself is kept so that
+// it can be rolled back to again, while the savepoints created after it are removed.
+func (s *Statement) RollbackTo(id SavepointID) error {
+	for i := len(s.savepoints) - 1; i >= 0; i-- {
+		sp := s.savepoints[i]
+		if sp.SavepointID != id {
+			continue
+		}
+		klog.V(4).Infof("Rolling back %d operations to savepoint <%s>", len(s.operations)-sp.index, id)
+		s.rollbackOperations(sp.index)
+		s.savepoints = s.savepoints[:i+1]
+		return nil
+	}
+	return fmt.Errorf("savepoint <%s> not found", id)
+}
+
+// ReleaseSavepoint removes the savepoint and the savepoints created after it,
+// the operations made after it are kept in the statement.
+func (s *Statement) ReleaseSavepoint(id SavepointID) error {
+	for i := len(s.savepoints) - 1; i >= 0; i-- {
+		if s.savepoints[i].SavepointID == id {
+			s.savepoints = s.savepoints[:i]
+			return nil
+		}
+	}
+	return fmt.Errorf("savepoint <%s> not found", id)
 }
 
 // Commit operation for evict and pipeline
 func (s *Statement) Commit() {
-	klog.V(3).Info("Committing operations ...")
-	for _, op := range s.operations {
+	s.ssn.Logger().V(3).Info("Committing operations ...")
+	s.outputOperations("Committing operations", 4)
+	// victims are the tasks evicted per node, the tasks pipelined to the node are waiting for them.
+	victims := map[string][]*api.TaskInfo{}
+	// reclaimees are the tasks evicted per node by reclaim, they are counted for the queue of the task pipelined to the node.
+	reclaimees := map[string][]*api.TaskInfo{}
+	victimContexts := s.victimContexts()
+	// the operations which fail to be committed are reported as discarded
+	var committed, discarded []StagedOperation
+	for i, op := range s.operations {
 		op.task.ClearLastTxContext()
+		staged := op.staged()
 		switch op.name {
 		case Evict:
+			op.task.VictimContext = victimContexts[i]
 			err := s.evict(op.task, op.reason)
 			if err != nil {
 				klog.Errorf("Failed to evict task: %s", err.Error())
+				discarded = append(discarded, staged)
+				continue
+			}
+			victims[op.task.NodeName] = append(victims[op.task.NodeName], op.task)
+			if op.reason == reclaimReason {
+				reclaimees[op.task.NodeName] = append(reclaimees[op.task.NodeName], op.task)
 			}
 		case Pipeline:
-			s.pipeline(op.task)
+			s.pipeline(op.task, victims[op.task.NodeName])
+			s.recordReclaims(op.task, reclaimees[op.task.NodeName])
+			delete(reclaimees, op.task.NodeName)
 		case Allocate:
 			err := s.allocate(op.task)
 			if err != nil {
@@ -408,10 +592,83 @@ func (s *Statement) Commit() {
 					klog.Errorf("Failed to unallocate task <%v/%v>: %v.", op.task.Namespace, op.task.Name, e)
 				}
 				klog.Errorf("Failed to allocate task <%v/%v>: %v.", op.task.Namespace, op.task.Name, err)
+				discarded = append(discarded, staged)
+				continue
 			}
 		}
+		committed = append(committed, staged)
+	}
+	for _, tasks := range reclaimees {
+		s.recordReclaims(nil, tasks)
 	}
 	s.operations = nil
+	s.savepoints = nil
+	s.ssn.PostCommit(committed)
+	s.ssn.PostDiscard(discarded)
+}
+
+// victimContexts returns the victim context of each evict operation, by the index of the operation. The resources of
+// a victim are freed for the task pipelined or allocated to its node after it, or the first task pipelined or allocated
+// by the statement if there is none. The victims are checkpointed by the policy of the action for their queue.
+func (s *Statement) victimContexts() map[int]*api.VictimContext {
+	var first *api.TaskInfo
+	for _, op := range s.operations {
+		if op.name == Pipeline || op.name == Allocate {
+			first = op.task
+			break
+		}
+	}
+
+	contexts := map[int]*api.VictimContext{}
+	var checkpoints *checkpointPolicies
+	for i, op := range s.operations {
+		if op.name != Evict {
+			continue
+		}
+		if checkpoints == nil {
+			checkpoints = newCheckpointPolicies(s.ssn)
+		}
+		aggressor := first
+		for _, next := range s.operations[i+1:] {
+			if (next.name == Pipeline || next.name == Allocate) && next.task.NodeName == op.task.NodeName {
+				aggressor = next.task
+				break
+			}
+		}
+
+		victimContext := &api.VictimContext{SessionID: string(s.ssn.UID), Migration: op.migration}
+		if job, found := s.ssn.Jobs[op.task.Job]; found {
+			victimContext.Checkpoint = checkpoints.policyOf(job.Queue)
+		}
+		if aggressor != nil {
+			victimContext.AggressorJob = aggressor.Job
+			if job, found := s.ssn.Jobs[aggressor.Job]; found {
+				victimContext.AggressorQueue = job.Queue
+				if job.PodGroup != nil {
+					victimContext.AggressorJobUID = job.PodGroup.UID
+				}
+			}
+		}
+		contexts[i] = victimContext
+	}
+	return contexts
+}
+
+// recordReclaims records the reclaimees evicted for the reclaimer in the reclaim statistics of their queues,
+// the reclaimer is nil if no task was pipelined in place of the reclaimees.
+func (s *Statement) recordReclaims(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) {
+	var reclaimerQueue api.QueueID
+	if reclaimer != nil {
+		if job, found := s.ssn.Jobs[reclaimer.Job]; found {
+			reclaimerQueue = job.Queue
+		}
+	}
+	now := time.Now()
+	for _, reclaimee := range reclaimees {
+		if job, found := s.ssn.Jobs[reclaimee.Job]; found {
+			recordReclaim(reclaimerQueue, job.Queue, now)
+		}
+	}
 }
 
 // Merge transfers operations from the given statements into this statement.
@@ -424,13 +681,14 @@ func (s *Statement) Merge(stmts ...*Statement) {
 	for _, stmt := range stmts {
 		s.operations = append(s.operations, stmt.operations...)
 		stmt.operations = nil
+		stmt.savepoints = nil
 	}
 }
 
 func SaveOperations(stmts ...*Statement) *Statement {
 	stmtTmp := &Statement{}
 	for _, stmt := range stmts {
-		stmt.outputOperations("Save operations: ", 4)
+		stmt.outputOperations("Save operations", 4)
 		for _, op := range stmt.operations {
 			task := op.task.Clone()
 			task.EvictionOccurred = op.task.EvictionOccurred
@@ -448,7 +706,7 @@ func (s *Statement) RecoverOperations(stmt *Statement) error {
 	if stmt == nil {
 		return errors.New("statement is nil")
 	}
-	s.outputOperations("Recover operations: ", 4)
+	s.outputOperations("Recover operations", 4)
 	for _, op := range stmt.operations {
 		switch op.name {
 		case Evict:
@@ -476,16 +734,10 @@ func (s *Statement) outputOperations(msg string, level klog.Level) {
 		return
 	}
 
-	var buffer string
-	for _, op := range s.operations {
-		switch op.name {
-		case Evict:
-			buffer += fmt.Sprintf("task %s evict from node %s ", op.task.Name, op.task.NodeName)
-		case Pipeline:
-			buffer += fmt.Sprintf("task %s pipeline from node %s ", op.task.Name, op.task.NodeName)
-		case Allocate:
-			buffer += fmt.Sprintf("task %s allocate from node %s ", op.task.Name, op.task.NodeName)
-		}
+	ops := s.Operations()
+	descs := make([]string, 0, len(ops))
+	for _, op := range ops {
+		descs = append(descs, op.String())
 	}
-	klog.V(level).Info(msg, buffer)
+	s.ssn.Logger().V(int(level)).Info(msg, "count", len(descs), "operations", descs)
 }
@@ -17,13 +17,16 @@ limitations under the License.
 package framework
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
 	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
@@ -394,3 +397,229 @@ func TestDiscardReversesOperations(t *testing.T) {
 		}
 	})
 }
+
+func TestStatementSavepoint(t *testing.T) {
+	t.Run("rollback to savepoint reverts only later operations", func(t *testing.T) {
+		ssn, job, task, node := newTestSession(t)
+		if err := NewStatement(ssn).Allocate(task, node); err != nil {
+			t.Fatalf("setup allocate failed: %v", err)
+		}
+		job.UpdateTaskStatus(task, api.Running)
+		idle := node.FutureIdle()
+
+		stmt := NewStatement(ssn)
+		beforeEvict := stmt.Savepoint("before-evict")
+		stmt.Evict(task, "reclaim")
+		if task.Status != api.Releasing || !ssn.IsVictimClaimed(task) {
+			t.Fatalf("expected task to be Releasing and claimed, got status %v", task.Status)
+		}
+
+		if err := stmt.RollbackTo(beforeEvict); err != nil {
+			t.Fatalf("RollbackTo failed: %v", err)
+		}
+		if len(stmt.operations) != 0 {
+			t.Errorf("expected no operations after rollback, got %d", len(stmt.operations))
+		}
+		if task.Status != api.Running {
+			t.Errorf("expected task status Running after rollback, got %v", task.Status)
+		}
+		if ssn.IsVictimClaimed(task) {
+			t.Errorf("expected victim claim to be released after rollback")
+		}
+		if !node.FutureIdle().Equal(idle, api.Zero) {
+			t.Errorf("expected node future idle <%v> after rollback, got <%v>", idle, node.FutureIdle())
+		}
+
+		// The savepoint is kept after rollback, so it can be rolled back to again.
+		stmt.Evict(task, "reclaim")
+		if err := stmt.RollbackTo(beforeEvict); err != nil {
+			t.Errorf("second RollbackTo failed: %v", err)
+		}
+		if task.Status != api.Running {
+			t.Errorf("expected task status Running after second rollback, got %v", task.Status)
+		}
+	})
+
+	t.Run("operations before savepoint are kept", func(t *testing.T) {
+		ssn, _, task, node := newTestSession(t)
+		stmt := NewStatement(ssn)
+		if err := stmt.Pipeline(task, node.Name, false); err != nil {
+			t.Fatalf("Pipeline failed: %v", err)
+		}
+		afterPipeline := stmt.Savepoint("after-pipeline")
+		if err := stmt.RollbackTo(afterPipeline); err != nil {
+			t.Fatalf("RollbackTo failed: %v", err)
+		}
+		if len(stmt.operations) != 1 || task.Status != api.Pipelined {
+			t.Errorf("expected pipeline operation to be kept, got %d operations and status %v", len(stmt.operations), task.Status)
+		}
+	})
+
+	t.Run("release and unknown savepoints", func(t *testing.T) {
+		ssn, _, _, _ := newTestSession(t)
+		stmt := NewStatement(ssn)
+		a := stmt.Savepoint("a")
+		b := stmt.Savepoint("b")
+		if err := stmt.ReleaseSavepoint(a); err != nil {
+			t.Fatalf("ReleaseSavepoint failed: %v", err)
+		}
+		// Releasing a savepoint also releases the savepoints created after it.
+		if err := stmt.RollbackTo(b); err == nil {
+			t.Errorf("expected error rolling back to released savepoint")
+		}
+		if err := stmt.ReleaseSavepoint(SavepointID{}); err == nil {
+			t.Errorf("expected error releasing unknown savepoint")
+		}
+	})
+
+	t.Run("savepoints sharing a name are distinct", func(t *testing.T) {
+		ssn, job, task, node := newTestSession(t)
+		if err := NewStatement(ssn).Allocate(task, node); err != nil {
+			t.Fatalf("setup allocate failed: %v", err)
+		}
+		job.UpdateTaskStatus(task, api.Running)
+
+		stmt := NewStatement(ssn)
+		outer := stmt.Savepoint("n1")
+		stmt.Evict(task, "reclaim")
+		inner := stmt.Savepoint("n1")
+		if outer == inner {
+			t.Fatalf("expected savepoints sharing a name to have distinct ids, got %v", outer)
+		}
+		// Rolling back to the inner savepoint keeps the eviction made after the outer one.
+		if err := stmt.RollbackTo(inner); err != nil {
+			t.Fatalf("RollbackTo failed: %v", err)
+		}
+		if len(stmt.operations) != 1 || task.Status != api.Releasing {
+			t.Errorf("expected the eviction to be kept, got %d operations and status %v", len(stmt.operations), task.Status)
+		}
+		if err := stmt.RollbackTo(outer); err != nil {
+			t.Fatalf("RollbackTo failed: %v", err)
+		}
+		if len(stmt.operations) != 0 || task.Status != api.Running {
+			t.Errorf("expected the eviction to be reverted, got %d operations and status %v", len(stmt.operations), task.Status)
+		}
+	})
+}
+
+func TestStatementOperations(t *testing.T) {
+	ssn, job, task, node := newTestSession(t)
+	stmt := NewStatement(ssn)
+	if len(stmt.Operations()) != 0 {
+		t.Fatalf("expected no operations in a new statement")
+	}
+
+	if err := stmt.Allocate(task, node); err != nil {
+		t.Fatalf("Allocate failed: %v", err)
+	}
+	job.UpdateTaskStatus(task, api.Running)
+	stmt.Evict(task, "preempt")
+
+	ops := stmt.Operations()
+	if len(ops) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(ops))
+	}
+	if ops[0].Type != Allocate || ops[0].NodeName != node.Name || ops[0].Reason != "" {
+		t.Errorf("unexpected first operation %+v", ops[0])
+	}
+	if ops[1].Type != Evict || ops[1].Task.UID != task.UID || ops[1].Reason != "preempt" {
+		t.Errorf("unexpected second operation %+v", ops[1])
+	}
+	expected := "evict ns1/p1 from node n1 (preempt)"
+	if ops[1].String() != expected {
+		t.Errorf("expected %q, got %q", expected, ops[1].String())
+	}
+	if Operation(Pipeline).String() != "pipeline" {
+		t.Errorf("unexpected name of pipeline operation %s", Operation(Pipeline))
+	}
+}
+
+func TestStatementPostCommitAndPostDiscard(t *testing.T) {
+	ssn, _, task, node := newTestSession(t)
+	ssn.Tiers = []conf.Tier{{Plugins: []conf.PluginOption{{Name: "tracker"}}}}
+	var committed, discarded []string
+	ssn.AddPostCommitFn("tracker", func(ops []StagedOperation) {
+		for _, op := range ops {
+			committed = append(committed, op.String())
+		}
+	})
+	ssn.AddPostDiscardFn("tracker", func(ops []StagedOperation) {
+		for _, op := range ops {
+			discarded = append(discarded, op.String())
+		}
+	})
+
+	stmt := NewStatement(ssn)
+	if err := stmt.Pipeline(task, node.Name, false); err != nil {
+		t.Fatalf("Pipeline failed: %v", err)
+	}
+	stmt.Discard()
+	expected := []string{"pipeline ns1/p1 to node n1"}
+	if !reflect.DeepEqual(discarded, expected) || len(committed) != 0 {
+		t.Errorf("expected discarded %v and nothing committed, got discarded %v and committed %v", expected, discarded, committed)
+	}
+
+	// an empty statement does not call the plugins
+	discarded = nil
+	NewStatement(ssn).Discard()
+	if discarded != nil {
+		t.Errorf("expected no discarded operations for an empty statement, got %v", discarded)
+	}
+
+	stmt = NewStatement(ssn)
+	beforePipeline := stmt.Savepoint("before-pipeline")
+	if err := stmt.Pipeline(task, node.Name, false); err != nil {
+		t.Fatalf("Pipeline failed: %v", err)
+	}
+	if err := stmt.RollbackTo(beforePipeline); err != nil {
+		t.Fatalf("RollbackTo failed: %v", err)
+	}
+	if !reflect.DeepEqual(discarded, expected) {
+		t.Errorf("expected the rolled back operations %v to be discarded, got %v", expected, discarded)
+	}
+
+	if err := stmt.Pipeline(task, node.Name, false); err != nil {
+		t.Fatalf("Pipeline failed: %v", err)
+	}
+	stmt.Commit()
+	if !reflect.DeepEqual(committed, expected) {
+		t.Errorf("expected committed %v, got %v", expected, committed)
+	}
+}
+
+func TestStatementVictimContexts(t *testing.T) {
+	ssn, job, _, _ := newTestSession(t)
+
+	victim := func(name, nodeName string) *api.TaskInfo {
+		return &api.TaskInfo{Name: name, Job: "ns1/victim", TransactionContext: api.TransactionContext{NodeName: nodeName}}
+	}
+	aggressor := &api.TaskInfo{Name: "aggressor", Job: job.UID, TransactionContext: api.TransactionContext{NodeName: "n1"}}
+	other := &api.TaskInfo{Name: "other", Job: "ns1/other", TransactionContext: api.TransactionContext{NodeName: "n3"}}
+
+	stmt := NewStatement(ssn)
+	stmt.operations = []operation{
+		{name: Evict, task: victim("v1", "n1")},
+		{name: Evict, task: victim("v2", "n2")},
+		{name: Pipeline, task: aggressor},
+		{name: Pipeline, task: other},
+		{name: Evict, task: victim("v3", "n4"), migration: &api.Migration{NodeName: "n5", Timeout: time.Minute}},
+	}
+
+	contexts := stmt.victimContexts()
+	if len(contexts) != 3 {
+		t.Fatalf("expected victim contexts of 3 evictions, got %d", len(contexts))
+	}
+	// the victim on n1 is evicted for the task pipelined to n1
+	expected := &api.VictimContext{AggressorJob: job.UID, AggressorJobUID: job.PodGroup.UID, AggressorQueue: "q1", SessionID: string(ssn.UID)}
+	if !reflect.DeepEqual(contexts[0], expected) {
+		t.Errorf("expected victim context %+v, got %+v", expected, contexts[0])
+	}
+	// no task is pipelined to n2, the victim is evicted for the first task pipelined by the statement
+	if !reflect.DeepEqual(contexts[1], expected) {
+		t.Errorf("expected victim context %+v, got %+v", expected, contexts[1])
+	}
+	// the migrated victim carries its migration
+	if migration := contexts[4].Migration; migration == nil || migration.NodeName != "n5" {
+		t.Errorf("expected the victim migrated to n5, got %+v", migration)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sort"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// taskMembers counts the scheduled and running members of every task of the job with a
+// minimum in minTaskMember, sorted by task name. It returns nil when no task has a minimum.
+func taskMembers(job *api.JobInfo) []scheduling.TaskMemberStatus {
+	if len(job.TaskMinAvailable) == 0 {
+		return nil
+	}
+
+	scheduled, running := scheduledTaskRoles(job), map[string]int32{}
+	for _, task := range job.TaskStatusIndex[api.Running] {
+		running[task.TaskRole]++
+	}
+
+	members := make([]scheduling.TaskMemberStatus, 0, len(job.TaskMinAvailable))
+	for name, minMember := range job.TaskMinAvailable {
+		members = append(members, scheduling.TaskMemberStatus{
+			Name:      name,
+			MinMember: minMember,
+			Scheduled: scheduled[name],
+			Running:   running[name],
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+	return members
+}
+
+// scheduledTaskRoles counts the scheduled members of every task of the job.
+func scheduledTaskRoles(job *api.JobInfo) map[string]int32 {
+	scheduled := map[string]int32{}
+	for status, tasks := range job.TaskStatusIndex {
+		if !api.ScheduledStatus(status) {
+			continue
+		}
+		for _, task := range tasks {
+			scheduled[task.TaskRole]++
+		}
+	}
+	return scheduled
+}
+
+// taskMembersScheduled returns whether every task of the job has its minimum in minTaskMember
+// scheduled. As for the gang checks, the minimums are not enforced when minAvailable is less
+// than their sum.
+func taskMembersScheduled(job *api.JobInfo) bool {
+	if job.MinAvailable < job.TaskMinAvailableTotal {
+		return true
+	}
+	scheduled := scheduledTaskRoles(job)
+	for name, minMember := range job.TaskMinAvailable {
+		if scheduled[name] < minMember {
+			return false
+		}
+	}
+	return true
+}
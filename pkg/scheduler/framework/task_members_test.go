@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestTaskMembers(t *testing.T) {
+	newJob := func(minMember int32, taskMinMember map[string]int32, tasks ...*api.TaskInfo) *api.JobInfo {
+		job := api.NewJobInfo("ns/job1")
+		job.PodGroup = &api.PodGroup{}
+		job.PodGroup.Spec.MinMember = minMember
+		job.PodGroup.Status.Phase = scheduling.PodGroupInqueue
+		job.MinAvailable = minMember
+		for name, member := range taskMinMember {
+			job.TaskMinAvailable[name] = member
+			job.TaskMinAvailableTotal += member
+		}
+		for _, task := range tasks {
+			job.AddTaskInfo(task)
+		}
+		return job
+	}
+	newTask := func(name, role string, status api.TaskStatus) *api.TaskInfo {
+		return &api.TaskInfo{
+			UID:      api.TaskID(name),
+			Job:      "ns/job1",
+			Name:     name,
+			TaskRole: role,
+			Resreq:   api.EmptyResource(),
+			TransactionContext: api.TransactionContext{
+				Status: status,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		job           *api.JobInfo
+		expectedPhase scheduling.PodGroupPhase
+		expected      []scheduling.TaskMemberStatus
+	}{
+		{
+			name: "every task meets its minimum",
+			job: newJob(3, map[string]int32{"worker": 2, "ps": 1},
+				newTask("w0", "worker", api.Running),
+				newTask("w1", "worker", api.Bound),
+				newTask("p0", "ps", api.Running)),
+			expectedPhase: scheduling.PodGroupRunning,
+			expected: []scheduling.TaskMemberStatus{
+				{Name: "ps", MinMember: 1, Scheduled: 1, Running: 1},
+				{Name: "worker", MinMember: 2, Scheduled: 2, Running: 1},
+			},
+		},
+		{
+			name: "a task below its minimum keeps the podgroup inqueue",
+			job: newJob(3, map[string]int32{"worker": 2, "ps": 1},
+				newTask("w0", "worker", api.Running),
+				newTask("w1", "worker", api.Running),
+				newTask("w2", "worker", api.Running),
+				newTask("p0", "ps", api.Pending)),
+			expectedPhase: scheduling.PodGroupInqueue,
+			expected: []scheduling.TaskMemberStatus{
+				{Name: "ps", MinMember: 1},
+				{Name: "worker", MinMember: 2, Scheduled: 3, Running: 3},
+			},
+		},
+		{
+			name: "minimums are not enforced when minMember is less than their sum",
+			job: newJob(2, map[string]int32{"worker": 2, "ps": 1},
+				newTask("w0", "worker", api.Running),
+				newTask("w1", "worker", api.Running),
+				newTask("p0", "ps", api.Pending)),
+			expectedPhase: scheduling.PodGroupRunning,
+			expected: []scheduling.TaskMemberStatus{
+				{Name: "ps", MinMember: 1},
+				{Name: "worker", MinMember: 2, Scheduled: 2, Running: 2},
+			},
+		},
+		{
+			name:          "job without task minimums",
+			job:           newJob(1, nil, newTask("w0", "worker", api.Running)),
+			expectedPhase: scheduling.PodGroupRunning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPhase, getPodGroupPhase(tt.job, false))
+			assert.Equal(t, tt.expected, taskMembers(tt.job))
+		})
+	}
+}
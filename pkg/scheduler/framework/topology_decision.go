@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// topologyDecision builds the topology decision recorded in the PodGroup status of a
+// network topology aware job: the lowest HyperNode holding all placed tasks and the
+// node and leaf HyperNode of every task. It returns nil when nothing can be recorded.
+func topologyDecision(ssn *Session, job *api.JobInfo) *scheduling.TopologyDecision {
+	if !ssn.HyperNodesReadyToSchedule || !job.ContainsNetworkTopology() {
+		return nil
+	}
+
+	var placements []scheduling.TaskPlacement
+	nodes := sets.New[string]()
+	leaves := map[string]string{}
+	for status, tasks := range job.TaskStatusIndex {
+		if !api.AllocatedStatus(status) {
+			continue
+		}
+		for _, task := range tasks {
+			if task.NodeName == "" {
+				continue
+			}
+			leaf, found := leaves[task.NodeName]
+			if !found {
+				leaf, _ = ssn.lowestHyperNodeOf(sets.New(task.NodeName))
+				leaves[task.NodeName] = leaf
+			}
+			nodes.Insert(task.NodeName)
+			placements = append(placements, scheduling.TaskPlacement{
+				Name:      task.Name,
+				TaskSpec:  task.TaskRole,
+				NodeName:  task.NodeName,
+				HyperNode: leaf,
+			})
+		}
+	}
+	if len(placements) == 0 {
+		return nil
+	}
+	sort.Slice(placements, func(i, j int) bool {
+		return placements[i].Name < placements[j].Name
+	})
+
+	decision := &scheduling.TopologyDecision{Placements: placements}
+	if hyperNode, tier := ssn.lowestHyperNodeOf(nodes); hyperNode != "" {
+		decision.HyperNode = hyperNode
+		decision.Tier = int32(tier)
+	}
+	return decision
+}
+
+// lowestHyperNodeOf returns the lowest tier real HyperNode containing all the given nodes and its
+// tier, ties within a tier broken by name. The virtual cluster top HyperNode is never returned.
+func (ssn *Session) lowestHyperNodeOf(nodes sets.Set[string]) (string, int) {
+	for _, tier := range ssn.HyperNodesTiers {
+		for _, hyperNode := range sets.List(ssn.HyperNodesSetByTier[tier]) {
+			if hyperNode == ClusterTopHyperNode {
+				continue
+			}
+			if ssn.RealNodesSet[hyperNode].IsSuperset(nodes) {
+				return hyperNode, tier
+			}
+		}
+	}
+	return "", 0
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestTopologyDecision(t *testing.T) {
+	newHyperNode := func(name string, tier int) *api.HyperNodeInfo {
+		hn := &topologyv1alpha1.HyperNode{}
+		hn.Name = name
+		hn.Spec.Tier = tier
+		return api.NewHyperNodeInfo(hn)
+	}
+	newSession := func() *Session {
+		return &Session{
+			HyperNodesReadyToSchedule: true,
+			HyperNodes: api.HyperNodeInfoMap{
+				"s0":                newHyperNode("s0", 1),
+				"s1":                newHyperNode("s1", 1),
+				"s2":                newHyperNode("s2", 2),
+				ClusterTopHyperNode: newHyperNode(ClusterTopHyperNode, 3),
+			},
+			HyperNodesSetByTier: map[int]sets.Set[string]{
+				1: sets.New("s0", "s1"),
+				2: sets.New("s2"),
+				3: sets.New(ClusterTopHyperNode),
+			},
+			HyperNodesTiers: []int{1, 2, 3},
+			RealNodesSet: map[string]sets.Set[string]{
+				"s0":                sets.New("n0", "n1"),
+				"s1":                sets.New("n2", "n3"),
+				"s2":                sets.New("n0", "n1", "n2", "n3"),
+				ClusterTopHyperNode: sets.New("n0", "n1", "n2", "n3", "n4"),
+			},
+		}
+	}
+	newJob := func(topology bool, tasks ...*api.TaskInfo) *api.JobInfo {
+		job := api.NewJobInfo("ns/job1")
+		if topology {
+			job.NetworkTopology = &scheduling.NetworkTopologySpec{Mode: scheduling.HardNetworkTopologyMode}
+		}
+		for _, task := range tasks {
+			job.AddTaskInfo(task)
+		}
+		return job
+	}
+	newTask := func(name, role, node string, status api.TaskStatus) *api.TaskInfo {
+		return &api.TaskInfo{
+			UID:      api.TaskID(name),
+			Job:      "ns/job1",
+			Name:     name,
+			TaskRole: role,
+			Resreq:   api.EmptyResource(),
+			TransactionContext: api.TransactionContext{
+				NodeName: node,
+				Status:   status,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ready    bool
+		job      *api.JobInfo
+		expected *scheduling.TopologyDecision
+	}{
+		{
+			name:  "tasks within one leaf hyperNode",
+			ready: true,
+			job: newJob(true,
+				newTask("p1", "worker", "n1", api.Running),
+				newTask("p0", "master", "n0", api.Allocated),
+				newTask("p2", "worker", "", api.Pending)),
+			expected: &scheduling.TopologyDecision{
+				HyperNode: "s0",
+				Tier:      1,
+				Placements: []scheduling.TaskPlacement{
+					{Name: "p0", TaskSpec: "master", NodeName: "n0", HyperNode: "s0"},
+					{Name: "p1", TaskSpec: "worker", NodeName: "n1", HyperNode: "s0"},
+				},
+			},
+		},
+		{
+			name:  "tasks spread across leaf hyperNodes",
+			ready: true,
+			job: newJob(true,
+				newTask("p0", "worker", "n0", api.Running),
+				newTask("p1", "worker", "n3", api.Binding)),
+			expected: &scheduling.TopologyDecision{
+				HyperNode: "s2",
+				Tier:      2,
+				Placements: []scheduling.TaskPlacement{
+					{Name: "p0", TaskSpec: "worker", NodeName: "n0", HyperNode: "s0"},
+					{Name: "p1", TaskSpec: "worker", NodeName: "n3", HyperNode: "s1"},
+				},
+			},
+		},
+		{
+			name:  "the virtual cluster top hyperNode is not recorded",
+			ready: true,
+			job: newJob(true,
+				newTask("p0", "worker", "n0", api.Running),
+				newTask("p1", "worker", "n4", api.Running)),
+			expected: &scheduling.TopologyDecision{
+				Placements: []scheduling.TaskPlacement{
+					{Name: "p0", TaskSpec: "worker", NodeName: "n0", HyperNode: "s0"},
+					{Name: "p1", TaskSpec: "worker", NodeName: "n4"},
+				},
+			},
+		},
+		{
+			name:  "no placed tasks",
+			ready: true,
+			job:   newJob(true, newTask("p0", "worker", "", api.Pending)),
+		},
+		{
+			name:  "job without network topology",
+			ready: true,
+			job:   newJob(false, newTask("p0", "worker", "n0", api.Running)),
+		},
+		{
+			name:  "hyperNodes not ready",
+			ready: false,
+			job:   newJob(true, newTask("p0", "worker", "n0", api.Running)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := newSession()
+			ssn.HyperNodesReadyToSchedule = tt.ready
+			assert.Equal(t, tt.expected, topologyDecision(ssn, tt.job))
+		})
+	}
+}
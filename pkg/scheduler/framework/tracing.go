@@ -0,0 +1,146 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// tracerName is the instrumentation scope of the spans of the sessions.
+	tracerName = "volcano.sh/volcano/pkg/scheduler/framework"
+
+	// cycleSpanName is the name of the root span of every session.
+	cycleSpanName = "SchedulingCycle"
+
+	// The names of the events of the pod decisions recorded on the spans.
+	DecisionBind     = "Bind"
+	DecisionPipeline = "Pipeline"
+	DecisionEvict    = "Evict"
+)
+
+var (
+	tracerMutex sync.RWMutex
+	tracer      oteltrace.Tracer = noop.NewTracerProvider().Tracer(tracerName)
+)
+
+// SetTracerProvider sets the provider of the spans of the sessions opened afterwards,
+// nil disables the tracing.
+func SetTracerProvider(tp oteltrace.TracerProvider) {
+	if tp == nil {
+		tp = noop.NewTracerProvider()
+	}
+	tracerMutex.Lock()
+	defer tracerMutex.Unlock()
+	tracer = tp.Tracer(tracerName)
+}
+
+func getTracer() oteltrace.Tracer {
+	tracerMutex.RLock()
+	defer tracerMutex.RUnlock()
+	return tracer
+}
+
+// sessionTrace is the trace of one session: the root span of the cycle and the span of the running action.
+type sessionTrace struct {
+	tracer     oteltrace.Tracer
+	ctx        context.Context
+	cycleSpan  oteltrace.Span
+	actionSpan oteltrace.Span
+}
+
+// startCycleSpan starts the root span of the session.
+func startCycleSpan() *sessionTrace {
+	t := &sessionTrace{tracer: getTracer()}
+	t.ctx, t.cycleSpan = t.tracer.Start(context.Background(), cycleSpanName, oteltrace.WithNewRoot())
+	return t
+}
+
+// setCycleAttributes records the snapshot of the session on the root span.
+func (ssn *Session) setCycleAttributes() {
+	if ssn.trace == nil {
+		return
+	}
+	ssn.trace.cycleSpan.SetAttributes(
+		attribute.String("session", string(ssn.UID)),
+		attribute.Int("jobs", len(ssn.Jobs)),
+		attribute.Int("nodes", len(ssn.Nodes)),
+		attribute.Int("queues", len(ssn.Queues)),
+	)
+}
+
+// startSpan starts a child span of the cycle, the returned function ends it.
+func (ssn *Session) startSpan(name string, attributes ...attribute.KeyValue) (oteltrace.Span, func()) {
+	if ssn.trace == nil {
+		return oteltrace.SpanFromContext(context.Background()), func() {}
+	}
+	_, span := ssn.trace.tracer.Start(ssn.trace.ctx, name, oteltrace.WithAttributes(attributes...))
+	return span, func() { span.End() }
+}
+
+// startActionSpan starts the span of the action executed in the session.
+func (ssn *Session) startActionSpan(action string) func() {
+	span, end := ssn.startSpan(action, attribute.String("action", action))
+	if ssn.trace == nil {
+		return end
+	}
+	ssn.trace.actionSpan = span
+	return func() {
+		ssn.trace.actionSpan = nil
+		end()
+	}
+}
+
+// endCycleSpan ends the root span of the session.
+func (ssn *Session) endCycleSpan() {
+	if ssn.trace == nil {
+		return
+	}
+	ssn.trace.cycleSpan.End()
+}
+
+// TraceDecision records the decision about the pod of the task as an event of the span of the running action,
+// or of the cycle out of any action.
+func (ssn *Session) TraceDecision(decision string, task *api.TaskInfo, attributes ...attribute.KeyValue) {
+	if ssn.trace == nil {
+		return
+	}
+	span := ssn.trace.actionSpan
+	if span == nil {
+		span = ssn.trace.cycleSpan
+	}
+	if !span.IsRecording() {
+		return
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("pod", task.Namespace+"/"+task.Name),
+		attribute.String("node", task.NodeName),
+		attribute.String("job", string(task.Job)),
+	}
+	if job, found := ssn.Jobs[task.Job]; found {
+		attrs = append(attrs, attribute.String("queue", string(job.Queue)))
+	}
+	span.AddEvent(decision, oteltrace.WithAttributes(append(attrs, attributes...)...))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+)
+
+// decisionAction records a bind decision in the session.
+type decisionAction struct{}
+
+func (da *decisionAction) Name() string  { return "tracing-test" }
+func (da *decisionAction) Initialize()   {}
+func (da *decisionAction) UnInitialize() {}
+func (da *decisionAction) Execute(ssn *Session) {
+	ssn.TraceDecision(DecisionBind, &api.TaskInfo{Namespace: "ns1", Name: "p1", Job: "ns1/pg1", TransactionContext: api.TransactionContext{NodeName: "n1"}})
+}
+
+func TestSessionTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer SetTracerProvider(nil)
+
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, nil)
+	ssn.ExecuteAction(&decisionAction{})
+	// decisions out of any action are recorded on the cycle
+	ssn.TraceDecision(DecisionEvict, &api.TaskInfo{Namespace: "ns1", Name: "p2", TransactionContext: api.TransactionContext{NodeName: "n2"}})
+	CloseSession(ssn)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	cycle, found := spans[cycleSpanName]
+	if !found {
+		t.Fatalf("expected the span %s, got %v", cycleSpanName, spans)
+	}
+	if cycle.Parent().IsValid() {
+		t.Errorf("expected the cycle span to be a root span")
+	}
+	if len(cycle.Events()) != 1 || cycle.Events()[0].Name != DecisionEvict {
+		t.Errorf("expected one %s event on the cycle span, got %v", DecisionEvict, cycle.Events())
+	}
+
+	for _, name := range []string{"OpenSession", "tracing-test", "CloseSession"} {
+		span, found := spans[name]
+		if !found {
+			t.Fatalf("expected the span %s, got %v", name, spans)
+		}
+		if span.Parent().SpanID() != cycle.SpanContext().SpanID() {
+			t.Errorf("expected the span %s to be a child of the cycle span", name)
+		}
+	}
+
+	events := spans["tracing-test"].Events()
+	if len(events) != 1 || events[0].Name != DecisionBind {
+		t.Fatalf("expected one %s event on the action span, got %v", DecisionBind, events)
+	}
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[string(attr.Key)] = attr.Value.AsString()
+	}
+	if attrs["pod"] != "ns1/p1" || attrs["node"] != "n1" {
+		t.Errorf("unexpected attributes of the %s event: %v", DecisionBind, attrs)
+	}
+}
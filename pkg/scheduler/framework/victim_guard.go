@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// victimGuardedOnNode returns the capacity freed on the node by evicting victims which is held for the other jobs
+// than the given one, i.e. not used by the tasks of the jobs the victims were evicted for yet.
+func (ssn *Session) victimGuardedOnNode(jobID api.JobID, node *api.NodeInfo, now time.Time) (*api.Resource, []string) {
+	held := map[api.JobID]*api.Resource{}
+	for _, guard := range node.VictimGuards {
+		if guard.Beneficiary == jobID || !now.Before(guard.Expiry.Time) {
+			continue
+		}
+		if held[guard.Beneficiary] == nil {
+			held[guard.Beneficiary] = api.EmptyResource()
+		}
+		held[guard.Beneficiary].Add(guard.Resreq)
+	}
+	if len(held) == 0 {
+		return api.EmptyResource(), nil
+	}
+
+	used := map[api.JobID]*api.Resource{}
+	for _, task := range node.Tasks {
+		if _, found := held[task.Job]; !found {
+			continue
+		}
+		if !api.AllocatedStatus(task.Status) && task.Status != api.Pipelined {
+			continue
+		}
+		if used[task.Job] == nil {
+			used[task.Job] = api.EmptyResource()
+		}
+		used[task.Job].Add(task.Resreq)
+	}
+
+	guarded := api.EmptyResource()
+	var beneficiaries []string
+	for job, resreq := range held {
+		if used[job] != nil {
+			resreq = api.ExceededPart(resreq, used[job])
+		}
+		if resreq.IsEmpty() {
+			continue
+		}
+		guarded.Add(resreq)
+		beneficiaries = append(beneficiaries, string(job))
+	}
+	sort.Strings(beneficiaries)
+	return guarded, beneficiaries
+}
+
+// victimGuardPredicate checks whether the task fits the node without the capacity freed by evicting victims
+// for other jobs, so that the capacity is not taken back, e.g. by the recreated pods of the victims.
+func (ssn *Session) victimGuardPredicate(task *api.TaskInfo, node *api.NodeInfo) error {
+	if len(node.VictimGuards) == 0 {
+		return nil
+	}
+	guarded, beneficiaries := ssn.victimGuardedOnNode(task.Job, node, time.Now())
+	if len(beneficiaries) == 0 {
+		return nil
+	}
+	if task.InitResreq.LessEqual(api.ExceededPart(node.FutureIdle(), guarded), api.Zero) {
+		return nil
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{
+		Code:   api.Unschedulable,
+		Reason: fmt.Sprintf("node capacity freed by evicting victims is held for %v", beneficiaries),
+	})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestVictimGuardPredicate(t *testing.T) {
+	ssn := OpenSession(cache.NewDefaultMockSchedulerCache("test-scheduler"), nil, nil)
+	defer CloseSession(ssn)
+
+	node := api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("3", "3Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+	running := util.BuildPod("c1", "running", "n1", v1.PodRunning, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil)
+	victim := buildReservationJob("victim", "q1", "", running,
+		util.BuildPod("c1", "recreated", "", v1.PodPending, api.BuildResourceList("2", "2Gi"), "pg1", nil, nil))
+	beneficiary := buildReservationJob("beneficiary", "q2", "",
+		util.BuildPod("c1", "starving-1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil),
+		util.BuildPod("c1", "starving-2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil))
+	// the victim evicted for the beneficiary is releasing
+	victim.Tasks[api.TaskID(running.UID)].Status = api.Releasing
+	if err := node.AddTask(victim.Tasks[api.TaskID(running.UID)]); err != nil {
+		t.Fatal(err)
+	}
+	node.VictimGuards = []*api.VictimGuard{{
+		Beneficiary: beneficiary.UID,
+		Resreq:      api.NewResource(api.BuildResourceList("2", "2Gi")),
+		Expiry:      metav1.NewTime(time.Now().Add(time.Minute)),
+	}}
+
+	taskOf := func(job *api.JobInfo, name string) *api.TaskInfo {
+		for _, task := range job.Tasks {
+			if task.Name == name {
+				return task
+			}
+		}
+		t.Fatalf("task %s not found", name)
+		return nil
+	}
+	recreated := taskOf(victim, "recreated")
+	if err := ssn.victimGuardPredicate(recreated, node); err == nil {
+		t.Errorf("expected the recreated victim not to take the guarded capacity")
+	}
+	starving := taskOf(beneficiary, "starving-1")
+	if err := ssn.victimGuardPredicate(starving, node); err != nil {
+		t.Errorf("expected the beneficiary to use the guarded capacity, got %v", err)
+	}
+
+	// the capacity which is not guarded can be used
+	small := taskOf(victim, "recreated").Clone()
+	small.InitResreq = api.NewResource(api.BuildResourceList("1", "1Gi"))
+	if err := ssn.victimGuardPredicate(small, node); err != nil {
+		t.Errorf("expected the capacity not guarded to be used, got %v", err)
+	}
+
+	// the capacity used by the beneficiary is not guarded any more
+	starving.NodeName, starving.Status = "n1", api.Allocated
+	if err := node.AddTask(starving); err != nil {
+		t.Fatal(err)
+	}
+	if err := ssn.victimGuardPredicate(small, node); err != nil {
+		t.Errorf("expected the capacity not guarded any more to be used, got %v", err)
+	}
+	if err := ssn.victimGuardPredicate(recreated, node); err == nil {
+		t.Errorf("expected the recreated victim not to take the capacity still guarded")
+	}
+
+	// the expired guards do not hold capacity
+	node.VictimGuards[0].Expiry = metav1.NewTime(time.Now().Add(-time.Second))
+	if err := ssn.victimGuardPredicate(recreated, node); err != nil {
+		t.Errorf("expected the expired guard not to hold capacity, got %v", err)
+	}
+}
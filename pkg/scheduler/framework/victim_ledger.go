@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// VictimClaim records a victim whose resources have been claimed by an action in the session.
+type VictimClaim struct {
+	Victim   *api.TaskInfo
+	NodeName string
+	Reason   string
+	Resreq   *api.Resource
+}
+
+// VictimLedger records the victims claimed by all actions in one session. Preempt and reclaim
+// select victims independently, so without a shared ledger the same victim could be selected
+// by both actions and its freed capacity would be counted twice.
+// A nil VictimLedger is valid and records nothing.
+type VictimLedger struct {
+	claims map[api.TaskID]*VictimClaim
+}
+
+// NewVictimLedger returns an empty victim ledger.
+func NewVictimLedger() *VictimLedger {
+	return &VictimLedger{
+		claims: map[api.TaskID]*VictimClaim{},
+	}
+}
+
+// Claim marks the resources of victim as claimed, it returns false if the victim has already been claimed.
+func (vl *VictimLedger) Claim(victim *api.TaskInfo, reason string) bool {
+	if vl == nil {
+		return true
+	}
+	if claim, found := vl.claims[victim.UID]; found {
+		klog.V(4).Infof("Victim <%s/%s> on node <%s> has already been claimed by <%s>",
+			victim.Namespace, victim.Name, claim.NodeName, claim.Reason)
+		return false
+	}
+	vl.claims[victim.UID] = &VictimClaim{
+		Victim:   victim,
+		NodeName: victim.NodeName,
+		Reason:   reason,
+		Resreq:   victim.Resreq.Clone(),
+	}
+	return true
+}
+
+// Release removes the claim of victim, e.g. when the eviction is discarded or failed.
+func (vl *VictimLedger) Release(victim *api.TaskInfo) {
+	if vl == nil {
+		return
+	}
+	delete(vl.claims, victim.UID)
+}
+
+// IsClaimed returns whether the resources of the task have been claimed by an action.
+func (vl *VictimLedger) IsClaimed(taskID api.TaskID) bool {
+	if vl == nil {
+		return false
+	}
+	_, found := vl.claims[taskID]
+	return found
+}
+
+// GetClaim returns the claim of the task, or nil if the task has not been claimed.
+func (vl *VictimLedger) GetClaim(taskID api.TaskID) *VictimClaim {
+	if vl == nil {
+		return nil
+	}
+	return vl.claims[taskID]
+}
+
+// Len returns the number of claimed victims.
+func (vl *VictimLedger) Len() int {
+	if vl == nil {
+		return 0
+	}
+	return len(vl.claims)
+}
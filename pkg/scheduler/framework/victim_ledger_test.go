@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestVictimLedger(t *testing.T) {
+	t.Run("nil ledger records nothing", func(t *testing.T) {
+		var vl *VictimLedger
+		task := &api.TaskInfo{UID: "t1", Resreq: api.EmptyResource()}
+		if !vl.Claim(task, "reclaim") {
+			t.Errorf("expected nil ledger to accept claim")
+		}
+		if vl.IsClaimed(task.UID) {
+			t.Errorf("expected nil ledger to report task as not claimed")
+		}
+		vl.Release(task)
+		if vl.Len() != 0 {
+			t.Errorf("expected empty nil ledger, got %d claims", vl.Len())
+		}
+	})
+
+	t.Run("victim can only be claimed once", func(t *testing.T) {
+		vl := NewVictimLedger()
+		task := &api.TaskInfo{UID: "t1", Resreq: &api.Resource{MilliCPU: 1000}}
+		task.NodeName = "n1"
+		if !vl.Claim(task, "preempt") {
+			t.Fatalf("expected first claim to succeed")
+		}
+		if vl.Claim(task, "reclaim") {
+			t.Errorf("expected second claim to fail")
+		}
+		claim := vl.GetClaim(task.UID)
+		if claim == nil || claim.Reason != "preempt" || claim.NodeName != "n1" {
+			t.Errorf("unexpected claim: %v", claim)
+		}
+		vl.Release(task)
+		if vl.IsClaimed(task.UID) {
+			t.Errorf("expected task not claimed after release")
+		}
+		if !vl.Claim(task, "reclaim") {
+			t.Errorf("expected claim to succeed after release")
+		}
+	})
+}
+
+func TestStatementEvictClaimsVictim(t *testing.T) {
+	ssn, job, task, node := newTestSession(t)
+	if err := NewStatement(ssn).Allocate(task, node); err != nil {
+		t.Fatalf("setup allocate failed: %v", err)
+	}
+	job.UpdateTaskStatus(task, api.Running)
+
+	preemptStmt := NewStatement(ssn)
+	preemptStmt.Evict(task, "preempt")
+	if !ssn.IsVictimClaimed(task) {
+		t.Fatalf("expected task to be claimed after Evict")
+	}
+	releasing := node.Releasing.Clone()
+
+	// A second action trying to claim the same victim must not count it again.
+	reclaimStmt := NewStatement(ssn)
+	reclaimStmt.Evict(task, "reclaim")
+	if len(reclaimStmt.operations) != 0 {
+		t.Errorf("expected no operation for an already claimed victim, got %d", len(reclaimStmt.operations))
+	}
+	if !node.Releasing.Equal(releasing, api.Zero) {
+		t.Errorf("expected node releasing to stay <%v>, got <%v>", releasing, node.Releasing)
+	}
+
+	preemptStmt.Discard()
+	if ssn.IsVictimClaimed(task) {
+		t.Errorf("expected claim to be released after Discard")
+	}
+}
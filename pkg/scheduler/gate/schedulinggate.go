@@ -22,6 +22,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
 )
@@ -52,6 +53,7 @@ type SchGateManager struct {
 type gateRemovalOp struct {
 	namespace string
 	name      string
+	gate      string
 }
 
 // NewSchGateManager creates a new gate manager with the given client and worker count.
@@ -98,10 +100,10 @@ func (m *SchGateManager) worker() {
 			klog.V(4).Infof("Scheduling gate operation worker shutting down")
 			return
 		case op := <-m.opCh:
-			if err := cache.RemoveVolcanoSchGate(m.kubeClient, op.namespace, op.name); err != nil {
-				klog.Errorf("Failed to remove gate from %s/%s: %v", op.namespace, op.name, err)
+			if err := cache.RemoveSchGate(m.kubeClient, op.namespace, op.name, op.gate); err != nil {
+				klog.Errorf("Failed to remove gate %s from %s/%s: %v", op.gate, op.namespace, op.name, err)
 			} else {
-				klog.V(3).Infof("Removed Volcano scheduling gate from pod %s/%s", op.namespace, op.name)
+				klog.V(3).Infof("Removed Volcano scheduling gate %s from pod %s/%s", op.gate, op.namespace, op.name)
 			}
 		}
 	}
@@ -114,9 +116,24 @@ func (m *SchGateManager) Enqueue(task *api.TaskInfo) bool {
 	if !api.HasOnlyVolcanoSchedulingGate(task.Pod) {
 		return false
 	}
+	return m.enqueue(task, schedulingv1beta1.QueueAllocationGateKey)
+}
+
+// EnqueueGangAdmission queues an async removal of the gang admission gate for the given task.
+// Returns true if the operation was enqueued, false if the channel is full or the task
+// does not have only the gang admission gate.
+func (m *SchGateManager) EnqueueGangAdmission(task *api.TaskInfo) bool {
+	if !api.HasOnlyGangAdmissionGate(task.Pod) {
+		return false
+	}
+	return m.enqueue(task, schedulingv1beta1.GangAdmissionGateKey)
+}
+
+func (m *SchGateManager) enqueue(task *api.TaskInfo, gate string) bool {
 	op := gateRemovalOp{
 		namespace: task.Namespace,
 		name:      task.Name,
+		gate:      gate,
 	}
 	select {
 	case m.opCh <- op:
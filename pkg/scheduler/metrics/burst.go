@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+)
+
+var (
+	burstSessionLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "burst_session_latency_milliseconds",
+			Help:      "Duration of a single burst session triggered by the pending pods of burst queues, in milliseconds",
+			Buckets:   prometheus.ExponentialBucketsRange(1, 5000, 20),
+		},
+	)
+
+	burstLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "burst_pipeline_latency_milliseconds",
+			Help:      "Latency from pod creation to pipeline of the tasks of burst queues, in milliseconds",
+			Buckets:   prometheus.ExponentialBucketsRange(10, 60000, 20),
+		}, []string{"queue_name"},
+	)
+
+	burstSLOViolations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "burst_slo_violations_total",
+			Help:      "Number of tasks of burst queues pipelined later than the latency SLO",
+		}, []string{"queue_name"},
+	)
+)
+
+// UpdateBurstSessionDuration updates the duration of a burst session.
+func UpdateBurstSessionDuration(duration time.Duration) {
+	burstSessionLatency.Observe(DurationInMilliseconds(duration))
+}
+
+// UpdateBurstLatency records the latency from pod creation to pipeline of a task of a burst queue.
+func UpdateBurstLatency(queueName string, latency time.Duration) {
+	burstLatency.WithLabelValues(queueName).Observe(DurationInMilliseconds(latency))
+}
+
+// RegisterBurstSLOViolation records a task of a burst queue pipelined later than the latency SLO.
+func RegisterBurstSLOViolation(queueName string) {
+	burstSLOViolations.WithLabelValues(queueName).Inc()
+}
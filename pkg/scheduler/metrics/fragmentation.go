@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+)
+
+var (
+	nodeFragmentationScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "node_fragmentation_score",
+			Help:      "Share of the free resource of one node which does not make up a whole slot",
+		}, []string{"node_name", "resource"},
+	)
+
+	clusterFragmentationScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "cluster_fragmentation_score",
+			Help:      "Share of the free resource of the cluster which does not make up a whole slot",
+		}, []string{"resource"},
+	)
+
+	clusterFreeSlots = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "cluster_free_slots",
+			Help:      "Number of whole free slots of the resource in the cluster",
+		}, []string{"resource"},
+	)
+)
+
+// UpdateNodeFragmentation records the fragmentation score of the resource of one node.
+func UpdateNodeFragmentation(nodeName, resource string, score float64) {
+	nodeFragmentationScore.WithLabelValues(nodeName, resource).Set(score)
+}
+
+// UpdateClusterFragmentation records the fragmentation score and the number of whole free slots of the resource
+// of the cluster.
+func UpdateClusterFragmentation(resource string, score float64, slots int) {
+	clusterFragmentationScore.WithLabelValues(resource).Set(score)
+	clusterFreeSlots.WithLabelValues(resource).Set(float64(slots))
+}
+
+// ResetNodeFragmentation deletes the fragmentation scores of all nodes, so the nodes which are gone are not reported.
+func ResetNodeFragmentation() {
+	nodeFragmentationScore.Reset()
+}
@@ -37,6 +37,62 @@ var (
 			Help:      "Number of retry counts for one job",
 		}, []string{"job_id"},
 	)
+
+	jobDeadlineMisses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_deadline_misses_total",
+			Help:      "The number of jobs of one queue which can no longer complete before their deadline",
+		}, []string{"queue_name"},
+	)
+
+	starvingJobs = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "starving_jobs",
+			Help:      "The number of jobs of one queue waiting for resources longer than the starvation threshold",
+		}, []string{"queue_name"},
+	)
+
+	longestPendingDuration = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_longest_pending_seconds",
+			Help:      "The longest time in seconds a job of one queue is waiting for resources",
+		}, []string{"queue_name"},
+	)
+
+	jobStarvations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_starvations_total",
+			Help:      "The number of times the jobs of one queue became starving",
+		}, []string{"queue_name"},
+	)
+
+	jobSchedulingEfficiency = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_scheduling_efficiency",
+			Help:      "Share of the runtime of the tasks of one job which is not lost by failed or evicted tasks",
+		}, []string{"job_ns", "job_id"},
+	)
+
+	jobChurns = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_churns_total",
+			Help:      "The number of tasks of the jobs of one queue which failed or were evicted after they were allocated",
+		}, []string{"queue_name"},
+	)
+
+	churnDecayedJobs = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "churn_decayed_jobs",
+			Help:      "The number of jobs of one queue whose priority is decayed for churning",
+		}, []string{"queue_name"},
+	)
 )
 
 // UpdateJobShare records share for one job
@@ -49,6 +105,47 @@ func RegisterJobRetries(jobID string) {
 	jobRetryCount.WithLabelValues(jobID).Inc()
 }
 
+// RegisterJobDeadlineMiss records one job of the queue which can no longer complete before its deadline.
+func RegisterJobDeadlineMiss(queueName string) {
+	jobDeadlineMisses.WithLabelValues(queueName).Inc()
+}
+
+// UpdateQueueStarvation records the number of starving jobs of the queue and the longest time one of its jobs is pending.
+func UpdateQueueStarvation(queueName string, starving int, longestPending float64) {
+	starvingJobs.WithLabelValues(queueName).Set(float64(starving))
+	longestPendingDuration.WithLabelValues(queueName).Set(longestPending)
+}
+
+// RegisterJobStarvation records one job of the queue becoming starving.
+func RegisterJobStarvation(queueName string) {
+	jobStarvations.WithLabelValues(queueName).Inc()
+}
+
+// DeleteQueueStarvation deletes the starvation gauges of the queue without pending jobs.
+func DeleteQueueStarvation(queueName string) {
+	starvingJobs.DeleteLabelValues(queueName)
+	longestPendingDuration.DeleteLabelValues(queueName)
+}
+
+// UpdateJobSchedulingEfficiency records the scheduling efficiency of one job.
+func UpdateJobSchedulingEfficiency(jobNs, jobID string, efficiency float64) {
+	jobSchedulingEfficiency.WithLabelValues(jobNs, jobID).Set(efficiency)
+}
+
+// RegisterJobChurns records the tasks of a job of the queue which failed or were evicted after they were allocated.
+func RegisterJobChurns(queueName string, churns int) {
+	jobChurns.WithLabelValues(queueName).Add(float64(churns))
+}
+
+// UpdateChurnDecayedJobs records the number of jobs of each queue whose priority is decayed, the queues without
+// such jobs are not reported.
+func UpdateChurnDecayedJobs(decayed map[string]int) {
+	churnDecayedJobs.Reset()
+	for queueName, count := range decayed {
+		churnDecayedJobs.WithLabelValues(queueName).Set(float64(count))
+	}
+}
+
 // DeleteJobMetrics delete all metrics related to the job
 func DeleteJobMetrics(jobName, queue, namespace string) {
 	e2eJobSchedulingDuration.DeleteLabelValues(jobName, queue, namespace)
@@ -57,4 +154,5 @@ func DeleteJobMetrics(jobName, queue, namespace string) {
 	unscheduleTaskCount.DeleteLabelValues(jobName)
 	jobShare.DeleteLabelValues(namespace, jobName)
 	jobRetryCount.DeleteLabelValues(jobName)
+	jobSchedulingEfficiency.DeleteLabelValues(namespace, jobName)
 }
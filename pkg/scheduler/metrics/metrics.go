@@ -112,6 +112,71 @@ var (
 		}, []string{"plugin", "OnSession"},
 	)
 
+	pluginFnLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "plugin_fn_latency_microseconds",
+			Help:      "Execution time of plugin functions in microseconds, by plugin, extension point and action",
+			Buckets:   prometheus.ExponentialBucketsRange(1, 100000, 20),
+		}, []string{"plugin", "extension_point", "action"},
+	)
+
+	pluginPanics = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "plugin_panics_total",
+			Help:      "Number of panics recovered from plugin functions, by plugin and extension point",
+		}, []string{"plugin", "extension_point"},
+	)
+
+	pluginDisabled = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "plugin_disabled",
+			Help:      "Whether the plugin has been disabled in the current session for panicking repeatedly, 1 if disabled",
+		}, []string{"plugin"},
+	)
+
+	configReloads = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "config_reloads_total",
+			Help:      "Number of loads of the scheduler configuration, by the result. 'rollback' means the configuration failed to load and the last good configuration was kept.",
+		}, []string{"result"},
+	)
+
+	configGeneration = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "config_generation",
+			Help:      "Generation of the scheduler configuration applied, incremented every time a new configuration is applied",
+		},
+	)
+
+	nodeOperationFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "node_operation_failures_total",
+			Help:      "Number of binds and evictions failed on the nodes, by operation",
+		}, []string{"operation"},
+	)
+
+	nodeQuarantines = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "node_quarantines_total",
+			Help:      "Number of times nodes were quarantined from placement for failing binds or evictions repeatedly",
+		},
+	)
+
+	nodeQuarantined = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "node_quarantined",
+			Help:      "Whether the node is quarantined from placement for failing binds or evictions repeatedly, 1 if quarantined",
+		}, []string{"node_name"},
+	)
+
 	actionSchedulingLatency = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Subsystem: VolcanoSubSystemName,
@@ -198,6 +263,50 @@ func UpdatePluginDuration(pluginName, onSessionStatus string, duration time.Dura
 	pluginSchedulingLatency.WithLabelValues(pluginName, onSessionStatus).Observe(DurationInMilliseconds(duration))
 }
 
+// UpdatePluginFnDuration updates the execution time of a plugin function
+func UpdatePluginFnDuration(pluginName, extensionPoint, action string, duration time.Duration) {
+	pluginFnLatency.WithLabelValues(pluginName, extensionPoint, action).Observe(DurationInMicroseconds(duration))
+}
+
+// RegisterPluginPanic records a panic recovered from a plugin function
+func RegisterPluginPanic(pluginName, extensionPoint string) {
+	pluginPanics.WithLabelValues(pluginName, extensionPoint).Inc()
+}
+
+// UpdatePluginDisabled updates whether the plugin is disabled in the current session
+func UpdatePluginDisabled(pluginName string, disabled bool) {
+	value := float64(0)
+	if disabled {
+		value = 1
+	}
+	pluginDisabled.WithLabelValues(pluginName).Set(value)
+}
+
+// RegisterConfigReload records a load of the scheduler configuration with the result, 'applied', 'unchanged' or 'rollback'
+func RegisterConfigReload(result string) {
+	configReloads.WithLabelValues(result).Inc()
+}
+
+// UpdateConfigGeneration updates the generation of the scheduler configuration applied
+func UpdateConfigGeneration(generation int64) {
+	configGeneration.Set(float64(generation))
+}
+
+// RegisterNodeOperationFailure records a bind or an eviction failed on a node
+func RegisterNodeOperationFailure(operation string) {
+	nodeOperationFailures.WithLabelValues(operation).Inc()
+}
+
+// UpdateNodeQuarantined updates whether the node is quarantined from placement
+func UpdateNodeQuarantined(nodeName string, quarantined bool) {
+	if quarantined {
+		nodeQuarantines.Inc()
+		nodeQuarantined.WithLabelValues(nodeName).Set(1)
+		return
+	}
+	nodeQuarantined.DeleteLabelValues(nodeName)
+}
+
 // UpdateActionDuration updates latency for every action
 func UpdateActionDuration(actionName string, duration time.Duration) {
 	actionSchedulingLatency.WithLabelValues(actionName).Observe(DurationInMilliseconds(duration))
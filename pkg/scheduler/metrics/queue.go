@@ -121,6 +121,78 @@ var (
 		}, []string{"queue_name"},
 	)
 
+	queueRealizedShare = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_realized_share",
+			Help:      "Average dominant share of cluster resources allocated to one queue over the fairness audit window",
+		}, []string{"queue_name"},
+	)
+
+	queueEntitledShare = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_entitled_share",
+			Help:      "Average dominant share of cluster resources one queue is entitled to over the fairness audit window",
+		}, []string{"queue_name"},
+	)
+
+	queueFairShareViolated = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_fair_share_violated",
+			Help:      "If the realized share of one queue is below its entitled share over the fairness audit window",
+		}, []string{"queue_name"},
+	)
+
+	queueFairShareViolations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_fair_share_violations_total",
+			Help:      "The number of times one queue started to violate its entitled share",
+		}, []string{"queue_name"},
+	)
+
+	queueBurstCredits = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_burst_credits",
+			Help:      "Burst credits of one queue, in seconds of its whole deserved resources",
+		}, []string{"queue_name"},
+	)
+
+	queueBurstTokens = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_burst_tokens",
+			Help:      "Tokens left in the burst bucket of one queue, in resource-seconds above its deserved resources",
+		}, []string{"queue_name", "resource"},
+	)
+
+	queueCostSpent = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_cost_spent",
+			Help:      "Cost spent by the jobs of one queue in the current month",
+		}, []string{"queue_name"},
+	)
+
+	queueCostBudget = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_cost_budget",
+			Help:      "Monthly cost budget of one queue",
+		}, []string{"queue_name"},
+	)
+
+	queueOverBudget = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_over_budget",
+			Help:      "If one queue has exceeded its monthly cost budget, one means exceeded and zero means not",
+		}, []string{"queue_name"},
+	)
+
 	queueCapacityMilliCPU = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: VolcanoSubSystemName,
@@ -262,6 +334,50 @@ func UpdateQueueOverused(queueName string, overused bool) {
 	queueOverused.WithLabelValues(queueName).Set(value)
 }
 
+// UpdateQueueFairShare records the realized and entitled share for one queue
+func UpdateQueueFairShare(queueName string, realized, entitled float64) {
+	queueRealizedShare.WithLabelValues(queueName).Set(realized)
+	queueEntitledShare.WithLabelValues(queueName).Set(entitled)
+}
+
+// UpdateQueueFairShareViolated records if one queue violates its entitled share
+func UpdateQueueFairShareViolated(queueName string, violated bool) {
+	var value float64
+	if violated {
+		value = 1
+	} else {
+		value = 0
+	}
+	queueFairShareViolated.WithLabelValues(queueName).Set(value)
+}
+
+// RegisterQueueFairShareViolation records one queue starting to violate its entitled share
+func RegisterQueueFairShareViolation(queueName string) {
+	queueFairShareViolations.WithLabelValues(queueName).Inc()
+}
+
+// UpdateQueueBurstCredits records the burst credits of one queue
+func UpdateQueueBurstCredits(queueName string, credits float64) {
+	queueBurstCredits.WithLabelValues(queueName).Set(credits)
+}
+
+// UpdateQueueBurstTokens records the tokens left in the burst bucket of one queue for one resource
+func UpdateQueueBurstTokens(queueName, resource string, tokens float64) {
+	queueBurstTokens.WithLabelValues(queueName, resource).Set(tokens)
+}
+
+// UpdateQueueCost records the cost spent in the current month and the monthly budget of one queue, a queue without
+// budget has a budget of zero and is never over budget
+func UpdateQueueCost(queueName string, spent, budget float64, overBudget bool) {
+	queueCostSpent.WithLabelValues(queueName).Set(spent)
+	queueCostBudget.WithLabelValues(queueName).Set(budget)
+	var value float64
+	if overBudget {
+		value = 1
+	}
+	queueOverBudget.WithLabelValues(queueName).Set(value)
+}
+
 // UpdateQueueCapacity records capacity resources for one queue
 func UpdateQueueCapacity(queueName string, milliCPU, memory float64, scalarResources map[v1.ResourceName]float64) {
 	queueCapacityMilliCPU.WithLabelValues(queueName).Set(milliCPU)
@@ -294,6 +410,14 @@ func DeleteQueueMetrics(queueName string) {
 	queueShare.DeleteLabelValues(queueName)
 	queueWeight.DeleteLabelValues(queueName)
 	queueOverused.DeleteLabelValues(queueName)
+	queueRealizedShare.DeleteLabelValues(queueName)
+	queueEntitledShare.DeleteLabelValues(queueName)
+	queueFairShareViolated.DeleteLabelValues(queueName)
+	queueFairShareViolations.DeleteLabelValues(queueName)
+	queueBurstCredits.DeleteLabelValues(queueName)
+	queueCostSpent.DeleteLabelValues(queueName)
+	queueCostBudget.DeleteLabelValues(queueName)
+	queueOverBudget.DeleteLabelValues(queueName)
 	queueCapacityMilliCPU.DeleteLabelValues(queueName)
 	queueCapacityMemory.DeleteLabelValues(queueName)
 	queueRealCapacityMilliCPU.DeleteLabelValues(queueName)
@@ -301,6 +425,7 @@ func DeleteQueueMetrics(queueName string) {
 	queueInqueueMilliCPU.DeleteLabelValues(queueName)
 	queueInqueueMemory.DeleteLabelValues(queueName)
 	partialLabelMap := map[string]string{"queue_name": queueName}
+	queueBurstTokens.DeletePartialMatch(partialLabelMap)
 	queueAllocatedScalarResource.DeletePartialMatch(partialLabelMap)
 	queueRequestScalarResource.DeletePartialMatch(partialLabelMap)
 	queueDeservedScalarResource.DeletePartialMatch(partialLabelMap)
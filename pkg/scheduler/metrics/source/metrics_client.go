@@ -30,12 +30,15 @@ const (
 	Metrics_Type_Prometheus_Adaptor = "prometheus_adaptor"
 	Metrics_Tpye_Prometheus         = "prometheus"
 	Metrics_Type_Elasticsearch      = "elasticsearch"
+	Metrics_Type_Metrics_Server     = "metrics_server"
 )
 
 type NodeMetrics struct {
 	MetricsTime time.Time
 	CPU         float64
 	Memory      float64
+	// GPU is the average utilization of the GPUs of the node, left 0 by the sources not collecting it.
+	GPU float64
 }
 
 type MetricsClient interface {
@@ -51,9 +54,11 @@ func NewMetricsClient(restConfig *rest.Config, metricsConf map[string]string) (M
 		return NewPrometheusMetricsClient(metricsConf)
 	} else if metricsType == Metrics_Type_Prometheus_Adaptor {
 		return NewCustomMetricsClient(restConfig)
+	} else if metricsType == Metrics_Type_Metrics_Server {
+		return NewMetricsServerClient(restConfig)
 	} else {
 		return nil, fmt.Errorf("data cannot be collected from the %s monitoring system. "+
-			"The supported monitoring systems are %s, %s, and %s",
-			metricsType, Metrics_Type_Elasticsearch, Metrics_Tpye_Prometheus, Metrics_Type_Prometheus_Adaptor)
+			"The supported monitoring systems are %s, %s, %s, and %s",
+			metricsType, Metrics_Type_Elasticsearch, Metrics_Tpye_Prometheus, Metrics_Type_Prometheus_Adaptor, Metrics_Type_Metrics_Server)
 	}
 }
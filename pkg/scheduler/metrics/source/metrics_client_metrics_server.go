@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// MetricsServerClient collects the utilization of the nodes from the resource metrics API served by metrics-server.
+// The API only reports the current usage of the nodes, not an average, and no GPU usage.
+type MetricsServerClient struct {
+	kubeCli    kubernetes.Interface
+	metricsCli metricsclient.Interface
+}
+
+func NewMetricsServerClient(cfg *rest.Config) (*MetricsServerClient, error) {
+	kubeCli, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	metricsCli, err := metricsclient.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricsServerClient{kubeCli: kubeCli, metricsCli: metricsCli}, nil
+}
+
+func (ms *MetricsServerClient) NodesMetricsAvg(ctx context.Context, nodeMetricsMap map[string]*NodeMetrics) error {
+	klog.V(5).Infof("Get node metrics from metrics-server")
+
+	nodes, err := ms.kubeCli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	metricsList, err := ms.metricsCli.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("Failed to query the resource metrics API, error is: %v.", err)
+		return err
+	}
+
+	allocatable := make(map[string]*NodeMetrics, len(nodes.Items))
+	for _, node := range nodes.Items {
+		allocatable[node.Name] = &NodeMetrics{
+			CPU:    node.Status.Allocatable.Cpu().AsApproximateFloat64(),
+			Memory: node.Status.Allocatable.Memory().AsApproximateFloat64(),
+		}
+	}
+
+	for _, metricValue := range metricsList.Items {
+		nodeMetrics, ok := nodeMetricsMap[metricValue.Name]
+		if !ok {
+			continue
+		}
+		total, ok := allocatable[metricValue.Name]
+		if !ok {
+			klog.Warningf("The node %s is reported by the resource metrics API, but cannot be found.", metricValue.Name)
+			continue
+		}
+		nodeMetrics.MetricsTime = metricValue.Timestamp.Time
+		if total.CPU > 0 {
+			nodeMetrics.CPU = metricValue.Usage.Cpu().AsApproximateFloat64() * 100 / total.CPU
+		}
+		if total.Memory > 0 {
+			nodeMetrics.Memory = metricValue.Usage.Memory().AsApproximateFloat64() * 100 / total.Memory
+		}
+		klog.V(5).Infof("The updated usage information of node %s is %v.", metricValue.Name, nodeMetrics)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+)
+
+func TestMetricsServerClientNodesMetricsAvg(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+	metricsCli := &metricsfake.Clientset{}
+	metricsCli.AddReactor("list", "nodes", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, &metricsv1beta1.NodeMetricsList{
+			Items: []metricsv1beta1.NodeMetrics{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+					Usage: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("1"),
+						v1.ResourceMemory: resource.MustParse("6Gi"),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "unknown"},
+				},
+			},
+		}, nil
+	})
+	client := &MetricsServerClient{kubeCli: kubefake.NewSimpleClientset(node), metricsCli: metricsCli}
+
+	nodeMetricsMap := map[string]*NodeMetrics{"n1": {}}
+	if err := client.NodesMetricsAvg(context.Background(), nodeMetricsMap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(nodeMetricsMap["n1"].CPU-25) > 1e-6 || math.Abs(nodeMetricsMap["n1"].Memory-75) > 1e-6 {
+		t.Errorf("expected cpu 25%% and memory 75%%, got %+v", nodeMetricsMap["n1"])
+	}
+	if len(nodeMetricsMap) != 1 {
+		t.Errorf("unexpected nodes added: %v", nodeMetricsMap)
+	}
+}
@@ -56,10 +56,8 @@ func (p *PrometheusMetricsClient) NodesMetricsAvg(ctx context.Context, nodeMetri
 	return nil
 }
 
-func (p *PrometheusMetricsClient) NodeMetricsAvg(ctx context.Context, nodeName string) (*NodeMetrics, error) {
-	klog.V(4).Infof("Get node metrics from Prometheus: %s", p.address)
-	var client api.Client
-	var err error
+// newAPI returns the client of the Prometheus HTTP API
+func (p *PrometheusMetricsClient) newAPI() (prometheusv1.API, error) {
 	insecureSkipVerify := p.conf["tls.insecureSkipVerify"] == "true"
 	if insecureSkipVerify {
 		klog.Warningf("WARNING: TLS certificate verification is disabled which is insecure. This should not be used in production environments")
@@ -70,19 +68,32 @@ func (p *PrometheusMetricsClient) NodeMetricsAvg(ctx context.Context, nodeName s
 			InsecureSkipVerify: insecureSkipVerify,
 		},
 	}
-	client, err = api.NewClient(api.Config{
+	client, err := api.NewClient(api.Config{
 		Address:      p.address,
 		RoundTripper: tr,
 	})
 	if err != nil {
 		return nil, err
 	}
-	v1api := prometheusv1.NewAPI(client)
+	return prometheusv1.NewAPI(client), nil
+}
+
+func (p *PrometheusMetricsClient) NodeMetricsAvg(ctx context.Context, nodeName string) (*NodeMetrics, error) {
+	klog.V(4).Infof("Get node metrics from Prometheus: %s", p.address)
+	v1api, err := p.newAPI()
+	if err != nil {
+		return nil, err
+	}
 	nodeMetrics := &NodeMetrics{}
 	cpuQueryStr := fmt.Sprintf(`avg_over_time(clamp_min(100 - (avg by (instance) (rate(node_cpu_seconds_total{mode="idle",instance="%s"}[5m])) * 100), 0)[%s:30s])`, nodeName, NODE_METRICS_PERIOD)
 	memQueryStr := fmt.Sprintf("100*avg_over_time(((1-node_memory_MemAvailable_bytes{instance=\"%s\"}/node_memory_MemTotal_bytes{instance=\"%s\"}))[%s:30s])", nodeName, nodeName, NODE_METRICS_PERIOD)
+	queries := []string{cpuQueryStr, memQueryStr}
+	gpuQueryStr := p.gpuQuery(nodeName)
+	if gpuQueryStr != "" {
+		queries = append(queries, gpuQueryStr)
+	}
 
-	for _, metric := range []string{cpuQueryStr, memQueryStr} {
+	for _, metric := range queries {
 		res, warnings, err := v1api.Query(ctx, metric, time.Now())
 		if err != nil {
 			klog.Errorf("Error querying Prometheus: %v", err)
@@ -109,8 +120,56 @@ func (p *PrometheusMetricsClient) NodeMetricsAvg(ctx context.Context, nodeName s
 		case memQueryStr:
 			memUsage, _ := strconv.ParseFloat(value[0], 64)
 			nodeMetrics.Memory = memUsage
+		case gpuQueryStr:
+			gpuUsage, _ := strconv.ParseFloat(value[0], 64)
+			nodeMetrics.GPU = gpuUsage
 		}
 	}
 	nodeMetrics.MetricsTime = time.Now()
 	return nodeMetrics, nil
 }
+
+// gpuQuery returns the query of the average GPU utilization of the node, built from the gpu.metric, e.g. the
+// DCGM_FI_DEV_GPU_UTIL of the DCGM exporter, and the gpu.nodeLabel, Hostname by default, of the configuration.
+// The GPU utilization is not collected when no metric is set.
+func (p *PrometheusMetricsClient) gpuQuery(nodeName string) string {
+	metric := p.conf["gpu.metric"]
+	if metric == "" {
+		return ""
+	}
+	nodeLabel := p.conf["gpu.nodeLabel"]
+	if nodeLabel == "" {
+		nodeLabel = "Hostname"
+	}
+	return fmt.Sprintf(`avg_over_time(avg(%s{%s="%s"})[%s:30s])`, metric, nodeLabel, nodeName, NODE_METRICS_PERIOD)
+}
+
+// QueryNodeValues runs an instant query and returns the values of the resulting vector by node, the node of a sample
+// being the value of its nodeLabel. The samples without the label are ignored.
+func (p *PrometheusMetricsClient) QueryNodeValues(ctx context.Context, query, nodeLabel string) (map[string]float64, error) {
+	klog.V(4).Infof("Query node values from Prometheus %s: %s", p.address, query)
+	v1api, err := p.newAPI()
+	if err != nil {
+		return nil, err
+	}
+	res, warnings, err := v1api.Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(warnings) > 0 {
+		klog.V(3).Infof("Warning querying Prometheus: %v", warnings)
+	}
+	vector, ok := res.(pmodel.Vector)
+	if !ok {
+		return nil, fmt.Errorf("query %s returned %s, but a vector is expected", query, res.Type())
+	}
+	values := make(map[string]float64, len(vector))
+	for _, sample := range vector {
+		node, found := sample.Metric[pmodel.LabelName(nodeLabel)]
+		if !found {
+			continue
+		}
+		values[string(node)] = float64(sample.Value)
+	}
+	return values, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestPrometheusQueryNodeValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status": "success", "data": {"resultType": "vector", "result": [
+			{"metric": {"Hostname": "n1"}, "value": [1700000000, "42.5"]},
+			{"metric": {"Hostname": "n2"}, "value": [1700000000, "7"]},
+			{"metric": {"instance": "n3"}, "value": [1700000000, "99"]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewPrometheusMetricsClient(map[string]string{"address": server.URL})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	values, err := client.QueryNodeValues(context.Background(), "avg by (Hostname) (DCGM_FI_PROF_SM_ACTIVE) * 100", "Hostname")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]float64{"n1": 42.5, "n2": 7}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected values %v, got %v", expected, values)
+	}
+}
+
+func TestPrometheusGPUQuery(t *testing.T) {
+	client, _ := NewPrometheusMetricsClient(map[string]string{"address": "http://localhost:9090"})
+	if query := client.gpuQuery("n1"); query != "" {
+		t.Errorf("expected no gpu query without metric, got %s", query)
+	}
+	client.conf["gpu.metric"] = "DCGM_FI_DEV_GPU_UTIL"
+	if query := client.gpuQuery("n1"); query != `avg_over_time(avg(DCGM_FI_DEV_GPU_UTIL{Hostname="n1"})[10m:30s])` {
+		t.Errorf("unexpected gpu query %s", query)
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Version is the OpenAPI version of the documents built by this package.
+	Version = "3.0.3"
+	// DocumentPath is the path the scheduler serves its OpenAPI document on.
+	DocumentPath = "/openapi/v3"
+)
+
+// Document is an OpenAPI v3 document, only the fields used by Volcano are modeled.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info is the metadata of the document.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Components holds the reusable schemas of the document.
+type Components struct {
+	Schemas map[string]Schema `json:"schemas"`
+}
+
+// Schema is a JSON schema object.
+type Schema map[string]interface{}
+
+// PathItem describes the operations available on a single path.
+type PathItem struct {
+	Parameters []Parameter `json:"parameters,omitempty"`
+	Get        *Operation  `json:"get,omitempty"`
+	Put        *Operation  `json:"put,omitempty"`
+	Post       *Operation  `json:"post,omitempty"`
+	Delete     *Operation  `json:"delete,omitempty"`
+}
+
+// Operation describes a single API operation on a path.
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a single operation parameter.
+type Parameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required"`
+	Schema   Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request.
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a single response of an operation.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType describes the schema of a content type.
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+// customResourceDefinition is the subset of apiextensions/v1 CustomResourceDefinition needed to build the document.
+type customResourceDefinition struct {
+	Spec struct {
+		Group string `json:"group"`
+		Names struct {
+			Kind   string `json:"kind"`
+			Plural string `json:"plural"`
+		} `json:"names"`
+		Scope    string `json:"scope"`
+		Versions []struct {
+			Name   string `json:"name"`
+			Served bool   `json:"served"`
+			Schema struct {
+				OpenAPIV3Schema Schema `json:"openAPIV3Schema"`
+			} `json:"schema"`
+		} `json:"versions"`
+	} `json:"spec"`
+}
+
+// NewDocument builds the OpenAPI document of the scheduler endpoints and of the resources
+// defined by the given CustomResourceDefinition manifests.
+func NewDocument(crds ...[]byte) (*Document, error) {
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   "Volcano",
+			Version: "unversioned",
+		},
+		Paths: schedulerPaths(),
+		Components: Components{
+			Schemas: map[string]Schema{},
+		},
+	}
+
+	for _, data := range crds {
+		crd := &customResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			return nil, fmt.Errorf("failed to parse CustomResourceDefinition: %v", err)
+		}
+		if crd.Spec.Group == "" || crd.Spec.Names.Kind == "" {
+			return nil, fmt.Errorf("invalid CustomResourceDefinition: group and kind are required")
+		}
+		for _, v := range crd.Spec.Versions {
+			if !v.Served || v.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+			addResource(doc, crd, v.Name, v.Schema.OpenAPIV3Schema)
+		}
+	}
+
+	return doc, nil
+}
+
+// schedulerPaths returns the HTTP endpoints served by the scheduler.
+func schedulerPaths() map[string]*PathItem {
+	return map[string]*PathItem{
+		"/metrics": {
+			Get: &Operation{
+				OperationID: "getSchedulerMetrics",
+				Summary:     "Prometheus metrics of the scheduler, served when --enable-metrics is set",
+				Tags:        []string{"scheduler"},
+				Responses: map[string]Response{
+					"200": {
+						Description: "OK",
+						Content:     map[string]MediaType{"text/plain": {Schema: Schema{"type": "string"}}},
+					},
+				},
+			},
+		},
+		DocumentPath: {
+			Get: &Operation{
+				OperationID: "getSchedulerOpenAPIDocument",
+				Summary:     "OpenAPI document of the scheduler endpoints and the Volcano resources, served when --enable-openapi is set",
+				Tags:        []string{"scheduler"},
+				Responses: map[string]Response{
+					"200": {
+						Description: "OK",
+						Content:     map[string]MediaType{"application/json": {Schema: Schema{"type": "object"}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addResource adds the schemas and the REST paths of the kind in the given version to the document.
+func addResource(doc *Document, crd *customResourceDefinition, version string, schema Schema) {
+	group, kind, plural := crd.Spec.Group, crd.Spec.Names.Kind, crd.Spec.Names.Plural
+	namespaced := crd.Spec.Scope == "Namespaced"
+
+	schemaName := SchemaName(group, version, kind)
+	listSchemaName := SchemaName(group, version, kind+"List")
+	gvk := []interface{}{map[string]interface{}{"group": group, "version": version, "kind": kind}}
+
+	s := Schema{}
+	for k, v := range schema {
+		s[k] = v
+	}
+	s["x-kubernetes-group-version-kind"] = gvk
+	doc.Components.Schemas[schemaName] = s
+	doc.Components.Schemas[listSchemaName] = Schema{
+		"type":     "object",
+		"required": []interface{}{"items"},
+		"properties": map[string]interface{}{
+			"apiVersion": map[string]interface{}{"type": "string"},
+			"kind":       map[string]interface{}{"type": "string"},
+			"metadata":   map[string]interface{}{"type": "object"},
+			"items": map[string]interface{}{
+				"type":  "array",
+				"items": ref(schemaName),
+			},
+		},
+	}
+
+	tag := strings.ReplaceAll(group, ".", "_") + "_" + version
+	// Operation IDs are unique in the document, e.g. listSchedulingV1beta1Queue, as in the Kubernetes OpenAPI.
+	opKind := upperFirst(strings.Split(group, ".")[0]) + upperFirst(version) + kind
+	collectionPath := fmt.Sprintf("/apis/%s/%s/%s", group, version, plural)
+	var params []Parameter
+	if namespaced {
+		opKind = upperFirst(strings.Split(group, ".")[0]) + upperFirst(version) + "Namespaced" + kind
+		collectionPath = fmt.Sprintf("/apis/%s/%s/namespaces/{namespace}/%s", group, version, plural)
+		params = append(params, pathParameter("namespace"))
+	}
+
+	doc.Paths[collectionPath] = &PathItem{
+		Parameters: params,
+		Get:        operation("list"+opKind, tag, listSchemaName, "200", nil),
+		Post:       operation("create"+opKind, tag, schemaName, "201", requestBody(schemaName)),
+	}
+	doc.Paths[collectionPath+"/{name}"] = &PathItem{
+		Parameters: append(append([]Parameter{}, params...), pathParameter("name")),
+		Get:        operation("read"+opKind, tag, schemaName, "200", nil),
+		Put:        operation("replace"+opKind, tag, schemaName, "200", requestBody(schemaName)),
+		Delete:     operation("delete"+opKind, tag, "", "200", nil),
+	}
+}
+
+// SchemaName returns the name of the schema of the kind in the document, following the
+// reverse domain notation used by Kubernetes, e.g. sh.volcano.scheduling.v1beta1.Queue.
+func SchemaName(group, version, kind string) string {
+	parts := strings.Split(group, ".")
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(append(parts, version, kind), ".")
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func ref(schemaName string) Schema {
+	return Schema{"$ref": "#/components/schemas/" + schemaName}
+}
+
+func pathParameter(name string) Parameter {
+	return Parameter{Name: name, In: "path", Required: true, Schema: Schema{"type": "string"}}
+}
+
+func requestBody(schemaName string) *RequestBody {
+	return &RequestBody{
+		Required: true,
+		Content:  map[string]MediaType{"application/json": {Schema: ref(schemaName)}},
+	}
+}
+
+func operation(id, tag, schemaName, code string, body *RequestBody) *Operation {
+	resp := Response{Description: "OK"}
+	if schemaName != "" {
+		resp.Content = map[string]MediaType{"application/json": {Schema: ref(schemaName)}}
+	}
+	return &Operation{
+		OperationID: id,
+		Tags:        []string{tag},
+		RequestBody: body,
+		Responses:   map[string]Response{code: resp},
+	}
+}
+
+// Handler returns a http handler which serves the given OpenAPI document.
+func Handler(document []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(document); err != nil {
+			klog.Errorf("Failed to write OpenAPI document: %v", err)
+		}
+	})
+}
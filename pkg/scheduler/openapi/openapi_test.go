@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"volcano.sh/volcano/api/openapi"
+)
+
+const queueCRD = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: queues.scheduling.volcano.sh
+spec:
+  group: scheduling.volcano.sh
+  names:
+    kind: Queue
+    plural: queues
+  scope: Cluster
+  versions:
+  - name: v1beta1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+`
+
+const jobCRD = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+spec:
+  group: batch.volcano.sh
+  names:
+    kind: Job
+    plural: jobs
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    schema:
+      openAPIV3Schema:
+        type: object
+  - name: v1alpha0
+    served: false
+    schema:
+      openAPIV3Schema:
+        type: object
+`
+
+func TestNewDocument(t *testing.T) {
+	doc, err := NewDocument([]byte(queueCRD), []byte(jobCRD))
+	if err != nil {
+		t.Fatalf("failed to build document: %v", err)
+	}
+
+	for _, name := range []string{
+		"sh.volcano.scheduling.v1beta1.Queue",
+		"sh.volcano.scheduling.v1beta1.QueueList",
+		"sh.volcano.batch.v1alpha1.Job",
+		"sh.volcano.batch.v1alpha1.JobList",
+	} {
+		if _, found := doc.Components.Schemas[name]; !found {
+			t.Errorf("expected schema %s in document", name)
+		}
+	}
+	if _, found := doc.Components.Schemas["sh.volcano.batch.v1alpha0.Job"]; found {
+		t.Errorf("expected no schema for version which is not served")
+	}
+
+	queuePath, found := doc.Paths["/apis/scheduling.volcano.sh/v1beta1/queues/{name}"]
+	if !found {
+		t.Fatalf("expected cluster scoped path of queue in document")
+	}
+	if queuePath.Get.OperationID != "readSchedulingV1beta1Queue" {
+		t.Errorf("unexpected operation id %s", queuePath.Get.OperationID)
+	}
+	jobPath, found := doc.Paths["/apis/batch.volcano.sh/v1alpha1/namespaces/{namespace}/jobs"]
+	if !found {
+		t.Fatalf("expected namespaced path of job in document")
+	}
+	if jobPath.Post.OperationID != "createBatchV1alpha1NamespacedJob" {
+		t.Errorf("unexpected operation id %s", jobPath.Post.OperationID)
+	}
+	if _, found := doc.Paths[DocumentPath]; !found {
+		t.Errorf("expected scheduler path %s in document", DocumentPath)
+	}
+
+	if _, err := NewDocument([]byte("spec: {}")); err == nil {
+		t.Errorf("expected error for CustomResourceDefinition without group and kind")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(Handler(openapi.Document))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + DocumentPath)
+	if err != nil {
+		t.Fatalf("failed to get document: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("unexpected content type %s", ct)
+	}
+	doc := &Document{}
+	if err := json.NewDecoder(resp.Body).Decode(doc); err != nil {
+		t.Fatalf("failed to decode generated document: %v", err)
+	}
+	for _, name := range []string{
+		"sh.volcano.batch.v1alpha1.Job",
+		"sh.volcano.scheduling.v1beta1.Queue",
+		"sh.volcano.scheduling.v1beta1.PodGroup",
+	} {
+		if _, found := doc.Components.Schemas[name]; !found {
+			t.Errorf("expected schema %s in generated document", name)
+		}
+	}
+}
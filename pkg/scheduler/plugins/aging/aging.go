@@ -0,0 +1,338 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aging
+
+import (
+	"math"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "aging"
+
+	// SlopeKey is the priority a pending job gains per minute it is pending.
+	SlopeKey = "aging.slope"
+	// CeilingKey is the maximum priority a pending job gains by aging.
+	CeilingKey = "aging.ceiling"
+	// PreemptableKey allows the aged jobs to preempt the jobs of lower effective priority in the same queue.
+	PreemptableKey = "aging.preemptable"
+	// ChurnDecayKey enables the priority decay of the jobs whose tasks repeatedly fail or are evicted after they are
+	// allocated, so they do not take the scheduler effort from the other jobs.
+	ChurnDecayKey = "aging.churnDecay"
+	// ChurnWindowKey is how long the churns of a job are counted, e.g. "1h".
+	ChurnWindowKey = "aging.churnWindow"
+	// ChurnThresholdKey is the number of churns within the window from which the priority of a job decays.
+	ChurnThresholdKey = "aging.churnThreshold"
+	// ChurnEfficiencyKey is the scheduling efficiency, the share of the runtime of the tasks not lost by churns,
+	// from which the priority of a job does not decay.
+	ChurnEfficiencyKey = "aging.churnEfficiency"
+	// ChurnPenaltyKey is the priority a job loses per churn within the window.
+	ChurnPenaltyKey = "aging.churnPenalty"
+	// ChurnMaxDecayKey is the maximum priority a job loses by churning.
+	ChurnMaxDecayKey = "aging.churnMaxDecay"
+
+	defaultSlope   = 1.0
+	defaultCeiling = 1000
+
+	defaultChurnWindow     = time.Hour
+	defaultChurnThreshold  = 3
+	defaultChurnEfficiency = 0.5
+	defaultChurnPenalty    = 10
+	defaultChurnMaxDecay   = 1000
+)
+
+/*
+   actions: "enqueue, allocate, preempt, backfill"
+   tiers:
+   - plugins:
+     - name: aging
+       arguments:
+         aging.slope: 10
+         aging.ceiling: 500
+         aging.preemptable: true
+         aging.churnDecay: true
+         aging.churnWindow: 1h
+         aging.churnThreshold: 3
+         aging.churnEfficiency: 0.5
+         aging.churnPenalty: 10
+         aging.churnMaxDecay: 1000
+     - name: priority
+       enableJobOrder: false
+       enablePreemptable: false
+*/
+
+// pendingSince is the time the jobs are pending since. The jobs seen running have the zero time, so that they age from
+// the time they are pending again.
+var pendingSince = framework.JobStates[time.Time](PluginName, "pendingSince")
+
+type agingPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	slope           float64
+	ceiling         int
+	preemptable     bool
+	churnDecay      bool
+	churnWindow     time.Duration
+	churnThreshold  int
+	churnEfficiency float64
+	churnPenalty    int
+	churnMaxDecay   int
+	now             func() time.Time
+}
+
+// New return aging plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	ap := &agingPlugin{
+		pluginArguments: arguments,
+		slope:           defaultSlope,
+		ceiling:         defaultCeiling,
+		churnWindow:     defaultChurnWindow,
+		churnThreshold:  defaultChurnThreshold,
+		churnEfficiency: defaultChurnEfficiency,
+		churnPenalty:    defaultChurnPenalty,
+		churnMaxDecay:   defaultChurnMaxDecay,
+		now:             time.Now,
+	}
+
+	arguments.GetFloat64(&ap.slope, SlopeKey)
+	if ap.slope < 0 {
+		klog.Warningf("Invalid %s <%v> in plugin %s, using default %v", SlopeKey, ap.slope, PluginName, defaultSlope)
+		ap.slope = defaultSlope
+	}
+	arguments.GetInt(&ap.ceiling, CeilingKey)
+	if ap.ceiling < 0 || ap.ceiling > math.MaxInt32 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default %d", CeilingKey, ap.ceiling, PluginName, defaultCeiling)
+		ap.ceiling = defaultCeiling
+	}
+	arguments.GetBool(&ap.preemptable, PreemptableKey)
+	ap.parseChurnArguments(arguments)
+
+	return ap
+}
+
+func (ap *agingPlugin) parseChurnArguments(arguments framework.Arguments) {
+	arguments.GetBool(&ap.churnDecay, ChurnDecayKey)
+	var window string
+	arguments.GetString(&window, ChurnWindowKey)
+	if window != "" {
+		if d, err := time.ParseDuration(window); err != nil || d <= 0 {
+			klog.Warningf("Invalid %s <%s> in plugin %s, using default %v", ChurnWindowKey, window, PluginName, defaultChurnWindow)
+		} else {
+			ap.churnWindow = d
+		}
+	}
+	arguments.GetInt(&ap.churnThreshold, ChurnThresholdKey)
+	if ap.churnThreshold < 1 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default %d", ChurnThresholdKey, ap.churnThreshold, PluginName, defaultChurnThreshold)
+		ap.churnThreshold = defaultChurnThreshold
+	}
+	arguments.GetFloat64(&ap.churnEfficiency, ChurnEfficiencyKey)
+	if ap.churnEfficiency < 0 || ap.churnEfficiency > 1 {
+		klog.Warningf("Invalid %s <%v> in plugin %s, using default %v", ChurnEfficiencyKey, ap.churnEfficiency, PluginName, defaultChurnEfficiency)
+		ap.churnEfficiency = defaultChurnEfficiency
+	}
+	arguments.GetInt(&ap.churnPenalty, ChurnPenaltyKey)
+	if ap.churnPenalty < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default %d", ChurnPenaltyKey, ap.churnPenalty, PluginName, defaultChurnPenalty)
+		ap.churnPenalty = defaultChurnPenalty
+	}
+	arguments.GetInt(&ap.churnMaxDecay, ChurnMaxDecayKey)
+	if ap.churnMaxDecay < 0 || ap.churnMaxDecay > math.MaxInt32 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default %d", ChurnMaxDecayKey, ap.churnMaxDecay, PluginName, defaultChurnMaxDecay)
+		ap.churnMaxDecay = defaultChurnMaxDecay
+	}
+}
+
+func (ap *agingPlugin) Name() string {
+	return PluginName
+}
+
+// pending returns whether the job is waiting to start, i.e. its PodGroup is not running yet.
+func pending(job *api.JobInfo) bool {
+	return job.IsPending() || job.PodGroup.Status.Phase == scheduling.PodGroupInqueue
+}
+
+// updatePendingSince records the time the jobs of the session are pending since. A job first seen pending is pending
+// since its creation, e.g. after the scheduler restarts.
+func updatePendingSince(ssn *framework.Session, now time.Time) {
+	pendingSince.Update(func(pendingSince map[api.JobID]time.Time) {
+		for jobID, job := range ssn.Jobs {
+			if job.PodGroup == nil || !pending(job) {
+				pendingSince[jobID] = time.Time{}
+				continue
+			}
+			since, found := pendingSince[jobID]
+			switch {
+			case !found:
+				pendingSince[jobID] = job.CreationTimestamp.Time
+			case since.IsZero():
+				pendingSince[jobID] = now
+			}
+		}
+	})
+}
+
+// boost returns the priority the job gains by aging, the slope times the minutes it is pending, up to the ceiling.
+// The jobs found starving by the scheduler gain the ceiling.
+func (ap *agingPlugin) boost(ssn *framework.Session, job *api.JobInfo, now time.Time) int32 {
+	if ssn.StarvationDetected(job) {
+		return int32(ap.ceiling)
+	}
+	since, _ := pendingSince.Get(job.UID)
+	if since.IsZero() || !now.After(since) {
+		return 0
+	}
+	gain := ap.slope * now.Sub(since).Minutes()
+	if gain >= float64(ap.ceiling) {
+		return int32(ap.ceiling)
+	}
+	return int32(gain)
+}
+
+// effectivePriority returns the priority of the job with the priority it gains by aging and loses by churning,
+// saturated at MaxInt32 and MinInt32.
+func (ap *agingPlugin) effectivePriority(ssn *framework.Session, job *api.JobInfo, now time.Time) int32 {
+	priority := int64(job.Priority) + int64(ap.boost(ssn, job, now)) - int64(ap.decay(job, now))
+	if priority > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	if priority < math.MinInt32 {
+		return math.MinInt32
+	}
+	return int32(priority)
+}
+
+// updateChurn records the churns of the jobs of the session and reports the scheduling efficiency of the jobs,
+// their churns and the jobs decayed.
+func (ap *agingPlugin) updateChurn(ssn *framework.Session, now time.Time) {
+	for queueID, churns := range updateChurnRecords(ssn, now, ap.churnWindow) {
+		metrics.RegisterJobChurns(string(queueID), churns)
+	}
+	decayed := map[string]int{}
+	for jobID, job := range ssn.Jobs {
+		record, _ := churnRecords.Get(jobID)
+		metrics.UpdateJobSchedulingEfficiency(job.Namespace, job.Name, record.efficiency(now))
+		if loss := ap.decay(job, now); loss > 0 {
+			decayed[string(job.Queue)]++
+			klog.V(4).Infof("Aging: job <%s/%s> churned %d times with efficiency %.2f, its priority decays by %d",
+				job.Namespace, job.Name, len(record.churns), record.efficiency(now), loss)
+		}
+	}
+	metrics.UpdateChurnDecayedJobs(decayed)
+}
+
+func (ap *agingPlugin) OnSessionOpen(ssn *framework.Session) {
+	now := ap.now()
+	updatePendingSince(ssn, now)
+	if ap.churnDecay {
+		ap.updateChurn(ssn, now)
+	}
+
+	priorities := make(map[api.JobID]int32, len(ssn.Jobs))
+	for jobID, job := range ssn.Jobs {
+		priorities[jobID] = ap.effectivePriority(ssn, job, now)
+		if priorities[jobID] != job.Priority {
+			since, _ := pendingSince.Get(jobID)
+			klog.V(4).Infof("Aging: job <%s/%s> pending since %v has effective priority %d, priority %d",
+				job.Namespace, job.Name, since, priorities[jobID], job.Priority)
+		}
+	}
+
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+		lp, rp := priorities[lv.UID], priorities[rv.UID]
+
+		klog.V(4).Infof("Aging JobOrderFn: <%v/%v> effective priority: %d, <%v/%v> effective priority: %d",
+			lv.Namespace, lv.Name, lp, rv.Namespace, rv.Name, rp)
+
+		if lp > rp {
+			return -1
+		}
+		if lp < rp {
+			return 1
+		}
+		return 0
+	}
+	ssn.AddJobOrderFn(ap.Name(), jobOrderFn)
+
+	if !ap.preemptable {
+		return
+	}
+
+	preemptableFn := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		preemptorJob := ssn.Jobs[preemptor.Job]
+		if preemptorJob == nil {
+			return nil, util.Permit
+		}
+
+		var victims []*api.TaskInfo
+		for _, preemptee := range preemptees {
+			preempteeJob := ssn.Jobs[preemptee.Job]
+			if preempteeJob == nil || preempteeJob.Queue != preemptorJob.Queue {
+				continue
+			}
+			if preempteeJob.UID == preemptorJob.UID {
+				// same job's different tasks should compare task's priority
+				if preemptee.Priority < preemptor.Priority {
+					victims = append(victims, preemptee)
+				}
+				continue
+			}
+			if priorities[preempteeJob.UID] >= priorities[preemptorJob.UID] {
+				klog.V(4).Infof("[aging] Can not preempt task <%v/%v> because preemptee job has greater or equal "+
+					"effective priority (%d) than preemptor (%d)",
+					preemptee.Namespace, preemptee.Name, priorities[preempteeJob.UID], priorities[preemptorJob.UID])
+				continue
+			}
+			victims = append(victims, preemptee)
+		}
+
+		klog.V(4).Infof("[aging] Victims from Aging plugin are %+v", victims)
+		return victims, util.Permit
+	}
+	ssn.AddPreemptableFn(ap.Name(), preemptableFn)
+
+	ssn.AddUnifiedEvictableFn(ap.Name(), func(evictCtx *api.EvictionContext, candidates []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		if evictCtx.Kind == api.EvictionKindGangReclaim {
+			// aging only orders the jobs within the queue, reclaim between queues is not gated
+			return candidates, util.Permit
+		}
+		preemptor := ap.effectivePriority(ssn, evictCtx.Job, now)
+		var victims []*api.TaskInfo
+		for _, candidate := range candidates {
+			candidateJob := ssn.Jobs[candidate.Job]
+			if candidateJob == nil || priorities[candidateJob.UID] >= preemptor {
+				continue
+			}
+			victims = append(victims, candidate)
+		}
+		return victims, util.Permit
+	})
+}
+
+func (ap *agingPlugin) OnSessionClose(ssn *framework.Session) {}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aging
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/preempt"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/priority"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestBoost(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+		since     time.Time
+		boost     int32
+	}{
+		{name: "one per minute by default", arguments: framework.Arguments{}, since: now.Add(-90 * time.Second), boost: 1},
+		{name: "slope per minute", arguments: framework.Arguments{SlopeKey: 2.5}, since: now.Add(-10 * time.Minute), boost: 25},
+		{name: "capped by the ceiling", arguments: framework.Arguments{SlopeKey: 10, CeilingKey: 50}, since: now.Add(-time.Hour), boost: 50},
+		{name: "not pending", arguments: framework.Arguments{SlopeKey: 10}, since: time.Time{}, boost: 0},
+		{name: "invalid slope uses the default", arguments: framework.Arguments{SlopeKey: -1}, since: now.Add(-5 * time.Minute), boost: 5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ap := New(test.arguments).(*agingPlugin)
+			job := api.NewJobInfo("j1")
+			pendingSince.Reset()
+			pendingSince.Set(job.UID, test.since)
+			if boost := ap.boost(&framework.Session{}, job, now); boost != test.boost {
+				t.Errorf("expected boost %d, got %d", test.boost, boost)
+			}
+		})
+	}
+}
+
+func TestUpdatePendingSince(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	now := time.Now()
+	job := api.NewJobInfo("c1/pg1")
+	pg := &api.PodGroup{}
+	pg.CreationTimestamp = metav1.Time{Time: created}
+	pg.Status.Phase = scheduling.PodGroupPending
+	job.SetPodGroup(pg)
+	ssn := &framework.Session{Jobs: map[api.JobID]*api.JobInfo{job.UID: job}}
+	pendingSince.Reset()
+
+	updatePendingSince(ssn, now)
+	if since, _ := pendingSince.Get(job.UID); !since.Equal(created) {
+		t.Errorf("expected job first seen pending since its creation %v, got %v", created, since)
+	}
+
+	job.PodGroup.Status.Phase = scheduling.PodGroupRunning
+	updatePendingSince(ssn, now)
+	if since, _ := pendingSince.Get(job.UID); !since.IsZero() {
+		t.Errorf("expected running job not to age, got pending since %v", since)
+	}
+
+	job.PodGroup.Status.Phase = scheduling.PodGroupInqueue
+	later := now.Add(time.Minute)
+	updatePendingSince(ssn, later)
+	if since, _ := pendingSince.Get(job.UID); !since.Equal(later) {
+		t.Errorf("expected restarted job pending since %v, got %v", later, since)
+	}
+}
+
+func TestAging(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:          New,
+		gang.PluginName:     gang.New,
+		priority.PluginName: priority.New,
+	}
+	created := func(pg *schedulingv1beta1.PodGroup, age time.Duration) *schedulingv1beta1.PodGroup {
+		pg.CreationTimestamp = metav1.Time{Time: time.Now().Add(-age)}
+		return pg
+	}
+	priClasses := []*schedulingv1.PriorityClass{
+		util.BuildPriorityClass("low-priority", 100),
+		util.BuildPriorityClass("high-priority", 500),
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+		actions   []framework.Action
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:     "long-pending gang is allocated before the new job of higher priority",
+				Plugins:  plugins,
+				PriClass: priClasses,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					created(util.BuildPodGroupWithPrio("big", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue, "low-priority"), time.Hour),
+					created(util.BuildPodGroupWithPrio("small", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "high-priority"), time.Minute),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "big", nil, nil),
+					util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "big", nil, nil),
+					util.BuildPod("c1", "small-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "small", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/big-1": "n1", "c1/big-2": "n1"},
+				ExpectBindsNum: 2,
+			},
+			arguments: framework.Arguments{SlopeKey: 10},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:     "job of higher priority is allocated first without aging",
+				Plugins:  plugins,
+				PriClass: priClasses,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					created(util.BuildPodGroupWithPrio("big", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue, "low-priority"), time.Hour),
+					created(util.BuildPodGroupWithPrio("small", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "high-priority"), time.Minute),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "big", nil, nil),
+					util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "big", nil, nil),
+					util.BuildPod("c1", "small-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "small", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/small-1": "n1"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{SlopeKey: 0},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:     "long-pending job preempts the running job of higher priority in the queue",
+				Plugins:  plugins,
+				PriClass: priClasses,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					created(util.BuildPodGroupWithPrio("running", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "high-priority"), time.Hour),
+					created(util.BuildPodGroupWithPrio("aged", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "low-priority"), time.Hour),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "running-1", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "running", map[string]string{schedulingv1beta1.PodPreemptable: "true"}, nil),
+					util.BuildPod("c1", "aged-1", "", v1.PodPending, api.BuildResourceList("2", "2G"), "aged", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+				ExpectEvicted:   []string{"c1/running-1"},
+				ExpectEvictNum:  1,
+				ExpectPipeLined: map[string][]string{"c1/aged": {"n1"}},
+			},
+			arguments: framework.Arguments{SlopeKey: 10, PreemptableKey: true},
+			actions:   []framework.Action{allocate.New(), preempt.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:     "long-pending job does not preempt unless preemptable",
+				Plugins:  plugins,
+				PriClass: priClasses,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					created(util.BuildPodGroupWithPrio("running", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "high-priority"), time.Hour),
+					created(util.BuildPodGroupWithPrio("aged", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "low-priority"), time.Hour),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "running-1", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "running", map[string]string{schedulingv1beta1.PodPreemptable: "true"}, nil),
+					util.BuildPod("c1", "aged-1", "", v1.PodPending, api.BuildResourceList("2", "2G"), "aged", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+				ExpectEvicted:   []string{},
+				ExpectEvictNum:  0,
+				ExpectPipeLined: map[string][]string{},
+			},
+			arguments: framework.Arguments{SlopeKey: 10},
+			actions:   []framework.Action{allocate.New(), preempt.New()},
+		},
+	}
+
+	trueValue, falseValue := true, false
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			pendingSince.Reset()
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:               PluginName,
+							EnabledJobOrder:    &trueValue,
+							EnabledPreemptable: &trueValue,
+							Arguments:          test.arguments,
+						},
+						{
+							Name:                gang.PluginName,
+							EnabledJobReady:     &trueValue,
+							EnabledJobPipelined: &trueValue,
+						},
+						{
+							Name:               priority.PluginName,
+							EnabledJobOrder:    &falseValue,
+							EnabledPreemptable: &falseValue,
+							EnabledJobStarving: &trueValue,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(test.actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aging
+
+import (
+	"time"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// churnRecord is the scheduling history of a job: the tasks allocated to it and the tasks it lost.
+type churnRecord struct {
+	// started is the time the allocated tasks started running, the zero time if they are not running yet.
+	started map[api.TaskID]time.Time
+	// useful is the runtime of the tasks which succeeded.
+	useful time.Duration
+	// churns are the tasks which failed or were evicted after they were allocated, within the churn window.
+	churns []churn
+}
+
+// churn is a task of the job which failed or was evicted after it was allocated.
+type churn struct {
+	at time.Time
+	// wasted is the runtime of the task which is lost.
+	wasted time.Duration
+}
+
+// churnRecords are the scheduling histories of the jobs, accumulated over the sessions to find the jobs churning.
+var churnRecords = framework.JobStates[*churnRecord](PluginName, "churnRecords")
+
+// runtime returns how long a task started at the time has run, 0 if it has not started.
+func runtime(started, now time.Time) time.Duration {
+	if started.IsZero() || !now.After(started) {
+		return 0
+	}
+	return now.Sub(started)
+}
+
+// updateChurnRecords records the tasks of the jobs of the session allocated, succeeded or lost since the last session,
+// forgets the churns older than the window, and returns the new churns of each queue. The allocated tasks which
+// failed, are being evicted or are gone are churns.
+func updateChurnRecords(ssn *framework.Session, now time.Time, window time.Duration) map[api.QueueID]int {
+	churns := map[api.QueueID]int{}
+	churnRecords.Update(func(records map[api.JobID]*churnRecord) {
+		for jobID, job := range ssn.Jobs {
+			record, found := records[jobID]
+			if !found {
+				record = &churnRecord{started: map[api.TaskID]time.Time{}}
+				records[jobID] = record
+			}
+
+			for taskID, started := range record.started {
+				task, found := job.Tasks[taskID]
+				if found && api.AllocatedStatus(task.Status) {
+					continue
+				}
+				delete(record.started, taskID)
+				if found && task.Status == api.Succeeded {
+					record.useful += runtime(started, now)
+					continue
+				}
+				record.churns = append(record.churns, churn{at: now, wasted: runtime(started, now)})
+				churns[job.Queue]++
+			}
+			for _, task := range job.Tasks {
+				if !api.AllocatedStatus(task.Status) {
+					continue
+				}
+				var started time.Time
+				if task.Pod != nil && task.Pod.Status.StartTime != nil {
+					started = task.Pod.Status.StartTime.Time
+				}
+				record.started[task.UID] = started
+			}
+
+			recent := record.churns[:0]
+			for _, c := range record.churns {
+				if now.Sub(c.at) <= window {
+					recent = append(recent, c)
+				}
+			}
+			record.churns = recent
+		}
+	})
+	return churns
+}
+
+// efficiency returns the share of the runtime of the tasks of the job which is not lost by its churns within the
+// window, 1 if the job has neither runtime nor churns, 0 if it has churns only.
+func (record *churnRecord) efficiency(now time.Time) float64 {
+	useful := record.useful
+	for _, started := range record.started {
+		useful += runtime(started, now)
+	}
+	var wasted time.Duration
+	for _, c := range record.churns {
+		wasted += c.wasted
+	}
+	if useful+wasted == 0 {
+		if len(record.churns) > 0 {
+			return 0
+		}
+		return 1
+	}
+	return float64(useful) / float64(useful+wasted)
+}
+
+// churnDecayEnabled returns whether the job is not opted out of the churn decay by its annotation.
+func churnDecayEnabled(job *api.JobInfo) bool {
+	return job.PodGroup == nil || job.PodGroup.Annotations[v1beta1.ChurnDecayKey] != "false"
+}
+
+// decay returns the priority the job loses for churning: the penalty times its churns within the window, up to the
+// maximum decay, if it churned at least the threshold times and its efficiency is below the target.
+func (ap *agingPlugin) decay(job *api.JobInfo, now time.Time) int32 {
+	if !ap.churnDecay || !churnDecayEnabled(job) {
+		return 0
+	}
+	record, found := churnRecords.Get(job.UID)
+	if !found || len(record.churns) < ap.churnThreshold || record.efficiency(now) >= ap.churnEfficiency {
+		return 0
+	}
+	loss := ap.churnPenalty * len(record.churns)
+	if loss > ap.churnMaxDecay {
+		return int32(ap.churnMaxDecay)
+	}
+	return int32(loss)
+}
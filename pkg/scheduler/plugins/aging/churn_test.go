@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aging
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// buildChurnJob returns a job of queue q1 with the pods started at the time, in the phase.
+func buildChurnJob(uid string, annotations map[string]string, phase v1.PodPhase, started time.Time, names ...string) *api.JobInfo {
+	job := api.NewJobInfo(api.JobID(uid))
+	pg := &api.PodGroup{}
+	pg.Name = uid
+	pg.Spec.Queue = "q1"
+	pg.Annotations = annotations
+	job.SetPodGroup(pg)
+	for _, name := range names {
+		pod := util.BuildPod("c1", name, "n1", phase, api.BuildResourceList("1", "1Gi"), uid, nil, nil)
+		pod.Status.StartTime = &metav1.Time{Time: started}
+		job.AddTaskInfo(api.NewTaskInfo(pod))
+	}
+	return job
+}
+
+func TestChurnDecay(t *testing.T) {
+	now := time.Now()
+	tasks := []string{"p1", "p2", "p3"}
+	tests := []struct {
+		name string
+		// before is the job in the first session, after in the second one
+		before, after *api.JobInfo
+		arguments     framework.Arguments
+		efficiency    float64
+		decay         int32
+	}{
+		{
+			name:       "tasks failing right after they start decay the priority",
+			before:     buildChurnJob("j1", nil, v1.PodRunning, now.Add(-10*time.Second), tasks...),
+			after:      buildChurnJob("j1", nil, v1.PodFailed, now.Add(-10*time.Second), tasks...),
+			arguments:  framework.Arguments{ChurnDecayKey: true},
+			efficiency: 0,
+			decay:      30,
+		},
+		{
+			name:       "tasks deleted after they are allocated are churns",
+			before:     buildChurnJob("j1", nil, v1.PodRunning, now.Add(-10*time.Second), tasks...),
+			after:      buildChurnJob("j1", nil, v1.PodPending, now, "p4"),
+			arguments:  framework.Arguments{ChurnDecayKey: true, ChurnPenaltyKey: 100, ChurnMaxDecayKey: 250},
+			efficiency: 0,
+			decay:      250,
+		},
+		{
+			name:       "succeeded tasks are not churns",
+			before:     buildChurnJob("j1", nil, v1.PodRunning, now.Add(-time.Hour), tasks...),
+			after:      buildChurnJob("j1", nil, v1.PodSucceeded, now.Add(-time.Hour), tasks...),
+			arguments:  framework.Arguments{ChurnDecayKey: true},
+			efficiency: 1,
+			decay:      0,
+		},
+		{
+			name:       "churns below the threshold do not decay the priority",
+			before:     buildChurnJob("j1", nil, v1.PodRunning, now.Add(-10*time.Second), tasks...),
+			after:      buildChurnJob("j1", nil, v1.PodFailed, now.Add(-10*time.Second), tasks...),
+			arguments:  framework.Arguments{ChurnDecayKey: true, ChurnThresholdKey: 4},
+			efficiency: 0,
+			decay:      0,
+		},
+		{
+			name:   "job opted out by annotation does not decay",
+			before: buildChurnJob("j1", map[string]string{schedulingv1beta1.ChurnDecayKey: "false"}, v1.PodRunning, now.Add(-10*time.Second), tasks...),
+			after: buildChurnJob("j1", map[string]string{schedulingv1beta1.ChurnDecayKey: "false"}, v1.PodFailed,
+				now.Add(-10*time.Second), tasks...),
+			arguments:  framework.Arguments{ChurnDecayKey: true},
+			efficiency: 0,
+			decay:      0,
+		},
+		{
+			name:       "churn decay is disabled by default",
+			before:     buildChurnJob("j1", nil, v1.PodRunning, now.Add(-10*time.Second), tasks...),
+			after:      buildChurnJob("j1", nil, v1.PodFailed, now.Add(-10*time.Second), tasks...),
+			arguments:  framework.Arguments{},
+			efficiency: 0,
+			decay:      0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			churnRecords.Reset()
+			ap := New(test.arguments).(*agingPlugin)
+
+			updateChurnRecords(&framework.Session{Jobs: map[api.JobID]*api.JobInfo{test.before.UID: test.before}}, now, ap.churnWindow)
+			updateChurnRecords(&framework.Session{Jobs: map[api.JobID]*api.JobInfo{test.after.UID: test.after}}, now, ap.churnWindow)
+
+			record, _ := churnRecords.Get(test.after.UID)
+			if efficiency := record.efficiency(now); efficiency != test.efficiency {
+				t.Errorf("expected efficiency %v, got %v", test.efficiency, efficiency)
+			}
+			if decay := ap.decay(test.after, now); decay != test.decay {
+				t.Errorf("expected decay %d, got %d", test.decay, decay)
+			}
+			if priority := ap.effectivePriority(&framework.Session{}, test.after, now); priority != test.after.Priority-test.decay {
+				t.Errorf("expected effective priority %d, got %d", test.after.Priority-test.decay, priority)
+			}
+		})
+	}
+}
+
+func TestChurnWindow(t *testing.T) {
+	now := time.Now()
+	churnRecords.Reset()
+	job := buildChurnJob("j1", nil, v1.PodRunning, now.Add(-10*time.Second), "p1")
+	ssn := &framework.Session{Jobs: map[api.JobID]*api.JobInfo{job.UID: job}}
+	updateChurnRecords(ssn, now, time.Hour)
+
+	failed := buildChurnJob("j1", nil, v1.PodFailed, now.Add(-10*time.Second), "p1")
+	ssn.Jobs[job.UID] = failed
+	if churns := updateChurnRecords(ssn, now, time.Hour); churns["q1"] != 1 {
+		t.Errorf("expected 1 new churn of queue q1, got %v", churns)
+	}
+	record, _ := churnRecords.Get(job.UID)
+	if churns := updateChurnRecords(ssn, now.Add(30*time.Minute), time.Hour); len(churns) != 0 || len(record.churns) != 1 {
+		t.Errorf("expected the churn counted once and kept within the window, got %v and %d", churns, len(record.churns))
+	}
+	updateChurnRecords(ssn, now.Add(2*time.Hour), time.Hour)
+	if len(record.churns) != 0 {
+		t.Errorf("expected the churn older than the window to be forgotten, got %d", len(record.churns))
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// BurstCreditsEnable is the key for enabling the burst credits of the queues in the capacity plugin
+	BurstCreditsEnable = "capacity.burstCredits"
+	// CreditAccrualRateKey is the credits a queue accrues per second it uses none of its deserved resources
+	CreditAccrualRateKey = "capacity.creditAccrualRate"
+	// CreditSpendRateKey is the credits a queue spends per second it uses twice its deserved resources
+	CreditSpendRateKey = "capacity.creditSpendRate"
+	// MaxCreditsKey is the maximum credits a queue accrues
+	MaxCreditsKey = "capacity.maxCredits"
+
+	defaultCreditAccrualRate = 1.0
+	defaultCreditSpendRate   = 1.0
+	defaultMaxCredits        = 3600.0
+)
+
+/*
+   tiers:
+   - plugins:
+     - name: capacity
+       arguments:
+         capacity.burstCredits: true
+         capacity.creditAccrualRate: 1
+         capacity.creditSpendRate: 2
+         capacity.maxCredits: 3600
+*/
+
+// burstCredit is the credit balance of a queue, in seconds of its whole deserved resources.
+type burstCredit struct {
+	balance float64
+	updated time.Time
+}
+
+// burstCredits are the credit balances of the queues with deserved resources, accrued and spent over the sessions.
+var burstCredits = framework.QueueStates[*burstCredit](PluginName, "burstCredits")
+
+// burstBucket is the token bucket of a queue declaring a burst, in resource-seconds used above its deserved resources.
+type burstBucket struct {
+	tokens  map[v1.ResourceName]float64
+	updated time.Time
+}
+
+// burstBuckets are the token buckets of the queues declaring a burst, refilled and charged over the sessions.
+var burstBuckets = framework.QueueStates[*burstBucket](PluginName, "burstBuckets")
+
+type burstConfig struct {
+	enable      bool
+	accrualRate float64
+	spendRate   float64
+	maxCredits  float64
+}
+
+func (cp *capacityPlugin) parseBurstArguments() {
+	cp.burst = burstConfig{
+		accrualRate: defaultCreditAccrualRate,
+		spendRate:   defaultCreditSpendRate,
+		maxCredits:  defaultMaxCredits,
+	}
+	cp.pluginArguments.GetBool(&cp.burst.enable, BurstCreditsEnable)
+	cp.pluginArguments.GetFloat64(&cp.burst.accrualRate, CreditAccrualRateKey)
+	if cp.burst.accrualRate < 0 {
+		klog.Warningf("Invalid %s <%v> in plugin %s, using default %v", CreditAccrualRateKey, cp.burst.accrualRate, PluginName, defaultCreditAccrualRate)
+		cp.burst.accrualRate = defaultCreditAccrualRate
+	}
+	cp.pluginArguments.GetFloat64(&cp.burst.spendRate, CreditSpendRateKey)
+	if cp.burst.spendRate <= 0 {
+		klog.Warningf("Invalid %s <%v> in plugin %s, using default %v", CreditSpendRateKey, cp.burst.spendRate, PluginName, defaultCreditSpendRate)
+		cp.burst.spendRate = defaultCreditSpendRate
+	}
+	cp.pluginArguments.GetFloat64(&cp.burst.maxCredits, MaxCreditsKey)
+	if cp.burst.maxCredits < 0 {
+		klog.Warningf("Invalid %s <%v> in plugin %s, using default %v", MaxCreditsKey, cp.burst.maxCredits, PluginName, defaultMaxCredits)
+		cp.burst.maxCredits = defaultMaxCredits
+	}
+}
+
+// deservedUsage returns the highest ratio of the allocated to the deserved resources of the dimensions the deserved
+// resources are set for, false if none is set.
+func deservedUsage(allocated, deserved *api.Resource) (float64, bool) {
+	usage, found := 0.0, false
+	for _, name := range deserved.ResourceNames() {
+		quantity := deserved.Get(name)
+		if quantity <= 0 {
+			continue
+		}
+		usage, found = math.Max(usage, allocated.Get(name)/quantity), true
+	}
+	return usage, found
+}
+
+// updateBurstCredits accrues the credits of the queues using less than their deserved resources since the last
+// session, in proportion to the unused share, and spends the credits of the queues using more, in proportion to the
+// exceeding share.
+func (cp *capacityPlugin) updateBurstCredits(ssn *framework.Session, now time.Time) {
+	for queueID, queue := range ssn.Queues {
+		allocated, deserved := api.EmptyResource(), api.NewResource(queue.Queue.Spec.Deserved).Normalized()
+		if attr, found := cp.queueOpts[queueID]; found {
+			allocated, deserved = attr.allocated, attr.deserved
+		}
+		usage, found := deservedUsage(allocated, deserved)
+		if !found {
+			burstCredits.Delete(queueID)
+			continue
+		}
+
+		credit, found := burstCredits.Get(queueID)
+		if !found {
+			burstCredits.Set(queueID, &burstCredit{updated: now})
+			metrics.UpdateQueueBurstCredits(queue.Name, 0)
+			continue
+		}
+		elapsed := now.Sub(credit.updated).Seconds()
+		credit.updated = now
+		if elapsed <= 0 {
+			continue
+		}
+		if usage < 1 {
+			credit.balance += cp.burst.accrualRate * elapsed * (1 - usage)
+		} else {
+			credit.balance -= cp.burst.spendRate * elapsed * (usage - 1)
+		}
+		credit.balance = math.Min(math.Max(credit.balance, 0), cp.burst.maxCredits)
+		klog.V(4).Infof("Queue <%s> uses %.2f of its deserved resources, burst credits: %.0f", queue.Name, usage, credit.balance)
+		metrics.UpdateQueueBurstCredits(queue.Name, credit.balance)
+	}
+}
+
+// bursting returns whether the queue has burst credits left, so its tasks exceeding the deserved resources
+// are not reclaimed.
+func (cp *capacityPlugin) bursting(queueID api.QueueID) bool {
+	if !cp.burst.enable {
+		return false
+	}
+	credit, found := burstCredits.Get(queueID)
+	return found && credit.balance > 0
+}
+
+// updateBurstBuckets refills the token buckets of the queues declaring a burst for the time since the last session,
+// and charges them for the resources the queues used above their deserved resources meanwhile. A new bucket is full.
+func (cp *capacityPlugin) updateBurstBuckets(ssn *framework.Session, now time.Time) {
+	for queueID, queue := range ssn.Queues {
+		if queue.Queue.Spec.Burst == nil {
+			burstBuckets.Delete(queueID)
+			continue
+		}
+		attr := cp.queueOpts[queueID]
+		if attr == nil {
+			continue
+		}
+		capacity := api.NewResource(queue.Queue.Spec.Burst.Capacity).Normalized()
+		refillRate := api.NewResource(queue.Queue.Spec.Burst.RefillRate).Normalized()
+
+		bucket, found := burstBuckets.Get(queueID)
+		if !found {
+			bucket = &burstBucket{tokens: map[v1.ResourceName]float64{}, updated: now}
+			burstBuckets.Set(queueID, bucket)
+		}
+		elapsed := math.Max(now.Sub(bucket.updated).Seconds(), 0)
+		bucket.updated = now
+
+		tokens := map[v1.ResourceName]float64{}
+		for _, name := range capacity.ResourceNames() {
+			balance, found := bucket.tokens[name]
+			if !found {
+				balance = capacity.Get(name)
+			}
+			used := math.Max(attr.allocated.Get(name)-attr.deserved.Get(name), 0)
+			balance += (refillRate.Get(name) - used) * elapsed
+			tokens[name] = math.Min(math.Max(balance, 0), capacity.Get(name))
+			metrics.UpdateQueueBurstTokens(queue.Name, string(name), tokens[name])
+		}
+		bucket.tokens = tokens
+		klog.V(4).Infof("Queue <%s> allocated <%v> of deserved <%v>, burst tokens: %v", queue.Name, attr.allocated, attr.deserved, tokens)
+	}
+}
+
+// burstAllowed returns whether the queue may use the resources requested above its deserved resources: the resources
+// held in the token bucket of the queue only while tokens are left, the other resources up to the capability.
+func burstAllowed(attr *queueAttr, futureUsed, resreq *api.Resource) bool {
+	bucket, found := burstBuckets.Get(attr.queueID)
+	if !found {
+		return true
+	}
+	for name, tokens := range bucket.tokens {
+		if tokens <= 0 && resreq.Get(name) > 0 && futureUsed.Get(name) > attr.deserved.Get(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// usingBurst returns whether the queue uses resources held in its token bucket above its deserved resources.
+func (cp *capacityPlugin) usingBurst(queueID api.QueueID) bool {
+	bucket, found := burstBuckets.Get(queueID)
+	attr := cp.queueOpts[queueID]
+	if !found || attr == nil {
+		return false
+	}
+	for name := range bucket.tokens {
+		if attr.allocated.Get(name) > attr.deserved.Get(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareBurstUsage orders the queues using their burst first, so that their burst usage is reclaimed first.
+func (cp *capacityPlugin) compareBurstUsage(l, r *api.QueueInfo) int {
+	lBurst, rBurst := cp.usingBurst(l.UID), cp.usingBurst(r.UID)
+	if lBurst == rBurst {
+		return 0
+	}
+	if lBurst {
+		return -1
+	}
+	return 1
+}
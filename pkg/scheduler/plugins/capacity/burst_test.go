@@ -0,0 +1,357 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestUpdateBurstCredits(t *testing.T) {
+	defer burstCredits.Reset()
+
+	now := time.Now()
+	queue := func(name, deservedCPU string) *api.QueueInfo {
+		return api.NewQueueInfo(&scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       scheduling.QueueSpec{Deserved: api.BuildResourceList(deservedCPU, "0")},
+		})
+	}
+	attr := func(q *api.QueueInfo, allocatedCPU string) *queueAttr {
+		return &queueAttr{
+			queueID:   q.UID,
+			name:      q.Name,
+			deserved:  api.NewResource(q.Queue.Spec.Deserved),
+			allocated: api.NewResource(api.BuildResourceList(allocatedCPU, "0")),
+		}
+	}
+	underUsed, overUsed, capped, idle, fresh := queue("under", "4"), queue("over", "2"), queue("capped", "4"), queue("idle", "2"), queue("fresh", "2")
+	ssn := &framework.Session{Queues: map[api.QueueID]*api.QueueInfo{}}
+	for _, q := range []*api.QueueInfo{underUsed, overUsed, capped, idle, fresh} {
+		ssn.Queues[q.UID] = q
+	}
+	cp := &capacityPlugin{
+		queueOpts: map[api.QueueID]*queueAttr{
+			underUsed.UID: attr(underUsed, "1"),
+			overUsed.UID:  attr(overUsed, "3"),
+			capped.UID:    attr(capped, "0"),
+			fresh.UID:     attr(fresh, "0"),
+		},
+		burst: burstConfig{enable: true, accrualRate: 1, spendRate: 2, maxCredits: 150},
+	}
+	last := now.Add(-100 * time.Second)
+	burstCredits.Reset()
+	for queueID, credit := range map[api.QueueID]*burstCredit{
+		underUsed.UID: {updated: last},
+		overUsed.UID:  {balance: 120, updated: last},
+		capped.UID:    {balance: 100, updated: last},
+		idle.UID:      {updated: last},
+	} {
+		burstCredits.Set(queueID, credit)
+	}
+
+	cp.updateBurstCredits(ssn, now)
+
+	expected := map[api.QueueID]float64{
+		// uses 1/4 of deserved: 100s * 1 * 3/4
+		underUsed.UID: 75,
+		// uses 3/2 of deserved: 120 - 100s * 2 * 1/2
+		overUsed.UID: 20,
+		// uses none of deserved: 100 + 100s, capped at 150
+		capped.UID: 150,
+		// no queue attributes without jobs, uses none of deserved
+		idle.UID: 100,
+		// accrues from the next session on
+		fresh.UID: 0,
+	}
+	if burstCredits.Len() != len(expected) {
+		t.Errorf("expected credits of %d queues, got %d", len(expected), burstCredits.Len())
+	}
+	for queueID, balance := range expected {
+		credit, found := burstCredits.Get(queueID)
+		if !found {
+			t.Errorf("expected credits of queue <%s>", queueID)
+			continue
+		}
+		if math.Abs(credit.balance-balance) > 1e-6 {
+			t.Errorf("expected credits %v of queue <%s>, got %v", balance, queueID, credit.balance)
+		}
+	}
+	if !cp.bursting(overUsed.UID) || cp.bursting(fresh.UID) {
+		t.Errorf("expected only the queues with credits left to be bursting")
+	}
+}
+
+func TestBurstCreditsProtectFromReclaim(t *testing.T) {
+	defer burstCredits.Reset()
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New, predicates.PluginName: predicates.New, gang.PluginName: gang.New}
+	trueValue := true
+
+	n1 := util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	n2 := util.BuildNode("n2", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	// queue q1 bursts to twice its deserved resources, queue q2 has nothing allocated
+	newTest := func(name string) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			Pods: []*corev1.Pod{
+				util.BuildPod("ns1", "p1", "n1", corev1.PodRunning, api.BuildResourceList("2", "4Gi"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: "false"}, nil),
+				util.BuildPod("ns1", "p2", "n2", corev1.PodRunning, api.BuildResourceList("2", "4Gi"), "pg1", nil, nil),
+				util.BuildPod("ns1", "p3", "", corev1.PodPending, api.BuildResourceList("2", "4Gi"), "pg2", nil, nil),
+			},
+			Nodes: []*corev1.Node{n1, n2},
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+				util.BuildPodGroup("pg2", "ns1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("2", "4Gi"), nil),
+				util.BuildQueueWithResourcesQuantity("q2", api.BuildResourceList("2", "4Gi"), nil),
+			},
+		}
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{
+			{
+				Name:               PluginName,
+				EnabledAllocatable: &trueValue,
+				EnablePreemptive:   &trueValue,
+				EnabledReclaimable: &trueValue,
+				EnabledQueueOrder:  &trueValue,
+				Arguments:          framework.Arguments{BurstCreditsEnable: true},
+			},
+			{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+			{Name: gang.PluginName, EnabledJobStarving: &trueValue},
+		},
+	}}
+
+	withCredits := newTest("queue with burst credits left is not reclaimed")
+	burstCredits.Set("q1", &burstCredit{balance: 600, updated: time.Now()})
+	withCredits.RegisterSession(tiers, nil)
+	withCredits.Run([]framework.Action{allocate.New(), reclaim.New()})
+	if err := withCredits.CheckAll(0); err != nil {
+		t.Error(err)
+	}
+	withCredits.Close()
+
+	withoutCredits := newTest("queue out of burst credits is reclaimed")
+	withoutCredits.ExpectPipeLined = map[string][]string{"ns1/pg2": {"n2"}}
+	withoutCredits.ExpectEvicted = []string{"ns1/p2"}
+	withoutCredits.ExpectEvictNum = 1
+	burstCredits.Set("q1", &burstCredit{balance: 0, updated: time.Now()})
+	withoutCredits.RegisterSession(tiers, nil)
+	withoutCredits.Run([]framework.Action{allocate.New(), reclaim.New()})
+	if err := withoutCredits.CheckAll(1); err != nil {
+		t.Error(err)
+	}
+	withoutCredits.Close()
+}
+
+func TestUpdateBurstBuckets(t *testing.T) {
+	defer burstBuckets.Reset()
+
+	now := time.Now()
+	queue := func(name string, burst *scheduling.QueueBurst) *api.QueueInfo {
+		return api.NewQueueInfo(&scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       scheduling.QueueSpec{Deserved: api.BuildResourceList("2", "0"), Burst: burst},
+		})
+	}
+	burst := &scheduling.QueueBurst{
+		Capacity:   api.BuildResourceList("100", "0"),
+		RefillRate: api.BuildResourceList("500m", "0"),
+	}
+	bursting, idle, fresh, unlimited := queue("bursting", burst), queue("idle", burst), queue("fresh", burst), queue("unlimited", nil)
+	ssn := &framework.Session{Queues: map[api.QueueID]*api.QueueInfo{}}
+	cp := &capacityPlugin{queueOpts: map[api.QueueID]*queueAttr{}}
+	for q, allocatedCPU := range map[*api.QueueInfo]string{bursting: "3", idle: "0", fresh: "4", unlimited: "4"} {
+		ssn.Queues[q.UID] = q
+		cp.queueOpts[q.UID] = &queueAttr{
+			queueID:   q.UID,
+			name:      q.Name,
+			deserved:  api.NewResource(q.Queue.Spec.Deserved),
+			allocated: api.NewResource(api.BuildResourceList(allocatedCPU, "0")),
+		}
+	}
+	last := now.Add(-100 * time.Second)
+	burstBuckets.Reset()
+	for _, q := range []*api.QueueInfo{bursting, idle, unlimited} {
+		burstBuckets.Set(q.UID, &burstBucket{tokens: map[corev1.ResourceName]float64{corev1.ResourceCPU: 80000}, updated: last})
+	}
+
+	cp.updateBurstBuckets(ssn, now)
+
+	expected := map[api.QueueID]float64{
+		// uses 1 CPU above deserved, refilled 0.5 CPU: 80 - 100s * 0.5 CPU
+		bursting.UID: 30000,
+		// refilled 100s * 0.5 CPU, capped at 100
+		idle.UID: 100000,
+		// starts full
+		fresh.UID: 100000,
+	}
+	if burstBuckets.Len() != len(expected) {
+		t.Errorf("expected buckets of %d queues, got %d", len(expected), burstBuckets.Len())
+	}
+	for queueID, tokens := range expected {
+		bucket, found := burstBuckets.Get(queueID)
+		if !found {
+			t.Errorf("expected bucket of queue <%s>", queueID)
+			continue
+		}
+		if math.Abs(bucket.tokens[corev1.ResourceCPU]-tokens) > 1e-6 {
+			t.Errorf("expected tokens %v of queue <%s>, got %v", tokens, queueID, bucket.tokens[corev1.ResourceCPU])
+		}
+	}
+	if !cp.usingBurst(bursting.UID) || cp.usingBurst(idle.UID) || cp.usingBurst(unlimited.UID) {
+		t.Errorf("expected only the queue above its deserved resources with a bucket to use its burst")
+	}
+}
+
+func TestBurstBucket(t *testing.T) {
+	defer burstBuckets.Reset()
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New, predicates.PluginName: predicates.New, gang.PluginName: gang.New}
+	trueValue := true
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{
+			{
+				Name:               PluginName,
+				EnabledAllocatable: &trueValue,
+				EnablePreemptive:   &trueValue,
+				EnabledReclaimable: &trueValue,
+				EnabledQueueOrder:  &trueValue,
+			},
+			{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+			{Name: gang.PluginName, EnabledJobStarving: &trueValue},
+		},
+	}}
+	burst := &schedulingv1beta1.QueueBurst{Capacity: api.BuildResourceList("3600", "0")}
+	withBurst := func(queue *schedulingv1beta1.Queue) *schedulingv1beta1.Queue {
+		queue.Spec.Burst = burst
+		return queue
+	}
+	buildNode := func(name, cpu string) *corev1.Node {
+		return util.BuildNode(name, api.BuildResourceList(cpu, "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	}
+
+	// queue q1 may use twice its deserved resources while its bucket holds tokens
+	allocateTest := func(name string) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			Pods: []*corev1.Pod{
+				util.BuildPod("ns1", "p1", "n1", corev1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+				util.BuildPod("ns1", "p2", "", corev1.PodPending, api.BuildResourceList("2", "1Gi"), "pg2", nil, nil),
+			},
+			Nodes: []*corev1.Node{buildNode("n1", "4")},
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+				util.BuildPodGroup("pg2", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				withBurst(util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("2", "4Gi"), api.BuildResourceList("4", "8Gi"))),
+			},
+		}
+	}
+
+	withTokens := allocateTest("queue with burst tokens exceeds its deserved resources")
+	withTokens.ExpectBindMap = map[string]string{"ns1/p2": "n1"}
+	withTokens.ExpectBindsNum = 1
+	burstBuckets.Reset()
+	withTokens.RegisterSession(tiers, nil)
+	withTokens.Run([]framework.Action{allocate.New()})
+	if err := withTokens.CheckAll(0); err != nil {
+		t.Error(err)
+	}
+	withTokens.Close()
+
+	withoutTokens := allocateTest("queue out of burst tokens keeps to its deserved resources")
+	burstBuckets.Set("q1", &burstBucket{tokens: map[corev1.ResourceName]float64{corev1.ResourceCPU: 0}, updated: time.Now()})
+	withoutTokens.RegisterSession(tiers, nil)
+	withoutTokens.Run([]framework.Action{allocate.New()})
+	if err := withoutTokens.CheckAll(1); err != nil {
+		t.Error(err)
+	}
+	withoutTokens.Close()
+
+	// queues q1 and q3 both use twice their deserved resources, only q1 by its burst
+	reclaimTest := func(name string, q1 *schedulingv1beta1.Queue) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			Pods: []*corev1.Pod{
+				util.BuildPod("ns1", "p1", "n1", corev1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: "false"}, nil),
+				util.BuildPod("ns1", "p2", "n2", corev1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+				util.BuildPod("ns1", "p3", "n2", corev1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg3", nil, nil),
+				util.BuildPod("ns1", "p4", "", corev1.PodPending, api.BuildResourceList("2", "1Gi"), "pg2", nil, nil),
+			},
+			Nodes: []*corev1.Node{buildNode("n1", "2"), buildNode("n2", "4")},
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+				util.BuildPodGroup("pg2", "ns1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				util.BuildPodGroup("pg3", "ns1", "q3", 1, nil, schedulingv1beta1.PodGroupRunning),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				q1,
+				util.BuildQueueWithResourcesQuantity("q2", api.BuildResourceList("2", "2Gi"), nil),
+				util.BuildQueueWithResourcesQuantity("q3", api.BuildResourceList("1", "1Gi"), nil),
+			},
+			ExpectPipeLined: map[string][]string{"ns1/pg2": {"n2"}},
+			ExpectEvictNum:  1,
+		}
+	}
+
+	withoutBurst := reclaimTest("queues are reclaimed in the victim queue order",
+		util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("2", "2Gi"), nil))
+	withoutBurst.ExpectEvicted = []string{"ns1/p3"}
+	burstBuckets.Reset()
+	withoutBurst.RegisterSession(tiers, nil)
+	withoutBurst.Run([]framework.Action{allocate.New(), reclaim.New()})
+	if err := withoutBurst.CheckAll(2); err != nil {
+		t.Error(err)
+	}
+	withoutBurst.Close()
+
+	burstFirst := reclaimTest("burst usage is reclaimed first",
+		withBurst(util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("2", "2Gi"), nil)))
+	burstFirst.ExpectEvicted = []string{"ns1/p2"}
+	burstBuckets.Reset()
+	burstFirst.RegisterSession(tiers, nil)
+	burstFirst.Run([]framework.Action{allocate.New(), reclaim.New()})
+	if err := burstFirst.CheckAll(3); err != nil {
+		t.Error(err)
+	}
+	burstFirst.Close()
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -76,12 +77,20 @@ type capacityPlugin struct {
 	dynamicResourceAllocationEnable bool
 	// draConsumableCapacityEnable controls whether Capacity dimensions inside DRA are enforced
 	draConsumableCapacityEnable bool
+
+	// burst configures the burst credits of the queues
+	burst burstConfig
+	// weightedSharing shares the idle resources of a parent queue among its children by their weight
+	weightedSharing bool
+	// subQuotas are the sub-quotas of the queues, shared by the jobs of their users and groups
+	subQuotas map[api.QueueID][]*subQuotaAttr
 }
 
 type queueAttr struct {
 	queueID   api.QueueID
 	name      string
 	share     float64
+	weight    int32
 	ancestors []api.QueueID
 	children  map[api.QueueID]*queueAttr
 
@@ -98,6 +107,10 @@ type queueAttr struct {
 	guarantee         *api.Resource
 	dra               *draQuotaAttr
 	resourceClaimRefs map[string]int
+	// fairShare represents the resources of the parent queue the queue is entitled to by its weight, with weighted sharing
+	fairShare *api.Resource
+	// pool represents the allocatable resources of the node pool the queue is bound to, nil if it is not bound
+	pool *api.Resource
 }
 
 // draQuotaAttr holds DRA quota tracking state for a queue
@@ -438,7 +451,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 	cp.parseArguments()
 
 	// Prepare scheduling data for this session.
-	cp.totalResource.Add(ssn.TotalResource)
+	cp.totalResource.Add(ssn.TotalResource.Normalized())
 
 	klog.V(4).Infof("The total resource is <%v>", cp.totalResource)
 
@@ -455,6 +468,17 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 	} else {
 		cp.buildQueueAttrs(ssn)
 	}
+	cp.buildSubQuotas(ssn)
+	now := time.Now()
+	if cp.burst.enable {
+		cp.updateBurstCredits(ssn, now)
+	}
+	cp.updateBurstBuckets(ssn, now)
+	if !hierarchyEnabled {
+		ssn.AddVictimQueueOrderFn(cp.Name(), func(l, r, preemptor interface{}) int {
+			return cp.compareBurstUsage(l.(*api.QueueInfo), r.(*api.QueueInfo))
+		})
+	}
 
 	ssn.AddReclaimableFn(cp.Name(), func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
 		var victims []*api.TaskInfo
@@ -497,6 +521,12 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 			klog.V(5).Infof("[capacity] Considering reclaimee <%s/%s> from queue <%s> for reclaimer <%s/%s>.",
 				reclaimee.Namespace, reclaimee.Name, attr.queueID, reclaimer.Namespace, reclaimer.Name)
 
+			if cp.bursting(job.Queue) {
+				klog.V(5).Infof("[capacity] Queue <%s> of reclaimee <%s/%s> has burst credits left, skip it.",
+					attr.name, reclaimee.Namespace, reclaimee.Name)
+				continue
+			}
+
 			// If reclaimee doesn't have intersecting resource dimensions with reclaimer we can skip it.
 			if skip, reason := cp.shouldSkipReclaimee(reclaimee, reclaimer); skip {
 				klog.V(5).Infof("%s, skip it.", reason)
@@ -591,9 +621,16 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 				continue
 			}
 
-			allocated.Sub(reclaimee.Resreq)
+			// The guarantee of the ancestors of the reclaimee is never reclaimed by a queue out of their subtree.
+			if hierarchyEnabled && !cp.checkAncestorGuarantees(reclaimerAttr, attr, reclaimee, allocations, ancestorAllocations) {
+				klog.V(5).Infof("[capacity] Skip reclaimee <%s/%s> from queue <%s>: reclaiming it breaks the guarantee of an ancestor queue.",
+					reclaimee.Namespace, reclaimee.Name, attr.name)
+				continue
+			}
+
+			allocated.Sub(reclaimee.Resreq.Normalized())
 			for _, ancestorAllocated := range ancestorAllocations {
-				ancestorAllocated.Sub(reclaimee.Resreq)
+				ancestorAllocated.Sub(reclaimee.Resreq.Normalized())
 			}
 			victims = append(victims, reclaimee)
 			klog.V(5).Infof("[capacity] Current victims: %+v.", victims)
@@ -620,8 +657,15 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 		var victims []*api.TaskInfo
 		allocations := map[api.QueueID]*api.Resource{}
+		var reclaimerAttr *queueAttr
+		if evictCtx.Job != nil {
+			reclaimerAttr = cp.queueOpts[evictCtx.Job.Queue]
+		}
 		for _, reclaimee := range candidates {
 			job := ssn.Jobs[reclaimee.Job]
+			if cp.bursting(job.Queue) {
+				continue
+			}
 			attr := cp.queueOpts[job.Queue]
 			if _, found := allocations[job.Queue]; !found {
 				allocations[job.Queue] = attr.allocated.Clone()
@@ -630,113 +674,30 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 			if satisfies, _ := cp.checkGuaranteeConstraint(allocated, reclaimee, attr.guarantee); !satisfies {
 				continue
 			}
-			if isVictim, _ := cp.isImmediateVictim(reclaimee, attr.deserved); isVictim {
-				allocated.Sub(reclaimee.Resreq)
-				victims = append(victims, reclaimee)
+			if isVictim, _ := cp.isImmediateVictim(reclaimee, attr.deserved); !isVictim {
+				if reclaimable, _ := allocated.GreaterPartlyWithRelevantDimensions(attr.deserved, reclaimee.Resreq.Normalized()); !reclaimable {
+					continue
+				}
+			}
+			ancestorAllocations := map[api.QueueID]*api.Resource{}
+			if hierarchyEnabled && reclaimerAttr != nil && !cp.checkAncestorGuarantees(reclaimerAttr, attr, reclaimee, allocations, ancestorAllocations) {
 				continue
 			}
-			reclaimable, _ := allocated.GreaterPartlyWithRelevantDimensions(attr.deserved, reclaimee.Resreq)
-			if reclaimable {
-				allocated.Sub(reclaimee.Resreq)
-				victims = append(victims, reclaimee)
+			allocated.Sub(reclaimee.Resreq.Normalized())
+			for _, ancestorAllocated := range ancestorAllocations {
+				ancestorAllocated.Sub(reclaimee.Resreq.Normalized())
 			}
+			victims = append(victims, reclaimee)
 		}
 		klog.V(4).Infof("[capacity] Victims from capacity UnifiedEvictableFn: victims=%+v", victims)
 		return victims, util.Permit
 	})
 
-	ssn.AddPreemptiveFn(cp.Name(), func(obj interface{}, candidates []*api.TaskInfo) bool {
-		if !readyToSchedule {
-			klog.V(3).Infof("Capacity plugin failed to check queue's hierarchical structure!")
-			return false
-		}
-
-		queue := obj.(*api.QueueInfo)
-		if queue.Queue.Status.State != scheduling.QueueStateOpen {
-			klog.V(3).Infof("Queue <%s> current state: %s, is not open state, can not reclaim for tasks.",
-				queue.Name, queue.Queue.Status.State)
-			return false
-		}
-
-		attr := cp.queueOpts[queue.UID]
-		totalReq := api.EmptyResource()
-		for _, task := range candidates {
-			if task != nil {
-				totalReq.Add(task.Resreq)
-			}
-		}
-		futureUsed := attr.allocated.Clone().Add(totalReq)
-
-		if allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.realCapability, totalReq); !allocatable {
-			klog.V(3).Infof("Queue <%v> cannot reclaim because futureUsed <%v> exceeds realCapability <%v>.",
-				queue.Name, futureUsed, attr.realCapability)
-			return false
-		}
-
-		// If there is a single dimension whose deserved is greater than allocated, current tasks can reclaim by preempting others.
-		isPreemptive, resourceNames := futureUsed.LessEqualPartlyWithDimensionZeroFiltered(attr.deserved, totalReq)
-		if isPreemptive {
-			klog.V(3).Infof("Queue <%v> can reclaim on resource dimensions: %v. "+
-				"The futureUsed: %v, deserved: %v, allocated: %v, tasks requested: %v",
-				queue.Name, resourceNames, futureUsed, attr.deserved, attr.allocated, totalReq)
-		} else {
-			klog.V(4).Infof("Queue <%v> itself can not reclaim, futureUsed: %v, deserved: %v, requested: %v",
-				queue.Name, futureUsed, attr.deserved, totalReq)
-			if hierarchyEnabled && cp.ancestorReclaimLevel > 0 {
-				for level := 1; level <= cp.ancestorReclaimLevel; level++ {
-					ancestorID, found := queueAncestorAtDepth(attr, level)
-					if !found || ancestorID == rootQueueID {
-						continue
-					}
-					ancestorAttr := cp.queueOpts[ancestorID]
-					if ancestorAttr == nil {
-						continue
-					}
-
-					futureUsedAncestor := ancestorAttr.allocated.Clone().Add(totalReq)
-					isPreemptive, resourceNames = futureUsedAncestor.LessEqualPartlyWithDimensionZeroFiltered(ancestorAttr.deserved, totalReq)
-					if isPreemptive {
-						klog.V(3).Infof("Queue's ancestor <%v> can reclaim on resource dimensions: %v. "+
-							"The futureUsedAncestor: %v, deserved: %v, allocated: %v, task requested: %v",
-							ancestorAttr.name, resourceNames, futureUsedAncestor, ancestorAttr.deserved, ancestorAttr.allocated, totalReq)
-						break
-					}
-				}
-			}
-			if !isPreemptive {
-				klog.V(4).Infof("Queue <%v> and its ancestors can not reclaim. futureUsed: %v, deserved: %v, requested: %v",
-					queue.Name, futureUsed, attr.deserved, totalReq)
-			}
-		}
-
-		// PreemptiveFn is the opposite of OverusedFn in proportion plugin cause as long as there is a one-dimensional
-		// resource whose deserved is greater than allocated, current tasks can reclaim by preempt others.
-		return isPreemptive
-	})
-
-	ssn.AddAllocatableFn(cp.Name(), func(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
-		if queue.Queue.Status.State != scheduling.QueueStateOpen {
-			klog.V(3).Infof("Queue <%s> current state: %s, cannot allocate task <%s>.", queue.Name, queue.Queue.Status.State, candidate.Name)
-			return false
-		}
-		if !readyToSchedule {
-			klog.V(3).Infof("Capacity plugin failed to check queue's hierarchical structure!")
-			return false
-		}
-		if hierarchyEnabled && !cp.isLeafQueue(queue.UID) {
-			klog.V(3).Infof("Queue <%s> is not a leaf queue, can not allocate task <%s>.", queue.Name, candidate.Name)
-			return false
-		}
-
-		allocatable := cp.checkQueueAllocatableHierarchically(ssn, queue, candidate)
-
-		// If queue has capacity and task has the QueueAllocationGate annotation.
-		if allocatable && utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) &&
-			api.HasQueueAllocationGateAnnotation(candidate.Pod) {
-			cp.addTaskToReservedCache(queue.UID, candidate)
-		}
-
-		return allocatable
+	ssn.AddQuotaProvider(cp.Name(), &quotaProvider{
+		capacityPlugin:   cp,
+		ssn:              ssn,
+		hierarchyEnabled: hierarchyEnabled,
+		readyToSchedule:  readyToSchedule,
 	})
 
 	ssn.AddJobEnqueueableFn(cp.Name(), func(obj interface{}) int {
@@ -759,6 +720,9 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 				queue.Name, queue.Queue.Status.State, job.Namespace, job.Name)
 			return util.Reject
 		}
+		if !cp.checkJobEnqueueableBySubQuota(ssn, job) {
+			return util.Reject
+		}
 		// If no capability is set, always enqueue the job.
 		if attr.realCapability == nil {
 			klog.V(4).Infof("Capability of queue <%s> was not set, allow job <%s/%s> to Inqueue.",
@@ -791,8 +755,11 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		if job.PodGroup.Spec.MinResources == nil && !(cp.dynamicResourceAllocationEnable && attr.dra != nil && job.GetMinDRAResources() != nil) {
 			return
 		}
-		deductedResources := job.DeductSchGatedResources(job.GetMinResources())
+		deductedResources := job.DeductSchGatedResources(job.GetMinResources()).Normalized()
 		attr.inqueue.Add(deductedResources)
+		if subQuota := cp.subQuotaOf(job); subQuota != nil {
+			subQuota.inqueue.Add(deductedResources)
+		}
 		var minDRAReq map[string]*api.DRAResource
 		if cp.dynamicResourceAllocationEnable && attr.dra != nil {
 			minDRAReq = job.GetMinDRAResources()
@@ -813,6 +780,14 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		klog.V(5).Infof("job <%s/%s> enqueued", job.Namespace, job.Name)
 	})
 
+	ssn.AddPredicateFn(cp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+		job, found := ssn.Jobs[task.Job]
+		if !found {
+			return nil
+		}
+		return util.NodePoolPredicate(task, node, ssn.Queues, job.Queue)
+	})
+
 	ssn.AddPrePredicateFn(cp.Name(), func(task *api.TaskInfo) error {
 		state := &capacityState{
 			queueAttrs: make(map[api.QueueID]*queueAttr),
@@ -840,7 +815,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		if attr == nil {
 			return fmt.Errorf("[capacity] queue %s not found", job.Queue)
 		}
-		attr.allocated.Add(taskToAdd.Resreq)
+		attr.allocated.Add(taskToAdd.Resreq.Normalized())
 		if cp.dynamicResourceAllocationEnable && attr.dra != nil && taskToAdd.DRAResreq != nil {
 			addTaskDRAAllocated(attr, taskToAdd)
 		}
@@ -848,7 +823,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		if hierarchyEnabled {
 			for _, ancestorID := range attr.ancestors {
 				ancestorAttr := state.queueAttrs[ancestorID]
-				ancestorAttr.allocated.Add(taskToAdd.Resreq)
+				ancestorAttr.allocated.Add(taskToAdd.Resreq.Normalized())
 				if cp.dynamicResourceAllocationEnable && ancestorAttr.dra != nil && taskToAdd.DRAResreq != nil {
 					addTaskDRAAllocated(ancestorAttr, taskToAdd)
 				}
@@ -870,7 +845,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		if attr == nil {
 			return fmt.Errorf("[capacity] queue %s not found", job.Queue)
 		}
-		attr.allocated.Sub(taskToRemove.Resreq)
+		attr.allocated.Sub(taskToRemove.Resreq.Normalized())
 		if cp.dynamicResourceAllocationEnable && attr.dra != nil && taskToRemove.DRAResreq != nil {
 			removeTaskDRAAllocated(attr, taskToRemove)
 		}
@@ -878,7 +853,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		if hierarchyEnabled {
 			for _, ancestorID := range attr.ancestors {
 				ancestorAttr := state.queueAttrs[ancestorID]
-				ancestorAttr.allocated.Sub(taskToRemove.Resreq)
+				ancestorAttr.allocated.Sub(taskToRemove.Resreq.Normalized())
 				if cp.dynamicResourceAllocationEnable && ancestorAttr.dra != nil && taskToRemove.DRAResreq != nil {
 					removeTaskDRAAllocated(ancestorAttr, taskToRemove)
 				}
@@ -936,17 +911,20 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 					event.Task.Namespace, event.Task.Name, job.Queue)
 				return
 			}
-			attr.allocated.Add(event.Task.Resreq)
+			attr.allocated.Add(event.Task.Resreq.Normalized())
 			if cp.dynamicResourceAllocationEnable && attr.dra != nil && event.Task.DRAResreq != nil {
 				addTaskDRAAllocated(attr, event.Task)
 			}
+			if subQuota := cp.subQuotaOf(job); subQuota != nil {
+				subQuota.allocated.Add(event.Task.Resreq.Normalized())
+			}
 			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 
 			cp.updateShare(attr)
 			if hierarchyEnabled {
 				for _, ancestorID := range attr.ancestors {
 					ancestorAttr := cp.queueOpts[ancestorID]
-					ancestorAttr.allocated.Add(event.Task.Resreq)
+					ancestorAttr.allocated.Add(event.Task.Resreq.Normalized())
 					if cp.dynamicResourceAllocationEnable && ancestorAttr.dra != nil && event.Task.DRAResreq != nil {
 						addTaskDRAAllocated(ancestorAttr, event.Task)
 					}
@@ -974,17 +952,20 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 					event.Task.Namespace, event.Task.Name, job.Queue)
 				return
 			}
-			attr.allocated.Sub(event.Task.Resreq)
+			attr.allocated.Sub(event.Task.Resreq.Normalized())
 			if cp.dynamicResourceAllocationEnable && attr.dra != nil && event.Task.DRAResreq != nil {
 				removeTaskDRAAllocated(attr, event.Task)
 			}
+			if subQuota := cp.subQuotaOf(job); subQuota != nil {
+				subQuota.allocated.Sub(event.Task.Resreq.Normalized())
+			}
 			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 
 			cp.updateShare(attr)
 			if hierarchyEnabled {
 				for _, ancestorID := range attr.ancestors {
 					ancestorAttr := cp.queueOpts[ancestorID]
-					ancestorAttr.allocated.Sub(event.Task.Resreq)
+					ancestorAttr.allocated.Sub(event.Task.Resreq.Normalized())
 					if cp.dynamicResourceAllocationEnable && ancestorAttr.dra != nil && event.Task.DRAResreq != nil {
 						removeTaskDRAAllocated(ancestorAttr, event.Task)
 					}
@@ -1014,6 +995,9 @@ func (cp *capacityPlugin) parseArguments() {
 
 	cp.ancestorReclaimLevel = ancestorReclaimLevel
 	klog.V(4).Infof("[capacity] reclaim ancestor level configured as %d", cp.ancestorReclaimLevel)
+
+	cp.parseBurstArguments()
+	cp.pluginArguments.GetBool(&cp.weightedSharing, WeightedSharingEnable)
 }
 
 func (cp *capacityPlugin) getReclaimeeAncestorToCheck(reclaimerAttr, reclaimeeAttr *queueAttr, level int) (*queueAttr, bool) {
@@ -1051,6 +1035,7 @@ func (cp *capacityPlugin) OnSessionClose(ssn *framework.Session) {
 	cp.totalGuarantee = nil
 	cp.queueOpts = nil
 	cp.queueGateReservedTasks = nil
+	cp.subQuotas = nil
 }
 
 func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
@@ -1058,7 +1043,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 		if len(queue.Queue.Spec.Guarantee.Resource) == 0 {
 			continue
 		}
-		guarantee := api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+		guarantee := api.NewResource(queue.Queue.Spec.Guarantee.Resource).Normalized()
 		cp.totalGuarantee.Add(guarantee)
 	}
 	klog.V(4).Infof("The total guarantee resource is <%v>", cp.totalGuarantee)
@@ -1071,7 +1056,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 				queueID: queue.UID,
 				name:    queue.Name,
 
-				deserved:          api.NewResource(queue.Queue.Spec.Deserved),
+				deserved:          api.NewResource(queue.Queue.Spec.Deserved).Normalized(),
 				allocated:         api.EmptyResource(),
 				request:           api.EmptyResource(),
 				elastic:           api.EmptyResource(),
@@ -1080,7 +1065,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 				resourceClaimRefs: make(map[string]int),
 			}
 			if len(queue.Queue.Spec.Capability) != 0 {
-				attr.capability = api.NewResource(queue.Queue.Spec.Capability)
+				attr.capability = api.NewResource(queue.Queue.Spec.Capability).Normalized()
 				if attr.capability.MilliCPU <= 0 {
 					attr.capability.MilliCPU = math.MaxFloat64
 				}
@@ -1090,7 +1075,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 			}
 			attr.dra = newDRAQuotaAttr(queue.Queue.Spec.Capability, queue.Queue.Spec.Deserved, queue.Queue.Spec.Guarantee.Resource)
 			if len(queue.Queue.Spec.Guarantee.Resource) != 0 {
-				attr.guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+				attr.guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource).Normalized()
 			}
 			realCapability := api.ExceededPart(cp.totalResource, cp.totalGuarantee).Add(attr.guarantee)
 			if attr.capability == nil {
@@ -1100,6 +1085,8 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 				realCapability.MinDimensionResource(attr.capability, api.Infinity)
 				attr.realCapability = realCapability
 			}
+			attr.pool = queue.PoolResource(ssn.Nodes)
+			attr.limitToNodePool()
 			cp.queueOpts[job.Queue] = attr
 			klog.V(4).Infof("Added Queue <%s> attributes.", job.Queue)
 		}
@@ -1108,15 +1095,15 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 		for status, tasks := range job.TaskStatusIndex {
 			if api.AllocatedStatus(status) {
 				for _, t := range tasks {
-					attr.allocated.Add(t.Resreq)
-					attr.request.Add(t.Resreq)
+					attr.allocated.Add(t.Resreq.Normalized())
+					attr.request.Add(t.Resreq.Normalized())
 					if cp.dynamicResourceAllocationEnable && attr.dra != nil && t.DRAResreq != nil {
 						addTaskDRAAllocated(attr, t)
 					}
 				}
 			} else if status == api.Pending {
 				for _, t := range tasks {
-					attr.request.Add(t.Resreq)
+					attr.request.Add(t.Resreq.Normalized())
 				}
 			}
 		}
@@ -1129,7 +1116,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 			// Without this deduction, the same resources appear in both attr.allocated and attr.inqueue.
 			if job.PodGroup.Spec.MinResources != nil {
 				inqueued := util.GetInqueueResource(job, job.Allocated)
-				attr.inqueue.Add(job.DeductSchGatedResources(inqueued))
+				attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
 			}
 		}
 
@@ -1140,9 +1127,9 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 			job.PodGroup.Spec.MinResources != nil &&
 			int32(util.CalculateAllocatedTaskNum(job)) >= job.PodGroup.Spec.MinMember {
 			inqueued := util.GetInqueueResource(job, job.Allocated)
-			attr.inqueue.Add(job.DeductSchGatedResources(inqueued))
+			attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
 		}
-		attr.elastic.Add(job.GetElasticResources())
+		attr.elastic.Add(job.GetElasticResources().Normalized())
 		klog.V(5).Infof("Queue %s allocated <%s> request <%s> inqueue <%s> elastic <%s>",
 			attr.name, attr.allocated.String(), attr.request.String(), attr.inqueue.String(), attr.elastic.String())
 	}
@@ -1174,7 +1161,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 		}
 		deservedCPU, deservedMem, scalarResources := 0.0, 0.0, map[v1.ResourceName]float64{}
 		if queue.Queue.Spec.Deserved != nil {
-			attr := api.NewResource(queue.Queue.Spec.Deserved)
+			attr := api.NewResource(queue.Queue.Spec.Deserved).Normalized()
 			deservedCPU = attr.MilliCPU
 			deservedMem = attr.Memory
 			scalarResources = attr.ScalarResources
@@ -1185,28 +1172,16 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 		metrics.UpdateQueueInqueue(queueInfo.Name, 0, 0, map[v1.ResourceName]float64{})
 		guarantee := api.EmptyResource()
 		if len(queue.Queue.Spec.Guarantee.Resource) != 0 {
-			guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+			guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource).Normalized()
 		}
 		realCapacity := api.ExceededPart(cp.totalResource, cp.totalGuarantee).Add(guarantee)
 		if len(queue.Queue.Spec.Capability) > 0 {
-			capacity := api.NewResource(queue.Queue.Spec.Capability)
+			capacity := api.NewResource(queue.Queue.Spec.Capability).Normalized()
 			realCapacity.MinDimensionResource(capacity, api.Infinity)
 			metrics.UpdateQueueCapacity(queueInfo.Name, capacity.MilliCPU, capacity.Memory, capacity.ScalarResources)
 		}
 		metrics.UpdateQueueRealCapacity(queueInfo.Name, realCapacity.MilliCPU, realCapacity.Memory, realCapacity.ScalarResources)
 	}
-
-	ssn.AddQueueOrderFn(cp.Name(), func(l, r interface{}) int {
-		lv := l.(*api.QueueInfo)
-		rv := r.(*api.QueueInfo)
-
-		if lv.Queue.Spec.Priority != rv.Queue.Spec.Priority {
-			// return negative means high priority
-			return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
-		}
-
-		return cp.compareShareWithDeserved(cp.queueOpts[lv.UID], cp.queueOpts[rv.UID])
-	})
 }
 
 func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bool {
@@ -1220,7 +1195,7 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 			continue
 		}
 
-		attr := cp.newQueueAttr(queue)
+		attr := cp.newQueueAttr(queue, ssn.Nodes)
 		cp.queueOpts[queue.UID] = attr
 		visited := make(map[api.QueueID]struct{})
 		err := cp.updateAncestors(queue, ssn, visited)
@@ -1255,15 +1230,15 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 		for status, tasks := range job.TaskStatusIndex {
 			if api.AllocatedStatus(status) {
 				for _, t := range tasks {
-					attr.allocated.Add(t.Resreq)
-					attr.request.Add(t.Resreq)
+					attr.allocated.Add(t.Resreq.Normalized())
+					attr.request.Add(t.Resreq.Normalized())
 					if cp.dynamicResourceAllocationEnable && attr.dra != nil && t.DRAResreq != nil {
 						addTaskDRAAllocated(attr, t)
 					}
 				}
 			} else if status == api.Pending {
 				for _, t := range tasks {
-					attr.request.Add(t.Resreq)
+					attr.request.Add(t.Resreq.Normalized())
 				}
 			}
 		}
@@ -1273,7 +1248,7 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 			// so tasks in Allocated/Binding state are not counted in both attr.allocated and attr.inqueue.
 			if job.PodGroup.Spec.MinResources != nil {
 				inqueued := util.GetInqueueResource(job, job.Allocated)
-				attr.inqueue.Add(job.DeductSchGatedResources(inqueued))
+				attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
 			}
 		}
 
@@ -1284,9 +1259,9 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 			job.PodGroup.Spec.MinResources != nil &&
 			int32(util.CalculateAllocatedTaskNum(job)) >= job.PodGroup.Spec.MinMember {
 			inqueued := util.GetInqueueResource(job, job.Allocated)
-			attr.inqueue.Add(job.DeductSchGatedResources(inqueued))
+			attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
 		}
-		attr.elastic.Add(job.GetElasticResources())
+		attr.elastic.Add(job.GetElasticResources().Normalized())
 
 		allocatedDelta := attr.allocated.Clone().Sub(oldAllocated)
 		requestDelta := attr.request.Clone().Sub(oldRequest)
@@ -1343,6 +1318,9 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 	// checkHierarchicalQueue only logs warnings and never returns errors
 	// to avoid aborting the entire scheduling cycle due to configuration issues
 	cp.checkHierarchicalQueue(rootQueueAttr)
+	if cp.weightedSharing {
+		cp.updateFairShares(rootQueueAttr)
+	}
 
 	// Update share
 	for _, attr := range cp.queueOpts {
@@ -1362,51 +1340,25 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 		metrics.UpdateQueueRealCapacity(attr.name, attr.realCapability.MilliCPU, attr.realCapability.Memory, attr.realCapability.ScalarResources)
 	}
 
-	ssn.AddQueueOrderFn(cp.Name(), func(l, r interface{}) int {
-		lv := l.(*api.QueueInfo)
-		rv := r.(*api.QueueInfo)
-
-		if lv.Queue.Spec.Priority != rv.Queue.Spec.Priority {
-			// return negative means high priority
-			return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
-		}
-
-		lvLeaf := cp.isLeafQueue(lv.UID)
-		rvLeaf := cp.isLeafQueue(rv.UID)
-
-		if lvLeaf && !rvLeaf {
-			return -1
-		} else if !lvLeaf && rvLeaf {
-			return 1
-		} else if !lvLeaf && !rvLeaf {
-			return cp.compareShareWithDeserved(cp.queueOpts[lv.UID], cp.queueOpts[rv.UID])
-		}
-
-		lvAttr := cp.queueOpts[lv.UID]
-		rvAttr := cp.queueOpts[rv.UID]
-		level := getQueueLevel(lvAttr, rvAttr)
-		lvParentID := lvAttr.queueID
-		rvParentID := rvAttr.queueID
-		if level+1 < len(lvAttr.ancestors) {
-			lvParentID = lvAttr.ancestors[level+1]
-		}
-		if level+1 < len(rvAttr.ancestors) {
-			rvParentID = rvAttr.ancestors[level+1]
-		}
-
-		return cp.compareShareWithDeserved(cp.queueOpts[lvParentID], cp.queueOpts[rvParentID])
-	})
-
 	ssn.AddVictimQueueOrderFn(cp.Name(), func(l, r, preemptor interface{}) int {
 		lv := l.(*api.QueueInfo)
 		rv := r.(*api.QueueInfo)
 		pv := preemptor.(*api.QueueInfo)
 
+		if ret := cp.compareBurstUsage(lv, rv); ret != 0 {
+			return ret
+		}
+
 		lLevel := getQueueLevel(cp.queueOpts[lv.UID], cp.queueOpts[pv.UID])
 		rLevel := getQueueLevel(cp.queueOpts[rv.UID], cp.queueOpts[pv.UID])
 
 		if lLevel == rLevel {
-			return 0
+			if !cp.weightedSharing {
+				return 0
+			}
+			// The branch above its weighted share is reclaimed first.
+			lID, rID := siblingBranches(cp.queueOpts[lv.UID], cp.queueOpts[rv.UID])
+			return cp.compareWeightedShares(cp.queueOpts[rID], cp.queueOpts[lID])
 		}
 
 		if lLevel > rLevel {
@@ -1419,14 +1371,15 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 	return true
 }
 
-func (cp *capacityPlugin) newQueueAttr(queue *api.QueueInfo) *queueAttr {
+func (cp *capacityPlugin) newQueueAttr(queue *api.QueueInfo, nodes map[string]*api.NodeInfo) *queueAttr {
 	attr := &queueAttr{
 		queueID:   queue.UID,
 		name:      queue.Name,
+		weight:    queue.Weight,
 		ancestors: make([]api.QueueID, 0),
 		children:  make(map[api.QueueID]*queueAttr),
 
-		deserved:          api.NewResource(queue.Queue.Spec.Deserved),
+		deserved:          api.NewResource(queue.Queue.Spec.Deserved).Normalized(),
 		allocated:         api.EmptyResource(),
 		request:           api.EmptyResource(),
 		elastic:           api.EmptyResource(),
@@ -1435,13 +1388,14 @@ func (cp *capacityPlugin) newQueueAttr(queue *api.QueueInfo) *queueAttr {
 		capability:        api.EmptyResource(),
 		realCapability:    api.EmptyResource(),
 		resourceClaimRefs: make(map[string]int),
+		pool:              queue.PoolResource(nodes),
 	}
 	if len(queue.Queue.Spec.Capability) != 0 {
-		attr.capability = api.NewResource(queue.Queue.Spec.Capability)
+		attr.capability = api.NewResource(queue.Queue.Spec.Capability).Normalized()
 	}
 
 	if len(queue.Queue.Spec.Guarantee.Resource) != 0 {
-		attr.guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+		attr.guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource).Normalized()
 	}
 
 	attr.dra = newDRAQuotaAttr(queue.Queue.Spec.Capability, queue.Queue.Spec.Deserved, queue.Queue.Spec.Guarantee.Resource)
@@ -1471,7 +1425,7 @@ func (cp *capacityPlugin) updateAncestors(queue *api.QueueInfo, ssn *framework.S
 
 	parentInfo := ssn.Queues[api.QueueID(parent)]
 	if _, found := cp.queueOpts[parentInfo.UID]; !found {
-		parentAttr := cp.newQueueAttr(parentInfo)
+		parentAttr := cp.newQueueAttr(parentInfo, ssn.Nodes)
 		cp.queueOpts[parentAttr.queueID] = parentAttr
 		err := cp.updateAncestors(parentInfo, ssn, visited)
 		if err != nil {
@@ -1533,6 +1487,7 @@ func (cp *capacityPlugin) checkHierarchicalQueue(attr *queueAttr) {
 			realCapability.MinDimensionResource(childAttr.capability, api.Infinity)
 			childAttr.realCapability = realCapability
 		}
+		childAttr.limitToNodePool()
 	}
 
 	// Check if the parent queue's deserved resources are less than the total deserved resources of child queues
@@ -1555,6 +1510,16 @@ func (cp *capacityPlugin) checkHierarchicalQueue(attr *queueAttr) {
 	}
 }
 
+// limitToNodePool limits the deserved resources and the real capability of the queue to the resources of the node
+// pool it is bound to, if any.
+func (qa *queueAttr) limitToNodePool() {
+	if qa.pool == nil {
+		return
+	}
+	qa.deserved.MinDimensionResource(qa.pool, api.Zero)
+	qa.realCapability.MinDimensionResource(qa.pool, api.Zero)
+}
+
 // compareShareWithDeserved compares two queueAttr by share; when shares are equal,
 // queues with non-empty deserved are prioritized over best-effort queues.
 // Returns negative if l should come before r.
@@ -1659,18 +1624,22 @@ func (cp *capacityPlugin) queueAllocatableWithReserved(attr *queueAttr, candidat
 		for _, task := range queueGateReserved {
 			if task.UID != candidate.UID {
 				// Skip candidate to avoid double-counting (it will be added in futureUsed below)
-				reserved.Add(task.Resreq)
+				reserved.Add(task.Resreq.Normalized())
 			}
 		}
 	}
 
 	// Include reserved resources in capacity check
-	futureUsed := attr.allocated.Clone().Add(reserved).Add(candidate.Resreq)
-	allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.realCapability, candidate.Resreq)
+	futureUsed := attr.allocated.Clone().Add(reserved).Add(candidate.Resreq.Normalized())
+	allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.realCapability, candidate.Resreq.Normalized())
 
 	if !allocatable {
 		klog.V(3).Infof("Queue <%v>: realCapability <%v>, allocated <%v>, reserved <%v>; Candidate <%v>: resource request <%v>",
 			queue.Name, attr.realCapability, attr.allocated, reserved, candidate.Name, candidate.Resreq)
+	} else if !burstAllowed(attr, futureUsed, candidate.Resreq.Normalized()) {
+		klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>, reserved <%v>, no burst tokens left; Candidate <%v>: resource request <%v>",
+			queue.Name, attr.deserved, attr.allocated, reserved, candidate.Name, candidate.Resreq)
+		allocatable = false
 	}
 
 	return allocatable
@@ -1696,7 +1665,7 @@ func (cp *capacityPlugin) checkQueueAllocatableHierarchically(ssn *framework.Ses
 
 func (cp *capacityPlugin) jobEnqueueable(queue *api.QueueInfo, job *api.JobInfo) (bool, []string) {
 	attr := cp.queueOpts[queue.UID]
-	minReq := job.GetMinResources()
+	minReq := job.GetMinResources().Normalized()
 
 	klog.V(5).Infof("job %s min resource <%s>, queue %s capability <%s> allocated <%s> inqueue <%s> elastic <%s>",
 		job.Name, minReq.String(), queue.Name, attr.realCapability.String(), attr.allocated.String(), attr.inqueue.String(), attr.elastic.String())
@@ -1770,6 +1739,7 @@ func (qa *queueAttr) Clone() *queueAttr {
 		queueID:           qa.queueID,
 		name:              qa.name,
 		share:             qa.share,
+		weight:            qa.weight,
 		deserved:          qa.deserved.Clone(),
 		allocated:         qa.allocated.Clone(),
 		request:           qa.request.Clone(),
@@ -1783,6 +1753,13 @@ func (qa *queueAttr) Clone() *queueAttr {
 		children:          make(map[api.QueueID]*queueAttr),
 	}
 
+	if qa.fairShare != nil {
+		cloned.fairShare = qa.fairShare.Clone()
+	}
+	if qa.pool != nil {
+		cloned.pool = qa.pool.Clone()
+	}
+
 	if len(qa.ancestors) > 0 {
 		cloned.ancestors = make([]api.QueueID, len(qa.ancestors))
 		copy(cloned.ancestors, qa.ancestors)
@@ -1846,7 +1823,7 @@ func updateQueueAttrShare(attr *queueAttr) {
 // shouldSkipReclaimee checks if a reclaimee should be skipped based on whether it has
 // intersecting resource dimensions with the reclaimer. Returns true if should skip, with a reason message.
 func (cp *capacityPlugin) shouldSkipReclaimee(reclaimee, reclaimer *api.TaskInfo) (bool, string) {
-	reclaimerIntersecting := len(api.IntersectionWithIgnoredScalarResources(reclaimee.Resreq, reclaimer.InitResreq)) > 0
+	reclaimerIntersecting := len(api.IntersectionWithIgnoredScalarResources(reclaimee.Resreq.Normalized(), reclaimer.InitResreq.Normalized())) > 0
 	if !reclaimerIntersecting {
 		return true, fmt.Sprintf("[capacity] Reclaimee <%s/%s>: <%v> does not have intersecting resource dimensions with reclaimer <%s/%s>: <%v>",
 			reclaimee.Namespace, reclaimee.Name, reclaimee.Resreq, reclaimer.Namespace, reclaimer.Name, reclaimer.InitResreq)
@@ -1861,11 +1838,41 @@ func (cp *capacityPlugin) checkGuaranteeConstraint(
 	reclaimee *api.TaskInfo,
 	guarantee *api.Resource,
 ) (bool, *api.Resource) {
-	exceptReclaimee := allocated.Clone().Sub(reclaimee.Resreq)
+	exceptReclaimee := allocated.Clone().Sub(reclaimee.Resreq.Normalized())
 	reclaimable := guarantee.LessEqual(exceptReclaimee, api.Zero)
 	return reclaimable, exceptReclaimee
 }
 
+// checkAncestorGuarantees returns whether reclaiming the reclaimee keeps the guarantee of the ancestors of its queue
+// which are not ancestors of the queue of the reclaimer, and records their allocations to update once it is reclaimed.
+func (cp *capacityPlugin) checkAncestorGuarantees(
+	reclaimerAttr, reclaimeeAttr *queueAttr,
+	reclaimee *api.TaskInfo,
+	allocations, ancestorAllocations map[api.QueueID]*api.Resource,
+) bool {
+	shared := make(map[api.QueueID]struct{}, len(reclaimerAttr.ancestors))
+	for _, ancestorID := range reclaimerAttr.ancestors {
+		shared[ancestorID] = struct{}{}
+	}
+	for _, ancestorID := range reclaimeeAttr.ancestors {
+		if _, found := shared[ancestorID]; found {
+			continue
+		}
+		ancestorAttr := cp.queueOpts[ancestorID]
+		if ancestorAttr == nil || ancestorAttr.guarantee.IsEmpty() {
+			continue
+		}
+		if _, found := allocations[ancestorID]; !found {
+			allocations[ancestorID] = ancestorAttr.allocated.Clone()
+		}
+		if satisfies, _ := cp.checkGuaranteeConstraint(allocations[ancestorID], reclaimee, ancestorAttr.guarantee); !satisfies {
+			return false
+		}
+		ancestorAllocations[ancestorID] = allocations[ancestorID]
+	}
+	return true
+}
+
 // isImmediateVictim checks if a reclaimee is an immediate victim because it has no
 // intersecting resource dimensions with the queue's deserved resources.
 // Returns true if it's an immediate victim, with a reason message.
@@ -1884,7 +1891,7 @@ func hasRelevantDeserved(task *api.TaskInfo, deserved *api.Resource) bool {
 	if task == nil || deserved == nil {
 		return false
 	}
-	return len(api.Intersection(task.Resreq, deserved)) > 0
+	return len(api.Intersection(task.Resreq.Normalized(), deserved)) > 0
 }
 
 // checkDeservedExceedance checks if the queue's allocated resources exceed its deserved resources
@@ -1897,7 +1904,7 @@ func (cp *capacityPlugin) checkDeservedExceedance(
 	reclaimer *api.TaskInfo,
 	queueName string,
 ) (bool, []string, string) {
-	reclaimable, dims := allocated.GreaterPartlyWithRelevantDimensions(deserved, reclaimee.Resreq)
+	reclaimable, dims := allocated.GreaterPartlyWithRelevantDimensions(deserved, reclaimee.Resreq.Normalized())
 	if !reclaimable {
 		reason := fmt.Sprintf(
 			"[capacity] Queue <%v> allocated resources are not greater than deserved on any relevant dimension of reclaimee. "+
@@ -505,6 +505,83 @@ func TestEnqueueAndAllocatable(t *testing.T) {
 	}
 }
 
+func TestNodePool(t *testing.T) {
+	// Queue q1 is bound to the gpu pool of node n1, q2 runs 1 CPU of it.
+	n1 := util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"pool": "gpu"})
+	n2 := util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"pool": "cpu"})
+
+	res1c1g := api.BuildResourceList("1", "1G")
+	res3c1g := api.BuildResourceList("3", "1G")
+	res6c2g := api.BuildResourceList("6", "2G")
+	p1 := util.BuildPod("ns1", "pod1", "n1", corev1.PodRunning, res1c1g, "pg1", nil, nil)
+	p2 := util.BuildPod("ns1", "pod2", "", corev1.PodPending, res3c1g, "pg2", nil, nil)
+	p3 := util.BuildPod("ns1", "pod3", "", corev1.PodPending, res3c1g, "pg3", nil, nil)
+	p4 := util.BuildPod("ns1", "pod4", "", corev1.PodPending, res3c1g, "pg3", nil, nil)
+
+	pg1 := util.BuildPodGroup("pg1", "ns1", "q2", 1, nil, schedulingv1beta1.PodGroupRunning)
+	pg2 := util.BuildPodGroup("pg2", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupPending)
+	pg3 := util.BuildPodGroup("pg3", "ns1", "q1", 2, nil, schedulingv1beta1.PodGroupPending)
+	pg1.Spec.MinResources = &res1c1g
+	pg2.Spec.MinResources = &res3c1g
+	pg3.Spec.MinResources = &res6c2g
+
+	queue1 := util.BuildQueueWithResourcesQuantity("q1", nil, nil)
+	queue1.Spec.NodeSelector = map[string]string{"pool": "gpu"}
+	queue2 := util.BuildQueueWithResourcesQuantity("q2", nil, nil)
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               PluginName,
+					EnabledAllocatable: &trueValue,
+					EnablePreemptive:   &trueValue,
+					EnabledOverused:    &trueValue,
+					EnabledJobEnqueued: &trueValue,
+					EnabledPredicate:   &trueValue,
+				},
+			},
+		},
+	}
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:           "case0: the job of a queue bound to a node pool is allocated in the pool",
+			Plugins:        plugins,
+			Pods:           []*corev1.Pod{p1, p2},
+			Nodes:          []*corev1.Node{n1, n2},
+			PodGroups:      []*schedulingv1beta1.PodGroup{pg1, pg2},
+			Queues:         []*schedulingv1beta1.Queue{queue1, queue2},
+			ExpectBindsNum: 1,
+			ExpectBindMap:  map[string]string{"ns1/pod2": "n1"},
+		},
+		{
+			Name:           "case1: the job of a queue bound to a node pool exceeding the pool can not enqueue",
+			Plugins:        plugins,
+			Pods:           []*corev1.Pod{p1, p3, p4},
+			Nodes:          []*corev1.Node{n1, n2},
+			PodGroups:      []*schedulingv1beta1.PodGroup{pg1, pg3},
+			Queues:         []*schedulingv1beta1.Queue{queue1, queue2},
+			ExpectBindsNum: 0,
+			ExpectBindMap:  map[string]string{},
+		},
+	}
+	actions := []framework.Action{enqueue.New(), allocate.New()}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(actions)
+
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 func Test_capacityPlugin_OnSessionOpenWithHierarchy(t *testing.T) {
 	plugins := map[string]framework.PluginBuilder{PluginName: New, predicates.PluginName: predicates.New, gang.PluginName: gang.New}
 	trueValue := true
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestReclaimKeepsAncestorGuarantee(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New, predicates.PluginName: predicates.New, gang.PluginName: gang.New}
+	trueValue := true
+
+	// Queue qa1 runs 4 CPUs without deserved resources, all the memory its parent qa is guaranteed, and queue qb needs
+	// 1 CPU of the full node back. Only the pod p1 of qa1 is preemptable.
+	n1 := util.BuildNode("n1", api.BuildResourceList("7", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	pods := []*corev1.Pod{}
+	podGroups := []*schedulingv1beta1.PodGroup{}
+	for i := 1; i <= 7; i++ {
+		name, queue := fmt.Sprintf("p%d", i), "qa1"
+		if i > 4 {
+			queue = "qb"
+		}
+		preemptable := map[string]string{schedulingv1beta1.PodPreemptable: strconv.FormatBool(i == 1)}
+		pods = append(pods, util.BuildPod("ns1", name, "n1", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1-"+queue, preemptable, nil))
+	}
+	pods = append(pods, util.BuildPod("ns1", "p8", "", corev1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2-qb", nil, nil))
+	podGroups = append(podGroups,
+		util.BuildPodGroup("pg1-qa1", "ns1", "qa1", 1, nil, schedulingv1beta1.PodGroupRunning),
+		util.BuildPodGroup("pg1-qb", "ns1", "qb", 1, nil, schedulingv1beta1.PodGroupRunning),
+		util.BuildPodGroup("pg2-qb", "ns1", "qb", 1, nil, schedulingv1beta1.PodGroupInqueue),
+	)
+
+	buildQueues := func(guarantee corev1.ResourceList) []*schedulingv1beta1.Queue {
+		qa := buildQueueWithParents("qa", "root", api.BuildResourceList("1", "4Gi"), nil)
+		qa.Spec.Guarantee.Resource = guarantee
+		return []*schedulingv1beta1.Queue{
+			buildQueueWithParents("root", "", nil, nil),
+			qa,
+			buildQueueWithParents("qa1", "qa", nil, nil),
+			buildQueueWithParents("qb", "root", api.BuildResourceList("4", "4Gi"), nil),
+		}
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:           "reclaim from the child of a queue without guarantee",
+			Plugins:        plugins,
+			Pods:           pods,
+			Nodes:          []*corev1.Node{n1},
+			PodGroups:      podGroups,
+			Queues:         buildQueues(nil),
+			ExpectEvicted:  []string{"ns1/p1"},
+			ExpectEvictNum: 1,
+		},
+		{
+			Name:           "no reclaim from the child of a queue below its guarantee",
+			Plugins:        plugins,
+			Pods:           pods,
+			Nodes:          []*corev1.Node{n1},
+			PodGroups:      podGroups,
+			Queues:         buildQueues(corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}),
+			ExpectEvictNum: 0,
+		},
+	}
+
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               PluginName,
+					EnabledAllocatable: &trueValue,
+					EnablePreemptive:   &trueValue,
+					EnabledReclaimable: &trueValue,
+					EnabledQueueOrder:  &trueValue,
+					EnabledHierarchy:   &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+				{
+					Name:               gang.PluginName,
+					EnabledJobStarving: &trueValue,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{reclaim.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/features"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// quotaProvider is the capacity plugin as the quota provider of a session.
+type quotaProvider struct {
+	*capacityPlugin
+	ssn              *framework.Session
+	hierarchyEnabled bool
+	readyToSchedule  bool
+}
+
+// QueueOrder orders the queues by priority, then by share of their deserved resources. With hierarchy, the leaf
+// queues are scheduled first, and two leaf queues are ordered by their ancestors under their common ancestor, by
+// their weighted share with weighted sharing.
+func (qp *quotaProvider) QueueOrder(lv, rv *api.QueueInfo) int {
+	if !qp.hierarchyEnabled {
+		return qp.flatQueueOrder(lv, rv)
+	}
+	if !qp.readyToSchedule {
+		return 0
+	}
+	if lv.Queue.Spec.Priority != rv.Queue.Spec.Priority {
+		// return negative means high priority
+		return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
+	}
+
+	lvLeaf := qp.isLeafQueue(lv.UID)
+	rvLeaf := qp.isLeafQueue(rv.UID)
+
+	if lvLeaf && !rvLeaf {
+		return -1
+	} else if !lvLeaf && rvLeaf {
+		return 1
+	} else if !lvLeaf && !rvLeaf {
+		return qp.compareShareWithDeserved(qp.queueOpts[lv.UID], qp.queueOpts[rv.UID])
+	}
+
+	lvParentID, rvParentID := siblingBranches(qp.queueOpts[lv.UID], qp.queueOpts[rv.UID])
+
+	return qp.compareWeightedShares(qp.queueOpts[lvParentID], qp.queueOpts[rvParentID])
+}
+
+func (qp *quotaProvider) flatQueueOrder(lv, rv *api.QueueInfo) int {
+	if lv.Queue.Spec.Priority != rv.Queue.Spec.Priority {
+		// return negative means high priority
+		return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
+	}
+
+	return qp.compareShareWithDeserved(qp.queueOpts[lv.UID], qp.queueOpts[rv.UID])
+}
+
+// Overused returns false: the capacity plugin limits the queues by their capability in Allocatable.
+func (qp *quotaProvider) Overused(queue *api.QueueInfo) bool {
+	return false
+}
+
+// Allocatable returns whether the task fits the capability of the queue and of its ancestors.
+func (qp *quotaProvider) Allocatable(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
+	if queue.Queue.Status.State != scheduling.QueueStateOpen {
+		klog.V(3).Infof("Queue <%s> current state: %s, cannot allocate task <%s>.", queue.Name, queue.Queue.Status.State, candidate.Name)
+		return false
+	}
+	if !qp.readyToSchedule {
+		klog.V(3).Infof("Capacity plugin failed to check queue's hierarchical structure!")
+		return false
+	}
+	if qp.hierarchyEnabled && !qp.isLeafQueue(queue.UID) {
+		klog.V(3).Infof("Queue <%s> is not a leaf queue, can not allocate task <%s>.", queue.Name, candidate.Name)
+		return false
+	}
+
+	allocatable := qp.checkQueueAllocatableHierarchically(qp.ssn, queue, candidate) &&
+		qp.checkTaskAllocatableBySubQuota(qp.ssn, candidate)
+
+	// If queue has capacity and task has the QueueAllocationGate annotation.
+	if allocatable && utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) &&
+		api.HasQueueAllocationGateAnnotation(candidate.Pod) {
+		qp.addTaskToReservedCache(queue.UID, candidate)
+	}
+
+	return allocatable
+}
+
+// Resizable returns whether the queue and its ancestors stay within their capability with the pending resize of the
+// task, in the resource dimensions of the resize.
+func (qp *quotaProvider) Resizable(queue *api.QueueInfo, task *api.TaskInfo) bool {
+	attr := qp.queueOpts[queue.UID]
+	if attr == nil || task.ResizeReq == nil {
+		return true
+	}
+
+	list := append(append([]api.QueueID{}, attr.ancestors...), queue.UID)
+	for i := len(list) - 1; i >= 0; i-- {
+		queueAttr := qp.queueOpts[list[i]]
+		if queueAttr == nil || queueAttr.realCapability == nil {
+			continue
+		}
+		if resizable, _ := queueAttr.allocated.LessEqualWithDimensionAndResourcesName(queueAttr.realCapability, task.ResizeReq); !resizable {
+			klog.V(3).Infof("Queue <%v>: realCapability <%v>, allocated <%v>; Task <%v/%v> resize request <%v>",
+				queueAttr.name, queueAttr.realCapability, queueAttr.allocated, task.Namespace, task.Name, task.ResizeReq)
+			return false
+		}
+	}
+	return true
+}
+
+// Preemptive returns whether the candidates fit the capability of the queue, and the deserved resources of the queue,
+// or of one of its ancestors up to the ancestor reclaim level, in one resource dimension at least.
+func (qp *quotaProvider) Preemptive(queue *api.QueueInfo, candidates []*api.TaskInfo) bool {
+	if !qp.readyToSchedule {
+		klog.V(3).Infof("Capacity plugin failed to check queue's hierarchical structure!")
+		return false
+	}
+
+	if queue.Queue.Status.State != scheduling.QueueStateOpen {
+		klog.V(3).Infof("Queue <%s> current state: %s, is not open state, can not reclaim for tasks.",
+			queue.Name, queue.Queue.Status.State)
+		return false
+	}
+
+	attr := qp.queueOpts[queue.UID]
+	totalReq := api.EmptyResource()
+	for _, task := range candidates {
+		if task != nil {
+			totalReq.Add(task.Resreq.Normalized())
+		}
+	}
+	futureUsed := attr.allocated.Clone().Add(totalReq)
+
+	if allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.realCapability, totalReq); !allocatable {
+		klog.V(3).Infof("Queue <%v> cannot reclaim because futureUsed <%v> exceeds realCapability <%v>.",
+			queue.Name, futureUsed, attr.realCapability)
+		return false
+	}
+
+	// If there is a single dimension whose deserved is greater than allocated, current tasks can reclaim by preempting others.
+	isPreemptive, resourceNames := futureUsed.LessEqualPartlyWithDimensionZeroFiltered(attr.deserved, totalReq)
+	if isPreemptive {
+		klog.V(3).Infof("Queue <%v> can reclaim on resource dimensions: %v. "+
+			"The futureUsed: %v, deserved: %v, allocated: %v, tasks requested: %v",
+			queue.Name, resourceNames, futureUsed, attr.deserved, attr.allocated, totalReq)
+	} else {
+		klog.V(4).Infof("Queue <%v> itself can not reclaim, futureUsed: %v, deserved: %v, requested: %v",
+			queue.Name, futureUsed, attr.deserved, totalReq)
+		if qp.hierarchyEnabled && qp.ancestorReclaimLevel > 0 {
+			for level := 1; level <= qp.ancestorReclaimLevel; level++ {
+				ancestorID, found := queueAncestorAtDepth(attr, level)
+				if !found || ancestorID == rootQueueID {
+					continue
+				}
+				ancestorAttr := qp.queueOpts[ancestorID]
+				if ancestorAttr == nil {
+					continue
+				}
+
+				futureUsedAncestor := ancestorAttr.allocated.Clone().Add(totalReq)
+				isPreemptive, resourceNames = futureUsedAncestor.LessEqualPartlyWithDimensionZeroFiltered(ancestorAttr.deserved, totalReq)
+				if isPreemptive {
+					klog.V(3).Infof("Queue's ancestor <%v> can reclaim on resource dimensions: %v. "+
+						"The futureUsedAncestor: %v, deserved: %v, allocated: %v, task requested: %v",
+						ancestorAttr.name, resourceNames, futureUsedAncestor, ancestorAttr.deserved, ancestorAttr.allocated, totalReq)
+					break
+				}
+			}
+		}
+		if !isPreemptive {
+			klog.V(4).Infof("Queue <%v> and its ancestors can not reclaim. futureUsed: %v, deserved: %v, requested: %v",
+				queue.Name, futureUsed, attr.deserved, totalReq)
+		}
+	}
+
+	// PreemptiveFn is the opposite of OverusedFn in proportion plugin cause as long as there is a one-dimensional
+	// resource whose deserved is greater than allocated, current tasks can reclaim by preempt others.
+	return isPreemptive
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestQuotaProviderConformance(t *testing.T) {
+	n1 := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string))
+
+	p1 := util.BuildPod("ns1", "p1", "n1", apiv1.PodRunning, api.BuildResourceList("3", "6Gi"), "pg1", make(map[string]string), make(map[string]string))
+	p2 := util.BuildPod("ns1", "p2", "", apiv1.PodPending, api.BuildResourceList("1", "2Gi"), "pg1", make(map[string]string), make(map[string]string))
+	p3 := util.BuildPod("ns1", "p3", "", apiv1.PodPending, api.BuildResourceList("1", "2Gi"), "pg2", make(map[string]string), make(map[string]string))
+	p4 := util.BuildPod("ns1", "p4", "", apiv1.PodPending, api.BuildResourceList("1", "2Gi"), "pg3", make(map[string]string), make(map[string]string))
+
+	pg1 := util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning)
+	pg2 := util.BuildPodGroup("pg2", "ns1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue)
+	pg3 := util.BuildPodGroup("pg3", "ns1", "q3", 1, nil, schedulingv1beta1.PodGroupInqueue)
+
+	q1 := util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("2", "4Gi"), nil)
+	q2 := util.BuildQueueWithResourcesQuantity("q2", api.BuildResourceList("2", "4Gi"), nil)
+	q3 := util.BuildQueueWithState("q3", 1, api.BuildResourceList("2", "4Gi"), schedulingv1beta1.QueueStateClosed)
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:      "overused, open and closed queues",
+			Pods:      []*apiv1.Pod{p1, p2, p3, p4},
+			Nodes:     []*apiv1.Node{n1},
+			PodGroups: []*schedulingv1beta1.PodGroup{pg1, pg2, pg3},
+			Queues:    []*schedulingv1beta1.Queue{q1, q2, q3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ssn := test.RegisterSession(nil, nil)
+			defer test.Close()
+			cp := New(framework.Arguments{}).(*capacityPlugin)
+			cp.OnSessionOpen(ssn)
+			qp := &quotaProvider{capacityPlugin: cp, ssn: ssn, readyToSchedule: true}
+			if err := uthelper.CheckQuotaProvider(ssn, qp); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestResourceAliasQuota(t *testing.T) {
+	api.SetResourceAliases(map[corev1.ResourceName]api.ResourceAlias{
+		"nvidia.com/A100": {Resource: "nvidia.com/gpu", Ratio: 1},
+		"nvidia.com/H100": {Resource: "nvidia.com/gpu", Ratio: 2},
+	})
+	defer api.SetResourceAliases(nil)
+
+	n1 := util.BuildNode("n1", api.BuildResourceList("16", "16Gi", []api.ScalarResource{
+		{Name: "pods", Value: "20"}, {Name: "nvidia.com/A100", Value: "8"}, {Name: "nvidia.com/H100", Value: "8"},
+	}...), nil)
+
+	buildPods := func(pg, model string, num int) []*corev1.Pod {
+		var pods []*corev1.Pod
+		for i := 0; i < num; i++ {
+			pods = append(pods, util.BuildPod("ns1", fmt.Sprintf("%s-%d", pg, i), "", corev1.PodPending,
+				api.BuildResourceList("1", "1Gi", api.ScalarResource{Name: model, Value: "1"}), pg, nil, nil))
+		}
+		return pods
+	}
+	pgA100 := util.BuildPodGroup("pg-a100", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue)
+	pgH100 := util.BuildPodGroup("pg-h100", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue)
+
+	// the quota of 4 GPUs covers both models, an H100 accounting for 2 GPUs
+	queue1 := util.BuildQueueWithResourcesQuantity("q1", nil,
+		api.BuildResourceList("16", "16Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "4"}))
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               PluginName,
+					EnabledAllocatable: &trueValue,
+					EnabledQueueOrder:  &trueValue,
+				},
+			},
+		},
+	}
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:             "A100 pods limited by the GPU quota",
+			Plugins:          plugins,
+			Pods:             buildPods("pg-a100", "nvidia.com/A100", 6),
+			Nodes:            []*corev1.Node{n1},
+			PodGroups:        []*schedulingv1beta1.PodGroup{pgA100},
+			Queues:           []*schedulingv1beta1.Queue{queue1},
+			ExpectBindsNum:   4,
+			MinimalBindCheck: true,
+		},
+		{
+			Name:             "H100 pods accounted twice in the GPU quota",
+			Plugins:          plugins,
+			Pods:             buildPods("pg-h100", "nvidia.com/H100", 6),
+			Nodes:            []*corev1.Node{n1},
+			PodGroups:        []*schedulingv1beta1.PodGroup{pgH100},
+			Queues:           []*schedulingv1beta1.Queue{queue1},
+			ExpectBindsNum:   2,
+			MinimalBindCheck: true,
+		},
+	}
+	actions := []framework.Action{allocate.New()}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+// subQuotaAttr is a sub-quota of a queue and the resources used by the jobs of its users and groups.
+type subQuotaAttr struct {
+	name   string
+	users  map[string]bool
+	groups map[string]bool
+	// names are the resource dimensions the sub-quota limits
+	names     []v1.ResourceName
+	deserved  *api.Resource
+	allocated *api.Resource
+	inqueue   *api.Resource
+}
+
+// buildSubQuotas builds the sub-quotas of the queues, with the resources allocated to and inqueue for the jobs of
+// their users and groups.
+func (cp *capacityPlugin) buildSubQuotas(ssn *framework.Session) {
+	cp.subQuotas = map[api.QueueID][]*subQuotaAttr{}
+	for queueID, queue := range ssn.Queues {
+		for _, subQuota := range queue.Queue.Spec.SubQuotas {
+			attr := &subQuotaAttr{
+				name:      subQuota.Name,
+				users:     map[string]bool{},
+				groups:    map[string]bool{},
+				deserved:  api.NewResource(subQuota.Deserved).Normalized(),
+				allocated: api.EmptyResource(),
+				inqueue:   api.EmptyResource(),
+			}
+			for _, user := range subQuota.Users {
+				attr.users[user] = true
+			}
+			for _, group := range subQuota.Groups {
+				attr.groups[group] = true
+			}
+			// the deserved resources are normalized, so are the dimensions they limit
+			for name := range subQuota.Deserved {
+				if name = api.NormalizedResourceName(name); !slices.Contains(attr.names, name) {
+					attr.names = append(attr.names, name)
+				}
+			}
+			cp.subQuotas[queueID] = append(cp.subQuotas[queueID], attr)
+		}
+	}
+
+	for _, job := range ssn.Jobs {
+		attr := cp.subQuotaOf(job)
+		if attr == nil {
+			continue
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, t := range tasks {
+				attr.allocated.Add(t.Resreq.Normalized())
+			}
+		}
+		if job.PodGroup.Status.Phase == scheduling.PodGroupInqueue && job.PodGroup.Spec.MinResources != nil {
+			inqueued := util.GetInqueueResource(job, job.Allocated)
+			attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
+		}
+		klog.V(5).Infof("Sub-quota <%s> of queue <%s> allocated <%s> inqueue <%s>",
+			attr.name, job.Queue, attr.allocated.String(), attr.inqueue.String())
+	}
+}
+
+// subQuotaOf returns the first sub-quota of the queue of the job listing the submitter of the job or one of its
+// groups, nil if none does.
+func (cp *capacityPlugin) subQuotaOf(job *api.JobInfo) *subQuotaAttr {
+	subQuotas := cp.subQuotas[job.Queue]
+	if len(subQuotas) == 0 || job.PodGroup == nil {
+		return nil
+	}
+	user := job.PodGroup.Annotations[schedulingv1beta1.SubmitterAnnotationKey]
+	var groups []string
+	if value := job.PodGroup.Annotations[schedulingv1beta1.SubmitterGroupsAnnotationKey]; value != "" {
+		groups = strings.Split(value, ",")
+	}
+	for _, attr := range subQuotas {
+		if user != "" && attr.users[user] {
+			return attr
+		}
+		for _, group := range groups {
+			if attr.groups[group] {
+				return attr
+			}
+		}
+	}
+	return nil
+}
+
+// subQuotaAllowed returns whether the sub-quota may use the requested resources: the dimensions it limits must stay
+// within its deserved resources once the resources are used.
+func subQuotaAllowed(attr *subQuotaAttr, futureUsed, resreq *api.Resource) bool {
+	for _, name := range attr.names {
+		if resreq.Get(name) > 0 && futureUsed.Get(name) > attr.deserved.Get(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkJobEnqueueableBySubQuota returns whether the minimum resources of the job fit the sub-quota of its submitter,
+// along with the resources allocated to and inqueue for the other jobs of the sub-quota.
+func (cp *capacityPlugin) checkJobEnqueueableBySubQuota(ssn *framework.Session, job *api.JobInfo) bool {
+	attr := cp.subQuotaOf(job)
+	if attr == nil || job.PodGroup.Spec.MinResources == nil {
+		return true
+	}
+	minReq := job.DeductSchGatedResources(job.GetMinResources()).Normalized()
+	futureUsed := attr.allocated.Clone().Add(attr.inqueue).Add(minReq)
+	if subQuotaAllowed(attr, futureUsed, minReq) {
+		return true
+	}
+	klog.V(3).Infof("Sub-quota <%s> of queue <%s>: deserved <%v>, allocated <%v>, inqueue <%v>; job <%s/%s>: min resources <%v>",
+		attr.name, job.Queue, attr.deserved, attr.allocated, attr.inqueue, job.Namespace, job.Name, minReq)
+	ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, string(scheduling.PodGroupUnschedulableType),
+		"sub-quota "+attr.name+" of the queue is insufficient")
+	return false
+}
+
+// checkTaskAllocatableBySubQuota returns whether the task fits the sub-quota of the submitter of its job.
+func (cp *capacityPlugin) checkTaskAllocatableBySubQuota(ssn *framework.Session, candidate *api.TaskInfo) bool {
+	job := ssn.Jobs[candidate.Job]
+	if job == nil {
+		return true
+	}
+	attr := cp.subQuotaOf(job)
+	if attr == nil {
+		return true
+	}
+	resreq := candidate.Resreq.Normalized()
+	futureUsed := attr.allocated.Clone().Add(resreq)
+	if subQuotaAllowed(attr, futureUsed, resreq) {
+		return true
+	}
+	klog.V(3).Infof("Sub-quota <%s> of queue <%s>: deserved <%v>, allocated <%v>; candidate <%v>: resource request <%v>",
+		attr.name, job.Queue, attr.deserved, attr.allocated, candidate.Name, resreq)
+	return false
+}
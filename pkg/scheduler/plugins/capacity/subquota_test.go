@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestSubQuotaOf(t *testing.T) {
+	queue := api.NewQueueInfo(&scheduling.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "q1"},
+		Spec: scheduling.QueueSpec{SubQuotas: []scheduling.QueueSubQuota{
+			{Name: "alice", Users: []string{"alice"}, Deserved: api.BuildResourceList("2", "0")},
+			{Name: "research", Groups: []string{"research", "ml"}, Deserved: api.BuildResourceList("4", "0")},
+		}},
+	})
+	ssn := &framework.Session{Queues: map[api.QueueID]*api.QueueInfo{queue.UID: queue}}
+	cp := &capacityPlugin{}
+	cp.buildSubQuotas(ssn)
+
+	job := func(annotations map[string]string) *api.JobInfo {
+		return &api.JobInfo{
+			Queue: queue.UID,
+			PodGroup: &api.PodGroup{PodGroup: scheduling.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			}},
+		}
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    string
+	}{
+		{
+			name:        "submitter listed as user",
+			annotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+			expected:    "alice",
+		},
+		{
+			name: "submitter in a listed group",
+			annotations: map[string]string{
+				schedulingv1beta1.SubmitterAnnotationKey:       "bob",
+				schedulingv1beta1.SubmitterGroupsAnnotationKey: "system:authenticated,ml",
+			},
+			expected: "research",
+		},
+		{
+			name: "first sub-quota listing the submitter",
+			annotations: map[string]string{
+				schedulingv1beta1.SubmitterAnnotationKey:       "alice",
+				schedulingv1beta1.SubmitterGroupsAnnotationKey: "research",
+			},
+			expected: "alice",
+		},
+		{
+			name:        "submitter not listed",
+			annotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "carol"},
+		},
+		{
+			name: "no submitter",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			name := ""
+			if attr := cp.subQuotaOf(job(test.annotations)); attr != nil {
+				name = attr.name
+			}
+			if name != test.expected {
+				t.Errorf("expected sub-quota %q, got %q", test.expected, name)
+			}
+		})
+	}
+}
+
+func TestSubQuota(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New, predicates.PluginName: predicates.New, gang.PluginName: gang.New}
+	trueValue := true
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{
+			{
+				Name:               PluginName,
+				EnabledAllocatable: &trueValue,
+				EnabledJobEnqueued: &trueValue,
+				EnabledQueueOrder:  &trueValue,
+			},
+			{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+			{Name: gang.PluginName, EnabledJobStarving: &trueValue},
+		},
+	}}
+	submittedBy := func(pg *schedulingv1beta1.PodGroup, user string) *schedulingv1beta1.PodGroup {
+		pg.Annotations = map[string]string{schedulingv1beta1.SubmitterAnnotationKey: user}
+		return pg
+	}
+	queue := util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("8", "16Gi"), nil)
+	queue.Spec.SubQuotas = []schedulingv1beta1.QueueSubQuota{
+		{Name: "alice", Users: []string{"alice"}, Deserved: api.BuildResourceList("2", "0")},
+	}
+
+	// alice already uses the cpu of her sub-quota, the other users share the rest of the queue
+	test := uthelper.TestCommonStruct{
+		Name:    "sub-quota limits the jobs of its users only",
+		Plugins: plugins,
+		Pods: []*corev1.Pod{
+			util.BuildPod("ns1", "p1", "n1", corev1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+			util.BuildPod("ns1", "p2", "", corev1.PodPending, api.BuildResourceList("2", "1Gi"), "pg2", nil, nil),
+			util.BuildPod("ns1", "p3", "", corev1.PodPending, api.BuildResourceList("2", "1Gi"), "pg3", nil, nil),
+		},
+		Nodes: []*corev1.Node{
+			util.BuildNode("n1", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			submittedBy(util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning), "alice"),
+			submittedBy(util.BuildPodGroup("pg2", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), "alice"),
+			submittedBy(util.BuildPodGroup("pg3", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), "bob"),
+		},
+		Queues:         []*schedulingv1beta1.Queue{queue},
+		ExpectBindMap:  map[string]string{"ns1/p3": "n1"},
+		ExpectBindsNum: 1,
+	}
+	test.RegisterSession(tiers, nil)
+	defer test.Close()
+	test.Run([]framework.Action{allocate.New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSubQuotaResourceAlias(t *testing.T) {
+	api.SetResourceAliases(map[corev1.ResourceName]api.ResourceAlias{
+		"nvidia.com/A100": {Resource: "nvidia.com/gpu", Ratio: 1},
+	})
+	defer api.SetResourceAliases(nil)
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New, predicates.PluginName: predicates.New, gang.PluginName: gang.New}
+	trueValue := true
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{
+			{
+				Name:               PluginName,
+				EnabledAllocatable: &trueValue,
+				EnabledJobEnqueued: &trueValue,
+				EnabledQueueOrder:  &trueValue,
+			},
+			{Name: predicates.PluginName, EnabledPredicate: &trueValue},
+			{Name: gang.PluginName, EnabledJobStarving: &trueValue},
+		},
+	}}
+	submittedBy := func(pg *schedulingv1beta1.PodGroup, user string) *schedulingv1beta1.PodGroup {
+		pg.Annotations = map[string]string{schedulingv1beta1.SubmitterAnnotationKey: user}
+		return pg
+	}
+	a100 := api.ScalarResource{Name: "nvidia.com/A100", Value: "1"}
+	queue := util.BuildQueueWithResourcesQuantity("q1", api.BuildResourceList("8", "16Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "4"}), nil)
+	// the sub-quota is set in the alias, and limits the GPUs of alice accounted in the resource it aliases
+	queue.Spec.SubQuotas = []schedulingv1beta1.QueueSubQuota{
+		{Name: "alice", Users: []string{"alice"}, Deserved: corev1.ResourceList{"nvidia.com/A100": resource.MustParse("1")}},
+	}
+
+	test := uthelper.TestCommonStruct{
+		Name:    "sub-quota set in an alias limits the resource it aliases",
+		Plugins: plugins,
+		Pods: []*corev1.Pod{
+			util.BuildPod("ns1", "p1", "", corev1.PodPending, api.BuildResourceList("1", "1Gi", a100), "pg1", nil, nil),
+			util.BuildPod("ns1", "p2", "", corev1.PodPending, api.BuildResourceList("1", "1Gi", a100), "pg2", nil, nil),
+		},
+		Nodes: []*corev1.Node{
+			util.BuildNode("n1", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}, {Name: "nvidia.com/A100", Value: "4"}}...), nil),
+		},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			submittedBy(util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), "alice"),
+			submittedBy(util.BuildPodGroup("pg2", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), "alice"),
+		},
+		Queues:           []*schedulingv1beta1.Queue{queue},
+		ExpectBindsNum:   1,
+		MinimalBindCheck: true,
+	}
+	test.RegisterSession(tiers, nil)
+	defer test.Close()
+	test.Run([]framework.Action{allocate.New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/api/helpers"
+)
+
+// WeightedSharingEnable is the key for sharing the idle resources of a parent queue among its children by their
+// weight in the capacity plugin, with hierarchy enabled
+const WeightedSharingEnable = "capacity.weightedSharing"
+
+/*
+   tiers:
+   - plugins:
+     - name: capacity
+       enableHierarchy: true
+       arguments:
+         capacity.weightedSharing: true
+*/
+
+// updateFairShares sets the fair share of the children of the queue: their deserved resources, up to their request,
+// and the rest of the fair share of the queue divided among them by their weight, up to their request and their
+// real capability. The fair share of the root queue is the total resource of the cluster.
+func (cp *capacityPlugin) updateFairShares(attr *queueAttr) {
+	if attr.queueID == api.QueueID(cp.rootQueue) {
+		attr.fairShare = cp.totalResource.Clone()
+		attr.fairShare.MinDimensionResource(attr.realCapability, api.Infinity)
+	}
+	if len(attr.children) == 0 {
+		return
+	}
+
+	remaining := attr.fairShare.Clone()
+	for _, child := range attr.children {
+		child.fairShare = child.deserved.Clone()
+		child.fairShare.MinDimensionResource(child.request, api.Zero)
+		remaining = api.ExceededPart(remaining, child.fairShare)
+	}
+
+	meet := map[api.QueueID]struct{}{}
+	for !remaining.IsEmpty() {
+		totalWeight := int32(0)
+		for _, child := range attr.children {
+			if _, found := meet[child.queueID]; !found {
+				totalWeight += child.weight
+			}
+		}
+		if totalWeight == 0 {
+			break
+		}
+
+		oldRemaining := remaining.Clone()
+		increased := api.EmptyResource()
+		for _, child := range attr.children {
+			if _, found := meet[child.queueID]; found {
+				continue
+			}
+			oldFairShare := child.fairShare.Clone()
+			child.fairShare.Add(remaining.Clone().Multi(float64(child.weight) / float64(totalWeight)))
+			if child.realCapability != nil {
+				child.fairShare.MinDimensionResource(child.realCapability, api.Infinity)
+			}
+			child.fairShare.MinDimensionResource(child.request, api.Zero)
+			child.fairShare = helpers.Max(child.fairShare, oldFairShare)
+
+			if child.request.LessEqual(child.fairShare, api.Zero) || equality.Semantic.DeepEqual(child.fairShare, oldFairShare) {
+				meet[child.queueID] = struct{}{}
+			}
+			grown, _ := child.fairShare.Diff(oldFairShare, api.Zero)
+			increased.Add(grown)
+		}
+
+		remaining = api.ExceededPart(remaining, increased)
+		if equality.Semantic.DeepEqual(remaining, oldRemaining) {
+			break
+		}
+	}
+
+	for _, child := range attr.children {
+		klog.V(4).Infof("The fair share of queue <%s> of weight <%d> under queue <%s>: <%v>",
+			child.name, child.weight, attr.name, child.fairShare)
+		cp.updateFairShares(child)
+	}
+}
+
+// weightedShare returns the highest ratio of the allocated resources of the queue to its fair share, over the
+// resources it requests.
+func weightedShare(attr *queueAttr) float64 {
+	share := 0.0
+	for _, name := range attr.request.ResourceNames() {
+		share = max(share, helpers.Share(attr.allocated.Get(name), attr.fairShare.Get(name)))
+	}
+	return share
+}
+
+// compareWeightedShares orders two sibling queues by their weighted share, lower first, and falls back to their share
+// of their deserved resources without weighted sharing.
+func (cp *capacityPlugin) compareWeightedShares(lattr, rattr *queueAttr) int {
+	if !cp.weightedSharing || lattr.fairShare == nil || rattr.fairShare == nil {
+		return cp.compareShareWithDeserved(lattr, rattr)
+	}
+	lshare, rshare := weightedShare(lattr), weightedShare(rattr)
+	if lshare == rshare {
+		return cp.compareShareWithDeserved(lattr, rattr)
+	}
+	if lshare < rshare {
+		return -1
+	}
+	return 1
+}
+
+// siblingBranches returns the ancestors of the two queues, or the queues themselves, which are children of their
+// closest common ancestor.
+func siblingBranches(lattr, rattr *queueAttr) (api.QueueID, api.QueueID) {
+	level := getQueueLevel(lattr, rattr)
+	lID, rID := lattr.queueID, rattr.queueID
+	if level+1 < len(lattr.ancestors) {
+		lID = lattr.ancestors[level+1]
+	}
+	if level+1 < len(rattr.ancestors) {
+		rID = rattr.ancestors[level+1]
+	}
+	return lID, rID
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestWeightedSharing(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+
+	// Queue qa runs 2 CPUs and queue qb runs 4 CPUs of their parent, both requesting 8 CPUs. The parent shares the 6
+	// CPUs left to it by queue qc, requesting 2 CPUs, by the weights 1 and 3 of its children: qa is above its fair
+	// share of 1.5 CPUs, qb below its fair share of 4.5 CPUs.
+	pods := []*corev1.Pod{}
+	podGroups := []*schedulingv1beta1.PodGroup{}
+	addPods := func(queue string, running, pending int) {
+		for i := 0; i < running+pending; i++ {
+			name := fmt.Sprintf("%s-p%d", queue, i)
+			if i < running {
+				pods = append(pods, util.BuildPod("ns1", name, "n1", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), name, nil, nil))
+				podGroups = append(podGroups, util.BuildPodGroup(name, "ns1", queue, 1, nil, schedulingv1beta1.PodGroupRunning))
+			} else {
+				pods = append(pods, util.BuildPod("ns1", name, "", corev1.PodPending, api.BuildResourceList("1", "1Gi"), name, nil, nil))
+				podGroups = append(podGroups, util.BuildPodGroup(name, "ns1", queue, 1, nil, schedulingv1beta1.PodGroupInqueue))
+			}
+		}
+	}
+	addPods("qa", 2, 6)
+	addPods("qb", 4, 4)
+	addPods("qc", 0, 2)
+
+	qa := buildQueueWithParents("qa", "qp", nil, nil)
+	qb := buildQueueWithParents("qb", "qp", nil, nil)
+	qb.Spec.Weight = 3
+	queues := []*schedulingv1beta1.Queue{
+		buildQueueWithParents("root", "", nil, nil),
+		buildQueueWithParents("qp", "root", nil, nil),
+		buildQueueWithParents("qc", "root", nil, nil),
+		qa,
+		qb,
+	}
+
+	tests := []struct {
+		name              string
+		arguments         framework.Arguments
+		expectQueueFirst  string
+		expectVictimFirst string
+	}{
+		{
+			name:              "siblings are left to the default order without weighted sharing",
+			expectQueueFirst:  "qa",
+			expectVictimFirst: "qb",
+		},
+		{
+			name:              "siblings are ordered by their weighted share",
+			arguments:         framework.Arguments{WeightedSharingEnable: true},
+			expectQueueFirst:  "qb",
+			expectVictimFirst: "qa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := uthelper.TestCommonStruct{
+				Name:      tt.name,
+				Plugins:   plugins,
+				Pods:      pods,
+				PodGroups: podGroups,
+				Queues:    queues,
+				Nodes: []*corev1.Node{
+					util.BuildNode("n1", api.BuildResourceList("8", "8Gi"), nil),
+				},
+			}
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:               PluginName,
+							EnabledQueueOrder:  &trueValue,
+							EnabledReclaimable: &trueValue,
+							EnabledHierarchy:   &trueValue,
+							Arguments:          tt.arguments,
+						},
+					},
+				},
+			}
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+
+			a, b, c := ssn.Queues["qa"], ssn.Queues["qb"], ssn.Queues["qc"]
+			checkFirst := func(kind, expect string, aFirst, bFirst bool) {
+				switch {
+				case expect == "qa" && (!aFirst || bFirst):
+					t.Errorf("expected qa first in %s order, got qa first %v, qb first %v", kind, aFirst, bFirst)
+				case expect == "qb" && (aFirst || !bFirst):
+					t.Errorf("expected qb first in %s order, got qa first %v, qb first %v", kind, aFirst, bFirst)
+				}
+			}
+			checkFirst("queue", tt.expectQueueFirst, ssn.QueueOrderFn(a, b), ssn.QueueOrderFn(b, a))
+			checkFirst("victim", tt.expectVictimFirst, ssn.VictimQueueOrderFn(a, b, c), ssn.VictimQueueOrderFn(b, a, c))
+		})
+	}
+}
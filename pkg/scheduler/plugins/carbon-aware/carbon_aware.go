@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbonaware
+
+import (
+	"math"
+	"time"
+
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "carbon-aware"
+
+	// WeightKey is the weight of the node order score of the carbon intensity of the zone of the nodes.
+	WeightKey = "carbon-aware.weight"
+	// ConsolidationWeightKey is the weight of the node order score of the usage of the nodes, packing the pods on
+	// the busy nodes so that the idle nodes can be powered down. 0, the default, disables the consolidation.
+	ConsolidationWeightKey = "carbon-aware.consolidationWeight"
+	// ZoneLabelKey is the label of the nodes naming their zone, a region or a rack, for the provider.
+	ZoneLabelKey = "carbon-aware.zoneLabel"
+	// ProviderKey is the name of the provider of the intensities, static or http, or a registered provider.
+	ProviderKey = "carbon-aware.provider"
+	// RefreshIntervalKey is the interval the provider is called at.
+	RefreshIntervalKey = "carbon-aware.refreshInterval"
+	// IntensitiesKey is the map of the intensities by zone of the static provider.
+	IntensitiesKey = "carbon-aware.intensities"
+	// URLKey is the URL of the http provider.
+	URLKey = "carbon-aware.url"
+
+	// StaticProvider provides the intensities set in the arguments.
+	StaticProvider = "static"
+	// HTTPProvider gets the intensities from a URL.
+	HTTPProvider = "http"
+
+	defaultZoneLabel       = "topology.kubernetes.io/region"
+	defaultRefreshInterval = 5 * time.Minute
+	// expiryRounds is the number of refresh intervals after which the intensities are outdated
+	expiryRounds    = 3
+	providerTimeout = 10 * time.Second
+	maxResponseSize = 1 << 20
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: carbon-aware
+       arguments:
+         carbon-aware.weight: 1
+         carbon-aware.consolidationWeight: 1
+         carbon-aware.zoneLabel: topology.kubernetes.io/region
+         carbon-aware.provider: http
+         carbon-aware.url: http://carbon-exporter.monitoring.svc/intensities
+         carbon-aware.refreshInterval: 5m
+*/
+
+type carbonAwarePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments     framework.Arguments
+	weight              int
+	consolidationWeight int
+	zoneLabel           string
+	provider            string
+	refreshInterval     time.Duration
+}
+
+// New function returns carbon-aware plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	cp := &carbonAwarePlugin{
+		pluginArguments: arguments,
+		weight:          1,
+		zoneLabel:       defaultZoneLabel,
+		provider:        StaticProvider,
+		refreshInterval: defaultRefreshInterval,
+	}
+
+	arguments.GetInt(&cp.weight, WeightKey)
+	if cp.weight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default 1", WeightKey, cp.weight, PluginName)
+		cp.weight = 1
+	}
+	arguments.GetInt(&cp.consolidationWeight, ConsolidationWeightKey)
+	if cp.consolidationWeight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default 0", ConsolidationWeightKey, cp.consolidationWeight, PluginName)
+		cp.consolidationWeight = 0
+	}
+	arguments.GetString(&cp.zoneLabel, ZoneLabelKey)
+	arguments.GetString(&cp.provider, ProviderKey)
+	var interval string
+	arguments.GetString(&interval, RefreshIntervalKey)
+	if interval != "" {
+		if d, err := time.ParseDuration(interval); err != nil || d <= 0 {
+			klog.Warningf("Invalid %s <%s> in plugin %s, using default %v", RefreshIntervalKey, interval, PluginName, defaultRefreshInterval)
+		} else {
+			cp.refreshInterval = d
+		}
+	}
+
+	return cp
+}
+
+func (cp *carbonAwarePlugin) Name() string {
+	return PluginName
+}
+
+func (cp *carbonAwarePlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	var zoneIntensities map[string]float64
+	if cp.weight > 0 {
+		zoneIntensities = currentIntensities(cp.provider, cp.pluginArguments, cp.refreshInterval, expiryRounds*cp.refreshInterval)
+	}
+	scores := cp.intensityScores(ssn.Nodes, zoneIntensities)
+	if len(scores) == 0 && cp.consolidationWeight == 0 {
+		klog.V(4).Infof("No carbon intensity of the zones of the nodes, plugin %s scores no node", PluginName)
+		return
+	}
+
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		score := scores[node.Name] * float64(fwk.MaxNodeScore) * float64(cp.weight)
+		score += consolidationScore(node) * float64(fwk.MaxNodeScore) * float64(cp.consolidationWeight)
+		klog.V(5).Infof("Task %s/%s on node %s scored %v by plugin %s", task.Namespace, task.Name, node.Name, score, PluginName)
+		return score, nil
+	}
+	ssn.AddNodeOrderFn(cp.Name(), nodeOrderFn)
+}
+
+// intensityScores returns the scores of the nodes by the intensity of their zone, between 0 for the highest
+// intensity and 1 for the lowest. The nodes of the zones without intensity are not scored.
+func (cp *carbonAwarePlugin) intensityScores(nodes map[string]*api.NodeInfo, zoneIntensities map[string]float64) map[string]float64 {
+	scores := map[string]float64{}
+	if len(zoneIntensities) == 0 {
+		return scores
+	}
+	lowest, highest := math.Inf(1), math.Inf(-1)
+	nodeIntensities := map[string]float64{}
+	for name, node := range nodes {
+		if node.Node == nil {
+			continue
+		}
+		intensity, found := zoneIntensities[node.Node.Labels[cp.zoneLabel]]
+		if !found {
+			continue
+		}
+		nodeIntensities[name] = intensity
+		lowest = math.Min(lowest, intensity)
+		highest = math.Max(highest, intensity)
+	}
+	for name, intensity := range nodeIntensities {
+		if highest == lowest {
+			scores[name] = 1
+			continue
+		}
+		scores[name] = (highest - intensity) / (highest - lowest)
+	}
+	return scores
+}
+
+// consolidationScore returns the usage of the node between 0 and 1, the average of its cpu and memory usage, so
+// that the pods are packed on the busy nodes and the idle nodes stay idle.
+func consolidationScore(node *api.NodeInfo) float64 {
+	if len(node.Tasks) == 0 || node.Allocatable == nil || node.Used == nil {
+		return 0
+	}
+	var usage float64
+	var dimensions int
+	if node.Allocatable.MilliCPU > 0 {
+		usage += math.Min(node.Used.MilliCPU/node.Allocatable.MilliCPU, 1)
+		dimensions++
+	}
+	if node.Allocatable.Memory > 0 {
+		usage += math.Min(node.Used.Memory/node.Allocatable.Memory, 1)
+		dimensions++
+	}
+	if dimensions == 0 {
+		return 0
+	}
+	return usage / float64(dimensions)
+}
+
+func (cp *carbonAwarePlugin) OnSessionClose(ssn *framework.Session) {}
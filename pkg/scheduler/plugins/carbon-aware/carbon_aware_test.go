@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbonaware
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func region(r string) map[string]string {
+	return map[string]string{defaultZoneLabel: r}
+}
+
+// warmUp provides the intensities of the arguments to the plugin before the sessions
+func warmUp(t *testing.T, arguments framework.Arguments) {
+	deadline := time.Now().Add(5 * time.Second)
+	for currentIntensities(StaticProvider, arguments, time.Millisecond, time.Minute) == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("the intensities were not provided")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestIntensityScores(t *testing.T) {
+	cp := New(framework.Arguments{}).(*carbonAwarePlugin)
+	nodes := map[string]*api.NodeInfo{
+		"green": api.NewNodeInfo(util.BuildNode("green", api.BuildResourceList("2", "4Gi"), region("north"))),
+		"gray":  api.NewNodeInfo(util.BuildNode("gray", api.BuildResourceList("2", "4Gi"), region("middle"))),
+		"brown": api.NewNodeInfo(util.BuildNode("brown", api.BuildResourceList("2", "4Gi"), region("south"))),
+		"other": api.NewNodeInfo(util.BuildNode("other", api.BuildResourceList("2", "4Gi"), region("unknown"))),
+	}
+	scores := cp.intensityScores(nodes, map[string]float64{"north": 100, "middle": 250, "south": 400})
+	expected := map[string]float64{"green": 1, "gray": 0.5, "brown": 0}
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("expected scores %v, got %v", expected, scores)
+	}
+
+	scores = cp.intensityScores(nodes, map[string]float64{"north": 100, "middle": 100})
+	if expected := map[string]float64{"green": 1, "gray": 1}; !reflect.DeepEqual(scores, expected) {
+		t.Errorf("expected the same score for the same intensities, got %v", scores)
+	}
+}
+
+func TestConsolidationScore(t *testing.T) {
+	idle := api.NewNodeInfo(util.BuildNode("idle", api.BuildResourceList("4", "4Gi"), nil))
+	if score := consolidationScore(idle); score != 0 {
+		t.Errorf("expected no score for an idle node, got %v", score)
+	}
+
+	busy := api.NewNodeInfo(util.BuildNode("busy", api.BuildResourceList("4", "4Gi"), nil))
+	pod := util.BuildPod("c1", "p1", "busy", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil)
+	if err := busy.AddTask(api.NewTaskInfo(pod)); err != nil {
+		t.Fatalf("failed to add task: %v", err)
+	}
+	if score := consolidationScore(busy); math.Abs(score-0.375) > 1e-9 {
+		t.Errorf("expected score 0.375 for a busy node, got %v", score)
+	}
+}
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"north": 100, "south": 400.5}`))
+	}))
+	defer server.Close()
+
+	provider, err := newHTTPProvider(framework.Arguments{URLKey: server.URL})
+	if err != nil {
+		t.Fatalf("failed to build provider: %v", err)
+	}
+	values, err := provider.Intensities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]float64{"north": 100, "south": 400.5}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected intensities %v, got %v", expected, values)
+	}
+
+	if _, err := newHTTPProvider(framework.Arguments{}); err == nil {
+		t.Errorf("expected an error without URL")
+	}
+}
+
+func TestCarbonAware(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "tasks are placed in the zone with the lowest carbon intensity",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), region("south")),
+					util.BuildNode("n2", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), region("north")),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "n2", "c1/p2": "n2"},
+				ExpectBindsNum: 2,
+			},
+			arguments: framework.Arguments{IntensitiesKey: map[interface{}]interface{}{"north": 100, "south": 400}},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "tasks are packed on the busy nodes so that the idle nodes can be powered down",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 1, nil, schedulingv1beta1.PodGroupRunning),
+					util.BuildPodGroup("pg2", "c1", "batch", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "running", "n3", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+					util.BuildNode("n2", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+					util.BuildNode("n3", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "n3"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{ConsolidationWeightKey: 1, IntensitiesKey: map[interface{}]interface{}{}},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if intensityArgs := test.arguments[IntensitiesKey].(map[interface{}]interface{}); len(intensityArgs) > 0 {
+				warmUp(t, test.arguments)
+			}
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:             PluginName,
+							EnabledNodeOrder: &trueValue,
+							Arguments:        test.arguments,
+						},
+						{
+							Name:                gang.PluginName,
+							EnabledJobReady:     &trueValue,
+							EnabledJobPipelined: &trueValue,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
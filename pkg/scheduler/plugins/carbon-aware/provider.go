@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package carbonaware
+
+import (
+	"context"
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/provider"
+)
+
+// Provider provides the current carbon intensity, or power price, of the zones of the nodes. The lower the value of
+// a zone, the more its nodes are preferred.
+type Provider interface {
+	Intensities(ctx context.Context) (map[string]float64, error)
+}
+
+// ProviderBuilder builds a provider from the arguments of the plugin.
+type ProviderBuilder = provider.Builder[Provider]
+
+var providers = provider.NewRegistry(map[string]ProviderBuilder{
+	StaticProvider: newStaticProvider,
+	HTTPProvider:   newHTTPProvider,
+})
+
+// RegisterProvider registers a provider of the carbon intensities, used by the plugin when named by its arguments.
+func RegisterProvider(name string, builder ProviderBuilder) {
+	providers.Register(name, builder)
+}
+
+// intensities keeps the intensities of the providers between the sessions.
+var intensities = provider.NewCache("carbon intensity provider of plugin "+PluginName, providers, providerTimeout,
+	func(ctx context.Context, p Provider) (map[string]float64, error) {
+		return p.Intensities(ctx)
+	})
+
+// currentIntensities returns the intensities of the provider no older than the expiry.
+func currentIntensities(name string, arguments framework.Arguments, refreshInterval, expiry time.Duration) map[string]float64 {
+	values, provided := intensities.Get(name, arguments, refreshInterval)
+	if values == nil || time.Since(provided) > expiry {
+		return nil
+	}
+	return values
+}
+
+// staticProvider provides the intensities set in the arguments of the plugin.
+type staticProvider struct {
+	intensities map[string]float64
+}
+
+func newStaticProvider(arguments framework.Arguments) (Provider, error) {
+	intensities, err := provider.StaticValues(arguments, IntensitiesKey)
+	if err != nil {
+		return nil, err
+	}
+	return &staticProvider{intensities: intensities}, nil
+}
+
+func (sp *staticProvider) Intensities(context.Context) (map[string]float64, error) {
+	return sp.intensities, nil
+}
+
+// httpProvider gets the intensities from a URL answering with a JSON object of the intensities by zone, e.g.
+// {"eu-west-1": 120, "us-east-1": 410}.
+type httpProvider struct {
+	*provider.HTTPClient
+}
+
+func newHTTPProvider(arguments framework.Arguments) (Provider, error) {
+	client, err := provider.NewHTTPClient(arguments, URLKey, providerTimeout, maxResponseSize)
+	if err != nil {
+		return nil, err
+	}
+	return &httpProvider{HTTPClient: client}, nil
+}
+
+func (hp *httpProvider) Intensities(ctx context.Context) (map[string]float64, error) {
+	return hp.Get(ctx)
+}
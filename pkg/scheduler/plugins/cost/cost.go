@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/api/helpers"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "cost"
+
+	// ProviderKey is the name of the pricing provider of the nodes without cost annotation, static or http, or a
+	// registered provider. The nodes are only priced by their annotation if not set.
+	ProviderKey = "cost.provider"
+	// RefreshIntervalKey is the interval the provider is called at.
+	RefreshIntervalKey = "cost.refreshInterval"
+	// PricesKey is the map of the prices per hour by instance type of the static provider.
+	PricesKey = "cost.prices"
+	// URLKey is the URL of the http provider.
+	URLKey = "cost.url"
+	// InstanceTypeLabelKey is the label of the nodes naming their instance type for the provider.
+	InstanceTypeLabelKey = "cost.instanceTypeLabel"
+	// StatusIntervalKey is the interval the cost spent by the queues is written to their status at.
+	StatusIntervalKey = "cost.statusInterval"
+
+	// StaticProvider provides the prices set in the arguments.
+	StaticProvider = "static"
+	// HTTPProvider gets the prices from a URL.
+	HTTPProvider = "http"
+
+	// OverBudgetReason is the reason of the condition when the queue exceeded its monthly budget.
+	OverBudgetReason = "CostExceededBudget"
+	// WithinBudgetReason is the reason of the condition when the queue is within its monthly budget again.
+	WithinBudgetReason = "CostWithinBudget"
+
+	defaultRefreshInterval = time.Hour
+	defaultStatusInterval  = time.Minute
+	providerTimeout        = 10 * time.Second
+	maxResponseSize        = 1 << 20
+
+	rootQueueID = api.QueueID("root")
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: cost
+       arguments:
+         cost.provider: static
+         cost.prices:
+           m5.large: 0.096
+           p4d.24xlarge: 32.77
+         cost.instanceTypeLabel: node.kubernetes.io/instance-type
+         cost.refreshInterval: 1h
+         cost.statusInterval: 1m
+*/
+
+// queueCost is the cost spent by the jobs of one queue in the current period.
+type queueCost struct {
+	spent       float64
+	periodStart time.Time
+	// written is the last time the cost was written to the status of the queue
+	written time.Time
+}
+
+var (
+	// costs are the costs spent by the queues in the current period, resumed from their status after a restart.
+	costs = framework.QueueStates[*queueCost](PluginName, "costs")
+	// lastAccrual is the last time the running tasks were charged for the cost of their nodes.
+	lastAccrual = framework.PluginValue(PluginName, "lastAccrual", time.Time{})
+)
+
+type costPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments   framework.Arguments
+	provider          string
+	refreshInterval   time.Duration
+	instanceTypeLabel string
+	statusInterval    time.Duration
+	now               func() time.Time
+
+	// overBudget is the budget policies of the queues over their budget, or under an ancestor over its budget.
+	overBudget map[api.QueueID]scheduling.BudgetPolicy
+}
+
+// New function returns cost plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	cp := &costPlugin{
+		pluginArguments:   arguments,
+		refreshInterval:   defaultRefreshInterval,
+		instanceTypeLabel: v1.LabelInstanceTypeStable,
+		statusInterval:    defaultStatusInterval,
+		now:               time.Now,
+		overBudget:        map[api.QueueID]scheduling.BudgetPolicy{},
+	}
+
+	arguments.GetString(&cp.provider, ProviderKey)
+	arguments.GetString(&cp.instanceTypeLabel, InstanceTypeLabelKey)
+	cp.refreshInterval = getDuration(arguments, RefreshIntervalKey, defaultRefreshInterval)
+	cp.statusInterval = getDuration(arguments, StatusIntervalKey, defaultStatusInterval)
+
+	return cp
+}
+
+func getDuration(arguments framework.Arguments, key string, defaultValue time.Duration) time.Duration {
+	var value string
+	arguments.GetString(&value, key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		klog.Warningf("Invalid %s <%s> in plugin %s, using default %v", key, value, PluginName, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+func (cp *costPlugin) Name() string {
+	return PluginName
+}
+
+// OnSessionOpen charges the queues for the cost of the nodes their tasks have run on since the last session, then
+// deprioritizes, or gates, the jobs of the queues which exceeded their monthly budget.
+func (cp *costPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(4).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(4).Infof("Leaving %s plugin.", PluginName)
+
+	now := cp.now()
+	cp.accrue(ssn, now)
+	cp.updateBudgets(ssn, now)
+
+	ssn.AddQueueOrderFn(cp.Name(), func(l, r interface{}) int {
+		lv := l.(*api.QueueInfo)
+		rv := r.(*api.QueueInfo)
+		_, lOver := cp.overBudget[lv.UID]
+		_, rOver := cp.overBudget[rv.UID]
+		if lOver == rOver {
+			return 0
+		}
+		if lOver {
+			return 1
+		}
+		return -1
+	})
+
+	ssn.AddJobEnqueueableFn(cp.Name(), func(obj interface{}) int {
+		job := obj.(*api.JobInfo)
+		if cp.overBudget[job.Queue] != scheduling.BudgetPolicyGate {
+			return util.Abstain
+		}
+		msg := fmt.Sprintf("queue <%s> exceeded its monthly budget", job.Queue)
+		klog.V(3).Infof("Job <%s/%s> is not enqueueable: %s", job.Namespace, job.Name, msg)
+		ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, string(scheduling.PodGroupUnschedulableType), msg)
+		return util.Reject
+	})
+}
+
+func (cp *costPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// accrue charges the queues, and their ancestors, for the share of the nodes their tasks have occupied since the last
+// session, the cost spent is reset at the start of every calendar month.
+func (cp *costPlugin) accrue(ssn *framework.Session, now time.Time) {
+	periodStart := monthStart(now)
+	for queueID, queue := range ssn.Queues {
+		qc, found := costs.Get(queueID)
+		if !found {
+			// Resume from the status of the queue after a restart of the scheduler.
+			qc = &queueCost{periodStart: periodStart}
+			if status := queue.Queue.Status.Cost; status != nil && status.PeriodStart.Time.Equal(periodStart) {
+				qc.spent = status.Spent.AsApproximateFloat64()
+			}
+			costs.Set(queueID, qc)
+		}
+		if !qc.periodStart.Equal(periodStart) {
+			qc.spent = 0
+			qc.periodStart = periodStart
+		}
+	}
+
+	// Only the time since the start of the period is charged, the previous period is over.
+	var elapsed time.Duration
+	lastAccrual.Update(func(last *time.Time) {
+		if !last.IsZero() && now.After(*last) {
+			elapsed = now.Sub(maxTime(*last, periodStart))
+		}
+		*last = now
+	})
+	if elapsed == 0 {
+		return
+	}
+
+	nodePrices := cp.nodePrices(ssn)
+	charges := map[api.QueueID]float64{}
+	for _, job := range ssn.Jobs {
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				price, priced := nodePrices[task.NodeName]
+				node, found := ssn.Nodes[task.NodeName]
+				if !priced || !found {
+					continue
+				}
+				charges[job.Queue] += price * nodeShare(task.Resreq, node.Allocatable) * elapsed.Hours()
+			}
+		}
+	}
+	// The ancestors are charged for their descendants, so that their budget bounds the whole hierarchy.
+	costs.Update(func(values map[api.QueueID]*queueCost) {
+		for queueID, cost := range charges {
+			for ancestor := range ancestors(ssn, queueID) {
+				values[ancestor].spent += cost
+			}
+		}
+	})
+}
+
+// nodePrices returns the price per hour of the nodes, given by their cost annotation, or by the pricing provider for
+// their instance type. The nodes without price are absent.
+func (cp *costPlugin) nodePrices(ssn *framework.Session) map[string]float64 {
+	var providerPrices map[string]float64
+	if cp.provider != "" {
+		providerPrices, _ = prices.Get(cp.provider, cp.pluginArguments, cp.refreshInterval)
+	}
+
+	nodePrices := map[string]float64{}
+	for name, node := range ssn.Nodes {
+		if node.Node == nil {
+			continue
+		}
+		if value, found := node.Node.Annotations[schedulingv1beta1.NodeHourlyCostAnnotationKey]; found {
+			price, err := strconv.ParseFloat(value, 64)
+			if err != nil || price < 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+				klog.Warningf("Invalid %s <%s> of node <%s>", schedulingv1beta1.NodeHourlyCostAnnotationKey, value, name)
+				continue
+			}
+			nodePrices[name] = price
+			continue
+		}
+		if price, found := providerPrices[node.Node.Labels[cp.instanceTypeLabel]]; found {
+			nodePrices[name] = price
+		}
+	}
+	return nodePrices
+}
+
+// updateBudgets finds the queues over budget, exports their cost and writes it to their status at the status
+// interval.
+func (cp *costPlugin) updateBudgets(ssn *framework.Session, now time.Time) {
+	exceeded := map[api.QueueID]scheduling.BudgetPolicy{}
+	for queueID, queue := range ssn.Queues {
+		qc, _ := costs.Get(queueID)
+		budget := queue.Queue.Spec.Budget
+		var monthly float64
+		over := false
+		if budget != nil {
+			monthly = budget.Monthly.AsApproximateFloat64()
+			over = qc.spent >= monthly
+			if over {
+				exceeded[queueID] = budget.Policy
+				if budget.Policy == "" {
+					exceeded[queueID] = scheduling.BudgetPolicyDeprioritize
+				}
+			}
+		}
+		metrics.UpdateQueueCost(queue.Name, qc.spent, monthly, over)
+		if budget != nil || hasCondition(queue, scheduling.QueueOverBudget) {
+			cp.updateCondition(ssn, queue, over, monthly)
+		}
+
+		if qc.spent == 0 && queue.Queue.Status.Cost == nil {
+			continue
+		}
+		status := queue.Queue.Status.Cost
+		if status != nil && status.PeriodStart.Time.Equal(qc.periodStart) && now.Sub(qc.written) < cp.statusInterval {
+			continue
+		}
+		cost := &scheduling.QueueCostStatus{
+			Spent:       *resource.NewMilliQuantity(int64(math.Round(qc.spent*1000)), resource.DecimalSI),
+			PeriodStart: metav1.NewTime(qc.periodStart),
+		}
+		if err := ssn.UpdateQueueCost(queueID, cost); err != nil {
+			klog.Errorf("Failed to update cost of queue <%s>: %v", queue.Name, err)
+			continue
+		}
+		qc.written = now
+	}
+
+	// A queue is over budget as soon as one of its ancestors is, gated if any of them gates its jobs.
+	for queueID := range ssn.Queues {
+		for ancestor := range ancestors(ssn, queueID) {
+			policy, found := exceeded[ancestor]
+			if !found {
+				continue
+			}
+			if cp.overBudget[queueID] != scheduling.BudgetPolicyGate {
+				cp.overBudget[queueID] = policy
+			}
+		}
+	}
+}
+
+// ancestors returns the queue and its ancestors up to the root queue.
+func ancestors(ssn *framework.Session, queueID api.QueueID) map[api.QueueID]struct{} {
+	result := map[api.QueueID]struct{}{}
+	for {
+		queue, found := ssn.Queues[queueID]
+		if !found {
+			return result
+		}
+		if _, visited := result[queueID]; visited {
+			return result
+		}
+		result[queueID] = struct{}{}
+		if queueID == rootQueueID {
+			return result
+		}
+		queueID = rootQueueID
+		if queue.Queue.Spec.Parent != "" {
+			queueID = api.QueueID(queue.Queue.Spec.Parent)
+		}
+	}
+}
+
+func (cp *costPlugin) updateCondition(ssn *framework.Session, queue *api.QueueInfo, over bool, monthly float64) {
+	cond := &scheduling.QueueCondition{
+		Type:               scheduling.QueueOverBudget,
+		Status:             v1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             WithinBudgetReason,
+		Message:            fmt.Sprintf("cost within monthly budget %v", monthly),
+	}
+	if over {
+		cond.Status = v1.ConditionTrue
+		cond.Reason = OverBudgetReason
+		cond.Message = fmt.Sprintf("cost exceeded monthly budget %v", monthly)
+	}
+	if err := ssn.UpdateQueueCondition(queue.UID, cond); err != nil {
+		klog.Errorf("Failed to update condition of queue <%s>: %v", queue.Name, err)
+	}
+}
+
+func hasCondition(queue *api.QueueInfo, condType scheduling.QueueConditionType) bool {
+	for _, cond := range queue.Queue.Status.Conditions {
+		if cond.Type == condType {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeShare is the dominant share of the allocatable resources of the node requested by the task.
+func nodeShare(req, allocatable *api.Resource) float64 {
+	if req == nil || allocatable == nil {
+		return 0
+	}
+	share := 0.0
+	for _, rn := range allocatable.ResourceNames() {
+		share = max(share, helpers.Share(req.Get(rn), allocatable.Get(rn)))
+	}
+	return min(share, 1)
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// monthStart is the start of the calendar month of the time, in UTC.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/enqueue"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func resetCosts() {
+	costs.Reset()
+	lastAccrual.Store(time.Time{})
+}
+
+func buildQueueWithBudget(name, parent, monthly string, policy schedulingv1beta1.BudgetPolicy) *schedulingv1beta1.Queue {
+	queue := util.BuildQueue(name, 1, nil)
+	queue.Spec.Parent = parent
+	if monthly != "" {
+		queue.Spec.Budget = &schedulingv1beta1.QueueBudget{Monthly: resource.MustParse(monthly), Policy: policy}
+	}
+	return queue
+}
+
+func TestCostAccrual(t *testing.T) {
+	resetCosts()
+	defer resetCosts()
+
+	node := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	node.Annotations = map[string]string{schedulingv1beta1.NodeHourlyCostAnnotationKey: "2"}
+	test := uthelper.TestCommonStruct{
+		Name:  "queues and their ancestors are charged for the share of the nodes of their tasks",
+		Nodes: []*v1.Node{node},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroup("pg1", "ns1", "child", 1, nil, schedulingv1beta1.PodGroupRunning),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("ns1", "p1", "n1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+		},
+		Queues: []*schedulingv1beta1.Queue{
+			buildQueueWithBudget("team", "", "1", schedulingv1beta1.BudgetPolicyGate),
+			buildQueueWithBudget("child", "team", "", ""),
+			buildQueueWithBudget("other", "", "1", ""),
+		},
+	}
+	ssn := test.RegisterSession(nil, nil)
+	defer test.Close()
+
+	now := time.Date(2026, time.October, 15, 10, 0, 0, 0, time.UTC)
+	open := func() *costPlugin {
+		cp := New(framework.Arguments{}).(*costPlugin)
+		cp.now = func() time.Time { return now }
+		cp.OnSessionOpen(ssn)
+		return cp
+	}
+
+	cp := open()
+	if len(cp.overBudget) != 0 {
+		t.Fatalf("expected no queue over budget before any charge, got %v", cp.overBudget)
+	}
+
+	// The task requests half of the node, priced 2 per hour, for an hour.
+	now = now.Add(time.Hour)
+	cp = open()
+	for queueID, expected := range map[api.QueueID]float64{"child": 1, "team": 1, "other": 0} {
+		qc, _ := costs.Get(queueID)
+		if math.Abs(qc.spent-expected) > 1e-6 {
+			t.Errorf("expected queue %s to have spent %v, got %v", queueID, expected, qc.spent)
+		}
+	}
+	if cp.overBudget["team"] != scheduling.BudgetPolicyGate || cp.overBudget["child"] != scheduling.BudgetPolicyGate {
+		t.Errorf("expected queues team and child to be gated, got %v", cp.overBudget)
+	}
+	if _, found := cp.overBudget["other"]; found {
+		t.Errorf("expected queue other within budget, got %v", cp.overBudget)
+	}
+	status := ssn.Queues["team"].Queue.Status
+	if status.Cost == nil || status.Cost.Spent.Cmp(resource.MustParse("1")) != 0 ||
+		!status.Cost.PeriodStart.Time.Equal(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected cost 1 spent since October 1st in the status of queue team, got %v", status.Cost)
+	}
+	if len(status.Conditions) != 1 || status.Conditions[0].Type != scheduling.QueueOverBudget ||
+		status.Conditions[0].Status != v1.ConditionTrue || status.Conditions[0].Reason != OverBudgetReason {
+		t.Errorf("expected OverBudget condition on queue team, got %v", status.Conditions)
+	}
+
+	// The cost spent is reset at the start of the month.
+	now = time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	cp = open()
+	team, _ := costs.Get("team")
+	if team.spent >= 1 || len(cp.overBudget) != 0 {
+		t.Errorf("expected the cost of the queues reset in the new month, got %v spent and %v over budget",
+			team.spent, cp.overBudget)
+	}
+	conditions := ssn.Queues["team"].Queue.Status.Conditions
+	if len(conditions) != 1 || conditions[0].Status != v1.ConditionFalse || conditions[0].Reason != WithinBudgetReason {
+		t.Errorf("expected queue team within budget again, got %v", conditions)
+	}
+}
+
+func TestCostBudgets(t *testing.T) {
+	// The queues resume the cost spent in the current month from their status.
+	spentQueue := func(queue *schedulingv1beta1.Queue, spent string) *schedulingv1beta1.Queue {
+		queue.Status.Cost = &schedulingv1beta1.QueueCostStatus{
+			Spent:       resource.MustParse(spent),
+			PeriodStart: metav1.NewTime(monthStart(time.Now())),
+		}
+		return queue
+	}
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		actions []framework.Action
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "the jobs of the queues over a gating budget are not enqueued",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroupWithMinResources("pg1", "ns1", "gated", 1, nil, api.BuildResourceList("1", "1Gi"), schedulingv1beta1.PodGroupPending),
+					util.BuildPodGroupWithMinResources("pg2", "ns1", "open", 1, nil, api.BuildResourceList("1", "1Gi"), schedulingv1beta1.PodGroupPending),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil),
+					util.BuildPod("ns1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					spentQueue(buildQueueWithBudget("gated", "", "10", schedulingv1beta1.BudgetPolicyGate), "12.5"),
+					spentQueue(buildQueueWithBudget("open", "", "10", schedulingv1beta1.BudgetPolicyGate), "2"),
+				},
+				ExpectStatus: map[api.JobID]scheduling.PodGroupPhase{
+					"ns1/pg1": scheduling.PodGroupPending,
+					"ns1/pg2": scheduling.PodGroupInqueue,
+				},
+			},
+			actions: []framework.Action{enqueue.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "the jobs of the queues over budget are scheduled last",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "ns1", "a-over", 1, nil, schedulingv1beta1.PodGroupInqueue),
+					util.BuildPodGroup("pg2", "ns1", "b-within", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+					util.BuildPod("ns1", "p2", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg2", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					spentQueue(buildQueueWithBudget("a-over", "", "10", ""), "10"),
+					spentQueue(buildQueueWithBudget("b-within", "", "10", ""), "9"),
+				},
+				ExpectBindMap:  map[string]string{"ns1/p2": "n1"},
+				ExpectBindsNum: 1,
+			},
+			actions: []framework.Action{allocate.New()},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			resetCosts()
+			defer resetCosts()
+
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:               PluginName,
+							EnabledQueueOrder:  &trueValue,
+							EnabledJobEnqueued: &trueValue,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(test.actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestNodeShare(t *testing.T) {
+	allocatable := api.NewResource(api.BuildResourceList("4", "8Gi"))
+	tests := []struct {
+		name     string
+		req      *api.Resource
+		expected float64
+	}{
+		{name: "dominant resource", req: api.NewResource(api.BuildResourceList("1", "4Gi")), expected: 0.5},
+		{name: "capped at the whole node", req: api.NewResource(api.BuildResourceList("8", "1Gi")), expected: 1},
+		{name: "empty request", req: api.EmptyResource(), expected: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if share := nodeShare(test.req, allocatable); math.Abs(share-test.expected) > 1e-6 {
+				t.Errorf("expected share %v, got %v", test.expected, share)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/provider"
+)
+
+// Provider provides the cost per hour of the nodes by their instance type, e.g. from the price list of a cloud provider.
+type Provider interface {
+	Prices(ctx context.Context) (map[string]float64, error)
+}
+
+// ProviderBuilder builds a provider from the arguments of the plugin.
+type ProviderBuilder = provider.Builder[Provider]
+
+var providers = provider.NewRegistry(map[string]ProviderBuilder{
+	StaticProvider: newStaticProvider,
+	HTTPProvider:   newHTTPProvider,
+})
+
+// RegisterProvider registers a pricing provider, used by the plugin when named by its arguments.
+func RegisterProvider(name string, builder ProviderBuilder) {
+	providers.Register(name, builder)
+}
+
+// prices keeps the prices of the providers between the sessions. Prices change seldom, so the last prices are kept
+// as long as the provider fails.
+var prices = provider.NewCache("pricing provider of plugin "+PluginName, providers, providerTimeout,
+	func(ctx context.Context, p Provider) (map[string]float64, error) {
+		return p.Prices(ctx)
+	})
+
+// staticProvider provides the prices set in the arguments of the plugin.
+type staticProvider struct {
+	prices map[string]float64
+}
+
+func newStaticProvider(arguments framework.Arguments) (Provider, error) {
+	prices, err := provider.StaticValues(arguments, PricesKey)
+	if err != nil {
+		return nil, err
+	}
+	return &staticProvider{prices: prices}, nil
+}
+
+func (sp *staticProvider) Prices(context.Context) (map[string]float64, error) {
+	return sp.prices, nil
+}
+
+// httpProvider gets the prices from a URL answering with a JSON object of the prices by instance type, e.g.
+// {"m5.large": 0.096, "p4d.24xlarge": 32.77}.
+type httpProvider struct {
+	*provider.HTTPClient
+}
+
+func newHTTPProvider(arguments framework.Arguments) (Provider, error) {
+	client, err := provider.NewHTTPClient(arguments, URLKey, providerTimeout, maxResponseSize)
+	if err != nil {
+		return nil, err
+	}
+	return &httpProvider{HTTPClient: client}, nil
+}
+
+func (hp *httpProvider) Prices(ctx context.Context) (map[string]float64, error) {
+	return hp.Get(ctx)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalocality
+
+import (
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "data-locality"
+
+	// WeightKey is the weight of the node order score of the datasets of the tasks cached on the nodes.
+	WeightKey = "data-locality.weight"
+	// FluidKey enables the cache placement of the Fluid runtimes, read from the labels of the nodes.
+	FluidKey = "data-locality.fluid"
+
+	// fluidLabelPrefix prefixes the labels set by Fluid on the nodes caching a dataset, fluid.io/s-<namespace>-<name>.
+	fluidLabelPrefix = "fluid.io/s-"
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: data-locality
+       arguments:
+         data-locality.weight: 1
+         data-locality.fluid: true
+*/
+
+type dataLocalityPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	weight          int
+	fluid           bool
+}
+
+// New function returns data-locality plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	dp := &dataLocalityPlugin{
+		pluginArguments: arguments,
+		weight:          1,
+		fluid:           true,
+	}
+
+	arguments.GetInt(&dp.weight, WeightKey)
+	if dp.weight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default 1", WeightKey, dp.weight, PluginName)
+		dp.weight = 1
+	}
+	arguments.GetBool(&dp.fluid, FluidKey)
+
+	return dp
+}
+
+func (dp *dataLocalityPlugin) Name() string {
+	return PluginName
+}
+
+func (dp *dataLocalityPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	caches := map[string]map[string]float64{}
+	for name, node := range ssn.Nodes {
+		if node.Node == nil {
+			continue
+		}
+		if cached := dp.cachedDatasets(node.Node); len(cached) > 0 {
+			caches[name] = cached
+		}
+	}
+	if len(caches) == 0 || dp.weight == 0 {
+		klog.V(4).Infof("No dataset cached on the nodes, plugin %s scores no node", PluginName)
+		return
+	}
+
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		cached := caches[node.Name]
+		if len(cached) == 0 {
+			return 0, nil
+		}
+		score := localityScore(taskDatasets(task.Pod), cached) * float64(fwk.MaxNodeScore) * float64(dp.weight)
+		klog.V(5).Infof("Task %s/%s on node %s scored %v by plugin %s", task.Namespace, task.Name, node.Name, score, PluginName)
+		return score, nil
+	}
+	ssn.AddNodeOrderFn(dp.Name(), nodeOrderFn)
+}
+
+// cachedDatasets returns the ratio cached on the node by dataset, <namespace>/<name>, read from the annotation of the
+// node, and by label of Fluid, cached in full.
+func (dp *dataLocalityPlugin) cachedDatasets(node *v1.Node) map[string]float64 {
+	cached := map[string]float64{}
+	for _, entry := range strings.Split(node.Annotations[scheduling.CachedDatasetsAnnotationKey], ",") {
+		dataset, ratio, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if dataset == "" {
+			continue
+		}
+		if !found {
+			cached[dataset] = 1
+			continue
+		}
+		value, err := strconv.ParseFloat(ratio, 64)
+		if err != nil || value < 0 || value > 1 {
+			klog.Warningf("Invalid cached ratio <%s> of dataset %s on node %s, ignored", ratio, dataset, node.Name)
+			continue
+		}
+		cached[dataset] = value
+	}
+	if !dp.fluid {
+		return cached
+	}
+	for key, value := range node.Labels {
+		if !strings.HasPrefix(key, fluidLabelPrefix) || value != "true" {
+			continue
+		}
+		// Namespaces and datasets may both contain dashes, so the label is kept as is and matched by localityScore.
+		cached[key] = 1
+	}
+	return cached
+}
+
+// taskDatasets returns the datasets read by the pod, <namespace>/<name>, listed by its annotation or claimed by its
+// volumes, as the persistent volume claims of the datasets of Fluid are named after them.
+func taskDatasets(pod *v1.Pod) []string {
+	var datasets []string
+	seen := map[string]bool{}
+	add := func(dataset string) {
+		if dataset == "" {
+			return
+		}
+		if !strings.Contains(dataset, "/") {
+			dataset = pod.Namespace + "/" + dataset
+		}
+		if !seen[dataset] {
+			seen[dataset] = true
+			datasets = append(datasets, dataset)
+		}
+	}
+	for _, dataset := range strings.Split(pod.Annotations[scheduling.DatasetsAnnotationKey], ",") {
+		add(strings.TrimSpace(dataset))
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			add(volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	return datasets
+}
+
+// localityScore returns the ratio of the datasets cached on the node between 0 and 1.
+func localityScore(datasets []string, cached map[string]float64) float64 {
+	if len(datasets) == 0 {
+		return 0
+	}
+	var score float64
+	for _, dataset := range datasets {
+		ratio, found := cached[dataset]
+		if !found {
+			ratio = cached[fluidLabelPrefix+strings.Replace(dataset, "/", "-", 1)]
+		}
+		score += ratio
+	}
+	return score / float64(len(datasets))
+}
+
+func (dp *dataLocalityPlugin) OnSessionClose(ssn *framework.Session) {}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalocality
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func buildNode(name string, labels, annotations map[string]string) *v1.Node {
+	node := util.BuildNode(name, api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), labels)
+	node.Annotations = annotations
+	return node
+}
+
+func buildPod(name, datasets string, claims ...string) *v1.Pod {
+	pod := util.BuildPod("c1", name, "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil)
+	if datasets != "" {
+		pod.Annotations[schedulingv1beta1.DatasetsAnnotationKey] = datasets
+	}
+	for _, claim := range claims {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name: claim,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+			},
+		})
+	}
+	return pod
+}
+
+func TestCachedDatasets(t *testing.T) {
+	node := buildNode("n1", map[string]string{
+		"fluid.io/s-team-a-imagenet": "true",
+		"fluid.io/s-team-a-coco":     "false",
+	}, map[string]string{
+		schedulingv1beta1.CachedDatasetsAnnotationKey: "c1/wiki, c1/books=0.5, c1/bad=2, c1/nan=x",
+	})
+
+	dp := New(framework.Arguments{}).(*dataLocalityPlugin)
+	expected := map[string]float64{"c1/wiki": 1, "c1/books": 0.5, "fluid.io/s-team-a-imagenet": 1}
+	if cached := dp.cachedDatasets(node); !reflect.DeepEqual(cached, expected) {
+		t.Errorf("expected cached datasets %v, got %v", expected, cached)
+	}
+
+	dp = New(framework.Arguments{FluidKey: false}).(*dataLocalityPlugin)
+	expected = map[string]float64{"c1/wiki": 1, "c1/books": 0.5}
+	if cached := dp.cachedDatasets(node); !reflect.DeepEqual(cached, expected) {
+		t.Errorf("expected cached datasets %v without Fluid, got %v", expected, cached)
+	}
+}
+
+func TestTaskDatasets(t *testing.T) {
+	pod := buildPod("p1", "wiki, team-a/imagenet,wiki", "imagenet", "wiki")
+	expected := []string{"c1/wiki", "team-a/imagenet", "c1/imagenet"}
+	if datasets := taskDatasets(pod); !reflect.DeepEqual(datasets, expected) {
+		t.Errorf("expected datasets %v, got %v", expected, datasets)
+	}
+}
+
+func TestLocalityScore(t *testing.T) {
+	cached := map[string]float64{"c1/wiki": 1, "c1/books": 0.5, "fluid.io/s-team-a-imagenet": 1}
+	tests := []struct {
+		datasets []string
+		expected float64
+	}{
+		{datasets: nil, expected: 0},
+		{datasets: []string{"c1/wiki"}, expected: 1},
+		{datasets: []string{"team-a/imagenet"}, expected: 1},
+		{datasets: []string{"c1/books", "c1/other"}, expected: 0.25},
+	}
+	for _, test := range tests {
+		if score := localityScore(test.datasets, cached); score != test.expected {
+			t.Errorf("expected score %v for datasets %v, got %v", test.expected, test.datasets, score)
+		}
+	}
+}
+
+func TestDataLocality(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "tasks are placed on the node caching their dataset",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "batch", 2, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				buildPod("p1", "wiki"),
+				buildPod("p2", "wiki"),
+			},
+			Nodes: []*v1.Node{
+				buildNode("n1", nil, map[string]string{schedulingv1beta1.CachedDatasetsAnnotationKey: "c1/wiki=0.2"}),
+				buildNode("n2", nil, map[string]string{schedulingv1beta1.CachedDatasetsAnnotationKey: "c1/wiki"}),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("batch", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n2", "c1/p2": "n2"},
+			ExpectBindsNum: 2,
+		},
+		{
+			Name:    "tasks reading a Fluid dataset are placed on the node labeled by its runtime",
+			Plugins: plugins,
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "batch", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				buildPod("p1", "imagenet"),
+			},
+			Nodes: []*v1.Node{
+				buildNode("n1", nil, nil),
+				buildNode("n2", map[string]string{"fluid.io/s-c1-imagenet": "true"}, nil),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("batch", 1, nil),
+			},
+			ExpectBindMap:  map[string]string{"c1/p1": "n2"},
+			ExpectBindsNum: 1,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:             PluginName,
+					EnabledNodeOrder: &trueValue,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+				},
+			},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadline
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "deadline"
+
+	// DeadlineExceededReason is the reason of the DeadlineMissed condition of the jobs not completed by their deadline.
+	DeadlineExceededReason = "DeadlineExceeded"
+	// DeadlineUnreachableReason is the reason of the DeadlineMissed condition of the jobs expected to end after their
+	// deadline by their runtime estimate.
+	DeadlineUnreachableReason = "DeadlineUnreachable"
+	// DeadlineReachableReason is the reason of the DeadlineMissed condition of the jobs which can meet their deadline
+	// again, e.g. after the deadline is updated.
+	DeadlineReachableReason = "DeadlineReachable"
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: deadline
+     - name: priority
+     - name: gang
+*/
+
+type deadlinePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	now             func() time.Time
+}
+
+// New return deadline plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &deadlinePlugin{
+		pluginArguments: arguments,
+		now:             time.Now,
+	}
+}
+
+func (dp *deadlinePlugin) Name() string {
+	return PluginName
+}
+
+// OnSessionOpen orders the jobs earliest deadline first, the jobs without deadline after the jobs with one.
+func (dp *deadlinePlugin) OnSessionOpen(ssn *framework.Session) {
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+		ld, lfound := lv.Deadline()
+		rd, rfound := rv.Deadline()
+
+		klog.V(4).Infof("Deadline JobOrderFn: <%v/%v> deadline: %v, <%v/%v> deadline: %v",
+			lv.Namespace, lv.Name, ld, rv.Namespace, rv.Name, rd)
+
+		switch {
+		case lfound && !rfound:
+			return -1
+		case !lfound && rfound:
+			return 1
+		case !lfound && !rfound || ld.Equal(rd):
+			return 0
+		case ld.Before(rd):
+			return -1
+		default:
+			return 1
+		}
+	}
+	ssn.AddJobOrderFn(dp.Name(), jobOrderFn)
+}
+
+// OnSessionClose marks the jobs which can no longer complete before their deadline after the decisions of the session.
+func (dp *deadlinePlugin) OnSessionClose(ssn *framework.Session) {
+	now := dp.now()
+	for _, job := range ssn.Jobs {
+		if job.PodGroup == nil || job.PodGroup.Status.Phase == scheduling.PodGroupCompleted || !ssn.JobInProfile(job) {
+			continue
+		}
+		deadline, found := job.Deadline()
+		if !found {
+			continue
+		}
+
+		reason, message := missed(job, deadline, now)
+		marked := deadlineMissed(job)
+		if reason == "" {
+			if marked {
+				dp.updateCondition(ssn, job, v1.ConditionFalse, DeadlineReachableReason,
+					fmt.Sprintf("job can complete before its deadline %s", deadline.Format(time.RFC3339)))
+			}
+			continue
+		}
+		if marked {
+			continue
+		}
+
+		klog.V(3).Infof("Job <%s/%s> misses its deadline %v: %s", job.Namespace, job.Name, deadline, message)
+		metrics.RegisterJobDeadlineMiss(string(job.Queue))
+		dp.updateCondition(ssn, job, v1.ConditionTrue, reason, message)
+	}
+}
+
+// missed returns the reason and the message why the job can no longer complete before the deadline, empty if it can.
+// A pending job is expected to end its runtime estimate from now, a running job when the last running task is
+// expected to end.
+func missed(job *api.JobInfo, deadline, now time.Time) (string, string) {
+	if now.After(deadline) {
+		return DeadlineExceededReason, fmt.Sprintf("job is not completed by its deadline %s", deadline.Format(time.RFC3339))
+	}
+
+	var end time.Time
+	if job.IsPending() || job.PodGroup.Status.Phase == scheduling.PodGroupInqueue {
+		if estimate, found := job.RuntimeEstimate(); found {
+			end = now.Add(estimate)
+		}
+	} else {
+		for _, task := range job.TaskStatusIndex[api.Running] {
+			if taskEnd, found := framework.ExpectedEndTime(job, task); found && taskEnd.After(end) {
+				end = taskEnd
+			}
+		}
+	}
+	if end.After(deadline) {
+		return DeadlineUnreachableReason, fmt.Sprintf("job is expected to end at %s after its deadline %s",
+			end.Format(time.RFC3339), deadline.Format(time.RFC3339))
+	}
+	return "", ""
+}
+
+// deadlineMissed returns whether the job is already marked missing its deadline.
+func deadlineMissed(job *api.JobInfo) bool {
+	for _, cond := range job.PodGroup.Status.Conditions {
+		if cond.Type == scheduling.PodGroupDeadlineMissedType {
+			return cond.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (dp *deadlinePlugin) updateCondition(ssn *framework.Session, job *api.JobInfo, status v1.ConditionStatus, reason, message string) {
+	cond := &scheduling.PodGroupCondition{
+		Type:               scheduling.PodGroupDeadlineMissedType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+	if err := ssn.UpdatePodGroupCondition(job, cond); err != nil {
+		klog.Errorf("Failed to update condition of job <%s/%s>: %v", job.Namespace, job.Name, err)
+	}
+}
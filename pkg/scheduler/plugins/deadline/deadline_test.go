@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadline
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func withDeadline(pg *schedulingv1beta1.PodGroup, deadline time.Time) *schedulingv1beta1.PodGroup {
+	pg.Annotations = map[string]string{schedulingv1beta1.DeadlineKey: deadline.Format(time.RFC3339)}
+	return pg
+}
+
+func TestEarliestDeadlineFirst(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+	now := time.Now()
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "job of the earliest deadline is allocated first",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					withDeadline(util.BuildPodGroup("a-late", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), now.Add(2*time.Hour)),
+					withDeadline(util.BuildPodGroup("b-early", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), now.Add(time.Hour)),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "a-late-1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "a-late", nil, nil),
+					util.BuildPod("c1", "b-early-1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "b-early", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/b-early-1": "n1"},
+				ExpectBindsNum: 1,
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "job with a deadline is allocated before the job without",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("adhoc", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+					withDeadline(util.BuildPodGroup("nightly", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue), now.Add(8*time.Hour)),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "adhoc-1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "adhoc", nil, nil),
+					util.BuildPod("c1", "nightly-1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "nightly", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/nightly-1": "n1"},
+				ExpectBindsNum: 1,
+			},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:            PluginName,
+							EnabledJobOrder: &trueValue,
+						},
+						{
+							Name:                gang.PluginName,
+							EnabledJobReady:     &trueValue,
+							EnabledJobPipelined: &trueValue,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func buildJob(uid string, phase scheduling.PodGroupPhase, annotations map[string]string, pods ...*v1.Pod) *api.JobInfo {
+	job := api.NewJobInfo(api.JobID(uid))
+	pg := &api.PodGroup{}
+	pg.Annotations = annotations
+	pg.Status.Phase = phase
+	job.SetPodGroup(pg)
+	for _, pod := range pods {
+		task := api.NewTaskInfo(pod)
+		task.Job = job.UID
+		job.AddTaskInfo(task)
+	}
+	return job
+}
+
+func TestMissed(t *testing.T) {
+	now := time.Now()
+	deadline := now.Add(time.Hour)
+	running := util.BuildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nil, nil)
+	running.Status.StartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+	estimate := func(value string) map[string]string {
+		return map[string]string{schedulingv1beta1.RuntimeEstimateKey: value}
+	}
+
+	tests := []struct {
+		name     string
+		job      *api.JobInfo
+		deadline time.Time
+		reason   string
+	}{
+		{name: "deadline passed", job: buildJob("j1", scheduling.PodGroupRunning, nil), deadline: now.Add(-time.Minute), reason: DeadlineExceededReason},
+		{name: "pending job without estimate", job: buildJob("j2", scheduling.PodGroupInqueue, nil), deadline: deadline, reason: ""},
+		{name: "pending job ends before the deadline", job: buildJob("j3", scheduling.PodGroupInqueue, estimate("30m")), deadline: deadline, reason: ""},
+		{name: "pending job ends after the deadline", job: buildJob("j4", scheduling.PodGroupPending, estimate("2h")), deadline: deadline, reason: DeadlineUnreachableReason},
+		{name: "running job ends before the deadline", job: buildJob("j5", scheduling.PodGroupRunning, estimate("90m"), running.DeepCopy()), deadline: deadline, reason: ""},
+		{name: "running job ends after the deadline", job: buildJob("j6", scheduling.PodGroupRunning, estimate("3h"), running.DeepCopy()), deadline: deadline, reason: DeadlineUnreachableReason},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if reason, _ := missed(test.job, test.deadline, now); reason != test.reason {
+				t.Errorf("expected reason %q, got %q", test.reason, reason)
+			}
+		})
+	}
+}
+
+func TestMarkDeadlineMissed(t *testing.T) {
+	now := time.Now()
+	missedJob := buildJob("missed", scheduling.PodGroupInqueue, map[string]string{
+		schedulingv1beta1.DeadlineKey:        now.Add(time.Hour).Format(time.RFC3339),
+		schedulingv1beta1.RuntimeEstimateKey: "2h",
+	})
+	reachableJob := buildJob("reachable", scheduling.PodGroupInqueue, map[string]string{
+		schedulingv1beta1.DeadlineKey: now.Add(time.Hour).Format(time.RFC3339),
+	})
+	reachableJob.PodGroup.Status.Conditions = []scheduling.PodGroupCondition{{
+		Type:   scheduling.PodGroupDeadlineMissedType,
+		Status: v1.ConditionTrue,
+		Reason: DeadlineExceededReason,
+	}}
+	ssn := &framework.Session{Jobs: map[api.JobID]*api.JobInfo{missedJob.UID: missedJob, reachableJob.UID: reachableJob}}
+
+	dp := New(framework.Arguments{}).(*deadlinePlugin)
+	dp.now = func() time.Time { return now }
+	dp.OnSessionClose(ssn)
+
+	expected := map[api.JobID]struct {
+		status v1.ConditionStatus
+		reason string
+	}{
+		missedJob.UID:    {status: v1.ConditionTrue, reason: DeadlineUnreachableReason},
+		reachableJob.UID: {status: v1.ConditionFalse, reason: DeadlineReachableReason},
+	}
+	for jobID, e := range expected {
+		conditions := ssn.Jobs[jobID].PodGroup.Status.Conditions
+		if len(conditions) != 1 || conditions[0].Type != scheduling.PodGroupDeadlineMissedType {
+			t.Fatalf("expected one DeadlineMissed condition of job <%s>, got %v", jobID, conditions)
+		}
+		if conditions[0].Status != e.status || conditions[0].Reason != e.reason {
+			t.Errorf("expected condition <%s/%s> of job <%s>, got <%s/%s>",
+				e.status, e.reason, jobID, conditions[0].Status, conditions[0].Reason)
+		}
+	}
+}
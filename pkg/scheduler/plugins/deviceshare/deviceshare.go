@@ -27,7 +27,9 @@ import (
 	fwk "k8s.io/kube-scheduler/framework"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/api/devices/amd/rocm"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/ascend/hami"
+	"volcano.sh/volcano/pkg/scheduler/api/devices/ascend/hccs"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/ascend/mindcluster/ascend310p/vnpu"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/config"
 	"volcano.sh/volcano/pkg/scheduler/api/devices/nvidia/gpushare"
@@ -45,15 +47,22 @@ const (
 	GPUNumberPredicate  = "deviceshare.GPUNumberEnable"
 
 	VGPUEnable = "deviceshare.VGPUEnable"
+	// ROCmEnable is the key for enabling the sharing of the AMD GPUs registered by the ROCm device plugin
+	ROCmEnable = "deviceshare.ROCmEnable"
 
 	AscendMindClusterVNPU = "deviceshare.AscendMindClusterVNPUEnable"
 	AscendHAMiVNPUEnable  = "deviceshare.AscendHAMiVNPUEnable"
+	// AscendHCCSEnable is the key for enabling the allocation of Ascend 910 NPUs by HCCS ring
+	AscendHCCSEnable = "deviceshare.AscendHCCSEnable"
 
 	SchedulePolicyArgument = "deviceshare.SchedulePolicy"
 	ScheduleWeight         = "deviceshare.ScheduleWeight"
 
 	KnownGeometriesCMName      = "deviceshare.KnownGeometriesCMName"
 	KnownGeometriesCMNamespace = "deviceshare.KnownGeometriesCMNamespace"
+
+	// MIGReconfigureEnable is the key for enabling the mig reconfiguration requests to the node agents
+	MIGReconfigureEnable = "deviceshare.MIGReconfigureEnable"
 )
 
 var (
@@ -74,6 +83,10 @@ type deviceSharePlugin struct {
 	persistedGPUs map[string]map[string]map[int]struct{}
 	// persistedPodRules maps nodeName → namespace/name → set of rule indices.
 	persistedPodRules map[string]map[string]map[int]struct{}
+	// migReconfigure enables requesting the node agents to repartition the GPUs in mig mode
+	migReconfigure bool
+	// migRequests collects the mig reconfiguration requests of the session
+	migRequests *migReconfigureRequests
 }
 
 // New return priority plugin
@@ -101,16 +114,22 @@ func enablePredicate(dsp *deviceSharePlugin) {
 	args.GetBool(&gpushare.GpuNumberEnable, GPUNumberPredicate)
 	args.GetBool(&nodeLockEnable, NodeLockEnable)
 	args.GetBool(&vgpu.VGPUEnable, VGPUEnable)
+	args.GetBool(&rocm.ROCmEnable, ROCmEnable)
 	args.GetBool(&vnpu.AscendMindClusterVNPUEnable, AscendMindClusterVNPU)
 	args.GetBool(&hami.AscendHAMiVNPUEnable, AscendHAMiVNPUEnable)
+	args.GetBool(&hccs.AscendHCCSEnable, AscendHCCSEnable)
 
 	gpushare.NodeLockEnable = nodeLockEnable
 	vgpu.NodeLockEnable = nodeLockEnable
+	rocm.NodeLockEnable = nodeLockEnable
 	hami.NodeLockEnable = nodeLockEnable
+	hccs.NodeLockEnable = nodeLockEnable
 
 	args.GetString(&dsp.schedulePolicy, SchedulePolicyArgument)
 	args.GetInt(&dsp.scheduleWeight, ScheduleWeight)
+	args.GetBool(&dsp.migReconfigure, MIGReconfigureEnable)
 	vgpu.SchedulePolicy = dsp.schedulePolicy
+	rocm.SchedulePolicy = dsp.schedulePolicy
 
 	if gpushare.GpuSharingEnable && gpushare.GpuNumberEnable {
 		klog.Fatal("can not define true in both gpu sharing and gpu number")
@@ -135,9 +154,15 @@ func registerDevices() {
 		if vgpu.VGPUEnable {
 			api.RegisterDevice(vgpu.DeviceName)
 		}
+		if rocm.ROCmEnable {
+			api.RegisterDevice(rocm.DeviceName)
+		}
 		if vnpu.AscendMindClusterVNPUEnable {
 			api.RegisterDevice(vnpu.DeviceName)
 		}
+		if hccs.AscendHCCSEnable {
+			api.RegisterDevice(hccs.DeviceName)
+		}
 		if hami.AscendHAMiVNPUEnable {
 			cfg := config.GetConfig()
 			if cfg != nil {
@@ -229,6 +254,11 @@ func (dp *deviceSharePlugin) OnSessionOpen(ssn *framework.Session) {
 	// initialize devices which needs ssn as input
 	initializeDevicesWithSession(ssn)
 
+	if dp.migReconfigure && vgpu.VGPUEnable {
+		dp.migRequests = newMIGReconfigureRequests()
+		vgpu.SetMIGReconfigureHook(dp.migRequests.add)
+	}
+
 	// Register event handlers to update task info in PodLister & nodeMap
 	ssn.AddPredicateFn(dp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
 		predicateStatus := make([]*api.Status, 0)
@@ -337,4 +367,10 @@ func (dp *deviceSharePlugin) OnSessionOpen(ssn *framework.Session) {
 	})
 }
 
-func (dp *deviceSharePlugin) OnSessionClose(ssn *framework.Session) {}
+func (dp *deviceSharePlugin) OnSessionClose(ssn *framework.Session) {
+	if dp.migRequests != nil {
+		vgpu.SetMIGReconfigureHook(nil)
+		dp.migRequests.flush(ssn)
+		dp.migRequests = nil
+	}
+}
@@ -200,3 +200,15 @@ func Test_FilterNode(t *testing.T) {
 		})
 	}
 }
+
+func TestMIGReconfigureRequests(t *testing.T) {
+	requests := newMIGReconfigureRequests()
+	requests.add("n1", "gpu-2", "3g.40gb")
+	requests.add("n1", "gpu-1", "3g.40gb")
+	requests.add("n1", "gpu-2", "1g.10gb")
+
+	expected := "gpu-1=3g.40gb,gpu-2=1g.10gb"
+	if got := encodeMIGReconfigureRequest(requests.requests["n1"]); got != expected {
+		t.Errorf("expected mig reconfiguration request %q, got %q", expected, got)
+	}
+}
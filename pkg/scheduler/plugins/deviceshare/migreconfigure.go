@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deviceshare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// migReconfigureRequests collects the mig reconfiguration requested by the predicates of a session,
+// which are called in parallel.
+type migReconfigureRequests struct {
+	sync.Mutex
+	// requests maps nodeName → GPU UUID → requested profile
+	requests map[string]map[string]string
+}
+
+func newMIGReconfigureRequests() *migReconfigureRequests {
+	return &migReconfigureRequests{requests: map[string]map[string]string{}}
+}
+
+func (r *migReconfigureRequests) add(node, uuid, profile string) {
+	r.Lock()
+	defer r.Unlock()
+	if r.requests[node] == nil {
+		r.requests[node] = map[string]string{}
+	}
+	r.requests[node][uuid] = profile
+}
+
+// flush annotates the nodes with the mig reconfiguration requested for their GPUs, the nodes already
+// annotated with the same request are not patched again.
+func (r *migReconfigureRequests) flush(ssn *framework.Session) {
+	r.Lock()
+	defer r.Unlock()
+	for nodeName, gpus := range r.requests {
+		request := encodeMIGReconfigureRequest(gpus)
+		if node, found := ssn.Nodes[nodeName]; found && node.Node != nil &&
+			node.Node.Annotations[v1beta1.MIGReconfigureRequestAnnotationKey] == request {
+			continue
+		}
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{v1beta1.MIGReconfigureRequestAnnotationKey: request},
+			},
+		})
+		if err != nil {
+			klog.Errorf("Failed to build mig reconfiguration request of node <%s>: %v", nodeName, err)
+			continue
+		}
+		if _, err := ssn.KubeClient().CoreV1().Nodes().Patch(context.TODO(), nodeName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			klog.Warningf("Failed to request mig reconfiguration of node <%s>: %v", nodeName, err)
+			continue
+		}
+		klog.V(3).Infof("Requested mig reconfiguration <%s> of node <%s>", request, nodeName)
+	}
+	r.requests = map[string]map[string]string{}
+}
+
+// encodeMIGReconfigureRequest encodes the requested profiles of the GPUs as <GPU UUID>=<profile> sorted by the UUIDs.
+func encodeMIGReconfigureRequest(gpus map[string]string) string {
+	requests := make([]string, 0, len(gpus))
+	for uuid, profile := range gpus {
+		requests = append(requests, fmt.Sprintf("%s=%s", uuid, profile))
+	}
+	sort.Strings(requests)
+	return strings.Join(requests, ",")
+}
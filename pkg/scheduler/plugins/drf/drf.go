@@ -37,8 +37,15 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
 )
 
-// PluginName indicates name of volcano scheduler plugin.
-const PluginName = "drf"
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "drf"
+
+	// UserLabelKey is the label, or annotation, of the pods naming their user, e.g. submitter. If set, the jobs of
+	// a queue are ordered by the dominant share of their users first, so that a user cannot starve the other users
+	// of the queue by submitting many jobs.
+	UserLabelKey = "drf.userLabel"
+)
 
 var shareDelta = 0.000001
 
@@ -118,13 +125,20 @@ type drfPlugin struct {
 	// hierarchical tree root
 	hierarchicalRoot *hierarchicalNode
 
+	// userLabel is the label, or annotation, naming the user of the jobs, the by-user mode is disabled if empty
+	userLabel string
+	// Key is Job ID, value is the user of the job, empty if the job has no user
+	jobUsers map[api.JobID]string
+	// Key is Queue ID, then user
+	userAttrs map[api.QueueID]map[string]*drfAttr
+
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
 }
 
 // New return drf plugin
 func New(arguments framework.Arguments) framework.Plugin {
-	return &drfPlugin{
+	drf := &drfPlugin{
 		totalResource:  api.EmptyResource(),
 		totalAllocated: api.EmptyResource(),
 		jobAttrs:       map[api.JobID]*drfAttr{},
@@ -136,8 +150,12 @@ func New(arguments framework.Arguments) framework.Plugin {
 			weight:    1,
 			children:  map[string]*hierarchicalNode{},
 		},
+		jobUsers:        map[api.JobID]string{},
+		userAttrs:       map[api.QueueID]map[string]*drfAttr{},
 		pluginArguments: arguments,
 	}
+	arguments.GetString(&drf.userLabel, UserLabelKey)
+	return drf
 }
 
 func (drf *drfPlugin) Name() string {
@@ -211,6 +229,7 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 
 		drf.jobAttrs[job.UID] = attr
+		drf.addUserAllocated(job, attr.allocated)
 
 		if hierarchyEnabled {
 			queue := ssn.Queues[job.Queue]
@@ -236,8 +255,26 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 		_, ls := drf.calculateShare(lalloc, drf.totalResource)
 
 		allocations := map[api.JobID]*api.Resource{}
+		userAllocations := map[string]*api.Resource{}
+		preemptorUser, preemptorQueue := drf.taskUser(ssn, preemptor)
+		var luserShare float64
+		if preemptorUser != "" {
+			_, luserShare = drf.calculateShare(drf.userAttr(preemptorQueue, preemptorUser).allocated.Clone().Add(preemptor.Resreq), drf.totalResource)
+		}
 
 		for _, preemptee := range preemptees {
+			// The users of a queue are compared by their share rather than their jobs
+			if user, queue := drf.taskUser(ssn, preemptee); preemptorUser != "" && user != "" && user != preemptorUser && queue == preemptorQueue {
+				if _, found := userAllocations[user]; !found {
+					userAllocations[user] = drf.userAttr(queue, user).allocated.Clone()
+				}
+				_, rs := drf.calculateShare(userAllocations[user].Sub(preemptee.Resreq), drf.totalResource)
+				if luserShare < rs || math.Abs(luserShare-rs) <= shareDelta {
+					addVictim(preemptee)
+				}
+				continue
+			}
+
 			if _, found := allocations[preemptee.Job]; !found {
 				ratt := drf.jobAttrs[preemptee.Job]
 				if ratt == nil {
@@ -374,6 +411,10 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 		klog.V(4).Infof("DRF JobOrderFn: <%v/%v> share state: %v, <%v/%v> share state: %v",
 			lv.Namespace, lv.Name, drf.jobAttrs[lv.UID].share, rv.Namespace, rv.Name, drf.jobAttrs[rv.UID].share)
 
+		if ret := drf.compareUsers(lv, rv); ret != 0 {
+			return ret
+		}
+
 		if drf.jobAttrs[lv.UID].share == drf.jobAttrs[rv.UID].share {
 			return 0
 		}
@@ -404,6 +445,7 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 			attr.allocated.Add(event.Task.Resreq)
 			drf.updateShare(attr)
+			drf.addUserAllocated(job, event.Task.Resreq)
 			if !ssn.IsJobTerminated(job.UID) {
 				metrics.UpdateJobShare(job.Namespace, job.Name, attr.share)
 			}
@@ -435,6 +477,7 @@ func (drf *drfPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 			attr.allocated.Sub(event.Task.Resreq)
 			drf.updateShare(attr)
+			drf.subUserAllocated(job, event.Task.Resreq)
 			if !ssn.IsJobTerminated(job.UID) {
 				metrics.UpdateJobShare(job.Namespace, job.Name, attr.share)
 			}
@@ -582,4 +625,6 @@ func (drf *drfPlugin) OnSessionClose(session *framework.Session) {
 	drf.totalResource = api.EmptyResource()
 	drf.totalAllocated = api.EmptyResource()
 	drf.jobAttrs = map[api.JobID]*drfAttr{}
+	drf.jobUsers = map[api.JobID]string{}
+	drf.userAttrs = map[api.QueueID]map[string]*drfAttr{}
 }
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drf
+
+import (
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// user returns the user of the job, named by the label, or annotation, of its pods or of its PodGroup, empty if the
+// by-user mode is disabled or the job has no user.
+func (drf *drfPlugin) user(job *api.JobInfo) string {
+	if drf.userLabel == "" {
+		return ""
+	}
+	if user, found := drf.jobUsers[job.UID]; found {
+		return user
+	}
+
+	user := ""
+	for _, task := range job.Tasks {
+		if user = lookupUser(task.Pod.Labels, task.Pod.Annotations, drf.userLabel); user != "" {
+			break
+		}
+	}
+	if user == "" && job.PodGroup != nil {
+		user = lookupUser(job.PodGroup.Labels, job.PodGroup.Annotations, drf.userLabel)
+	}
+	drf.jobUsers[job.UID] = user
+	return user
+}
+
+func lookupUser(labels, annotations map[string]string, key string) string {
+	if user := labels[key]; user != "" {
+		return user
+	}
+	return annotations[key]
+}
+
+// taskUser returns the user and the queue of the job of the task.
+func (drf *drfPlugin) taskUser(ssn *framework.Session, task *api.TaskInfo) (string, api.QueueID) {
+	job := ssn.Jobs[task.Job]
+	if job == nil {
+		return "", ""
+	}
+	return drf.user(job), job.Queue
+}
+
+// userAttr returns the drf attribute of the user in the queue, which accounts the resources allocated to all the
+// jobs of the user in the queue.
+func (drf *drfPlugin) userAttr(queue api.QueueID, user string) *drfAttr {
+	users, found := drf.userAttrs[queue]
+	if !found {
+		users = map[string]*drfAttr{}
+		drf.userAttrs[queue] = users
+	}
+	attr, found := users[user]
+	if !found {
+		attr = &drfAttr{allocated: api.EmptyResource()}
+		users[user] = attr
+	}
+	return attr
+}
+
+func (drf *drfPlugin) addUserAllocated(job *api.JobInfo, resreq *api.Resource) {
+	user := drf.user(job)
+	if user == "" {
+		return
+	}
+	attr := drf.userAttr(job.Queue, user)
+	attr.allocated.Add(resreq)
+	drf.updateShare(attr)
+}
+
+func (drf *drfPlugin) subUserAllocated(job *api.JobInfo, resreq *api.Resource) {
+	user := drf.user(job)
+	if user == "" {
+		return
+	}
+	attr := drf.userAttr(job.Queue, user)
+	attr.allocated.Sub(resreq)
+	drf.updateShare(attr)
+}
+
+// compareUsers orders the jobs of different users of the same queue by the dominant share of their users, lower
+// first. The jobs of different queues, of the same user or without user are left to the other orders.
+func (drf *drfPlugin) compareUsers(l, r *api.JobInfo) int {
+	if l.Queue != r.Queue {
+		return 0
+	}
+	lu, ru := drf.user(l), drf.user(r)
+	if lu == "" || ru == "" || lu == ru {
+		return 0
+	}
+	ls, rs := drf.userAttr(l.Queue, lu).share, drf.userAttr(r.Queue, ru).share
+	if ls == rs {
+		return 0
+	}
+	if ls < rs {
+		return -1
+	}
+	return 1
+}
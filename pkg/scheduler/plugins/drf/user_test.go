@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drf
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestDRFByUser(t *testing.T) {
+	options.Default()
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	alice := map[string]string{"submitter": "alice"}
+	bob := map[string]string{"submitter": "bob"}
+	buildTest := func(name string, bobGroup *schedulingv1.PodGroup, bobLabels map[string]string, expectBindMap map[string]string) uthelper.TestCommonStruct {
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("a1", "default", "q1", 1, nil, schedulingv1.PodGroupRunning),
+				util.BuildPodGroup("a2", "default", "q1", 1, nil, schedulingv1.PodGroupInqueue),
+				bobGroup,
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("default", "a1-p0", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "a1", alice, nil),
+				util.BuildPod("default", "a2-p0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "a2", alice, nil),
+				util.BuildPod("default", "b1-p0", "", v1.PodPending, api.BuildResourceList("1", "1G"), "b1", bobLabels, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+			ExpectBindsNum: 1,
+			ExpectBindMap:  expectBindMap,
+		}
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+	}{
+		{
+			TestCommonStruct: buildTest("jobs of the same share are taken in order regardless of their users",
+				util.BuildPodGroup("b1", "default", "q1", 1, nil, schedulingv1.PodGroupInqueue), bob,
+				map[string]string{"default/a2-p0": "n1"}),
+		},
+		{
+			TestCommonStruct: buildTest("the user of the lower share goes first",
+				util.BuildPodGroup("b1", "default", "q1", 1, nil, schedulingv1.PodGroupInqueue), bob,
+				map[string]string{"default/b1-p0": "n1"}),
+			arguments: framework.Arguments{UserLabelKey: "submitter"},
+		},
+		{
+			TestCommonStruct: buildTest("the user is named by the annotation of the PodGroup",
+				util.BuildPodGroupWithAnno("b1", "default", "q1", 1, nil, schedulingv1.PodGroupInqueue, bob), nil,
+				map[string]string{"default/b1-p0": "n1"}),
+			arguments: framework.Arguments{UserLabelKey: "submitter"},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:               PluginName,
+							EnabledJobOrder:    &trueValue,
+							EnabledPreemptable: &trueValue,
+							Arguments:          test.arguments,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
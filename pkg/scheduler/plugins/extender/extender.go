@@ -40,8 +40,12 @@ const (
 
 	// ExtenderURLPrefix is the key for providing extender endpoint address
 	ExtenderURLPrefix = "extender.urlPrefix"
-	// ExtenderHTTPTimeout is the timeout for extender http calls
+	// ExtenderHTTPTimeout is the timeout for extender http calls, and for the gRPC calls
 	ExtenderHTTPTimeout = "extender.httpTimeout"
+	// ExtenderGRPCAddress is the key for providing the gRPC extender address, the verbs are called over gRPC instead of HTTP when set
+	ExtenderGRPCAddress = "extender.grpcAddress"
+	// ExtenderGRPCTLS indicates whether the gRPC extender is called over TLS
+	ExtenderGRPCTLS = "extender.grpcTLS"
 	// ExtenderOnSessionOpenVerb is the verb of OnSessionOpen method
 	ExtenderOnSessionOpenVerb = "extender.onSessionOpenVerb"
 	// ExtenderOnSessionCloseVerb is the verb of OnSessionClose method
@@ -78,6 +82,8 @@ const (
 type extenderConfig struct {
 	urlPrefix          string
 	httpTimeout        time.Duration
+	grpcAddress        string
+	grpcTLS            bool
 	onSessionOpenVerb  string
 	onSessionCloseVerb string
 	predicateVerb      string
@@ -113,6 +119,8 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 			 - name: extender
 		       arguments:
 				   extender.urlPrefix: http://127.0.0.1
+				   # or, to call the verbs as the methods of the gRPC service volcano.extender.Extender
+				   # extender.grpcAddress: 127.0.0.1:8888
 				   extender.httpTimeout: 100ms
 				   extender.onSessionOpenVerb: onSessionOpen
 				   extender.onSessionCloseVerb: onSessionClose
@@ -131,6 +139,8 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 	*/
 	ec := &extenderConfig{}
 	ec.urlPrefix, _ = arguments[ExtenderURLPrefix].(string)
+	ec.grpcAddress, _ = arguments[ExtenderGRPCAddress].(string)
+	arguments.GetBool(&ec.grpcTLS, ExtenderGRPCTLS)
 	ec.onSessionOpenVerb, _ = arguments[ExtenderOnSessionOpenVerb].(string)
 	ec.onSessionCloseVerb, _ = arguments[ExtenderOnSessionCloseVerb].(string)
 	ec.predicateVerb, _ = arguments[ExtenderPredicateVerb].(string)
@@ -162,7 +172,7 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 
 func New(arguments framework.Arguments) framework.Plugin {
 	cfg := parseExtenderConfig(arguments)
-	klog.V(4).Infof("Initialize extender plugin with endpoint address %s", cfg.urlPrefix)
+	klog.V(4).Infof("Initialize extender plugin with endpoint address %s, gRPC address %s", cfg.urlPrefix, cfg.grpcAddress)
 	return &extenderPlugin{client: http.Client{Timeout: cfg.httpTimeout}, config: cfg}
 }
 
@@ -352,6 +362,10 @@ func (ep *extenderPlugin) OnSessionClose(ssn *framework.Session) {
 }
 
 func (ep *extenderPlugin) send(action string, args interface{}, result interface{}) error {
+	if ep.config.grpcAddress != "" {
+		return ep.invoke(action, args, result)
+	}
+
 	out, err := json.Marshal(args)
 	if err != nil {
 		return err
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11-devel
+// 	protoc        v5.29.3
+// source: extender.proto
+
+package extenderpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Message carries the request or the response of a callback of the extender
+// plugin. The body is the JSON document exchanged with the HTTP extenders, so
+// that both transports share the same schema.
+type Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          []byte                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_extender_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_extender_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_extender_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Message) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+var File_extender_proto protoreflect.FileDescriptor
+
+const file_extender_proto_rawDesc = "" +
+	"\n" +
+	"\x0eextender.proto\x12\x10volcano.extender\"\x1d\n" +
+	"\aMessage\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\fR\x04body2\xce\x06\n" +
+	"\bExtender\x12E\n" +
+	"\rOnSessionOpen\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12F\n" +
+	"\x0eOnSessionClose\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12A\n" +
+	"\tPredicate\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12B\n" +
+	"\n" +
+	"Prioritize\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12C\n" +
+	"\vPreemptable\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12C\n" +
+	"\vReclaimable\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12E\n" +
+	"\rQueueOverused\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12F\n" +
+	"\x0eJobEnqueueable\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12C\n" +
+	"\vJobEnqueued\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12@\n" +
+	"\bJobReady\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12D\n" +
+	"\fAllocateFunc\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.Message\x12F\n" +
+	"\x0eDeallocateFunc\x12\x19.volcano.extender.Message\x1a\x19.volcano.extender.MessageB>Z<volcano.sh/volcano/pkg/scheduler/plugins/extender/extenderpbb\x06proto3"
+
+var (
+	file_extender_proto_rawDescOnce sync.Once
+	file_extender_proto_rawDescData []byte
+)
+
+func file_extender_proto_rawDescGZIP() []byte {
+	file_extender_proto_rawDescOnce.Do(func() {
+		file_extender_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_extender_proto_rawDesc), len(file_extender_proto_rawDesc)))
+	})
+	return file_extender_proto_rawDescData
+}
+
+var file_extender_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_extender_proto_goTypes = []any{
+	(*Message)(nil), // 0: volcano.extender.Message
+}
+var file_extender_proto_depIdxs = []int32{
+	0,  // 0: volcano.extender.Extender.OnSessionOpen:input_type -> volcano.extender.Message
+	0,  // 1: volcano.extender.Extender.OnSessionClose:input_type -> volcano.extender.Message
+	0,  // 2: volcano.extender.Extender.Predicate:input_type -> volcano.extender.Message
+	0,  // 3: volcano.extender.Extender.Prioritize:input_type -> volcano.extender.Message
+	0,  // 4: volcano.extender.Extender.Preemptable:input_type -> volcano.extender.Message
+	0,  // 5: volcano.extender.Extender.Reclaimable:input_type -> volcano.extender.Message
+	0,  // 6: volcano.extender.Extender.QueueOverused:input_type -> volcano.extender.Message
+	0,  // 7: volcano.extender.Extender.JobEnqueueable:input_type -> volcano.extender.Message
+	0,  // 8: volcano.extender.Extender.JobEnqueued:input_type -> volcano.extender.Message
+	0,  // 9: volcano.extender.Extender.JobReady:input_type -> volcano.extender.Message
+	0,  // 10: volcano.extender.Extender.AllocateFunc:input_type -> volcano.extender.Message
+	0,  // 11: volcano.extender.Extender.DeallocateFunc:input_type -> volcano.extender.Message
+	0,  // 12: volcano.extender.Extender.OnSessionOpen:output_type -> volcano.extender.Message
+	0,  // 13: volcano.extender.Extender.OnSessionClose:output_type -> volcano.extender.Message
+	0,  // 14: volcano.extender.Extender.Predicate:output_type -> volcano.extender.Message
+	0,  // 15: volcano.extender.Extender.Prioritize:output_type -> volcano.extender.Message
+	0,  // 16: volcano.extender.Extender.Preemptable:output_type -> volcano.extender.Message
+	0,  // 17: volcano.extender.Extender.Reclaimable:output_type -> volcano.extender.Message
+	0,  // 18: volcano.extender.Extender.QueueOverused:output_type -> volcano.extender.Message
+	0,  // 19: volcano.extender.Extender.JobEnqueueable:output_type -> volcano.extender.Message
+	0,  // 20: volcano.extender.Extender.JobEnqueued:output_type -> volcano.extender.Message
+	0,  // 21: volcano.extender.Extender.JobReady:output_type -> volcano.extender.Message
+	0,  // 22: volcano.extender.Extender.AllocateFunc:output_type -> volcano.extender.Message
+	0,  // 23: volcano.extender.Extender.DeallocateFunc:output_type -> volcano.extender.Message
+	12, // [12:24] is the sub-list for method output_type
+	0,  // [0:12] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_extender_proto_init() }
+func file_extender_proto_init() {
+	if File_extender_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_extender_proto_rawDesc), len(file_extender_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_extender_proto_goTypes,
+		DependencyIndexes: file_extender_proto_depIdxs,
+		MessageInfos:      file_extender_proto_msgTypes,
+	}.Build()
+	File_extender_proto = out.File
+	file_extender_proto_goTypes = nil
+	file_extender_proto_depIdxs = nil
+}
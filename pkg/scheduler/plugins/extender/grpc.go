@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"volcano.sh/volcano/pkg/scheduler/plugins/extender/extenderpb"
+)
+
+const (
+	// GRPCServiceName is the name of the gRPC service implemented by the extenders,
+	// the verbs of the plugin arguments are the names of its methods.
+	GRPCServiceName = "volcano.extender.Extender"
+
+	// GRPCPredicateMethod is the method name of the Predicate callback of RegisterGRPCServer.
+	GRPCPredicateMethod = "Predicate"
+	// GRPCPrioritizeMethod is the method name of the Prioritize callback of RegisterGRPCServer.
+	GRPCPrioritizeMethod = "Prioritize"
+	// GRPCPreemptableMethod is the method name of the Preemptable callback of RegisterGRPCServer.
+	GRPCPreemptableMethod = "Preemptable"
+	// GRPCReclaimableMethod is the method name of the Reclaimable callback of RegisterGRPCServer.
+	GRPCReclaimableMethod = "Reclaimable"
+)
+
+var (
+	grpcConnMutex sync.Mutex
+	// grpcConns are the connections to the extenders by address, they outlive the sessions.
+	grpcConns = map[string]*grpc.ClientConn{}
+)
+
+// getGRPCConn returns the connection to the extender at the address, created on first use.
+func getGRPCConn(address string, useTLS bool) (*grpc.ClientConn, error) {
+	grpcConnMutex.Lock()
+	defer grpcConnMutex.Unlock()
+
+	if conn, found := grpcConns[address]; found {
+		return conn, nil
+	}
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxBodySize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	grpcConns[address] = conn
+	return conn, nil
+}
+
+// invoke calls the method of the extender with the timeout of the plugin, the
+// arguments and the result are carried as the JSON body of the messages.
+func (ep *extenderPlugin) invoke(method string, args interface{}, result interface{}) error {
+	conn, err := getGRPCConn(ep.config.grpcAddress, ep.config.grpcTLS)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ep.config.httpTimeout)
+	defer cancel()
+	out := &extenderpb.Message{}
+	if err := conn.Invoke(ctx, "/"+GRPCServiceName+"/"+method, &extenderpb.Message{Body: body}, out); err != nil {
+		return err
+	}
+	if result == nil || len(out.Body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(out.Body, result)
+}
+
+// GRPCServer is implemented by the extenders written in Go, to be registered with RegisterGRPCServer.
+type GRPCServer interface {
+	Predicate(ctx context.Context, req *PredicateRequest) (*PredicateResponse, error)
+	Prioritize(ctx context.Context, req *PrioritizeRequest) (*PrioritizeResponse, error)
+	Preemptable(ctx context.Context, req *PreemptableRequest) (*PreemptableResponse, error)
+	Reclaimable(ctx context.Context, req *ReclaimableRequest) (*ReclaimableResponse, error)
+}
+
+// RegisterGRPCServer registers the extender as the Extender service of the gRPC server, the
+// other methods of the service are left unimplemented. The extenders handling more callbacks
+// implement extenderpb.ExtenderServer instead.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, srv GRPCServer) {
+	extenderpb.RegisterExtenderServer(s, &grpcServer{srv: srv})
+}
+
+// grpcServer adapts the GRPCServer to the generated service.
+type grpcServer struct {
+	extenderpb.UnimplementedExtenderServer
+	srv GRPCServer
+}
+
+func (s *grpcServer) Predicate(ctx context.Context, in *extenderpb.Message) (*extenderpb.Message, error) {
+	return serveGRPC(ctx, in, s.srv.Predicate)
+}
+
+func (s *grpcServer) Prioritize(ctx context.Context, in *extenderpb.Message) (*extenderpb.Message, error) {
+	return serveGRPC(ctx, in, s.srv.Prioritize)
+}
+
+func (s *grpcServer) Preemptable(ctx context.Context, in *extenderpb.Message) (*extenderpb.Message, error) {
+	return serveGRPC(ctx, in, s.srv.Preemptable)
+}
+
+func (s *grpcServer) Reclaimable(ctx context.Context, in *extenderpb.Message) (*extenderpb.Message, error) {
+	return serveGRPC(ctx, in, s.srv.Reclaimable)
+}
+
+// serveGRPC decodes the request from the message body and encodes the response of the callback in the reply.
+func serveGRPC[Req, Resp any](ctx context.Context, in *extenderpb.Message, fn func(context.Context, *Req) (*Resp, error)) (*extenderpb.Message, error) {
+	req := new(Req)
+	if err := json.Unmarshal(in.Body, req); err != nil {
+		return nil, err
+	}
+	resp, err := fn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &extenderpb.Message{Body: body}, nil
+}
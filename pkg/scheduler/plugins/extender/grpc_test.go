@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+type fakeGRPCServer struct{}
+
+func (fakeGRPCServer) Predicate(_ context.Context, req *PredicateRequest) (*PredicateResponse, error) {
+	if req.Node.Name == "n2" {
+		return &PredicateResponse{ErrorMessage: "node n2 is reserved"}, nil
+	}
+	return &PredicateResponse{}, nil
+}
+
+func (fakeGRPCServer) Prioritize(_ context.Context, req *PrioritizeRequest) (*PrioritizeResponse, error) {
+	scores := map[string]float64{}
+	for i, node := range req.Nodes {
+		scores[node.Name] = float64(i)
+	}
+	return &PrioritizeResponse{NodeScore: scores}, nil
+}
+
+func (fakeGRPCServer) Preemptable(_ context.Context, req *PreemptableRequest) (*PreemptableResponse, error) {
+	return &PreemptableResponse{Status: util.Permit, Victims: req.Evictees[:1]}, nil
+}
+
+func (fakeGRPCServer) Reclaimable(_ context.Context, req *ReclaimableRequest) (*ReclaimableResponse, error) {
+	return &ReclaimableResponse{Status: util.Reject}, nil
+}
+
+func TestGRPCExtender(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	var (
+		methodsMutex sync.Mutex
+		methods      []string
+	)
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		methodsMutex.Lock()
+		methods = append(methods, info.FullMethod)
+		methodsMutex.Unlock()
+		return handler(ctx, req)
+	}))
+	RegisterGRPCServer(server, fakeGRPCServer{})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	plugin := New(framework.Arguments{
+		ExtenderGRPCAddress:     listener.Addr().String(),
+		ExtenderHTTPTimeout:     "5s",
+		ExtenderPredicateVerb:   GRPCPredicateMethod,
+		ExtenderPreemptableVerb: GRPCPreemptableMethod,
+		ExtenderReclaimableVerb: GRPCReclaimableMethod,
+	}).(*extenderPlugin)
+	if plugin.config.httpTimeout != 5*time.Second {
+		t.Errorf("expected the timeout to be parsed, got %v", plugin.config.httpTimeout)
+	}
+
+	task := &api.TaskInfo{Namespace: "ns", Name: "p1"}
+	resp := &PredicateResponse{}
+	if err := plugin.send(plugin.config.predicateVerb, &PredicateRequest{Task: task, Node: &api.NodeInfo{Name: "n1"}}, resp); err != nil || resp.ErrorMessage != "" {
+		t.Errorf("expected node n1 to fit, got %v, %q", err, resp.ErrorMessage)
+	}
+	if err := plugin.send(plugin.config.predicateVerb, &PredicateRequest{Task: task, Node: &api.NodeInfo{Name: "n2"}}, resp); err != nil || resp.ErrorMessage != "node n2 is reserved" {
+		t.Errorf("expected node n2 not to fit, got %v, %q", err, resp.ErrorMessage)
+	}
+
+	victims := []*api.TaskInfo{{Namespace: "ns", Name: "v1"}, {Namespace: "ns", Name: "v2"}}
+	preemptable := &PreemptableResponse{}
+	if err := plugin.send(plugin.config.preemptableVerb, &PreemptableRequest{Evictor: task, Evictees: victims}, preemptable); err != nil {
+		t.Fatalf("failed to call Preemptable: %v", err)
+	}
+	if preemptable.Status != util.Permit || len(preemptable.Victims) != 1 || preemptable.Victims[0].Name != "v1" {
+		t.Errorf("unexpected preemptable response %+v", preemptable)
+	}
+
+	reclaimable := &ReclaimableResponse{}
+	if err := plugin.send(plugin.config.reclaimableVerb, &ReclaimableRequest{Evictor: task, Evictees: victims}, reclaimable); err != nil || reclaimable.Status != util.Reject {
+		t.Errorf("expected reclaim to be rejected, got %v, %+v", err, reclaimable)
+	}
+
+	if err := plugin.send("Unknown", &PredicateRequest{Task: task, Node: &api.NodeInfo{Name: "n1"}}, resp); err == nil {
+		t.Errorf("expected the unknown method to fail")
+	}
+
+	methodsMutex.Lock()
+	defer methodsMutex.Unlock()
+	if len(methods) == 0 || methods[0] != "/volcano.extender.Extender/Predicate" {
+		t.Errorf("expected the interceptor to see the full method name, got %v", methods)
+	}
+}
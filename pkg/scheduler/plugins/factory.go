@@ -22,14 +22,25 @@ package plugins
 
 import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/aging"
 	"volcano.sh/volcano/pkg/scheduler/plugins/binpack"
 	"volcano.sh/volcano/pkg/scheduler/plugins/capacity"
+	carbonaware "volcano.sh/volcano/pkg/scheduler/plugins/carbon-aware"
 	"volcano.sh/volcano/pkg/scheduler/plugins/cdp"
 	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/cost"
+	datalocality "volcano.sh/volcano/pkg/scheduler/plugins/data-locality"
+	"volcano.sh/volcano/pkg/scheduler/plugins/deadline"
 	"volcano.sh/volcano/pkg/scheduler/plugins/deviceshare"
 	"volcano.sh/volcano/pkg/scheduler/plugins/drf"
 	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
+	fairshare "volcano.sh/volcano/pkg/scheduler/plugins/fair-share"
+	fairnessaudit "volcano.sh/volcano/pkg/scheduler/plugins/fairness-audit"
+	"volcano.sh/volcano/pkg/scheduler/plugins/fragmentation"
 	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	gangspread "volcano.sh/volcano/pkg/scheduler/plugins/gang-spread"
+	gangtopology "volcano.sh/volcano/pkg/scheduler/plugins/gang-topology"
+	"volcano.sh/volcano/pkg/scheduler/plugins/hotspare"
 	networktopologyaware "volcano.sh/volcano/pkg/scheduler/plugins/network-topology-aware"
 	"volcano.sh/volcano/pkg/scheduler/plugins/nodegroup"
 	"volcano.sh/volcano/pkg/scheduler/plugins/nodeorder"
@@ -42,10 +53,14 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/rescheduling"
 	resourcestrategyfit "volcano.sh/volcano/pkg/scheduler/plugins/resource-strategy-fit"
 	"volcano.sh/volcano/pkg/scheduler/plugins/resourcequota"
+	schedulinggate "volcano.sh/volcano/pkg/scheduler/plugins/scheduling-gate"
+	"volcano.sh/volcano/pkg/scheduler/plugins/sizing"
 	"volcano.sh/volcano/pkg/scheduler/plugins/sla"
+	"volcano.sh/volcano/pkg/scheduler/plugins/spot"
 	tasktopology "volcano.sh/volcano/pkg/scheduler/plugins/task-topology"
 	"volcano.sh/volcano/pkg/scheduler/plugins/tdm"
 	"volcano.sh/volcano/pkg/scheduler/plugins/usage"
+	"volcano.sh/volcano/pkg/scheduler/plugins/wasm"
 )
 
 func init() {
@@ -55,6 +70,8 @@ func init() {
 	framework.RegisterPluginBuilder(deviceshare.PluginName, deviceshare.New)
 	framework.RegisterPluginBuilder(predicates.PluginName, predicates.New)
 	framework.RegisterPluginBuilder(priority.PluginName, priority.New)
+	framework.RegisterPluginBuilder(deadline.PluginName, deadline.New)
+	framework.RegisterPluginBuilder(aging.PluginName, aging.New)
 	framework.RegisterPluginBuilder(nodeorder.PluginName, nodeorder.New)
 	framework.RegisterPluginBuilder(conformance.PluginName, conformance.New)
 	framework.RegisterPluginBuilder(binpack.PluginName, binpack.New)
@@ -70,10 +87,23 @@ func init() {
 	framework.RegisterPluginBuilder(pdb.PluginName, pdb.New)
 	framework.RegisterPluginBuilder(nodegroup.PluginName, nodegroup.New)
 	framework.RegisterPluginBuilder(networktopologyaware.PluginName, networktopologyaware.New)
+	framework.RegisterPluginBuilder(hotspare.PluginName, hotspare.New)
+	framework.RegisterPluginBuilder(sizing.PluginName, sizing.New)
+	framework.RegisterPluginBuilder(fragmentation.PluginName, fragmentation.New)
+	framework.RegisterPluginBuilder(gangtopology.PluginName, gangtopology.New)
+	framework.RegisterPluginBuilder(gangspread.PluginName, gangspread.New)
+	framework.RegisterPluginBuilder(wasm.PluginName, wasm.New)
+	framework.RegisterPluginBuilder(carbonaware.PluginName, carbonaware.New)
+	framework.RegisterPluginBuilder(spot.PluginName, spot.New)
+	framework.RegisterPluginBuilder(datalocality.PluginName, datalocality.New)
+	framework.RegisterPluginBuilder(schedulinggate.PluginName, schedulinggate.New)
 
 	// Plugins for Queues
 	framework.RegisterPluginBuilder(proportion.PluginName, proportion.New)
 	framework.RegisterPluginBuilder(capacity.PluginName, capacity.New)
+	framework.RegisterPluginBuilder(fairnessaudit.PluginName, fairnessaudit.New)
+	framework.RegisterPluginBuilder(cost.PluginName, cost.New)
+	framework.RegisterPluginBuilder(fairshare.PluginName, fairshare.New)
 
 	// Plugins for Extender
 	framework.RegisterPluginBuilder(extender.PluginName, extender.New)
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/api/helpers"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "fair-share"
+
+	// HalfLifeKey is the time after which the usage of a queue counts half.
+	HalfLifeKey = "fair-share.halfLife"
+	// WindowKey is the length of the sliding window the usage of the queues is tracked over.
+	WindowKey = "fair-share.window"
+	// BucketKey is the length of the buckets the usage is accounted in, the usage of a bucket decays as a whole.
+	BucketKey = "fair-share.bucket"
+	// ConfigMapKey is the ConfigMap, <namespace>/<name>, the usage is persisted to across restarts of the scheduler.
+	ConfigMapKey = "fair-share.configMap"
+	// PersistIntervalKey is the interval the usage is persisted at.
+	PersistIntervalKey = "fair-share.persistInterval"
+
+	defaultHalfLife        = 24 * time.Hour
+	defaultWindow          = 7 * 24 * time.Hour
+	defaultBucket          = time.Hour
+	defaultConfigMap       = "volcano-system/volcano-fair-share"
+	defaultPersistInterval = time.Minute
+
+	rootQueueID = api.QueueID("root")
+
+	// factorEpsilon is the precision of the fair-share factors.
+	factorEpsilon = 1e-6
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: priority
+     - name: gang
+     - name: fair-share
+       arguments:
+         fair-share.halfLife: 24h
+         fair-share.window: 168h
+         fair-share.bucket: 1h
+         fair-share.configMap: volcano-system/volcano-fair-share
+         fair-share.persistInterval: 1m
+   - plugins:
+     - name: predicates
+     - name: proportion
+*/
+
+// usageState is the usage history of the queues, along with the times it was loaded and persisted.
+type usageState struct {
+	history *usageHistory
+	// loaded is whether the history was loaded from the ConfigMap since the scheduler started.
+	loaded bool
+	// persisted is the last time the history was persisted.
+	persisted time.Time
+}
+
+// usage is the usage of the queues in the window, charged in every session and persisted to the ConfigMap so that it
+// survives a restart of the scheduler.
+var usage = framework.PluginValue(PluginName, "usage", usageState{history: newUsageHistory()})
+
+type fairSharePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	halfLife        time.Duration
+	window          time.Duration
+	bucket          time.Duration
+	cmNamespace     string
+	cmName          string
+	persistInterval time.Duration
+	now             func() time.Time
+
+	// factors is the fair-share factor of the queues, between 0 and 1, the higher the more underserved.
+	factors map[api.QueueID]float64
+}
+
+// New function returns fair-share plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	fp := &fairSharePlugin{
+		pluginArguments: arguments,
+		halfLife:        getDuration(arguments, HalfLifeKey, defaultHalfLife),
+		window:          getDuration(arguments, WindowKey, defaultWindow),
+		bucket:          getDuration(arguments, BucketKey, defaultBucket),
+		persistInterval: getDuration(arguments, PersistIntervalKey, defaultPersistInterval),
+		now:             time.Now,
+		factors:         map[api.QueueID]float64{},
+	}
+
+	configMap := defaultConfigMap
+	arguments.GetString(&configMap, ConfigMapKey)
+	namespace, name, found := strings.Cut(configMap, "/")
+	if !found || namespace == "" || name == "" {
+		klog.Warningf("Invalid %s <%s> in plugin %s, using default %s", ConfigMapKey, configMap, PluginName, defaultConfigMap)
+		namespace, name, _ = strings.Cut(defaultConfigMap, "/")
+	}
+	fp.cmNamespace, fp.cmName = namespace, name
+
+	return fp
+}
+
+func getDuration(arguments framework.Arguments, key string, defaultValue time.Duration) time.Duration {
+	var value string
+	arguments.GetString(&value, key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		klog.Warningf("Invalid %s <%s> in plugin %s, using default %v", key, value, PluginName, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+func (fp *fairSharePlugin) Name() string {
+	return PluginName
+}
+
+// OnSessionOpen charges the queues for the resources their tasks have occupied since the last session, then orders
+// the queues, and the jobs of different queues, by their fair-share factor, so that the queues which recently used
+// more than their share yield to the underserved queues.
+func (fp *fairSharePlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(4).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(4).Infof("Leaving %s plugin.", PluginName)
+
+	now := fp.now()
+	usage.Update(func(state *usageState) {
+		if !state.loaded {
+			if restored := fp.load(ssn.KubeClient()); restored != nil {
+				state.history = restored
+			}
+			state.loaded = true
+		}
+		fp.accrue(ssn, state.history, now)
+		fp.computeFactors(ssn, state.history, now)
+		if now.Sub(state.persisted) >= fp.persistInterval {
+			fp.persist(ssn.KubeClient(), state.history)
+			state.persisted = now
+		}
+	})
+
+	ssn.AddQueueOrderFn(fp.Name(), func(l, r interface{}) int {
+		lv := l.(*api.QueueInfo)
+		rv := r.(*api.QueueInfo)
+		return fp.compare(lv.UID, rv.UID)
+	})
+
+	ssn.AddJobOrderFn(fp.Name(), func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+		if lv.Queue == rv.Queue {
+			return 0
+		}
+		return fp.compare(lv.Queue, rv.Queue)
+	})
+}
+
+func (fp *fairSharePlugin) OnSessionClose(ssn *framework.Session) {}
+
+// compare orders the queue of the higher fair-share factor first.
+func (fp *fairSharePlugin) compare(l, r api.QueueID) int {
+	lf, rf := fp.factors[l], fp.factors[r]
+	if math.Abs(lf-rf) < factorEpsilon {
+		return 0
+	}
+	if lf > rf {
+		return -1
+	}
+	return 1
+}
+
+// accrue charges the queues for the dominant share of the cluster their tasks have occupied since the last session,
+// in cluster-hours. The time the scheduler was down is charged for one bucket at most.
+func (fp *fairSharePlugin) accrue(ssn *framework.Session, history *usageHistory, now time.Time) {
+	last := history.LastAccrual
+	history.LastAccrual = now
+	defer history.expire(now.Add(-fp.window))
+	if last.IsZero() || !now.After(last) {
+		return
+	}
+	elapsed := min(now.Sub(last), fp.bucket).Hours()
+
+	charges := map[api.QueueID]float64{}
+	for _, job := range ssn.Jobs {
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				charges[job.Queue] += dominantShare(task.Resreq, ssn.TotalResource) * elapsed
+			}
+		}
+	}
+	start := now.Truncate(fp.bucket)
+	for queueID, charge := range charges {
+		history.add(queueID, start, charge)
+	}
+}
+
+// computeFactors computes the fair-share factor of the queues, 2^(-U/S) as in HPC fair-share: U is the decayed usage
+// of the queue normalized by the usage of all the queues, S is its weight normalized by the weights of all the queues.
+// A queue which used exactly its share has a factor of 0.5, a queue which used nothing a factor of 1.
+func (fp *fairSharePlugin) computeFactors(ssn *framework.Session, history *usageHistory, now time.Time) {
+	decayed := map[api.QueueID]float64{}
+	var totalUsage, totalWeight float64
+	for queueID, queue := range ssn.Queues {
+		if queueID == rootQueueID {
+			continue
+		}
+		decayed[queueID] = history.decayed(queueID, now, fp.halfLife)
+		totalUsage += decayed[queueID]
+		totalWeight += float64(queue.Weight)
+	}
+
+	for queueID, queue := range ssn.Queues {
+		if queueID == rootQueueID {
+			continue
+		}
+		normalizedUsage := helpers.Share(decayed[queueID], totalUsage)
+		normalizedShare := helpers.Share(float64(queue.Weight), totalWeight)
+		factor := 1.0
+		if normalizedShare > 0 {
+			factor = math.Exp2(-normalizedUsage / normalizedShare)
+		} else if normalizedUsage > 0 {
+			factor = 0
+		}
+		fp.factors[queueID] = factor
+		klog.V(4).Infof("Queue <%s>: decayed usage %.4f cluster-hours, normalized usage %.4f, normalized share %.4f, fair-share factor %.4f",
+			queue.Name, decayed[queueID], normalizedUsage, normalizedShare, factor)
+	}
+}
+
+// dominantShare is the dominant share of the total resources requested by the task.
+func dominantShare(req, total *api.Resource) float64 {
+	if req == nil || total == nil {
+		return 0
+	}
+	share := 0.0
+	for _, rn := range total.ResourceNames() {
+		share = max(share, helpers.Share(req.Get(rn), total.Get(rn)))
+	}
+	return min(share, 1)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestUsageHistory(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	h := newUsageHistory()
+	h.add("q1", now.Add(-48*time.Hour), 4)
+	h.add("q1", now.Add(-24*time.Hour), 2)
+	h.add("q1", now.Add(-24*time.Hour), 2)
+	h.add("q2", now.Add(-49*time.Hour), 1)
+
+	if n := len(h.Queues["q1"]); n != 2 {
+		t.Fatalf("expected the usage of the same bucket merged, got %d buckets", n)
+	}
+
+	// 4 cluster-hours 2 half-lives ago, and 4 cluster-hours 1 half-life ago
+	expected := 4*0.25 + 4*0.5
+	if decayed := h.decayed("q1", now, 24*time.Hour); math.Abs(decayed-expected) > 1e-9 {
+		t.Errorf("expected decayed usage %v, got %v", expected, decayed)
+	}
+
+	h.expire(now.Add(-47 * time.Hour))
+	if _, found := h.Queues["q2"]; found {
+		t.Errorf("expected the queue without usage in the window dropped")
+	}
+	expected = 4 * 0.5
+	if decayed := h.decayed("q1", now, 24*time.Hour); math.Abs(decayed-expected) > 1e-9 {
+		t.Errorf("expected decayed usage %v after expiry, got %v", expected, decayed)
+	}
+}
+
+func TestPersistAndLoad(t *testing.T) {
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	saved := newUsageHistory()
+	saved.LastAccrual = start.Add(10 * time.Minute)
+	saved.add("q1", start, 1.5)
+	data, err := json.Marshal(saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kubeClient := fake.NewSimpleClientset()
+	fp := New(framework.Arguments{ConfigMapKey: "kube-system/usage"}).(*fairSharePlugin)
+	writeConfigMap(kubeClient, fp.cmNamespace, fp.cmName, string(data))
+	// Updated in place once it exists
+	writeConfigMap(kubeClient, fp.cmNamespace, fp.cmName, string(data))
+
+	loaded := fp.load(kubeClient)
+	if loaded == nil || !loaded.LastAccrual.Equal(saved.LastAccrual) || len(loaded.Queues["q1"]) != 1 || loaded.Queues["q1"][0].Usage != 1.5 {
+		t.Errorf("expected usage %+v loaded, got %+v", saved, loaded)
+	}
+}
+
+func TestFairShare(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+				},
+				{
+					Name:              PluginName,
+					EnabledQueueOrder: &trueValue,
+					EnabledJobOrder:   &trueValue,
+				},
+			},
+		},
+	}
+	buildPod := func(name, group string) *v1.Pod {
+		return util.BuildPod("c1", name, "", v1.PodPending, api.BuildResourceList("2", "2Gi"), group, nil, nil)
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		usage map[api.QueueID]float64
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "the queue which used less than its share goes first",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+					util.BuildPodGroup("pg2", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					buildPod("p1", "pg1"),
+					buildPod("p2", "pg2"),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 1, nil),
+					util.BuildQueue("q2", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p2": "n1"},
+				ExpectBindsNum: 1,
+			},
+			usage: map[api.QueueID]float64{"q1": 10, "q2": 2},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "the usage is weighed by the share of the queue",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+					util.BuildPodGroup("pg2", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					buildPod("p1", "pg1"),
+					buildPod("p2", "pg2"),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("q1", 10, nil),
+					util.BuildQueue("q2", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+				ExpectBindsNum: 1,
+			},
+			usage: map[api.QueueID]float64{"q1": 10, "q2": 2},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			history := newUsageHistory()
+			for queueID, charge := range test.usage {
+				history.add(queueID, time.Now().Truncate(time.Hour), charge)
+			}
+			usage.Store(usageState{history: history, loaded: true})
+
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
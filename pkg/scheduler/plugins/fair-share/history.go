@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// usageDataKey is the key of the usage in the data of the ConfigMap.
+const usageDataKey = "usage.json"
+
+// bucket is the usage of a queue in cluster-hours from the start of the bucket.
+type bucket struct {
+	Start time.Time `json:"start"`
+	Usage float64   `json:"usage"`
+}
+
+// usageHistory is the usage of the queues over the sliding window, persisted to the ConfigMap as JSON.
+type usageHistory struct {
+	// LastAccrual is the last time the running tasks were charged.
+	LastAccrual time.Time `json:"lastAccrual"`
+	// Queues is the buckets of usage of the queues, oldest first.
+	Queues map[api.QueueID][]bucket `json:"queues"`
+}
+
+func newUsageHistory() *usageHistory {
+	return &usageHistory{Queues: map[api.QueueID][]bucket{}}
+}
+
+// add charges the queue for the usage in the bucket starting at start.
+func (h *usageHistory) add(queueID api.QueueID, start time.Time, charge float64) {
+	buckets := h.Queues[queueID]
+	if n := len(buckets); n > 0 && buckets[n-1].Start.Equal(start) {
+		buckets[n-1].Usage += charge
+		return
+	}
+	h.Queues[queueID] = append(buckets, bucket{Start: start, Usage: charge})
+}
+
+// expire drops the buckets started before the sliding window.
+func (h *usageHistory) expire(windowStart time.Time) {
+	for queueID, buckets := range h.Queues {
+		i := 0
+		for i < len(buckets) && buckets[i].Start.Before(windowStart) {
+			i++
+		}
+		if i == len(buckets) {
+			delete(h.Queues, queueID)
+			continue
+		}
+		h.Queues[queueID] = buckets[i:]
+	}
+}
+
+// decayed returns the usage of the queue in the window, every bucket decayed by its age from its start.
+func (h *usageHistory) decayed(queueID api.QueueID, now time.Time, halfLife time.Duration) float64 {
+	total := 0.0
+	for _, b := range h.Queues[queueID] {
+		age := max(now.Sub(b.Start), 0)
+		total += b.Usage * math.Exp2(-float64(age)/float64(halfLife))
+	}
+	return total
+}
+
+// load returns the usage persisted by the previous scheduler, nil if there is none.
+func (fp *fairSharePlugin) load(kubeClient kubernetes.Interface) *usageHistory {
+	if kubeClient == nil {
+		return nil
+	}
+	cm, err := kubeClient.CoreV1().ConfigMaps(fp.cmNamespace).Get(context.TODO(), fp.cmName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to load the usage of the queues from ConfigMap <%s/%s>: %v", fp.cmNamespace, fp.cmName, err)
+		}
+		return nil
+	}
+	restored := newUsageHistory()
+	if err := json.Unmarshal([]byte(cm.Data[usageDataKey]), restored); err != nil {
+		klog.Errorf("Failed to parse the usage of the queues in ConfigMap <%s/%s>: %v", fp.cmNamespace, fp.cmName, err)
+		return nil
+	}
+	if restored.Queues == nil {
+		restored.Queues = map[api.QueueID][]bucket{}
+	}
+	klog.V(3).Infof("Loaded the usage of %d queues from ConfigMap <%s/%s>", len(restored.Queues), fp.cmNamespace, fp.cmName)
+	return restored
+}
+
+// persist writes the usage history to the ConfigMap in the background.
+func (fp *fairSharePlugin) persist(kubeClient kubernetes.Interface, history *usageHistory) {
+	if kubeClient == nil {
+		return
+	}
+	data, err := json.Marshal(history)
+	if err != nil {
+		klog.Errorf("Failed to marshal the usage of the queues: %v", err)
+		return
+	}
+	go writeConfigMap(kubeClient, fp.cmNamespace, fp.cmName, string(data))
+}
+
+func writeConfigMap(kubeClient kubernetes.Interface, namespace, name, data string) {
+	configMaps := kubeClient.CoreV1().ConfigMaps(namespace)
+	cm, err := configMaps.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Data:       map[string]string{usageDataKey: data},
+		}
+		_, err = configMaps.Create(context.TODO(), cm, metav1.CreateOptions{})
+	} else if err == nil {
+		cm = cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[usageDataKey] = data
+		_, err = configMaps.Update(context.TODO(), cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.Errorf("Failed to persist the usage of the queues to ConfigMap <%s/%s>: %v", namespace, name, err)
+	}
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairnessaudit
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/api/helpers"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "fairness-audit"
+
+	// WindowKey is the length of the sliding window the realized share of queues is audited over.
+	WindowKey = "fairness-audit.window"
+	// ToleranceKey is the fraction of the entitled share a queue may stay below without being reported.
+	ToleranceKey = "fairness-audit.tolerance"
+
+	defaultWindow    = 10 * time.Minute
+	defaultTolerance = 0.2
+
+	// FairShareViolatedReason is the reason of the condition when the queue violates its entitled share.
+	FairShareViolatedReason = "RealizedShareBelowEntitled"
+	// FairShareSatisfiedReason is the reason of the condition when the queue gets its entitled share again.
+	FairShareSatisfiedReason = "RealizedShareSatisfied"
+
+	// shareEpsilon is the precision of the share computation.
+	shareEpsilon = 1e-6
+)
+
+// sample is the share of one queue observed at the close of one session.
+type sample struct {
+	timestamp time.Time
+	realized  float64
+	entitled  float64
+}
+
+// queueHistory is the audit history of one queue with pending demand.
+type queueHistory struct {
+	// since is the time the queue has continuously had demand from.
+	since    time.Time
+	samples  []sample
+	violated bool
+}
+
+// histories are the audit histories of the queues with demand, sampled when every session closes over the window.
+var histories = framework.QueueStates[*queueHistory](PluginName, "histories")
+
+type fairnessAuditPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	window          time.Duration
+	tolerance       float64
+	now             func() time.Time
+}
+
+// New function returns fairness audit plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	fp := &fairnessAuditPlugin{
+		pluginArguments: arguments,
+		window:          defaultWindow,
+		tolerance:       defaultTolerance,
+		now:             time.Now,
+	}
+
+	var window string
+	arguments.GetString(&window, WindowKey)
+	if window != "" {
+		if d, err := time.ParseDuration(window); err != nil || d <= 0 {
+			klog.Warningf("Invalid %s <%s> in plugin %s, using default %v", WindowKey, window, PluginName, defaultWindow)
+		} else {
+			fp.window = d
+		}
+	}
+	arguments.GetFloat64(&fp.tolerance, ToleranceKey)
+	if fp.tolerance < 0 || fp.tolerance >= 1 {
+		klog.Warningf("Invalid %s <%v> in plugin %s, using default %v", ToleranceKey, fp.tolerance, PluginName, defaultTolerance)
+		fp.tolerance = defaultTolerance
+	}
+
+	return fp
+}
+
+func (fp *fairnessAuditPlugin) Name() string {
+	return PluginName
+}
+
+func (fp *fairnessAuditPlugin) OnSessionOpen(ssn *framework.Session) {}
+
+// OnSessionClose records the realized and entitled share of every queue after the decisions of the session,
+// and reports the queues whose realized share stayed below the entitled share over the audit window.
+func (fp *fairnessAuditPlugin) OnSessionClose(ssn *framework.Session) {
+	klog.V(4).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(4).Infof("Leaving %s plugin.", PluginName)
+
+	now := fp.now()
+	realized, demand := queueShares(ssn)
+	entitled := entitledShares(ssn, demand)
+
+	for queueID, queue := range ssn.Queues {
+		if demand[queueID] <= shareEpsilon {
+			// A queue without demand can not be treated unfairly, restart its audit.
+			if h, found := histories.Get(queueID); found && h.violated {
+				fp.updateCondition(ssn, queue, false, "queue has no demand")
+			}
+			histories.Delete(queueID)
+			continue
+		}
+
+		h, found := histories.Get(queueID)
+		if !found {
+			h = &queueHistory{since: now}
+			histories.Set(queueID, h)
+		}
+		h.samples = append(h.samples, sample{timestamp: now, realized: realized[queueID], entitled: entitled[queueID]})
+		for len(h.samples) > 0 && now.Sub(h.samples[0].timestamp) > fp.window {
+			h.samples = h.samples[1:]
+		}
+
+		avgRealized, avgEntitled := h.average()
+		metrics.UpdateQueueFairShare(queue.Name, avgRealized, avgEntitled)
+		klog.V(4).Infof("Queue <%s> realized share <%0.4f>, entitled share <%0.4f> over %v",
+			queue.Name, avgRealized, avgEntitled, fp.window)
+
+		// Only report a queue after it has been audited over a whole window, to ignore transient starvation.
+		violated := now.Sub(h.since) >= fp.window && avgRealized < avgEntitled*(1-fp.tolerance)
+		if violated == h.violated {
+			continue
+		}
+		h.violated = violated
+		metrics.UpdateQueueFairShareViolated(queue.Name, violated)
+		if violated {
+			metrics.RegisterQueueFairShareViolation(queue.Name)
+			klog.V(3).Infof("Queue <%s> violates its fair share: realized share <%0.4f>, entitled share <%0.4f> over %v",
+				queue.Name, avgRealized, avgEntitled, fp.window)
+		}
+		fp.updateCondition(ssn, queue, violated,
+			fmt.Sprintf("realized share %0.4f, entitled share %0.4f over %v", avgRealized, avgEntitled, fp.window))
+	}
+}
+
+func (fp *fairnessAuditPlugin) updateCondition(ssn *framework.Session, queue *api.QueueInfo, violated bool, message string) {
+	cond := &scheduling.QueueCondition{
+		Type:               scheduling.QueueFairShareViolated,
+		Status:             v1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             FairShareSatisfiedReason,
+		Message:            message,
+	}
+	if violated {
+		cond.Status = v1.ConditionTrue
+		cond.Reason = FairShareViolatedReason
+	}
+	if err := ssn.UpdateQueueCondition(queue.UID, cond); err != nil {
+		klog.Errorf("Failed to update condition of queue <%s>: %v", queue.Name, err)
+	}
+}
+
+func (h *queueHistory) average() (float64, float64) {
+	if len(h.samples) == 0 {
+		return 0, 0
+	}
+	var realized, entitled float64
+	for _, s := range h.samples {
+		realized += s.realized
+		entitled += s.entitled
+	}
+	return realized / float64(len(h.samples)), entitled / float64(len(h.samples))
+}
+
+// queueShares returns the dominant share of the cluster resources allocated to, and requested by every queue.
+func queueShares(ssn *framework.Session) (map[api.QueueID]float64, map[api.QueueID]float64) {
+	allocated := map[api.QueueID]*api.Resource{}
+	request := map[api.QueueID]*api.Resource{}
+	for _, job := range ssn.Jobs {
+		if _, found := allocated[job.Queue]; !found {
+			allocated[job.Queue] = api.EmptyResource()
+			request[job.Queue] = api.EmptyResource()
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if api.AllocatedStatus(status) {
+				for _, t := range tasks {
+					allocated[job.Queue].Add(t.Resreq)
+					request[job.Queue].Add(t.Resreq)
+				}
+			} else if status == api.Pending {
+				for _, t := range tasks {
+					request[job.Queue].Add(t.Resreq)
+				}
+			}
+		}
+	}
+
+	realized := map[api.QueueID]float64{}
+	demand := map[api.QueueID]float64{}
+	for queueID := range allocated {
+		realized[queueID] = dominantShare(allocated[queueID], ssn.TotalResource)
+		demand[queueID] = dominantShare(request[queueID], ssn.TotalResource)
+	}
+	return realized, demand
+}
+
+// entitledShares distributes the cluster to the queues with demand by water-filling in proportion to the
+// queue weights, capped by their demand. A queue with spec.deserved is entitled to its deserved share.
+func entitledShares(ssn *framework.Session, demand map[api.QueueID]float64) map[api.QueueID]float64 {
+	entitled := map[api.QueueID]float64{}
+	active := map[api.QueueID]float64{}
+	remaining := 1.0
+	for queueID, d := range demand {
+		queue, found := ssn.Queues[queueID]
+		if !found || d <= shareEpsilon {
+			continue
+		}
+		if len(queue.Queue.Spec.Deserved) > 0 {
+			deserved := api.NewResource(queue.Queue.Spec.Deserved)
+			entitled[queueID] = min(d, dominantShare(deserved, ssn.TotalResource))
+			remaining -= entitled[queueID]
+			continue
+		}
+		active[queueID] = float64(max(queue.Weight, 1))
+	}
+
+	for remaining > shareEpsilon && len(active) > 0 {
+		totalWeight := 0.0
+		for _, w := range active {
+			totalWeight += w
+		}
+		increased := 0.0
+		for queueID, w := range active {
+			share := remaining * w / totalWeight
+			if entitled[queueID]+share >= demand[queueID] {
+				share = demand[queueID] - entitled[queueID]
+				delete(active, queueID)
+			}
+			entitled[queueID] += share
+			increased += share
+		}
+		remaining -= increased
+		if increased <= shareEpsilon {
+			break
+		}
+	}
+	return entitled
+}
+
+// dominantShare returns the largest share of the resources among the resource dimensions of total.
+func dominantShare(res, total *api.Resource) float64 {
+	if res == nil || total == nil {
+		return 0
+	}
+	share := 0.0
+	for _, rn := range total.ResourceNames() {
+		share = max(share, helpers.Share(res.Get(rn), total.Get(rn)))
+	}
+	return share
+}
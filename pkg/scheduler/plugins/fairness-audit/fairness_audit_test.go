@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairnessaudit
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestEntitledShares(t *testing.T) {
+	total := api.NewResource(api.BuildResourceList("10", "10Gi"))
+	tests := []struct {
+		name     string
+		queues   map[api.QueueID]*api.QueueInfo
+		demand   map[api.QueueID]float64
+		expected map[api.QueueID]float64
+	}{
+		{
+			name: "equal weights split the cluster",
+			queues: map[api.QueueID]*api.QueueInfo{
+				"q1": {UID: "q1", Weight: 1, Queue: &scheduling.Queue{}},
+				"q2": {UID: "q2", Weight: 1, Queue: &scheduling.Queue{}},
+			},
+			demand:   map[api.QueueID]float64{"q1": 1, "q2": 1},
+			expected: map[api.QueueID]float64{"q1": 0.5, "q2": 0.5},
+		},
+		{
+			name: "share not demanded is redistributed by weight",
+			queues: map[api.QueueID]*api.QueueInfo{
+				"q1": {UID: "q1", Weight: 1, Queue: &scheduling.Queue{}},
+				"q2": {UID: "q2", Weight: 1, Queue: &scheduling.Queue{}},
+				"q3": {UID: "q3", Weight: 2, Queue: &scheduling.Queue{}},
+			},
+			demand:   map[api.QueueID]float64{"q1": 0.1, "q2": 1, "q3": 1},
+			expected: map[api.QueueID]float64{"q1": 0.1, "q2": 0.3, "q3": 0.6},
+		},
+		{
+			name: "queue with deserved is entitled to its deserved",
+			queues: map[api.QueueID]*api.QueueInfo{
+				"q1": {UID: "q1", Weight: 1, Queue: &scheduling.Queue{
+					Spec: scheduling.QueueSpec{Deserved: api.BuildResourceList("2", "1Gi")},
+				}},
+				"q2": {UID: "q2", Weight: 1, Queue: &scheduling.Queue{}},
+			},
+			demand:   map[api.QueueID]float64{"q1": 1, "q2": 1},
+			expected: map[api.QueueID]float64{"q1": 0.2, "q2": 0.8},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn := &framework.Session{Queues: test.queues, TotalResource: total}
+			entitled := entitledShares(ssn, test.demand)
+			for queueID, expected := range test.expected {
+				if math.Abs(entitled[queueID]-expected) > shareEpsilon {
+					t.Errorf("expected queue %s entitled to %v, got %v", queueID, expected, entitled[queueID])
+				}
+			}
+		})
+	}
+}
+
+func TestFairnessAudit(t *testing.T) {
+	histories.Reset()
+	defer histories.Reset()
+
+	test := uthelper.TestCommonStruct{
+		Name: "starving queue is reported after the audit window",
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+			util.BuildPodGroup("pg2", "ns1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("ns1", "p1", "n1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+			util.BuildPod("ns1", "p2", "n1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", nil, nil),
+			util.BuildPod("ns1", "p3", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg2", nil, nil),
+		},
+		Queues: []*schedulingv1beta1.Queue{
+			util.BuildQueue("q1", 1, nil),
+			util.BuildQueue("q2", 1, nil),
+		},
+	}
+	ssn := test.RegisterSession(nil, nil)
+	defer test.Close()
+
+	now := time.Now()
+	fp := New(framework.Arguments{WindowKey: "1m"}).(*fairnessAuditPlugin)
+	fp.now = func() time.Time { return now }
+
+	fp.OnSessionClose(ssn)
+	if len(ssn.Queues["q2"].Queue.Status.Conditions) != 0 {
+		t.Fatalf("expected no condition before the audit window elapses, got %v", ssn.Queues["q2"].Queue.Status.Conditions)
+	}
+
+	now = now.Add(2 * time.Minute)
+	fp.OnSessionClose(ssn)
+	conditions := ssn.Queues["q2"].Queue.Status.Conditions
+	if len(conditions) != 1 || conditions[0].Type != scheduling.QueueFairShareViolated ||
+		conditions[0].Status != v1.ConditionTrue || conditions[0].Reason != FairShareViolatedReason {
+		t.Errorf("expected FairShareViolated condition on queue q2, got %v", conditions)
+	}
+	if len(ssn.Queues["q1"].Queue.Status.Conditions) != 0 {
+		t.Errorf("expected no condition on queue q1, got %v", ssn.Queues["q1"].Queue.Status.Conditions)
+	}
+}
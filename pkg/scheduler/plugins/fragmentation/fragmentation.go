@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fragmentation
+
+import (
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "fragmentation"
+
+	// WeightKey is the weight of the node order penalty of the placements creating fragments.
+	WeightKey = "fragmentation.weight"
+	// GPUResourceKey is the name of the GPU resource the whole-GPU slots are counted of.
+	GPUResourceKey = "fragmentation.gpuResource"
+	// GPUSlotSizeKey is the number of GPUs making up a whole slot, all the GPUs of the node if not set.
+	GPUSlotSizeKey = "fragmentation.gpuSlotSize"
+
+	defaultGPUResource = "nvidia.com/gpu"
+	// numaResource is the resource label of the metrics of the whole-NUMA slots.
+	numaResource = "numa-cpu"
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: fragmentation
+       arguments:
+         fragmentation.weight: 1
+         fragmentation.gpuResource: nvidia.com/gpu
+         fragmentation.gpuSlotSize: 8
+*/
+
+type fragmentationPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	weight          int
+	gpuResource     v1.ResourceName
+	gpuSlotSize     int
+}
+
+// New function returns fragmentation plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	fp := &fragmentationPlugin{
+		pluginArguments: arguments,
+		weight:          1,
+		gpuResource:     defaultGPUResource,
+	}
+
+	arguments.GetInt(&fp.weight, WeightKey)
+	if fp.weight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default 1", WeightKey, fp.weight, PluginName)
+		fp.weight = 1
+	}
+	var gpuResource string
+	arguments.GetString(&gpuResource, GPUResourceKey)
+	if gpuResource != "" {
+		fp.gpuResource = v1.ResourceName(gpuResource)
+	}
+	arguments.GetInt(&fp.gpuSlotSize, GPUSlotSizeKey)
+	if fp.gpuSlotSize < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using the GPUs of the node", GPUSlotSizeKey, fp.gpuSlotSize, PluginName)
+		fp.gpuSlotSize = 0
+	}
+
+	return fp
+}
+
+func (fp *fragmentationPlugin) Name() string {
+	return PluginName
+}
+
+// slots is the free capacity of one resource of a node, split into whole slots and fragments.
+type slots struct {
+	// size is the capacity of a whole slot.
+	size float64
+	// free is the free capacity.
+	free float64
+	// fragmented is the free capacity which does not make up a whole slot.
+	fragmented float64
+	// whole is the number of whole free slots.
+	whole int
+}
+
+// score returns the share of the free capacity which does not make up a whole slot.
+func (s *slots) score() float64 {
+	if s.free <= 0 {
+		return 0
+	}
+	return s.fragmented / s.free
+}
+
+// fragmentedAfter returns the capacity not making up a whole slot after the request is placed, assuming the request
+// takes the fragments first and then the fewest whole slots.
+func (s *slots) fragmentedAfter(request float64) float64 {
+	if request <= s.fragmented {
+		return s.fragmented - request
+	}
+	rest := request - s.fragmented
+	return math.Ceil(rest/s.size)*s.size - rest
+}
+
+// penalty returns the share of a whole slot the request fragments, 0 if it takes fragments only.
+func (s *slots) penalty(request float64) float64 {
+	created := (s.fragmentedAfter(request) - s.fragmented) / s.size
+	return math.Min(math.Max(created, 0), 1)
+}
+
+// gpuSlots returns the whole-GPU slots of the node, false if the node has no GPU.
+func (fp *fragmentationPlugin) gpuSlots(node *api.NodeInfo) (*slots, bool) {
+	allocatable := node.Allocatable.Get(fp.gpuResource) / 1000
+	if allocatable <= 0 {
+		return nil, false
+	}
+	size := allocatable
+	if fp.gpuSlotSize > 0 {
+		size = float64(fp.gpuSlotSize)
+	}
+	free := math.Max(node.Idle.Get(fp.gpuResource)/1000, 0)
+	whole := math.Floor(free / size)
+	return &slots{size: size, free: free, fragmented: free - whole*size, whole: int(whole)}, true
+}
+
+// numaSlots returns the whole-NUMA slots of the CPUs of the node, a NUMA node is a whole slot if all its CPUs are
+// free. False if the NUMA topology of the node is unknown.
+func numaSlots(node *api.NodeInfo) (*slots, bool) {
+	info := node.NumaSchedulerInfo
+	if info == nil {
+		return nil, false
+	}
+	cpus, found := info.NumaResMap[string(v1.ResourceCPU)]
+	numaNodes := info.CPUDetail.NUMANodes()
+	if !found || numaNodes.Size() == 0 {
+		return nil, false
+	}
+
+	s := &slots{size: float64(info.CPUDetail.CPUs().Size()) / float64(numaNodes.Size())}
+	for _, numa := range numaNodes.List() {
+		numaCPUs := info.CPUDetail.CPUsInNUMANodes(numa)
+		free := cpus.Allocatable.Intersection(numaCPUs).Size()
+		s.free += float64(free)
+		if free == numaCPUs.Size() {
+			s.whole++
+		} else {
+			s.fragmented += float64(free)
+		}
+	}
+	return s, true
+}
+
+// numaAligned returns whether the CPUs of the task are aligned to NUMA nodes by the topology manager.
+func numaAligned(task *api.TaskInfo) bool {
+	return task.NumaInfo != nil && task.NumaInfo.Policy != "" && task.NumaInfo.Policy != "none"
+}
+
+func (fp *fragmentationPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	// nodeOrderFn scores the nodes by the whole slots the placement of the task keeps, so the tasks fill the
+	// fragments first and do not break whole slots the large tasks need.
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		var penalty float64
+		dimensions := 0
+		if request := task.Resreq.Get(fp.gpuResource) / 1000; request > 0 {
+			if s, found := fp.gpuSlots(node); found {
+				penalty += s.penalty(request)
+				dimensions++
+			}
+		}
+		if numaAligned(task) {
+			if s, found := numaSlots(node); found {
+				penalty += s.penalty(math.Ceil(task.Resreq.MilliCPU / 1000))
+				dimensions++
+			}
+		}
+		if dimensions == 0 {
+			return 0, nil
+		}
+
+		score := (1 - penalty/float64(dimensions)) * float64(fwk.MaxNodeScore) * float64(fp.weight)
+		klog.V(5).Infof("Fragmentation score of task <%s/%s> on node <%s>: %v", task.Namespace, task.Name, node.Name, score)
+		return score, nil
+	}
+
+	if fp.weight > 0 {
+		ssn.AddNodeOrderFn(fp.Name(), nodeOrderFn)
+	}
+}
+
+// OnSessionClose reports the fragmentation of the nodes and the cluster after the placements of the session.
+func (fp *fragmentationPlugin) OnSessionClose(ssn *framework.Session) {
+	metrics.ResetNodeFragmentation()
+	gpu, numa := &slots{}, &slots{}
+	for _, node := range ssn.Nodes {
+		if s, found := fp.gpuSlots(node); found {
+			metrics.UpdateNodeFragmentation(node.Name, string(fp.gpuResource), s.score())
+			gpu.free += s.free
+			gpu.fragmented += s.fragmented
+			gpu.whole += s.whole
+		}
+		if s, found := numaSlots(node); found {
+			metrics.UpdateNodeFragmentation(node.Name, numaResource, s.score())
+			numa.free += s.free
+			numa.fragmented += s.fragmented
+			numa.whole += s.whole
+		}
+	}
+	metrics.UpdateClusterFragmentation(string(fp.gpuResource), gpu.score(), gpu.whole)
+	metrics.UpdateClusterFragmentation(numaResource, numa.score(), numa.whole)
+	klog.V(4).Infof("Cluster fragmentation score of %s: %v with %d whole slots, of NUMA CPUs: %v with %d whole slots",
+		fp.gpuResource, gpu.score(), gpu.whole, numa.score(), numa.whole)
+}
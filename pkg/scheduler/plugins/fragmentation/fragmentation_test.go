@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fragmentation
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/kubelet/cm/cpumanager/topology"
+	"k8s.io/utils/cpuset"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func gpuResources(cpu, memory, gpus string) v1.ResourceList {
+	return api.BuildResourceList(cpu, memory, []api.ScalarResource{{Name: defaultGPUResource, Value: gpus}}...)
+}
+
+func gpuNode(name, gpus string) *v1.Node {
+	return util.BuildNode(name, api.BuildResourceList("32", "128Gi", []api.ScalarResource{
+		{Name: defaultGPUResource, Value: gpus}, {Name: "pods", Value: "110"}}...), nil)
+}
+
+func TestSlots(t *testing.T) {
+	tests := []struct {
+		name             string
+		slots            slots
+		request          float64
+		fragmentedAfter  float64
+		expectedPenalty  float64
+		expectedFragment float64
+	}{
+		{name: "request takes the fragments", slots: slots{size: 8, free: 12, fragmented: 4, whole: 1}, request: 2, fragmentedAfter: 2, expectedPenalty: 0, expectedFragment: 4.0 / 12},
+		{name: "request breaks a whole slot", slots: slots{size: 8, free: 8, whole: 1}, request: 2, fragmentedAfter: 6, expectedPenalty: 0.75, expectedFragment: 0},
+		{name: "request takes a whole slot", slots: slots{size: 8, free: 16, whole: 2}, request: 8, fragmentedAfter: 0, expectedPenalty: 0, expectedFragment: 0},
+		{name: "request takes the fragments and breaks a whole slot", slots: slots{size: 4, free: 6, fragmented: 2, whole: 1}, request: 3, fragmentedAfter: 3, expectedPenalty: 0.25, expectedFragment: 2.0 / 6},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if after := test.slots.fragmentedAfter(test.request); after != test.fragmentedAfter {
+				t.Errorf("expected %v fragmented after the request, got %v", test.fragmentedAfter, after)
+			}
+			if penalty := test.slots.penalty(test.request); penalty != test.expectedPenalty {
+				t.Errorf("expected penalty %v, got %v", test.expectedPenalty, penalty)
+			}
+			if score := test.slots.score(); score != test.expectedFragment {
+				t.Errorf("expected score %v, got %v", test.expectedFragment, score)
+			}
+		})
+	}
+}
+
+func TestNumaSlots(t *testing.T) {
+	// 2 NUMA nodes of 4 CPUs, CPU 1 of NUMA node 0 is used
+	detail := topology.CPUDetails{}
+	for cpu := 0; cpu < 8; cpu++ {
+		detail[cpu] = topology.CPUInfo{NUMANodeID: cpu / 4, SocketID: cpu / 4, CoreID: cpu}
+	}
+	node := api.NewNodeInfo(gpuNode("n1", "0"))
+	node.NumaSchedulerInfo = &api.NumatopoInfo{
+		NumaResMap: map[string]*api.ResourceInfo{
+			string(v1.ResourceCPU): {Allocatable: cpuset.New(0, 2, 3, 4, 5, 6, 7), Capacity: 8},
+		},
+		CPUDetail: detail,
+	}
+
+	s, found := numaSlots(node)
+	if !found {
+		t.Fatalf("expected NUMA slots of node with NUMA topology")
+	}
+	if s.size != 4 || s.free != 7 || s.fragmented != 3 || s.whole != 1 {
+		t.Errorf("expected 1 whole slot of 4 CPUs and 3 fragmented of 7 free CPUs, got %+v", s)
+	}
+	if _, found := numaSlots(api.NewNodeInfo(gpuNode("n2", "0"))); found {
+		t.Errorf("expected no NUMA slots of node without NUMA topology")
+	}
+}
+
+func TestFragmentation(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "task fills the fragmented node and keeps the whole node free",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+					util.BuildPodGroup("pg2", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "running", "n2", v1.PodRunning, gpuResources("1", "1Gi", "6"), "pg1", nil, nil),
+					util.BuildPod("c1", "p1", "", v1.PodPending, gpuResources("1", "1Gi", "2"), "pg2", nil, nil),
+				},
+				Nodes:          []*v1.Node{gpuNode("n1", "8"), gpuNode("n2", "8"), gpuNode("n3", "8")},
+				Queues:         []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)},
+				ExpectBindMap:  map[string]string{"c1/p1": "n2"},
+				ExpectBindsNum: 1,
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "task fills the fragments of the slots of the node",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+					util.BuildPodGroup("pg2", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "running", "n1", v1.PodRunning, gpuResources("1", "1Gi", "3"), "pg1", nil, nil),
+					util.BuildPod("c1", "p1", "", v1.PodPending, gpuResources("1", "1Gi", "1"), "pg2", nil, nil),
+				},
+				Nodes:          []*v1.Node{gpuNode("n1", "8"), gpuNode("n2", "6")},
+				Queues:         []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)},
+				ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{GPUSlotSizeKey: 2},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{{
+				Plugins: []conf.PluginOption{{
+					Name:             PluginName,
+					EnabledNodeOrder: &trueValue,
+					Arguments:        test.arguments,
+				}},
+			}}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gangspread
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "gang-spread"
+
+	// WeightKey is the weight of the node order score of the gang level topology spread.
+	WeightKey = "gang-spread.weight"
+
+	defaultWeight = 10
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: gang
+     - name: predicates
+     - name: gang-spread
+       arguments:
+         gang-spread.weight: 10
+*/
+
+// constraint is a topology spread constraint of the pods of a gang.
+type constraint struct {
+	key     string
+	maxSkew int32
+	// hard is true if the pods are not scheduled when the constraint is unsatisfiable
+	hard bool
+}
+
+// spread is the topology spread of a gang in the session.
+type spread struct {
+	pack        bool
+	constraints []constraint
+	// counts are the numbers of the members of the gang placed in the domains by topology key and domain.
+	counts map[string]map[string]int
+}
+
+type gangSpreadPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	weight          int
+
+	spreads map[api.JobID]*spread
+	// domains are the domains of the ready nodes by topology key.
+	domains map[string]sets.Set[string]
+}
+
+// New function returns gang spread plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	gp := &gangSpreadPlugin{
+		pluginArguments: arguments,
+		weight:          defaultWeight,
+		spreads:         map[api.JobID]*spread{},
+		domains:         map[string]sets.Set[string]{},
+	}
+
+	arguments.GetInt(&gp.weight, WeightKey)
+	if gp.weight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default %v", WeightKey, gp.weight, PluginName, defaultWeight)
+		gp.weight = defaultWeight
+	}
+	return gp
+}
+
+func (gp *gangSpreadPlugin) Name() string {
+	return PluginName
+}
+
+// policy returns whether the gang is packed or spread by the annotations of the job, false if the job has no gang
+// level topology spread policy.
+func policy(job *api.JobInfo) (bool, bool) {
+	if job.PodGroup == nil {
+		return false, false
+	}
+	switch p := job.PodGroup.Annotations[v1beta1.TopologySpreadPolicyKey]; p {
+	case v1beta1.TopologySpreadPolicySpread:
+		return false, true
+	case v1beta1.TopologySpreadPolicyPack:
+		return true, true
+	case "":
+		return false, false
+	default:
+		klog.V(4).Infof("Invalid topology spread policy <%s> of job <%s/%s>, ignoring it", p, job.Namespace, job.Name)
+		return false, false
+	}
+}
+
+// constraints returns the topology spread constraints of the pods of the job by topology key, with the smallest
+// max skew of the key, hard if any of the pods requires it.
+func constraints(job *api.JobInfo) []constraint {
+	var result []constraint
+	index := map[string]int{}
+	for _, task := range job.Tasks {
+		if task.Pod == nil {
+			continue
+		}
+		for _, c := range task.Pod.Spec.TopologySpreadConstraints {
+			hard := c.WhenUnsatisfiable == v1.DoNotSchedule
+			i, found := index[c.TopologyKey]
+			if !found {
+				index[c.TopologyKey] = len(result)
+				result = append(result, constraint{key: c.TopologyKey, maxSkew: c.MaxSkew, hard: hard})
+				continue
+			}
+			if c.MaxSkew < result[i].maxSkew {
+				result[i].maxSkew = c.MaxSkew
+			}
+			result[i].hard = result[i].hard || hard
+		}
+	}
+	return result
+}
+
+// placed returns whether the task counts as a placed member of its gang.
+func placed(task *api.TaskInfo) bool {
+	return (api.AllocatedStatus(task.Status) || task.Status == api.Pipelined) && task.NodeName != ""
+}
+
+// domainOf returns the domain of the node for the topology key, false if the node has no such label.
+func domainOf(node *api.NodeInfo, key string) (string, bool) {
+	if node == nil || node.Node == nil {
+		return "", false
+	}
+	value, found := node.Node.Labels[key]
+	return value, found && value != ""
+}
+
+// update adds delta to the count of the members of the gang in the domains of the node.
+func (s *spread) update(node *api.NodeInfo, delta int) {
+	for _, c := range s.constraints {
+		if value, found := domainOf(node, c.key); found {
+			s.counts[c.key][value] += delta
+		}
+	}
+}
+
+// bounds returns the smallest and the largest count of the members of the gang over the domains of the key.
+func (gp *gangSpreadPlugin) bounds(s *spread, key string) (int, int) {
+	minCount, maxCount := -1, 0
+	for value := range gp.domains[key] {
+		count := s.counts[key][value]
+		if minCount < 0 || count < minCount {
+			minCount = count
+		}
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+	if minCount < 0 {
+		minCount = 0
+	}
+	return minCount, maxCount
+}
+
+func (gp *gangSpreadPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	for _, job := range ssn.Jobs {
+		if len(job.TaskStatusIndex[api.Pending]) == 0 {
+			continue
+		}
+		pack, found := policy(job)
+		if !found {
+			continue
+		}
+		cs := constraints(job)
+		if len(cs) == 0 {
+			klog.V(4).Infof("Pods of job <%s/%s> have no topology spread constraints", job.Namespace, job.Name)
+			continue
+		}
+
+		s := &spread{pack: pack, constraints: cs, counts: map[string]map[string]int{}}
+		for _, c := range cs {
+			s.counts[c.key] = map[string]int{}
+			if _, found := gp.domains[c.key]; !found {
+				gp.domains[c.key] = sets.New[string]()
+			}
+		}
+		for _, task := range job.Tasks {
+			if placed(task) {
+				s.update(ssn.Nodes[task.NodeName], 1)
+			}
+		}
+		gp.spreads[job.UID] = s
+	}
+	if len(gp.spreads) == 0 {
+		return
+	}
+
+	for _, node := range ssn.Nodes {
+		if !node.Ready() {
+			continue
+		}
+		for key, values := range gp.domains {
+			if value, found := domainOf(node, key); found {
+				values.Insert(value)
+			}
+		}
+	}
+
+	// predicateFn keeps the skew of the members of a spread gang across the domains within the max skew of the hard
+	// constraints, counting the members placed in the session.
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		s, found := gp.spreads[task.Job]
+		if !found || s.pack {
+			return nil
+		}
+		for _, c := range s.constraints {
+			if !c.hard {
+				continue
+			}
+			value, found := domainOf(node, c.key)
+			if !found {
+				return api.NewFitErrWithStatus(task, node, &api.Status{
+					Code:   api.UnschedulableAndUnresolvable,
+					Reason: fmt.Sprintf("node has no label %s", c.key),
+					Plugin: PluginName,
+				})
+			}
+			minCount, _ := gp.bounds(s, c.key)
+			if skew := s.counts[c.key][value] + 1 - minCount; skew > int(c.maxSkew) {
+				return api.NewFitErrWithStatus(task, node, &api.Status{
+					Code:   api.Unschedulable,
+					Reason: fmt.Sprintf("gang skew %d in domain %s=%s exceeds max skew %d", skew, c.key, value, c.maxSkew),
+					Plugin: PluginName,
+				})
+			}
+		}
+		return nil
+	}
+
+	// nodeOrderFn prefers the domains with the fewest members of a spread gang, or the most members of a packed gang.
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		s, found := gp.spreads[task.Job]
+		if !found {
+			return 0, nil
+		}
+		var score float64
+		for _, c := range s.constraints {
+			value, found := domainOf(node, c.key)
+			if !found {
+				continue
+			}
+			minCount, maxCount := gp.bounds(s, c.key)
+			count := s.counts[c.key][value]
+			if s.pack {
+				score += float64(count+1) / float64(maxCount+1)
+			} else {
+				score += float64(maxCount-count+1) / float64(maxCount-minCount+1)
+			}
+		}
+		return score / float64(len(s.constraints)) * float64(fwk.MaxNodeScore*int64(gp.weight)), nil
+	}
+
+	ssn.AddPredicateFn(gp.Name(), predicateFn)
+	ssn.AddNodeOrderFn(gp.Name(), nodeOrderFn)
+
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc: func(event *framework.Event) {
+			if s, found := gp.spreads[event.Task.Job]; found {
+				s.update(ssn.Nodes[event.Task.NodeName], 1)
+			}
+		},
+		DeallocateFunc: func(event *framework.Event) {
+			if s, found := gp.spreads[event.Task.Job]; found {
+				s.update(ssn.Nodes[event.Task.NodeName], -1)
+			}
+		},
+	})
+}
+
+func (gp *gangSpreadPlugin) OnSessionClose(ssn *framework.Session) {
+	gp.spreads = map[api.JobID]*spread{}
+	gp.domains = map[string]sets.Set[string]{}
+}
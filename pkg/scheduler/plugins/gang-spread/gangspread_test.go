@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gangspread
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const zoneKey = "topology.kubernetes.io/zone"
+
+func init() {
+	options.Default()
+}
+
+func zoneNode(name, zone string) *v1.Node {
+	return util.BuildNode(name, api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "110"}}...),
+		map[string]string{zoneKey: zone})
+}
+
+func spreadPods(count int, whenUnsatisfiable v1.UnsatisfiableConstraintAction) []*v1.Pod {
+	var pods []*v1.Pod
+	for i := 0; i < count; i++ {
+		pod := util.BuildPod("c1", fmt.Sprintf("p%d", i), "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+		pod.Spec.TopologySpreadConstraints = []v1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       zoneKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+		}}
+		pods = append(pods, pod)
+	}
+	return pods
+}
+
+func spreadPodGroup(minMember int32, policy string) *schedulingv1beta1.PodGroup {
+	return util.BuildPodGroupWithAnno("pg1", "c1", "q1", minMember, nil, schedulingv1beta1.PodGroupInqueue,
+		map[string]string{schedulingv1beta1.TopologySpreadPolicyKey: policy})
+}
+
+func TestGangSpread(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New, gang.PluginName: gang.New}
+	nodes := []*v1.Node{
+		zoneNode("n1", "zone-a"),
+		zoneNode("n2", "zone-a"),
+		zoneNode("n3", "zone-b"),
+		zoneNode("n4", "zone-b"),
+		zoneNode("n5", "zone-c"),
+	}
+	queues := []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		// expectZones are the numbers of the members of the gang placed in the zones.
+		expectZones map[string]int
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "gang is spread evenly across the zones",
+				Plugins:          plugins,
+				PodGroups:        []*schedulingv1beta1.PodGroup{spreadPodGroup(6, schedulingv1beta1.TopologySpreadPolicySpread)},
+				Pods:             spreadPods(6, v1.DoNotSchedule),
+				Nodes:            nodes,
+				Queues:           queues,
+				ExpectBindsNum:   6,
+				MinimalBindCheck: true,
+			},
+			expectZones: map[string]int{"zone-a": 2, "zone-b": 2, "zone-c": 2},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "gang is packed in one zone",
+				Plugins:          plugins,
+				PodGroups:        []*schedulingv1beta1.PodGroup{spreadPodGroup(4, schedulingv1beta1.TopologySpreadPolicyPack)},
+				Pods:             spreadPods(4, v1.ScheduleAnyway),
+				Nodes:            nodes,
+				Queues:           queues,
+				ExpectBindsNum:   4,
+				MinimalBindCheck: true,
+			},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{{
+				Plugins: []conf.PluginOption{
+					{
+						Name:                gang.PluginName,
+						EnabledJobReady:     &trueValue,
+						EnabledJobPipelined: &trueValue,
+						EnabledJobOrder:     &trueValue,
+					},
+					{
+						Name:             PluginName,
+						EnabledPredicate: &trueValue,
+						EnabledNodeOrder: &trueValue,
+					},
+				},
+			}}
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			zones := map[string]int{}
+			for _, job := range ssn.Jobs {
+				for _, task := range job.Tasks {
+					zones[ssn.Nodes[task.NodeName].Node.Labels[zoneKey]]++
+				}
+			}
+			if test.expectZones == nil {
+				if len(zones) != 1 {
+					t.Errorf("expected the gang packed in one zone, got %v", zones)
+				}
+				return
+			}
+			for zone, count := range test.expectZones {
+				if zones[zone] != count {
+					t.Errorf("expected %d members in zone %s, got %v", count, zone, zones)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gangtopology
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "gang-topology"
+
+	// WeightKey is the weight of the node order score of the nodes in the domain chosen for the gang.
+	WeightKey = "gang-topology.weight"
+
+	defaultWeight = 10
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: gang
+     - name: gang-topology
+       arguments:
+         gang-topology.weight: 10
+*/
+
+// domain is the set of nodes sharing the value of a topology label, e.g. a zone or a rack.
+type domain struct {
+	key   string
+	value string
+}
+
+func (d *domain) String() string {
+	return fmt.Sprintf("%s=%s", d.key, d.value)
+}
+
+func (d *domain) contains(node *api.NodeInfo) bool {
+	if node.Node == nil {
+		return false
+	}
+	value, found := node.Node.Labels[d.key]
+	return found && value == d.value
+}
+
+// placement is the topology constraint of a gang in the session.
+type placement struct {
+	keys     []string
+	required bool
+	// domain is the domain the members of the gang are placed within, nil if no domain fits the gang.
+	domain *domain
+}
+
+type gangTopologyPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	weight          int
+
+	placements map[api.JobID]*placement
+}
+
+// New function returns gang topology plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	gp := &gangTopologyPlugin{
+		pluginArguments: arguments,
+		weight:          defaultWeight,
+		placements:      map[api.JobID]*placement{},
+	}
+
+	arguments.GetInt(&gp.weight, WeightKey)
+	if gp.weight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default %v", WeightKey, gp.weight, PluginName, defaultWeight)
+		gp.weight = defaultWeight
+	}
+	return gp
+}
+
+func (gp *gangTopologyPlugin) Name() string {
+	return PluginName
+}
+
+// constraint returns the topology label keys and whether the placement within one domain is required by the
+// annotations of the job, false if the job has no topology constraint.
+func constraint(job *api.JobInfo) ([]string, bool, bool) {
+	if job.PodGroup == nil {
+		return nil, false, false
+	}
+	var keys []string
+	for _, key := range strings.Split(job.PodGroup.Annotations[v1beta1.GangTopologyKey], ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, false, false
+	}
+
+	switch policy := job.PodGroup.Annotations[v1beta1.GangTopologyPolicyKey]; policy {
+	case v1beta1.GangTopologyRequired:
+		return keys, true, true
+	case "", v1beta1.GangTopologyPreferred:
+		return keys, false, true
+	default:
+		klog.V(4).Infof("Invalid gang topology policy <%s> of job <%s/%s>, using %s",
+			policy, job.Namespace, job.Name, v1beta1.GangTopologyPreferred)
+		return keys, false, true
+	}
+}
+
+// placedDomain returns the narrowest domain all the placed tasks of the job are within, nil if the job has no placed
+// tasks or they are not within one domain.
+func placedDomain(ssn *framework.Session, job *api.JobInfo, keys []string) (*domain, bool) {
+	var nodes []*api.NodeInfo
+	for _, task := range job.Tasks {
+		if (api.AllocatedStatus(task.Status) || task.Status == api.Pipelined) && task.NodeName != "" {
+			if node, found := ssn.Nodes[task.NodeName]; found && node.Node != nil {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, false
+	}
+
+	for _, key := range keys {
+		d := &domain{key: key, value: nodes[0].Node.Labels[key]}
+		if d.value == "" {
+			continue
+		}
+		within := true
+		for _, node := range nodes[1:] {
+			if !d.contains(node) {
+				within = false
+				break
+			}
+		}
+		if within {
+			return d, true
+		}
+	}
+	return nil, true
+}
+
+// pendingRequest returns the resources requested by the pending tasks the job needs to get ready, or by all its
+// pending tasks if it is ready already.
+func pendingRequest(ssn *framework.Session, job *api.JobInfo) *api.Resource {
+	tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if !task.BestEffort && !task.SchGated {
+			tasks.Push(task)
+		}
+	}
+	missing := int(job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum())
+	if missing <= 0 {
+		missing = tasks.Len()
+	}
+
+	need := api.EmptyResource()
+	for ; missing > 0 && !tasks.Empty(); missing-- {
+		need.Add(tasks.Pop().(*api.TaskInfo).InitResreq)
+	}
+	return need
+}
+
+// chooseDomain returns the smallest domain of the narrowest key the idle resources of which fit the request,
+// nil if there is none.
+func chooseDomain(ssn *framework.Session, keys []string, need *api.Resource) *domain {
+	type candidate struct {
+		idle  *api.Resource
+		nodes int
+	}
+	for _, key := range keys {
+		candidates := map[string]*candidate{}
+		for _, node := range ssn.Nodes {
+			if !node.Ready() || node.Node == nil {
+				continue
+			}
+			value, found := node.Node.Labels[key]
+			if !found || value == "" {
+				continue
+			}
+			c, found := candidates[value]
+			if !found {
+				c = &candidate{idle: api.EmptyResource()}
+				candidates[value] = c
+			}
+			c.idle.Add(node.FutureIdle())
+			c.nodes++
+		}
+
+		var fits []string
+		for value, c := range candidates {
+			if need.LessEqual(c.idle, api.Zero) {
+				fits = append(fits, value)
+			}
+		}
+		if len(fits) == 0 {
+			continue
+		}
+		// the smallest domain is chosen, so the larger ones are kept for the larger gangs
+		sort.Slice(fits, func(i, j int) bool {
+			if candidates[fits[i]].nodes != candidates[fits[j]].nodes {
+				return candidates[fits[i]].nodes < candidates[fits[j]].nodes
+			}
+			return fits[i] < fits[j]
+		})
+		return &domain{key: key, value: fits[0]}
+	}
+	return nil
+}
+
+func (gp *gangTopologyPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	for _, job := range ssn.Jobs {
+		if len(job.TaskStatusIndex[api.Pending]) == 0 {
+			continue
+		}
+		keys, required, found := constraint(job)
+		if !found {
+			continue
+		}
+
+		p := &placement{keys: keys, required: required}
+		if d, placed := placedDomain(ssn, job, keys); placed {
+			if d == nil {
+				// the placed tasks already span the domains, the rest of the gang is not constrained
+				klog.V(4).Infof("Placed tasks of job <%s/%s> are not within one domain of %v", job.Namespace, job.Name, keys)
+				continue
+			}
+			p.domain = d
+		} else {
+			p.domain = chooseDomain(ssn, keys, pendingRequest(ssn, job))
+		}
+		if p.domain != nil {
+			klog.V(3).Infof("Placing job <%s/%s> within domain <%s>", job.Namespace, job.Name, p.domain)
+		} else {
+			klog.V(3).Infof("No domain of %v fits job <%s/%s>, required: %v", keys, job.Namespace, job.Name, required)
+		}
+		gp.placements[job.UID] = p
+	}
+	if len(gp.placements) == 0 {
+		return
+	}
+
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		p, found := gp.placements[task.Job]
+		if !found || !p.required {
+			return nil
+		}
+		if p.domain == nil {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.UnschedulableAndUnresolvable,
+				Reason: fmt.Sprintf("no domain of %v fits the gang", p.keys),
+				Plugin: PluginName,
+			})
+		}
+		if !p.domain.contains(node) {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.UnschedulableAndUnresolvable,
+				Reason: fmt.Sprintf("node is not in domain %s of the gang", p.domain),
+				Plugin: PluginName,
+			})
+		}
+		return nil
+	}
+
+	// nodeOrderFn prefers the nodes in the domain of the gang, the gang spans the domains if it does not fit one.
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		p, found := gp.placements[task.Job]
+		if !found || p.domain == nil || !p.domain.contains(node) {
+			return 0, nil
+		}
+		return float64(fwk.MaxNodeScore * int64(gp.weight)), nil
+	}
+
+	ssn.AddPredicateFn(gp.Name(), predicateFn)
+	ssn.AddNodeOrderFn(gp.Name(), nodeOrderFn)
+}
+
+func (gp *gangTopologyPlugin) OnSessionClose(ssn *framework.Session) {
+	gp.placements = map[api.JobID]*placement{}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gangtopology
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	rackKey = "topology.volcano.sh/rack"
+	zoneKey = "topology.kubernetes.io/zone"
+)
+
+func init() {
+	options.Default()
+}
+
+func topologyNode(name, zone, rack string) *v1.Node {
+	return util.BuildNode(name, api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "110"}}...),
+		map[string]string{zoneKey: zone, rackKey: rack})
+}
+
+func gangPods(pg string, count int) []*v1.Pod {
+	var pods []*v1.Pod
+	for i := 0; i < count; i++ {
+		pods = append(pods, util.BuildPod("c1", pg+"-"+string(rune('a'+i)), "", v1.PodPending, api.BuildResourceList("3", "1Gi"), pg, nil, nil))
+	}
+	return pods
+}
+
+func gangPodGroup(minMember int32, keys, policy string) *schedulingv1beta1.PodGroup {
+	annotations := map[string]string{schedulingv1beta1.GangTopologyKey: keys}
+	if policy != "" {
+		annotations[schedulingv1beta1.GangTopologyPolicyKey] = policy
+	}
+	return util.BuildPodGroupWithAnno("pg1", "c1", "q1", minMember, nil, schedulingv1beta1.PodGroupInqueue, annotations)
+}
+
+func TestGangTopology(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New, gang.PluginName: gang.New}
+	// zone-a: rack r1 of n1 and n2, zone-b: rack r2 of n3, rack r3 of n4 and n5
+	nodes := []*v1.Node{
+		topologyNode("n1", "zone-a", "r1"),
+		topologyNode("n2", "zone-a", "r1"),
+		topologyNode("n3", "zone-b", "r2"),
+		topologyNode("n4", "zone-b", "r3"),
+		topologyNode("n5", "zone-b", "r3"),
+	}
+	queues := []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		// expectNodes are the nodes the members of the gang are placed within.
+		expectNodes []string
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "gang is placed within the smallest rack it fits",
+				Plugins:        plugins,
+				PodGroups:      []*schedulingv1beta1.PodGroup{gangPodGroup(1, rackKey, schedulingv1beta1.GangTopologyRequired)},
+				Pods:           gangPods("pg1", 1),
+				Nodes:          nodes,
+				Queues:         queues,
+				ExpectBindMap:  map[string]string{"c1/pg1-a": "n3"},
+				ExpectBindsNum: 1,
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "gang is placed within one rack",
+				Plugins:          plugins,
+				PodGroups:        []*schedulingv1beta1.PodGroup{gangPodGroup(2, rackKey, schedulingv1beta1.GangTopologyRequired)},
+				Pods:             gangPods("pg1", 2),
+				Nodes:            nodes,
+				Queues:           queues,
+				ExpectBindsNum:   2,
+				MinimalBindCheck: true,
+			},
+			expectNodes: []string{"n1", "n2"},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "gang falls back to the zone if it fits no rack",
+				Plugins:          plugins,
+				PodGroups:        []*schedulingv1beta1.PodGroup{gangPodGroup(3, rackKey+","+zoneKey, schedulingv1beta1.GangTopologyRequired)},
+				Pods:             gangPods("pg1", 3),
+				Nodes:            nodes,
+				Queues:           queues,
+				ExpectBindsNum:   3,
+				MinimalBindCheck: true,
+			},
+			expectNodes: []string{"n3", "n4", "n5"},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:      "required gang waits if it fits no rack",
+				Plugins:   plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{gangPodGroup(3, rackKey, schedulingv1beta1.GangTopologyRequired)},
+				Pods:      gangPods("pg1", 3),
+				Nodes:     nodes,
+				Queues:    queues,
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "preferred gang spans the racks if it fits no rack",
+				Plugins:          plugins,
+				PodGroups:        []*schedulingv1beta1.PodGroup{gangPodGroup(3, rackKey, "")},
+				Pods:             gangPods("pg1", 3),
+				Nodes:            nodes,
+				Queues:           queues,
+				ExpectBindsNum:   3,
+				MinimalBindCheck: true,
+			},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{{
+				Plugins: []conf.PluginOption{
+					{
+						Name:                gang.PluginName,
+						EnabledJobReady:     &trueValue,
+						EnabledJobPipelined: &trueValue,
+						EnabledJobOrder:     &trueValue,
+					},
+					{
+						Name:             PluginName,
+						EnabledPredicate: &trueValue,
+						EnabledNodeOrder: &trueValue,
+					},
+				},
+			}}
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+			if len(test.expectNodes) == 0 {
+				return
+			}
+			expected := sets.New(test.expectNodes...)
+			for _, job := range ssn.Jobs {
+				for _, task := range job.Tasks {
+					if !expected.Has(task.NodeName) {
+						t.Errorf("expected task <%s> placed within %v, got node <%s>", task.Name, test.expectNodes, task.NodeName)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// gangAdmissionTimeoutReason is the reason of the event recorded on a gang requeued after its admission timed out.
+const gangAdmissionTimeoutReason = "GangAdmissionTimeout"
+
+// parseAdmissionTimeout returns the admission timeout and the policy of the gang, the timeout is 0 when it is unset.
+func parseAdmissionTimeout(job *api.JobInfo) (time.Duration, string, error) {
+	if job.PodGroup == nil {
+		return 0, "", nil
+	}
+	value, found := job.PodGroup.Annotations[v1beta1.GangAdmissionTimeoutKey]
+	if !found {
+		return 0, "", nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, "", err
+	}
+	if timeout <= 0 {
+		return 0, "", fmt.Errorf("admission timeout %s is not positive", value)
+	}
+
+	policy := job.PodGroup.Annotations[v1beta1.GangAdmissionTimeoutPolicyKey]
+	switch policy {
+	case "":
+		policy = v1beta1.GangAdmissionTimeoutRequeue
+	case v1beta1.GangAdmissionTimeoutElastic, v1beta1.GangAdmissionTimeoutRequeue:
+	default:
+		return 0, "", fmt.Errorf("unknown admission timeout policy %q", policy)
+	}
+	return timeout, policy, nil
+}
+
+// applyAdmissionTimeouts applies the policy of the admitted gangs which are not ready after their admission timeout:
+// the minAvailable of an Elastic gang is lowered to its ready members, at least 1, in the session, so that the
+// members which fit are allocated; a Requeue gang can not be pipelined, and is moved back to Pending at the close
+// of the session unless it gets ready.
+func (gp *gangPlugin) applyAdmissionTimeouts(ssn *framework.Session) {
+	for _, job := range ssn.Jobs {
+		if job.PodGroup == nil || job.IsPending() || job.IsReady() {
+			continue
+		}
+		timeout, policy, err := parseAdmissionTimeout(job)
+		if err != nil {
+			klog.Warningf("Failed to parse the admission timeout of job <%s/%s>: %v", job.Namespace, job.Name, err)
+			continue
+		}
+		if timeout == 0 || time.Since(job.CreationTimestamp.Time) < timeout {
+			continue
+		}
+
+		switch policy {
+		case v1beta1.GangAdmissionTimeoutElastic:
+			minAvailable := max(job.ReadyTaskNum(), 1)
+			klog.V(3).Infof("Job <%s/%s> admission timed out after %v, lower its minAvailable from %d to %d",
+				job.Namespace, job.Name, timeout, job.MinAvailable, minAvailable)
+			job.MinAvailable = minAvailable
+		case v1beta1.GangAdmissionTimeoutRequeue:
+			if job.PodGroup.Status.Phase != scheduling.PodGroupInqueue {
+				continue
+			}
+			gp.requeued[job.UID] = timeout
+		}
+	}
+}
+
+// requeue moves the gangs whose admission timed out back to Pending, unless they got ready in the session.
+func (gp *gangPlugin) requeue(ssn *framework.Session) {
+	for uid, timeout := range gp.requeued {
+		job, found := ssn.Jobs[uid]
+		if !found || job.IsReady() {
+			continue
+		}
+		msg := fmt.Sprintf("gang not admitted within %v, %d/%d tasks ready, requeued", timeout, job.ReadyTaskNum(), job.MinAvailable)
+		klog.V(3).Infof("Job <%s/%s> %s", job.Namespace, job.Name, msg)
+		job.PodGroup.Status.Phase = scheduling.PodGroupPending
+		ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, gangAdmissionTimeoutReason, msg)
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestParseAdmissionTimeout(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		timeout     time.Duration
+		policy      string
+		wantErr     bool
+	}{
+		{
+			name: "no timeout",
+		},
+		{
+			name:        "requeue by default",
+			annotations: map[string]string{schedulingv1beta1.GangAdmissionTimeoutKey: "30m"},
+			timeout:     30 * time.Minute,
+			policy:      schedulingv1beta1.GangAdmissionTimeoutRequeue,
+		},
+		{
+			name: "elastic",
+			annotations: map[string]string{
+				schedulingv1beta1.GangAdmissionTimeoutKey:       "1h",
+				schedulingv1beta1.GangAdmissionTimeoutPolicyKey: schedulingv1beta1.GangAdmissionTimeoutElastic,
+			},
+			timeout: time.Hour,
+			policy:  schedulingv1beta1.GangAdmissionTimeoutElastic,
+		},
+		{
+			name:        "invalid duration",
+			annotations: map[string]string{schedulingv1beta1.GangAdmissionTimeoutKey: "soon"},
+			wantErr:     true,
+		},
+		{
+			name:        "non positive duration",
+			annotations: map[string]string{schedulingv1beta1.GangAdmissionTimeoutKey: "0s"},
+			wantErr:     true,
+		},
+		{
+			name: "unknown policy",
+			annotations: map[string]string{
+				schedulingv1beta1.GangAdmissionTimeoutKey:       "1h",
+				schedulingv1beta1.GangAdmissionTimeoutPolicyKey: "Shrink",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := api.NewJobInfo("ns1/job1")
+			job.PodGroup = &api.PodGroup{}
+			job.PodGroup.Annotations = tt.annotations
+			timeout, policy, err := parseAdmissionTimeout(job)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if timeout != tt.timeout || policy != tt.policy {
+				t.Errorf("expected timeout %v and policy %q, got %v and %q", tt.timeout, tt.policy, timeout, policy)
+			}
+		})
+	}
+}
+
+func TestAdmissionTimeout(t *testing.T) {
+	nodes := []*v1.Node{util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)}
+	buildPods := func() []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < 4; i++ {
+			pods = append(pods, util.BuildPod("ns1", fmt.Sprintf("p%d", i), "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+		}
+		return pods
+	}
+	buildPodGroup := func(created time.Duration, policy string) *schedulingv1beta1.PodGroup {
+		pg := util.BuildPodGroupWithAnno("pg1", "ns1", "q1", 4, nil, schedulingv1beta1.PodGroupInqueue, map[string]string{
+			schedulingv1beta1.GangAdmissionTimeoutKey:       "10m",
+			schedulingv1beta1.GangAdmissionTimeoutPolicyKey: policy,
+		})
+		pg.CreationTimestamp = metav1.NewTime(time.Now().Add(-created))
+		return pg
+	}
+	queue := util.BuildQueue("q1", 1, nil)
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		// phase is the phase of the podgroup once the gangs whose admission timed out are requeued
+		phase scheduling.PodGroupPhase
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "gang waiting within its admission timeout",
+				PodGroups:      []*schedulingv1beta1.PodGroup{buildPodGroup(time.Minute, schedulingv1beta1.GangAdmissionTimeoutElastic)},
+				ExpectBindsNum: 0,
+			},
+			phase: scheduling.PodGroupInqueue,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "elastic gang allocates the members which fit",
+				PodGroups:        []*schedulingv1beta1.PodGroup{buildPodGroup(time.Hour, schedulingv1beta1.GangAdmissionTimeoutElastic)},
+				ExpectBindsNum:   2,
+				MinimalBindCheck: true,
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "gang requeued after its admission timeout",
+				PodGroups:      []*schedulingv1beta1.PodGroup{buildPodGroup(time.Hour, schedulingv1beta1.GangAdmissionTimeoutRequeue)},
+				ExpectBindsNum: 0,
+			},
+			phase: scheduling.PodGroupPending,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = plugins
+			test.Pods = buildPods()
+			test.Nodes = nodes
+			test.Queues = []*schedulingv1beta1.Queue{queue}
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			gp := New(nil).(*gangPlugin)
+			gp.applyAdmissionTimeouts(ssn)
+			gp.requeue(ssn)
+			if phase := ssn.Jobs["ns1/pg1"].PodGroup.Status.Phase; test.phase != "" && phase != test.phase {
+				t.Errorf("expected phase %s, got %s", test.phase, phase)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+const compositionStateKey = "gangCompositionState"
+
+// nodeType is a type of node of a gang composition and the minimum number of members placed on it.
+type nodeType struct {
+	key   string
+	value string
+	min   int32
+}
+
+func (nt *nodeType) String() string {
+	return fmt.Sprintf("%s=%s", nt.key, nt.value)
+}
+
+func (nt *nodeType) matches(node *api.NodeInfo) bool {
+	if node == nil || node.Node == nil {
+		return false
+	}
+	value, found := node.Node.Labels[nt.key]
+	return found && value == nt.value
+}
+
+// composition is the minimum numbers of members of a gang on each type of node.
+type composition []*nodeType
+
+// parseComposition parses the GangCompositionKey annotation of the job, it returns nil if the job has none.
+func parseComposition(job *api.JobInfo) (composition, error) {
+	if job.PodGroup == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(job.PodGroup.Annotations[v1beta1.GangCompositionKey])
+	if value == "" {
+		return nil, nil
+	}
+
+	var c composition
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		sep := strings.LastIndex(entry, ":")
+		if sep < 0 {
+			return nil, fmt.Errorf("invalid gang composition entry %q, must be <label>=<value>:<min>", entry)
+		}
+		min, err := strconv.ParseInt(entry[sep+1:], 10, 32)
+		if err != nil || min < 0 {
+			return nil, fmt.Errorf("invalid minimum of gang composition entry %q, must be a non-negative integer", entry)
+		}
+		key, val, found := strings.Cut(entry[:sep], "=")
+		if !found || key == "" || val == "" {
+			return nil, fmt.Errorf("invalid node type of gang composition entry %q, must be <label>=<value>", entry)
+		}
+		for _, nt := range c {
+			if nt.key == key && nt.value == val {
+				return nil, fmt.Errorf("duplicated node type %s=%s in gang composition", key, val)
+			}
+		}
+		c = append(c, &nodeType{key: key, value: val, min: int32(min)})
+	}
+	return c, nil
+}
+
+// typeOf returns the index of the first type of the composition the node is of, -1 if it is of none.
+func (c composition) typeOf(node *api.NodeInfo) int {
+	for i, nt := range c {
+		if nt.matches(node) {
+			return i
+		}
+	}
+	return -1
+}
+
+// placed returns the number of the members of the job placed on each type of node, the pipelined members are counted
+// if pipelined is set.
+func (c composition) placed(ssn *framework.Session, job *api.JobInfo, pipelined bool) []int32 {
+	statuses := []api.TaskStatus{api.Bound, api.Binding, api.Running, api.Allocated, api.Succeeded}
+	if pipelined {
+		statuses = append(statuses, api.Pipelined)
+	}
+	counts := make([]int32, len(c))
+	for _, status := range statuses {
+		for _, task := range job.TaskStatusIndex[status] {
+			if i := c.typeOf(ssn.Nodes[task.NodeName]); i >= 0 {
+				counts[i]++
+			}
+		}
+	}
+	return counts
+}
+
+// met returns whether the minimum of every type of node is met by the placed members of the job.
+func (c composition) met(ssn *framework.Session, job *api.JobInfo, pipelined bool) bool {
+	counts := c.placed(ssn, job, pipelined)
+	for i, nt := range c {
+		if counts[i] < nt.min {
+			return false
+		}
+	}
+	return true
+}
+
+// compositionState is the number of members missing on each type of node of the composition of the job of a task,
+// and the number of pending members of the job, when the task is predicated.
+type compositionState struct {
+	missing []int32
+	pending int32
+}
+
+func (s *compositionState) Clone() fwk.StateData {
+	return &compositionState{missing: append([]int32(nil), s.missing...), pending: s.pending}
+}
+
+// enqueueable returns whether the future idle resources of the nodes of each type fit the members missing on it,
+// as estimated with the request of a pending member.
+func (c composition) enqueueable(ssn *framework.Session, job *api.JobInfo) (bool, string) {
+	var req *api.Resource
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if !task.BestEffort {
+			req = task.InitResreq
+			break
+		}
+	}
+	if req == nil {
+		return true, ""
+	}
+
+	counts := c.placed(ssn, job, true)
+	for i, nt := range c {
+		missing := nt.min - counts[i]
+		for _, node := range ssn.Nodes {
+			if missing <= 0 {
+				break
+			}
+			if !node.Ready() || !nt.matches(node) {
+				continue
+			}
+			idle := node.FutureIdle()
+			for missing > 0 && req.LessEqual(idle, api.Zero) {
+				idle.Sub(req)
+				missing--
+			}
+		}
+		if missing > 0 {
+			return false, fmt.Sprintf("nodes %s fit %d members less than the minimum %d of the gang composition",
+				nt, missing, nt.min)
+		}
+	}
+	return true, ""
+}
+
+// addCompositionFns registers the functions placing the members of the gangs as required by their compositions.
+func (gp *gangPlugin) addCompositionFns(ssn *framework.Session) {
+	for _, job := range ssn.Jobs {
+		c, err := parseComposition(job)
+		if err != nil {
+			klog.V(3).Infof("Invalid gang composition of job <%s/%s>: %v", job.Namespace, job.Name, err)
+			gp.invalidCompositions[job.UID] = err
+			continue
+		}
+		if c != nil {
+			gp.compositions[job.UID] = c
+		}
+	}
+	if len(gp.compositions) == 0 {
+		return
+	}
+
+	ssn.AddJobEnqueueableFn(gp.Name(), func(obj interface{}) int {
+		job := obj.(*api.JobInfo)
+		c, found := gp.compositions[job.UID]
+		if !found {
+			return util.Abstain
+		}
+		if fits, reason := c.enqueueable(ssn, job); !fits {
+			klog.V(3).Infof("Job <%s/%s> is not enqueueable: %s", job.Namespace, job.Name, reason)
+			ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, string(scheduling.PodGroupUnschedulableType), reason)
+			return util.Reject
+		}
+		return util.Abstain
+	})
+
+	ssn.AddPredicateFn(gp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+		c, found := gp.compositions[task.Job]
+		if !found {
+			return nil
+		}
+		i := c.typeOf(node)
+		if i < 0 {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.UnschedulableAndUnresolvable,
+				Reason: "node is of no type of the gang composition",
+				Plugin: PluginName,
+			})
+		}
+		data, err := ssn.GetCycleState(task.UID).Read(compositionStateKey)
+		if err != nil {
+			return nil
+		}
+		state := data.(*compositionState)
+		if state.missing[i] > 0 {
+			return nil
+		}
+		// the other members pending have to fill the minimums of the other types of node
+		var missing int32
+		for _, m := range state.missing {
+			missing += m
+		}
+		if state.pending-1 < missing {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.UnschedulableAndUnresolvable,
+				Reason: fmt.Sprintf("members of the gang are missing on the other types of node than %s", c[i]),
+				Plugin: PluginName,
+			})
+		}
+		return nil
+	})
+}
+
+// compositionPrePredicate records the members missing on each type of node of the composition of the gang of the task
+// in the cycle state of the task, for the predicate.
+func (gp *gangPlugin) compositionPrePredicate(ssn *framework.Session, task *api.TaskInfo) {
+	c, found := gp.compositions[task.Job]
+	job := ssn.Jobs[task.Job]
+	if !found || job == nil {
+		return
+	}
+	counts := c.placed(ssn, job, true)
+	state := &compositionState{missing: make([]int32, len(c)), pending: int32(len(job.TaskStatusIndex[api.Pending]))}
+	for i, nt := range c {
+		if counts[i] < nt.min {
+			state.missing[i] = nt.min - counts[i]
+		}
+	}
+	ssn.GetCycleState(task.UID).Write(compositionStateKey, state)
+}
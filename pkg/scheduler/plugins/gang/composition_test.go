@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/enqueue"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestParseComposition(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected composition
+		wantErr  bool
+	}{
+		{
+			name: "no composition",
+		},
+		{
+			name:  "minimums on gpu products",
+			value: "nvidia.com/gpu.product=H100:4, nvidia.com/gpu.product=A100:0",
+			expected: composition{
+				{key: "nvidia.com/gpu.product", value: "H100", min: 4},
+				{key: "nvidia.com/gpu.product", value: "A100", min: 0},
+			},
+		},
+		{name: "missing minimum", value: "nvidia.com/gpu.product=H100", wantErr: true},
+		{name: "negative minimum", value: "nvidia.com/gpu.product=H100:-1", wantErr: true},
+		{name: "missing value", value: "nvidia.com/gpu.product:4", wantErr: true},
+		{name: "duplicated type", value: "nvidia.com/gpu.product=H100:4,nvidia.com/gpu.product=H100:2", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job := &api.JobInfo{PodGroup: &api.PodGroup{PodGroup: scheduling.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{schedulingv1beta1.GangCompositionKey: test.value}},
+			}}}
+			c, err := parseComposition(job)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if fmt.Sprint(c) != fmt.Sprint(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, c)
+			}
+		})
+	}
+}
+
+func TestGangComposition(t *testing.T) {
+	h100 := map[string]string{"nvidia.com/gpu.product": "H100"}
+	a100 := map[string]string{"nvidia.com/gpu.product": "A100"}
+	v100 := map[string]string{"nvidia.com/gpu.product": "V100"}
+	nodeRes := api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...)
+	nodes := []*v1.Node{
+		util.BuildNode("h100-1", nodeRes, h100), util.BuildNode("h100-2", nodeRes, h100),
+		util.BuildNode("a100-1", nodeRes, a100), util.BuildNode("a100-2", nodeRes, a100),
+		util.BuildNode("v100-1", nodeRes, v100), util.BuildNode("v100-2", nodeRes, v100),
+	}
+
+	buildPods := func(pg string, num int) []*v1.Pod {
+		var pods []*v1.Pod
+		for i := 0; i < num; i++ {
+			pods = append(pods, util.BuildPod("ns1", fmt.Sprintf("%s-%d", pg, i), "", v1.PodPending,
+				api.BuildResourceList("1", "1Gi"), pg, nil, nil))
+		}
+		return pods
+	}
+	buildPodGroup := func(name string, minMember int32, phase schedulingv1beta1.PodGroupPhase, value string) *schedulingv1beta1.PodGroup {
+		pg := util.BuildPodGroupWithAnno(name, "ns1", "q1", minMember, nil, phase,
+			map[string]string{schedulingv1beta1.GangCompositionKey: value})
+		minResources := api.BuildResourceList("1", "1Gi")
+		pg.Spec.MinResources = &minResources
+		return pg
+	}
+	queue := util.BuildQueue("q1", 1, nil)
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobEnqueued:  &trueValue,
+					EnabledJobStarving:  &trueValue,
+					EnabledPredicate:    &trueValue,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		// minimums are the minimum numbers of the members bound on each type of node, no member is bound on the others
+		minimums map[string]int
+		binds    int
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:      "at least 3 members on H100 and the rest on A100",
+				Plugins:   plugins,
+				Pods:      buildPods("pg1", 6),
+				Nodes:     nodes,
+				PodGroups: []*schedulingv1beta1.PodGroup{buildPodGroup("pg1", 6, schedulingv1beta1.PodGroupInqueue, "nvidia.com/gpu.product=H100:3,nvidia.com/gpu.product=A100:0")},
+				Queues:    []*schedulingv1beta1.Queue{queue},
+			},
+			minimums: map[string]int{"H100": 3, "A100": 0},
+			binds:    6,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:      "minimums on H100 and A100 filled before the other members",
+				Plugins:   plugins,
+				Pods:      buildPods("pg1", 4),
+				Nodes:     nodes,
+				PodGroups: []*schedulingv1beta1.PodGroup{buildPodGroup("pg1", 4, schedulingv1beta1.PodGroupInqueue, "nvidia.com/gpu.product=H100:2,nvidia.com/gpu.product=A100:2")},
+				Queues:    []*schedulingv1beta1.Queue{queue},
+			},
+			minimums: map[string]int{"H100": 2, "A100": 2},
+			binds:    4,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:      "minimum on H100 larger than the H100 nodes fit",
+				Plugins:   plugins,
+				Pods:      buildPods("pg1", 6),
+				Nodes:     nodes,
+				PodGroups: []*schedulingv1beta1.PodGroup{buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupInqueue, "nvidia.com/gpu.product=H100:5")},
+				Queues:    []*schedulingv1beta1.Queue{queue},
+			},
+			minimums: map[string]int{},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:      "gang not admitted until the H100 nodes fit its minimum",
+				Plugins:   plugins,
+				Pods:      buildPods("pg1", 6),
+				Nodes:     nodes,
+				PodGroups: []*schedulingv1beta1.PodGroup{buildPodGroup("pg1", 2, schedulingv1beta1.PodGroupPending, "nvidia.com/gpu.product=H100:5")},
+				Queues:    []*schedulingv1beta1.Queue{queue},
+			},
+			minimums: map[string]int{},
+		},
+	}
+
+	actions := []framework.Action{enqueue.New(), allocate.New()}
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.ExpectBindsNum = test.binds
+			test.MinimalBindCheck = true
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			bound := map[string]int{}
+			for _, job := range ssn.Jobs {
+				for _, task := range job.Tasks {
+					if node, found := ssn.Nodes[task.NodeName]; found && api.AllocatedStatus(task.Status) {
+						bound[node.Node.Labels["nvidia.com/gpu.product"]]++
+					}
+				}
+			}
+			for product, num := range bound {
+				if min, found := test.minimums[product]; !found || num < min {
+					t.Errorf("expected at least the members %v bound on the types of node, got %v", test.minimums, bound)
+				}
+			}
+			for product, min := range test.minimums {
+				if bound[product] < min {
+					t.Errorf("expected at least the members %v bound on the types of node, got %v", test.minimums, bound)
+				}
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ package gang
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,11 +46,26 @@ const PluginName = "gang"
 type gangPlugin struct {
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
+
+	// compositions are the compositions of the gangs of the session by job
+	compositions map[api.JobID]composition
+	// invalidCompositions are the errors of the invalid compositions of the gangs of the session by job
+	invalidCompositions map[api.JobID]error
+	// stages are the stages of the gangs of the session by job
+	stages map[api.JobID]stages
+	// requeued are the admission timeouts of the gangs of the session to requeue unless they get ready
+	requeued map[api.JobID]time.Duration
 }
 
 // New return gang plugin
 func New(arguments framework.Arguments) framework.Plugin {
-	return &gangPlugin{pluginArguments: arguments}
+	return &gangPlugin{
+		pluginArguments:     arguments,
+		compositions:        map[api.JobID]composition{},
+		invalidCompositions: map[api.JobID]error{},
+		stages:              map[api.JobID]stages{},
+		requeued:            map[api.JobID]time.Duration{},
+	}
 }
 
 func (gp *gangPlugin) Name() string {
@@ -55,6 +73,19 @@ func (gp *gangPlugin) Name() string {
 }
 
 func (gp *gangPlugin) OnSessionOpen(ssn *framework.Session) {
+	gp.addCompositionFns(ssn)
+	gp.addStageFns(ssn)
+	if len(gp.compositions) != 0 || len(gp.stages) != 0 {
+		ssn.AddPrePredicateFn(gp.Name(), func(task *api.TaskInfo) error {
+			if err := gp.stagePrePredicate(ssn, task); err != nil {
+				return err
+			}
+			gp.compositionPrePredicate(ssn, task)
+			return nil
+		})
+	}
+	gp.applyAdmissionTimeouts(ssn)
+
 	validJobFn := func(obj interface{}) *api.ValidateResult {
 		job, ok := obj.(*api.JobInfo)
 		if !ok {
@@ -89,6 +120,28 @@ func (gp *gangPlugin) OnSessionOpen(ssn *framework.Session) {
 					vtn, job.MinAvailable),
 			}
 		}
+
+		if err, found := gp.invalidCompositions[job.UID]; found {
+			return &api.ValidateResult{
+				Pass:    false,
+				Reason:  v1beta1.NotEnoughPodsReason,
+				Message: fmt.Sprintf("Invalid gang composition: %v", err),
+			}
+		}
+		if c, found := gp.compositions[job.UID]; found {
+			var min int32
+			for _, nt := range c {
+				min += nt.min
+			}
+			if vtn < min {
+				return &api.ValidateResult{
+					Pass:   false,
+					Reason: v1beta1.NotEnoughPodsReason,
+					Message: fmt.Sprintf("Not enough valid tasks for the gang composition, valid: %d, min: %d",
+						vtn, min),
+				}
+			}
+		}
 		return nil
 	}
 
@@ -184,13 +237,13 @@ func (gp *gangPlugin) OnSessionOpen(ssn *framework.Session) {
 			return -1
 		}
 
-		return 0
+		return gp.compareStages(lv, rv)
 	}
 	ssn.AddSubJobOrderFn(gp.Name(), subJobOrderFn)
 
 	ssn.AddJobReadyFn(gp.Name(), func(obj interface{}) bool {
 		ji := obj.(*api.JobInfo)
-		if ji.CheckTaskReady() && ji.CheckSubJobReady() && ji.IsReady() {
+		if ji.CheckTaskReady() && ji.CheckSubJobReady() && ji.IsReady() && gp.compositionMet(ssn, ji, false) {
 			return true
 		}
 		return false
@@ -203,7 +256,11 @@ func (gp *gangPlugin) OnSessionOpen(ssn *framework.Session) {
 
 	pipelinedFn := func(obj interface{}) int {
 		ji := obj.(*api.JobInfo)
-		if ji.CheckTaskPipelined() && ji.CheckSubJobPipelined() && ji.IsPipelined() {
+		// the gang whose admission timed out does not hold the resources of its pipelined members
+		if _, found := gp.requeued[ji.UID]; found {
+			return util.Reject
+		}
+		if ji.CheckTaskPipelined() && ji.CheckSubJobPipelined() && ji.IsPipelined() && gp.compositionMet(ssn, ji, true) {
 			return util.Permit
 		}
 		return util.Reject
@@ -221,12 +278,24 @@ func (gp *gangPlugin) OnSessionOpen(ssn *framework.Session) {
 	jobStarvingFn := func(obj interface{}) bool {
 		ji := obj.(*api.JobInfo)
 		// In the preemption scenario, the taskMinAvailable configuration is not concerned, only the jobMinAvailable is concerned
-		return ji.IsStarving()
+		return ji.IsStarving() || !gp.compositionMet(ssn, ji, true)
 	}
 	ssn.AddJobStarvingFns(gp.Name(), jobStarvingFn)
 }
 
+// compositionMet returns whether the job has no gang composition, or its placed members meet it.
+func (gp *gangPlugin) compositionMet(ssn *framework.Session, job *api.JobInfo, pipelined bool) bool {
+	c, found := gp.compositions[job.UID]
+	return !found || c.met(ssn, job, pipelined)
+}
+
 func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
+	gp.requeue(ssn)
+	gp.compositions = map[api.JobID]composition{}
+	gp.invalidCompositions = map[api.JobID]error{}
+	gp.stages = map[api.JobID]stages{}
+	gp.requeued = map[api.JobID]time.Duration{}
+
 	var unreadyTaskCount int32
 	var unScheduleJobCount int
 	for _, job := range ssn.Jobs {
@@ -234,7 +303,8 @@ func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
 		if len(job.Tasks) == 0 {
 			continue
 		}
-		if !job.IsReady() {
+		unreadyRoles := job.UnreadyTaskRoles()
+		if !job.IsReady() || len(unreadyRoles) != 0 {
 			schedulableTaskNum := func() (num int32) {
 				for _, task := range job.TaskStatusIndex[api.Pending] {
 					ctx := task.GetTransactionContext()
@@ -248,8 +318,17 @@ func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
 				return num + job.ReadyTaskNum()
 			}
 			unreadyTaskCount = job.MinAvailable - schedulableTaskNum()
+			reason := v1beta1.NotEnoughResourcesReason
 			msg := fmt.Sprintf("%v/%v tasks in gang unschedulable: %v",
 				unreadyTaskCount, len(job.Tasks), job.FitError())
+			if len(unreadyRoles) != 0 {
+				// The gang has enough members, but not of every task.
+				if job.IsReady() {
+					reason = v1beta1.NotEnoughPodsOfTaskReason
+					msg = fmt.Sprintf("tasks in gang unschedulable: %v", job.FitError())
+				}
+				msg = fmt.Sprintf("%s; %s", msg, unreadyRolesMessage(job, unreadyRoles))
+			}
 
 			unScheduleJobCount++
 			if !ssn.IsJobTerminated(job.UID) {
@@ -264,7 +343,7 @@ func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
 				Status:             v1.ConditionTrue,
 				LastTransitionTime: metav1.Now(),
 				TransitionID:       string(ssn.UID),
-				Reason:             v1beta1.NotEnoughResourcesReason,
+				Reason:             reason,
 				Message:            msg,
 			}
 
@@ -295,3 +374,19 @@ func (gp *gangPlugin) OnSessionClose(ssn *framework.Session) {
 
 	metrics.UpdateUnscheduleJobCount(unScheduleJobCount)
 }
+
+// unreadyRolesMessage describes the tasks of job below their minimum in minTaskMember,
+// e.g. "task ps 0/1 ready, task worker 2/4 ready".
+func unreadyRolesMessage(job *api.JobInfo, unreadyRoles map[string]int32) string {
+	roles := make([]string, 0, len(unreadyRoles))
+	for role := range unreadyRoles {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	parts := make([]string, 0, len(roles))
+	for _, role := range roles {
+		parts = append(parts, fmt.Sprintf("task %s %d/%d ready", role, unreadyRoles[role], job.TaskMinAvailable[role]))
+	}
+	return strings.Join(parts, ", ")
+}
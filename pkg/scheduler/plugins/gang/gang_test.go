@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestTaskMemberConditions(t *testing.T) {
+	nodes := []*v1.Node{util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)}
+	buildPod := func(name, role, node string, phase v1.PodPhase) *v1.Pod {
+		return util.BuildPod("ns1", name, node, phase, api.BuildResourceList("1", "1Gi"), "pg1",
+			map[string]string{batch.TaskSpecKey: role}, nil)
+	}
+	podGroup := util.BuildPodGroup("pg1", "ns1", "q1", 3, map[string]int32{"worker": 2, "ps": 1}, schedulingv1beta1.PodGroupRunning)
+	queue := util.BuildQueue("q1", 1, nil)
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		condition scheduling.PodGroupConditionType
+		reason    string
+		message   string
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "not enough members",
+				Pods: []*v1.Pod{
+					buildPod("w0", "worker", "", v1.PodPending),
+					buildPod("w1", "worker", "", v1.PodPending),
+					buildPod("p0", "ps", "", v1.PodPending),
+				},
+			},
+			condition: scheduling.PodGroupUnschedulableType,
+			reason:    schedulingv1beta1.NotEnoughResourcesReason,
+			message:   "3/3 tasks in gang unschedulable: pod group is not ready, 3 Pending, 3 minAvailable; Pending: 3 Unschedulable; task ps 0/1 ready, task worker 0/2 ready",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "enough members but not of every task",
+				Pods: []*v1.Pod{
+					buildPod("w0", "worker", "n1", v1.PodRunning),
+					buildPod("w1", "worker", "n1", v1.PodRunning),
+					buildPod("w2", "worker", "n1", v1.PodRunning),
+					buildPod("p0", "ps", "", v1.PodPending),
+				},
+			},
+			condition: scheduling.PodGroupUnschedulableType,
+			reason:    schedulingv1beta1.NotEnoughPodsOfTaskReason,
+			message:   "tasks in gang unschedulable: pod group is ready, 1 Pending, 3 Running, 3 minAvailable; Pending: 1 Unschedulable; task ps 0/1 ready",
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "every task meets its minimum",
+				Pods: []*v1.Pod{
+					buildPod("w0", "worker", "n1", v1.PodRunning),
+					buildPod("w1", "worker", "n1", v1.PodRunning),
+					buildPod("p0", "ps", "n1", v1.PodRunning),
+				},
+			},
+			condition: scheduling.PodGroupScheduled,
+			reason:    "tasks in gang are ready to be scheduled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = map[string]framework.PluginBuilder{PluginName: New}
+			test.Nodes = nodes
+			test.PodGroups = []*schedulingv1beta1.PodGroup{podGroup.DeepCopy()}
+			test.Queues = []*schedulingv1beta1.Queue{queue}
+			ssn := test.RegisterSession([]conf.Tier{{Plugins: []conf.PluginOption{{Name: PluginName}}}}, nil)
+			defer test.Close()
+
+			New(nil).OnSessionClose(ssn)
+
+			conditions := ssn.Jobs["ns1/pg1"].PodGroup.Status.Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected 1 condition, got %v", conditions)
+			}
+			if conditions[0].Type != test.condition || conditions[0].Reason != test.reason || conditions[0].Message != test.message {
+				t.Errorf("expected condition %s with reason %q and message %q, got %s with reason %q and message %q",
+					test.condition, test.reason, test.message, conditions[0].Type, conditions[0].Reason, conditions[0].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// stages are the subJobs of the subGroupPolicy of a gang given by its gang-stages annotation, in the order of the first
+// value of the matchLabelKeys of the policy.
+type stages []*api.SubJobInfo
+
+// jobStages returns the stages of the job, or nil if it has none.
+func jobStages(job *api.JobInfo) stages {
+	if job.PodGroup == nil {
+		return nil
+	}
+	policy := job.PodGroup.Annotations[v1beta1.GangStagesKey]
+	if policy == "" {
+		return nil
+	}
+
+	gid := api.SubJobGIDOf(job.UID, policy)
+	var s stages
+	for _, subJob := range job.SubJobs {
+		if subJob.GID == gid {
+			s = append(s, subJob)
+		}
+	}
+	sort.Slice(s, func(i, j int) bool {
+		if s[i].MatchIndex != s[j].MatchIndex {
+			return s[i].MatchIndex < s[j].MatchIndex
+		}
+		return s[i].UID < s[j].UID
+	})
+	return s
+}
+
+// indexOf returns the index of the stage of the subJob, or -1 if it is no stage.
+func (s stages) indexOf(subJob api.SubJobID) int {
+	for i, stage := range s {
+		if stage.UID == subJob {
+			return i
+		}
+	}
+	return -1
+}
+
+// stageOf returns the stages of the gang of the task and the index of the stage of the task, or -1 if it is in none.
+func (gp *gangPlugin) stageOf(ssn *framework.Session, task *api.TaskInfo) (stages, int) {
+	s, found := gp.stages[task.Job]
+	job := ssn.Jobs[task.Job]
+	if !found || job == nil {
+		return nil, -1
+	}
+	return s, s.indexOf(job.TaskToSubJob[task.UID])
+}
+
+// addStageFns registers the functions admitting the stages of the gangs one after the other, and placing them close to
+// their adjacent stages.
+func (gp *gangPlugin) addStageFns(ssn *framework.Session) {
+	for _, job := range ssn.Jobs {
+		if s := jobStages(job); len(s) != 0 {
+			gp.stages[job.UID] = s
+		}
+	}
+	if len(gp.stages) == 0 {
+		return
+	}
+
+	ssn.AddNodeOrderFn(gp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		s, i := gp.stageOf(ssn, task)
+		if i < 0 {
+			return 0, nil
+		}
+		var score float64
+		for _, adjacent := range []int{i - 1, i + 1} {
+			if adjacent < 0 || adjacent >= len(s) {
+				continue
+			}
+			score = max(score, stageAffinityScore(ssn, s[adjacent], node))
+		}
+		return score, nil
+	})
+}
+
+// stagePrePredicate rejects the tasks of a stage while a previous stage of the gang is not ready.
+func (gp *gangPlugin) stagePrePredicate(ssn *framework.Session, task *api.TaskInfo) error {
+	s, i := gp.stageOf(ssn, task)
+	for j := 0; j < i; j++ {
+		if !s[j].IsReady() {
+			klog.V(4).Infof("Task <%s/%s> of stage %d waits for stage %d of its gang", task.Namespace, task.Name, i, j)
+			return fmt.Errorf("stage %d of the gang is not ready", j)
+		}
+	}
+	return nil
+}
+
+// compareStages orders the stages of a gang which are not ready by their index.
+func (gp *gangPlugin) compareStages(l, r *api.SubJobInfo) int {
+	if l.Job != r.Job || l.GID != r.GID {
+		return 0
+	}
+	s, found := gp.stages[l.Job]
+	if !found {
+		return 0
+	}
+	li, ri := s.indexOf(l.UID), s.indexOf(r.UID)
+	if li < ri {
+		return -1
+	}
+	if li > ri {
+		return 1
+	}
+	return 0
+}
+
+// stageAffinityScore scores the node by its closeness to the nodes of the members of the stage: the highest score on
+// a node of a member, lower scores the higher the tier of the lowest HyperNode containing the node and a node of a
+// member, and 0 when they are in no common HyperNode but the cluster top one.
+func stageAffinityScore(ssn *framework.Session, stage *api.SubJobInfo, node *api.NodeInfo) float64 {
+	leaf := util.FindHyperNodeForNode(node.Name, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
+	tiers := len(ssn.HyperNodesTiers)
+
+	var score float64
+	for status, tasks := range stage.TaskStatusIndex {
+		if !api.AllocatedStatus(status) {
+			continue
+		}
+		for _, member := range tasks {
+			if member.NodeName == "" {
+				continue
+			}
+			if member.NodeName == node.Name {
+				return api.DefaultMaxNodeScore
+			}
+			if leaf == "" {
+				continue
+			}
+			memberLeaf := util.FindHyperNodeForNode(member.NodeName, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
+			if memberLeaf == "" {
+				continue
+			}
+			lca, found := ssn.HyperNodes[ssn.HyperNodes.GetLCAHyperNode(leaf, memberLeaf)]
+			if !found || lca.Name == framework.ClusterTopHyperNode {
+				continue
+			}
+			rank := slices.Index(ssn.HyperNodesTiers, lca.Tier())
+			if rank < 0 {
+				continue
+			}
+			score = max(score, float64(api.DefaultMaxNodeScore)*float64(tiers-rank)/float64(tiers+1))
+		}
+	}
+	return score
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gang
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestGangStages(t *testing.T) {
+	nodeRes := api.BuildResourceList("3", "6Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...)
+	nodes := []*v1.Node{
+		util.BuildNode("s0-n1", nodeRes, nil), util.BuildNode("s0-n2", nodeRes, nil),
+		util.BuildNode("s1-n3", nodeRes, nil), util.BuildNode("s1-n4", nodeRes, nil),
+	}
+	leaves := map[string]string{"s0-n1": "s0", "s0-n2": "s0", "s1-n3": "s1", "s1-n4": "s1"}
+
+	// buildPods builds 2 members of each stage, the members of stage 0 request cpu0
+	buildPods := func(stages int, cpu0 string) []*v1.Pod {
+		var pods []*v1.Pod
+		for stage := 0; stage < stages; stage++ {
+			cpu := "1"
+			if stage == 0 {
+				cpu = cpu0
+			}
+			for i := 0; i < 2; i++ {
+				pods = append(pods, util.BuildPod("ns1", fmt.Sprintf("stage%d-%d", stage, i), "", v1.PodPending,
+					api.BuildResourceList(cpu, "1Gi"), "pg1", map[string]string{"stage": fmt.Sprint(stage)}, nil))
+			}
+		}
+		return pods
+	}
+	podGroup := util.BuildPodGroupWithSubGroupPolicy("pg1", "ns1", "", "q1", 2, nil, schedulingv1beta1.PodGroupInqueue, "", 0,
+		[]schedulingv1beta1.SubGroupPolicySpec{util.BuildSubGroupPolicyWithMinSubGroups("stages", []string{"stage"}, "", 0, 2, 1)})
+	podGroup.Annotations = map[string]string{schedulingv1beta1.GangStagesKey: "stages"}
+
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledSubJobReady:  &trueValue,
+					EnabledSubJobOrder:  &trueValue,
+					EnabledPredicate:    &trueValue,
+					EnabledNodeOrder:    &trueValue,
+				},
+			},
+		},
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:           "stages allocated in order next to their adjacent stages",
+			Pods:           buildPods(3, "1"),
+			ExpectBindsNum: 6,
+		},
+		{
+			Name:           "no stage allocated while the first stage does not fit",
+			Pods:           buildPods(3, "4"),
+			ExpectBindsNum: 0,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = plugins
+			test.Nodes = nodes
+			test.PodGroups = []*schedulingv1beta1.PodGroup{podGroup.DeepCopy()}
+			test.Queues = []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)}
+			test.HyperNodesSetByTier = map[int]sets.Set[string]{1: sets.New("s0", "s1"), 2: sets.New("s2")}
+			test.HyperNodes = map[string]sets.Set[string]{
+				"s0": sets.New("s0-n1", "s0-n2"),
+				"s1": sets.New("s1-n3", "s1-n4"),
+				"s2": sets.New("s0-n1", "s0-n2", "s1-n3", "s1-n4"),
+			}
+			test.MinimalBindCheck = true
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			// every stage shares a leaf hyperNode with the previous stage
+			stageLeaves := map[string]sets.Set[string]{}
+			for _, task := range ssn.Jobs["ns1/pg1"].Tasks {
+				if task.NodeName == "" {
+					continue
+				}
+				stage := task.Pod.Labels["stage"]
+				if stageLeaves[stage] == nil {
+					stageLeaves[stage] = sets.New[string]()
+				}
+				stageLeaves[stage].Insert(leaves[task.NodeName])
+			}
+			for stage := 1; stage < len(stageLeaves); stage++ {
+				prev, cur := stageLeaves[fmt.Sprint(stage-1)], stageLeaves[fmt.Sprint(stage)]
+				if !prev.HasAny(cur.UnsortedList()...) {
+					t.Errorf("expected stage %d placed next to stage %d, got %v", stage, stage-1, stageLeaves)
+				}
+			}
+		})
+	}
+}
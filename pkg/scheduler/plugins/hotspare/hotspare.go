@@ -0,0 +1,228 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hotspare
+
+import (
+	"math"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "hotspare"
+
+	// NodesKey is the number of nodes of every flavor held back as hot spares.
+	NodesKey = "hotspare.nodes"
+	// PercentageKey is the percentage of the nodes of every flavor held back as hot spares.
+	PercentageKey = "hotspare.percentage"
+	// FlavorLabelKey is the node label the nodes are grouped into flavors by.
+	FlavorLabelKey = "hotspare.flavorLabel"
+	// QueuesKey is the list of queues allowed to use the hot spare nodes.
+	QueuesKey = "hotspare.queues"
+
+	defaultFlavorLabel = v1.LabelInstanceTypeStable
+
+	// hotSpareReason is the reason of the predicate failure of the tasks not allowed on the hot spare nodes.
+	hotSpareReason = "node is held back as hot spare"
+)
+
+/*
+   actions: "enqueue, allocate, backfill, shuffle"
+   tiers:
+   - plugins:
+     - name: hotspare
+       arguments:
+         hotspare.nodes: 1
+         hotspare.percentage: 10
+         hotspare.flavorLabel: node.kubernetes.io/instance-type
+         hotspare.queues:
+         - inference
+*/
+
+type hotSparePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	nodes           int
+	percentage      int
+	flavorLabel     string
+	queues          sets.Set[string]
+
+	// spares are the names of the nodes held back in this session.
+	spares sets.Set[string]
+}
+
+// New function returns hot spare plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	hp := &hotSparePlugin{
+		pluginArguments: arguments,
+		flavorLabel:     defaultFlavorLabel,
+		queues:          sets.New[string](),
+		spares:          sets.New[string](),
+	}
+
+	arguments.GetInt(&hp.nodes, NodesKey)
+	if hp.nodes < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, no node is held back by count", NodesKey, hp.nodes, PluginName)
+		hp.nodes = 0
+	}
+	arguments.GetInt(&hp.percentage, PercentageKey)
+	if hp.percentage < 0 || hp.percentage > 100 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, no node is held back by percentage", PercentageKey, hp.percentage, PluginName)
+		hp.percentage = 0
+	}
+	arguments.GetString(&hp.flavorLabel, FlavorLabelKey)
+	if queues, found := framework.Get[[]string](arguments, QueuesKey); found {
+		hp.queues.Insert(queues...)
+	}
+
+	return hp
+}
+
+func (hp *hotSparePlugin) Name() string {
+	return PluginName
+}
+
+// spareCount returns the number of nodes held back out of the given number of nodes of one flavor,
+// the larger of the configured count and percentage.
+func (hp *hotSparePlugin) spareCount(total int) int {
+	count := hp.nodes
+	if byPercentage := int(math.Ceil(float64(total) * float64(hp.percentage) / 100)); byPercentage > count {
+		count = byPercentage
+	}
+	if count > total {
+		count = total
+	}
+	return count
+}
+
+// allowed returns whether the task belongs to a queue allowed to use the hot spare nodes.
+func (hp *hotSparePlugin) allowed(ssn *framework.Session, task *api.TaskInfo) bool {
+	job, found := ssn.Jobs[task.Job]
+	if !found {
+		return false
+	}
+	queue, found := ssn.Queues[job.Queue]
+	return found && hp.queues.Has(queue.Name)
+}
+
+// nodeLoad is the work a node has to be drained of to become a hot spare.
+type nodeLoad struct {
+	node *api.NodeInfo
+	// allowed is the number of tasks of the queues allowed on the hot spare nodes, which are not migrated.
+	allowed int
+	// pinned is the number of other tasks which can not be migrated off the node.
+	pinned int
+	// migratable is the number of other tasks which are migrated off the node.
+	migratable int
+}
+
+// selectSpares holds back the nodes of every flavor with the least work to migrate off, the idle nodes first.
+// The nodes used by the allowed queues are selected last, so that the buffer is replenished elsewhere once
+// the allowed queues consume it.
+func (hp *hotSparePlugin) selectSpares(ssn *framework.Session) {
+	flavors := map[string][]*nodeLoad{}
+	for _, node := range ssn.Nodes {
+		if !node.Ready() || node.Node == nil {
+			continue
+		}
+		load := &nodeLoad{node: node}
+		for _, task := range node.Tasks {
+			if api.AllocatedStatus(task.Status) || task.Status == api.Pipelined {
+				switch {
+				case hp.allowed(ssn, task):
+					load.allowed++
+				case task.Preemptable && task.Status == api.Running:
+					load.migratable++
+				default:
+					load.pinned++
+				}
+			}
+		}
+		flavor := node.Node.Labels[hp.flavorLabel]
+		flavors[flavor] = append(flavors[flavor], load)
+	}
+
+	for flavor, loads := range flavors {
+		sort.Slice(loads, func(i, j int) bool {
+			l, r := loads[i], loads[j]
+			if (l.allowed > 0) != (r.allowed > 0) {
+				return r.allowed > 0
+			}
+			if (l.pinned > 0) != (r.pinned > 0) {
+				return r.pinned > 0
+			}
+			if l.migratable != r.migratable {
+				return l.migratable < r.migratable
+			}
+			return l.node.Name < r.node.Name
+		})
+		count := hp.spareCount(len(loads))
+		for _, load := range loads[:count] {
+			hp.spares.Insert(load.node.Name)
+		}
+		klog.V(4).Infof("Hot spare nodes of flavor <%s>: %d of %d nodes", flavor, count, len(loads))
+	}
+}
+
+func (hp *hotSparePlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	hp.selectSpares(ssn)
+	if hp.spares.Len() == 0 {
+		return
+	}
+	klog.V(3).Infof("Hot spare nodes held back in Session <%s>: %v", ssn.UID, sets.List(hp.spares))
+
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if !hp.spares.Has(node.Name) || hp.allowed(ssn, task) {
+			return nil
+		}
+		return api.NewFitErrWithStatus(task, node, &api.Status{
+			Code:   api.UnschedulableAndUnresolvable,
+			Reason: hotSpareReason,
+			Plugin: PluginName,
+		})
+	}
+
+	// victimsFn replenishes the buffer by migrating the preemptable tasks of the other queues off the hot spare nodes.
+	victimsFn := func(tasks []*api.TaskInfo) []*api.TaskInfo {
+		var victims []*api.TaskInfo
+		for _, task := range tasks {
+			if !hp.spares.Has(task.NodeName) {
+				continue
+			}
+			if task.Status == api.Running && task.Preemptable && !hp.allowed(ssn, task) {
+				victims = append(victims, task)
+			}
+		}
+		klog.V(4).Infof("Migrating %d tasks off the hot spare nodes", len(victims))
+		return victims
+	}
+
+	ssn.AddPredicateFn(hp.Name(), predicateFn)
+	ssn.AddVictimTasksFns(hp.Name(), []api.VictimTasksFn{victimsFn})
+}
+
+func (hp *hotSparePlugin) OnSessionClose(ssn *framework.Session) {}
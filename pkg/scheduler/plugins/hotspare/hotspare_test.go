@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hotspare
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/shuffle"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestSpareCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		arguments  framework.Arguments
+		total      int
+		spareCount int
+	}{
+		{name: "no spare by default", arguments: framework.Arguments{}, total: 10, spareCount: 0},
+		{name: "spare by count", arguments: framework.Arguments{NodesKey: 2}, total: 10, spareCount: 2},
+		{name: "spare by percentage rounded up", arguments: framework.Arguments{PercentageKey: 15}, total: 10, spareCount: 2},
+		{name: "larger of count and percentage", arguments: framework.Arguments{NodesKey: 1, PercentageKey: 30}, total: 10, spareCount: 3},
+		{name: "capped by the flavor size", arguments: framework.Arguments{NodesKey: 5}, total: 3, spareCount: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hp := New(test.arguments).(*hotSparePlugin)
+			if count := hp.spareCount(test.total); count != test.spareCount {
+				t.Errorf("expected %d spare nodes, got %d", test.spareCount, count)
+			}
+		})
+	}
+}
+
+func TestHotSpare(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+	preemptable := map[string]string{schedulingv1beta1.PodPreemptable: "true"}
+	nonPreemptable := map[string]string{schedulingv1beta1.PodPreemptable: "false"}
+	flavor := func(f string) map[string]string { return map[string]string{v1.LabelInstanceTypeStable: f} }
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+		actions   []framework.Action
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "normal tasks are kept off the hot spare nodes",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+					util.BuildNode("n2", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "n2", "c1/p2": "n2"},
+				ExpectBindsNum: 2,
+			},
+			arguments: framework.Arguments{NodesKey: 1},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "allowed queues use the hot spare nodes",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 1, nil, schedulingv1beta1.PodGroupRunning),
+					util.BuildPodGroup("pg2", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "n2", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nonPreemptable, nil),
+					util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg2", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+					util.BuildNode("n2", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+					util.BuildQueue("inference", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p2": "n1"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{NodesKey: 1, QueuesKey: []interface{}{"inference"}},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "preemptable tasks are migrated off the hot spare nodes to replenish the buffer",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 0, nil, schedulingv1beta1.PodGroupRunning),
+					util.BuildPodGroup("pg2", "c1", "inference", 1, nil, schedulingv1beta1.PodGroupRunning),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "pinned", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nonPreemptable, nil),
+					util.BuildPod("c1", "migratable", "n2", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", preemptable, nil),
+					util.BuildPod("c1", "infer", "n3", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg2", nil, nil),
+					util.BuildPod("c1", "large", "n4", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", preemptable, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+					util.BuildNode("n2", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+					util.BuildNode("n3", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("small")),
+					util.BuildNode("n4", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("large")),
+					util.BuildNode("n5", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), flavor("large")),
+				},
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+					util.BuildQueue("inference", 1, nil),
+				},
+				ExpectEvictNum: 1,
+				ExpectEvicted:  []string{"c1/migratable"},
+			},
+			arguments: framework.Arguments{NodesKey: 1, QueuesKey: []interface{}{"inference"}},
+			actions:   []framework.Action{shuffle.New()},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:             PluginName,
+							EnabledPredicate: &trueValue,
+							EnabledVictim:    &trueValue,
+							Arguments:        test.arguments,
+						},
+						{
+							Name:                gang.PluginName,
+							EnabledJobReady:     &trueValue,
+							EnabledJobPipelined: &trueValue,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(test.actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networktopologyaware
+
+import (
+	"math"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// NCCLEnable is the key for whether to score the nodes by the estimated NCCL ring bandwidth of the gang
+	NCCLEnable = "nccl.enable"
+	// NCCLBandwidthFading is the key for the bandwidth of the links crossing a tier relative to the tier below it
+	NCCLBandwidthFading = "nccl.bandwidth.fading"
+)
+
+const (
+	// DefaultNCCLEnable is the default value of nccl.enable
+	DefaultNCCLEnable = false
+	// DefaultNCCLBandwidthFading is the default value of nccl.bandwidth.fading
+	DefaultNCCLBandwidthFading = 0.5
+)
+
+type ncclConfig struct {
+	enable          bool
+	bandwidthFading float64
+}
+
+func getNCCLConfig(args framework.Arguments) *ncclConfig {
+	config := ncclConfig{
+		enable:          DefaultNCCLEnable,
+		bandwidthFading: DefaultNCCLBandwidthFading,
+	}
+	args.GetBool(&config.enable, NCCLEnable)
+	args.GetFloat64(&config.bandwidthFading, NCCLBandwidthFading)
+	if config.bandwidthFading <= 0 || config.bandwidthFading > 1 {
+		config.bandwidthFading = DefaultNCCLBandwidthFading
+	}
+	return &config
+}
+
+// ncclRing is the ring of the placed members of a gang, which the NCCL collectives run over.
+type ncclRing struct {
+	// nodes are the nodes the members are placed on.
+	nodes sets.Set[string]
+	// lcaHyperNode is the least common ancestor hyperNode of the members, empty if a member is in no hyperNode
+	// or the members have no common ancestor.
+	lcaHyperNode string
+}
+
+// newNCCLRing returns the ring of the placed members of the subJob, nil if no member is placed.
+func newNCCLRing(ssn *framework.Session, subJob *api.SubJobInfo) *ncclRing {
+	ring := &ncclRing{nodes: sets.New[string]()}
+	for _, task := range subJob.Tasks {
+		if (api.AllocatedStatus(task.Status) || task.Status == api.Pipelined) && task.NodeName != "" {
+			ring.nodes.Insert(task.NodeName)
+		}
+	}
+	if ring.nodes.Len() == 0 {
+		return nil
+	}
+
+	first := true
+	for node := range ring.nodes {
+		hyperNode := util.FindHyperNodeForNode(node, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
+		if hyperNode == "" {
+			ring.lcaHyperNode = ""
+			break
+		}
+		if first {
+			ring.lcaHyperNode, first = hyperNode, false
+			continue
+		}
+		if ring.lcaHyperNode = ssn.HyperNodes.GetLCAHyperNode(hyperNode, ring.lcaHyperNode); ring.lcaHyperNode == "" {
+			break
+		}
+	}
+	return ring
+}
+
+// crossedTier returns the highest tier the ring crosses when the node joins it: 0 if all the members are on the node,
+// above the highest tier if the node and the members have no common ancestor hyperNode.
+func (ring *ncclRing) crossedTier(ssn *framework.Session, node string, maxTier int) int {
+	if ring.nodes.Len() == 1 && ring.nodes.Has(node) {
+		return 0
+	}
+	if ring.lcaHyperNode == "" {
+		return maxTier + 1
+	}
+	hyperNode := util.FindHyperNodeForNode(node, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
+	if hyperNode == "" {
+		return maxTier + 1
+	}
+	hyperNodeInfo, ok := ssn.HyperNodes[ssn.HyperNodes.GetLCAHyperNode(hyperNode, ring.lcaHyperNode)]
+	if !ok {
+		return maxTier + 1
+	}
+	return hyperNodeInfo.Tier()
+}
+
+// ncclScore estimates the ring all-reduce bandwidth of the gang when the node joins the ring, relative to the
+// bandwidth within one node. The bandwidth of a ring is bound by its slowest link, the one crossing the highest tier,
+// and the bandwidth fades by nccl.bandwidth.fading with every tier crossed.
+func (nta *networkTopologyAwarePlugin) ncclScore(ssn *framework.Session, ring *ncclRing, node string) float64 {
+	return math.Pow(nta.ncclConfig.bandwidthFading, float64(ring.crossedTier(ssn, node, nta.hyperNodesTier.maxTier)))
+}
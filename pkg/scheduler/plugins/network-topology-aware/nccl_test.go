@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networktopologyaware
+
+import (
+	"math"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// buildNCCLTopology builds the hyperNodes s0 of tier 3, s1 and s2 of tier 2, s3 to s6 of tier 1,
+// each of which has the nodes <name>-n1 and <name>-n2.
+func buildNCCLTopology(test *uthelper.TestCommonStruct) {
+	hyperNode := func(name string, tier int, memberType topologyv1alpha1.MemberType, members ...string) *api.HyperNodeInfo {
+		var configs []api.MemberConfig
+		for _, member := range members {
+			configs = append(configs, api.MemberConfig{Name: member, Type: memberType, Selector: "exact"})
+		}
+		return api.NewHyperNodeInfo(api.BuildHyperNode(name, tier, configs))
+	}
+	test.HyperNodesSetByTier = map[int]sets.Set[string]{
+		1: sets.New[string]("s3", "s4", "s5", "s6"),
+		2: sets.New[string]("s1", "s2"),
+		3: sets.New[string]("s0"),
+	}
+	test.HyperNodesMap = map[string]*api.HyperNodeInfo{
+		"s0": hyperNode("s0", 3, topologyv1alpha1.MemberTypeHyperNode, "s1", "s2"),
+		"s1": hyperNode("s1", 2, topologyv1alpha1.MemberTypeHyperNode, "s3", "s4"),
+		"s2": hyperNode("s2", 2, topologyv1alpha1.MemberTypeHyperNode, "s5", "s6"),
+	}
+	test.HyperNodes = map[string]sets.Set[string]{
+		"s0": sets.New[string](),
+		"s1": sets.New[string]("s3-n1", "s3-n2", "s4-n1", "s4-n2"),
+		"s2": sets.New[string]("s5-n1", "s5-n2", "s6-n1", "s6-n2"),
+	}
+	for _, leaf := range []string{"s3", "s4", "s5", "s6"} {
+		test.HyperNodesMap[leaf] = hyperNode(leaf, 1, topologyv1alpha1.MemberTypeNode, leaf+"-n1", leaf+"-n2")
+		test.HyperNodes[leaf] = sets.New[string](leaf+"-n1", leaf+"-n2")
+		test.HyperNodes["s0"].Insert(leaf+"-n1", leaf+"-n2")
+		for _, node := range []string{leaf + "-n1", leaf + "-n2"} {
+			test.Nodes = append(test.Nodes, util.BuildNode(node, api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil))
+		}
+	}
+}
+
+func TestNCCLNodeScore(t *testing.T) {
+	worker := map[string]string{"volcano.sh/task-spec": "worker"}
+	tests := []struct {
+		name string
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+		expected  map[string]float64
+	}{
+		{
+			name: "members within one hyperNode of tier 1, the bandwidth fades with the highest tier crossed",
+			TestCommonStruct: uthelper.TestCommonStruct{
+				PodGroups: []*schedulingv1.PodGroup{
+					util.BuildPodGroupWithNetWorkTopologies("pg1", "c1", "s3", "q1", 3, nil, schedulingv1.PodGroupRunning, "hard", 3),
+				},
+				Pods: []*corev1.Pod{
+					util.BuildPod("c1", "p1", "s3-n1", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+					util.BuildPod("c1", "p2", "s3-n2", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+					util.BuildPod("c1", "p3", "", corev1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+				},
+			},
+			arguments: framework.Arguments{NCCLEnable: true},
+			// 100 * 0.5^tier, the nodes of s3 host 2 of the 3 tasks of the subjob
+			expected: map[string]float64{
+				"s3-n1": 50 + 66.7,
+				"s3-n2": 50 + 66.7,
+				"s4-n1": 25,
+				"s5-n1": 12.5,
+			},
+		},
+		{
+			name: "members on one node, the node keeps the ring within it",
+			TestCommonStruct: uthelper.TestCommonStruct{
+				PodGroups: []*schedulingv1.PodGroup{
+					util.BuildPodGroupWithNetWorkTopologies("pg1", "c1", "s3", "q1", 2, nil, schedulingv1.PodGroupRunning, "hard", 3),
+				},
+				Pods: []*corev1.Pod{
+					util.BuildPod("c1", "p1", "s3-n1", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+					util.BuildPod("c1", "p2", "", corev1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+				},
+			},
+			arguments: framework.Arguments{NCCLEnable: true, NCCLBandwidthFading: 0.25},
+			expected: map[string]float64{
+				"s3-n1": 100,
+				"s3-n2": 25,
+				"s4-n1": 6.25,
+				"s6-n2": 1.5625,
+			},
+		},
+		{
+			name: "members across hyperNodes of tier 2, the nodes within the ring score the same",
+			TestCommonStruct: uthelper.TestCommonStruct{
+				PodGroups: []*schedulingv1.PodGroup{
+					util.BuildPodGroupWithNetWorkTopologies("pg1", "c1", "s1", "q1", 3, nil, schedulingv1.PodGroupRunning, "hard", 3),
+				},
+				Pods: []*corev1.Pod{
+					util.BuildPod("c1", "p1", "s3-n1", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+					util.BuildPod("c1", "p2", "s4-n1", corev1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+					util.BuildPod("c1", "p3", "", corev1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", worker, nil),
+				},
+			},
+			arguments: framework.Arguments{NCCLEnable: true},
+			expected: map[string]float64{
+				"s3-n2": 25 + 33.3,
+				"s4-n2": 25 + 33.3,
+				"s5-n1": 12.5,
+			},
+		},
+	}
+
+	trueValue := true
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.Plugins = map[string]framework.PluginBuilder{PluginName: New}
+			buildNCCLTopology(&test.TestCommonStruct)
+			test.Queues = []*schedulingv1.Queue{util.BuildQueue("q1", 1, nil)}
+			tiers := []conf.Tier{{
+				Plugins: []conf.PluginOption{{
+					Name:             PluginName,
+					EnabledNodeOrder: &trueValue,
+					Arguments:        test.arguments,
+				}},
+			}}
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+
+			nodeScores, err := ssn.BatchNodeOrderFn(parseTask(ssn.Jobs), ssn.NodeList)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for node, expected := range test.expected {
+				if math.Abs(nodeScores[node]-expected) > eps {
+					t.Errorf("expected score %v on node %s, got %v", expected, node, nodeScores[node])
+				}
+			}
+		})
+	}
+}
@@ -70,6 +70,7 @@ type networkTopologyAwarePlugin struct {
 	pluginArguments framework.Arguments
 	weight          *priorityWeight
 	*normalPodConfig
+	*ncclConfig
 	*hyperNodesTier
 	maxHyperNodesForEviction int
 	// hyperNodeResourceCache stores the resource status of hypernodes to avoid repeated calculation: hypernode -> resourceStatus
@@ -143,6 +144,8 @@ func (nta *networkTopologyAwarePlugin) initHyperNodeResourceCache(ssn *framework
          hypernode.binpack.resources.example.com/foo: 3
          hypernode.binpack.normal-pod.enable: true
          hypernode.binpack.normal-pod.fading: 0.8
+         nccl.enable: true
+         nccl.bandwidth.fading: 0.5
 */
 
 // New function returns prioritizePlugin object
@@ -151,6 +154,7 @@ func New(arguments framework.Arguments) framework.Plugin {
 		pluginArguments:          arguments,
 		weight:                   getPriorityWeight(arguments),
 		normalPodConfig:          getNormalPodConfig(arguments),
+		ncclConfig:               getNCCLConfig(arguments),
 		hyperNodesTier:           &hyperNodesTier{},
 		maxHyperNodesForEviction: getMaxHyperNodesForEviction(arguments),
 		hyperNodeResourceCache:   make(map[string]*resourceStatus),
@@ -248,7 +252,7 @@ func (w *priorityWeight) getBinPackWeight(name corev1.ResourceName) (int, bool)
 }
 
 func (nta *networkTopologyAwarePlugin) String() string {
-	length := 5
+	length := 7
 	if extendLength := len(nta.weight.HyperNodeBinPackingResources); extendLength == 0 {
 		length++
 	} else {
@@ -269,7 +273,9 @@ func (nta *networkTopologyAwarePlugin) String() string {
 		}
 	}
 	msg = append(msg, fmt.Sprintf("%s[%t]", HyperNodeBinPackNormalPodEnable, nta.normalPodConfig.hyperNodeBinPackingEnable),
-		fmt.Sprintf("%s[%g]", HyperNodeBinPackNormalPodFading, nta.normalPodConfig.hyperNodeBinPackingFading))
+		fmt.Sprintf("%s[%g]", HyperNodeBinPackNormalPodFading, nta.normalPodConfig.hyperNodeBinPackingFading),
+		fmt.Sprintf("%s[%t]", NCCLEnable, nta.ncclConfig.enable),
+		fmt.Sprintf("%s[%g]", NCCLBandwidthFading, nta.ncclConfig.bandwidthFading))
 
 	return strings.Join(msg, ", ")
 }
@@ -559,16 +565,31 @@ func (nta *networkTopologyAwarePlugin) getPodHyperNodeBinPackingScore(task *api.
 func (nta *networkTopologyAwarePlugin) batchNodeOrderFnForNetworkAwarePods(ssn *framework.Session, task *api.TaskInfo, subJob *api.SubJobInfo, nodes []*api.NodeInfo) (map[string]float64, error) {
 	nodeScores := make(map[string]float64)
 
-	allocatedHyperNode := task.JobAllocatedHyperNode
-	if allocatedHyperNode == "" {
-		return nodeScores, nil
+	var scoreFn func(node *api.NodeInfo) float64
+	if nta.ncclConfig.enable {
+		// Calculate score based on the estimated NCCL ring bandwidth with the placed members of the subjob.
+		if ring := newNCCLRing(ssn, subJob); ring != nil {
+			scoreFn = func(node *api.NodeInfo) float64 {
+				return nta.ncclScore(ssn, ring, node.Name)
+			}
+		}
 	}
-	// Calculate score based on LCAHyperNode tier.
+	if scoreFn == nil {
+		allocatedHyperNode := task.JobAllocatedHyperNode
+		if allocatedHyperNode == "" {
+			return nodeScores, nil
+		}
+		// Calculate score based on LCAHyperNode tier.
+		scoreFn = func(node *api.NodeInfo) float64 {
+			hyperNode := util.FindHyperNodeForNode(node.Name, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
+			return nta.networkTopologyAwareScore(hyperNode, allocatedHyperNode, ssn.HyperNodes)
+		}
+	}
+
 	var maxScore float64 = -1
 	scoreToNodes := map[float64][]string{}
 	for _, node := range nodes {
-		hyperNode := util.FindHyperNodeForNode(node.Name, ssn.RealNodesList, ssn.HyperNodesTiers, ssn.HyperNodesSetByTier)
-		score := nta.networkTopologyAwareScore(hyperNode, allocatedHyperNode, ssn.HyperNodes)
+		score := scoreFn(node)
 		nodeScores[node.Name] = score
 		if score >= maxScore {
 			maxScore = score
@@ -62,6 +62,10 @@ func TestNew(t *testing.T) {
 					hyperNodeBinPackingEnable: DefaultNormalPodEnable,
 					hyperNodeBinPackingFading: DefaultNormalPodFading,
 				},
+				ncclConfig: &ncclConfig{
+					enable:          DefaultNCCLEnable,
+					bandwidthFading: DefaultNCCLBandwidthFading,
+				},
 				hyperNodesTier:         &hyperNodesTier{},
 				hyperNodeResourceCache: make(map[string]*resourceStatus),
 			},
@@ -77,6 +81,8 @@ func TestNew(t *testing.T) {
 				"hypernode.binpack.resources.example.com/foo":   6,
 				"hypernode.binpack.normal-pod.enable":           false,
 				"hypernode.binpack.normal-pod.fading":           0,
+				"nccl.enable":                                   true,
+				"nccl.bandwidth.fading":                         0.25,
 			},
 			expectedPlugin: &networkTopologyAwarePlugin{
 				weight: &priorityWeight{
@@ -92,6 +98,10 @@ func TestNew(t *testing.T) {
 					hyperNodeBinPackingEnable: false,
 					hyperNodeBinPackingFading: 0,
 				},
+				ncclConfig: &ncclConfig{
+					enable:          true,
+					bandwidthFading: 0.25,
+				},
 				hyperNodesTier:         &hyperNodesTier{},
 				hyperNodeResourceCache: make(map[string]*resourceStatus),
 			},
@@ -106,6 +116,7 @@ func TestNew(t *testing.T) {
 				"hypernode.binpack.resources.example.com/foo": -1,
 				"hypernode.binpack.normal-pod.enable":         "a",
 				"hypernode.binpack.normal-pod.fading":         -1,
+				"nccl.bandwidth.fading":                       2,
 			},
 			expectedPlugin: &networkTopologyAwarePlugin{
 				weight: &priorityWeight{
@@ -121,6 +132,10 @@ func TestNew(t *testing.T) {
 					hyperNodeBinPackingEnable: DefaultNormalPodEnable,
 					hyperNodeBinPackingFading: DefaultNormalPodFading,
 				},
+				ncclConfig: &ncclConfig{
+					enable:          DefaultNCCLEnable,
+					bandwidthFading: DefaultNCCLBandwidthFading,
+				},
 				hyperNodesTier:         &hyperNodesTier{},
 				hyperNodeResourceCache: make(map[string]*resourceStatus),
 			},
@@ -145,6 +160,7 @@ func TestNew(t *testing.T) {
 			}
 			assert.Equal(t, tt.expectedPlugin.weight, nta.weight)
 			assert.Equal(t, tt.expectedPlugin.normalPodConfig, nta.normalPodConfig)
+			assert.Equal(t, tt.expectedPlugin.ncclConfig, nta.ncclConfig)
 			assert.Equal(t, tt.expectedPlugin.hyperNodesTier, nta.hyperNodesTier)
 			assert.Equal(t, tt.expectedPlugin.hyperNodeResourceCache, nta.hyperNodeResourceCache)
 		})
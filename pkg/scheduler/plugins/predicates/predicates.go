@@ -46,6 +46,7 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/volumebinding"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/volumezone"
 
+	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -82,6 +83,10 @@ const (
 	// VolumeBindingEnable is the key for enabling Volume Binding Predicates in scheduler configmap
 	VolumeBindingEnable = "predicate.VolumeBindingEnable"
 
+	// VolumeCapacityEnable is the key for enabling the accounting of the CSI storage capacity reserved in the session
+	// by the WaitForFirstConsumer claims of the allocated tasks in scheduler configmap
+	VolumeCapacityEnable = "predicate.VolumeCapacityEnable"
+
 	// DynamicResourceAllocationEnable is the key for enabling Dynamic Resource Allocation Predicates in scheduler configmap
 	DynamicResourceAllocationEnable = "predicate.DynamicResourceAllocationEnable"
 
@@ -117,6 +122,8 @@ type PredicatesPlugin struct {
 	ScoreOrder          []string
 	PredicateCache      *predicateCache
 	Handle              fwk.Handle
+
+	volumeCapacity *volumeCapacity
 }
 
 // New return predicate plugin
@@ -131,6 +138,7 @@ func New(arguments framework.Arguments) framework.Plugin {
 		podTopologySpreadEnable:         true,
 		cacheEnable:                     false,
 		volumeBindingEnable:             true,
+		volumeCapacityEnable:            true,
 		dynamicResourceAllocationEnable: utilFeature.DefaultFeatureGate.Enabled(features.DynamicResourceAllocation),
 	}
 
@@ -144,6 +152,7 @@ func New(arguments framework.Arguments) framework.Plugin {
 	arguments.GetBool(&predicate.volumeZoneEnable, VolumeZoneEnable)
 	arguments.GetBool(&predicate.podTopologySpreadEnable, PodTopologySpreadEnable)
 	arguments.GetBool(&predicate.volumeBindingEnable, VolumeBindingEnable)
+	arguments.GetBool(&predicate.volumeCapacityEnable, VolumeCapacityEnable)
 	arguments.GetBool(&predicate.cacheEnable, CachePredicate)
 
 	features := feature.Features{
@@ -180,6 +189,7 @@ type predicateEnable struct {
 	podTopologySpreadEnable         bool
 	cacheEnable                     bool
 	volumeBindingEnable             bool
+	volumeCapacityEnable            bool
 	dynamicResourceAllocationEnable bool
 }
 
@@ -207,6 +217,13 @@ func (pp *PredicatesPlugin) OnSessionOpen(ssn *framework.Session) {
 		pp.PredicateCache = predicateCacheNew()
 	}
 
+	// The CSIStorageCapacity objects are only watched with the CSI storage enabled
+	pp.volumeCapacity = nil
+	if pp.enabledPredicates.volumeBindingEnable && pp.enabledPredicates.volumeCapacityEnable &&
+		options.ServerOpts != nil && options.ServerOpts.EnableCSIStorage {
+		pp.volumeCapacity = newVolumeCapacity(ssn.InformerFactory())
+	}
+
 	// Register event handlers to update task info in PodLister & nodeMap
 	ssn.AddEventHandler(&framework.EventHandler{
 		AllocateFunc: func(event *framework.Event) {
@@ -228,6 +245,14 @@ func (pp *PredicatesPlugin) OnSessionOpen(ssn *framework.Session) {
 			if event.Err != nil {
 				return
 			}
+			if pp.volumeCapacity != nil {
+				if err := pp.volumeCapacity.reserve(event.Task, nodeInfo.Node); err != nil {
+					klog.Errorf("Failed to reserve storage capacity for task %s/%s: %v", event.Task.Namespace, event.Task.Name, err)
+					pp.runUnReservePlugins(ssn, event)
+					event.Err = err
+					return
+				}
+			}
 			//predicate gpu sharing
 			for _, val := range api.RegisteredDevices {
 				if devices, ok := nodeInfo.Others[val].(api.Devices); ok {
@@ -273,6 +298,9 @@ func (pp *PredicatesPlugin) OnSessionOpen(ssn *framework.Session) {
 
 			// run unReserve plugins
 			pp.runUnReservePlugins(ssn, event)
+			if pp.volumeCapacity != nil {
+				pp.volumeCapacity.release(event.Task)
+			}
 
 			for _, val := range api.RegisteredDevices {
 				if devices, ok := nodeInfo.Others[val].(api.Devices); ok {
@@ -739,6 +767,10 @@ func (pp *PredicatesPlugin) Predicate(task *api.TaskInfo, node *api.NodeInfo, st
 		status := plugin.Filter(context.TODO(), state, task.Pod, nodeInfo)
 		filterStatus := api.ConvertPredicateStatus(status)
 		if filterStatus.Code != api.Success {
+			// the statuses returned by the filter plugins do not carry their name, e.g. reclaim checks the DRA failures by plugin
+			if filterStatus.Plugin == "" {
+				filterStatus.Plugin = name
+			}
 			predicateStatus = append(predicateStatus, filterStatus)
 			if util.ShouldAbort(filterStatus) {
 				return api.NewFitErrWithStatus(task, node, predicateStatus...)
@@ -746,6 +778,18 @@ func (pp *PredicatesPlugin) Predicate(task *api.TaskInfo, node *api.NodeInfo, st
 		}
 	}
 
+	// Check the storage capacity left in the session for the delayed volumes of the task
+	if pp.volumeCapacity != nil {
+		if _, err := pp.volumeCapacity.fit(task, node.Node); err != nil {
+			klog.V(4).Infof("VolumeCapacity predicates Task <%s/%s> on Node <%s> failed: %v", task.Namespace, task.Name, node.Name, err)
+			predicateStatus = append(predicateStatus, &api.Status{
+				Code:   api.Unschedulable,
+				Reason: err.Error(),
+				Plugin: pp.Name(),
+			})
+		}
+	}
+
 	if len(predicateStatus) > 0 {
 		return api.NewFitErrWithStatus(task, node, predicateStatus...)
 	}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/component-helpers/storage/ephemeral"
+	storagehelpers "k8s.io/component-helpers/storage/volume"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// delayedClaim is a claim of a task whose volume is provisioned once the task is bound, in the topology of its node.
+type delayedClaim struct {
+	name      string
+	className string
+	size      int64
+}
+
+// volumeCapacity accounts the storage capacity, published by the CSI drivers through the CSIStorageCapacity objects,
+// reserved by the delayed claims of the tasks allocated in the session. The VolumeBinding plugin checks each claim
+// against the whole capacity, so that the members of a gang could all be allocated to a topology only able to
+// provision the volumes of a part of them, leaving the gang half bound.
+type volumeCapacity struct {
+	pvcLister      corelisters.PersistentVolumeClaimLister
+	scLister       storagelisters.StorageClassLister
+	driverLister   storagelisters.CSIDriverLister
+	capacityLister storagelisters.CSIStorageCapacityLister
+
+	// reserved is the storage reserved in the session by capacity object, <namespace>/<name>.
+	reserved map[string]int64
+	// reservations is the storage reserved by capacity object of each task, released on deallocation.
+	reservations map[api.TaskID]map[string]int64
+}
+
+func newVolumeCapacity(informerFactory informers.SharedInformerFactory) *volumeCapacity {
+	return &volumeCapacity{
+		pvcLister:      informerFactory.Core().V1().PersistentVolumeClaims().Lister(),
+		scLister:       informerFactory.Storage().V1().StorageClasses().Lister(),
+		driverLister:   informerFactory.Storage().V1().CSIDrivers().Lister(),
+		capacityLister: informerFactory.Storage().V1().CSIStorageCapacities().Lister(),
+		reserved:       map[string]int64{},
+		reservations:   map[api.TaskID]map[string]int64{},
+	}
+}
+
+// delayedClaims returns the unbound claims of the pod in a WaitForFirstConsumer storage class whose CSI driver
+// publishes its storage capacity.
+func (vc *volumeCapacity) delayedClaims(pod *v1.Pod) []delayedClaim {
+	var claims []delayedClaim
+	for _, volume := range pod.Spec.Volumes {
+		var pvc *v1.PersistentVolumeClaim
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claim, err := vc.pvcLister.PersistentVolumeClaims(pod.Namespace).Get(volume.PersistentVolumeClaim.ClaimName)
+			if err != nil {
+				// The VolumeBinding plugin reports the missing claims.
+				continue
+			}
+			pvc = claim
+		case volume.Ephemeral != nil && volume.Ephemeral.VolumeClaimTemplate != nil:
+			name := ephemeral.VolumeClaimName(pod, &volume)
+			claim, err := vc.pvcLister.PersistentVolumeClaims(pod.Namespace).Get(name)
+			if err != nil {
+				// The claim is created from its template by the ephemeral volume controller.
+				claim = &v1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: name},
+					Spec:       volume.Ephemeral.VolumeClaimTemplate.Spec,
+				}
+			}
+			pvc = claim
+		default:
+			continue
+		}
+
+		if pvc.Spec.VolumeName != "" {
+			continue
+		}
+		if _, found := pvc.Annotations[storagehelpers.AnnSelectedNode]; found {
+			// The volume is being provisioned, its capacity is taken out of the CSIStorageCapacity objects soon.
+			continue
+		}
+		className := storagehelpers.GetPersistentVolumeClaimClass(pvc)
+		if className == "" {
+			continue
+		}
+		class, err := vc.scLister.Get(className)
+		if err != nil || class.VolumeBindingMode == nil || *class.VolumeBindingMode != storagev1.VolumeBindingWaitForFirstConsumer {
+			continue
+		}
+		driver, err := vc.driverLister.Get(class.Provisioner)
+		if err != nil || driver.Spec.StorageCapacity == nil || !*driver.Spec.StorageCapacity {
+			continue
+		}
+		size := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+		if size.IsZero() {
+			continue
+		}
+		claims = append(claims, delayedClaim{name: pvc.Name, className: className, size: size.Value()})
+	}
+	return claims
+}
+
+// fit returns the storage to reserve by capacity object to provision the delayed claims of the task on the node, on
+// top of the storage already reserved in the session, or an error if a claim does not fit.
+func (vc *volumeCapacity) fit(task *api.TaskInfo, node *v1.Node) (map[string]int64, error) {
+	claims := vc.delayedClaims(task.Pod)
+	if len(claims) == 0 {
+		return nil, nil
+	}
+	capacities, err := vc.capacityLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	taken := map[string]int64{}
+	for _, claim := range claims {
+		found := false
+		for _, capacity := range capacities {
+			if capacity.StorageClassName != claim.className || capacity.Capacity == nil || capacity.NodeTopology == nil {
+				continue
+			}
+			if capacity.MaximumVolumeSize != nil && capacity.MaximumVolumeSize.Value() < claim.size {
+				continue
+			}
+			selector, err := metav1.LabelSelectorAsSelector(capacity.NodeTopology)
+			if err != nil || !selector.Matches(labels.Set(node.Labels)) {
+				continue
+			}
+			key := capacity.Namespace + "/" + capacity.Name
+			if capacity.Capacity.Value()-vc.reserved[key]-taken[key] < claim.size {
+				continue
+			}
+			taken[key] += claim.size
+			found = true
+			break
+		}
+		if !found {
+			return nil, fmt.Errorf("not enough capacity of storage class %s left in the session for claim %s/%s on node %s",
+				claim.className, task.Namespace, claim.name, node.Name)
+		}
+	}
+	return taken, nil
+}
+
+// reserve reserves the storage of the delayed claims of the task allocated to the node.
+func (vc *volumeCapacity) reserve(task *api.TaskInfo, node *v1.Node) error {
+	taken, err := vc.fit(task, node)
+	if err != nil {
+		return err
+	}
+	if len(taken) == 0 {
+		return nil
+	}
+	for key, size := range taken {
+		vc.reserved[key] += size
+	}
+	vc.reservations[task.UID] = taken
+	klog.V(4).Infof("Reserved storage capacity %v for task %s/%s on node %s", taken, task.Namespace, task.Name, node.Name)
+	return nil
+}
+
+// release releases the storage reserved for the task deallocated.
+func (vc *volumeCapacity) release(task *api.TaskInfo) {
+	taken, found := vc.reservations[task.UID]
+	if !found {
+		return
+	}
+	for key, size := range taken {
+		vc.reserved[key] -= size
+	}
+	delete(vc.reservations, task.UID)
+	klog.V(4).Infof("Released storage capacity %v of task %s/%s", taken, task.Namespace, task.Name)
+}
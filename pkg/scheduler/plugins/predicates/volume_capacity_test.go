@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const zoneLabel = "topology.kubernetes.io/zone"
+
+func buildCapacity(name, className, zone, capacity string) *storagev1.CSIStorageCapacity {
+	quantity := resource.MustParse(capacity)
+	return &storagev1.CSIStorageCapacity{
+		ObjectMeta:       metav1.ObjectMeta{Namespace: "kube-system", Name: name},
+		StorageClassName: className,
+		NodeTopology:     &metav1.LabelSelector{MatchLabels: map[string]string{zoneLabel: zone}},
+		Capacity:         &quantity,
+	}
+}
+
+func buildClaimTask(name string) (*api.TaskInfo, *v1.PersistentVolumeClaim) {
+	pvc := util.BuildPVC("c1", "data-"+name, v1.ResourceList{v1.ResourceStorage: resource.MustParse("6Gi")}, "local")
+	pod := util.BuildPodWithPVC("c1", name, "", v1.PodPending, api.BuildResourceList("1", "1Gi"), pvc, "pg1", nil, nil)
+	return api.NewTaskInfo(pod), pvc
+}
+
+func newTestVolumeCapacity(t *testing.T, objects ...runtime.Object) *volumeCapacity {
+	storageCapacity := true
+	objects = append(objects,
+		util.BuildStorageClass("local", "local.csi.io", storagev1.VolumeBindingWaitForFirstConsumer),
+		util.BuildStorageClass("immediate", "local.csi.io", storagev1.VolumeBindingImmediate),
+		&storagev1.CSIDriver{
+			ObjectMeta: metav1.ObjectMeta{Name: "local.csi.io"},
+			Spec:       storagev1.CSIDriverSpec{StorageCapacity: &storageCapacity},
+		},
+	)
+	informerFactory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(objects...), 0)
+	vc := newVolumeCapacity(informerFactory)
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+	informerFactory.Start(stop)
+	informerFactory.WaitForCacheSync(stop)
+	return vc
+}
+
+func TestVolumeCapacityDelayedClaims(t *testing.T) {
+	bound := util.BuildPVC("c1", "bound", v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")}, "local")
+	bound.Spec.VolumeName = "pv1"
+	immediate := util.BuildPVC("c1", "immediate", v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")}, "immediate")
+	delayed := util.BuildPVC("c1", "delayed", v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")}, "local")
+	vc := newTestVolumeCapacity(t, bound, immediate, delayed)
+
+	pod := util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	for _, claim := range []string{"bound", "immediate", "delayed", "missing"} {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name:         claim,
+			VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim}},
+		})
+	}
+	className := "local"
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: "scratch",
+		VolumeSource: v1.VolumeSource{Ephemeral: &v1.EphemeralVolumeSource{
+			VolumeClaimTemplate: &v1.PersistentVolumeClaimTemplate{Spec: v1.PersistentVolumeClaimSpec{
+				StorageClassName: &className,
+				Resources:        v1.VolumeResourceRequirements{Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("2Gi")}},
+			}},
+		}},
+	})
+
+	expected := []delayedClaim{
+		{name: "delayed", className: "local", size: 1 << 30},
+		{name: "p1-scratch", className: "local", size: 2 << 30},
+	}
+	assert.Equal(t, expected, vc.delayedClaims(pod))
+}
+
+func TestVolumeCapacityReservation(t *testing.T) {
+	p1, pvc1 := buildClaimTask("p1")
+	p2, pvc2 := buildClaimTask("p2")
+	vc := newTestVolumeCapacity(t, pvc1, pvc2,
+		buildCapacity("zone-a", "local", "a", "10Gi"),
+		buildCapacity("zone-b", "local", "b", "20Gi"),
+	)
+	na := util.BuildNode("na", api.BuildResourceList("4", "8Gi"), map[string]string{zoneLabel: "a"})
+	nb := util.BuildNode("nb", api.BuildResourceList("4", "8Gi"), map[string]string{zoneLabel: "b"})
+	nc := util.BuildNode("nc", api.BuildResourceList("4", "8Gi"), map[string]string{zoneLabel: "c"})
+
+	_, err := vc.fit(p1, nc)
+	assert.Error(t, err, "no capacity is published in zone c")
+
+	assert.NoError(t, vc.reserve(p1, na))
+	assert.Equal(t, map[string]int64{"kube-system/zone-a": 6 << 30}, vc.reserved)
+
+	// The second member of the gang does not fit in the capacity left in zone a
+	_, err = vc.fit(p2, na)
+	assert.Error(t, err)
+	taken, err := vc.fit(p2, nb)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"kube-system/zone-b": 6 << 30}, taken)
+
+	vc.release(p1)
+	assert.Equal(t, map[string]int64{"kube-system/zone-a": 0}, vc.reserved)
+	_, err = vc.fit(p2, na)
+	assert.NoError(t, err)
+}
@@ -89,14 +89,14 @@ func (pp *proportionPlugin) Name() string {
 
 func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	// Prepare scheduling data for this session.
-	pp.totalResource.Add(ssn.TotalResource)
+	pp.totalResource.Add(ssn.TotalResource.Normalized())
 
 	klog.V(4).Infof("The total resource is <%v>", pp.totalResource)
 	for _, queue := range ssn.Queues {
 		if len(queue.Queue.Spec.Guarantee.Resource) == 0 {
 			continue
 		}
-		guarantee := api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+		guarantee := api.NewResource(queue.Queue.Spec.Guarantee.Resource).Normalized()
 		pp.totalGuarantee.Add(guarantee)
 	}
 	klog.V(4).Infof("The total guarantee resource is <%v>", pp.totalGuarantee)
@@ -118,7 +118,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 				guarantee: api.EmptyResource(),
 			}
 			if len(queue.Queue.Spec.Capability) != 0 {
-				attr.capability = api.NewResource(queue.Queue.Spec.Capability)
+				attr.capability = api.NewResource(queue.Queue.Spec.Capability).Normalized()
 				if attr.capability.MilliCPU <= 0 {
 					attr.capability.MilliCPU = math.MaxFloat64
 				}
@@ -127,7 +127,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 				}
 			}
 			if len(queue.Queue.Spec.Guarantee.Resource) != 0 {
-				attr.guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+				attr.guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource).Normalized()
 			}
 			realCapability := api.ExceededPart(pp.totalResource, pp.totalGuarantee).Add(attr.guarantee)
 			if attr.capability == nil {
@@ -137,6 +137,10 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 				realCapability.MinDimensionResource(attr.capability, api.Infinity)
 				attr.realCapability = realCapability
 			}
+			// The queue bound to a node pool only counts the resources of the pool.
+			if pool := queue.PoolResource(ssn.Nodes); pool != nil {
+				attr.realCapability.MinDimensionResource(pool, api.Zero)
+			}
 			pp.queueOpts[job.Queue] = attr
 			klog.V(4).Infof("Added Queue <%s> attributes.", job.Queue)
 		}
@@ -145,12 +149,12 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		for status, tasks := range job.TaskStatusIndex {
 			if api.AllocatedStatus(status) {
 				for _, t := range tasks {
-					attr.allocated.Add(t.Resreq)
-					attr.request.Add(t.Resreq)
+					attr.allocated.Add(t.Resreq.Normalized())
+					attr.request.Add(t.Resreq.Normalized())
 				}
 			} else if status == api.Pending {
 				for _, t := range tasks {
-					attr.request.Add(t.Resreq)
+					attr.request.Add(t.Resreq.Normalized())
 				}
 			}
 		}
@@ -163,7 +167,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		if job.PodGroup.Status.Phase == scheduling.PodGroupInqueue {
 			if job.PodGroup.Spec.MinResources != nil {
 				inqueued := util.GetInqueueResource(job, job.Allocated)
-				attr.inqueue.Add(job.DeductSchGatedResources(inqueued))
+				attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
 			}
 		}
 
@@ -175,9 +179,9 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			int32(util.CalculateAllocatedTaskNum(job)) >= job.PodGroup.Spec.MinMember {
 			inqueued := util.GetInqueueResource(job, job.Allocated)
 			// deduct scheduling gated tasks from inqueue resources
-			attr.inqueue.Add(job.DeductSchGatedResources(inqueued))
+			attr.inqueue.Add(job.DeductSchGatedResources(inqueued).Normalized())
 		}
-		attr.elastic.Add(job.GetElasticResources())
+		attr.elastic.Add(job.GetElasticResources().Normalized())
 		klog.V(5).Infof("Queue %s allocated <%s> request <%s> inqueue <%s> elastic <%s>",
 			attr.name, attr.allocated.String(), attr.request.String(), attr.inqueue.String(), attr.elastic.String())
 	}
@@ -265,25 +269,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 	}
 
-	ssn.AddQueueOrderFn(pp.Name(), func(l, r interface{}) int {
-		lv := l.(*api.QueueInfo)
-		rv := r.(*api.QueueInfo)
-
-		if lv.Queue.Spec.Priority != rv.Queue.Spec.Priority {
-			// return negative means high priority
-			return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
-		}
-
-		if pp.queueOpts[lv.UID].share == pp.queueOpts[rv.UID].share {
-			return 0
-		}
-
-		if pp.queueOpts[lv.UID].share < pp.queueOpts[rv.UID].share {
-			return -1
-		}
-
-		return 1
-	})
+	ssn.AddQuotaProvider(pp.Name(), pp)
 
 	ssn.AddReclaimableFn(pp.Name(), func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
 		var victims []*api.TaskInfo
@@ -309,57 +295,22 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 			allocated := allocations[job.Queue]
 
-			if !allocated.LessEqual(attr.deserved, api.Zero) {
-				allocated.Sub(reclaimee.Resreq)
-				victims = append(victims, reclaimee)
+			if allocated.LessEqual(attr.deserved, api.Zero) {
+				continue
+			}
+			// The guarantee of the queue is never reclaimed.
+			if exceptReclaimee := allocated.Clone().Sub(reclaimee.Resreq.Normalized()); !attr.guarantee.LessEqual(exceptReclaimee, api.Zero) {
+				klog.V(5).Infof("[proportion] Skip reclaimee <%s/%s>: queue <%s> would fall below its guarantee <%v>.",
+					reclaimee.Namespace, reclaimee.Name, attr.name, attr.guarantee)
+				continue
 			}
+			allocated.Sub(reclaimee.Resreq.Normalized())
+			victims = append(victims, reclaimee)
 		}
 		klog.V(4).Infof("Victims from proportion plugins are %+v", victims)
 		return victims, util.Permit
 	})
 
-	ssn.AddOverusedFn(pp.Name(), func(obj interface{}) bool {
-		queue := obj.(*api.QueueInfo)
-		attr := pp.queueOpts[queue.UID]
-
-		overused := attr.deserved.LessEqual(attr.allocated, api.Zero)
-		metrics.UpdateQueueOverused(attr.name, overused)
-		if overused {
-			klog.V(3).Infof("Queue <%v> is overused: deserved <%v>, allocated <%v>, share <%v>",
-				queue.Name, attr.deserved, attr.allocated, attr.share)
-		}
-
-		return overused
-	})
-
-	queueAllocatable := func(queue *api.QueueInfo, candidates []*api.TaskInfo) bool {
-		if queue.Queue.Status.State != scheduling.QueueStateOpen {
-			klog.V(3).Infof("Queue <%s> current state: %s, is not in open state, can not allocate tasks.", queue.Name, queue.Queue.Status.State)
-			return false
-		}
-
-		attr := pp.queueOpts[queue.UID]
-		totalReq := api.EmptyResource()
-		for _, task := range candidates {
-			if task != nil {
-				totalReq.Add(task.Resreq)
-			}
-		}
-
-		futureUsed := attr.allocated.Clone().Add(totalReq)
-		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.deserved, totalReq)
-		if !allocatable {
-			klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>; Candidates total request <%v>",
-				queue.Name, attr.deserved, attr.allocated, totalReq)
-		}
-
-		return allocatable
-	}
-
-	ssn.AddAllocatableFn(pp.Name(), func(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
-		return queueAllocatable(queue, []*api.TaskInfo{candidate})
-	})
-
 	ssn.AddSimulateAllocatableFn(pp.Name(), func(ctx context.Context, cycleState fwk.CycleState, queue *api.QueueInfo, candidate *api.TaskInfo) bool {
 		state, err := getProportionState(cycleState)
 		if err != nil {
@@ -372,8 +323,8 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			return false
 		}
 
-		futureUsed := attr.allocated.Clone().Add(candidate.Resreq)
-		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.deserved, candidate.Resreq)
+		futureUsed := attr.allocated.Clone().Add(candidate.Resreq.Normalized())
+		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.deserved, candidate.Resreq.Normalized())
 		if !allocatable {
 			klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>; Candidate <%v>: resource request <%v>",
 				queue.Name, attr.deserved, attr.allocated, candidate.Name, candidate.Resreq)
@@ -382,9 +333,12 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		return allocatable
 	})
 
-	ssn.AddPreemptiveFn(pp.Name(), func(obj interface{}, candidates []*api.TaskInfo) bool {
-		queue := obj.(*api.QueueInfo)
-		return queueAllocatable(queue, candidates)
+	ssn.AddPredicateFn(pp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+		job, found := ssn.Jobs[task.Job]
+		if !found {
+			return nil
+		}
+		return util.NodePoolPredicate(task, node, ssn.Queues, job.Queue)
 	})
 
 	ssn.AddPrePredicateFn(pp.Name(), func(task *api.TaskInfo) error {
@@ -422,7 +376,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			klog.V(4).Infof("job %s MinResources is null.", job.Name)
 			return util.Permit
 		}
-		minReq := job.GetMinResources()
+		minReq := job.GetMinResources().Normalized()
 
 		klog.V(5).Infof("job %s min resource <%s>, queue %s capability <%s> allocated <%s> inqueue <%s> elastic <%s>",
 			job.Name, minReq.String(), queue.Name, attr.realCapability.String(), attr.allocated.String(), attr.inqueue.String(), attr.elastic.String())
@@ -449,7 +403,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 		// deduct the resources of scheduling gated tasks in a job when calculating inqueued resources
 		// so that it will not block other jobs from being inqueued.
-		attr.inqueue.Add(job.DeductSchGatedResources(job.GetMinResources()))
+		attr.inqueue.Add(job.DeductSchGatedResources(job.GetMinResources()).Normalized())
 	})
 
 	ssn.AddSimulateAddTaskFn(pp.Name(), func(ctx context.Context, cycleState fwk.CycleState, taskToSchedule *api.TaskInfo, taskToAdd *api.TaskInfo, nodeInfo *api.NodeInfo) error {
@@ -466,7 +420,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		if attr == nil {
 			return fmt.Errorf("[proportion] queue %s not found", job.Queue)
 		}
-		attr.allocated.Add(taskToAdd.Resreq)
+		attr.allocated.Add(taskToAdd.Resreq.Normalized())
 		updateQueueAttrShare(attr)
 		return nil
 	})
@@ -485,7 +439,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		if attr == nil {
 			return fmt.Errorf("[proportion] queue %s not found", job.Queue)
 		}
-		attr.allocated.Sub(taskToRemove.Resreq)
+		attr.allocated.Sub(taskToRemove.Resreq.Normalized())
 		updateQueueAttrShare(attr)
 		return nil
 	})
@@ -505,7 +459,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 					event.Task.Namespace, event.Task.Name, job.Queue)
 				return
 			}
-			attr.allocated.Add(event.Task.Resreq)
+			attr.allocated.Add(event.Task.Resreq.Normalized())
 			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 
 			pp.updateShare(attr)
@@ -526,7 +480,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 					event.Task.Namespace, event.Task.Name, job.Queue)
 				return
 			}
-			attr.allocated.Sub(event.Task.Resreq)
+			attr.allocated.Sub(event.Task.Resreq.Normalized())
 			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 
 			pp.updateShare(attr)
@@ -537,6 +491,88 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	})
 }
 
+// QueueOrder orders the queues by priority, then by share.
+func (pp *proportionPlugin) QueueOrder(lv, rv *api.QueueInfo) int {
+	if lv.Queue.Spec.Priority != rv.Queue.Spec.Priority {
+		// return negative means high priority
+		return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
+	}
+
+	if pp.queueOpts[lv.UID].share == pp.queueOpts[rv.UID].share {
+		return 0
+	}
+
+	if pp.queueOpts[lv.UID].share < pp.queueOpts[rv.UID].share {
+		return -1
+	}
+
+	return 1
+}
+
+// Overused returns whether the queue allocated its deserved resources.
+func (pp *proportionPlugin) Overused(queue *api.QueueInfo) bool {
+	attr := pp.queueOpts[queue.UID]
+
+	overused := attr.deserved.LessEqual(attr.allocated, api.Zero)
+	metrics.UpdateQueueOverused(attr.name, overused)
+	if overused {
+		klog.V(3).Infof("Queue <%v> is overused: deserved <%v>, allocated <%v>, share <%v>",
+			queue.Name, attr.deserved, attr.allocated, attr.share)
+	}
+
+	return overused
+}
+
+// Allocatable returns whether the task fits the deserved resources of the queue.
+func (pp *proportionPlugin) Allocatable(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
+	return pp.queueAllocatable(queue, []*api.TaskInfo{candidate})
+}
+
+// Preemptive returns whether the candidates fit the deserved resources of the queue.
+func (pp *proportionPlugin) Preemptive(queue *api.QueueInfo, candidates []*api.TaskInfo) bool {
+	return pp.queueAllocatable(queue, candidates)
+}
+
+// Resizable returns whether the queue stays within its deserved resources with the pending resize of the task, in the
+// resource dimensions of the resize.
+func (pp *proportionPlugin) Resizable(queue *api.QueueInfo, task *api.TaskInfo) bool {
+	attr := pp.queueOpts[queue.UID]
+	if attr == nil || task.ResizeReq == nil {
+		return true
+	}
+
+	resizable, _ := attr.allocated.LessEqualWithDimensionAndResourcesName(attr.deserved, task.ResizeReq)
+	if !resizable {
+		klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>; Task <%v/%v> resize request <%v>",
+			queue.Name, attr.deserved, attr.allocated, task.Namespace, task.Name, task.ResizeReq)
+	}
+	return resizable
+}
+
+func (pp *proportionPlugin) queueAllocatable(queue *api.QueueInfo, candidates []*api.TaskInfo) bool {
+	if queue.Queue.Status.State != scheduling.QueueStateOpen {
+		klog.V(3).Infof("Queue <%s> current state: %s, is not in open state, can not allocate tasks.", queue.Name, queue.Queue.Status.State)
+		return false
+	}
+
+	attr := pp.queueOpts[queue.UID]
+	totalReq := api.EmptyResource()
+	for _, task := range candidates {
+		if task != nil {
+			totalReq.Add(task.Resreq.Normalized())
+		}
+	}
+
+	futureUsed := attr.allocated.Clone().Add(totalReq)
+	allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.deserved, totalReq)
+	if !allocatable {
+		klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>; Candidates total request <%v>",
+			queue.Name, attr.deserved, attr.allocated, totalReq)
+	}
+
+	return allocatable
+}
+
 func (pp *proportionPlugin) OnSessionClose(ssn *framework.Session) {
 	pp.totalResource = nil
 	pp.totalGuarantee = nil
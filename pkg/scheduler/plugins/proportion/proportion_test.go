@@ -17,6 +17,7 @@ limitations under the License.
 package proportion
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -685,3 +686,76 @@ func TestJobEnqueuedOnOtherPluginsReject(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestReclaimKeepsGuarantee(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{PluginName: New, gang.PluginName: gang.New}
+	trueValue := true
+	actions := []framework.Action{reclaim.New()}
+
+	n1 := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string))
+
+	// Queue q1 runs the whole CPU of the cluster, twice its deserved CPU, but only the memory it is guaranteed: none of
+	// its pods can be reclaimed without taking it below its guarantee.
+	// Only its pod p1 is preemptable.
+	pods := []*apiv1.Pod{}
+	for i := 1; i <= 4; i++ {
+		name := fmt.Sprintf("p%d", i)
+		preemptable := strconv.FormatBool(i == 1)
+		pods = append(pods, util.BuildPod("ns1", name, "n1", apiv1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: preemptable}, make(map[string]string)))
+	}
+	pods = append(pods, util.BuildPod("ns1", "p5", "", apiv1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", make(map[string]string), make(map[string]string)))
+	pg1 := util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning)
+	pg2 := util.BuildPodGroup("pg2", "ns1", "q2", 1, nil, schedulingv1beta1.PodGroupInqueue)
+
+	guaranteed := util.BuildQueue("q1", 1, nil)
+	guaranteed.Spec.Guarantee.Resource = apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("4Gi")}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:           "reclaim down to the deserved resources of the queue without guarantee",
+			Plugins:        plugins,
+			Pods:           pods,
+			Nodes:          []*apiv1.Node{n1},
+			PodGroups:      []*schedulingv1beta1.PodGroup{pg1, pg2},
+			Queues:         []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil), util.BuildQueue("q2", 1, nil)},
+			ExpectEvicted:  []string{"ns1/p1"},
+			ExpectEvictNum: 1,
+		},
+		{
+			Name:           "no reclaim below the guarantee of the queue",
+			Plugins:        plugins,
+			Pods:           pods,
+			Nodes:          []*apiv1.Node{n1},
+			PodGroups:      []*schedulingv1beta1.PodGroup{pg1, pg2},
+			Queues:         []*schedulingv1beta1.Queue{guaranteed, util.BuildQueue("q2", 1, nil)},
+			ExpectEvictNum: 0,
+		},
+	}
+
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledReclaimable: &trueValue,
+				},
+				{
+					Name:               gang.PluginName,
+					EnabledJobStarving: &trueValue,
+				},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rescheduling
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics/source"
+)
+
+const (
+	// LoadAwareStrategy is the name of the strategy moving pods off the nodes which are hot for a sustained time
+	LoadAwareStrategy = "loadAware"
+
+	loadCPU    = "cpu"
+	loadMemory = "memory"
+	loadGPU    = "gpu"
+
+	// metricsActiveTime is the age after which the utilization of a node is considered outdated
+	metricsActiveTime = 5 * time.Minute
+)
+
+// LoadAwareConf is the configuration of the loadAware strategy. The thresholds are utilization percentages of cpu,
+// memory and gpu, as reported by the metrics source of the scheduler.
+type LoadAwareConf struct {
+	// HotThresholds is the utilization above which a node is getting hot.
+	HotThresholds map[string]float64
+	// ColdThresholds is the utilization under which a node can receive the pods moved off the hot nodes.
+	ColdThresholds map[string]float64
+	// Hysteresis is the number of points under the hot thresholds a hot node has to fall to cool down.
+	Hysteresis float64
+	// SustainedRounds is the number of consecutive utilization samples above the hot thresholds making a node hot.
+	SustainedRounds int
+	// GPUResourceName is the resource whose requests are weighed against the gpu utilization.
+	GPUResourceName v1.ResourceName
+}
+
+// NewLoadAwareConf returns the pointer of LoadAwareConf object with default value
+func NewLoadAwareConf() *LoadAwareConf {
+	return &LoadAwareConf{
+		HotThresholds:   map[string]float64{loadCPU: 80, loadMemory: 80, loadGPU: 90},
+		ColdThresholds:  map[string]float64{loadCPU: 50, loadMemory: 50, loadGPU: 50},
+		Hysteresis:      10,
+		SustainedRounds: 3,
+		GPUResourceName: "nvidia.com/gpu",
+	}
+}
+
+// parse converts the config map to struct object
+func (lac *LoadAwareConf) parse(configs map[string]interface{}) {
+	for k, v := range toFloatMap(configs["hotThresholds"]) {
+		lac.HotThresholds[k] = v
+	}
+	for k, v := range toFloatMap(configs["coldThresholds"]) {
+		lac.ColdThresholds[k] = v
+	}
+	if hysteresis, ok := toFloat(configs["hysteresis"]); ok && hysteresis >= 0 {
+		lac.Hysteresis = hysteresis
+	}
+	if rounds, ok := toFloat(configs["sustainedRounds"]); ok && rounds >= 1 {
+		lac.SustainedRounds = int(rounds)
+	}
+	if name, ok := configs["gpuResourceName"].(string); ok && name != "" {
+		lac.GPUResourceName = v1.ResourceName(name)
+	}
+}
+
+// nodeHeat records how long a node has been hot across the rescheduling rounds
+type nodeHeat struct {
+	// sample is the metrics time of the last utilization sample counted
+	sample time.Time
+	// rounds is the number of consecutive samples above the hot thresholds
+	rounds int
+	hot    bool
+}
+
+// nodeHeats keeps the heat of the nodes between the sessions, by node name.
+var nodeHeats = map[string]*nodeHeat{}
+
+// nodeLoad is the utilization of a node, updated with the victims selected on it or moved to it.
+type nodeLoad struct {
+	node  *api.NodeInfo
+	usage map[string]float64
+}
+
+var victimsFnForLoadAware = func(tasks []*api.TaskInfo) []*api.TaskInfo {
+	victims := make([]*api.TaskInfo, 0)
+
+	conf := NewLoadAwareConf()
+	config, ok := RegisteredStrategyConfigs[LoadAwareStrategy].(map[string]interface{})
+	if !ok {
+		klog.Errorln("parameters parse error for loadAware")
+		return victims
+	}
+	conf.parse(config)
+
+	hotNodes, coldNodes := groupNodesByHeat(conf)
+	if len(hotNodes) == 0 {
+		klog.V(4).Infof("No node has been hot for %d rounds", conf.SustainedRounds)
+		return victims
+	}
+	if len(coldNodes) == 0 {
+		klog.V(4).Infof("No cold node can receive the pods of the hot nodes")
+		return victims
+	}
+	return moveOffHotNodes(hotNodes, coldNodes, tasks, conf)
+}
+
+// groupNodesByHeat updates the heat of the nodes with their latest utilization, and returns the hot and the cold
+// ones. The nodes without recent utilization are neither.
+func groupNodesByHeat(conf *LoadAwareConf) ([]*nodeLoad, []*nodeLoad) {
+	hotNodes := make([]*nodeLoad, 0)
+	coldNodes := make([]*nodeLoad, 0)
+	now := time.Now()
+	for name, node := range Session.Nodes {
+		if node.ResourceUsage == nil || now.Sub(node.ResourceUsage.MetricsTime) > metricsActiveTime {
+			delete(nodeHeats, name)
+			continue
+		}
+		load := &nodeLoad{node: node, usage: usageOf(node.ResourceUsage)}
+		if updateHeat(name, load, conf) {
+			hotNodes = append(hotNodes, load)
+		} else if !node.Node.Spec.Unschedulable && !exceeds(load.usage, conf.ColdThresholds, 0) {
+			coldNodes = append(coldNodes, load)
+		}
+	}
+	for name := range nodeHeats {
+		if _, found := Session.Nodes[name]; !found {
+			delete(nodeHeats, name)
+		}
+	}
+	return hotNodes, coldNodes
+}
+
+// usageOf returns the utilization of a node for the metrics period of the plugin, or the period collected by
+// the scheduler cache when the plugin period is not collected.
+func usageOf(usage *api.NodeUsage) map[string]float64 {
+	period := MetricsPeriod
+	if _, found := usage.CPUUsageAvg[period]; !found {
+		period = source.NODE_METRICS_PERIOD
+	}
+	return map[string]float64{
+		loadCPU:    usage.CPUUsageAvg[period],
+		loadMemory: usage.MEMUsageAvg[period],
+		loadGPU:    usage.GPUUsageAvg[period],
+	}
+}
+
+// updateHeat counts a new utilization sample of a node, and returns whether the node is hot. A node gets hot once
+// it has been above the hot thresholds for the sustained rounds, and stays hot until it falls under the hot
+// thresholds by the hysteresis.
+func updateHeat(name string, load *nodeLoad, conf *LoadAwareConf) bool {
+	heat, found := nodeHeats[name]
+	if !found {
+		heat = &nodeHeat{}
+		nodeHeats[name] = heat
+	}
+	sample := load.node.ResourceUsage.MetricsTime
+	if !heat.sample.Equal(sample) {
+		heat.sample = sample
+		if exceeds(load.usage, conf.HotThresholds, 0) {
+			heat.rounds++
+		} else {
+			heat.rounds = 0
+		}
+	}
+	if heat.rounds >= conf.SustainedRounds {
+		heat.hot = true
+	} else if heat.hot && !exceeds(load.usage, conf.HotThresholds, conf.Hysteresis) {
+		heat.hot = false
+	}
+	klog.V(4).Infof("node: %s, usage: %v, hot rounds: %d, hot: %t", name, load.usage, heat.rounds, heat.hot)
+	return heat.hot
+}
+
+// exceeds returns whether the usage of any resource reaches its threshold lowered by the margin
+func exceeds(usage, thresholds map[string]float64, margin float64) bool {
+	for name, threshold := range thresholds {
+		if usage[name] >= threshold-margin {
+			return true
+		}
+	}
+	return false
+}
+
+// moveOffHotNodes selects the victims on the hot nodes, from the hottest node and from the lowest priority and
+// QoS, until the nodes cool down. The pods moved are bounded by the room left on the cold nodes under the hot
+// thresholds lowered by the hysteresis, so that they do not get hot in turn.
+func moveOffHotNodes(hotNodes, coldNodes []*nodeLoad, tasks []*api.TaskInfo, conf *LoadAwareConf) []*api.TaskInfo {
+	room := map[string]float64{}
+	for _, load := range coldNodes {
+		for name, threshold := range conf.HotThresholds {
+			if free := threshold - conf.Hysteresis - load.usage[name]; free > 0 {
+				room[name] += free * allocatable(load.node, name, conf) / 100
+			}
+		}
+	}
+	klog.V(4).Infof("room on the cold nodes: %v", room)
+
+	sort.Slice(hotNodes, func(i, j int) bool {
+		return loadScore(hotNodes[i]) > loadScore(hotNodes[j])
+	})
+
+	tasksByNode := map[string]map[api.TaskID]*api.TaskInfo{}
+	for _, task := range tasks {
+		if tasksByNode[task.NodeName] == nil {
+			tasksByNode[task.NodeName] = map[api.TaskID]*api.TaskInfo{}
+		}
+		tasksByNode[task.NodeName][task.UID] = task
+	}
+
+	victims := make([]*api.TaskInfo, 0)
+	for _, load := range hotNodes {
+		candidates := tasksByNode[load.node.Name]
+		pods := make([]*v1.Pod, 0, len(candidates))
+		for _, task := range candidates {
+			pods = append(pods, task.Pod)
+		}
+		sortPods(pods)
+		for _, pod := range pods {
+			if !exceeds(load.usage, conf.HotThresholds, conf.Hysteresis) {
+				klog.V(4).Infof("node %s cooled down, usage: %v", load.node.Name, load.usage)
+				break
+			}
+			task := candidates[api.TaskID(pod.UID)]
+			if !relieves(task, load, conf) {
+				continue
+			}
+			if !fitsRoom(task, room, conf) {
+				klog.V(4).Infof("no room on the cold nodes for task <%s/%s>", task.Namespace, task.Name)
+				continue
+			}
+			for name := range load.usage {
+				amount := request(task, name, conf)
+				room[name] -= amount
+				if total := allocatable(load.node, name, conf); total > 0 {
+					load.usage[name] -= amount * 100 / total
+				}
+			}
+			victims = append(victims, task)
+		}
+	}
+	klog.V(3).Infof("victims: %v\n", victims)
+	return victims
+}
+
+// relieves returns whether the task requests any resource which is hot on its node
+func relieves(task *api.TaskInfo, load *nodeLoad, conf *LoadAwareConf) bool {
+	for name, threshold := range conf.HotThresholds {
+		if load.usage[name] >= threshold-conf.Hysteresis && request(task, name, conf) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// fitsRoom returns whether the requests of the task fit in the room left on the cold nodes
+func fitsRoom(task *api.TaskInfo, room map[string]float64, conf *LoadAwareConf) bool {
+	for name := range conf.HotThresholds {
+		if amount := request(task, name, conf); amount > 0 && amount > room[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// loadScore returns the score of a node to order the hot nodes, the sum of its utilization
+func loadScore(load *nodeLoad) float64 {
+	return load.usage[loadCPU] + load.usage[loadMemory] + load.usage[loadGPU]
+}
+
+// resourceOf returns the resource whose requests are weighed against the utilization of the given name
+func resourceOf(name string, conf *LoadAwareConf) v1.ResourceName {
+	switch name {
+	case loadCPU:
+		return v1.ResourceCPU
+	case loadMemory:
+		return v1.ResourceMemory
+	case loadGPU:
+		return conf.GPUResourceName
+	}
+	return v1.ResourceName(name)
+}
+
+func request(task *api.TaskInfo, name string, conf *LoadAwareConf) float64 {
+	return task.Resreq.Get(resourceOf(name, conf))
+}
+
+func allocatable(node *api.NodeInfo, name string, conf *LoadAwareConf) float64 {
+	return node.Allocatable.Get(resourceOf(name, conf))
+}
+
+// toFloatMap converts a map of the configuration to a map of numbers, ignoring the entries which are not numbers
+func toFloatMap(value interface{}) map[string]float64 {
+	result := map[string]float64{}
+	switch m := value.(type) {
+	case map[string]float64:
+		for k, v := range m {
+			result[k] = v
+		}
+	case map[string]interface{}:
+		for k, v := range m {
+			if f, ok := toFloat(v); ok {
+				result[k] = f
+			}
+		}
+	case map[interface{}]interface{}:
+		for k, v := range m {
+			if f, ok := toFloat(v); ok {
+				result[fmt.Sprint(k)] = f
+			}
+		}
+	}
+	return result
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rescheduling
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics/source"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func buildLoadedNode(name string, cpu, memory float64, sample time.Time) *api.NodeInfo {
+	node := api.NewNodeInfo(util.BuildNode(name, api.BuildResourceList("10", "10Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{}))
+	node.ResourceUsage = &api.NodeUsage{
+		MetricsTime: sample,
+		CPUUsageAvg: map[string]float64{source.NODE_METRICS_PERIOD: cpu},
+		MEMUsageAvg: map[string]float64{source.NODE_METRICS_PERIOD: memory},
+	}
+	return node
+}
+
+func TestUpdateHeat(t *testing.T) {
+	conf := NewLoadAwareConf()
+	conf.SustainedRounds = 2
+	start := time.Now()
+
+	steps := []struct {
+		cpu    float64
+		sample time.Time
+		hot    bool
+	}{
+		{cpu: 90, sample: start},
+		// the same sample is not counted twice
+		{cpu: 90, sample: start},
+		{cpu: 90, sample: start.Add(time.Minute), hot: true},
+		// still hot within the hysteresis
+		{cpu: 75, sample: start.Add(2 * time.Minute), hot: true},
+		{cpu: 65, sample: start.Add(3 * time.Minute)},
+		{cpu: 90, sample: start.Add(4 * time.Minute)},
+	}
+
+	defer delete(nodeHeats, "n1")
+	for i, step := range steps {
+		node := buildLoadedNode("n1", step.cpu, 10, step.sample)
+		load := &nodeLoad{node: node, usage: usageOf(node.ResourceUsage)}
+		if hot := updateHeat("n1", load, conf); hot != step.hot {
+			t.Errorf("step %d: expected hot %t, got %t", i, step.hot, hot)
+		}
+	}
+}
+
+func TestVictimsFnForLoadAware(t *testing.T) {
+	var lowPriority, highPriority int32 = 10, 100
+	now := time.Now()
+	nodes := map[string]*api.NodeInfo{
+		"hot":  buildLoadedNode("hot", 95, 40, now),
+		"cold": buildLoadedNode("cold", 20, 20, now),
+		"warm": buildLoadedNode("warm", 60, 60, now),
+	}
+	tasks := []*api.TaskInfo{
+		api.NewTaskInfo(util.BuildPodWithPriority("test", "low", "hot", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", map[string]string{}, map[string]string{}, &lowPriority)),
+		api.NewTaskInfo(util.BuildPodWithPriority("test", "high", "hot", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", map[string]string{}, map[string]string{}, &highPriority)),
+		api.NewTaskInfo(util.BuildPodWithPriority("test", "mid", "hot", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", map[string]string{}, map[string]string{}, &lowPriority)),
+		api.NewTaskInfo(util.BuildPodWithPriority("test", "warm", "warm", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", map[string]string{}, map[string]string{}, &lowPriority)),
+	}
+
+	Session = &framework.Session{Nodes: nodes}
+	RegisteredStrategyConfigs[LoadAwareStrategy] = map[string]interface{}{
+		"sustainedRounds": 1,
+		"hotThresholds":   map[interface{}]interface{}{"cpu": 80, "memory": 80},
+	}
+	defer func() {
+		Session = nil
+		delete(RegisteredStrategyConfigs, LoadAwareStrategy)
+		for name := range nodeHeats {
+			delete(nodeHeats, name)
+		}
+	}()
+
+	// the hot node falls under 70% cpu after two of its low priority pods are moved to the cold node
+	victims := victimsFnForLoadAware(tasks)
+	names := make([]string, 0, len(victims))
+	for _, victim := range victims {
+		names = append(names, victim.Name)
+	}
+	if len(names) != 2 || names[0] == "high" || names[1] == "high" {
+		t.Errorf("expected the two low priority pods of the hot node as victims, got %v", names)
+	}
+
+	// no pod is moved when no node is cold
+	nodes["cold"].ResourceUsage.CPUUsageAvg[source.NODE_METRICS_PERIOD] = 69
+	nodes["cold"].ResourceUsage.MetricsTime = now.Add(time.Second)
+	nodes["hot"].ResourceUsage.MetricsTime = now.Add(time.Second)
+	if victims := victimsFnForLoadAware(tasks); !reflect.DeepEqual(victims, []*api.TaskInfo{}) {
+		t.Errorf("expected no victims without a cold node, got %v", victims)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rescheduling
+
+import (
+	"sort"
+
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// NodeAffinityStrategy is the name of the strategy moving pods off the nodes which no longer match their required
+// node affinity, as the RemovePodsViolatingNodeAffinity strategy of the descheduler.
+const NodeAffinityStrategy = "removePodsViolatingNodeAffinity"
+
+// NodeAffinityConf is the configuration of the removePodsViolatingNodeAffinity strategy.
+type NodeAffinityConf struct {
+	// NodeFit evicts a pod only when another node matching its node affinity has room for it.
+	NodeFit bool
+}
+
+// NewNodeAffinityConf returns the pointer of NodeAffinityConf object with default value
+func NewNodeAffinityConf() *NodeAffinityConf {
+	return &NodeAffinityConf{NodeFit: true}
+}
+
+// parse converts the config map to struct object
+func (nac *NodeAffinityConf) parse(configs map[string]interface{}) {
+	if nodeFit, ok := configs["nodeFit"].(bool); ok {
+		nac.NodeFit = nodeFit
+	}
+}
+
+var victimsFnForNodeAffinity = func(tasks []*api.TaskInfo) []*api.TaskInfo {
+	victims := make([]*api.TaskInfo, 0)
+
+	conf := NewNodeAffinityConf()
+	if config, ok := RegisteredStrategyConfigs[NodeAffinityStrategy].(map[string]interface{}); ok {
+		conf.parse(config)
+	}
+
+	// the room left on the nodes, updated with the victims moved to them
+	idle := make(map[string]*api.Resource, len(Session.Nodes))
+	names := make([]string, 0, len(Session.Nodes))
+	for name, node := range Session.Nodes {
+		idle[name] = node.Idle.Clone()
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, task := range tasks {
+		node, found := Session.Nodes[task.NodeName]
+		if task.Pod == nil || !found || node.Node == nil {
+			continue
+		}
+		affinity := nodeaffinity.GetRequiredNodeAffinity(task.Pod)
+		if match, err := affinity.Match(node.Node); err != nil || match {
+			continue
+		}
+		if !conf.NodeFit {
+			victims = append(victims, task)
+			continue
+		}
+		for _, name := range names {
+			target := Session.Nodes[name]
+			if name == task.NodeName || target.Node == nil || target.Node.Spec.Unschedulable {
+				continue
+			}
+			if match, err := affinity.Match(target.Node); err != nil || !match {
+				continue
+			}
+			if !task.Resreq.LessEqual(idle[name], api.Zero) {
+				continue
+			}
+			klog.V(4).Infof("Task <%s/%s> violates the node affinity on node %s, and fits node %s",
+				task.Namespace, task.Name, task.NodeName, name)
+			idle[name].Sub(task.Resreq)
+			victims = append(victims, task)
+			break
+		}
+	}
+	return victims
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rescheduling
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestVictimsFnForNodeAffinity(t *testing.T) {
+	buildNode := func(name, pool, cpu string) *api.NodeInfo {
+		return api.NewNodeInfo(util.BuildNode(name, api.BuildResourceList(cpu, "10Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"pool": pool}))
+	}
+	buildTask := func(name, node, pool string) *api.TaskInfo {
+		pod := util.BuildPod("test", name, node, v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg1", map[string]string{}, map[string]string{"pool": pool})
+		return api.NewTaskInfo(pod)
+	}
+	tasks := []*api.TaskInfo{
+		// matches its node
+		buildTask("matching", "gpu-1", "gpu"),
+		// violates the affinity, and fits the cpu node
+		buildTask("moved", "gpu-1", "cpu"),
+		// violates the affinity, but no other node matches it
+		buildTask("stranded", "gpu-1", "spot"),
+		// violates the affinity, but the cpu node is full once the first pod is moved
+		buildTask("blocked", "gpu-1", "cpu"),
+	}
+
+	testCases := []struct {
+		name     string
+		params   map[string]interface{}
+		expected []string
+	}{
+		{
+			name:     "evict the pods fitting another node",
+			expected: []string{"moved"},
+		},
+		{
+			name:     "evict all the pods violating their affinity without nodeFit",
+			params:   map[string]interface{}{"nodeFit": false},
+			expected: []string{"moved", "stranded", "blocked"},
+		},
+	}
+	defer func() {
+		Session = nil
+		delete(RegisteredStrategyConfigs, NodeAffinityStrategy)
+	}()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			Session = &framework.Session{Nodes: map[string]*api.NodeInfo{
+				"gpu-1": buildNode("gpu-1", "gpu", "10"),
+				"cpu-1": buildNode("cpu-1", "cpu", "3"),
+			}}
+			RegisteredStrategyConfigs[NodeAffinityStrategy] = tc.params
+			victims := victimsFnForNodeAffinity(tasks)
+			names := make([]string, 0, len(victims))
+			for _, victim := range victims {
+				names = append(names, victim.Name)
+			}
+			if len(names) != len(tc.expected) {
+				t.Fatalf("expected victims %v, got %v", tc.expected, names)
+			}
+			for i := range names {
+				if names[i] != tc.expected[i] {
+					t.Errorf("expected victims %v, got %v", tc.expected, names)
+				}
+			}
+		})
+	}
+}
@@ -59,6 +59,8 @@ func init() {
 
 	// register victim functions for all strategies here
 	VictimFn["lowNodeUtilization"] = victimsFnForLnu
+	VictimFn[LoadAwareStrategy] = victimsFnForLoadAware
+	VictimFn[NodeAffinityStrategy] = victimsFnForNodeAffinity
 }
 
 type reschedulingPlugin struct {
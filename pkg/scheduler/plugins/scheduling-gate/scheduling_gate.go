@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulinggate
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// PluginName indicates name of volcano scheduler plugin.
+const PluginName = "scheduling-gate"
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: priority
+     - name: gang
+     - name: scheduling-gate
+   - plugins:
+     - name: predicates
+     - name: proportion
+*/
+
+// schedulingGatePlugin releases the gang admission gate of the pods of the jobs admitted by their queue, whose gang
+// minimums are met. The pods opted in to the gate are created gated by the admission webhook, so that they are not
+// tried by the scheduler, nor reported unschedulable to the cluster autoscalers, while their job waits in the queue.
+type schedulingGatePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	// release queues the removal of the gang admission gate of the task, nil if no gate manager is running.
+	release func(task *api.TaskInfo) bool
+}
+
+// New function returns scheduling-gate plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	return &schedulingGatePlugin{pluginArguments: arguments}
+}
+
+func (sp *schedulingGatePlugin) Name() string {
+	return PluginName
+}
+
+func (sp *schedulingGatePlugin) OnSessionOpen(ssn *framework.Session) {
+	if manager := ssn.SchGateManager(); manager != nil {
+		sp.release = manager.EnqueueGangAdmission
+	}
+}
+
+// OnSessionClose releases the gates once the actions of the session ran, so that the jobs enqueued in the session are
+// released at once.
+func (sp *schedulingGatePlugin) OnSessionClose(ssn *framework.Session) {
+	if sp.release == nil {
+		klog.V(4).Infof("No scheduling gate manager is running, the SchedulingGatesGangAdmission feature is disabled.")
+		return
+	}
+
+	for _, job := range ssn.Jobs {
+		gated := gatedTasks(job)
+		if len(gated) == 0 {
+			continue
+		}
+		if job.IsPending() {
+			klog.V(4).Infof("Job <%s/%s> is not admitted by queue <%s> yet, keep the gates of its %d tasks.",
+				job.Namespace, job.Name, job.Queue, len(gated))
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			klog.V(4).Infof("Job <%s/%s> does not meet its gang minimums, keep the gates of its %d tasks: %s",
+				job.Namespace, job.Name, len(gated), vr.Message)
+			continue
+		}
+
+		klog.V(3).Infof("Job <%s/%s> is admitted by queue <%s>, release the gates of its %d tasks.",
+			job.Namespace, job.Name, job.Queue, len(gated))
+		for _, task := range gated {
+			sp.release(task)
+		}
+	}
+}
+
+// gatedTasks returns the pending tasks of the job held only by the gang admission gate.
+func gatedTasks(job *api.JobInfo) []*api.TaskInfo {
+	var gated []*api.TaskInfo
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if task.SchGated && api.HasOnlyGangAdmissionGate(task.Pod) {
+			gated = append(gated, task)
+		}
+	}
+	return gated
+}
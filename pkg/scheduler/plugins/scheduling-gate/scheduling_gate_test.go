@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulinggate
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/enqueue"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func buildGatedPod(name, group string) *v1.Pod {
+	pod := util.BuildPod("c1", name, "", v1.PodPending, api.BuildResourceList("1", "1Gi"), group, nil, nil)
+	pod.Annotations[schedulingv1beta1.GangAdmissionGateKey] = "true"
+	pod.Spec.SchedulingGates = []v1.PodSchedulingGate{{Name: schedulingv1beta1.GangAdmissionGateKey}}
+	return pod
+}
+
+func buildPodGroup(name string, minMember int32, minCPU string) *schedulingv1beta1.PodGroup {
+	pg := util.BuildPodGroup(name, "c1", "q1", minMember, nil, schedulingv1beta1.PodGroupPending)
+	minResources := api.BuildResourceList(minCPU, "1Gi")
+	pg.Spec.MinResources = &minResources
+	return pg
+}
+
+func TestSchedulingGate(t *testing.T) {
+	var plugin *schedulingGatePlugin
+	plugins := map[string]framework.PluginBuilder{
+		PluginName: func(arguments framework.Arguments) framework.Plugin {
+			plugin = New(arguments).(*schedulingGatePlugin)
+			return plugin
+		},
+		gang.PluginName:       gang.New,
+		proportion.PluginName: proportion.New,
+	}
+
+	test := uthelper.TestCommonStruct{
+		Name:    "gates released for the jobs admitted by their queue with their gang minimums met",
+		Plugins: plugins,
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			buildPodGroup("admitted", 2, "2"),
+			buildPodGroup("incomplete", 3, "3"),
+			buildPodGroup("over-quota", 2, "8"),
+		},
+		Pods: []*v1.Pod{
+			buildGatedPod("admitted-0", "admitted"),
+			buildGatedPod("admitted-1", "admitted"),
+			buildGatedPod("incomplete-0", "incomplete"),
+			buildGatedPod("incomplete-1", "incomplete"),
+			buildGatedPod("over-quota-0", "over-quota"),
+			buildGatedPod("over-quota-1", "over-quota"),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("16", "32Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*schedulingv1beta1.Queue{
+			util.BuildQueue("q1", 1, api.BuildResourceList("4", "8Gi")),
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:            gang.PluginName,
+					EnabledJobOrder: &trueValue,
+				},
+				{
+					Name: PluginName,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledJobEnqueued: &trueValue,
+				},
+			},
+		},
+	}
+
+	test.RegisterSession(tiers, nil)
+	var released []string
+	plugin.release = func(task *api.TaskInfo) bool {
+		released = append(released, task.Name)
+		return true
+	}
+	test.Run([]framework.Action{enqueue.New()})
+	test.Close()
+
+	sort.Strings(released)
+	expected := []string{"admitted-0", "admitted-1"}
+	if !reflect.DeepEqual(released, expected) {
+		t.Errorf("expected the gates of %v released, got %v", expected, released)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sizing
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "sizing"
+
+	// FlavorLabelKey is the node label the nodes are grouped into flavors by.
+	FlavorLabelKey = "sizing.flavorLabel"
+	// IntervalKey is the period the largest schedulable pods are refreshed in.
+	IntervalKey = "sizing.interval"
+
+	// Path is the path of the endpoint serving the largest schedulable pod of every queue and flavor.
+	Path = "/scheduler/queues/largest-schedulable-pod"
+
+	defaultFlavorLabel = v1.LabelInstanceTypeStable
+	defaultInterval    = 30 * time.Second
+)
+
+/*
+   actions: "enqueue, allocate, backfill"
+   tiers:
+   - plugins:
+     - name: sizing
+       arguments:
+         sizing.flavorLabel: node.kubernetes.io/instance-type
+         sizing.interval: 30s
+*/
+
+// Probe is the largest single pod every queue could schedule on every flavor of nodes without preemption.
+type Probe struct {
+	// RefreshTime is the time the probe was computed.
+	RefreshTime time.Time `json:"refreshTime"`
+	// Queues are the largest schedulable pod requests by flavor of every queue.
+	Queues map[string]map[string]v1.ResourceList `json:"queues"`
+}
+
+// probe is the last probe, refreshed when a session closes once in the interval and served by the handler.
+var probe = framework.PluginValue(PluginName, "probe", Probe{Queues: map[string]map[string]v1.ResourceList{}})
+
+type sizingPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	flavorLabel     string
+	interval        time.Duration
+	now             func() time.Time
+}
+
+// New function returns sizing plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	sp := &sizingPlugin{
+		pluginArguments: arguments,
+		flavorLabel:     defaultFlavorLabel,
+		interval:        defaultInterval,
+		now:             time.Now,
+	}
+
+	arguments.GetString(&sp.flavorLabel, FlavorLabelKey)
+	var interval string
+	arguments.GetString(&interval, IntervalKey)
+	if interval != "" {
+		if d, err := time.ParseDuration(interval); err != nil || d < 0 {
+			klog.Warningf("Invalid %s <%s> in plugin %s, using default %v", IntervalKey, interval, PluginName, defaultInterval)
+		} else {
+			sp.interval = d
+		}
+	}
+
+	return sp
+}
+
+func (sp *sizingPlugin) Name() string {
+	return PluginName
+}
+
+func (sp *sizingPlugin) OnSessionOpen(ssn *framework.Session) {}
+
+// OnSessionClose refreshes the probe after the decisions of the session, once in the interval.
+func (sp *sizingPlugin) OnSessionClose(ssn *framework.Session) {
+	now := sp.now()
+	if now.Sub(probe.Load().RefreshTime) < sp.interval {
+		return
+	}
+
+	queues := sp.largestSchedulablePods(ssn)
+	probe.Store(Probe{RefreshTime: now, Queues: queues})
+}
+
+// largestSchedulablePods returns the largest pod request every queue could schedule on every flavor of nodes without
+// preemption: the idle resources of one node of the flavor, capped by the capability the queue has left.
+func (sp *sizingPlugin) largestSchedulablePods(ssn *framework.Session) map[string]map[string]v1.ResourceList {
+	allocated := map[api.QueueID]*api.Resource{}
+	for _, job := range ssn.Jobs {
+		if _, found := allocated[job.Queue]; !found {
+			allocated[job.Queue] = api.EmptyResource()
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				allocated[job.Queue].Add(task.Resreq)
+			}
+		}
+	}
+
+	queues := map[string]map[string]v1.ResourceList{}
+	for queueID, queue := range ssn.Queues {
+		used, found := allocated[queueID]
+		if !found {
+			used = api.EmptyResource()
+		}
+		largest := map[string]*api.Resource{}
+		for _, node := range ssn.Nodes {
+			if !node.Ready() || (node.Node != nil && node.Node.Spec.Unschedulable) || len(node.Tasks) >= node.Allocatable.MaxTaskNum {
+				continue
+			}
+			fit := headroom(node.Idle, queue, used)
+			var flavor string
+			if node.Node != nil {
+				flavor = node.Node.Labels[sp.flavorLabel]
+			}
+			if current, found := largest[flavor]; !found || larger(fit, current) {
+				largest[flavor] = fit
+			}
+		}
+
+		queues[queue.Name] = map[string]v1.ResourceList{}
+		for flavor, fit := range largest {
+			request := util.ConvertRes2ResList(fit)
+			delete(request, v1.ResourcePods)
+			queues[queue.Name][flavor] = request
+		}
+	}
+	return queues
+}
+
+// headroom returns the idle resources of the node capped by the capability the queue has left.
+// The resources without capability are not capped.
+func headroom(idle *api.Resource, queue *api.QueueInfo, used *api.Resource) *api.Resource {
+	fit := idle.Clone()
+	if queue.Queue == nil {
+		return fit
+	}
+	for name, quantity := range queue.Queue.Spec.Capability {
+		if name == v1.ResourcePods {
+			continue
+		}
+		capability := api.NewResource(v1.ResourceList{name: quantity}).Get(name)
+		left := capability - used.Get(name)
+		if left < 0 {
+			left = 0
+		}
+		switch name {
+		case v1.ResourceCPU:
+			fit.MilliCPU = min(fit.MilliCPU, left)
+		case v1.ResourceMemory:
+			fit.Memory = min(fit.Memory, left)
+		default:
+			fit.SetScalar(name, min(fit.Get(name), left))
+		}
+	}
+	return fit
+}
+
+// larger returns whether the left pod is larger than the right one, comparing the scalar resources, e.g. the
+// accelerators, by name first, then the CPU, then the memory.
+func larger(l, r *api.Resource) bool {
+	names := map[v1.ResourceName]struct{}{}
+	for name := range l.ScalarResources {
+		names[name] = struct{}{}
+	}
+	for name := range r.ScalarResources {
+		names[name] = struct{}{}
+	}
+	delete(names, v1.ResourcePods)
+	scalars := make([]string, 0, len(names))
+	for name := range names {
+		scalars = append(scalars, string(name))
+	}
+	sort.Strings(scalars)
+
+	for _, name := range scalars {
+		if lv, rv := l.Get(v1.ResourceName(name)), r.Get(v1.ResourceName(name)); lv != rv {
+			return lv > rv
+		}
+	}
+	if l.MilliCPU != r.MilliCPU {
+		return l.MilliCPU > r.MilliCPU
+	}
+	return l.Memory > r.Memory
+}
+
+// Handler returns the handler of Path. It responds the last Probe, only of the queue given by the queue parameter
+// if it is set.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		result := probe.Load()
+		if queue := r.URL.Query().Get("queue"); queue != "" {
+			flavors, found := result.Queues[queue]
+			if !found {
+				http.Error(w, "queue not found", http.StatusNotFound)
+				return
+			}
+			result.Queues = map[string]map[string]v1.ResourceList{queue: flavors}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			klog.Errorf("Failed to write the largest schedulable pods: %v", err)
+		}
+	})
+}
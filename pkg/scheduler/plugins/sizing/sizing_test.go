@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sizing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestLargestSchedulablePods(t *testing.T) {
+	flavor := func(f string) map[string]string { return map[string]string{v1.LabelInstanceTypeStable: f} }
+	gpu := func(count string) []api.ScalarResource {
+		return []api.ScalarResource{{Name: "nvidia.com/gpu", Value: count}, {Name: "pods", Value: "10"}}
+	}
+	pods := []api.ScalarResource{{Name: "pods", Value: "10"}}
+
+	test := uthelper.TestCommonStruct{
+		Name:    "largest schedulable pod by queue and flavor",
+		Plugins: map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+			util.BuildPodGroup("pg2", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupRunning),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "p1", "n3", v1.PodRunning, api.BuildResourceList("2", "8Gi", gpu("1")[0]), "pg1", nil, nil),
+			util.BuildPod("c1", "p2", "n2", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "8Gi", pods...), flavor("small")),
+			util.BuildNode("n2", api.BuildResourceList("2", "8Gi", pods...), flavor("small")),
+			util.BuildNode("n3", api.BuildResourceList("8", "32Gi", gpu("4")...), flavor("gpu")),
+		},
+		Queues: []*schedulingv1beta1.Queue{
+			util.BuildQueue("q1", 1, nil),
+			util.BuildQueue("q2", 1, api.BuildResourceList("3", "64Gi")),
+		},
+	}
+	trueValue := true
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{Name: PluginName, EnabledJobOrder: &trueValue}}}}
+	ssn := test.RegisterSession(tiers, nil)
+	defer test.Close()
+
+	expected := map[string]map[string]v1.ResourceList{
+		"q1": {
+			"small": api.BuildResourceList("4", "8Gi"),
+			"gpu":   api.BuildResourceList("6", "24Gi", gpu("3")[0]),
+		},
+		// q2 has 2 of the capability of 3 cpus left
+		"q2": {
+			"small": api.BuildResourceList("2", "8Gi"),
+			"gpu":   api.BuildResourceList("2", "24Gi", gpu("3")[0]),
+		},
+	}
+	sp := New(framework.Arguments{}).(*sizingPlugin)
+	queues := sp.largestSchedulablePods(ssn)
+	for queue, flavors := range expected {
+		for f, request := range flavors {
+			got, found := queues[queue][f]
+			if !found {
+				t.Errorf("expected largest pod of queue %s on flavor %s", queue, f)
+				continue
+			}
+			for name, quantity := range request {
+				if q := got[name]; q.Cmp(quantity) != 0 {
+					t.Errorf("expected %s %s of queue %s on flavor %s, got %s", quantity.String(), name, queue, f, q.String())
+				}
+			}
+		}
+	}
+}
+
+func TestLarger(t *testing.T) {
+	tests := []struct {
+		name   string
+		l, r   *api.Resource
+		larger bool
+	}{
+		{
+			name:   "more accelerators",
+			l:      api.NewResource(api.BuildResourceList("1", "1Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "2"})),
+			r:      api.NewResource(api.BuildResourceList("8", "32Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "1"})),
+			larger: true,
+		},
+		{
+			name:   "more cpu",
+			l:      api.NewResource(api.BuildResourceList("4", "1Gi")),
+			r:      api.NewResource(api.BuildResourceList("2", "32Gi")),
+			larger: true,
+		},
+		{
+			name:   "less memory",
+			l:      api.NewResource(api.BuildResourceList("4", "1Gi")),
+			r:      api.NewResource(api.BuildResourceList("4", "2Gi")),
+			larger: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if larger := larger(test.l, test.r); larger != test.larger {
+				t.Errorf("expected %v, got %v", test.larger, larger)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	probe.Store(Probe{
+		RefreshTime: time.Now(),
+		Queues: map[string]map[string]v1.ResourceList{
+			"q1": {"small": {v1.ResourceCPU: resource.MustParse("4")}},
+			"q2": {"small": {v1.ResourceCPU: resource.MustParse("2")}},
+		},
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		status int
+		queues int
+	}{
+		{name: "all queues", method: http.MethodGet, url: Path, status: http.StatusOK, queues: 2},
+		{name: "one queue", method: http.MethodGet, url: Path + "?queue=q2", status: http.StatusOK, queues: 1},
+		{name: "unknown queue", method: http.MethodGet, url: Path + "?queue=q3", status: http.StatusNotFound},
+		{name: "post is not allowed", method: http.MethodPost, url: Path, status: http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			Handler().ServeHTTP(recorder, httptest.NewRequest(test.method, test.url, nil))
+			if recorder.Code != test.status {
+				t.Fatalf("expected status %d, got %d", test.status, recorder.Code)
+			}
+			if test.status != http.StatusOK {
+				return
+			}
+			var result Probe
+			if err := json.Unmarshal(recorder.Body.Bytes(), &result); err != nil {
+				t.Fatal(err)
+			}
+			if len(result.Queues) != test.queues {
+				t.Errorf("expected %d queues, got %d", test.queues, len(result.Queues))
+			}
+		})
+	}
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package sla
 
 import (
+	"fmt"
 	"time"
 
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -33,12 +35,23 @@ const (
 	// when job waits longer than waiting time, it should be enqueue at once, and cluster should reserve resources for it
 	// Valid time units are “ns”, “us” (or “µs”), “ms”, “s”, “m”, “h”
 	JobWaitingTime = "sla-waiting-time"
+	// EscalateReclaim enables the escalation of the jobs waiting longer than waiting time to reclaim, they reclaim
+	// resources from other queues even if their queue is overused, true by default
+	EscalateReclaim = "sla-escalate-reclaim"
+
+	// escalatedReason is the reason of the event recorded on the PodGroup when the job is escalated to reclaim
+	escalatedReason = "SLAEscalated"
 )
 
+// escalatedJobs are the jobs whose escalation is recorded on their PodGroup, so that the event is recorded once per
+// wait of the job.
+var escalatedJobs = framework.JobStates[struct{}](PluginName, "escalatedJobs")
+
 type slaPlugin struct {
 	// Arguments given for sla plugin
 	pluginArguments framework.Arguments
 	jobWaitingTime  *time.Duration
+	escalateReclaim bool
 }
 
 // New function returns sla plugin object
@@ -46,6 +59,7 @@ func New(arguments framework.Arguments) framework.Plugin {
 	return &slaPlugin{
 		pluginArguments: arguments,
 		jobWaitingTime:  nil,
+		escalateReclaim: true,
 	}
 }
 
@@ -72,6 +86,7 @@ tiers:
   - name: sla
     arguments:
     sla-waiting-time: 1h2m3s4ms5µs6ns
+    sla-escalate-reclaim: true
 
 Meanwhile, user can give individual job waiting time settings for one job via job annotations:
 apiVersion: batch.volcano.sh/v1alpha1
@@ -105,6 +120,11 @@ func (sp *slaPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 	}
 
+	sp.pluginArguments.GetBool(&sp.escalateReclaim, EscalateReclaim)
+	if sp.escalateReclaim {
+		sp.escalate(ssn)
+	}
+
 	jobOrderFn := func(l, r interface{}) int {
 		lv := l.(*api.JobInfo)
 		rv := r.(*api.JobInfo)
@@ -135,8 +155,11 @@ func (sp *slaPlugin) OnSessionOpen(ssn *framework.Session) {
 
 	permitableFn := func(obj interface{}) int {
 		jobInfo := obj.(*api.JobInfo)
-		var jwt = sp.readJobWaitingTime(jobInfo.WaitingTime)
+		if ssn.StarvationDetected(jobInfo) {
+			return util.Permit
+		}
 
+		var jwt = sp.readJobWaitingTime(jobInfo.WaitingTime)
 		if jwt == nil {
 			return util.Abstain
 		}
@@ -147,10 +170,47 @@ func (sp *slaPlugin) OnSessionOpen(ssn *framework.Session) {
 
 		return util.Permit
 	}
-	// if job waiting time is over, turn job to be inqueue in enqueue action
+	// if job waiting time is over or job is starving, turn job to be inqueue in enqueue action
 	ssn.AddJobEnqueueableFn(sp.Name(), permitableFn)
-	// if job waiting time is over, turn job to be pipelined in allocate action
+	// if job waiting time is over or job is starving, turn job to be pipelined in allocate action
 	ssn.AddJobPipelinedFn(sp.Name(), permitableFn)
 }
 
+// escalate marks the jobs waiting longer than their waiting time, or found starving by the scheduler, and not ready yet
+// eligible for reclaim from other queues, and records the escalation on their PodGroup once.
+func (sp *slaPlugin) escalate(ssn *framework.Session) {
+	for _, job := range ssn.Jobs {
+		if !waitingForResources(job) {
+			escalatedJobs.Delete(job.UID)
+			continue
+		}
+		jwt := sp.readJobWaitingTime(job.WaitingTime)
+		overdue := jwt != nil && time.Since(job.CreationTimestamp.Time) >= *jwt
+		if !overdue && !ssn.StarvationDetected(job) {
+			continue
+		}
+
+		ssn.EscalateJob(job)
+		if _, found := escalatedJobs.Get(job.UID); found {
+			continue
+		}
+		escalatedJobs.Set(job.UID, struct{}{})
+		reason := "is starving longer than the starvation threshold"
+		if overdue {
+			reason = fmt.Sprintf("waited longer than its SLA waiting time %v", *jwt)
+		}
+		klog.V(3).Infof("Job <%s/%s> %s, escalated to reclaim", job.Namespace, job.Name, reason)
+		ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeWarning, escalatedReason,
+			fmt.Sprintf("job %s, escalated to reclaim resources from other queues", reason))
+	}
+}
+
+// waitingForResources returns whether the job has pending tasks and is not ready yet.
+func waitingForResources(job *api.JobInfo) bool {
+	if job.PodGroup == nil || len(job.TaskStatusIndex[api.Pending]) == 0 {
+		return false
+	}
+	return job.ReadyTaskNum()+job.WaitingTaskNum() < job.MinAvailable
+}
+
 func (sp *slaPlugin) OnSessionClose(ssn *framework.Session) {}
@@ -20,16 +20,28 @@ import (
 	"testing"
 	"time"
 
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
 	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
+func init() {
+	options.Default()
+}
+
 func TestSlaPlugin(t *testing.T) {
 	var (
 		tm   = time.Hour
@@ -146,3 +158,100 @@ func TestSlaPlugin(t *testing.T) {
 	}
 
 }
+
+func TestEscalateReclaim(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:             New,
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+		proportion.PluginName:  proportion.New,
+	}
+	buildPodGroups := func() []*schedulingv1beta1.PodGroup {
+		waiting := util.BuildPodGroup("pg2", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue)
+		waiting.Annotations = map[string]string{schedulingv1beta1.JobWaitingTime: "10m"}
+		return []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning),
+			waiting,
+			util.BuildPodGroup("pg3", "c1", "q2", 1, nil, schedulingv1beta1.PodGroupRunning),
+		}
+	}
+	buildPods := func() []*v1.Pod {
+		return []*v1.Pod{
+			util.BuildPod("c1", "running1", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg1", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "preemptor", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "preemptee1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg3", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "preemptee2", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg3", make(map[string]string), make(map[string]string)),
+		}
+	}
+	buildQueues := func() []*schedulingv1beta1.Queue {
+		// q1 is overused with the capacity it is capped at, q2 uses more than its capability
+		return []*schedulingv1beta1.Queue{
+			util.BuildQueue("q1", 1, api.BuildResourceList("2", "2G", []api.ScalarResource{{Name: "pods", Value: "1"}}...)),
+			util.BuildQueue("q2", 1, api.BuildResourceList("1", "1G")),
+		}
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+		escalated bool
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "job waiting longer than its sla reclaims from other queues though its queue is overused",
+				Plugins:        plugins,
+				PodGroups:      buildPodGroups(),
+				Pods:           buildPods(),
+				Nodes:          []*v1.Node{util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)},
+				Queues:         buildQueues(),
+				ExpectEvictNum: 1,
+				ExpectEvicted:  []string{"c1/preemptee1"},
+			},
+			arguments: framework.Arguments{},
+			escalated: true,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "escalation disabled, the overused queue does not reclaim",
+				Plugins:        plugins,
+				PodGroups:      buildPodGroups(),
+				Pods:           buildPods(),
+				Nodes:          []*v1.Node{util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)},
+				Queues:         buildQueues(),
+				ExpectEvictNum: 0,
+			},
+			arguments: framework.Arguments{EscalateReclaim: false},
+			escalated: false,
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			escalatedJobs.Reset()
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{Name: PluginName, Arguments: test.arguments},
+						{Name: conformance.PluginName, EnabledPreemptable: &trueValue},
+						{Name: gang.PluginName, EnabledJobPipelined: &trueValue, EnabledJobStarving: &trueValue},
+					},
+				},
+				{
+					Plugins: []conf.PluginOption{
+						{Name: proportion.PluginName, EnabledOverused: &trueValue, EnabledReclaimable: &trueValue, EnabledQueueOrder: &trueValue},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{reclaim.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+			if _, found := escalatedJobs.Get("c1/pg2"); found != test.escalated {
+				t.Errorf("expected escalation of job c1/pg2 recorded %v, got %v", test.escalated, found)
+			}
+		})
+	}
+}
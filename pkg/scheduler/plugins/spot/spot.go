@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spot
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	fwk "k8s.io/kube-scheduler/framework"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "spot"
+
+	// WeightKey is the weight of the node order score of the interruptible nodes for the tasks steered to them.
+	WeightKey = "spot.weight"
+	// QueuesKey is the list of queues whose jobs are steered to the interruptible nodes.
+	QueuesKey = "spot.queues"
+	// PriorityThresholdKey is the priority under which the jobs are steered to the interruptible nodes.
+	PriorityThresholdKey = "spot.priorityThreshold"
+	// ProtectGangsKey keeps the gang jobs, which fail as a whole when a member is interrupted, on the on-demand nodes
+	// unless they can be migrated. True by default.
+	ProtectGangsKey = "spot.protectGangs"
+
+	// onDemandReason is the reason of the predicate failure of the tasks kept on the on-demand nodes.
+	onDemandReason = "task must run on on-demand nodes"
+	// terminationReason is the reason of the predicate failure of the nodes whose termination is announced.
+	terminationReason = "node is being terminated"
+)
+
+/*
+   actions: "enqueue, allocate, backfill, shuffle"
+   tiers:
+   - plugins:
+     - name: spot
+       arguments:
+         spot.weight: 1
+         spot.queues:
+         - batch
+         spot.priorityThreshold: 100
+         spot.protectGangs: true
+*/
+
+type spotPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	weight          int
+	queues          sets.Set[string]
+	// priorityThreshold is the priority under which the jobs are steered to the interruptible nodes, nil if unset
+	priorityThreshold *int
+	protectGangs      bool
+}
+
+// New function returns spot plugin object.
+func New(arguments framework.Arguments) framework.Plugin {
+	sp := &spotPlugin{
+		pluginArguments: arguments,
+		weight:          1,
+		queues:          sets.New[string](),
+		protectGangs:    true,
+	}
+
+	arguments.GetInt(&sp.weight, WeightKey)
+	if sp.weight < 0 {
+		klog.Warningf("Invalid %s <%d> in plugin %s, using default 1", WeightKey, sp.weight, PluginName)
+		sp.weight = 1
+	}
+	if queues, found := framework.Get[[]string](arguments, QueuesKey); found {
+		sp.queues.Insert(queues...)
+	}
+	if _, found := arguments[PriorityThresholdKey]; found {
+		threshold := 0
+		arguments.GetInt(&threshold, PriorityThresholdKey)
+		sp.priorityThreshold = &threshold
+	}
+	arguments.GetBool(&sp.protectGangs, ProtectGangsKey)
+
+	return sp
+}
+
+func (sp *spotPlugin) Name() string {
+	return PluginName
+}
+
+// placement returns the placement of the task on the interruptible nodes. The annotation of the task, then of its
+// podgroup, takes precedence; otherwise:
+//   - the non-preemptable tasks, which are not restarted elsewhere, are kept on the on-demand nodes;
+//   - the tasks of the migratable jobs, of the spot queues, or under the priority threshold are steered to the
+//     interruptible nodes;
+//   - the gang jobs are kept on the on-demand nodes when protected;
+//   - the other tasks may run anywhere.
+func (sp *spotPlugin) placement(ssn *framework.Session, task *api.TaskInfo) string {
+	if task.Pod != nil {
+		if value, found := task.Pod.Annotations[schedulingv1beta1.SpotPlacementKey]; found && validPlacement(value) {
+			return value
+		}
+	}
+	job, found := ssn.Jobs[task.Job]
+	if !found {
+		return schedulingv1beta1.SpotPlacementAllow
+	}
+	if job.PodGroup != nil {
+		if value, found := job.PodGroup.Annotations[schedulingv1beta1.SpotPlacementKey]; found && validPlacement(value) {
+			return value
+		}
+	}
+
+	if !task.Preemptable {
+		return schedulingv1beta1.SpotPlacementForbid
+	}
+	if migratable(task, job) {
+		return schedulingv1beta1.SpotPlacementPrefer
+	}
+	if queue, found := ssn.Queues[job.Queue]; found && sp.queues.Has(queue.Name) {
+		return schedulingv1beta1.SpotPlacementPrefer
+	}
+	if sp.priorityThreshold != nil && job.Priority < int32(*sp.priorityThreshold) {
+		return schedulingv1beta1.SpotPlacementPrefer
+	}
+	if sp.protectGangs && job.MinAvailable > 1 {
+		return schedulingv1beta1.SpotPlacementForbid
+	}
+	return schedulingv1beta1.SpotPlacementAllow
+}
+
+func validPlacement(value string) bool {
+	switch value {
+	case schedulingv1beta1.SpotPlacementPrefer, schedulingv1beta1.SpotPlacementForbid, schedulingv1beta1.SpotPlacementAllow:
+		return true
+	}
+	klog.Warningf("Invalid %s <%s>, ignored", schedulingv1beta1.SpotPlacementKey, value)
+	return false
+}
+
+// migratable returns whether the task can be checkpointed and restored elsewhere when its node is interrupted.
+func migratable(task *api.TaskInfo, job *api.JobInfo) bool {
+	if task.Pod != nil && task.Pod.Annotations[schedulingv1beta1.MigratableKey] == "true" {
+		return true
+	}
+	return job.PodGroup != nil && job.PodGroup.Annotations[schedulingv1beta1.MigratableKey] == "true"
+}
+
+func (sp *spotPlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(5).Infof("Enter %s plugin ...", PluginName)
+	defer klog.V(5).Infof("Leaving %s plugin.", PluginName)
+
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		reason := ""
+		switch {
+		case node.TerminationNotice:
+			reason = terminationReason
+		case node.Interruptible && sp.placement(ssn, task) == schedulingv1beta1.SpotPlacementForbid:
+			reason = onDemandReason
+		default:
+			return nil
+		}
+		return api.NewFitErrWithStatus(task, node, &api.Status{
+			Code:   api.UnschedulableAndUnresolvable,
+			Reason: reason,
+			Plugin: PluginName,
+		})
+	}
+
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		if !node.Interruptible || sp.placement(ssn, task) != schedulingv1beta1.SpotPlacementPrefer {
+			return 0, nil
+		}
+		return float64(fwk.MaxNodeScore) * float64(sp.weight), nil
+	}
+
+	// victimsFn reschedules the tasks of the nodes whose termination is announced at once, rather than when the
+	// nodes are gone.
+	victimsFn := func(tasks []*api.TaskInfo) []*api.TaskInfo {
+		var victims []*api.TaskInfo
+		for _, task := range tasks {
+			node, found := ssn.Nodes[task.NodeName]
+			if found && node.TerminationNotice && task.Status == api.Running {
+				victims = append(victims, task)
+			}
+		}
+		if len(victims) > 0 {
+			klog.V(3).Infof("Rescheduling %d tasks off the nodes being terminated", len(victims))
+		}
+		return victims
+	}
+
+	ssn.AddPredicateFn(sp.Name(), predicateFn)
+	ssn.AddNodeOrderFn(sp.Name(), nodeOrderFn)
+	ssn.AddVictimTasksFns(sp.Name(), []api.VictimTasksFn{victimsFn})
+}
+
+func (sp *spotPlugin) OnSessionClose(ssn *framework.Session) {}
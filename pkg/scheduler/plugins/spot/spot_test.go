@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spot
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/shuffle"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestSpot(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+	spotLabels := map[string]string{api.InterruptibleNodeKey: "true"}
+	nonPreemptable := map[string]string{schedulingv1beta1.PodPreemptable: "false"}
+	nodes := func() []*v1.Node {
+		return []*v1.Node{
+			util.BuildNode("spot", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), spotLabels),
+			util.BuildNode("on-demand", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		}
+	}
+
+	terminating := util.BuildNode("terminating", api.BuildResourceList("8", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), spotLabels)
+	terminating.Spec.Taints = []v1.Taint{{Key: api.TerminationNoticeKey, Effect: v1.TaintEffectNoSchedule}}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments framework.Arguments
+		actions   []framework.Action
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "gang jobs are kept on the on-demand nodes",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "default", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				},
+				Nodes: nodes(),
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("default", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "on-demand", "c1/p2": "on-demand"},
+				ExpectBindsNum: 2,
+			},
+			arguments: framework.Arguments{},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "jobs of the spot queues are steered to the spot nodes",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 2, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				},
+				Nodes: nodes(),
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "spot", "c1/p2": "spot"},
+				ExpectBindsNum: 2,
+			},
+			arguments: framework.Arguments{QueuesKey: []interface{}{"batch"}},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "non-preemptable tasks of the spot queues are kept on the on-demand nodes",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 1, nil, schedulingv1beta1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nonPreemptable, nil),
+				},
+				Nodes: nodes(),
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+				},
+				ExpectBindMap:  map[string]string{"c1/p1": "on-demand"},
+				ExpectBindsNum: 1,
+			},
+			arguments: framework.Arguments{QueuesKey: []interface{}{"batch"}},
+			actions:   []framework.Action{allocate.New()},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:    "tasks of the nodes being terminated are rescheduled",
+				Plugins: plugins,
+				PodGroups: []*schedulingv1beta1.PodGroup{
+					util.BuildPodGroup("pg1", "c1", "batch", 0, nil, schedulingv1beta1.PodGroupRunning),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "doomed", "terminating", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("c1", "safe", "spot", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				},
+				Nodes: append(nodes(), terminating),
+				Queues: []*schedulingv1beta1.Queue{
+					util.BuildQueue("batch", 1, nil),
+				},
+				ExpectEvictNum: 1,
+				ExpectEvicted:  []string{"c1/doomed"},
+			},
+			arguments: framework.Arguments{},
+			actions:   []framework.Action{shuffle.New()},
+		},
+	}
+
+	trueValue := true
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:             PluginName,
+							EnabledPredicate: &trueValue,
+							EnabledNodeOrder: &trueValue,
+							EnabledVictim:    &trueValue,
+							Arguments:        test.arguments,
+						},
+						{
+							Name:                gang.PluginName,
+							EnabledJobReady:     &trueValue,
+							EnabledJobPipelined: &trueValue,
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run(test.actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -124,14 +124,23 @@ func CalcCompositeUtilization(realLoadPercent float64, shadowEstAbs, capacity fl
 //   - memWeight: MEM weight from usagePlugin.memoryWeight
 //   - usageWeight: plugin weight in the overall scheduler scoring
 func CalcNodeScore(cpuComp, memComp float64, cpuWeight, memWeight, usageWeight int) float64 {
-	totalWeight := cpuWeight + memWeight
+	return CalcWeightedNodeScore([]float64{cpuComp, memComp}, []int{cpuWeight, memWeight}, usageWeight)
+}
+
+// CalcWeightedNodeScore computes the node score from the utilization of any number of dimensions, e.g. the
+// composite CPU and MEM utilization and the results of the queries.
+// Formula: score = sum((1 - util_i) * weight_i) / sum(weight_i) * MaxNodeScore * usageWeight
+func CalcWeightedNodeScore(utilizations []float64, weights []int, usageWeight int) float64 {
+	totalWeight := 0
+	score := 0.0
+	for i, utilization := range utilizations {
+		totalWeight += weights[i]
+		score += (1.0 - utilization) * float64(weights[i])
+	}
 	if totalWeight == 0 {
 		return 0
 	}
-	cpuScore := (1.0 - cpuComp) * float64(cpuWeight)
-	memScore := (1.0 - memComp) * float64(memWeight)
-	score := (cpuScore + memScore) / float64(totalWeight)
-	return score * float64(fwk.MaxNodeScore) * float64(usageWeight)
+	return score / float64(totalWeight) * float64(fwk.MaxNodeScore) * float64(usageWeight)
 }
 
 // getPodResourceRequestLimit extracts CPU (milliCPU) and memory (bytes) request/limit for a pod.
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics/source"
+)
+
+const (
+	querySection = "queries"
+
+	// defaultQueryNodeLabel is the label of the query results naming the node, as set by the node exporter
+	defaultQueryNodeLabel = "instance"
+	// queryTimeout bounds the queries run in background
+	queryTimeout = 30 * time.Second
+)
+
+// Query is a PromQL query whose result, a utilization percentage (0-100) by node, scores and filters the nodes as
+// the CPU and memory load do.
+type Query struct {
+	// Name names the resource measured by the query in the logs and the predicate errors.
+	Name string
+	// Expr is the PromQL expression, returning a vector with a sample per node.
+	Expr string
+	// NodeLabel is the label of the samples naming their node, instance by default.
+	NodeLabel string
+	// Weight is the weight of the query in the score of the nodes, 1 by default.
+	Weight int
+	// Threshold is the result above which no pod is scheduled to a node, 0 for no threshold.
+	Threshold float64
+}
+
+// key identifies the results of the query in the query cache
+func (q Query) key() string {
+	return q.NodeLabel + "|" + q.Expr
+}
+
+// queryClient runs the queries, a Prometheus client out of the tests.
+type queryClient interface {
+	QueryNodeValues(ctx context.Context, query, nodeLabel string) (map[string]float64, error)
+}
+
+var newQueryClient = func(metricsConf map[string]string) (queryClient, error) {
+	return source.NewPrometheusMetricsClient(metricsConf)
+}
+
+// queryResult is the result of a query, by node
+type queryResult struct {
+	values map[string]float64
+	time   time.Time
+}
+
+// queryCache keeps the results of the queries between the sessions. The queries are run in background at the
+// metrics interval, so that they never delay a session.
+type queryCache struct {
+	sync.Mutex
+	results    map[string]*queryResult
+	refreshing bool
+}
+
+var queries = &queryCache{results: map[string]*queryResult{}}
+
+// parseQueryArgs parses the queries of the plugin, ignoring the queries without name or expression.
+func parseQueryArgs(args framework.Arguments, plugin *usagePlugin) {
+	items, ok := args[querySection].([]interface{})
+	if !ok {
+		return
+	}
+	for _, item := range items {
+		queryArgs, ok := toArguments(item)
+		if !ok {
+			klog.Warningf("Invalid query %v of plugin %s, ignored", item, PluginName)
+			continue
+		}
+		query := Query{NodeLabel: defaultQueryNodeLabel, Weight: 1}
+		queryArgs.GetString(&query.Name, "name")
+		queryArgs.GetString(&query.Expr, "query")
+		queryArgs.GetString(&query.NodeLabel, "nodeLabel")
+		queryArgs.GetInt(&query.Weight, "weight")
+		queryArgs.GetFloat64(&query.Threshold, "threshold")
+		if query.Name == "" || query.Expr == "" {
+			klog.Warningf("Query %v of plugin %s lacks a name or a query, ignored", item, PluginName)
+			continue
+		}
+		if query.Weight < 0 {
+			query.Weight = 1
+		}
+		if query.Threshold < 0 || query.Threshold > 100 {
+			query.Threshold = 0
+		}
+		plugin.queries = append(plugin.queries, query)
+	}
+}
+
+// queryValues returns the fresh results of the queries by query name and node, and refreshes the results older than
+// the metrics interval in background.
+func (qc *queryCache) queryValues(metricsConf map[string]string, list []Query) map[string]map[string]float64 {
+	interval := defaultMetricsInterval
+	if d, err := time.ParseDuration(metricsConf["interval"]); err == nil && d > 0 {
+		interval = d
+	}
+
+	qc.Lock()
+	defer qc.Unlock()
+	now := time.Now()
+	values := make(map[string]map[string]float64, len(list))
+	stale := false
+	for _, query := range list {
+		result, found := qc.results[query.key()]
+		if !found || now.Sub(result.time) > interval {
+			stale = true
+		}
+		if found && now.Sub(result.time) <= MetricsActiveTime {
+			values[query.Name] = result.values
+		}
+	}
+	if stale && !qc.refreshing {
+		client, err := newQueryClient(metricsConf)
+		if err != nil {
+			klog.V(3).Infof("The queries of plugin %s cannot be run: %v", PluginName, err)
+			return values
+		}
+		qc.refreshing = true
+		go qc.refresh(client, list)
+	}
+	return values
+}
+
+// refresh runs the queries and stores their results, the failed queries keep their previous results.
+func (qc *queryCache) refresh(client queryClient, list []Query) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	results := make(map[string]*queryResult, len(list))
+	for _, query := range list {
+		values, err := client.QueryNodeValues(ctx, query.Expr, query.NodeLabel)
+		if err != nil {
+			klog.Errorf("Failed to run query %s of plugin %s: %v", query.Name, PluginName, err)
+			continue
+		}
+		results[query.key()] = &queryResult{values: values, time: time.Now()}
+	}
+
+	qc.Lock()
+	defer qc.Unlock()
+	for key, result := range results {
+		qc.results[key] = result
+	}
+	qc.refreshing = false
+}
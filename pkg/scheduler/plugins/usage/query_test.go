@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+type fakeQueryClient struct {
+	values map[string]map[string]float64
+}
+
+func (f *fakeQueryClient) QueryNodeValues(_ context.Context, query, _ string) (map[string]float64, error) {
+	values, found := f.values[query]
+	if !found {
+		return nil, errors.New("query failed")
+	}
+	return values, nil
+}
+
+func TestParseQueryArgs(t *testing.T) {
+	plugin := New(framework.Arguments{
+		"queries": []interface{}{
+			map[interface{}]interface{}{
+				"name":      "gpu_sm",
+				"query":     "avg by (Hostname) (DCGM_FI_PROF_SM_ACTIVE) * 100",
+				"nodeLabel": "Hostname",
+				"weight":    2,
+				"threshold": 90,
+			},
+			map[interface{}]interface{}{
+				"name":      "nic",
+				"query":     "nic_saturation",
+				"threshold": 120,
+			},
+			map[interface{}]interface{}{
+				"name": "no-query",
+			},
+			"invalid",
+		},
+	}).(*usagePlugin)
+
+	expected := []Query{
+		{Name: "gpu_sm", Expr: "avg by (Hostname) (DCGM_FI_PROF_SM_ACTIVE) * 100", NodeLabel: "Hostname", Weight: 2, Threshold: 90},
+		{Name: "nic", Expr: "nic_saturation", NodeLabel: "instance", Weight: 1},
+	}
+	if !reflect.DeepEqual(plugin.queries, expected) {
+		t.Errorf("expected queries %v, got %v", expected, plugin.queries)
+	}
+}
+
+func TestQueryCacheQueryValues(t *testing.T) {
+	client := &fakeQueryClient{values: map[string]map[string]float64{
+		"gpu": {"n1": 50},
+	}}
+	original := newQueryClient
+	newQueryClient = func(map[string]string) (queryClient, error) { return client, nil }
+	defer func() { newQueryClient = original }()
+
+	cache := &queryCache{results: map[string]*queryResult{}}
+	list := []Query{{Name: "gpu", Expr: "gpu"}, {Name: "failing", Expr: "failing"}}
+
+	if values := cache.queryValues(nil, list); len(values) != 0 {
+		t.Errorf("expected no values before the first refresh, got %v", values)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		cache.Lock()
+		refreshing := cache.refreshing
+		cache.Unlock()
+		if !refreshing {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the queries were not refreshed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	values := cache.queryValues(nil, list)
+	if !reflect.DeepEqual(values, map[string]map[string]float64{"gpu": {"n1": 50}}) {
+		t.Errorf("unexpected values %v", values)
+	}
+}
+
+func TestCalcNodeScoreWithQueries(t *testing.T) {
+	plugin := New(framework.Arguments{
+		"queries": []interface{}{
+			map[interface{}]interface{}{"name": "gpu", "query": "gpu", "weight": 1},
+			map[interface{}]interface{}{"name": "nic", "query": "nic", "weight": 3},
+		},
+	}).(*usagePlugin)
+	plugin.shadowCache = NewShadowLoadCache()
+	plugin.queryValues = map[string]map[string]float64{
+		"gpu": {"n1": 20, "n2": 80},
+		"nic": {"n1": 60},
+	}
+
+	n1 := api.NewNodeInfo(util.BuildNode("n1", api.BuildResourceList("4", "8Gi"), map[string]string{}))
+	n2 := api.NewNodeInfo(util.BuildNode("n2", api.BuildResourceList("4", "8Gi"), map[string]string{}))
+	n3 := api.NewNodeInfo(util.BuildNode("n3", api.BuildResourceList("4", "8Gi"), map[string]string{}))
+
+	// the cpu and memory metrics are outdated, the nodes are scored by the queries only
+	if score, expected := plugin.calcNodeScore(n1), (0.8+0.4*3)/4*100*5; math.Abs(score-expected) > eps {
+		t.Errorf("expected score %v for n1, got %v", expected, score)
+	}
+	if score, expected := plugin.calcNodeScore(n2), 0.2*100*5; math.Abs(score-expected) > eps {
+		t.Errorf("expected score %v for n2, got %v", expected, score)
+	}
+	if plugin.hasQueryValues(n3) || plugin.calcNodeScore(n3) != 0 {
+		t.Errorf("expected no score for n3 without results")
+	}
+}
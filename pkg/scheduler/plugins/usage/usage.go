@@ -17,6 +17,7 @@ limitations under the License.
 package usage
 
 import (
+	"fmt"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -37,6 +38,8 @@ const (
 	MetricsActiveTime     = 5 * time.Minute
 	NodeUsageCPUExtend    = "the CPU load of the node exceeds the upper limit."
 	NodeUsageMemoryExtend = "the memory load of the node exceeds the upper limit."
+	NodeUsageGPUExtend    = "the GPU load of the node exceeds the upper limit."
+	NodeUsageQueryExtend  = "the %s load of the node exceeds the upper limit."
 
 	// defaultMetricsInterval is the default interval for metrics collection (used as monitoring delay window)
 	defaultMetricsInterval = 30 * time.Second
@@ -55,6 +58,7 @@ const (
          thresholds:
            cpu: 80
            mem: 80
+           gpu: 90
          estimator:
            request_ratio: 0.7
            burst_ratio: 0
@@ -62,6 +66,12 @@ const (
            risk_factor: 1.2
            be_cpu: 250m
            be_mem: 200Mi
+         queries:
+         - name: gpu_sm
+           query: avg by (Hostname) (DCGM_FI_PROF_SM_ACTIVE) * 100
+           nodeLabel: Hostname
+           weight: 2
+           threshold: 90
 */
 
 const AVG string = "average"
@@ -74,6 +84,7 @@ type usagePlugin struct {
 	usageType       string
 	cpuThresholds   float64
 	memThresholds   float64
+	gpuThresholds   float64
 	period          string
 
 	// Resource estimator parameters
@@ -84,6 +95,10 @@ type usagePlugin struct {
 	beCPU         float64 // BestEffort CPU estimate in milliCPU, default 250m
 	beMemory      float64 // BestEffort memory estimate in bytes, default 200Mi
 
+	// PromQL queries scoring and filtering the nodes, and their results by query name and node
+	queries     []Query
+	queryValues map[string]map[string]float64
+
 	// Session-level shadow load cache
 	shadowCache *ShadowLoadCache
 	// Metrics collection interval (used as the monitoring delay window)
@@ -100,6 +115,7 @@ func New(args framework.Arguments) framework.Plugin {
 		usageType:       AVG,
 		cpuThresholds:   80,
 		memThresholds:   80,
+		gpuThresholds:   100,
 		period:          source.NODE_METRICS_PERIOD,
 		requestRatio:    0.7,
 		burstRatio:      0.0,
@@ -128,10 +144,11 @@ func New(args framework.Arguments) framework.Plugin {
 	// Parse threshold configuration
 	parseThresholdArgs(plugin.pluginArguments, plugin)
 	parseEstimatorArgs(plugin.pluginArguments, plugin)
+	parseQueryArgs(plugin.pluginArguments, plugin)
 
 	klog.V(4).Infof("Usage estimator config: requestRatio=%.2f burstRatio=%.2f riskThreshold=%.2f riskFactor=%.2f beCPU=%.2f beMemory=%.2f",
 		plugin.requestRatio, plugin.burstRatio, plugin.riskThreshold, plugin.riskFactor, plugin.beCPU, plugin.beMemory)
-	klog.V(4).Infof("Usage threshold config: cpuThreshold=%.2f memThreshold=%.2f", plugin.cpuThresholds, plugin.memThresholds)
+	klog.V(4).Infof("Usage threshold config: cpuThreshold=%.2f memThreshold=%.2f gpuThreshold=%.2f", plugin.cpuThresholds, plugin.memThresholds, plugin.gpuThresholds)
 
 	return plugin
 }
@@ -153,6 +170,12 @@ func parseThresholdArgs(args framework.Arguments, plugin *usagePlugin) {
 	if memThreshold >= 0 && memThreshold <= 100 {
 		plugin.memThresholds = float64(memThreshold)
 	}
+
+	gpuThreshold := int(plugin.gpuThresholds)
+	thresholdArgs.GetInt(&gpuThreshold, "gpu")
+	if gpuThreshold >= 0 && gpuThreshold <= 100 {
+		plugin.gpuThresholds = float64(gpuThreshold)
+	}
 }
 
 func parseEstimatorArgs(args framework.Arguments, plugin *usagePlugin) {
@@ -194,7 +217,11 @@ func getUsageSectionArgs(args framework.Arguments, section string) (framework.Ar
 	if !ok {
 		return nil, false
 	}
+	return toArguments(value)
+}
 
+// toArguments converts a map of the configuration to Arguments
+func toArguments(value interface{}) (framework.Arguments, bool) {
 	switch sectionArgs := value.(type) {
 	case framework.Arguments:
 		return sectionArgs, true
@@ -279,6 +306,10 @@ func (up *usagePlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 	}
 
+	if len(up.queries) > 0 {
+		up.queryValues = queries.queryValues(ssn.GetMetricsConf(), up.queries)
+	}
+
 	// Step 3: Warm up shadow cache by scanning existing tasks on nodes
 	up.warmUpShadowCache(ssn)
 
@@ -306,6 +337,17 @@ func (up *usagePlugin) OnSessionOpen(ssn *framework.Session) {
 		predicateStatus := make([]*api.Status, 0)
 		usageStatus := &api.Status{Plugin: PluginName}
 
+		for _, query := range up.queries {
+			value, found := up.queryValues[query.Name][node.Name]
+			if query.Threshold > 0 && found && value > query.Threshold {
+				klog.V(3).Infof("Node %s %s usage %f exceeds the threshold %f", node.Name, query.Name, value, query.Threshold)
+				usageStatus.Code = api.UnschedulableAndUnresolvable
+				usageStatus.Reason = fmt.Sprintf(NodeUsageQueryExtend, query.Name)
+				predicateStatus = append(predicateStatus, usageStatus)
+				return api.NewFitErrWithStatus(task, node, predicateStatus...)
+			}
+		}
+
 		now := time.Now()
 		if up.period == "" || now.Sub(node.ResourceUsage.MetricsTime) > MetricsActiveTime {
 			klog.V(4).Infof("The period(%s) is empty or the usage metrics data is not updated for more than %v minutes, "+
@@ -313,7 +355,7 @@ func (up *usagePlugin) OnSessionOpen(ssn *framework.Session) {
 			return nil
 		}
 
-		klog.V(4).Infof("predicateFn cpuThreshold:%v, memThreshold:%v", up.cpuThresholds, up.memThresholds)
+		klog.V(4).Infof("predicateFn cpuThreshold:%v, memThreshold:%v, gpuThreshold:%v", up.cpuThresholds, up.memThresholds, up.gpuThresholds)
 		if node.ResourceUsage.CPUUsageAvg[up.period] > up.cpuThresholds {
 			klog.V(3).Infof("Node %s cpu usage %f exceeds the threshold %f", node.Name, node.ResourceUsage.CPUUsageAvg[up.period], up.cpuThresholds)
 			usageStatus.Code = api.UnschedulableAndUnresolvable
@@ -328,6 +370,13 @@ func (up *usagePlugin) OnSessionOpen(ssn *framework.Session) {
 			predicateStatus = append(predicateStatus, usageStatus)
 			return api.NewFitErrWithStatus(task, node, predicateStatus...)
 		}
+		if node.ResourceUsage.GPUUsageAvg[up.period] > up.gpuThresholds {
+			klog.V(3).Infof("Node %s gpu usage %f exceeds the threshold %f", node.Name, node.ResourceUsage.GPUUsageAvg[up.period], up.gpuThresholds)
+			usageStatus.Code = api.UnschedulableAndUnresolvable
+			usageStatus.Reason = NodeUsageGPUExtend
+			predicateStatus = append(predicateStatus, usageStatus)
+			return api.NewFitErrWithStatus(task, node, predicateStatus...)
+		}
 
 		klog.V(4).Infof("Usage plugin filter for task %s/%s on node %s pass.", task.Namespace, task.Name, node.Name)
 		return nil
@@ -335,7 +384,7 @@ func (up *usagePlugin) OnSessionOpen(ssn *framework.Session) {
 
 	// Step 6: Register NodeOrderFn - scores nodes based on composite utilization
 	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
-		if !up.isMetricsAvailable(node) {
+		if !up.isMetricsAvailable(node) && !up.hasQueryValues(node) {
 			return 0, nil
 		}
 		return up.calcNodeScore(node), nil
@@ -409,19 +458,43 @@ func (up *usagePlugin) handleDeallocate(ssn *framework.Session, event *framework
 		task.Namespace, task.Name)
 }
 
-// calcNodeScore computes the score for a node based on composite utilization.
+// calcNodeScore computes the score for a node based on composite utilization, and the results of the queries.
 func (up *usagePlugin) calcNodeScore(node *api.NodeInfo) float64 {
-	cpuEst, memEst := up.shadowCache.GetNodeEst(node.Name)
-	realCPU := getRealCPUPercent(node, up.period)
-	realMem := getRealMemPercent(node, up.period)
-	cpuComp := CalcCompositeUtilization(realCPU, cpuEst, node.Capacity.MilliCPU)
-	memComp := CalcCompositeUtilization(realMem, memEst, node.Capacity.Memory)
-	score := CalcNodeScore(cpuComp, memComp, up.cpuWeight, up.memoryWeight, up.usageWeight)
-	klog.V(4).Infof("Node %s score: realCPU=%.2f realMem=%.2f shadowCPU=%.2f shadowMem=%.2f cpuCapacity=%.2f memCapacity=%.2f cpuComp=%.4f memComp=%.4f score=%.2f (max=%d)",
-		node.Name, realCPU, realMem, cpuEst, memEst, node.Capacity.MilliCPU, node.Capacity.Memory, cpuComp, memComp, score, fwk.MaxNodeScore)
+	utilizations := make([]float64, 0, 2+len(up.queries))
+	weights := make([]int, 0, 2+len(up.queries))
+	if up.isMetricsAvailable(node) {
+		cpuEst, memEst := up.shadowCache.GetNodeEst(node.Name)
+		realCPU := getRealCPUPercent(node, up.period)
+		realMem := getRealMemPercent(node, up.period)
+		cpuComp := CalcCompositeUtilization(realCPU, cpuEst, node.Capacity.MilliCPU)
+		memComp := CalcCompositeUtilization(realMem, memEst, node.Capacity.Memory)
+		utilizations = append(utilizations, cpuComp, memComp)
+		weights = append(weights, up.cpuWeight, up.memoryWeight)
+		klog.V(4).Infof("Node %s utilization: realCPU=%.2f realMem=%.2f shadowCPU=%.2f shadowMem=%.2f cpuCapacity=%.2f memCapacity=%.2f cpuComp=%.4f memComp=%.4f",
+			node.Name, realCPU, realMem, cpuEst, memEst, node.Capacity.MilliCPU, node.Capacity.Memory, cpuComp, memComp)
+	}
+	for _, query := range up.queries {
+		if value, found := up.queryValues[query.Name][node.Name]; found {
+			utilizations = append(utilizations, clampFloat64(value/100.0, 0, 1))
+			weights = append(weights, query.Weight)
+			klog.V(4).Infof("Node %s utilization: %s=%.2f", node.Name, query.Name, value)
+		}
+	}
+	score := CalcWeightedNodeScore(utilizations, weights, up.usageWeight)
+	klog.V(4).Infof("Node %s score: %.2f (max=%d)", node.Name, score, fwk.MaxNodeScore)
 	return score
 }
 
+// hasQueryValues returns whether any query has a result for the node
+func (up *usagePlugin) hasQueryValues(node *api.NodeInfo) bool {
+	for _, query := range up.queries {
+		if _, found := up.queryValues[query.Name][node.Name]; found {
+			return true
+		}
+	}
+	return false
+}
+
 func (up *usagePlugin) calcAppliedRiskFactor(node *api.NodeInfo) float64 {
 	cpuEst, memEst := up.shadowCache.GetNodeEst(node.Name)
 	realCPU := getRealCPUPercent(node, up.period)
@@ -230,6 +230,22 @@ func TestUsageThresholdsKeepDefaultWhenOutOfRange(t *testing.T) {
 	}
 }
 
+func TestUsageGPUThreshold(t *testing.T) {
+	plugin := New(framework.Arguments{}).(*usagePlugin)
+	if math.Abs(plugin.gpuThresholds-100) > eps {
+		t.Fatalf("gpu threshold should be disabled by default, got %v", plugin.gpuThresholds)
+	}
+
+	plugin = New(framework.Arguments{
+		"thresholds": map[interface{}]interface{}{
+			"gpu": 90,
+		},
+	}).(*usagePlugin)
+	if math.Abs(plugin.gpuThresholds-90) > eps {
+		t.Fatalf("unexpected gpu threshold: %v", plugin.gpuThresholds)
+	}
+}
+
 func TestUsageWeightsKeepDefaultWhenNegative(t *testing.T) {
 	plugin := New(framework.Arguments{
 		"usage.weight":  -1,
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provider holds the external providers of the plugins scoring or gating by values of the nodes
+// looked up by key, e.g. the carbon intensity of their zone or the price of their instance type. The
+// providers are named in the arguments of the plugin and called in background between the sessions.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// Builder builds a provider from the arguments of the plugin.
+type Builder[P any] func(arguments framework.Arguments) (P, error)
+
+// Registry is the providers of a plugin by name.
+type Registry[P any] struct {
+	mutex    sync.Mutex
+	builders map[string]Builder[P]
+}
+
+// NewRegistry returns the registry of the builtin providers of the plugin.
+func NewRegistry[P any](builders map[string]Builder[P]) *Registry[P] {
+	return &Registry[P]{builders: builders}
+}
+
+// Register registers the provider, used by the plugin when named by its arguments.
+func (r *Registry[P]) Register(name string, builder Builder[P]) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.builders[name] = builder
+}
+
+func (r *Registry[P]) get(name string) (Builder[P], bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	builder, found := r.builders[name]
+	return builder, found
+}
+
+// StaticValues returns the values by key set in the argument of the plugin.
+func StaticValues(arguments framework.Arguments, argument string) (map[string]float64, error) {
+	values := map[string]float64{}
+	switch v := arguments[argument].(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			values[key] = toFloat(value)
+		}
+	case map[interface{}]interface{}:
+		for key, value := range v {
+			values[fmt.Sprint(key)] = toFloat(value)
+		}
+	default:
+		return nil, fmt.Errorf("%s is not a map of values", argument)
+	}
+	return values, nil
+}
+
+func toFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
+
+// HTTPClient gets the values from a URL answering with a JSON object of the values by key.
+type HTTPClient struct {
+	url     string
+	maxSize int64
+	client  *http.Client
+}
+
+// NewHTTPClient returns the client of the URL set in the argument of the plugin.
+func NewHTTPClient(arguments framework.Arguments, argument string, timeout time.Duration, maxSize int64) (*HTTPClient, error) {
+	var url string
+	arguments.GetString(&url, argument)
+	if url == "" {
+		return nil, fmt.Errorf("%s is not set", argument)
+	}
+	return &HTTPClient{url: url, maxSize: maxSize, client: &http.Client{Timeout: timeout}}, nil
+}
+
+// Get gets the values from the URL.
+func (hc *HTTPClient) Get(ctx context.Context) (map[string]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hc.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hc.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s answered with status %d", hc.url, resp.StatusCode)
+	}
+	values := map[string]float64{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, hc.maxSize)).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// result is the last values provided by a provider.
+type result[P any] struct {
+	provider P
+	values   map[string]float64
+	time     time.Time
+	// attempt is the time the provider was last called
+	attempt    time.Time
+	refreshing bool
+}
+
+// Cache keeps the providers of a plugin and their values between the sessions, by the arguments building them.
+// The providers are called in background at the refresh interval, so that they never delay a session.
+type Cache[P any] struct {
+	sync.Mutex
+	// kind names the providers in the logs, e.g. "pricing provider of plugin cost"
+	kind     string
+	registry *Registry[P]
+	fetch    func(ctx context.Context, provider P) (map[string]float64, error)
+	timeout  time.Duration
+	results  map[string]*result[P]
+}
+
+// NewCache returns the cache of the providers of the registry, fetch calls a provider with the timeout.
+func NewCache[P any](kind string, registry *Registry[P], timeout time.Duration,
+	fetch func(ctx context.Context, provider P) (map[string]float64, error)) *Cache[P] {
+	return &Cache[P]{
+		kind:     kind,
+		registry: registry,
+		fetch:    fetch,
+		timeout:  timeout,
+		results:  map[string]*result[P]{},
+	}
+}
+
+// key identifies a provider by its name and arguments
+func key(name string, arguments framework.Arguments) string {
+	keys := make([]string, 0, len(arguments))
+	for key := range arguments {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := []string{name}
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, arguments[key]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Get returns the last values of the provider and the time they were provided, and refreshes them in background
+// when the provider was last called before the refresh interval. The values are kept as long as the provider fails.
+func (c *Cache[P]) Get(name string, arguments framework.Arguments, refreshInterval time.Duration) (map[string]float64, time.Time) {
+	k := key(name, arguments)
+
+	c.Lock()
+	defer c.Unlock()
+	r, found := c.results[k]
+	if !found {
+		builder, ok := c.registry.get(name)
+		if !ok {
+			klog.Errorf("Unknown %s %s", c.kind, name)
+			return nil, time.Time{}
+		}
+		provider, err := builder(arguments)
+		if err != nil {
+			klog.Errorf("Failed to build %s %s: %v", c.kind, name, err)
+			return nil, time.Time{}
+		}
+		r = &result[P]{provider: provider}
+		c.results[k] = r
+	}
+
+	now := time.Now()
+	if !r.refreshing && now.Sub(r.attempt) > refreshInterval {
+		r.refreshing = true
+		r.attempt = now
+		go c.refresh(name, r)
+	}
+	return r.values, r.time
+}
+
+// refresh calls the provider, the values are kept when it fails.
+func (c *Cache[P]) refresh(name string, r *result[P]) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	values, err := c.fetch(ctx, r.provider)
+
+	c.Lock()
+	defer c.Unlock()
+	r.refreshing = false
+	if err != nil {
+		klog.Errorf("Failed to get the values of %s %s: %v", c.kind, name, err)
+		return
+	}
+	r.values = values
+	r.time = time.Now()
+}
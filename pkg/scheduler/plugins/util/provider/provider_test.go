@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+type fakeProvider struct {
+	values map[string]float64
+	err    error
+}
+
+func TestCache(t *testing.T) {
+	registry := NewRegistry(map[string]Builder[*fakeProvider]{
+		"static": func(arguments framework.Arguments) (*fakeProvider, error) {
+			values, err := StaticValues(arguments, "values")
+			return &fakeProvider{values: values}, err
+		},
+	})
+	registry.Register("failing", func(framework.Arguments) (*fakeProvider, error) {
+		return &fakeProvider{err: errors.New("unavailable")}, nil
+	})
+	cache := NewCache("provider of plugin test", registry, time.Second,
+		func(_ context.Context, p *fakeProvider) (map[string]float64, error) {
+			return p.values, p.err
+		})
+
+	if values, _ := cache.Get("unknown", framework.Arguments{}, time.Millisecond); values != nil {
+		t.Errorf("expected no values of an unknown provider, got %v", values)
+	}
+	if values, _ := cache.Get("static", framework.Arguments{"values": 1}, time.Millisecond); values != nil {
+		t.Errorf("expected no values of a provider failing to build, got %v", values)
+	}
+
+	arguments := framework.Arguments{"values": map[string]interface{}{"a": 1, "b": 2.5}}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		values, provided := cache.Get("static", arguments, time.Millisecond)
+		if values != nil {
+			if expected := map[string]float64{"a": 1, "b": 2.5}; !reflect.DeepEqual(values, expected) || provided.IsZero() {
+				t.Errorf("expected values %v, got %v provided at %v", expected, values, provided)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the values were not provided")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cache.Get("failing", arguments, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	if values, provided := cache.Get("failing", arguments, time.Hour); values != nil || !provided.IsZero() {
+		t.Errorf("expected no values of a failing provider, got %v provided at %v", values, provided)
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/values" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"a": 1, "b": 2.5}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewHTTPClient(framework.Arguments{}, "url", time.Second, 1<<10); err == nil {
+		t.Errorf("expected the client without URL to fail")
+	}
+	client, err := NewHTTPClient(framework.Arguments{"url": server.URL + "/values"}, "url", time.Second, 1<<10)
+	if err != nil {
+		t.Fatalf("failed to build the client: %v", err)
+	}
+	values, err := client.Get(context.Background())
+	if expected := map[string]float64{"a": 1, "b": 2.5}; err != nil || !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected values %v, got %v, %v", expected, values, err)
+	}
+
+	client, _ = NewHTTPClient(framework.Arguments{"url": server.URL + "/missing"}, "url", time.Second, 1<<10)
+	if _, err := client.Get(context.Background()); err == nil {
+		t.Errorf("expected the missing URL to fail")
+	}
+}
@@ -185,3 +185,26 @@ func FormatResourceNames(prefix, verb string, resourceNames []string) string {
 
 	return fmt.Sprintf("%s: %s", prefix, strings.Join(parts, ", "))
 }
+
+// NodePoolPredicate returns an error if the node is out of the node pool the queue of the task, or one of its
+// ancestors, is bound to.
+func NodePoolPredicate(task *api.TaskInfo, node *api.NodeInfo, queues map[api.QueueID]*api.QueueInfo, queueID api.QueueID) error {
+	// the depth is bounded by the number of queues in case of a cycle in the hierarchy
+	for depth := 0; depth <= len(queues); depth++ {
+		queue, found := queues[queueID]
+		if !found || queue.Queue == nil {
+			return nil
+		}
+		if !queue.NodeSelected(node.Node) {
+			return api.NewFitErrWithStatus(task, node, &api.Status{
+				Code:   api.UnschedulableAndUnresolvable,
+				Reason: fmt.Sprintf("node(s) out of the node pool of queue %s", queue.Name),
+			})
+		}
+		if queue.Queue.Spec.Parent == "" {
+			return nil
+		}
+		queueID = api.QueueID(queue.Queue.Spec.Parent)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -139,3 +140,45 @@ func TestGetInqueueResource(t *testing.T) {
 		})
 	}
 }
+
+func TestNodePoolPredicate(t *testing.T) {
+	newQueue := func(name, parent string, nodeSelector map[string]string) *api.QueueInfo {
+		return api.NewQueueInfo(&scheduling.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       scheduling.QueueSpec{Parent: parent, NodeSelector: nodeSelector},
+		})
+	}
+	queues := map[api.QueueID]*api.QueueInfo{}
+	for _, queue := range []*api.QueueInfo{
+		newQueue("root", "", nil),
+		newQueue("gpu", "root", map[string]string{"pool": "gpu"}),
+		newQueue("team", "gpu", nil),
+		newQueue("shared", "root", nil),
+	} {
+		queues[queue.UID] = queue
+	}
+	gpuNode := &api.NodeInfo{Name: "n1", Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{"pool": "gpu"}}}}
+	cpuNode := &api.NodeInfo{Name: "n2", Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n2", Labels: map[string]string{"pool": "cpu"}}}}
+	task := &api.TaskInfo{Namespace: "ns1", Name: "p1"}
+
+	tests := []struct {
+		name      string
+		queue     api.QueueID
+		node      *api.NodeInfo
+		expectErr bool
+	}{
+		{name: "queue bound to the pool of the node", queue: "gpu", node: gpuNode},
+		{name: "queue bound to another pool", queue: "gpu", node: cpuNode, expectErr: true},
+		{name: "child of a queue bound to another pool", queue: "team", node: cpuNode, expectErr: true},
+		{name: "queue not bound to a pool", queue: "shared", node: cpuNode},
+		{name: "unknown queue", queue: "unknown", node: cpuNode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NodePoolPredicate(task, tt.node, queues, tt.queue)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("expected error %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
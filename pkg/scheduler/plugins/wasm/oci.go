@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// WasmLayerMediaType is the media type of the layer holding the WASM module in the OCI artifacts.
+	WasmLayerMediaType = "application/vnd.wasm.content.layer.v1+wasm"
+	// legacyWasmLayerMediaType is the media type used by the first WASM OCI artifacts.
+	legacyWasmLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+
+	// dockerHubRegistry is the registry serving the docker.io references.
+	dockerHubRegistry = "registry-1.docker.io"
+	// maxModuleSize is the maximum size of the manifests and WASM modules pulled, 64MB.
+	maxModuleSize = 64 << 20
+)
+
+// ociClient pulls the WASM modules from the OCI registries, anonymously or with the bearer token
+// the registry grants to anonymous clients.
+type ociClient struct {
+	client    *http.Client
+	plainHTTP bool
+}
+
+// pull returns the WASM module of the OCI artifact, the first layer with the WASM media type,
+// or the only layer of the artifact.
+func (c *ociClient) pull(ctx context.Context, ref string) ([]byte, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference %q: %v", ref, err)
+	}
+	named = reference.TagNameOnly(named)
+
+	registry := reference.Domain(named)
+	if registry == "docker.io" {
+		registry = dockerHubRegistry
+	}
+	repository := reference.Path(named)
+	version := ""
+	if digested, ok := named.(reference.Digested); ok {
+		version = digested.Digest().String()
+	} else if tagged, ok := named.(reference.Tagged); ok {
+		version = tagged.Tag()
+	}
+
+	scheme := "https"
+	if c.plainHTTP {
+		scheme = "http"
+	}
+	base := fmt.Sprintf("%s://%s/v2/%s", scheme, registry, repository)
+
+	manifestData, err := c.get(ctx, base+"/manifests/"+version, ocispec.MediaTypeImageManifest, repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest of %q: %v", ref, err)
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		if !verified(digested.Digest(), manifestData) {
+			return nil, fmt.Errorf("manifest of %q does not match its digest", ref)
+		}
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest of %q: %v", ref, err)
+	}
+	layer, err := wasmLayer(manifest.Layers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact %q: %v", ref, err)
+	}
+
+	module, err := c.get(ctx, base+"/blobs/"+layer.Digest.String(), layer.MediaType, repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get WASM module of %q: %v", ref, err)
+	}
+	if !verified(layer.Digest, module) {
+		return nil, fmt.Errorf("WASM module of %q does not match its digest %s", ref, layer.Digest)
+	}
+	return module, nil
+}
+
+func wasmLayer(layers []ocispec.Descriptor) (*ocispec.Descriptor, error) {
+	for i, layer := range layers {
+		if layer.MediaType == WasmLayerMediaType || layer.MediaType == legacyWasmLayerMediaType {
+			return &layers[i], nil
+		}
+	}
+	if len(layers) == 1 {
+		return &layers[0], nil
+	}
+	return nil, fmt.Errorf("no layer of media type %s among %d layers", WasmLayerMediaType, len(layers))
+}
+
+// get returns the content at the URL, requesting a token from the realm of the registry when challenged.
+func (c *ociClient) get(ctx context.Context, u, accept, repository string) ([]byte, error) {
+	resp, err := c.do(ctx, u, accept, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		token, err := c.token(ctx, challenge, repository)
+		if err != nil {
+			return nil, err
+		}
+		if resp, err = c.do(ctx, u, accept, token); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s", resp.Status, u)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxModuleSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxModuleSize {
+		return nil, fmt.Errorf("content of %s exceeds %d bytes", u, maxModuleSize)
+	}
+	return data, nil
+}
+
+func (c *ociClient) do(ctx context.Context, u, accept, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.client.Do(req)
+}
+
+// token returns the anonymous pull token granted by the realm of the bearer challenge.
+func (c *ociClient) token(ctx context.Context, challenge, repository string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+	attributes := map[string]string{}
+	for _, param := range strings.Split(params, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if found {
+			attributes[key] = strings.Trim(value, `"`)
+		}
+	}
+	realm, err := url.Parse(attributes["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm in authentication challenge %q", challenge)
+	}
+	query := realm.Query()
+	if service := attributes["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := attributes["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", repository)
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	resp, err := c.do(ctx, realm.String(), "application/json", "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s from %s", resp.Status, realm.Host)
+	}
+	tokenResp := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxModuleSize)).Decode(&tokenResp); err != nil {
+		return "", err
+	}
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+	return tokenResp.AccessToken, nil
+}
+
+// verified returns whether the content matches the digest.
+func verified(d digest.Digest, content []byte) bool {
+	return d.Validate() == nil && d.Algorithm().FromBytes(content) == d
+}
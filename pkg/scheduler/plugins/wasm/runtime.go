@@ -0,0 +1,225 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// allocFunction is the function exported by the modules to allocate the memory of the requests,
+	// it takes the size of the request and returns its offset in the memory.
+	allocFunction = "alloc"
+	// filterFunction and scoreFunction are the functions exported by the modules, they take the offset
+	// and the size of the JSON request and return the offset and the size of the JSON response packed
+	// in an i64, the offset in the high 32 bits.
+	filterFunction = "filter"
+	scoreFunction  = "score"
+	// initializeFunction is called after the instantiation of the modules built as WASI reactors.
+	initializeFunction = "_initialize"
+
+	// wasmPageSize is the size of the pages of the WASM memory.
+	wasmPageSize = 64 << 10
+	// loadRetryInterval is the interval between two loads of a module which failed to load.
+	loadRetryInterval = time.Minute
+	// maxIdleInstances is the number of instances of a module kept between the calls, as many as the predicate workers.
+	maxIdleInstances = 16
+)
+
+// errModuleLoading is returned while the module is pulled and compiled.
+var errModuleLoading = errors.New("module is loading")
+
+// module is a WASM module compiled in its own runtime, bounding the memory of its instances.
+type module struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	filter   bool
+	score    bool
+	// idle are the instances of the module reused by the calls.
+	idle chan api.Module
+}
+
+// loadResult is the module loaded for a key, or the error of the last load.
+type loadResult struct {
+	module   *module
+	err      error
+	loadTime time.Time
+	loading  bool
+}
+
+var (
+	modulesMutex sync.Mutex
+	// modules are the modules compiled by image and memory limit, they outlive the sessions.
+	modules = map[string]*loadResult{}
+)
+
+// loadModule returns the module of the image, or errModuleLoading while it is pulled and compiled in background on
+// first use, so that a slow registry never delays a session. A module which failed to load is loaded again after
+// loadRetryInterval.
+func loadModule(cfg *moduleConfig) (*module, error) {
+	key := fmt.Sprintf("%s/%d", cfg.Image, cfg.memoryLimitPages)
+
+	modulesMutex.Lock()
+	defer modulesMutex.Unlock()
+
+	result, found := modules[key]
+	if !found {
+		result = &loadResult{}
+		modules[key] = result
+	}
+	switch {
+	case result.module != nil:
+		return result.module, nil
+	case result.loading:
+		return nil, errModuleLoading
+	case result.err != nil && time.Since(result.loadTime) < loadRetryInterval:
+		return nil, result.err
+	}
+
+	result.loading = true
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.pullTimeout)
+		defer cancel()
+		m, err := newModule(ctx, cfg)
+		if err != nil {
+			klog.Errorf("Failed to load WASM module <%s>: %v", cfg.Name, err)
+		} else {
+			klog.V(3).Infof("Loaded WASM module <%s> of image <%s>", cfg.Name, cfg.Image)
+		}
+
+		modulesMutex.Lock()
+		defer modulesMutex.Unlock()
+		result.module, result.err, result.loadTime, result.loading = m, err, time.Now(), false
+	}()
+	return nil, errModuleLoading
+}
+
+func newModule(ctx context.Context, cfg *moduleConfig) (*module, error) {
+	code, err := (&ociClient{client: httpClient, plainHTTP: cfg.PlainHTTP}).pull(ctx, cfg.Image)
+	if err != nil {
+		return nil, err
+	}
+	return compileModule(ctx, code, cfg.memoryLimitPages)
+}
+
+func compileModule(ctx context.Context, code []byte, memoryLimitPages uint32) (*module, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(memoryLimitPages).
+		WithCloseOnContextDone(true))
+	// the modules built for WASI are given no file system, environment nor arguments
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		runtime.Close(ctx)
+		return nil, err
+	}
+	compiled, err := runtime.CompileModule(ctx, code)
+	if err != nil {
+		runtime.Close(ctx)
+		return nil, fmt.Errorf("failed to compile WASM module: %v", err)
+	}
+
+	exports := compiled.ExportedFunctions()
+	m := &module{runtime: runtime, compiled: compiled, idle: make(chan api.Module, maxIdleInstances)}
+	_, m.filter = exports[filterFunction]
+	_, m.score = exports[scoreFunction]
+	if _, found := exports[allocFunction]; !found || (!m.filter && !m.score) {
+		runtime.Close(ctx)
+		return nil, fmt.Errorf("WASM module must export %s and at least one of %s and %s", allocFunction, filterFunction, scoreFunction)
+	}
+	return m, nil
+}
+
+// call calls the function of an instance of the module with the JSON encoded request, and decodes its response.
+// The instance is reused by the next calls, unless the call fails, e.g. when the timeout expires.
+func (m *module) call(timeout time.Duration, function string, request, response interface{}) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	instance, err := m.instance(ctx)
+	if err != nil {
+		return err
+	}
+	if err := callInstance(ctx, instance, function, data, response); err != nil {
+		instance.Close(ctx)
+		return err
+	}
+	m.release(ctx, instance)
+	return nil
+}
+
+// instance returns an idle instance of the module, or a new one when all are busy.
+func (m *module) instance(ctx context.Context) (api.Module, error) {
+	select {
+	case instance := <-m.idle:
+		return instance, nil
+	default:
+	}
+	instance, err := m.runtime.InstantiateModule(ctx, m.compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions(initializeFunction))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate WASM module: %v", err)
+	}
+	return instance, nil
+}
+
+// release keeps the instance for the next calls, or closes it when enough instances are idle.
+func (m *module) release(ctx context.Context, instance api.Module) {
+	select {
+	case m.idle <- instance:
+	default:
+		instance.Close(ctx)
+	}
+}
+
+func callInstance(ctx context.Context, instance api.Module, function string, data []byte, response interface{}) error {
+	results, err := instance.ExportedFunction(allocFunction).Call(ctx, uint64(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %v", allocFunction, err)
+	}
+	offset := uint32(results[0])
+	if !instance.Memory().Write(offset, data) {
+		return fmt.Errorf("%s returned offset %d out of the memory for %d bytes", allocFunction, offset, len(data))
+	}
+
+	results, err = instance.ExportedFunction(function).Call(ctx, uint64(offset), uint64(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %v", function, err)
+	}
+	resultOffset, resultSize := uint32(results[0]>>32), uint32(results[0])
+	result, ok := instance.Memory().Read(resultOffset, resultSize)
+	if !ok {
+		return fmt.Errorf("%s returned a response out of the memory", function)
+	}
+	if err := json.Unmarshal(result, response); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %v", function, err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// Task is the task passed to the WASM modules.
+type Task struct {
+	Namespace   string            `json:"namespace"`
+	Name        string            `json:"name"`
+	Job         string            `json:"job"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Resources are the requested resources, cpu in millicores and memory in bytes.
+	Resources map[string]float64 `json:"resources"`
+}
+
+// Node is the node passed to the WASM modules.
+type Node struct {
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Allocatable, Idle and Used are the resources of the node, cpu in millicores and memory in bytes.
+	Allocatable map[string]float64 `json:"allocatable"`
+	Idle        map[string]float64 `json:"idle"`
+	Used        map[string]float64 `json:"used"`
+}
+
+// FilterRequest is the input of the filter function of the WASM modules.
+type FilterRequest struct {
+	Task *Task `json:"task"`
+	Node *Node `json:"node"`
+}
+
+// FilterResponse is the output of the filter function of the WASM modules,
+// the node is feasible for the task if the reason is empty.
+type FilterResponse struct {
+	Reason string `json:"reason,omitempty"`
+	// Unresolvable indicates that evicting tasks from the node does not make it feasible.
+	Unresolvable bool `json:"unresolvable,omitempty"`
+}
+
+// ScoreRequest is the input of the score function of the WASM modules.
+type ScoreRequest struct {
+	Task  *Task   `json:"task"`
+	Nodes []*Node `json:"nodes"`
+}
+
+// ScoreResponse is the output of the score function of the WASM modules, the nodes absent from the scores are scored 0.
+type ScoreResponse struct {
+	Scores map[string]float64 `json:"scores"`
+}
+
+func newTask(task *api.TaskInfo) *Task {
+	t := &Task{
+		Namespace: task.Namespace,
+		Name:      task.Name,
+		Job:       string(task.Job),
+		Resources: resources(task.Resreq),
+	}
+	if task.Pod != nil {
+		t.Labels = task.Pod.Labels
+		t.Annotations = task.Pod.Annotations
+	}
+	return t
+}
+
+func newNode(node *api.NodeInfo) *Node {
+	n := &Node{
+		Name:        node.Name,
+		Allocatable: resources(node.Allocatable),
+		Idle:        resources(node.Idle),
+		Used:        resources(node.Used),
+	}
+	if node.Node != nil {
+		n.Labels = node.Node.Labels
+		n.Annotations = node.Node.Annotations
+	}
+	return n
+}
+
+func resources(r *api.Resource) map[string]float64 {
+	if r == nil {
+		return map[string]float64{}
+	}
+	res := map[string]float64{
+		string(v1.ResourceCPU):    r.MilliCPU,
+		string(v1.ResourceMemory): r.Memory,
+	}
+	for name, quantity := range r.ScalarResources {
+		res[string(name)] = quantity
+	}
+	return res
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "wasm"
+
+	// WasmModules is the key of the list of the WASM modules run by the plugin
+	WasmModules = "wasm.modules"
+
+	defaultTimeout     = 100 * time.Millisecond
+	defaultPullTimeout = 30 * time.Second
+	defaultMemoryLimit = "16Mi"
+)
+
+// httpClient pulls the modules, the requests are bounded by the pull timeout of the modules.
+var httpClient = &http.Client{}
+
+// moduleConfig is the configuration of a WASM module.
+type moduleConfig struct {
+	// Name identifies the module in the logs and the fit errors.
+	Name string
+	// Image is the reference of the OCI artifact of the module, e.g. registry.example.com/wasm/filter:v1
+	// or registry.example.com/wasm/filter@sha256:..., the module is pulled again only when the reference changes.
+	Image string
+	// PlainHTTP pulls the artifact over HTTP instead of HTTPS.
+	PlainHTTP bool
+	// Timeout bounds the wall-clock time of each call to the module, 100ms by default.
+	Timeout string
+	// PullTimeout bounds the time to pull the artifact, 30s by default.
+	PullTimeout string
+	// MemoryLimit bounds the memory of each instance of the module, 16Mi by default.
+	MemoryLimit string
+	// Weight is the weight of the scores of the module, 1 by default.
+	Weight int
+	// Ignorable ignores the errors of the module, the nodes are feasible and scored 0 when the module fails.
+	Ignorable bool
+
+	timeout          time.Duration
+	pullTimeout      time.Duration
+	memoryLimitPages uint32
+}
+
+type wasmPlugin struct {
+	modules []*moduleConfig
+}
+
+func parseModuleConfigs(arguments framework.Arguments) []*moduleConfig {
+	/*
+		actions: "enqueue, allocate, backfill"
+		tiers:
+		- plugins:
+		  - name: predicates
+		  - name: wasm
+		    arguments:
+		      wasm.modules:
+		      - name: zone-filter
+		        image: registry.example.com/scheduler/zone-filter:v1
+		        timeout: 50ms
+		        memoryLimit: 32Mi
+		        weight: 2
+		        ignorable: true
+		  - name: nodeorder
+	*/
+	raw, ok := arguments[WasmModules].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var configs []*moduleConfig
+	for _, item := range raw {
+		cfg := &moduleConfig{}
+		if err := mapstructure.Decode(item, cfg); err != nil {
+			klog.Errorf("Failed to decode WASM module configuration %v: %v", item, err)
+			continue
+		}
+		if err := cfg.complete(); err != nil {
+			klog.Errorf("Invalid WASM module configuration %v: %v", item, err)
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+// complete validates the configuration and sets its defaults.
+func (cfg *moduleConfig) complete() error {
+	if cfg.Image == "" {
+		return fmt.Errorf("image is required")
+	}
+	if cfg.Name == "" {
+		cfg.Name = cfg.Image
+	}
+	if cfg.Weight == 0 {
+		cfg.Weight = 1
+	}
+
+	var err error
+	cfg.timeout, err = parseDuration(cfg.Timeout, defaultTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout: %v", err)
+	}
+	cfg.pullTimeout, err = parseDuration(cfg.PullTimeout, defaultPullTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid pullTimeout: %v", err)
+	}
+
+	if cfg.MemoryLimit == "" {
+		cfg.MemoryLimit = defaultMemoryLimit
+	}
+	memoryLimit, err := resource.ParseQuantity(cfg.MemoryLimit)
+	if err != nil {
+		return fmt.Errorf("invalid memoryLimit: %v", err)
+	}
+	pages := memoryLimit.Value() / wasmPageSize
+	if pages < 1 || pages > 65536 {
+		return fmt.Errorf("memoryLimit %s must be between 64Ki and 4Gi", cfg.MemoryLimit)
+	}
+	cfg.memoryLimitPages = uint32(pages)
+	return nil
+}
+
+func parseDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err == nil && d <= 0 {
+		err = fmt.Errorf("duration %s must be positive", value)
+	}
+	return d, err
+}
+
+// New returns a wasm plugin running the filter and score functions of WASM modules pulled from OCI registries,
+// the calls run in instances of the module reused between the calls and bounded by its timeout and memory limit.
+func New(arguments framework.Arguments) framework.Plugin {
+	return &wasmPlugin{modules: parseModuleConfigs(arguments)}
+}
+
+func (wp *wasmPlugin) Name() string {
+	return PluginName
+}
+
+func (wp *wasmPlugin) OnSessionOpen(ssn *framework.Session) {
+	var filters, scorers []*moduleConfig
+	loaded := map[*moduleConfig]*module{}
+	failed := map[*moduleConfig]error{}
+	for _, cfg := range wp.modules {
+		m, err := loadModule(cfg)
+		if err != nil {
+			klog.V(3).Infof("WASM module <%s> is unavailable: %v", cfg.Name, err)
+			if !cfg.Ignorable {
+				// the nodes are unfeasible for the tasks while the module is unavailable
+				failed[cfg] = err
+				filters = append(filters, cfg)
+			}
+			continue
+		}
+		loaded[cfg] = m
+		if m.filter {
+			filters = append(filters, cfg)
+		}
+		if m.score {
+			scorers = append(scorers, cfg)
+		}
+	}
+
+	if len(filters) != 0 {
+		ssn.AddPredicateFn(wp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+			var request *FilterRequest
+			for _, cfg := range filters {
+				if err, found := failed[cfg]; found {
+					return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Error, Reason: fmt.Sprintf("WASM module %s is unavailable: %v", cfg.Name, err), Plugin: PluginName})
+				}
+				if request == nil {
+					request = &FilterRequest{Task: newTask(task), Node: newNode(node)}
+				}
+
+				resp := &FilterResponse{}
+				if err := loaded[cfg].call(cfg.timeout, filterFunction, request, resp); err != nil {
+					klog.Warningf("Filter of WASM module <%s> failed for task <%s/%s> on node <%s>: %v", cfg.Name, task.Namespace, task.Name, node.Name, err)
+					if cfg.Ignorable {
+						continue
+					}
+					return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Error, Reason: err.Error(), Plugin: PluginName})
+				}
+				if resp.Reason == "" {
+					continue
+				}
+				code := api.Unschedulable
+				if resp.Unresolvable {
+					code = api.UnschedulableAndUnresolvable
+				}
+				return api.NewFitErrWithStatus(task, node, &api.Status{Code: code, Reason: resp.Reason, Plugin: PluginName})
+			}
+			return nil
+		})
+	}
+
+	if len(scorers) != 0 {
+		ssn.AddBatchNodeOrderFn(wp.Name(), func(task *api.TaskInfo, nodes []*api.NodeInfo) (map[string]float64, error) {
+			request := &ScoreRequest{Task: newTask(task), Nodes: make([]*Node, 0, len(nodes))}
+			for _, node := range nodes {
+				request.Nodes = append(request.Nodes, newNode(node))
+			}
+
+			scores := map[string]float64{}
+			for _, cfg := range scorers {
+				resp := &ScoreResponse{}
+				if err := loaded[cfg].call(cfg.timeout, scoreFunction, request, resp); err != nil {
+					klog.Warningf("Score of WASM module <%s> failed for task <%s/%s>: %v", cfg.Name, task.Namespace, task.Name, err)
+					if cfg.Ignorable {
+						continue
+					}
+					return nil, err
+				}
+				for nodeName, score := range resp.Scores {
+					scores[nodeName] += score * float64(cfg.Weight)
+				}
+			}
+			return scores, nil
+		})
+	}
+}
+
+func (wp *wasmPlugin) OnSessionClose(ssn *framework.Session) {}
@@ -0,0 +1,386 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+// testModule describes a WASM module returning constant responses, assembled by build.
+type testModule struct {
+	// filterResponse and scoreResponse are the responses of the functions, not exported if empty.
+	filterResponse string
+	scoreResponse  string
+	// spin makes the functions loop forever.
+	spin bool
+	// memoryPages is the initial memory of the module, one page by default.
+	memoryPages int
+}
+
+const (
+	filterResponseOffset = 1024
+	scoreResponseOffset  = 2048
+	requestOffset        = 4096
+)
+
+// build assembles the binary of the module.
+func (tm testModule) build() []byte {
+	pages := tm.memoryPages
+	if pages == 0 {
+		pages = 1
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(uint64(len(content)))...), content...)
+	}
+	name := func(s string) []byte {
+		return append(uleb(uint64(len(s))), s...)
+	}
+	response := func(offset int, resp string) []byte {
+		if tm.spin {
+			// loop br 0 end unreachable
+			return []byte{0x03, 0x40, 0x0c, 0x00, 0x0b, 0x00}
+		}
+		// i64.const offset<<32|size
+		return append([]byte{0x42}, sleb(int64(offset)<<32|int64(len(resp)))...)
+	}
+
+	// types: (i32) -> i32 and (i32, i32) -> i64
+	types := []byte{0x02, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}
+	functions := []byte{0x00}
+	exports := [][]byte{append(name("memory"), 0x02, 0x00), append(name(allocFunction), 0x00, 0x00)}
+	// alloc returns the constant offset of the requests
+	bodies := [][]byte{append([]byte{0x41}, sleb(requestOffset)...)}
+	var data [][]byte
+	for _, fn := range []struct {
+		name     string
+		offset   int
+		response string
+	}{{filterFunction, filterResponseOffset, tm.filterResponse}, {scoreFunction, scoreResponseOffset, tm.scoreResponse}} {
+		if fn.response == "" {
+			continue
+		}
+		exports = append(exports, append(name(fn.name), 0x00, byte(len(functions))))
+		functions = append(functions, 0x01)
+		bodies = append(bodies, response(fn.offset, fn.response))
+		// active segment of memory 0 at i32.const offset
+		segment := append([]byte{0x00, 0x41}, sleb(int64(fn.offset))...)
+		data = append(data, append(append(segment, 0x0b), name(fn.response)...))
+	}
+
+	vector := func(items [][]byte) []byte {
+		v := uleb(uint64(len(items)))
+		for _, item := range items {
+			v = append(v, item...)
+		}
+		return v
+	}
+	code := make([][]byte, 0, len(bodies))
+	for _, body := range bodies {
+		// no locals, the instructions and end
+		body = append(append([]byte{0x00}, body...), 0x0b)
+		code = append(code, append(uleb(uint64(len(body))), body...))
+	}
+
+	binary := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	binary = append(binary, section(1, types)...)
+	binary = append(binary, section(3, append(uleb(uint64(len(functions))), functions...))...)
+	binary = append(binary, section(5, append([]byte{0x01, 0x00}, uleb(uint64(pages))...))...)
+	binary = append(binary, section(7, vector(exports))...)
+	binary = append(binary, section(10, vector(code))...)
+	binary = append(binary, section(11, vector(data))...)
+	return binary
+}
+
+func uleb(v uint64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func sleb(v int64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+// newRegistry serves the module as the artifact wasm/<name>:v1, behind an anonymous bearer token.
+func newRegistry(t *testing.T, modules map[string][]byte) *httptest.Server {
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") == "Bearer anonymous" {
+			return true
+		}
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("service") != "test" || !strings.HasSuffix(r.URL.Query().Get("scope"), ":pull") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"token":"anonymous"}`))
+	})
+	for name, code := range modules {
+		layer := ocispec.Descriptor{MediaType: WasmLayerMediaType, Digest: digest.FromBytes(code), Size: int64(len(code))}
+		manifest, err := json.Marshal(ocispec.Manifest{MediaType: ocispec.MediaTypeImageManifest, Layers: []ocispec.Descriptor{layer}})
+		if err != nil {
+			t.Fatalf("failed to encode manifest: %v", err)
+		}
+		mux.HandleFunc("/v2/wasm/"+name+"/manifests/v1", func(w http.ResponseWriter, r *http.Request) {
+			if authorized(w, r) {
+				w.Write(manifest)
+			}
+		})
+		mux.HandleFunc("/v2/wasm/"+name+"/blobs/"+layer.Digest.String(), func(w http.ResponseWriter, r *http.Request) {
+			if authorized(w, r) {
+				w.Write(code)
+			}
+		})
+	}
+	server = httptest.NewServer(mux)
+	return server
+}
+
+func TestParseModuleConfigs(t *testing.T) {
+	configs := parseModuleConfigs(framework.Arguments{
+		WasmModules: []interface{}{
+			map[string]interface{}{"name": "filter", "image": "registry.example.com/wasm/filter:v1", "timeout": "50ms", "memoryLimit": "32Mi", "weight": 2},
+			map[string]interface{}{"image": "registry.example.com/wasm/score:v1"},
+			map[string]interface{}{"name": "no-image"},
+			map[string]interface{}{"image": "registry.example.com/wasm/small:v1", "memoryLimit": "1Ki"},
+		},
+	})
+	if len(configs) != 2 {
+		t.Fatalf("expected the invalid configurations to be skipped, got %d configurations", len(configs))
+	}
+	if cfg := configs[0]; cfg.Name != "filter" || cfg.timeout != 50*time.Millisecond || cfg.memoryLimitPages != 512 || cfg.Weight != 2 {
+		t.Errorf("unexpected configuration %+v", cfg)
+	}
+	if cfg := configs[1]; cfg.Name != cfg.Image || cfg.timeout != defaultTimeout || cfg.pullTimeout != defaultPullTimeout || cfg.memoryLimitPages != 256 || cfg.Weight != 1 {
+		t.Errorf("expected the defaults to be set, got %+v", cfg)
+	}
+}
+
+func TestModuleCall(t *testing.T) {
+	ctx := context.Background()
+	request := &FilterRequest{Task: &Task{Namespace: "c1", Name: "p1"}, Node: &Node{Name: "n1"}}
+
+	m, err := compileModule(ctx, testModule{filterResponse: `{"reason":"node is reserved"}`, scoreResponse: `{"scores":{"n1":10}}`}.build(), 256)
+	if err != nil {
+		t.Fatalf("failed to compile module: %v", err)
+	}
+	if !m.filter || !m.score {
+		t.Errorf("expected the module to filter and score")
+	}
+	filterResp := &FilterResponse{}
+	if err := m.call(time.Second, filterFunction, request, filterResp); err != nil || filterResp.Reason != "node is reserved" {
+		t.Errorf("unexpected filter response %+v, error %v", filterResp, err)
+	}
+	scoreResp := &ScoreResponse{}
+	if err := m.call(time.Second, scoreFunction, request, scoreResp); err != nil || scoreResp.Scores["n1"] != 10 {
+		t.Errorf("unexpected score response %+v, error %v", scoreResp, err)
+	}
+	if len(m.idle) != 1 {
+		t.Errorf("expected the instance to be reused by the calls, got %d idle instances", len(m.idle))
+	}
+
+	m, err = compileModule(ctx, testModule{filterResponse: `{}`, spin: true}.build(), 256)
+	if err != nil {
+		t.Fatalf("failed to compile module: %v", err)
+	}
+	start := time.Now()
+	if err := m.call(50*time.Millisecond, filterFunction, request, &FilterResponse{}); err == nil {
+		t.Errorf("expected the call looping forever to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the call to be interrupted by the timeout, took %v", elapsed)
+	}
+	if len(m.idle) != 0 {
+		t.Errorf("expected the instance of the failed call to be discarded, got %d idle instances", len(m.idle))
+	}
+
+	if _, err := compileModule(ctx, testModule{filterResponse: `{}`, memoryPages: 512}.build(), 256); err == nil {
+		t.Errorf("expected the module exceeding the memory limit to be rejected")
+	}
+	if _, err := compileModule(ctx, []byte("not a module"), 256); err == nil {
+		t.Errorf("expected an invalid module to be rejected")
+	}
+}
+
+func TestLoadModule(t *testing.T) {
+	registry := newRegistry(t, map[string][]byte{"filter": testModule{filterResponse: `{}`}.build()})
+	defer registry.Close()
+	cfg := &moduleConfig{Name: "filter", Image: strings.TrimPrefix(registry.URL, "http://") + "/wasm/filter:v1", PlainHTTP: true}
+	if err := cfg.complete(); err != nil {
+		t.Fatalf("invalid configuration: %v", err)
+	}
+
+	if _, err := loadModule(cfg); err != errModuleLoading {
+		t.Errorf("expected the module to be loaded in background, got %v", err)
+	}
+	waitLoaded(t, []interface{}{map[string]interface{}{"name": cfg.Name, "image": cfg.Image, "plainHTTP": true}})
+	if m, err := loadModule(cfg); err != nil || m == nil || !m.filter {
+		t.Errorf("expected the filter module to be loaded, got %v, %v", m, err)
+	}
+}
+
+// waitLoaded waits for the modules to be loaded, or to fail to load, before the sessions.
+func waitLoaded(t *testing.T, modules []interface{}) {
+	deadline := time.Now().Add(10 * time.Second)
+	for _, cfg := range parseModuleConfigs(framework.Arguments{WasmModules: modules}) {
+		for _, err := loadModule(cfg); err == errModuleLoading; _, err = loadModule(cfg) {
+			if time.Now().After(deadline) {
+				t.Fatalf("module %s was not loaded", cfg.Name)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestWasm(t *testing.T) {
+	registry := newRegistry(t, map[string][]byte{
+		"reject": testModule{filterResponse: `{"reason":"rejected by policy","unresolvable":true}`}.build(),
+		"score":  testModule{filterResponse: `{}`, scoreResponse: `{"scores":{"n2":50}}`}.build(),
+	})
+	defer registry.Close()
+	image := func(name string) string {
+		return strings.TrimPrefix(registry.URL, "http://") + "/wasm/" + name + ":v1"
+	}
+	plugins := map[string]framework.PluginBuilder{PluginName: New}
+	trueValue := true
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		modules []interface{}
+		// n2CPU is the cpu of n2, as much as n1 if empty.
+		n2CPU string
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "the nodes are ordered by the scores of the module",
+				ExpectBindMap:  map[string]string{"c1/p1": "n2"},
+				ExpectBindsNum: 1,
+			},
+			modules: []interface{}{map[string]interface{}{"name": "score", "image": image("score"), "plainHTTP": true}},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "the nodes rejected by the module are unfeasible",
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			modules: []interface{}{map[string]interface{}{"name": "reject", "image": image("reject"), "plainHTTP": true}},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "the nodes are unfeasible while a module is unavailable",
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			modules: []interface{}{map[string]interface{}{"name": "missing", "image": image("missing"), "plainHTTP": true}},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "an ignorable module which is unavailable is skipped",
+				ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+				ExpectBindsNum: 1,
+			},
+			modules: []interface{}{map[string]interface{}{"name": "missing", "image": image("missing-ignorable"), "plainHTTP": true, "ignorable": true}},
+			// nothing scores the nodes, n2 is too small for p1 so that it binds to n1 only
+			n2CPU: "500m",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			waitLoaded(t, test.modules)
+			test.Plugins = plugins
+			test.PodGroups = []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue),
+			}
+			test.Pods = []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+			}
+			n2CPU := test.n2CPU
+			if n2CPU == "" {
+				n2CPU = "2"
+			}
+			test.Nodes = []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("2", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				util.BuildNode("n2", api.BuildResourceList(n2CPU, "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			}
+			test.Queues = []*schedulingv1beta1.Queue{util.BuildQueue("q1", 1, nil)}
+
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:             PluginName,
+							EnabledPredicate: &trueValue,
+							EnabledNodeOrder: &trueValue,
+							Arguments:        framework.Arguments{WasmModules: test.modules},
+						},
+					},
+				},
+			}
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
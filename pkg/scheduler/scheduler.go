@@ -22,22 +22,33 @@ limitations under the License.
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/rest"
+	"k8s.io/component-base/tracing"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/features"
 	"volcano.sh/volcano/pkg/filewatcher"
+	"volcano.sh/volcano/pkg/scheduler/actions/burst"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/audit"
 	schedcache "volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -45,26 +56,70 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 )
 
+// tracingServiceName is the service name of the spans exported by the scheduler.
+const tracingServiceName = "volcano-scheduler"
+
 // Scheduler represents a "Volcano Scheduler".
 // Scheduler watches for new unscheduled pods(PodGroup) in Volcano.
 // It attempts to find nodes that can accommodate these pods and writes the binding information back to the API server.
 type Scheduler struct {
-	cache          schedcache.Cache
-	schedulerConf  string
+	cache         schedcache.Cache
+	schedulerConf string
+	// schedulerConfData is the scheduler configuration given inline, it takes precedence over schedulerConf.
+	schedulerConfData string
+	// embedded is true when the scheduler runs in another program, which owns the process wide endpoints.
+	embedded bool
+
 	fileWatcher    filewatcher.FileWatcher
 	schedulePeriod time.Duration
 	once           sync.Once
 
-	mutex              sync.Mutex
-	actions            []framework.Action
-	plugins            []conf.Tier
-	configurations     []conf.Configuration
-	metricsConf        map[string]string
-	dumper             schedcache.Dumper
-	disableDefaultConf bool
+	// sessionMutex serializes the periodic sessions and the burst sessions.
+	sessionMutex sync.Mutex
+
+	mutex               sync.Mutex
+	actions             []framework.Action
+	pipelines           []*framework.ActionPipeline
+	profiles            []*Profile
+	plugins             []conf.Tier
+	configurations      []conf.Configuration
+	metricsConf         map[string]string
+	tracingConf         *conf.TracingConfiguration
+	auditConf           *conf.AuditConfiguration
+	starvationConf      *conf.StarvationConfiguration
+	starvationThreshold time.Duration
+	resourceAliases     map[v1.ResourceName]api.ResourceAlias
+	resizeConf          *conf.ResizeConfiguration
+	dumper              schedcache.Dumper
+	disableDefaultConf  bool
+
+	// confVersion is the version of the applied configuration, the configurations failing to load are rolled back to it.
+	confVersion ConfigVersion
+	// effectiveConf is the configuration applied, as the scheduler uses it
+	effectiveConf *conf.EffectiveConfiguration
 
 	// schGateManager is used for async scheduling gate removal.
 	schGateManager *gate.SchGateManager
+
+	// tracerProvider exports the spans of the scheduling cycles as configured by appliedTracing.
+	tracingMutex   sync.Mutex
+	tracerProvider tracing.TracerProvider
+	appliedTracing *conf.TracingConfiguration
+
+	// auditLogger records the scheduling decisions as configured by appliedAudit.
+	auditMutex   sync.Mutex
+	auditLogger  *audit.Logger
+	appliedAudit *conf.AuditConfiguration
+}
+
+// Profile is a named set of actions and plugins of the scheduler, scheduling the jobs which select it
+// by the volcano.sh/scheduler-profile label of their pods or annotation of their queue.
+type Profile struct {
+	Name           string
+	Actions        []framework.Action
+	Pipelines      []*framework.ActionPipeline
+	Tiers          []conf.Tier
+	Configurations []conf.Configuration
 }
 
 // NewScheduler returns a Scheduler
@@ -80,25 +135,48 @@ func NewScheduler(config *rest.Config, opt *options.ServerOption) (*Scheduler, e
 	}
 
 	cache := schedcache.New(config, opt.SchedulerNames, opt.DefaultQueue, opt.NodeSelector, opt.NodeWorkerThreads, opt.IgnoredCSIProvisioners, opt.ResyncPeriod, opt.ResourceSyncTimeout)
+	scheduler := newScheduler(cache, opt)
+	scheduler.fileWatcher = watcher
+
+	return scheduler, nil
+}
+
+func newScheduler(cache schedcache.Cache, opt *options.ServerOption) *Scheduler {
 	scheduler := &Scheduler{
 		schedulerConf:      opt.SchedulerConf,
-		fileWatcher:        watcher,
 		cache:              cache,
 		schedulePeriod:     opt.SchedulePeriod,
 		dumper:             schedcache.Dumper{Cache: cache, RootDir: opt.CacheDumpFileDir},
 		disableDefaultConf: opt.DisableDefaultSchedulerConfig,
 	}
-
-	return scheduler, nil
+	framework.EnablePluginProfiling = opt.EnablePluginProfiling
+	return scheduler
 }
 
 // Run initializes and starts the Scheduler. It loads the configuration,
 // initializes the cache, and begins the scheduling process.
 func (pc *Scheduler) Run(stopCh <-chan struct{}) {
+	pc.Start(stopCh)
+	klog.V(2).Infof("Scheduler completes Initialization and start to run")
+	go wait.Until(pc.runOnce, pc.schedulePeriod, stopCh)
+	go pc.runBurst(stopCh)
+	if pc.embedded {
+		return
+	}
+	if options.ServerOpts.EnableCacheDumper {
+		pc.dumper.ListenForSignal(stopCh)
+	}
+	go runSchedulerSocket()
+}
+
+// Start loads the configuration and starts the cache, it returns once the cache is synced.
+// The scheduling sessions are not run, they are run by Run or on demand by RunOnce.
+func (pc *Scheduler) Start(stopCh <-chan struct{}) {
 	pc.loadSchedulerConf()
 
-	// Start the gate manager (if the feature gate is enabled).
-	if utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) {
+	// Start the gate manager (if any of the feature gates is enabled).
+	if utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) ||
+		utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesGangAdmission) {
 		pc.schGateManager = gate.NewSchGateManager(pc.cache.Client(), options.ServerOpts.GateRemovalWorkerNum)
 		pc.schGateManager.Start()
 		go func() {
@@ -110,48 +188,300 @@ func (pc *Scheduler) Run(stopCh <-chan struct{}) {
 	go pc.watchSchedulerConf(stopCh)
 	// Start cache for policy.
 	pc.cache.SetMetricsConf(pc.metricsConf)
+	pc.setBurstQueues()
+	pc.setTracing()
+	pc.setAudit()
+	pc.setStarvation()
+	pc.setResourceAliases()
+	pc.setResizeReview()
+	go func() {
+		<-stopCh
+		pc.shutdownTracing()
+		pc.closeAudit()
+	}()
 	pc.cache.Run(stopCh)
-	klog.V(2).Infof("Scheduler completes Initialization and start to run")
-	go wait.Until(pc.runOnce, pc.schedulePeriod, stopCh)
-	if options.ServerOpts.EnableCacheDumper {
-		pc.dumper.ListenForSignal(stopCh)
-	}
-	go runSchedulerSocket()
+}
+
+// RunOnce executes a single scheduling cycle synchronously. The binds and evictions of the cycle
+// are sent to the cluster asynchronously by the cache.
+func (pc *Scheduler) RunOnce() {
+	pc.runOnce()
 }
 
 // runOnce executes a single scheduling cycle. This function is called periodically
 // as defined by the Scheduler's schedule period.
 func (pc *Scheduler) runOnce() {
 	klog.V(4).Infof("Start scheduling ...")
+	pc.sessionMutex.Lock()
+	defer pc.sessionMutex.Unlock()
 	scheduleStartTime := time.Now()
 	defer klog.V(4).Infof("End scheduling ...")
 
+	profiles, names := pc.sessionProfiles()
+	defer func() {
+		metrics.UpdateE2eDuration(metrics.Duration(scheduleStartTime))
+	}()
+
+	for _, profile := range profiles {
+		pc.runProfile(profile, names)
+	}
+}
+
+// sessionProfiles returns the profiles a session is run for in every cycle, the default profile first, along with
+// the names of the other profiles.
+func (pc *Scheduler) sessionProfiles() ([]*Profile, sets.Set[string]) {
 	pc.mutex.Lock()
-	actions := pc.actions
-	plugins := pc.plugins
-	configurations := pc.configurations
+	// the default profile schedules the jobs which select no profile or an unknown profile
+	profiles := append([]*Profile{{
+		Actions:        pc.actions,
+		Pipelines:      pc.pipelines,
+		Tiers:          pc.plugins,
+		Configurations: pc.configurations,
+	}}, pc.profiles...)
 	pc.mutex.Unlock()
 
-	// Load ConfigMap to check which action is enabled.
-	conf.EnabledActionMap = make(map[string]bool)
-	for _, action := range actions {
-		conf.EnabledActionMap[action.Name()] = true
+	names := sets.New[string]()
+	for _, profile := range profiles[1:] {
+		names.Insert(profile.Name)
 	}
+	return profiles, names
+}
 
-	ssn := framework.OpenSession(pc.cache, plugins, configurations)
+// runProfile executes a session of the profile, which only schedules the jobs of the profile.
+func (pc *Scheduler) runProfile(profile *Profile, names sets.Set[string]) {
+	ssn := framework.OpenSession(pc.cache, profile.Tiers, profile.Configurations)
 	ssn.SetSchGateManager(pc.schGateManager)
+	ssn.SetProfile(profile.Name, names)
+	defer framework.CloseSession(ssn)
+
+	// The pipelines of the queue classes are executed in order, then the default pipeline of the other queues.
+	classes := sets.New[string]()
+	for _, pipeline := range profile.Pipelines {
+		classes.Insert(pipeline.Class)
+	}
+	ssn.SetPipelineClasses(classes)
+	pipelines := append(profile.Pipelines[:len(profile.Pipelines):len(profile.Pipelines)], &framework.ActionPipeline{Actions: profile.Actions})
+	for _, pipeline := range pipelines {
+		// Load ConfigMap to check which action is enabled.
+		conf.EnabledActionMap = make(map[string]bool)
+		for _, action := range pipeline.Actions {
+			conf.EnabledActionMap[action.Name()] = true
+		}
+		ssn.ExecutePipeline(pipeline, func(action framework.Action, start time.Time) {
+			metrics.UpdateActionDuration(action.Name(), metrics.Duration(start))
+		})
+	}
+}
+
+// runBurst runs a burst session whenever a pod of a burst queue is waiting for scheduling,
+// without waiting for the schedule period.
+func (pc *Scheduler) runBurst(stopCh <-chan struct{}) {
+	trigger := pc.cache.BurstTrigger()
+	for {
+		select {
+		case <-trigger:
+			pc.runBurstOnce()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// runBurstOnce executes a burst session for every profile with burst queues, which only enqueues jobs and places the
+// starving jobs of the burst queues of the profile, reclaiming resources from the victim queues if needed.
+func (pc *Scheduler) runBurstOnce() {
+	profiles, names := pc.sessionProfiles()
+
+	var actions []framework.Action
+	for _, name := range []string{"enqueue", burst.Name} {
+		action, found := framework.GetAction(name)
+		if !found {
+			klog.Errorf("Failed to find action %s for burst session", name)
+			return
+		}
+		actions = append(actions, action)
+	}
+
+	klog.V(4).Infof("Start burst scheduling ...")
+	pc.sessionMutex.Lock()
+	defer pc.sessionMutex.Unlock()
+	defer klog.V(4).Infof("End burst scheduling ...")
+
+	for _, profile := range profiles {
+		if !burst.ParseConfig(profile.Configurations).Enabled() {
+			continue
+		}
+		pc.runBurstProfile(profile, names, actions)
+	}
+}
+
+// runBurstProfile executes a burst session of the profile, which only schedules the jobs of the profile with its
+// plugins, as runProfile does.
+func (pc *Scheduler) runBurstProfile(profile *Profile, names sets.Set[string], actions []framework.Action) {
+	scheduleStartTime := time.Now()
+	ssn := framework.OpenSession(pc.cache, profile.Tiers, profile.Configurations)
+	ssn.SetSchGateManager(pc.schGateManager)
+	ssn.SetProfile(profile.Name, names)
 	defer func() {
 		framework.CloseSession(ssn)
-		metrics.UpdateE2eDuration(metrics.Duration(scheduleStartTime))
+		metrics.UpdateBurstSessionDuration(metrics.Duration(scheduleStartTime))
 	}()
 
 	for _, action := range actions {
-		actionStartTime := time.Now()
-		action.Execute(ssn)
-		metrics.UpdateActionDuration(action.Name(), metrics.Duration(actionStartTime))
+		ssn.ExecuteAction(action)
 	}
 }
 
+// setBurstQueues passes the burst queues of the profiles of the loaded configuration to the cache.
+func (pc *Scheduler) setBurstQueues() {
+	profiles, _ := pc.sessionProfiles()
+	queues := sets.New[string]()
+	for _, profile := range profiles {
+		queues = queues.Union(burst.ParseConfig(profile.Configurations).BurstQueues)
+	}
+	pc.cache.SetBurstQueues(queues)
+}
+
+// setTracing (re)creates the provider of the spans of the scheduling cycles when the tracing configuration changes.
+func (pc *Scheduler) setTracing() {
+	pc.mutex.Lock()
+	tracingConf := pc.tracingConf
+	pc.mutex.Unlock()
+
+	pc.tracingMutex.Lock()
+	defer pc.tracingMutex.Unlock()
+	if pc.tracerProvider != nil && reflect.DeepEqual(tracingConf, pc.appliedTracing) {
+		return
+	}
+
+	var tp tracing.TracerProvider = tracing.NewNoopTracerProvider()
+	if tracingConf != nil {
+		// all the cycles are sampled unless configured otherwise
+		samplingRate := int32(1000000)
+		if tracingConf.SamplingRatePerMillion != nil {
+			samplingRate = *tracingConf.SamplingRatePerMillion
+		}
+		config := &tracingapi.TracingConfiguration{SamplingRatePerMillion: &samplingRate}
+		if tracingConf.Endpoint != "" {
+			config.Endpoint = &tracingConf.Endpoint
+		}
+		var err error
+		tp, err = tracing.NewProvider(context.Background(), config, nil,
+			[]resource.Option{resource.WithAttributes(attribute.String("service.name", tracingServiceName))})
+		if err != nil {
+			klog.Errorf("Failed to create the tracer provider, using previous tracing configuration: %v", err)
+			return
+		}
+		klog.V(2).Infof("Tracing scheduling cycles to <%s>, sampling %d cycles per million", tracingConf.Endpoint, samplingRate)
+	}
+
+	framework.SetTracerProvider(tp)
+	if pc.tracerProvider != nil {
+		if err := pc.tracerProvider.Shutdown(context.Background()); err != nil {
+			klog.Errorf("Failed to shutdown the previous tracer provider: %v", err)
+		}
+	}
+	pc.tracerProvider = tp
+	pc.appliedTracing = tracingConf
+}
+
+// shutdownTracing flushes and stops the current tracer provider.
+func (pc *Scheduler) shutdownTracing() {
+	pc.tracingMutex.Lock()
+	defer pc.tracingMutex.Unlock()
+	if pc.tracerProvider == nil {
+		return
+	}
+	if err := pc.tracerProvider.Shutdown(context.Background()); err != nil {
+		klog.Errorf("Failed to shutdown the tracer provider: %v", err)
+	}
+}
+
+// setAudit (re)creates the audit logger of the scheduling decisions when the audit configuration changes.
+func (pc *Scheduler) setAudit() {
+	pc.mutex.Lock()
+	auditConf := pc.auditConf
+	pc.mutex.Unlock()
+
+	pc.auditMutex.Lock()
+	defer pc.auditMutex.Unlock()
+	if reflect.DeepEqual(auditConf, pc.appliedAudit) {
+		return
+	}
+
+	var logger *audit.Logger
+	if auditConf != nil {
+		var sinks []audit.Sink
+		if auditConf.File != "" {
+			fileSink, err := audit.NewFileSink(auditConf.File)
+			if err != nil {
+				klog.Errorf("Failed to open the audit file, using previous audit configuration: %v", err)
+				return
+			}
+			sinks = append(sinks, fileSink)
+		}
+		if auditConf.Webhook != "" {
+			sinks = append(sinks, audit.NewWebhookSink(auditConf.Webhook, audit.DefaultWebhookTimeout))
+		}
+		logger = audit.NewLogger(sinks...)
+		klog.V(2).Infof("Auditing scheduling decisions to file <%s> and webhook <%s>", auditConf.File, auditConf.Webhook)
+	}
+
+	framework.SetAuditLogger(logger)
+	if err := pc.auditLogger.Close(); err != nil {
+		klog.Errorf("Failed to close the previous audit logger: %v", err)
+	}
+	pc.auditLogger = logger
+	pc.appliedAudit = auditConf
+}
+
+// setStarvation enables the starvation detection of the sessions as configured, or disables it if not configured.
+func (pc *Scheduler) setStarvation() {
+	pc.mutex.Lock()
+	starvationConf := pc.starvationConf
+	threshold := pc.starvationThreshold
+	pc.mutex.Unlock()
+
+	if starvationConf == nil {
+		framework.SetStarvationDetection(0, false)
+		return
+	}
+	framework.SetStarvationDetection(threshold, starvationConf.TriggerPlugins)
+}
+
+// setResourceAliases sets the resource aliases the quotas of the queues are accounted with in the sessions.
+func (pc *Scheduler) setResourceAliases() {
+	pc.mutex.Lock()
+	resourceAliases := pc.resourceAliases
+	pc.mutex.Unlock()
+
+	api.SetResourceAliases(resourceAliases)
+}
+
+// setResizeReview sets whether the pending in-place resizes of the running pods are reviewed in the sessions.
+func (pc *Scheduler) setResizeReview() {
+	pc.mutex.Lock()
+	resizeConf := pc.resizeConf
+	pc.mutex.Unlock()
+
+	if resizeConf == nil {
+		framework.SetResizeReview(false, false)
+		return
+	}
+	framework.SetResizeReview(true, resizeConf.RevertDenied)
+}
+
+// closeAudit writes the pending audit records and closes the audit logger.
+func (pc *Scheduler) closeAudit() {
+	pc.auditMutex.Lock()
+	defer pc.auditMutex.Unlock()
+	framework.SetAuditLogger(nil)
+	if err := pc.auditLogger.Close(); err != nil {
+		klog.Errorf("Failed to close the audit logger: %v", err)
+	}
+	pc.auditLogger = nil
+}
+
 // logLoadedSchedulerConf logs the scheduler configuration that was actually
 // applied, line by line, to facilitate debugging.
 func logLoadedSchedulerConf(confStr string) {
@@ -164,7 +494,7 @@ func logLoadedSchedulerConf(confStr string) {
 func (pc *Scheduler) loadSchedulerConf() {
 	klog.V(4).Infof("Start loadSchedulerConf ...")
 
-	if pc.disableDefaultConf && len(pc.schedulerConf) == 0 {
+	if pc.disableDefaultConf && len(pc.schedulerConf) == 0 && len(pc.schedulerConfData) == 0 {
 		klog.Fatalf("No --scheduler-conf path provided and default configuration fallback is disabled")
 	}
 
@@ -175,39 +505,78 @@ func (pc *Scheduler) loadSchedulerConf() {
 			if err != nil {
 				klog.Fatalf("Invalid default configuration: unmarshal Scheduler config %s failed: %v", DefaultSchedulerConf, err)
 			}
+			pc.mutex.Lock()
+			pc.confVersion, _ = pc.nextConfigVersion(DefaultSchedulerConf)
+			pc.setEffectiveConf(DefaultSchedulerConf)
+			pc.mutex.Unlock()
 			logLoadedSchedulerConf(DefaultSchedulerConf)
 		})
 	}
 
 	var config string
-	if len(pc.schedulerConf) != 0 {
+	if len(pc.schedulerConfData) != 0 {
+		config = strings.TrimSpace(pc.schedulerConfData)
+	} else if len(pc.schedulerConf) != 0 {
 		confData, err := os.ReadFile(pc.schedulerConf)
 		if err != nil {
-			if pc.disableDefaultConf {
+			if pc.disableDefaultConf && pc.ConfigVersion().Generation == 0 {
 				klog.Fatalf("Failed to read scheduler config and default configuration fallback is disabled")
 			}
-			klog.Errorf("Failed to read the Scheduler config in '%s', using previous configuration: %v",
-				pc.schedulerConf, err)
+			pc.rollbackSchedulerConf("", fmt.Errorf("failed to read the Scheduler config in '%s': %v", pc.schedulerConf, err))
 			return
 		}
 		config = strings.TrimSpace(string(confData))
+	} else {
+		// the default configuration is applied
+		return
 	}
 
-	actions, plugins, configurations, metricsConf, err := UnmarshalSchedulerConf(config)
+	err = ValidateSchedulerConf(config)
 	if err != nil {
-		if pc.disableDefaultConf {
-			klog.Fatalf("Invalid scheduler configuration and default configuration fallback is disabled")
+		if pc.disableDefaultConf && pc.ConfigVersion().Generation == 0 {
+			klog.Fatalf("Invalid scheduler configuration and default configuration fallback is disabled: %v", err)
 		}
-		klog.Errorf("Scheduler config %s is invalid: %v", config, err)
+		pc.rollbackSchedulerConf(config, err)
 		return
 	}
+	// the configuration is valid, so the unmarshal functions do not fail
+	actions, plugins, configurations, metricsConf, _ := UnmarshalSchedulerConf(config)
+	pipelines, _ := UnmarshalPipelinesConf(config)
+	profiles, _ := UnmarshalProfilesConf(config)
+	tracingConf, _ := UnmarshalTracingConf(config)
+	auditConf, _ := UnmarshalAuditConf(config)
+	starvationConf, starvationThreshold, _ := UnmarshalStarvationConf(config)
+	resourceAliases, _ := UnmarshalResourceAliasesConf(config)
+	resizeConf, _ := UnmarshalResizeConf(config)
 
 	pc.mutex.Lock()
+	version, changed := pc.nextConfigVersion(config)
 	pc.actions = actions
+	pc.pipelines = pipelines
+	pc.profiles = profiles
 	pc.plugins = plugins
 	pc.configurations = configurations
 	pc.metricsConf = metricsConf
+	pc.tracingConf = tracingConf
+	pc.auditConf = auditConf
+	pc.starvationConf = starvationConf
+	pc.starvationThreshold = starvationThreshold
+	pc.resourceAliases = resourceAliases
+	pc.resizeConf = resizeConf
+	pc.confVersion = version
+	if changed {
+		pc.setEffectiveConf(config)
+	}
 	pc.mutex.Unlock()
+
+	if !changed {
+		metrics.RegisterConfigReload(configReloadUnchanged)
+		klog.V(4).Infof("Scheduler configuration generation %d (%s) is unchanged", version.Generation, shortHash(version.Hash))
+		return
+	}
+	metrics.RegisterConfigReload(configReloadApplied)
+	metrics.UpdateConfigGeneration(version.Generation)
+	klog.Infof("Applied scheduler configuration generation %d (%s)", version.Generation, shortHash(version.Hash))
 	logLoadedSchedulerConf(config)
 }
 
@@ -227,6 +596,12 @@ func (pc *Scheduler) watchSchedulerConf(stopCh <-chan struct{}) {
 			if event.Op&fsnotify.Write == fsnotify.Write || event.Op&fsnotify.Create == fsnotify.Create {
 				pc.loadSchedulerConf()
 				pc.cache.SetMetricsConf(pc.metricsConf)
+				pc.setBurstQueues()
+				pc.setTracing()
+				pc.setAudit()
+				pc.setStarvation()
+				pc.setResourceAliases()
+				pc.setResizeReview()
 			}
 		case err, ok := <-errCh:
 			if !ok {
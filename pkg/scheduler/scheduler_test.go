@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakevcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestRunOnceProfiles(t *testing.T) {
+	node := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	training := util.BuildPod("ns1", "training", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1",
+		map[string]string{api.SchedulerProfileKey: "training"}, nil)
+	web := util.BuildPod("ns1", "web", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil)
+	for _, pod := range []*v1.Pod{training, web} {
+		pod.Spec.SchedulerName = "volcano"
+	}
+	kubeClient := fake.NewSimpleClientset(node, training, web)
+	vcClient := fakevcclient.NewSimpleClientset(
+		util.BuildPodGroup("pg1", "ns1", "default", 1, nil, schedulingv1beta1.PodGroupPending),
+		util.BuildPodGroup("pg2", "ns1", "default", 1, nil, schedulingv1beta1.PodGroupPending),
+	)
+
+	opt := options.NewDefaultServerOption()
+	opt.ResourceSyncTimeout = 0
+	sched, err := NewEmbeddedScheduler(EmbeddedConfig{
+		KubeClient: kubeClient,
+		VCClient:   vcClient,
+		Options:    opt,
+		// the default profile only enqueues the jobs
+		SchedulerConf: `
+actions: "enqueue"
+tiers:
+- plugins:
+  - name: gang
+profiles:
+- name: training
+  actions: "enqueue, allocate"
+  tiers:
+  - plugins:
+    - name: gang
+    - name: predicates
+`,
+	})
+	if err != nil {
+		t.Fatalf("failed to create embedded scheduler: %v", err)
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	sched.Start(stopCh)
+
+	// the binder of the cache is registered once per process, the pods are bound in the cache
+	bound := func() sets.Set[string] {
+		pods := sets.New[string]()
+		for _, job := range sched.cache.Snapshot().Jobs {
+			for _, task := range job.Tasks {
+				if task.NodeName != "" {
+					pods.Insert(task.Name)
+				}
+			}
+		}
+		return pods
+	}
+	err = wait.PollUntilContextTimeout(context.TODO(), 100*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		sched.RunOnce()
+		return bound().Has("training"), nil
+	})
+	if err != nil {
+		t.Fatalf("expected pod ns1/training to be bound by the training profile")
+	}
+	sched.RunOnce()
+	if bound().Has("web") {
+		t.Errorf("expected pod ns1/web not to be bound by the default profile without allocate")
+	}
+}
+
+func TestRunBurstOnceProfiles(t *testing.T) {
+	node := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	training := util.BuildPod("ns1", "training", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1",
+		map[string]string{api.SchedulerProfileKey: "training"}, nil)
+	web := util.BuildPod("ns1", "web", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg2", nil, nil)
+	for _, pod := range []*v1.Pod{training, web} {
+		pod.Spec.SchedulerName = "volcano"
+	}
+	kubeClient := fake.NewSimpleClientset(node, training, web)
+	vcClient := fakevcclient.NewSimpleClientset(
+		util.BuildPodGroup("pg1", "ns1", "default", 1, nil, schedulingv1beta1.PodGroupPending),
+		util.BuildPodGroup("pg2", "ns1", "default", 1, nil, schedulingv1beta1.PodGroupPending),
+	)
+
+	opt := options.NewDefaultServerOption()
+	opt.ResourceSyncTimeout = 0
+	sched, err := NewEmbeddedScheduler(EmbeddedConfig{
+		KubeClient: kubeClient,
+		VCClient:   vcClient,
+		Options:    opt,
+		// only the default profile has a burst lane
+		SchedulerConf: `
+actions: "enqueue"
+tiers:
+- plugins:
+  - name: gang
+  - name: predicates
+configurations:
+- name: burst
+  arguments:
+    burstQueues:
+    - default
+profiles:
+- name: training
+  actions: "enqueue"
+  tiers:
+  - plugins:
+    - name: gang
+    - name: predicates
+`,
+	})
+	if err != nil {
+		t.Fatalf("failed to create embedded scheduler: %v", err)
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	sched.Start(stopCh)
+
+	bound := func() sets.Set[string] {
+		pods := sets.New[string]()
+		for _, job := range sched.cache.Snapshot().Jobs {
+			for _, task := range job.Tasks {
+				if task.NodeName != "" {
+					pods.Insert(task.Name)
+				}
+			}
+		}
+		return pods
+	}
+	err = wait.PollUntilContextTimeout(context.TODO(), 100*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		sched.runBurstOnce()
+		return bound().Has("web"), nil
+	})
+	if err != nil {
+		t.Fatalf("expected pod ns1/web to be placed by the burst session of the default profile")
+	}
+	sched.runBurstOnce()
+	if bound().Has("training") {
+		t.Errorf("expected pod ns1/training not to be placed by the burst session of the default profile")
+	}
+}
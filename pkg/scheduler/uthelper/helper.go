@@ -224,7 +224,7 @@ func (test *TestCommonStruct) Run(actions []framework.Action) {
 
 	for _, action := range actions {
 		action.Initialize()
-		action.Execute(test.ssn)
+		test.ssn.ExecuteAction(action)
 		action.UnInitialize()
 	}
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uthelper
+
+import (
+	"fmt"
+	"sort"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// CheckQuotaProvider checks that the provider conforms to the contract of framework.QuotaProvider on the queues and
+// the pending tasks of the session, so that the actions behave the same with any provider:
+//   - QueueOrder returns 0 for a queue with itself, and opposite signs when its arguments are swapped;
+//   - no task requesting resources is allocatable in an overused queue;
+//   - no task is allocatable in, and no candidates are preemptive for, a queue which is not open;
+//   - the results do not change when they are computed again.
+func CheckQuotaProvider(ssn *framework.Session, provider framework.QuotaProvider) error {
+	queues := make([]*api.QueueInfo, 0, len(ssn.Queues))
+	for _, queue := range ssn.Queues {
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+
+	tasks := map[api.QueueID][]*api.TaskInfo{}
+	for _, job := range ssn.Jobs {
+		for _, task := range job.TaskStatusIndex[api.Pending] {
+			tasks[job.Queue] = append(tasks[job.Queue], task)
+		}
+	}
+
+	for _, l := range queues {
+		if order := provider.QueueOrder(l, l); order != 0 {
+			return fmt.Errorf("queue <%s> ordered %d against itself, want 0", l.Name, order)
+		}
+		for _, r := range queues {
+			lr, rl := provider.QueueOrder(l, r), provider.QueueOrder(r, l)
+			if sign(lr) != -sign(rl) {
+				return fmt.Errorf("queues <%s> and <%s> ordered %d and %d when swapped, want opposite signs", l.Name, r.Name, lr, rl)
+			}
+			if again := provider.QueueOrder(l, r); again != lr {
+				return fmt.Errorf("queues <%s> and <%s> ordered %d, then %d", l.Name, r.Name, lr, again)
+			}
+		}
+
+		overused := provider.Overused(l)
+		if again := provider.Overused(l); again != overused {
+			return fmt.Errorf("queue <%s> overused %t, then %t", l.Name, overused, again)
+		}
+		open := l.Queue.Status.State == scheduling.QueueStateOpen
+		for _, task := range tasks[l.UID] {
+			allocatable := provider.Allocatable(l, task)
+			if again := provider.Allocatable(l, task); again != allocatable {
+				return fmt.Errorf("task <%s> allocatable %t in queue <%s>, then %t", task.Name, allocatable, l.Name, again)
+			}
+			if allocatable && !open {
+				return fmt.Errorf("task <%s> allocatable in queue <%s> in state %s", task.Name, l.Name, l.Queue.Status.State)
+			}
+			if allocatable && overused && !task.Resreq.IsEmpty() {
+				return fmt.Errorf("task <%s> requesting <%v> allocatable in overused queue <%s>", task.Name, task.Resreq, l.Name)
+			}
+		}
+		if !open && len(tasks[l.UID]) > 0 && provider.Preemptive(l, tasks[l.UID]) {
+			return fmt.Errorf("tasks of queue <%s> in state %s are preemptive", l.Name, l.Queue.Status.State)
+		}
+	}
+	return nil
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
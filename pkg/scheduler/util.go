@@ -22,13 +22,20 @@ limitations under the License.
 package scheduler
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
 
+	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins"
@@ -51,15 +58,30 @@ tiers:
 `
 
 func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []conf.Configuration, map[string]string, error) {
-	var actions []framework.Action
-
 	schedulerConf := &conf.SchedulerConfiguration{}
 
 	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
 		return nil, nil, nil, nil, err
 	}
+	for _, warning := range schedulerConf.ResolveDeprecatedArguments() {
+		klog.Warning(warning)
+	}
+	if err := applyTierDefaults(schedulerConf.Tiers); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	actions, err := parseActions(schedulerConf.Actions)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return actions, schedulerConf.Tiers, schedulerConf.Configurations, schedulerConf.MetricsConfiguration, nil
+}
+
+// applyTierDefaults sets the default settings of the plugins of the tiers.
+func applyTierDefaults(tiers []conf.Tier) error {
 	// Set default settings for each plugin if not set
-	for i, tier := range schedulerConf.Tiers {
+	for i, tier := range tiers {
 		// drf with hierarchy enabled
 		hdrf := false
 		// proportion enabled
@@ -73,23 +95,260 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 			if tier.Plugins[j].Name == "proportion" {
 				proportion = true
 			}
-			plugins.ApplyPluginConfDefaults(&schedulerConf.Tiers[i].Plugins[j])
+			plugins.ApplyPluginConfDefaults(&tiers[i].Plugins[j])
 		}
 		if hdrf && proportion {
-			return nil, nil, nil, nil, fmt.Errorf("proportion and drf with hierarchy enabled conflicts")
+			return fmt.Errorf("proportion and drf with hierarchy enabled conflicts")
 		}
 	}
+	return nil
+}
 
-	actionNames := strings.Split(schedulerConf.Actions, ",")
-	for _, actionName := range actionNames {
+// parseActions returns the actions of a comma separated list of action names.
+func parseActions(actionNames string) ([]framework.Action, error) {
+	var actions []framework.Action
+	for _, actionName := range strings.Split(actionNames, ",") {
 		if action, found := framework.GetAction(strings.TrimSpace(actionName)); found {
 			actions = append(actions, action)
 		} else {
-			return nil, nil, nil, nil, fmt.Errorf("failed to find Action %s", actionName)
+			return nil, fmt.Errorf("failed to find Action %s", actionName)
 		}
 	}
+	return actions, nil
+}
 
-	return actions, schedulerConf.Tiers, schedulerConf.Configurations, schedulerConf.MetricsConfiguration, nil
+// UnmarshalPipelinesConf returns the action pipelines of the queue classes of the scheduler configuration,
+// in the order they are configured.
+func UnmarshalPipelinesConf(confStr string) ([]*framework.ActionPipeline, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, err
+	}
+
+	return parsePipelines(schedulerConf.Pipelines)
+}
+
+// parsePipelines returns the action pipelines of the queue classes.
+func parsePipelines(pipelineConfs []conf.PipelineConfiguration) ([]*framework.ActionPipeline, error) {
+	var pipelines []*framework.ActionPipeline
+	classes := sets.New[string]()
+	for _, pipelineConf := range pipelineConfs {
+		if pipelineConf.QueueClass == "" {
+			return nil, fmt.Errorf("the queueClass of a pipeline is required")
+		}
+		if classes.Has(pipelineConf.QueueClass) {
+			return nil, fmt.Errorf("duplicate pipeline of queue class %s", pipelineConf.QueueClass)
+		}
+		classes.Insert(pipelineConf.QueueClass)
+		actions, err := parseActions(pipelineConf.Actions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pipeline of queue class %s: %v", pipelineConf.QueueClass, err)
+		}
+		pipelines = append(pipelines, &framework.ActionPipeline{Class: pipelineConf.QueueClass, Actions: actions})
+	}
+	return pipelines, nil
+}
+
+// UnmarshalProfilesConf returns the profiles of the scheduler configuration, in the order they are configured.
+func UnmarshalProfilesConf(confStr string) ([]*Profile, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, err
+	}
+
+	// the deprecated arguments are logged by UnmarshalSchedulerConf
+	schedulerConf.ResolveDeprecatedArguments()
+
+	var profiles []*Profile
+	names := sets.New[string]()
+	for _, profileConf := range schedulerConf.Profiles {
+		if profileConf.Name == "" {
+			return nil, fmt.Errorf("the name of a profile is required")
+		}
+		if names.Has(profileConf.Name) {
+			return nil, fmt.Errorf("duplicate profile %s", profileConf.Name)
+		}
+		names.Insert(profileConf.Name)
+
+		profile := &Profile{Name: profileConf.Name, Tiers: profileConf.Tiers, Configurations: profileConf.Configurations}
+		var err error
+		if err = applyTierDefaults(profile.Tiers); err == nil {
+			if profile.Actions, err = parseActions(profileConf.Actions); err == nil {
+				profile.Pipelines, err = parsePipelines(profileConf.Pipelines)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid profile %s: %v", profileConf.Name, err)
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// UnmarshalTracingConf returns the tracing configuration of the scheduler configuration, nil if the tracing is disabled.
+func UnmarshalTracingConf(confStr string) (*conf.TracingConfiguration, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, err
+	}
+	tracing := schedulerConf.Tracing
+	if tracing == nil {
+		return nil, nil
+	}
+	if tracing.SamplingRatePerMillion != nil && (*tracing.SamplingRatePerMillion < 0 || *tracing.SamplingRatePerMillion > 1000000) {
+		return nil, fmt.Errorf("invalid tracing samplingRatePerMillion %d, must be in [0, 1000000]", *tracing.SamplingRatePerMillion)
+	}
+	return tracing, nil
+}
+
+// UnmarshalAuditConf returns the audit configuration of the scheduler configuration, nil if the audit is disabled.
+func UnmarshalAuditConf(confStr string) (*conf.AuditConfiguration, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, err
+	}
+	auditConf := schedulerConf.Audit
+	if auditConf == nil {
+		return nil, nil
+	}
+	if auditConf.File == "" && auditConf.Webhook == "" {
+		return nil, fmt.Errorf("audit requires a file or a webhook")
+	}
+	if auditConf.Webhook != "" {
+		if u, err := url.Parse(auditConf.Webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid audit webhook %q, must be an http or https URL", auditConf.Webhook)
+		}
+	}
+	return auditConf, nil
+}
+
+// UnmarshalStarvationConf returns the starvation configuration of the scheduler configuration and its threshold,
+// nil if the detection is disabled.
+func UnmarshalStarvationConf(confStr string) (*conf.StarvationConfiguration, time.Duration, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, 0, err
+	}
+	starvationConf := schedulerConf.Starvation
+	if starvationConf == nil {
+		return nil, 0, nil
+	}
+	threshold, err := time.ParseDuration(starvationConf.Threshold)
+	if err != nil || threshold <= 0 {
+		return nil, 0, fmt.Errorf("invalid starvation threshold %q, must be a positive duration", starvationConf.Threshold)
+	}
+	return starvationConf, threshold, nil
+}
+
+// UnmarshalResizeConf returns the resize configuration of the scheduler configuration, nil if the review of the
+// resizes is disabled.
+func UnmarshalResizeConf(confStr string) (*conf.ResizeConfiguration, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, err
+	}
+	return schedulerConf.Resize, nil
+}
+
+// UnmarshalResourceAliasesConf returns the resource aliases of the scheduler configuration by the names of the
+// aliases, nil if there is none.
+func UnmarshalResourceAliasesConf(confStr string) (map[v1.ResourceName]api.ResourceAlias, error) {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+		return nil, err
+	}
+	if len(schedulerConf.ResourceAliases) == 0 {
+		return nil, nil
+	}
+
+	aliases := make(map[v1.ResourceName]api.ResourceAlias, len(schedulerConf.ResourceAliases))
+	for _, aliasConf := range schedulerConf.ResourceAliases {
+		name, resource := v1.ResourceName(aliasConf.Name), v1.ResourceName(aliasConf.Resource)
+		if name == "" || resource == "" || name == resource {
+			return nil, fmt.Errorf("invalid resource alias %q of resource %q, both must be set and differ", name, resource)
+		}
+		for _, n := range []v1.ResourceName{name, resource} {
+			if n == v1.ResourceCPU || n == v1.ResourceMemory {
+				return nil, fmt.Errorf("invalid resource alias %q of resource %q, %s cannot be aliased", name, resource, n)
+			}
+		}
+		if _, found := aliases[name]; found {
+			return nil, fmt.Errorf("duplicated resource alias %q", name)
+		}
+		ratio := 1.0
+		if aliasConf.Ratio != nil {
+			ratio = *aliasConf.Ratio
+		}
+		if ratio <= 0 {
+			return nil, fmt.Errorf("invalid ratio %v of resource alias %q, must be positive", ratio, name)
+		}
+		aliases[name] = api.ResourceAlias{Resource: resource, Ratio: ratio}
+	}
+	for name, alias := range aliases {
+		if _, found := aliases[alias.Resource]; found {
+			return nil, fmt.Errorf("resource alias %q is accounted in alias %q, the aliases cannot be chained", name, alias.Resource)
+		}
+	}
+	return aliases, nil
+}
+
+// ValidateSchedulerConf validates the scheduler configuration as it is loaded, and rejects in addition the unknown
+// fields, the unknown plugins and the configurations of unknown actions, which are otherwise ignored.
+func ValidateSchedulerConf(confStr string) error {
+	schedulerConf := &conf.SchedulerConfiguration{}
+	if err := yaml.UnmarshalStrict([]byte(confStr), schedulerConf); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, tier := range schedulerConf.Tiers {
+		for _, plugin := range tier.Plugins {
+			if _, found := framework.GetPluginBuilder(plugin.Name); !found {
+				errs = append(errs, fmt.Errorf("failed to find Plugin %s", plugin.Name))
+			}
+		}
+	}
+	for _, configuration := range schedulerConf.Configurations {
+		if _, found := framework.GetAction(configuration.Name); !found {
+			errs = append(errs, fmt.Errorf("failed to find Action %s of the configurations", configuration.Name))
+		}
+	}
+	for _, profile := range schedulerConf.Profiles {
+		for _, tier := range profile.Tiers {
+			for _, plugin := range tier.Plugins {
+				if _, found := framework.GetPluginBuilder(plugin.Name); !found {
+					errs = append(errs, fmt.Errorf("failed to find Plugin %s of profile %s", plugin.Name, profile.Name))
+				}
+			}
+		}
+		for _, configuration := range profile.Configurations {
+			if _, found := framework.GetAction(configuration.Name); !found {
+				errs = append(errs, fmt.Errorf("failed to find Action %s of the configurations of profile %s", configuration.Name, profile.Name))
+			}
+		}
+	}
+	if _, _, _, _, err := UnmarshalSchedulerConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := UnmarshalPipelinesConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := UnmarshalProfilesConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := UnmarshalTracingConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := UnmarshalAuditConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	if _, _, err := UnmarshalStarvationConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := UnmarshalResourceAliasesConf(confStr); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
 }
 
 func runSchedulerSocket() {
@@ -49,15 +49,19 @@ func (ph *predicateHelper) PredicateNodes(task *api.TaskInfo, nodes []*api.NodeI
 	// don't enable error cache if task's TaskRole is empty, because different pods with empty TaskRole will all
 	// have the same taskGroupID, and one pod predicate failed, all other pods will also be failed
 	// see issue: https://github.com/volcano-sh/volcano/issues/3527
+	if task.PredicateOverrides != nil && task.PredicateOverrides.EnablePredicateErrorCache != nil {
+		enableErrorCache = *task.PredicateOverrides.EnablePredicateErrorCache
+	}
 	if len(task.TaskRole) == 0 {
 		enableErrorCache = false
 	}
+	shardingMode := TaskShardingMode(task)
 
 	allNodes := len(nodes)
 	if allNodes == 0 {
 		return make([]*api.NodeInfo, 0), fe
 	}
-	numNodesToFind := CalculateNumOfFeasibleNodesToFind(int32(allNodes))
+	numNodesToFind := CalculateNumOfFeasibleNodesToFindForTask(task, int32(allNodes))
 
 	//allocate enough space to avoid growing it
 	predicateNodes := make([]*api.NodeInfo, numNodesToFind)
@@ -99,7 +103,7 @@ func (ph *predicateHelper) PredicateNodes(task *api.TaskInfo, nodes []*api.NodeI
 			}
 		}
 
-		if options.ServerOpts.ShardingMode == util.HardShardingMode && !nodesInShard.Has(node.Name) {
+		if shardingMode == util.HardShardingMode && !nodesInShard.Has(node.Name) {
 			klog.V(3).Infof("Predicates failed: node %s is not in scheduler shard", node.Name)
 			err := fmt.Errorf("node isn't in scheduler node shard")
 			errorLock.Lock()
@@ -151,12 +155,25 @@ func NewPredicateHelper() PredicateHelper {
 	return &predicateHelper{taskPredicateErrorCache: map[string]map[string]error{}}
 }
 
+// TaskShardingMode returns the sharding mode of the scheduler for the task, overridden by the annotation of the task.
+// The override takes no effect if the scheduler is not sharded.
+func TaskShardingMode(task *api.TaskInfo) string {
+	shardingMode := options.ServerOpts.ShardingMode
+	if shardingMode != util.HardShardingMode && shardingMode != util.SoftShardingMode {
+		return shardingMode
+	}
+	if task.PredicateOverrides != nil && task.PredicateOverrides.ShardingMode != "" {
+		return task.PredicateOverrides.ShardingMode
+	}
+	return shardingMode
+}
+
 // GetPredicatedNodeByShard return predicateNodes by shard
-func GetPredicatedNodeByShard(predicateNodes []*api.NodeInfo, nodesInShard sets.Set[string]) [2][]*api.NodeInfo {
+func GetPredicatedNodeByShard(task *api.TaskInfo, predicateNodes []*api.NodeInfo, nodesInShard sets.Set[string]) [2][]*api.NodeInfo {
 	var candidateNodes [2][]*api.NodeInfo
 	var candidateNodesInShard []*api.NodeInfo
 	var candidateNodesInOtherShards []*api.NodeInfo
-	shardingMode := options.ServerOpts.ShardingMode
+	shardingMode := TaskShardingMode(task)
 	for _, node := range predicateNodes {
 		if shardingMode == util.SoftShardingMode && nodesInShard != nil && !nodesInShard.Has(node.Name) {
 			candidateNodesInOtherShards = append(candidateNodesInOtherShards, node)
@@ -224,6 +224,7 @@ func TestGetPredicatedNodeByShard(t *testing.T) {
 		predicateNodes       []*api.NodeInfo
 		nodesInShard         sets.Set[string]
 		shardingMode         string
+		overrides            *api.PredicateOverrides
 		expectedInShard      []string
 		expectedInOtherShard []string
 	}{
@@ -294,6 +295,42 @@ func TestGetPredicatedNodeByShard(t *testing.T) {
 			expectedInShard:      []string{"node1", "node2"},
 			expectedInOtherShard: nil,
 		},
+		{
+			name: "HardShardingMode: overridden to soft by task",
+			predicateNodes: []*api.NodeInfo{
+				{Name: "node1"},
+				{Name: "node2"},
+			},
+			nodesInShard:         sets.New[string]("node1"),
+			shardingMode:         commonutil.HardShardingMode,
+			overrides:            &api.PredicateOverrides{ShardingMode: commonutil.SoftShardingMode},
+			expectedInShard:      []string{"node1"},
+			expectedInOtherShard: []string{"node2"},
+		},
+		{
+			name: "SoftShardingMode: overridden to none by task",
+			predicateNodes: []*api.NodeInfo{
+				{Name: "node1"},
+				{Name: "node2"},
+			},
+			nodesInShard:         sets.New[string]("node1"),
+			shardingMode:         commonutil.SoftShardingMode,
+			overrides:            &api.PredicateOverrides{ShardingMode: commonutil.NoneShardingMode},
+			expectedInShard:      []string{"node1", "node2"},
+			expectedInOtherShard: nil,
+		},
+		{
+			name: "NoneShardingMode: task override ignored",
+			predicateNodes: []*api.NodeInfo{
+				{Name: "node1"},
+				{Name: "node2"},
+			},
+			nodesInShard:         sets.New[string]("node1"),
+			shardingMode:         commonutil.NoneShardingMode,
+			overrides:            &api.PredicateOverrides{ShardingMode: commonutil.SoftShardingMode},
+			expectedInShard:      []string{"node1", "node2"},
+			expectedInOtherShard: nil,
+		},
 	}
 
 	checkNodes := func(t *testing.T, name string, result []*api.NodeInfo, expected []string) {
@@ -314,7 +351,8 @@ func TestGetPredicatedNodeByShard(t *testing.T) {
 				ShardingMode: tt.shardingMode,
 			}
 
-			result := GetPredicatedNodeByShard(tt.predicateNodes, tt.nodesInShard)
+			task := &api.TaskInfo{PredicateOverrides: tt.overrides}
+			result := GetPredicatedNodeByShard(task, tt.predicateNodes, tt.nodesInShard)
 
 			checkNodes(t, "InShard", result[0], tt.expectedInShard)
 			checkNodes(t, "InOtherShard", result[1], tt.expectedInOtherShard)
@@ -54,12 +54,25 @@ var lastProcessedNodeIndex atomic.Int64
 // CalculateNumOfFeasibleNodesToFind returns the number of feasible nodes that once found,
 // the scheduler stops its search for more feasible nodes.
 func CalculateNumOfFeasibleNodesToFind(numAllNodes int32) (numNodes int32) {
+	return calculateNumOfFeasibleNodesToFind(numAllNodes, options.ServerOpts.PercentageOfNodesToFind)
+}
+
+// CalculateNumOfFeasibleNodesToFindForTask is CalculateNumOfFeasibleNodesToFind with the percentage of nodes
+// to find overridden by the annotation of the task.
+func CalculateNumOfFeasibleNodesToFindForTask(task *api.TaskInfo, numAllNodes int32) int32 {
+	if task.PredicateOverrides != nil && task.PredicateOverrides.PercentageOfNodesToFind != nil {
+		return calculateNumOfFeasibleNodesToFind(numAllNodes, *task.PredicateOverrides.PercentageOfNodesToFind)
+	}
+	return CalculateNumOfFeasibleNodesToFind(numAllNodes)
+}
+
+func calculateNumOfFeasibleNodesToFind(numAllNodes, percentageOfNodesToFind int32) (numNodes int32) {
 	opts := options.ServerOpts
-	if numAllNodes <= opts.MinNodesToFind || opts.PercentageOfNodesToFind >= 100 {
+	if numAllNodes <= opts.MinNodesToFind || percentageOfNodesToFind >= 100 {
 		return numAllNodes
 	}
 
-	adaptivePercentage := opts.PercentageOfNodesToFind
+	adaptivePercentage := percentageOfNodesToFind
 	if adaptivePercentage <= 0 {
 		adaptivePercentage = baselinePercentageOfNodesToFind - numAllNodes/125
 		if adaptivePercentage < opts.MinPercentageOfNodesToFind {
@@ -176,7 +189,7 @@ func PrioritizeHyperNodes(candidateHyperNodes map[string][]*api.NodeInfo, subJob
 	return hyperNodeScores, nil
 }
 
-// SortNodes returns nodes by order of score
+// SortNodes returns nodes by order of score, the nodes with the same score ordered by the node tie-breakers if configured.
 func SortNodes(nodeScores map[float64][]*api.NodeInfo) []*api.NodeInfo {
 	var nodesInorder []*api.NodeInfo
 	var keys []float64
@@ -186,12 +199,14 @@ func SortNodes(nodeScores map[float64][]*api.NodeInfo) []*api.NodeInfo {
 	sort.Sort(sort.Reverse(sort.Float64Slice(keys)))
 	for _, key := range keys {
 		nodes := nodeScores[key]
+		OrderTiedNodes(nodes)
 		nodesInorder = append(nodesInorder, nodes...)
 	}
 	return nodesInorder
 }
 
-// SelectBestNodeAndScore returns the best node whose score is highest and the highest score, pick one randomly if there are many nodes with same score.
+// SelectBestNodeAndScore returns the best node whose score is highest and the highest score, pick one randomly if there are many nodes with same score
+// unless node tie-breakers are configured.
 func SelectBestNodeAndScore(nodeScores map[float64][]*api.NodeInfo) (*api.NodeInfo, float64) {
 	var bestNodes []*api.NodeInfo
 	var maxScore = math.Inf(-1)
@@ -206,6 +221,9 @@ func SelectBestNodeAndScore(nodeScores map[float64][]*api.NodeInfo) (*api.NodeIn
 		return nil, 0
 	}
 
+	if OrderTiedNodes(bestNodes) {
+		return bestNodes[0], maxScore
+	}
 	return bestNodes[rand.Intn(len(bestNodes))], maxScore
 }
 
@@ -227,7 +245,8 @@ func SelectBestHyperNodeAndScore(hyperNodeScores map[float64][]string) (string,
 	return bestHyperNodes[rand.Intn(len(bestHyperNodes))], maxScore
 }
 
-// SelectBestNodes returns the best N node whose score is highest N score, pick one randomly if there are many nodes with same score.
+// SelectBestNodes returns the best N node whose score is highest N score, pick one randomly if there are many nodes with same score
+// unless node tie-breakers are configured.
 // Nodes in nodesInBinder will be downgraded to reduce the conflict with binder.
 func SelectBestNodes(nodeScores map[float64][]*api.NodeInfo, count int, nodesInBinder map[string]int) []*api.NodeInfo {
 	bestNodes := []*api.NodeInfo{}
@@ -257,7 +276,7 @@ func SelectBestNodes(nodeScores map[float64][]*api.NodeInfo, count int, nodesInB
 	selecteNodeCount := 0
 	for _, score := range allScores {
 		nodes := nodeScores[score]
-		if len(nodes)+selecteNodeCount > count {
+		if len(nodes)+selecteNodeCount > count && !OrderTiedNodes(nodes) {
 			rand.Shuffle(len(nodes), func(i, j int) {
 				nodes[i], nodes[j] = nodes[j], nodes[i]
 			})
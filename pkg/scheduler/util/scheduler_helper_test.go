@@ -178,6 +178,38 @@ func TestNumFeasibleNodesToFind(t *testing.T) {
 	}
 }
 
+func TestCalculateNumOfFeasibleNodesToFindForTask(t *testing.T) {
+	options.ServerOpts = &options.ServerOption{
+		MinPercentageOfNodesToFind: 5,
+		MinNodesToFind:             100,
+		PercentageOfNodesToFind:    40,
+	}
+	percentage := int32(100)
+	tests := []struct {
+		name         string
+		task         *api.TaskInfo
+		wantNumNodes int32
+	}{
+		{
+			name:         "task without overrides uses the scheduler option",
+			task:         &api.TaskInfo{},
+			wantNumNodes: 400,
+		},
+		{
+			name:         "task overrides the percentage of nodes to find",
+			task:         &api.TaskInfo{PredicateOverrides: &api.PredicateOverrides{PercentageOfNodesToFind: &percentage}},
+			wantNumNodes: 1000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotNumNodes := CalculateNumOfFeasibleNodesToFindForTask(tt.task, 1000); gotNumNodes != tt.wantNumNodes {
+				t.Errorf("CalculateNumOfFeasibleNodesToFindForTask() = %v, want %v", gotNumNodes, tt.wantNumNodes)
+			}
+		})
+	}
+}
+
 func TestSelectBestNodes(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -34,6 +34,7 @@ import (
 	fwk "k8s.io/kube-scheduler/framework"
 	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
 
+	schedulingv1alpha1 "volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	nodeshardv1alpha1 "volcano.sh/apis/pkg/apis/shard/v1alpha1"
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -644,6 +645,12 @@ func (ftsu *FakeStatusUpdater) UpdateQueueStatus(queue *api.QueueInfo) error {
 	return nil
 }
 
+// UpdateReservationStatus do fake empty update for reservation status
+func (ftsu *FakeStatusUpdater) UpdateReservationStatus(reservation *schedulingv1alpha1.Reservation) (*schedulingv1alpha1.Reservation, error) {
+	// do nothing here
+	return reservation, nil
+}
+
 // UpdateNodeShardStatus do fake empty update for node shard status
 func (ftsu *FakeStatusUpdater) UpdateNodeShardStatus(nodeshard *nodeshardv1alpha1.NodeShard) (*nodeshardv1alpha1.NodeShard, error) {
 	// do nothing here
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	commonutil "volcano.sh/volcano/pkg/util"
+)
+
+// nodeTieBreakersEnabled returns whether node tie-breakers are configured, the nodes with the same score are
+// picked randomly otherwise.
+func nodeTieBreakersEnabled() bool {
+	return options.ServerOpts != nil && len(options.ServerOpts.NodeTieBreakers) > 0
+}
+
+// OrderTiedNodes sorts the nodes with the same score in place by the configured node tie-breakers, the node name
+// breaking the remaining ties, so that the same node is picked for the same state whatever the order of the nodes.
+// It returns false and leaves the nodes untouched if no tie-breaker is configured.
+func OrderTiedNodes(nodes []*api.NodeInfo) bool {
+	if !nodeTieBreakersEnabled() {
+		return false
+	}
+
+	tieBreakers := options.ServerOpts.NodeTieBreakers
+	powerEfficiencyLabel := options.ServerOpts.PowerEfficiencyLabel
+	sort.SliceStable(nodes, func(i, j int) bool {
+		for _, tieBreaker := range tieBreakers {
+			switch tieBreaker {
+			case commonutil.NodeNameHashTieBreaker:
+				if hi, hj := nodeNameHash(nodes[i].Name), nodeNameHash(nodes[j].Name); hi != hj {
+					return hi < hj
+				}
+			case commonutil.LeastRecentlyBoundTieBreaker:
+				if !nodes[i].LastBound.Equal(&nodes[j].LastBound) {
+					return nodes[i].LastBound.Before(&nodes[j].LastBound)
+				}
+			case commonutil.PowerEfficiencyTieBreaker:
+				if ei, ej := powerEfficiency(nodes[i], powerEfficiencyLabel), powerEfficiency(nodes[j], powerEfficiencyLabel); ei != ej {
+					return ei > ej
+				}
+			}
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+	return true
+}
+
+func nodeNameHash(name string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return h.Sum32()
+}
+
+// powerEfficiency returns the power efficiency of the node from its label, the nodes without a valid label are
+// the least efficient.
+func powerEfficiency(node *api.NodeInfo, label string) float64 {
+	if node.Node == nil {
+		return math.Inf(-1)
+	}
+	value, err := strconv.ParseFloat(node.Node.Labels[label], 64)
+	if err != nil || math.IsNaN(value) {
+		return math.Inf(-1)
+	}
+	return value
+}
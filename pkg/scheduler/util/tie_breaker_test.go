@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	commonutil "volcano.sh/volcano/pkg/util"
+)
+
+func TestOrderTiedNodes(t *testing.T) {
+	defer func(opts *options.ServerOption) { options.ServerOpts = opts }(options.ServerOpts)
+
+	now := time.Now()
+	newNode := func(name string, lastBound time.Time, labels map[string]string) *api.NodeInfo {
+		node := api.NewNodeInfo(BuildNode(name, nil, labels))
+		node.LastBound = metav1.NewTime(lastBound)
+		return node
+	}
+	nodeNames := func(nodes []*api.NodeInfo) []string {
+		var names []string
+		for _, node := range nodes {
+			names = append(names, node.Name)
+		}
+		return names
+	}
+	label := "volcano.sh/power-efficiency"
+
+	tests := []struct {
+		name        string
+		tieBreakers []string
+		nodes       []*api.NodeInfo
+		ordered     bool
+		expected    []string
+	}{
+		{
+			name:     "no tie-breaker leaves the nodes untouched",
+			nodes:    []*api.NodeInfo{newNode("n2", now, nil), newNode("n1", now, nil)},
+			expected: []string{"n2", "n1"},
+		},
+		{
+			name:        "node name hash",
+			tieBreakers: []string{commonutil.NodeNameHashTieBreaker},
+			nodes:       []*api.NodeInfo{newNode("n1", now, nil), newNode("n2", now, nil), newNode("n3", now, nil)},
+			ordered:     true,
+			// fnv32a: n1 < n3 < n2
+			expected: []string{"n1", "n3", "n2"},
+		},
+		{
+			name:        "least recently bound, never bound first, ties broken by name",
+			tieBreakers: []string{commonutil.LeastRecentlyBoundTieBreaker},
+			nodes: []*api.NodeInfo{
+				newNode("n1", now, nil),
+				newNode("n2", now.Add(-time.Minute), nil),
+				newNode("n4", time.Time{}, nil),
+				newNode("n3", time.Time{}, nil),
+			},
+			ordered:  true,
+			expected: []string{"n3", "n4", "n2", "n1"},
+		},
+		{
+			name:        "power efficiency, invalid or missing labels last",
+			tieBreakers: []string{commonutil.PowerEfficiencyTieBreaker},
+			nodes: []*api.NodeInfo{
+				newNode("n1", now, map[string]string{label: "0.5"}),
+				newNode("n2", now, nil),
+				newNode("n3", now, map[string]string{label: "invalid"}),
+				newNode("n4", now, map[string]string{label: "0.9"}),
+			},
+			ordered:  true,
+			expected: []string{"n4", "n1", "n2", "n3"},
+		},
+		{
+			name:        "tie-breakers applied in order",
+			tieBreakers: []string{commonutil.PowerEfficiencyTieBreaker, commonutil.LeastRecentlyBoundTieBreaker},
+			nodes: []*api.NodeInfo{
+				newNode("n1", now, map[string]string{label: "0.9"}),
+				newNode("n2", now.Add(-time.Minute), map[string]string{label: "0.9"}),
+				newNode("n3", time.Time{}, map[string]string{label: "0.5"}),
+			},
+			ordered:  true,
+			expected: []string{"n2", "n1", "n3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options.ServerOpts = &options.ServerOption{
+				NodeTieBreakers:      tt.tieBreakers,
+				PowerEfficiencyLabel: label,
+			}
+			assert.Equal(t, tt.ordered, OrderTiedNodes(tt.nodes))
+			assert.Equal(t, tt.expected, nodeNames(tt.nodes))
+		})
+	}
+}
+
+func TestSelectBestNodeWithTieBreakers(t *testing.T) {
+	defer func(opts *options.ServerOption) { options.ServerOpts = opts }(options.ServerOpts)
+	options.ServerOpts = &options.ServerOption{NodeTieBreakers: []string{commonutil.NodeNameHashTieBreaker}}
+
+	// the same node is picked every time among the nodes with the highest score
+	for i := 0; i < 10; i++ {
+		nodeScores := map[float64][]*api.NodeInfo{
+			1: {api.NewNodeInfo(BuildNode("n0", nil, nil))},
+			2: {
+				api.NewNodeInfo(BuildNode("n1", nil, nil)),
+				api.NewNodeInfo(BuildNode("n2", nil, nil)),
+				api.NewNodeInfo(BuildNode("n3", nil, nil)),
+			},
+		}
+		node, score := SelectBestNodeAndScore(nodeScores)
+		assert.Equal(t, "n1", node.Name)
+		assert.Equal(t, float64(2), score)
+
+		nodes := SelectBestNodes(nodeScores, 2, nil)
+		assert.Equal(t, []string{"n1", "n3"}, []string{nodes[0].Name, nodes[1].Name})
+	}
+}
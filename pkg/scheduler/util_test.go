@@ -21,11 +21,19 @@ limitations under the License.
 package scheduler
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"k8s.io/utils/ptr"
+
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 
 	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 )
 
@@ -253,3 +261,530 @@ tiers:
 			expectedConfigurations, configurations)
 	}
 }
+
+func TestUnmarshalPipelinesConf(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected map[string][]string
+		wantErr  bool
+	}{
+		{
+			name:     "no pipelines by default",
+			config:   `actions: "enqueue, allocate, backfill"`,
+			expected: map[string][]string{},
+		},
+		{
+			name: "pipelines of queue classes",
+			config: `
+actions: "enqueue, allocate, backfill"
+pipelines:
+- queueClass: prod
+  actions: "enqueue, allocate, preempt, reclaim"
+- queueClass: batch
+  actions: "allocate, backfill"
+`,
+			expected: map[string][]string{
+				"prod":  {"enqueue", "allocate", "preempt", "reclaim"},
+				"batch": {"allocate", "backfill"},
+			},
+		},
+		{
+			name: "unknown action",
+			config: `
+pipelines:
+- queueClass: prod
+  actions: "enqueue, alocate"
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate queue class",
+			config: `
+pipelines:
+- queueClass: prod
+  actions: "allocate"
+- queueClass: prod
+  actions: "preempt"
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing queue class",
+			config: `
+pipelines:
+- actions: "allocate"
+`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pipelines, err := UnmarshalPipelinesConf(test.config)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if test.wantErr {
+				return
+			}
+			actions := map[string][]string{}
+			for _, pipeline := range pipelines {
+				for _, action := range pipeline.Actions {
+					actions[pipeline.Class] = append(actions[pipeline.Class], action.Name())
+				}
+			}
+			if !equality.Semantic.DeepEqual(actions, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actions)
+			}
+		})
+	}
+}
+
+func TestUnmarshalProfilesConf(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected map[string][]string
+		wantErr  bool
+	}{
+		{
+			name:     "no profiles by default",
+			config:   `actions: "enqueue, allocate, backfill"`,
+			expected: map[string][]string{},
+		},
+		{
+			name: "profiles with their actions and plugins",
+			config: `
+actions: "enqueue, allocate, backfill"
+profiles:
+- name: training
+  actions: "enqueue, allocate"
+  tiers:
+  - plugins:
+    - name: gang
+    - name: proportion
+- name: web
+  actions: "allocate, preempt"
+  tiers:
+  - plugins:
+    - name: priority
+`,
+			expected: map[string][]string{
+				"training": {"enqueue", "allocate", "gang", "proportion"},
+				"web":      {"allocate", "preempt", "priority"},
+			},
+		},
+		{
+			name: "unknown action",
+			config: `
+profiles:
+- name: training
+  actions: "enqueue, alocate"
+`,
+			wantErr: true,
+		},
+		{
+			name: "conflicting plugins",
+			config: `
+profiles:
+- name: training
+  actions: "allocate"
+  tiers:
+  - plugins:
+    - name: drf
+      enableHierarchy: true
+    - name: proportion
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate profile",
+			config: `
+profiles:
+- name: training
+  actions: "allocate"
+- name: training
+  actions: "preempt"
+`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			profiles, err := UnmarshalProfilesConf(test.config)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if test.wantErr {
+				return
+			}
+			names := map[string][]string{}
+			for _, profile := range profiles {
+				for _, action := range profile.Actions {
+					names[profile.Name] = append(names[profile.Name], action.Name())
+				}
+				for _, tier := range profile.Tiers {
+					for _, plugin := range tier.Plugins {
+						names[profile.Name] = append(names[profile.Name], plugin.Name)
+					}
+				}
+			}
+			if !equality.Semantic.DeepEqual(names, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, names)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTracingConf(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected *conf.TracingConfiguration
+		wantErr  bool
+	}{
+		{
+			name:   "tracing disabled by default",
+			config: `actions: "allocate"`,
+		},
+		{
+			name: "tracing with endpoint and sampling rate",
+			config: `
+actions: "allocate"
+tracing:
+  endpoint: collector:4317
+  samplingRatePerMillion: 100
+`,
+			expected: &conf.TracingConfiguration{Endpoint: "collector:4317", SamplingRatePerMillion: ptr.To[int32](100)},
+		},
+		{
+			name: "invalid sampling rate",
+			config: `
+tracing:
+  samplingRatePerMillion: 2000000
+`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tracing, err := UnmarshalTracingConf(test.config)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !equality.Semantic.DeepEqual(tracing, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, tracing)
+			}
+		})
+	}
+}
+
+func TestUnmarshalAuditConf(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected *conf.AuditConfiguration
+		wantErr  bool
+	}{
+		{
+			name:   "audit disabled by default",
+			config: `actions: "allocate"`,
+		},
+		{
+			name: "audit to file and webhook",
+			config: `
+audit:
+  file: /var/log/volcano/audit.log
+  webhook: https://audit.example.com/records
+`,
+			expected: &conf.AuditConfiguration{File: "/var/log/volcano/audit.log", Webhook: "https://audit.example.com/records"},
+		},
+		{
+			name: "audit without sink",
+			config: `
+audit: {}
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid webhook",
+			config: `
+audit:
+  webhook: audit.example.com
+`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auditConf, err := UnmarshalAuditConf(test.config)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !equality.Semantic.DeepEqual(auditConf, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, auditConf)
+			}
+		})
+	}
+}
+
+func TestUnmarshalStarvationConf(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		expected  *conf.StarvationConfiguration
+		threshold time.Duration
+		wantErr   bool
+	}{
+		{
+			name:   "starvation detection disabled by default",
+			config: `actions: "allocate"`,
+		},
+		{
+			name: "starvation threshold triggering the plugins",
+			config: `
+starvation:
+  threshold: 30m
+  triggerPlugins: true
+`,
+			expected:  &conf.StarvationConfiguration{Threshold: "30m", TriggerPlugins: true},
+			threshold: 30 * time.Minute,
+		},
+		{
+			name: "starvation without threshold",
+			config: `
+starvation: {}
+`,
+			wantErr: true,
+		},
+		{
+			name: "negative threshold",
+			config: `
+starvation:
+  threshold: -5m
+`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			starvationConf, threshold, err := UnmarshalStarvationConf(test.config)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !equality.Semantic.DeepEqual(starvationConf, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, starvationConf)
+			}
+			if threshold != test.threshold {
+				t.Errorf("expected threshold %v, got %v", test.threshold, threshold)
+			}
+		})
+	}
+}
+
+func TestUnmarshalResizeConf(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected *conf.ResizeConfiguration
+	}{
+		{
+			name:   "resize review disabled by default",
+			config: `actions: "allocate"`,
+		},
+		{
+			name: "denied resizes reverted",
+			config: `
+resize:
+  revertDenied: true
+`,
+			expected: &conf.ResizeConfiguration{RevertDenied: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resizeConf, err := UnmarshalResizeConf(test.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(resizeConf, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, resizeConf)
+			}
+		})
+	}
+}
+
+func TestUnmarshalResourceAliasesConf(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected map[v1.ResourceName]api.ResourceAlias
+		wantErr  bool
+	}{
+		{
+			name:   "no resource aliases by default",
+			config: `actions: "allocate"`,
+		},
+		{
+			name: "gpu models accounted in nvidia.com/gpu",
+			config: `
+resourceAliases:
+- name: nvidia.com/A100
+  resource: nvidia.com/gpu
+- name: nvidia.com/H100
+  resource: nvidia.com/gpu
+  ratio: 2
+`,
+			expected: map[v1.ResourceName]api.ResourceAlias{
+				"nvidia.com/A100": {Resource: "nvidia.com/gpu", Ratio: 1},
+				"nvidia.com/H100": {Resource: "nvidia.com/gpu", Ratio: 2},
+			},
+		},
+		{
+			name: "alias without resource",
+			config: `
+resourceAliases:
+- name: nvidia.com/A100
+`,
+			wantErr: true,
+		},
+		{
+			name: "alias of cpu",
+			config: `
+resourceAliases:
+- name: example.com/vcpu
+  resource: cpu
+`,
+			wantErr: true,
+		},
+		{
+			name: "negative ratio",
+			config: `
+resourceAliases:
+- name: nvidia.com/A100
+  resource: nvidia.com/gpu
+  ratio: -1
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicated alias",
+			config: `
+resourceAliases:
+- name: nvidia.com/A100
+  resource: nvidia.com/gpu
+- name: nvidia.com/A100
+  resource: example.com/gpu
+`,
+			wantErr: true,
+		},
+		{
+			name: "chained aliases",
+			config: `
+resourceAliases:
+- name: nvidia.com/A100-80G
+  resource: nvidia.com/A100
+- name: nvidia.com/A100
+  resource: nvidia.com/gpu
+`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aliases, err := UnmarshalResourceAliasesConf(test.config)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !equality.Semantic.DeepEqual(aliases, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, aliases)
+			}
+		})
+	}
+}
+
+func TestValidateSchedulerConf(t *testing.T) {
+	for _, file := range []string{"volcano-scheduler.conf", "volcano-scheduler-ci.conf"} {
+		t.Run(file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("../../installer/helm/chart/volcano/config", file))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", file, err)
+			}
+			if err := ValidateSchedulerConf(string(data)); err != nil {
+				t.Errorf("expected the installed configuration to be valid, got %v", err)
+			}
+		})
+	}
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:   "default configuration",
+			config: DefaultSchedulerConf,
+		},
+		{
+			name: "unknown field",
+			config: `
+actions: "allocate"
+tier:
+- plugins:
+  - name: gang
+`,
+			wantErr: "field tier not found",
+		},
+		{
+			name: "unknown plugin",
+			config: `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: gangs
+`,
+			wantErr: "failed to find Plugin gangs",
+		},
+		{
+			name: "configuration of unknown action",
+			config: `
+actions: "allocate"
+configurations:
+- name: allocat
+  arguments:
+    predicateErrorCacheEnable: true
+`,
+			wantErr: "failed to find Action allocat of the configurations",
+		},
+		{
+			name: "unknown action",
+			config: `
+actions: "allocate, backfil"
+`,
+			wantErr: "failed to find Action  backfil",
+		},
+		{
+			name: "invalid tracing",
+			config: `
+actions: "allocate"
+tracing:
+  samplingRatePerMillion: 2000000
+`,
+			wantErr: "invalid tracing samplingRatePerMillion",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSchedulerConf(test.config)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
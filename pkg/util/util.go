@@ -41,6 +41,13 @@ const (
 	HardShardingMode           = "hard"
 	SoftShardingMode           = "soft"
 	NoneShardingMode           = "none"
+
+	// NodeNameHashTieBreaker prefers the node with the lowest FNV hash of its name among the nodes with the same score.
+	NodeNameHashTieBreaker = "node-name-hash"
+	// LeastRecentlyBoundTieBreaker prefers the node a task was bound to the longest time ago among the nodes with the same score.
+	LeastRecentlyBoundTieBreaker = "least-recently-bound"
+	// PowerEfficiencyTieBreaker prefers the node with the highest power efficiency label among the nodes with the same score.
+	PowerEfficiencyTieBreaker = "power-efficiency"
 )
 
 var (
@@ -23,6 +23,7 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	whv1 "k8s.io/api/admissionregistration/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
@@ -93,7 +94,7 @@ func Jobs(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	var patchBytes []byte
 	switch ar.Request.Operation {
 	case admissionv1.Create:
-		patchBytes, _ = createPatch(job)
+		patchBytes, _ = createPatch(job, ar.Request.UserInfo)
 	default:
 		err = fmt.Errorf("expect operation to be 'CREATE' ")
 		return util.ToAdmissionResponse(err)
@@ -112,8 +113,12 @@ func Jobs(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	return &reviewResponse
 }
 
-func createPatch(job *v1alpha1.Job) ([]byte, error) {
+func createPatch(job *v1alpha1.Job, userInfo authenticationv1.UserInfo) ([]byte, error) {
 	var patch []patchOperation
+	pathSubmitter := patchSubmitter(job, userInfo)
+	if pathSubmitter != nil {
+		patch = append(patch, *pathSubmitter)
+	}
 	pathQueue := patchDefaultQueue(job)
 	if pathQueue != nil {
 		patch = append(patch, *pathQueue)
@@ -143,9 +148,22 @@ func createPatch(job *v1alpha1.Job) ([]byte, error) {
 }
 
 func patchDefaultQueue(job *v1alpha1.Job) *patchOperation {
-	//Add default queue if not specified.
+	//Add default queue of the namespace if not specified.
 	if job.Spec.Queue == "" {
-		return &patchOperation{Op: "add", Path: "/spec/queue", Value: DefaultQueue}
+		queue, err := util.NamespaceDefaultQueue(config.KubeClient, config.QueueLister, job.Namespace)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the default queue of namespace", "namespace", job.Namespace)
+			queue = DefaultQueue
+		}
+		return &patchOperation{Op: "add", Path: "/spec/queue", Value: queue}
+	}
+	return nil
+}
+
+func patchSubmitter(job *v1alpha1.Job, userInfo authenticationv1.UserInfo) *patchOperation {
+	// Record the user submitting the job, for the sub-quotas of its queue.
+	if annotations := util.SubmitterAnnotations(job.Annotations, userInfo); annotations != nil {
+		return &patchOperation{Op: "add", Path: "/metadata/annotations", Value: annotations}
 	}
 	return nil
 }
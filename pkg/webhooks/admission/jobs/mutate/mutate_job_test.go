@@ -17,13 +17,20 @@ limitations under the License.
 package mutate
 
 import (
+	"reflect"
 	"testing"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakeclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informers "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/webhooks/router"
 )
 
 func TestCreatePatchExecution(t *testing.T) {
@@ -480,3 +487,106 @@ func TestMutateSpecPartitionPolicyDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchDefaultQueue(t *testing.T) {
+	teamQueue := &schedulingv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: schedulingv1beta1.QueueSpec{
+			NamespacePolicy: &schedulingv1beta1.QueueNamespacePolicy{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				Default:           true,
+			},
+		},
+	}
+	queueInformer := informers.NewSharedInformerFactory(fakeclient.NewSimpleClientset(), 0).Scheduling().V1beta1().Queues()
+	if err := queueInformer.Informer().GetIndexer().Add(teamQueue); err != nil {
+		t.Fatalf("failed to add queue: %v", err)
+	}
+	config = &router.AdmissionServiceConfig{
+		KubeClient: kubefake.NewSimpleClientset(
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-a", Labels: map[string]string{"team": "a"}}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-b", Labels: map[string]string{"team": "b"}}},
+		),
+		QueueLister: queueInformer.Lister(),
+	}
+
+	testCases := []struct {
+		Name      string
+		Namespace string
+		Queue     string
+		Expected  interface{}
+	}{
+		{Name: "namespace with a default queue", Namespace: "ns-a", Expected: "team-a"},
+		{Name: "namespace without default queue", Namespace: "ns-b", Expected: DefaultQueue},
+		{Name: "unknown namespace", Namespace: "ns-c", Expected: DefaultQueue},
+		{Name: "job with a queue", Namespace: "ns-a", Queue: "other"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: testCase.Namespace},
+				Spec:       v1alpha1.JobSpec{Queue: testCase.Queue},
+			}
+			ret := patchDefaultQueue(job)
+			if testCase.Expected == nil {
+				if ret != nil {
+					t.Errorf("expected no patch, but got %v", *ret)
+				}
+				return
+			}
+			if ret == nil || ret.Path != "/spec/queue" || ret.Value != testCase.Expected {
+				t.Errorf("expected queue %v to be patched, but got %v", testCase.Expected, ret)
+			}
+		})
+	}
+}
+
+func TestPatchSubmitter(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Annotations map[string]string
+		UserInfo    authenticationv1.UserInfo
+		Expected    map[string]string
+	}{
+		{
+			Name:        "user with groups",
+			Annotations: map[string]string{"foo": "bar"},
+			UserInfo:    authenticationv1.UserInfo{Username: "alice", Groups: []string{"ml", "system:authenticated"}},
+			Expected: map[string]string{
+				"foo":                                    "bar",
+				schedulingv1beta1.SubmitterAnnotationKey: "alice",
+				schedulingv1beta1.SubmitterGroupsAnnotationKey: "ml,system:authenticated",
+			},
+		},
+		{
+			Name: "submitter set by the user is overwritten",
+			Annotations: map[string]string{
+				schedulingv1beta1.SubmitterAnnotationKey:       "bob",
+				schedulingv1beta1.SubmitterGroupsAnnotationKey: "admins",
+			},
+			UserInfo: authenticationv1.UserInfo{Username: "alice"},
+			Expected: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+		},
+		{
+			Name:     "request without user",
+			UserInfo: authenticationv1.UserInfo{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "test", Annotations: testCase.Annotations}}
+			ret := patchSubmitter(job, testCase.UserInfo)
+			if testCase.Expected == nil {
+				if ret != nil {
+					t.Errorf("expected no patch, but got %v", *ret)
+				}
+				return
+			}
+			if ret == nil || ret.Path != "/metadata/annotations" || !reflect.DeepEqual(ret.Value, testCase.Expected) {
+				t.Errorf("expected annotations %v to be patched, but got %v", testCase.Expected, ret)
+			}
+		})
+	}
+}
@@ -176,6 +176,11 @@ func validateJobCreate(job *v1alpha1.Job, reviewResponse *admissionv1.AdmissionR
 	}
 
 	b.WriteString(validateJobName(job))
+	b.WriteString(validateAutoscaling(job))
+
+	if err := util.ValidatePredicateOverrides(job.Annotations); err != nil {
+		fmt.Fprintf(&b, " %v;", err)
+	}
 
 	if totalReplicas < job.Spec.MinAvailable {
 		b.WriteString(" job 'minAvailable' should not be greater than total replicas in tasks;")
@@ -207,6 +212,12 @@ func validateJobCreate(job *v1alpha1.Job, reviewResponse *admissionv1.AdmissionR
 			fmt.Fprintf(&b, " can only submit job to queue with state `Open`, "+
 				"queue `%s` status is `%s`;", queue.Name, queue.Status.State)
 		}
+		if queue.DeletionTimestamp != nil {
+			fmt.Fprintf(&b, " can not submit job to queue `%s` being deleted;", queue.Name)
+		}
+		if err := util.ValidateQueueNamespace(config.KubeClient, config.QueueLister, queue, job.Namespace); err != nil {
+			fmt.Fprintf(&b, " %v;", err)
+		}
 
 		// validate hierarchical queue
 		if queue.Name == "root" {
@@ -272,9 +283,23 @@ func validateJobUpdate(old, new *v1alpha1.Job) error {
 	if len(old.Spec.Tasks) != len(new.Spec.Tasks) {
 		return fmt.Errorf("job updates may not add or remove tasks")
 	}
+	if msg := validateAutoscaling(new); msg != "" {
+		return fmt.Errorf("%s", strings.TrimSpace(msg))
+	}
+	if err := util.ValidatePredicateOverrides(new.Annotations); err != nil {
+		return err
+	}
+	if err := util.ValidateSubmitterUpdate(old.Annotations, new.Annotations); err != nil {
+		return err
+	}
 	// other fields under spec are not allowed to mutate
 	new.Spec.MinAvailable = old.Spec.MinAvailable
 	new.Spec.PriorityClassName = old.Spec.PriorityClassName
+	new.Spec.Autoscaling = old.Spec.Autoscaling
+	// the queue controller moves the jobs of a queue deleted with the Orphan policy to its fallback queue
+	if isOrphanedToQueue(old.Spec.Queue, new.Spec.Queue) {
+		new.Spec.Queue = old.Spec.Queue
+	}
 
 	// K8S also permit mutating spec.schedulingGates
 	// We do not support this for vcjob  (More details in design doc pod-scheduling-readiness.md)
@@ -297,12 +322,26 @@ func validateJobUpdate(old, new *v1alpha1.Job) error {
 	}
 
 	if !apiequality.Semantic.DeepEqual(new.Spec, old.Spec) {
-		return fmt.Errorf("job updates may not change fields other than `minAvailable`, `tasks[*].replicas under spec` and `PriorityClassName`")
+		return fmt.Errorf("job updates may not change fields other than `minAvailable`, `tasks[*].replicas under spec`, `autoscaling` and `PriorityClassName`")
 	}
 
 	return nil
 }
 
+// isOrphanedToQueue returns whether the queue is being deleted with the Orphan policy, its jobs being moved to the
+// fallback queue.
+func isOrphanedToQueue(queueName, fallbackQueueName string) bool {
+	if queueName == fallbackQueueName || config.QueueLister == nil {
+		return false
+	}
+	queue, err := config.QueueLister.Get(queueName)
+	if err != nil || queue.DeletionTimestamp == nil {
+		return false
+	}
+	policy := queue.Spec.DeletionPolicy
+	return policy != nil && policy.Type == schedulingv1beta1.QueueDeletionOrphan && policy.FallbackQueue == fallbackQueueName
+}
+
 func validatePartitionPolicy(task v1alpha1.TaskSpec, job *v1alpha1.Job) string {
 	var msg string
 	if task.PartitionPolicy != nil {
@@ -324,6 +363,21 @@ func validatePartitionPolicy(task v1alpha1.TaskSpec, job *v1alpha1.Job) string {
 	return msg
 }
 
+// validateAutoscaling checks that the task scaled by the scale subresource of the job exists and can be scaled.
+func validateAutoscaling(job *v1alpha1.Job) string {
+	if job.Spec.Autoscaling == nil {
+		return ""
+	}
+	index := jobhelpers.GetAutoscaledTaskIndex(job)
+	if index < 0 {
+		return fmt.Sprintf(" autoscaled task %s not found in job: %s;", job.Spec.Autoscaling.TaskName, job.Name)
+	}
+	if job.Spec.Tasks[index].PartitionPolicy != nil {
+		return fmt.Sprintf(" task %s with 'partitionPolicy' can not be autoscaled, job: %s;", job.Spec.Tasks[index].Name, job.Name)
+	}
+	return ""
+}
+
 func validateNetworkTopology(networkTopology *v1alpha1.NetworkTopologySpec) string {
 	if networkTopology != nil && networkTopology.HighestTierAllowed != nil && networkTopology.HighestTierName != "" {
 		return "must not specify 'highestTierAllowed' and 'highestTierName' in networkTopology simultaneously"
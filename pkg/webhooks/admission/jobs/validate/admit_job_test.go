@@ -26,8 +26,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/utils/ptr"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
@@ -2270,6 +2272,115 @@ func TestValidateHierarchyCreate(t *testing.T) {
 	}
 }
 
+func TestValidateQueueNamespaceCreate(t *testing.T) {
+	openStatus := schedulingv1beta2.QueueStatus{State: schedulingv1beta2.QueueStateOpen}
+	teamQueue := &schedulingv1beta2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: schedulingv1beta2.QueueSpec{
+			Parent: "root",
+			NamespacePolicy: &schedulingv1beta2.QueueNamespacePolicy{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+		},
+		Status: openStatus,
+	}
+	childQueue := &schedulingv1beta2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-child"},
+		Spec:       schedulingv1beta2.QueueSpec{Parent: "team"},
+		Status:     openStatus,
+	}
+	sharedQueue := &schedulingv1beta2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+		Spec:       schedulingv1beta2.QueueSpec{Parent: "root"},
+		Status:     openStatus,
+	}
+	rootQueue := &schedulingv1beta2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "root"},
+		Status:     openStatus,
+	}
+
+	config.KubeClient = kubefake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-a", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns-b", Labels: map[string]string{"team": "b"}}},
+	)
+	config.VolcanoClient = fakeclient.NewSimpleClientset(rootQueue, teamQueue, childQueue, sharedQueue)
+	informerFactory := informers.NewSharedInformerFactory(config.VolcanoClient, 0)
+	queueInformer := informerFactory.Scheduling().V1beta1().Queues()
+	config.QueueLister = queueInformer.Lister()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	for informerType, ok := range informerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			panic(fmt.Errorf("failed to sync cache: %v", informerType))
+		}
+	}
+
+	testCases := []struct {
+		Name      string
+		Namespace string
+		Queue     string
+		ExpectErr string
+	}{
+		{
+			Name:      "namespace selected by the policy of the ancestor",
+			Namespace: "ns-a",
+			Queue:     "team-child",
+		},
+		{
+			Name:      "namespace not selected by the policy of the ancestor",
+			Namespace: "ns-b",
+			Queue:     "team-child",
+			ExpectErr: "namespace ns-b is not allowed to submit to queue team by its namespace policy",
+		},
+		{
+			Name:      "queue without namespace policy",
+			Namespace: "ns-b",
+			Queue:     "shared",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: testCase.Namespace},
+				Spec: v1alpha1.JobSpec{
+					MinAvailable: 1,
+					Queue:        testCase.Queue,
+					Tasks: []v1alpha1.TaskSpec{
+						{
+							Name:     "task-1",
+							Replicas: 1,
+							Template: v1.PodTemplateSpec{
+								ObjectMeta: metav1.ObjectMeta{
+									Labels: map[string]string{"name": "test"},
+								},
+								Spec: v1.PodSpec{
+									Containers: []v1.Container{{Name: "fake-name", Image: "busybox:1.24"}},
+								},
+							},
+						},
+					},
+				},
+			}
+			reviewResponse := &admissionv1.AdmissionResponse{Allowed: true}
+
+			ret := validateJobCreate(job, reviewResponse)
+
+			if testCase.ExpectErr == "" && ret != "" {
+				t.Errorf("Expect no error, but got error %v", ret)
+			}
+			if testCase.ExpectErr != "" && !strings.Contains(ret, testCase.ExpectErr) {
+				t.Errorf("Expect error msg :%s, but got %v", testCase.ExpectErr, ret)
+			}
+			if reviewResponse.Allowed != (testCase.ExpectErr == "") {
+				t.Errorf("Expect Allowed as %v but got %v", testCase.ExpectErr == "", reviewResponse.Allowed)
+			}
+		})
+	}
+}
+
 func TestValidateJobUpdate(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -2278,6 +2389,7 @@ func TestValidateJobUpdate(t *testing.T) {
 		addTask        bool
 		mutateTaskName bool
 		mutateSpec     bool
+		autoscaling    *v1alpha1.AutoscalingSpec
 		expectErr      bool
 	}{
 		{
@@ -2336,6 +2448,20 @@ func TestValidateJobUpdate(t *testing.T) {
 			mutateSpec:     true,
 			expectErr:      true,
 		},
+		{
+			name:         "mutate autoscaling",
+			replicas:     5,
+			minAvailable: 5,
+			autoscaling:  &v1alpha1.AutoscalingSpec{TaskName: "task-1", Replicas: ptr.To[int32](8), WaveSize: 4},
+			expectErr:    false,
+		},
+		{
+			name:         "invalid autoscaled task not found",
+			replicas:     5,
+			minAvailable: 5,
+			autoscaling:  &v1alpha1.AutoscalingSpec{TaskName: "task-2"},
+			expectErr:    true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2373,6 +2499,57 @@ func TestValidateJobUpdate(t *testing.T) {
 			if tc.mutateSpec {
 				new.Spec.Queue = "mutated-queue"
 			}
+			new.Spec.Autoscaling = tc.autoscaling
+
+			err := validateJobUpdate(old, new)
+			if err != nil && !tc.expectErr {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+			if err == nil && tc.expectErr {
+				t.Errorf("Expected error, but got none")
+			}
+		})
+	}
+
+}
+
+func TestValidateJobUpdateOrphanedQueue(t *testing.T) {
+	now := metav1.Now()
+	deleting := &schedulingv1beta2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", DeletionTimestamp: &now},
+		Spec: schedulingv1beta2.QueueSpec{DeletionPolicy: &schedulingv1beta2.QueueDeletionPolicy{
+			Type:          schedulingv1beta2.QueueDeletionOrphan,
+			FallbackQueue: "fallback",
+		}},
+	}
+	informerFactory := informers.NewSharedInformerFactory(fakeclient.NewSimpleClientset(), 0)
+	queueInformer := informerFactory.Scheduling().V1beta1().Queues()
+	if err := queueInformer.Informer().GetIndexer().Add(deleting); err != nil {
+		t.Fatalf("failed to add queue: %v", err)
+	}
+	config.QueueLister = queueInformer.Lister()
+
+	testCases := []struct {
+		name      string
+		queue     string
+		expectErr bool
+	}{
+		{
+			name:  "job moved to the fallback queue of its deleted queue",
+			queue: "fallback",
+		},
+		{
+			name:      "job moved to another queue",
+			queue:     "other",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			old := newJob()
+			new := newJob()
+			new.Spec.Queue = tc.queue
 
 			err := validateJobUpdate(old, new)
 			if err != nil && !tc.expectErr {
@@ -2383,7 +2560,41 @@ func TestValidateJobUpdate(t *testing.T) {
 			}
 		})
 	}
+}
+
+func TestValidateJobUpdateSubmitter(t *testing.T) {
+	testCases := []struct {
+		name      string
+		submitter string
+		expectErr bool
+	}{
+		{
+			name:      "submitter is kept",
+			submitter: "alice",
+		},
+		{
+			name:      "submitter is changed",
+			submitter: "mallory",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			old := newJob()
+			old.Annotations = map[string]string{schedulingv1beta2.SubmitterAnnotationKey: "alice"}
+			new := newJob()
+			new.Annotations = map[string]string{schedulingv1beta2.SubmitterAnnotationKey: tc.submitter}
 
+			err := validateJobUpdate(old, new)
+			if err != nil && !tc.expectErr {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+			if err == nil && tc.expectErr {
+				t.Errorf("Expected error, but got none")
+			}
+		})
+	}
 }
 
 func newJob() *v1alpha1.Job {
@@ -57,6 +57,7 @@ var policyActionMap = map[busv1alpha1.Action]bool{
 	busv1alpha1.SyncQueueAction:        false,
 	busv1alpha1.OpenQueueAction:        false,
 	busv1alpha1.CloseQueueAction:       false,
+	busv1alpha1.DrainQueueAction:       false,
 }
 
 func validatePolicies(policies []batchv1alpha1.LifecyclePolicy, fldPath *field.Path) error {
@@ -17,12 +17,12 @@ limitations under the License.
 package mutate
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	whv1 "k8s.io/api/admissionregistration/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
@@ -77,7 +77,7 @@ func PodGroups(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	var patchBytes []byte
 	switch ar.Request.Operation {
 	case admissionv1.Create:
-		patchBytes, err = createPodGroupPatch(podgroup)
+		patchBytes, err = createPodGroupPatch(podgroup, ar.Request.UserInfo)
 	default:
 		return util.ToAdmissionResponse(fmt.Errorf("invalid operation `%s`, "+
 			"expect operation to be `CREATE`", ar.Request.Operation))
@@ -101,25 +101,36 @@ func PodGroups(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	return &reviewResponse
 }
 
-func createPodGroupPatch(podgroup *schedulingv1beta1.PodGroup) ([]byte, error) {
-	if podgroup.Spec.Queue != schedulingv1beta1.DefaultQueue {
-		return nil, nil
-	}
-	ns, err := config.KubeClient.CoreV1().Namespaces().Get(context.TODO(), podgroup.Namespace, metav1.GetOptions{})
-	if err != nil {
-		klog.ErrorS(err, "Failed to get namespace", "namespace", podgroup.Namespace)
-		return nil, nil
+func createPodGroupPatch(podgroup *schedulingv1beta1.PodGroup, userInfo authenticationv1.UserInfo) ([]byte, error) {
+	var patch []patchOperation
+	// The volcano controllers create the podgroups of jobs with the submitter of the job, record the user creating the
+	// other ones, whatever submitter they claim.
+	_, found := podgroup.Annotations[schedulingv1beta1.SubmitterAnnotationKey]
+	if !found || userInfo.Username != config.ControllerUser {
+		if annotations := util.SubmitterAnnotations(podgroup.Annotations, userInfo); annotations != nil {
+			patch = append(patch, patchOperation{
+				Op:    "add",
+				Path:  "/metadata/annotations",
+				Value: annotations,
+			})
+		}
 	}
 
-	if val, ok := ns.GetAnnotations()[schedulingv1beta1.QueueNameAnnotationKey]; ok {
-		var patch []patchOperation
-		patch = append(patch, patchOperation{
-			Op:    "add",
-			Path:  "/spec/queue",
-			Value: val,
-		})
-		return json.Marshal(patch)
+	if podgroup.Spec.Queue == schedulingv1beta1.DefaultQueue {
+		queue, err := util.NamespaceDefaultQueue(config.KubeClient, config.QueueLister, podgroup.Namespace)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the default queue of namespace", "namespace", podgroup.Namespace)
+		} else if queue != schedulingv1beta1.DefaultQueue {
+			patch = append(patch, patchOperation{
+				Op:    "add",
+				Path:  "/spec/queue",
+				Value: queue,
+			})
+		}
 	}
 
-	return nil, nil
+	if len(patch) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(patch)
 }
@@ -21,13 +21,16 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
-	"volcano.sh/volcano/pkg/webhooks/router"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakeclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informers "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/webhooks/router"
 )
 
 func Test_createPodGroupPatch(t *testing.T) {
@@ -35,6 +38,9 @@ func Test_createPodGroupPatch(t *testing.T) {
 		name          string
 		podgroup      *schedulingv1beta1.PodGroup
 		nsAnnotations map[string]string
+		nsLabels      map[string]string
+		queues        []*schedulingv1beta1.Queue
+		userInfo      authenticationv1.UserInfo
 		wantPatch     []patchOperation
 		wantErr       bool
 	}{
@@ -88,6 +94,139 @@ func Test_createPodGroupPatch(t *testing.T) {
 			wantPatch:     nil,
 			wantErr:       false,
 		},
+		{
+			name: "podgroup with default queue and namespace selected by a default queue",
+			podgroup: &schedulingv1beta1.PodGroup{
+				Spec: schedulingv1beta1.PodGroupSpec{
+					Queue: schedulingv1beta1.DefaultQueue,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+				},
+			},
+			nsAnnotations: map[string]string{},
+			nsLabels:      map[string]string{"team": "a"},
+			queues: []*schedulingv1beta1.Queue{
+				buildPolicyQueue("team-b", "b", true),
+				buildPolicyQueue("team-a-low", "a", false),
+				buildPolicyQueue("team-a-z", "a", true),
+				buildPolicyQueue("team-a", "a", true),
+			},
+			wantPatch: []patchOperation{
+				{
+					Op:    "add",
+					Path:  "/spec/queue",
+					Value: "team-a",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "namespace queue annotation takes precedence over default queues",
+			podgroup: &schedulingv1beta1.PodGroup{
+				Spec: schedulingv1beta1.PodGroupSpec{
+					Queue: schedulingv1beta1.DefaultQueue,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+				},
+			},
+			nsAnnotations: map[string]string{
+				schedulingv1beta1.QueueNameAnnotationKey: "ns-queue",
+			},
+			nsLabels: map[string]string{"team": "a"},
+			queues:   []*schedulingv1beta1.Queue{buildPolicyQueue("team-a", "a", true)},
+			wantPatch: []patchOperation{
+				{
+					Op:    "add",
+					Path:  "/spec/queue",
+					Value: "ns-queue",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "podgroup with default queue and namespace not selected by default queues",
+			podgroup: &schedulingv1beta1.PodGroup{
+				Spec: schedulingv1beta1.PodGroupSpec{
+					Queue: schedulingv1beta1.DefaultQueue,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+				},
+			},
+			nsAnnotations: map[string]string{},
+			nsLabels:      map[string]string{"team": "c"},
+			queues:        []*schedulingv1beta1.Queue{buildPolicyQueue("team-a", "a", true)},
+			wantPatch:     nil,
+			wantErr:       false,
+		},
+		{
+			name: "podgroup without submitter is annotated with the requesting user",
+			podgroup: &schedulingv1beta1.PodGroup{
+				Spec: schedulingv1beta1.PodGroupSpec{
+					Queue: "custom-queue",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "test-ns",
+					Annotations: map[string]string{"foo": "bar"},
+				},
+			},
+			userInfo: authenticationv1.UserInfo{Username: "alice", Groups: []string{"ml", "system:authenticated"}},
+			wantPatch: []patchOperation{
+				{
+					Op:   "add",
+					Path: "/metadata/annotations",
+					Value: map[string]interface{}{
+						"foo":                                    "bar",
+						schedulingv1beta1.SubmitterAnnotationKey: "alice",
+						schedulingv1beta1.SubmitterGroupsAnnotationKey: "ml,system:authenticated",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "podgroup of a job keeps the submitter of the job",
+			podgroup: &schedulingv1beta1.PodGroup{
+				Spec: schedulingv1beta1.PodGroupSpec{
+					Queue: "custom-queue",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "test-ns",
+					Annotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+				},
+			},
+			userInfo:  authenticationv1.UserInfo{Username: "system:serviceaccount:volcano-system:volcano-controllers"},
+			wantPatch: nil,
+			wantErr:   false,
+		},
+		{
+			name: "podgroup claiming a submitter is annotated with the requesting user",
+			podgroup: &schedulingv1beta1.PodGroup{
+				Spec: schedulingv1beta1.PodGroupSpec{
+					Queue: "custom-queue",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Annotations: map[string]string{
+						schedulingv1beta1.SubmitterAnnotationKey:       "alice",
+						schedulingv1beta1.SubmitterGroupsAnnotationKey: "admins",
+					},
+				},
+			},
+			userInfo: authenticationv1.UserInfo{Username: "mallory"},
+			wantPatch: []patchOperation{
+				{
+					Op:   "add",
+					Path: "/metadata/annotations",
+					Value: map[string]interface{}{
+						schedulingv1beta1.SubmitterAnnotationKey: "mallory",
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,6 +238,7 @@ func Test_createPodGroupPatch(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Name:        "test-ns",
 						Annotations: tt.nsAnnotations,
+						Labels:      tt.nsLabels,
 					},
 				}
 				_, err := client.CoreV1().Namespaces().Create(context.TODO(), ns, metav1.CreateOptions{})
@@ -107,11 +247,21 @@ func Test_createPodGroupPatch(t *testing.T) {
 				}
 			}
 
+			vcClient := fakeclient.NewSimpleClientset()
+			queueInformer := informers.NewSharedInformerFactory(vcClient, 0).Scheduling().V1beta1().Queues()
+			for _, queue := range tt.queues {
+				if err := queueInformer.Informer().GetIndexer().Add(queue); err != nil {
+					t.Fatalf("Failed to add test queue: %v", err)
+				}
+			}
+
 			config = &router.AdmissionServiceConfig{
-				KubeClient: client,
+				KubeClient:     client,
+				QueueLister:    queueInformer.Lister(),
+				ControllerUser: "system:serviceaccount:volcano-system:volcano-controllers",
 			}
 
-			got, err := createPodGroupPatch(tt.podgroup)
+			got, err := createPodGroupPatch(tt.podgroup, tt.userInfo)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("createPodGroupPatch() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -136,3 +286,15 @@ func Test_createPodGroupPatch(t *testing.T) {
 		})
 	}
 }
+
+func buildPolicyQueue(name, team string, isDefault bool) *schedulingv1beta1.Queue {
+	return &schedulingv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: schedulingv1beta1.QueueSpec{
+			NamespacePolicy: &schedulingv1beta1.QueueNamespacePolicy{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": team}},
+				Default:           isDefault,
+			},
+		},
+	}
+}
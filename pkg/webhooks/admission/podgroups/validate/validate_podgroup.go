@@ -45,7 +45,7 @@ var service = &router.AdmissionService{
 			Name: "validatepodgroup.volcano.sh",
 			Rules: []whv1.RuleWithOperations{
 				{
-					Operations: []whv1.OperationType{whv1.Create},
+					Operations: []whv1.OperationType{whv1.Create, whv1.Update},
 					Rule: whv1.Rule{
 						APIGroups:   []string{schedulingv1beta1.SchemeGroupVersion.Group},
 						APIVersions: []string{schedulingv1beta1.SchemeGroupVersion.Version},
@@ -72,6 +72,14 @@ func Validate(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	switch ar.Request.Operation {
 	case admissionv1.Create:
 		errMsg = validatePodGroup(podgroup)
+	case admissionv1.Update:
+		oldPodgroup, err := schema.DecodePodGroup(ar.Request.OldObject, ar.Request.Resource)
+		if err != nil {
+			return util.ToAdmissionResponse(err)
+		}
+		if err := util.ValidateSubmitterUpdate(oldPodgroup.Annotations, podgroup.Annotations); err != nil {
+			errMsg = err.Error()
+		}
 	default:
 		errMsg = fmt.Sprintf("unsupported operation %s", ar.Request.Operation)
 	}
@@ -92,7 +100,7 @@ func Validate(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 func validatePodGroup(pg *schedulingv1beta1.PodGroup) string {
 	var errs []string
 
-	if msg := checkQueueState(pg.Spec.Queue); msg != "" {
+	if msg := checkQueueState(pg.Spec.Queue, pg.Namespace); msg != "" {
 		errs = append(errs, strings.TrimSpace(msg))
 	}
 	if msg := validateNetworkTopology(pg.Spec.NetworkTopology, pg.Spec.SubGroupPolicy); msg != "" {
@@ -102,8 +110,8 @@ func validatePodGroup(pg *schedulingv1beta1.PodGroup) string {
 	return strings.Join(errs, "; ")
 }
 
-// checkQueueState verifies if the queue exists and is in the open state
-func checkQueueState(queueName string) string {
+// checkQueueState verifies if the queue exists, is in the open state and admits the namespace
+func checkQueueState(queueName, namespace string) string {
 	if queueName == "" {
 		return ""
 	}
@@ -118,6 +126,10 @@ func checkQueueState(queueName string) string {
 			queue.Name, queue.Status.State)
 	}
 
+	if err := util.ValidateQueueNamespace(config.KubeClient, config.QueueLister, queue, namespace); err != nil {
+		return err.Error()
+	}
+
 	return ""
 }
 
@@ -288,3 +288,69 @@ func TestValidatePodGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePodGroupUpdate(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldAnnotations map[string]string
+		newAnnotations map[string]string
+		expectError    bool
+	}{
+		{
+			name:           "other annotations change",
+			oldAnnotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+			newAnnotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice", "foo": "bar"},
+		},
+		{
+			name:           "submitter changes",
+			oldAnnotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+			newAnnotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "mallory"},
+			expectError:    true,
+		},
+		{
+			name:           "submitter groups are added",
+			oldAnnotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+			newAnnotations: map[string]string{
+				schedulingv1beta1.SubmitterAnnotationKey:       "alice",
+				schedulingv1beta1.SubmitterGroupsAnnotationKey: "admins",
+			},
+			expectError: true,
+		},
+		{
+			name:           "submitter is removed",
+			oldAnnotations: map[string]string{schedulingv1beta1.SubmitterAnnotationKey: "alice"},
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldJSON, _ := json.Marshal(&schedulingv1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-podgroup", Annotations: tt.oldAnnotations},
+			})
+			newJSON, _ := json.Marshal(&schedulingv1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-podgroup", Annotations: tt.newAnnotations},
+			})
+			ar := admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					Name:      "test-podgroup",
+					Object:    runtime.RawExtension{Raw: newJSON},
+					OldObject: runtime.RawExtension{Raw: oldJSON},
+					Resource: metav1.GroupVersionResource{
+						Group:    schedulingv1beta1.SchemeGroupVersion.Group,
+						Version:  schedulingv1beta1.SchemeGroupVersion.Version,
+						Resource: "podgroups",
+					},
+				},
+			}
+
+			response := Validate(ar)
+			if tt.expectError && response.Allowed {
+				t.Errorf("Expected error but got allowed response")
+			} else if !tt.expectError && !response.Allowed {
+				t.Errorf("Expected allowed response but got error: %v", response.Result.Message)
+			}
+		})
+	}
+}
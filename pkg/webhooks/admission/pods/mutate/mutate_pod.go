@@ -160,21 +160,21 @@ func createPatch(pod *v1.Pod) ([]byte, error) {
 // patchSchedulingGates adds a scheduling gate for Volcano-managed pods.
 // The gate prevents cluster autoscalers from seeing the pod until Volcano
 // determines it's ready (queue admission + gang scheduling satisfied).
+// The gang admission gate, held for the whole job, takes precedence over the
+// queue allocation gate, released task by task.
 func patchSchedulingGates(pod *v1.Pod) *patchOperation {
-	// Skip if SchedulingGatesQueueAdmission feature gate is not enabled
-	if !utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) {
-		return nil
-	}
-
-	// Check if opt-in annotation is present
-	if !api.HasQueueAllocationGateAnnotation(pod) {
+	var gate v1.PodSchedulingGate
+	switch {
+	case utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesGangAdmission) && api.HasGangAdmissionGateAnnotation(pod):
+		gate = v1.PodSchedulingGate{Name: schedulingv1beta1.GangAdmissionGateKey}
+	case utilfeature.DefaultFeatureGate.Enabled(features.SchedulingGatesQueueAdmission) && api.HasQueueAllocationGateAnnotation(pod):
+		gate = v1.PodSchedulingGate{Name: schedulingv1beta1.QueueAllocationGateKey}
+	default:
 		klog.V(4).Infof("Pod %s/%s does not have opt-in annotation, skipping gate",
 			pod.Namespace, pod.Name)
 		return nil
 	}
 
-	gate := v1.PodSchedulingGate{Name: schedulingv1beta1.QueueAllocationGateKey}
-
 	// Idempotent: do not add a duplicate Volcano gate.
 	// This prevents appending the same gate multiple times if the mutation is retried.
 	for _, g := range pod.Spec.SchedulingGates {
@@ -95,6 +95,7 @@ func AdmitPods(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 allow pods to create when
 1. schedulerName of pod isn't volcano
 2. check pod budget annotations configure
+3. check pod predicate override annotations configure
 */
 func validatePod(pod *v1.Pod, reviewResponse *admissionv1.AdmissionResponse) string {
 	if !slices.Contains(config.SchedulerNames, pod.Spec.SchedulerName) {
@@ -106,11 +107,28 @@ func validatePod(pod *v1.Pod, reviewResponse *admissionv1.AdmissionResponse) str
 	if err := validateAnnotation(pod); err != nil {
 		msg = err.Error()
 		reviewResponse.Allowed = false
+	} else if err := validateQueueNamespace(pod); err != nil {
+		msg = err.Error()
+		reviewResponse.Allowed = false
 	}
 
 	return msg
 }
 
+// validateQueueNamespace checks that the queue of the pod admits the namespace of the pod, the missing queues being
+// reported on the podgroup of the pod.
+func validateQueueNamespace(pod *v1.Pod) error {
+	queueName := pod.Annotations[vcv1beta1.QueueNameAnnotationKey]
+	if queueName == "" {
+		return nil
+	}
+	queue, err := config.QueueLister.Get(queueName)
+	if err != nil {
+		return nil
+	}
+	return util.ValidateQueueNamespace(config.KubeClient, config.QueueLister, queue, pod.Namespace)
+}
+
 func validateAnnotation(pod *v1.Pod) error {
 	num := 0
 	if len(pod.Annotations) > 0 {
@@ -130,6 +148,10 @@ func validateAnnotation(pod *v1.Pod) error {
 		if num > 1 {
 			return fmt.Errorf("not allow configure multiple annotations <%v> at same time", keys)
 		}
+		if err := util.ValidatePredicateOverrides(pod.Annotations); err != nil {
+			recordEvent(err)
+			return err
+		}
 	}
 	return nil
 }
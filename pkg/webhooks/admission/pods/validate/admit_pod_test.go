@@ -24,6 +24,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 
 	vcschedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	vcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
@@ -64,6 +65,68 @@ func TestValidatePod(t *testing.T) {
 			ret:            "",
 			ExpectErr:      false,
 		},
+		// validate pod with valid predicate override annotations
+		{
+			Name: "validate pod with valid predicate overrides",
+			Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "override-pod-1",
+					Annotations: map[string]string{
+						vcschedulingv1.EnablePredicateErrorCacheKey: "false",
+						vcschedulingv1.PercentageOfNodesToFindKey:   "100",
+						vcschedulingv1.ShardingModeKey:              "none",
+					},
+				},
+				Spec: v1.PodSpec{
+					SchedulerName: "volcano",
+				},
+			},
+
+			reviewResponse: admissionv1.AdmissionResponse{Allowed: true},
+			ret:            "",
+			ExpectErr:      false,
+		},
+		// validate pod with invalid percentage of nodes to find
+		{
+			Name: "validate pod with invalid percentage of nodes to find",
+			Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "override-pod-2",
+					Annotations: map[string]string{
+						vcschedulingv1.PercentageOfNodesToFindKey: "120",
+					},
+				},
+				Spec: v1.PodSpec{
+					SchedulerName: "volcano",
+				},
+			},
+
+			reviewResponse: admissionv1.AdmissionResponse{Allowed: true},
+			ret:            "it must be an integer between 0 and 100",
+			ExpectErr:      true,
+		},
+		// validate pod with invalid sharding mode
+		{
+			Name: "validate pod with invalid sharding mode",
+			Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "override-pod-3",
+					Annotations: map[string]string{
+						vcschedulingv1.ShardingModeKey: "strict",
+					},
+				},
+				Spec: v1.PodSpec{
+					SchedulerName: "volcano",
+				},
+			},
+
+			reviewResponse: admissionv1.AdmissionResponse{Allowed: true},
+			ret:            "invalid value <\"strict\">",
+			ExpectErr:      true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -96,6 +159,7 @@ func TestValidatePod(t *testing.T) {
 		// create fake volcano clientset
 		config.VolcanoClient = vcclient.NewSimpleClientset()
 		config.SchedulerNames = []string{"volcano"}
+		config.Recorder = record.NewFakeRecorder(10)
 
 		if !testCase.disabledPG {
 			_, err := config.VolcanoClient.SchedulingV1beta1().PodGroups(namespace).Create(context.TODO(), pg, metav1.CreateOptions{})
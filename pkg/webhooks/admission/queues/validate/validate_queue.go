@@ -17,15 +17,19 @@ limitations under the License.
 package validate
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	whv1 "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
@@ -136,6 +140,18 @@ func validateQueue(queue *schedulingv1beta1.Queue) error {
 	errs = append(errs, validateResourceQuantityOfQueue(queue.Spec, resourcePath.Child("spec"))...)
 	errs = append(errs, validateStateOfQueue(queue.Status.State, resourcePath.Child("spec").Child("state"))...)
 	errs = append(errs, validateHierarchicalAttributes(queue, resourcePath.Child("metadata").Child("annotations"))...)
+	errs = append(errs, validateActionArguments(queue, resourcePath.Child("metadata").Child("annotations"))...)
+	errs = append(errs, validateDrainDeadline(queue, resourcePath.Child("metadata").Child("annotations"))...)
+	errs = append(errs, validateBudget(queue.Spec.Budget, resourcePath.Child("spec").Child("budget"))...)
+	errs = append(errs, validateBurst(queue.Spec.Burst, resourcePath.Child("spec").Child("burst"))...)
+	errs = append(errs, validateQuotaSchedules(queue.Spec, resourcePath.Child("spec").Child("quotaSchedules"))...)
+	errs = append(errs, metav1validation.ValidateLabels(queue.Spec.NodeSelector, resourcePath.Child("spec").Child("nodeSelector"))...)
+	errs = append(errs, validateSubQuotas(queue.Spec, resourcePath.Child("spec").Child("subQuotas"))...)
+	errs = append(errs, validateDeletionPolicy(queue, resourcePath.Child("spec").Child("deletionPolicy"))...)
+	if policy := queue.Spec.NamespacePolicy; policy != nil && policy.NamespaceSelector != nil {
+		errs = append(errs, metav1validation.ValidateLabelSelector(policy.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{},
+			resourcePath.Child("spec").Child("namespacePolicy").Child("namespaceSelector"))...)
+	}
 
 	if len(errs) > 0 {
 		return errs.ToAggregate()
@@ -144,6 +160,175 @@ func validateQueue(queue *schedulingv1beta1.Queue) error {
 	return nil
 }
 
+// validateActionArguments validates the annotation of the queue overriding the arguments of the actions.
+func validateActionArguments(queue *schedulingv1beta1.Queue, fldPath *field.Path) field.ErrorList {
+	value, found := queue.Annotations[api.QueueActionArgumentsKey]
+	if !found {
+		return nil
+	}
+	if _, err := api.ParseQueueActionArguments(value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Key(api.QueueActionArgumentsKey), value, err.Error())}
+	}
+	return nil
+}
+
+// validateDrainDeadline validates the annotation of the queue setting its drain deadline.
+func validateDrainDeadline(queue *schedulingv1beta1.Queue, fldPath *field.Path) field.ErrorList {
+	value, found := queue.Annotations[schedulingv1beta1.DrainDeadlineAnnotationKey]
+	if !found {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Key(schedulingv1beta1.DrainDeadlineAnnotationKey), value, "must be a time in RFC 3339 format")}
+	}
+	return nil
+}
+
+// validateBudget validates the monthly cost budget of the queue.
+func validateBudget(budget *schedulingv1beta1.QueueBudget, fldPath *field.Path) field.ErrorList {
+	if budget == nil {
+		return nil
+	}
+	if budget.Monthly.Sign() < 0 {
+		return field.ErrorList{field.Invalid(fldPath.Child("monthly"), budget.Monthly.String(), "budget must not be negative")}
+	}
+	return nil
+}
+
+// validateBurst validates the token bucket of the queue, the tokens are refilled only for the resources it holds.
+func validateBurst(burst *schedulingv1beta1.QueueBurst, fldPath *field.Path) field.ErrorList {
+	if burst == nil {
+		return nil
+	}
+	errs := field.ErrorList{}
+	for resourceName, quantity := range burst.Capacity {
+		errs = append(errs, k8scorevalid.ValidateResourceQuantityValue(k8score.ResourceName(resourceName), quantity, fldPath.Child("capacity").Child(resourceName.String()))...)
+	}
+	for resourceName, quantity := range burst.RefillRate {
+		errs = append(errs, k8scorevalid.ValidateResourceQuantityValue(k8score.ResourceName(resourceName), quantity, fldPath.Child("refillRate").Child(resourceName.String()))...)
+		if _, found := burst.Capacity[resourceName]; !found {
+			errs = append(errs, field.Invalid(fldPath.Child("refillRate").Child(resourceName.String()), quantity.String(),
+				fmt.Sprintf("capacity[%s] must be set to refill it", resourceName)))
+		}
+	}
+	return errs
+}
+
+var quotaScheduleDays = map[string]bool{"Mon": true, "Tue": true, "Wed": true, "Thu": true, "Fri": true, "Sat": true, "Sun": true}
+
+// validateQuotaSchedules validates the windows of the quota schedules of the queue, the deserved resources within a
+// window must not exceed its capability, falling back to the quotas of the spec.
+func validateQuotaSchedules(spec schedulingv1beta1.QueueSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	names := map[string]bool{}
+	for i, schedule := range spec.QuotaSchedules {
+		idxPath := fldPath.Index(i)
+		if schedule.Name == "" {
+			errs = append(errs, field.Required(idxPath.Child("name"), "the name of the window must be set"))
+		} else if names[schedule.Name] {
+			errs = append(errs, field.Duplicate(idxPath.Child("name"), schedule.Name))
+		}
+		names[schedule.Name] = true
+
+		for j, day := range schedule.Days {
+			if !quotaScheduleDays[day] {
+				errs = append(errs, field.NotSupported(idxPath.Child("days").Index(j), day,
+					[]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}))
+			}
+		}
+		if _, err := time.Parse("15:04", schedule.Start); err != nil {
+			errs = append(errs, field.Invalid(idxPath.Child("start"), schedule.Start, "must be a time of the day as HH:MM"))
+		}
+		if _, err := time.Parse("15:04", schedule.End); err != nil {
+			errs = append(errs, field.Invalid(idxPath.Child("end"), schedule.End, "must be a time of the day as HH:MM"))
+		}
+		if schedule.TimeZone != "" {
+			if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+				errs = append(errs, field.Invalid(idxPath.Child("timeZone"), schedule.TimeZone, err.Error()))
+			}
+		}
+
+		for resourceName, quantity := range schedule.Capability {
+			errs = append(errs, k8scorevalid.ValidateResourceQuantityValue(k8score.ResourceName(resourceName), quantity, idxPath.Child("capability").Child(resourceName.String()))...)
+		}
+		for resourceName, quantity := range schedule.Deserved {
+			errs = append(errs, k8scorevalid.ValidateResourceQuantityValue(k8score.ResourceName(resourceName), quantity, idxPath.Child("deserved").Child(resourceName.String()))...)
+		}
+		capability, deserved := spec.Capability, spec.Deserved
+		if schedule.Capability != nil {
+			capability = schedule.Capability
+		}
+		if schedule.Deserved != nil {
+			deserved = schedule.Deserved
+		}
+		for resourceName, desQ := range deserved {
+			if capQ, found := capability[resourceName]; found && desQ.Cmp(capQ) > 0 {
+				errs = append(errs, field.Invalid(idxPath.Child("deserved").Child(resourceName.String()), desQ.String(),
+					fmt.Sprintf("deserved[%s]=%s must be <= capability[%s]=%s within the window",
+						resourceName, desQ.String(), resourceName, capQ.String())))
+			}
+		}
+	}
+	return errs
+}
+
+// validateSubQuotas validates the sub-quotas of the queue, each applying to some users or groups, with deserved
+// resources not exceeding the capability of the queue.
+func validateSubQuotas(spec schedulingv1beta1.QueueSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	names := map[string]bool{}
+	for i, subQuota := range spec.SubQuotas {
+		idxPath := fldPath.Index(i)
+		if subQuota.Name == "" {
+			errs = append(errs, field.Required(idxPath.Child("name"), "the name of the sub-quota must be set"))
+		} else if names[subQuota.Name] {
+			errs = append(errs, field.Duplicate(idxPath.Child("name"), subQuota.Name))
+		}
+		names[subQuota.Name] = true
+
+		if len(subQuota.Users) == 0 && len(subQuota.Groups) == 0 {
+			errs = append(errs, field.Required(idxPath.Child("users"), "the sub-quota must apply to users or groups"))
+		}
+		for resourceName, quantity := range subQuota.Deserved {
+			errs = append(errs, k8scorevalid.ValidateResourceQuantityValue(k8score.ResourceName(resourceName), quantity, idxPath.Child("deserved").Child(resourceName.String()))...)
+			if capQ, found := spec.Capability[resourceName]; found && quantity.Cmp(capQ) > 0 {
+				errs = append(errs, field.Invalid(idxPath.Child("deserved").Child(resourceName.String()), quantity.String(),
+					fmt.Sprintf("deserved[%s]=%s must be <= capability[%s]=%s of the queue",
+						resourceName, quantity.String(), resourceName, capQ.String())))
+			}
+		}
+	}
+	return errs
+}
+
+// validateDeletionPolicy validates the deletion policy of the queue, only the Orphan policy moving the jobs to a
+// fallback queue other than the queue itself.
+func validateDeletionPolicy(queue *schedulingv1beta1.Queue, fldPath *field.Path) field.ErrorList {
+	policy := queue.Spec.DeletionPolicy
+	if policy == nil {
+		return nil
+	}
+
+	errs := field.ErrorList{}
+	switch policy.Type {
+	case schedulingv1beta1.QueueDeletionForbid, schedulingv1beta1.QueueDeletionCascade:
+		if policy.FallbackQueue != "" {
+			errs = append(errs, field.Invalid(fldPath.Child("fallbackQueue"), policy.FallbackQueue,
+				fmt.Sprintf("the fallback queue is only used by the %s policy", schedulingv1beta1.QueueDeletionOrphan)))
+		}
+	case schedulingv1beta1.QueueDeletionOrphan:
+		if policy.FallbackQueue == "" {
+			errs = append(errs, field.Required(fldPath.Child("fallbackQueue"), "the fallback queue of the jobs must be set"))
+		} else if policy.FallbackQueue == queue.Name {
+			errs = append(errs, field.Invalid(fldPath.Child("fallbackQueue"), policy.FallbackQueue, "the queue cannot be its own fallback queue"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("type"), policy.Type, []schedulingv1beta1.QueueDeletionPolicyType{
+			schedulingv1beta1.QueueDeletionForbid, schedulingv1beta1.QueueDeletionCascade, schedulingv1beta1.QueueDeletionOrphan}))
+	}
+	return errs
+}
+
 func validateHierarchicalAttributes(queue *schedulingv1beta1.Queue, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 	hierarchy := queue.Annotations[schedulingv1beta1.KubeHierarchyAnnotationKey]
@@ -324,11 +509,49 @@ func validateQueueDeleting(queueName string) error {
 			queue.Name, len(childQueueNames), strings.Join(childQueueNames, ", "))
 	}
 
+	if err := validateDeletionPolicyOnDeleting(queue); err != nil {
+		return err
+	}
+
 	klog.V(3).Infof("Validation passed for deleting hierarchical queue %s", queue.Name)
 
 	return nil
 }
 
+// validateDeletionPolicyOnDeleting enforces the deletion policy of the queue being deleted: the Forbid policy rejects
+// the deletion while the queue has podgroups not completed, and the Orphan policy while its fallback queue is missing.
+// The queue controller cascades or orphans the jobs of the queue once it is deleted.
+func validateDeletionPolicyOnDeleting(queue *schedulingv1beta1.Queue) error {
+	policy := queue.Spec.DeletionPolicy
+	if policy == nil {
+		return nil
+	}
+
+	switch policy.Type {
+	case schedulingv1beta1.QueueDeletionForbid:
+		podGroups, err := config.VolcanoClient.SchedulingV1beta1().PodGroups(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list podgroups of queue %s: %v", queue.Name, err)
+		}
+		var active []string
+		for _, pg := range podGroups.Items {
+			if pg.Spec.Queue == queue.Name && pg.Status.Phase != schedulingv1beta1.PodGroupCompleted {
+				active = append(active, pg.Namespace+"/"+pg.Name)
+			}
+		}
+		if len(active) > 0 {
+			return fmt.Errorf("queue %s can not be deleted because of its %s deletion policy, it has %d podgroups not completed: %s",
+				queue.Name, policy.Type, len(active), strings.Join(active, ", "))
+		}
+	case schedulingv1beta1.QueueDeletionOrphan:
+		if _, err := config.QueueLister.Get(policy.FallbackQueue); err != nil {
+			return fmt.Errorf("queue %s can not be deleted because its fallback queue %s is not found: %v",
+				queue.Name, policy.FallbackQueue, err)
+		}
+	}
+	return nil
+}
+
 // needsValidateHierarchicalQueue determines if hierarchy resource validation is necessary
 // Returns true only if:
 // - Queue is not the root queue itself AND
@@ -566,16 +789,27 @@ func validateHierarchicalQueueResources(queue *schedulingv1beta1.Queue) error {
 	return nil
 }
 
-// validateChildAgainstAncestor validates that child queue's resources don't exceed ancestors's
+// validateChildAgainstAncestor validates that child queue's capability, deserved and guarantee don't exceed
+// ancestors's capability, the hard limit of the whole subtree
 func validateChildAgainstAncestor(child *schedulingv1beta1.Queue) error {
-	if child.Spec.Capability != nil {
-		qRes := api.NewResource(child.Spec.Capability)
+	for _, tier := range []struct {
+		name      string
+		resources v1.ResourceList
+	}{
+		{name: "capability", resources: child.Spec.Capability},
+		{name: "deserved", resources: child.Spec.Deserved},
+		{name: "guarantee", resources: child.Spec.Guarantee.Resource},
+	} {
+		if tier.resources == nil {
+			continue
+		}
+		qRes := api.NewResource(tier.resources)
 		resKeys := qRes.ResourceNames()
 		for _, r := range resKeys {
 			myVal := getSingleResource(qRes, r)
 			if upLimit, ok := findNearestAncestorCapability(child, r); ok {
 				if myVal > upLimit {
-					return fmt.Errorf("queue %s capability[%s]=%v exceeds its ancestor's capability=%v", child.Name, r, formatResourceWithType(r, myVal), formatResourceWithType(r, upLimit))
+					return fmt.Errorf("queue %s %s[%s]=%v exceeds its ancestor's capability=%v", child.Name, tier.name, r, formatResourceWithType(r, myVal), formatResourceWithType(r, upLimit))
 				}
 			}
 		}
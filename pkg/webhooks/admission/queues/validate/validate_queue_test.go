@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	fakeclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
 	informers "volcano.sh/apis/pkg/client/informers/externalversions"
 	schedulingv1beta1informers "volcano.sh/apis/pkg/client/informers/externalversions/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/webhooks/router"
 	"volcano.sh/volcano/pkg/webhooks/util"
 )
@@ -2402,6 +2404,38 @@ func TestValidateChildAgainstAncestorForCapability(t *testing.T) {
 			expectErr: true,
 			errSubstr: "exceeds its ancestor's capability",
 		},
+		{
+			name: "Child deserved exceeds direct parent capability",
+			child: &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "child-exceed-deserved"},
+				Spec: schedulingv1beta1.QueueSpec{
+					Parent: "ancestor-p",
+					Weight: 1,
+					Deserved: v1.ResourceList{
+						v1.ResourceCPU: resource.MustParse("60"), // parent=50
+					},
+				},
+			},
+			expectErr: true,
+			errSubstr: "deserved[cpu]=60 exceeds its ancestor's capability",
+		},
+		{
+			name: "Child guarantee exceeds grandparent capability (parent has no GPU)",
+			child: &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "child-exceed-guarantee"},
+				Spec: schedulingv1beta1.QueueSpec{
+					Parent: "ancestor-p",
+					Weight: 1,
+					Guarantee: schedulingv1beta1.Guarantee{
+						Resource: v1.ResourceList{
+							v1.ResourceName("nvidia.com/gpu"): resource.MustParse("10"), // grandparent=8
+						},
+					},
+				},
+			},
+			expectErr: true,
+			errSubstr: "guarantee[nvidia.com/gpu]=10000.00 exceeds its ancestor's capability",
+		},
 		{
 			name: "Child under parent without capability - within grandparent limits",
 			child: &schedulingv1beta1.Queue{
@@ -2677,6 +2711,214 @@ func TestValidateChildrenConstraintsForCapability(t *testing.T) {
 	}
 }
 
+func TestValidateActionArguments(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expectErr   bool
+	}{
+		{
+			name: "no action arguments",
+		},
+		{
+			name:        "valid action arguments",
+			annotations: map[string]string{api.QueueActionArgumentsKey: `{"allocate": {"predicateErrorCacheEnable": false}}`},
+		},
+		{
+			name:        "arguments are not a map",
+			annotations: map[string]string{api.QueueActionArgumentsKey: `{"allocate": false}`},
+			expectErr:   true,
+		},
+		{
+			name:        "invalid json",
+			annotations: map[string]string{api.QueueActionArgumentsKey: `{"allocate": {`},
+			expectErr:   true,
+		},
+		{
+			name:        "checkpoint webhook is only set by the scheduler configuration",
+			annotations: map[string]string{api.QueueActionArgumentsKey: `{"reclaim": {"checkpointWebhook": "http://169.254.169.254/"}}`},
+			expectErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "q1", Annotations: tt.annotations}}
+			errs := validateActionArguments(queue, field.NewPath("metadata").Child("annotations"))
+			if tt.expectErr != (len(errs) > 0) {
+				t.Errorf("expected error %v, got %v", tt.expectErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateDrainDeadline(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expectErr   bool
+	}{
+		{
+			name: "no drain deadline",
+		},
+		{
+			name:        "valid drain deadline",
+			annotations: map[string]string{schedulingv1beta1.DrainDeadlineAnnotationKey: "2026-10-15T18:00:00Z"},
+		},
+		{
+			name:        "drain deadline is not a time",
+			annotations: map[string]string{schedulingv1beta1.DrainDeadlineAnnotationKey: "2h"},
+			expectErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "q1", Annotations: tt.annotations}}
+			errs := validateDrainDeadline(queue, field.NewPath("metadata").Child("annotations"))
+			if tt.expectErr != (len(errs) > 0) {
+				t.Errorf("expected error %v, got %v", tt.expectErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateBudget(t *testing.T) {
+	tests := []struct {
+		name      string
+		budget    *schedulingv1beta1.QueueBudget
+		expectErr bool
+	}{
+		{
+			name: "no budget",
+		},
+		{
+			name:   "valid budget",
+			budget: &schedulingv1beta1.QueueBudget{Monthly: resource.MustParse("1500.50"), Policy: schedulingv1beta1.BudgetPolicyGate},
+		},
+		{
+			name:      "negative budget",
+			budget:    &schedulingv1beta1.QueueBudget{Monthly: resource.MustParse("-1")},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateBudget(tt.budget, field.NewPath("spec").Child("budget"))
+			if tt.expectErr != (len(errs) > 0) {
+				t.Errorf("expected error %v, got %v", tt.expectErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateBurst(t *testing.T) {
+	tests := []struct {
+		name      string
+		burst     *schedulingv1beta1.QueueBurst
+		expectErr bool
+	}{
+		{
+			name: "no burst",
+		},
+		{
+			name: "valid burst",
+			burst: &schedulingv1beta1.QueueBurst{
+				Capacity:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("3600")},
+				RefillRate: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+			},
+		},
+		{
+			name: "negative capacity",
+			burst: &schedulingv1beta1.QueueBurst{
+				Capacity: v1.ResourceList{v1.ResourceCPU: resource.MustParse("-1")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "refill rate of a resource out of the bucket",
+			burst: &schedulingv1beta1.QueueBurst{
+				Capacity:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("3600")},
+				RefillRate: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateBurst(tt.burst, field.NewPath("spec").Child("burst"))
+			if tt.expectErr != (len(errs) > 0) {
+				t.Errorf("expected error %v, got %v", tt.expectErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateQuotaSchedules(t *testing.T) {
+	night := schedulingv1beta1.QueueQuotaSchedule{
+		Name:       "night",
+		Days:       []string{"Mon", "Tue"},
+		Start:      "22:00",
+		End:        "06:00",
+		TimeZone:   "Europe/Budapest",
+		Capability: v1.ResourceList{v1.ResourceCPU: resource.MustParse("16")},
+	}
+	tests := []struct {
+		name      string
+		mutate    func(schedule *schedulingv1beta1.QueueQuotaSchedule)
+		duplicate bool
+		expectErr bool
+	}{
+		{
+			name: "valid window",
+		},
+		{
+			name:      "duplicate names",
+			duplicate: true,
+			expectErr: true,
+		},
+		{
+			name:      "unknown day",
+			mutate:    func(schedule *schedulingv1beta1.QueueQuotaSchedule) { schedule.Days = []string{"Monday"} },
+			expectErr: true,
+		},
+		{
+			name:      "invalid time of the day",
+			mutate:    func(schedule *schedulingv1beta1.QueueQuotaSchedule) { schedule.End = "24:00" },
+			expectErr: true,
+		},
+		{
+			name:      "unknown time zone",
+			mutate:    func(schedule *schedulingv1beta1.QueueQuotaSchedule) { schedule.TimeZone = "Mars/Olympus" },
+			expectErr: true,
+		},
+		{
+			name: "deserved of the spec above the capability of the window",
+			mutate: func(schedule *schedulingv1beta1.QueueQuotaSchedule) {
+				schedule.Capability[v1.ResourceCPU] = resource.MustParse("1")
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := *night.DeepCopy()
+			if tt.mutate != nil {
+				tt.mutate(&schedule)
+			}
+			spec := schedulingv1beta1.QueueSpec{
+				Deserved:       v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+				QuotaSchedules: []schedulingv1beta1.QueueQuotaSchedule{schedule},
+			}
+			if tt.duplicate {
+				spec.QuotaSchedules = append(spec.QuotaSchedules, schedule)
+			}
+			errs := validateQuotaSchedules(spec, field.NewPath("spec").Child("quotaSchedules"))
+			if tt.expectErr != (len(errs) > 0) {
+				t.Errorf("expected error %v, got %v", tt.expectErr, errs)
+			}
+		})
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
 		(len(s) > 0 && len(substr) > 0 && searchSubstring(s, substr)))
@@ -2690,3 +2932,278 @@ func searchSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestValidateQueueNodeSelector(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodeSelector map[string]string
+		expectErr    bool
+	}{
+		{
+			name:         "valid node selector",
+			nodeSelector: map[string]string{"node.kubernetes.io/pool": "gpu"},
+		},
+		{
+			name:         "invalid label key",
+			nodeSelector: map[string]string{"pool/gpu/a100": "true"},
+			expectErr:    true,
+		},
+		{
+			name:         "invalid label value",
+			nodeSelector: map[string]string{"pool": "gpu pool"},
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "pooled"},
+				Spec:       schedulingv1beta1.QueueSpec{Weight: 1, NodeSelector: tt.nodeSelector},
+			}
+			err := validateQueue(queue)
+			if tt.expectErr && err == nil {
+				t.Errorf("expected an error for node selector %v", tt.nodeSelector)
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error for node selector %v: %v", tt.nodeSelector, err)
+			}
+		})
+	}
+}
+
+func TestValidateQueueNamespacePolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  *metav1.LabelSelector
+		expectErr bool
+	}{
+		{
+			name:     "valid namespace selector",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "team-a"}},
+		},
+		{
+			name: "invalid operator",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "team", Operator: "Is", Values: []string{"a"}},
+			}},
+			expectErr: true,
+		},
+		{
+			name:      "invalid label value",
+			selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"team": "team a"}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "team"},
+				Spec: schedulingv1beta1.QueueSpec{
+					Weight:          1,
+					NamespacePolicy: &schedulingv1beta1.QueueNamespacePolicy{NamespaceSelector: tt.selector, Default: true},
+				},
+			}
+			err := validateQueue(queue)
+			if tt.expectErr && err == nil {
+				t.Errorf("expected an error for namespace selector %v", tt.selector)
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error for namespace selector %v: %v", tt.selector, err)
+			}
+		})
+	}
+}
+
+func TestValidateQueueSubQuotas(t *testing.T) {
+	tests := []struct {
+		name      string
+		subQuotas []schedulingv1beta1.QueueSubQuota
+		expectErr string
+	}{
+		{
+			name: "valid sub-quotas",
+			subQuotas: []schedulingv1beta1.QueueSubQuota{
+				{Name: "alice", Users: []string{"alice"}, Deserved: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")}},
+				{Name: "ml", Groups: []string{"ml-team"}, Deserved: v1.ResourceList{v1.ResourceCPU: resource.MustParse("8")}},
+			},
+		},
+		{
+			name: "duplicate name",
+			subQuotas: []schedulingv1beta1.QueueSubQuota{
+				{Name: "team", Users: []string{"alice"}},
+				{Name: "team", Users: []string{"bob"}},
+			},
+			expectErr: "Duplicate value",
+		},
+		{
+			name:      "no users nor groups",
+			subQuotas: []schedulingv1beta1.QueueSubQuota{{Name: "nobody"}},
+			expectErr: "the sub-quota must apply to users or groups",
+		},
+		{
+			name: "deserved above the capability of the queue",
+			subQuotas: []schedulingv1beta1.QueueSubQuota{
+				{Name: "alice", Users: []string{"alice"}, Deserved: v1.ResourceList{v1.ResourceCPU: resource.MustParse("20")}},
+			},
+			expectErr: "deserved[cpu]=20 must be <= capability[cpu]=10 of the queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+				Spec: schedulingv1beta1.QueueSpec{
+					Weight:     1,
+					Capability: v1.ResourceList{v1.ResourceCPU: resource.MustParse("10")},
+					SubQuotas:  tt.subQuotas,
+				},
+			}
+			err := validateQueue(queue)
+			if tt.expectErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.expectErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectErr)) {
+				t.Errorf("expected error containing %q, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateQueueDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *schedulingv1beta1.QueueDeletionPolicy
+		expectErr string
+	}{
+		{
+			name: "no deletion policy",
+		},
+		{
+			name:   "forbid",
+			policy: &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionForbid},
+		},
+		{
+			name:   "orphan to a fallback queue",
+			policy: &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan, FallbackQueue: "default"},
+		},
+		{
+			name:      "orphan without fallback queue",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan},
+			expectErr: "the fallback queue of the jobs must be set",
+		},
+		{
+			name:      "orphan to the queue itself",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan, FallbackQueue: "team-a"},
+			expectErr: "the queue cannot be its own fallback queue",
+		},
+		{
+			name:      "cascade with a fallback queue",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionCascade, FallbackQueue: "default"},
+			expectErr: "the fallback queue is only used by the Orphan policy",
+		},
+		{
+			name:      "unknown policy",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: "Keep"},
+			expectErr: "Unsupported value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Spec:       schedulingv1beta1.QueueSpec{Weight: 1, DeletionPolicy: tt.policy},
+			}
+			err := validateQueue(queue)
+			if tt.expectErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.expectErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectErr)) {
+				t.Errorf("expected error containing %q, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateDeletionPolicyOnDeleting(t *testing.T) {
+	podGroup := func(name string, phase schedulingv1beta1.PodGroupPhase) *schedulingv1beta1.PodGroup {
+		return &schedulingv1beta1.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+			Spec:       schedulingv1beta1.PodGroupSpec{Queue: "team-a"},
+			Status:     schedulingv1beta1.PodGroupStatus{Phase: phase},
+		}
+	}
+	tests := []struct {
+		name      string
+		policy    *schedulingv1beta1.QueueDeletionPolicy
+		podGroups []*schedulingv1beta1.PodGroup
+		expectErr string
+	}{
+		{
+			name:      "no deletion policy",
+			podGroups: []*schedulingv1beta1.PodGroup{podGroup("running", schedulingv1beta1.PodGroupRunning)},
+		},
+		{
+			name:      "forbid with completed podgroups only",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionForbid},
+			podGroups: []*schedulingv1beta1.PodGroup{podGroup("completed", schedulingv1beta1.PodGroupCompleted)},
+		},
+		{
+			name:   "forbid with podgroups not completed",
+			policy: &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionForbid},
+			podGroups: []*schedulingv1beta1.PodGroup{
+				podGroup("completed", schedulingv1beta1.PodGroupCompleted),
+				podGroup("pending", schedulingv1beta1.PodGroupPending),
+			},
+			expectErr: "it has 1 podgroups not completed: ns1/pending",
+		},
+		{
+			name:      "cascade with podgroups not completed",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionCascade},
+			podGroups: []*schedulingv1beta1.PodGroup{podGroup("running", schedulingv1beta1.PodGroupRunning)},
+		},
+		{
+			name:   "orphan to an existing fallback queue",
+			policy: &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan, FallbackQueue: "fallback"},
+		},
+		{
+			name:      "orphan to a missing fallback queue",
+			policy:    &schedulingv1beta1.QueueDeletionPolicy{Type: schedulingv1beta1.QueueDeletionOrphan, FallbackQueue: "missing"},
+			expectErr: "its fallback queue missing is not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.VolcanoClient = fakeclient.NewSimpleClientset()
+			informerFactory := informers.NewSharedInformerFactory(config.VolcanoClient, 0)
+			queueInformer := informerFactory.Scheduling().V1beta1().Queues()
+			config.QueueLister = queueInformer.Lister()
+
+			fallback := &schedulingv1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "fallback"}}
+			if err := queueInformer.Informer().GetIndexer().Add(fallback); err != nil {
+				t.Fatalf("failed to add queue: %v", err)
+			}
+			for _, pg := range tt.podGroups {
+				if _, err := config.VolcanoClient.SchedulingV1beta1().PodGroups(pg.Namespace).Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("failed to create podgroup: %v", err)
+				}
+			}
+
+			queue := &schedulingv1beta1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Spec:       schedulingv1beta1.QueueSpec{DeletionPolicy: tt.policy},
+			}
+			err := validateDeletionPolicyOnDeleting(queue)
+			if tt.expectErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.expectErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectErr)) {
+				t.Errorf("expected error containing %q, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
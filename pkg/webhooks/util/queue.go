@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+)
+
+// namespaceSelected returns whether the namespace policy selects the namespace, all the namespaces being selected
+// without namespace selector.
+func namespaceSelected(policy *schedulingv1beta1.QueueNamespacePolicy, ns *v1.Namespace) (bool, error) {
+	if policy.NamespaceSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// ValidateQueueNamespace checks that the namespace policies of the queue and of its ancestors select the namespace,
+// i.e. that the jobs of the namespace may be submitted to the queue. The namespace is only fetched if a policy
+// selects namespaces.
+func ValidateQueueNamespace(kubeClient kubernetes.Interface, queueLister schedulinglister.QueueLister,
+	queue *schedulingv1beta1.Queue, namespace string) error {
+	var ns *v1.Namespace
+	visited := map[string]bool{}
+	for queue != nil && !visited[queue.Name] {
+		visited[queue.Name] = true
+		if policy := queue.Spec.NamespacePolicy; policy != nil && policy.NamespaceSelector != nil {
+			if ns == nil {
+				var err error
+				if ns, err = kubeClient.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{}); err != nil {
+					return fmt.Errorf("failed to get namespace %s: %v", namespace, err)
+				}
+			}
+			selected, err := namespaceSelected(policy, ns)
+			if err != nil {
+				return fmt.Errorf("invalid namespace selector of queue %s: %v", queue.Name, err)
+			}
+			if !selected {
+				return fmt.Errorf("namespace %s is not allowed to submit to queue %s by its namespace policy", namespace, queue.Name)
+			}
+		}
+		if queue.Spec.Parent == "" {
+			break
+		}
+		parent, err := queueLister.Get(queue.Spec.Parent)
+		if err != nil {
+			break
+		}
+		queue = parent
+	}
+	return nil
+}
+
+// NamespaceDefaultQueue returns the queue of the jobs of the namespace which do not set their queue: the queue of the
+// queue name annotation of the namespace, else the first queue by name whose namespace policy makes it the default
+// queue of the namespace, else the default queue.
+func NamespaceDefaultQueue(kubeClient kubernetes.Interface, queueLister schedulinglister.QueueLister, namespace string) (string, error) {
+	ns, err := kubeClient.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get namespace %s: %v", namespace, err)
+	}
+	if name, found := ns.Annotations[schedulingv1beta1.QueueNameAnnotationKey]; found {
+		return name, nil
+	}
+
+	queues, err := queueLister.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("failed to list queues: %v", err)
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	for _, queue := range queues {
+		policy := queue.Spec.NamespacePolicy
+		if policy == nil || !policy.Default {
+			continue
+		}
+		if selected, err := namespaceSelected(policy, ns); err == nil && selected {
+			return queue.Name, nil
+		}
+	}
+	return schedulingv1beta1.DefaultQueue, nil
+}
+
+// SubmitterAnnotations returns a copy of the annotations with the submitter annotations set to the user of the request
+// and its groups, nil if the request has no user.
+func SubmitterAnnotations(annotations map[string]string, userInfo authenticationv1.UserInfo) map[string]string {
+	if userInfo.Username == "" {
+		return nil
+	}
+	submitted := make(map[string]string, len(annotations)+2)
+	for key, value := range annotations {
+		submitted[key] = value
+	}
+	submitted[schedulingv1beta1.SubmitterAnnotationKey] = userInfo.Username
+	delete(submitted, schedulingv1beta1.SubmitterGroupsAnnotationKey)
+	if len(userInfo.Groups) > 0 {
+		submitted[schedulingv1beta1.SubmitterGroupsAnnotationKey] = strings.Join(userInfo.Groups, ",")
+	}
+	return submitted
+}
+
+// ValidateSubmitterUpdate rejects the changes of the submitter annotations, which are only set on creation.
+func ValidateSubmitterUpdate(oldAnnotations, newAnnotations map[string]string) error {
+	for _, key := range []string{schedulingv1beta1.SubmitterAnnotationKey, schedulingv1beta1.SubmitterGroupsAnnotationKey} {
+		if oldAnnotations[key] != newAnnotations[key] {
+			return fmt.Errorf("annotation %s may not be changed", key)
+		}
+	}
+	return nil
+}
@@ -17,9 +17,15 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
+	"strconv"
+
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/util"
 )
 
 // ToAdmissionResponse updates the admission response with the input error.
@@ -32,3 +38,26 @@ func ToAdmissionResponse(err error) *admissionv1.AdmissionResponse {
 		},
 	}
 }
+
+// ValidatePredicateOverrides validates the annotations of pod/job overriding the node search of the scheduler actions.
+func ValidatePredicateOverrides(annotations map[string]string) error {
+	if value, found := annotations[schedulingv1beta1.EnablePredicateErrorCacheKey]; found {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("invalid value <%q> for %v, it must be true or false", value, schedulingv1beta1.EnablePredicateErrorCacheKey)
+		}
+	}
+	if value, found := annotations[schedulingv1beta1.PercentageOfNodesToFindKey]; found {
+		if v, err := strconv.Atoi(value); err != nil || v < 0 || v > 100 {
+			return fmt.Errorf("invalid value <%q> for %v, it must be an integer between 0 and 100", value, schedulingv1beta1.PercentageOfNodesToFindKey)
+		}
+	}
+	if value, found := annotations[schedulingv1beta1.ShardingModeKey]; found {
+		switch value {
+		case util.HardShardingMode, util.SoftShardingMode, util.NoneShardingMode:
+		default:
+			return fmt.Errorf("invalid value <%q> for %v, it must be one of %v", value, schedulingv1beta1.ShardingModeKey,
+				[]string{util.HardShardingMode, util.SoftShardingMode, util.NoneShardingMode})
+		}
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=jobs,shortName=vcjob;vj
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.autoscaling.replicas,statuspath=.status.autoscaling.replicas,selectorpath=.status.autoscaling.selector
 
 // Job defines the volcano job.
 // +kubebuilder:printcolumn:name="STATUS",type=string,JSONPath=`.status.state.phase`
@@ -123,8 +124,66 @@ type JobSpec struct {
 	// NetworkTopology defines the NetworkTopology config, this field works in conjunction with network topology feature and hyperNode CRD.
 	// +optional
 	NetworkTopology *NetworkTopologySpec `json:"networkTopology,omitempty" protobuf:"bytes,13,opt,name=networkTopology"`
+
+	// PodReplacementPolicy specifies when to create the replacement of a pod being deleted, e.g. evicted by reclaim.
+	// `TerminatingOrFailed` replaces the pod as soon as it is terminating, the terminating pod is force deleted
+	// and may run together with its replacement for a while.
+	// `Failed` waits for the pod to be fully terminated, and the restarting job waits for all its terminating pods,
+	// so that no pod of the job runs twice.
+	// If not set, the replacement is created once the pod is gone, without holding the restarting job.
+	// +kubebuilder:validation:Enum=TerminatingOrFailed;Failed
+	// +optional
+	PodReplacementPolicy *PodReplacementPolicy `json:"podReplacementPolicy,omitempty" protobuf:"bytes,14,opt,name=podReplacementPolicy,casttype=PodReplacementPolicy"`
+
+	// Autoscaling scales the replicas of a task by the scale subresource of the job, e.g. by a KEDA ScaledObject.
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty" protobuf:"bytes,15,opt,name=autoscaling"`
+}
+
+// AutoscalingSpec describes how a task of the job is scaled by the scale subresource.
+// The task is scaled in waves: the minAvailable of the task and the job change with its replicas,
+// so that the replicas of a wave are admitted through the queue and started as a gang.
+type AutoscalingSpec struct {
+	// TaskName is the name of the task scaled, the first task if not set.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	TaskName string `json:"taskName,omitempty" protobuf:"bytes,1,opt,name=taskName"`
+
+	// Replicas is the desired replicas of the task, set by the scale subresource.
+	// The task is scaled towards it by whole waves, keeping at least the desired replicas.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,2,opt,name=replicas"`
+
+	// WaveSize is the number of replicas added or removed at once.
+	// Defaults to 1.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WaveSize int32 `json:"waveSize,omitempty" protobuf:"varint,3,opt,name=waveSize"`
+}
+
+// AutoscalingStatus represents the current status of the task scaled by the scale subresource.
+type AutoscalingStatus struct {
+	// Replicas is the current replicas of the task.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty" protobuf:"varint,1,opt,name=replicas"`
+
+	// Selector is the label selector of the pods of the task, used by the HorizontalPodAutoscaler.
+	// +optional
+	Selector string `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
 }
 
+// PodReplacementPolicy specifies the policy for creating the replacement of the pods of a job.
+type PodReplacementPolicy string
+
+const (
+	// PodReplacementTerminatingOrFailed means that the replacement is created as soon as the pod is terminating.
+	PodReplacementTerminatingOrFailed PodReplacementPolicy = "TerminatingOrFailed"
+	// PodReplacementFailed means that the replacement is created only once the pod is fully terminated.
+	PodReplacementFailed PodReplacementPolicy = "Failed"
+)
+
 // NetworkTopologyMode represents the networkTopology mode, valid values are "hard" and "soft".
 // +kubebuilder:validation:Enum=hard;soft
 type NetworkTopologyMode string
@@ -423,6 +482,10 @@ type JobStatus struct {
 	// +patchMergeKey=status
 	// +patchStrategy=merge
 	Conditions []JobCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"status" protobuf:"bytes,13,rep,name=conditions"`
+
+	// The status of the task scaled by the scale subresource.
+	// +optional
+	Autoscaling *AutoscalingStatus `json:"autoscaling,omitempty" protobuf:"bytes,14,opt,name=autoscaling"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
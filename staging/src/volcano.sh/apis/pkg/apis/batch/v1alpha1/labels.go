@@ -27,6 +27,8 @@ const (
 	TaskPartitionID = "volcano.sh/partition-id"
 	// QueueNameKey queue name key used in pod annotation / labels
 	QueueNameKey = "volcano.sh/queue-name"
+	// PriorityClassKey priority class name key used in job labels
+	PriorityClassKey = "volcano.sh/priority-class"
 	// JobNamespaceKey job namespace key
 	JobNamespaceKey = "volcano.sh/job-namespace"
 	// DefaultTaskSpec default task spec value
@@ -45,6 +47,12 @@ const (
 	JobForwardingKey = "volcano.sh/job-forwarding"
 	// ForwardClusterKey cluster key used in pod annotation
 	ForwardClusterKey = "volcano.sh/forward-cluster"
+	// DispatchedClusterKey annotation key for the member cluster a job is dispatched to by its queue
+	DispatchedClusterKey = "volcano.sh/dispatched-cluster"
+	// DispatchedQueueKey annotation key for the queue of the member cluster a job is dispatched to
+	DispatchedQueueKey = "volcano.sh/dispatched-queue"
+	// DispatchedPodGroupPhaseKey annotation key for the phase of the podgroup of a dispatched job in its member cluster
+	DispatchedPodGroupPhaseKey = "volcano.sh/dispatched-podgroup-phase"
 	// OrginalNameKey annotation key for resource name
 	OrginalNameKey = "volcano.sh/burst-name"
 	// BurstToSiloClusterAnnotation labels key for resource only in silo cluster
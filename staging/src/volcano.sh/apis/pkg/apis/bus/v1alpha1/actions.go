@@ -68,4 +68,7 @@ const (
 
 	// CloseQueueAction is the action to close queue
 	CloseQueueAction Action = "CloseQueue"
+
+	// DrainQueueAction is the action to drain queue
+	DrainQueueAction Action = "DrainQueue"
 )
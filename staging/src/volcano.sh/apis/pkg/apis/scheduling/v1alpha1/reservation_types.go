@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReservationPhase is the phase of a Reservation.
+type ReservationPhase string
+
+const (
+	// ReservationPending means the capacity of the Reservation is partially held,
+	// the capacity freed on the selected nodes is held until the Reservation is ready.
+	ReservationPending ReservationPhase = "Pending"
+	// ReservationReady means all the capacity of the Reservation is held.
+	ReservationReady ReservationPhase = "Ready"
+	// ReservationExpired means the TTL of the Reservation has elapsed, its capacity is released.
+	ReservationExpired ReservationPhase = "Expired"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Reservation holds cluster capacity for the jobs of a queue starting later, so that a large gang job is guaranteed
+// space at its start time.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=reservations,scope=Cluster,shortName=rsv
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="QUEUE",type=string,JSONPath=`.spec.queue`
+// +kubebuilder:printcolumn:name="PHASE",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="START",type=date,JSONPath=`.spec.startTime`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+type Reservation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Specification of the desired behavior of the Reservation.
+	Spec ReservationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+
+	// Status represents the capacity held by the Reservation.
+	// +optional
+	Status ReservationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ReservationSpec represents the capacity to reserve.
+type ReservationSpec struct {
+	// Queue is the owner queue of the Reservation, only the jobs of the queue referencing the Reservation
+	// by the volcano.sh/reservation annotation use the reserved capacity.
+	// +kubebuilder:validation:MinLength=1
+	Queue string `json:"queue" protobuf:"bytes,1,opt,name=queue"`
+
+	// Resources is the capacity to reserve.
+	Resources v1.ResourceList `json:"resources" protobuf:"bytes,2,rep,name=resources"`
+
+	// NodeSelector selects the nodes where the capacity is reserved, all the nodes if empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,3,rep,name=nodeSelector"`
+
+	// StartTime is the time the jobs using the Reservation start. The capacity is held from the creation of the
+	// Reservation, the idle reserved capacity is used until then by the jobs backfilled which end before it.
+	// Defaults to the creation time of the Reservation.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,4,opt,name=startTime"`
+
+	// TTL is the duration the capacity is held after the start time, the Reservation expires afterwards.
+	// Defaults to 1 hour.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty" protobuf:"bytes,5,opt,name=ttl"`
+}
+
+// ReservedNode is the capacity held on a node.
+type ReservedNode struct {
+	// NodeName is the name of the node.
+	NodeName string `json:"nodeName" protobuf:"bytes,1,opt,name=nodeName"`
+
+	// Resources is the capacity held on the node.
+	Resources v1.ResourceList `json:"resources" protobuf:"bytes,2,rep,name=resources"`
+}
+
+// ReservationStatus represents the capacity held by a Reservation.
+type ReservationStatus struct {
+	// Phase is the phase of the Reservation.
+	// +optional
+	Phase ReservationPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+
+	// Nodes is the capacity held on each node.
+	// +optional
+	Nodes []ReservedNode `json:"nodes,omitempty" protobuf:"bytes,2,rep,name=nodes"`
+
+	// LastTransitionTime is the last time the phase changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReservationList is a collection of Reservation.
+type ReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is a list of Reservation objects.
+	Items []Reservation `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -51,6 +51,11 @@ const QueueNameAnnotationKey = GroupName + "/queue-name"
 // gate management and the name of the scheduling gate that controls queue admission.
 const QueueAllocationGateKey = GroupName + "/queue-allocation-gate"
 
+// GangAdmissionGateKey is the annotation key to opt-in to gang admission gate
+// management and the name of the scheduling gate held until the job of the pod
+// is admitted by its queue and its gang minimums are met.
+const GangAdmissionGateKey = GroupName + "/gang-admission-gate"
+
 // NodeGroupNameKey is the label key of Node to identify which nodegroup it belongs to.
 const NodeGroupNameKey = AnnotationPrefix + "nodegroup-name"
 
@@ -73,6 +78,15 @@ const JDBMinAvailable = "volcano.sh/jdb-min-available"
 // JDBMaxUnavailable is the key of max unavailable pod number
 const JDBMaxUnavailable = "volcano.sh/jdb-max-unavailable"
 
+// EnablePredicateErrorCacheKey is the key of pod/job override of the predicate error cache of the actions, value "true" or "false"
+const EnablePredicateErrorCacheKey = "volcano.sh/enable-predicate-error-cache"
+
+// PercentageOfNodesToFindKey is the key of pod/job override of the percentage of feasible nodes to find, value between "0" and "100"
+const PercentageOfNodesToFindKey = "volcano.sh/percentage-of-nodes-to-find"
+
+// ShardingModeKey is the key of pod/job override of the shard restriction of the scheduler, value "hard", "soft" or "none"
+const ShardingModeKey = "volcano.sh/sharding-mode"
+
 // NumaPolicyKey is the key of pod numa-topology policy
 const NumaPolicyKey = "volcano.sh/numa-topology-policy"
 
@@ -81,3 +95,204 @@ const TopologyDecisionAnnotation = "volcano.sh/topology-decision"
 
 // PodQosLevel is the key of pod qos level
 const PodQosLevel = "volcano.sh/qos-level"
+
+// SchedulingCycleAnnotationKey is the key of event annotation of the ID of the scheduling cycle the event is recorded in
+const SchedulingCycleAnnotationKey = "volcano.sh/scheduling-cycle"
+
+// UngatedTaskOrderKey is the key of pod annotation of how the task is ordered once the scheduling gates of the pod are removed,
+// value "CreationTime", "GateRemovalTime" or "Boost"
+const UngatedTaskOrderKey = "volcano.sh/ungated-task-order"
+
+// ReservationKey is the key of podgroup/job annotation of the Reservation whose capacity is used by the job,
+// the job must belong to the queue of the Reservation
+const ReservationKey = "volcano.sh/reservation"
+
+// RuntimeEstimateKey is the key of podgroup/job annotation of the estimated runtime of the job, e.g. "30m".
+// It is used by backfill to place the job only if it finishes before the capacity is needed by a larger job.
+const RuntimeEstimateKey = "volcano.sh/runtime-estimate"
+
+// DeadlineKey is the key of podgroup/job annotation of the time the job must complete by in RFC3339 format,
+// e.g. "2025-10-01T06:00:00Z". The jobs are scheduled earliest deadline first by the deadline plugin.
+const DeadlineKey = "volcano.sh/deadline"
+
+// ChurnDecayKey is the key of podgroup/job annotation opting the job out of the priority decay of the aging plugin
+// for the jobs repeatedly failing or evicted after they are allocated, when it is set to "false".
+const ChurnDecayKey = "volcano.sh/churn-decay"
+
+// GangTopologyKey is the key of podgroup/job annotation of the comma separated node labels, e.g.
+// "topology.volcano.sh/rack,topology.kubernetes.io/zone", the members of the gang are placed within one domain of,
+// trying the labels in order.
+const GangTopologyKey = "volcano.sh/gang-topology"
+
+// GangTopologyPolicyKey is the key of podgroup/job annotation of the policy of the GangTopologyKey constraint:
+// "required" keeps the job pending until one domain fits the gang, "preferred" (default) places the members across
+// domains when no domain fits the gang.
+const GangTopologyPolicyKey = "volcano.sh/gang-topology-policy"
+
+const (
+	// GangTopologyRequired is the policy placing the members of the gang within one domain only.
+	GangTopologyRequired = "required"
+	// GangTopologyPreferred is the policy placing the members of the gang within one domain when possible.
+	GangTopologyPreferred = "preferred"
+)
+
+// GangCompositionKey is the key of podgroup/job annotation of the comma separated minimum numbers of members of the
+// gang on each type of node, given by a node label, e.g. "nvidia.com/gpu.product=H100:4,nvidia.com/gpu.product=A100:0"
+// places at least 4 members on H100 nodes and the rest on A100 nodes. The members are placed on the listed types of
+// node only, and the gang is ready when every minimum is met besides its minMember.
+const GangCompositionKey = "volcano.sh/gang-composition"
+
+// GangGroupKey is the key of podgroup/job label of the gang group of the podgroup. The podgroups of a namespace in the
+// same gang group, e.g. a trainer and its parameter servers owned by different controllers, are admitted atomically:
+// their allocations are committed in the cycle every one of them is ready or pipelined, or none is committed.
+const GangGroupKey = "volcano.sh/gang-group"
+
+// GangAdmissionTimeoutKey is the key of podgroup/job annotation of the duration, e.g. "30m", after the creation of the
+// podgroup, from which the gang which is not admitted yet falls back to its GangAdmissionTimeoutPolicyKey.
+const GangAdmissionTimeoutKey = "volcano.sh/gang-admission-timeout"
+
+// GangAdmissionTimeoutPolicyKey is the key of podgroup/job annotation of the policy of the gang once its admission
+// timed out, "Requeue" by default.
+const GangAdmissionTimeoutPolicyKey = "volcano.sh/gang-admission-timeout-policy"
+
+const (
+	// GangAdmissionTimeoutElastic is the policy lowering the minMember of the gang to the members which fit.
+	GangAdmissionTimeoutElastic = "Elastic"
+	// GangAdmissionTimeoutRequeue is the policy releasing the pipelined members of the gang and moving it back to Pending.
+	GangAdmissionTimeoutRequeue = "Requeue"
+)
+
+// GangStagesKey is the key of podgroup/job annotation of the name of the subGroupPolicy whose subGroups are the stages
+// of the gang, ordered by the first value of its matchLabelKeys, e.g. the stages of a pipeline-parallel training. Each
+// stage is all-or-nothing, a stage is only allocated once the previous stages are ready, and the members of a stage
+// are placed close to the members of the adjacent stages.
+const GangStagesKey = "volcano.sh/gang-stages"
+
+const (
+	// ElasticMinReplicasKey is the key of podgroup/job annotation of the minimum number of replicas of an elastic job.
+	// Under reclaim pressure, the scheduler asks an elastic job above its minimum to shrink with a scaleDownRequest
+	// in the status of its PodGroup, and only evicts its members if it has not shrunk by the deadline of the request.
+	ElasticMinReplicasKey = "volcano.sh/elastic-min-replicas"
+	// ElasticMaxReplicasKey is the key of podgroup/job annotation of the maximum number of replicas of an elastic job.
+	ElasticMaxReplicasKey = "volcano.sh/elastic-max-replicas"
+)
+
+// TopologySpreadPolicyKey is the key of podgroup/job annotation of the policy applying the topologySpreadConstraints
+// of the pods of the job to the whole gang: "spread" spreads the members of the gang evenly across the domains,
+// "pack" keeps them in as few domains as possible.
+const TopologySpreadPolicyKey = "volcano.sh/topology-spread-policy"
+
+const (
+	// TopologySpreadPolicySpread is the policy spreading the members of the gang evenly across the domains.
+	TopologySpreadPolicySpread = "spread"
+	// TopologySpreadPolicyPack is the policy packing the members of the gang in as few domains as possible.
+	TopologySpreadPolicyPack = "pack"
+)
+
+// GPUResetAgentAnnotationKey is the key of node annotation set by the volcano agent of the node which cleans up the GPUs
+// on request, the scheduler only requests the GPU cleanup from the nodes with the annotation
+const GPUResetAgentAnnotationKey = "volcano.sh/gpu-reset-agent"
+
+// GPUResetRequestAnnotationKey is the key of node annotation set by the scheduler after evicting GPU tasks of a queue
+// for the tasks of another queue, the value is the time of the request in RFC3339 format. The GPU tasks are not bound
+// to the node until the volcano agent confirms the cleanup, or the request times out.
+const GPUResetRequestAnnotationKey = "volcano.sh/gpu-reset-request"
+
+// GPUResetDoneAnnotationKey is the key of node annotation set by the volcano agent to the value of the GPU cleanup
+// request it has done
+const GPUResetDoneAnnotationKey = "volcano.sh/gpu-reset-done"
+
+// MIGReconfigureRequestAnnotationKey is the key of node annotation set by the scheduler when a pod requesting a mig
+// profile fits none of the GPUs of the node, while some GPUs could provide the profile by another allowed mig geometry.
+// The value is the comma separated list of <GPU UUID>=<profile> the agent of the node may repartition the GPUs for.
+const MIGReconfigureRequestAnnotationKey = "volcano.sh/mig-reconfigure-request"
+
+// MigratableKey is the key of pod/podgroup annotation allowing the scheduler to migrate the running pods to another
+// node by checkpoint and restore, instead of evicting them, when the PodMigration feature is enabled.
+const MigratableKey = "volcano.sh/migratable"
+
+// MigrationTargetKey is the key of pod annotation set by the scheduler to ask the checkpoint/restore agent of the node
+// of the pod to checkpoint it, the value is the node the pod is migrated to.
+const MigrationTargetKey = "volcano.sh/migration-target"
+
+// MigrationCheckpointKey is the key of pod annotation set by the checkpoint/restore agent once the pod is checkpointed,
+// the value is the reference of the checkpoint, e.g. the checkpoint image, the pod is restored from.
+const MigrationCheckpointKey = "volcano.sh/migration-checkpoint"
+
+// RestoreFromKey is the key of pod annotation of the pod recreated on the target node of a migration, the value is the
+// reference of the checkpoint the checkpoint/restore agent of the node restores the pod from.
+const RestoreFromKey = "volcano.sh/restore-from"
+
+// SpotPlacementKey is the key of pod/podgroup annotation setting the placement of the pods on the interruptible nodes,
+// e.g. spot instances, by the spot plugin: prefer, forbid, or allow, which keeps them off the placement derived from
+// their job.
+const SpotPlacementKey = "volcano.sh/spot-placement"
+
+const (
+	// SpotPlacementPrefer steers the pods toward the interruptible nodes.
+	SpotPlacementPrefer = "prefer"
+	// SpotPlacementForbid keeps the pods on the on-demand nodes.
+	SpotPlacementForbid = "forbid"
+	// SpotPlacementAllow places the pods on any node.
+	SpotPlacementAllow = "allow"
+)
+
+// NodeHourlyCostAnnotationKey is the key of node annotation setting the cost of the node per hour, e.g. "0.096", in the
+// currency of the budgets of the queues. It overrides the price of the node given by the pricing provider of the cost
+// plugin.
+const NodeHourlyCostAnnotationKey = "volcano.sh/hourly-cost"
+
+// VMIGroupLabelKey is the key of KubeVirt VirtualMachineInstance label, propagated to its virt-launcher pod, grouping
+// the VirtualMachineInstances gang scheduled together into a PodGroup named vmi-group-<value>.
+const VMIGroupLabelKey = "volcano.sh/vmi-group"
+
+// VMIGroupMinMemberLabelKey is the key of KubeVirt VirtualMachineInstance label setting the minimum number of
+// VirtualMachineInstances of its group scheduled together, 1 by default.
+const VMIGroupMinMemberLabelKey = "volcano.sh/vmi-group-min-member"
+
+// SparkMinExecutorsAnnotationKey is the key of Spark driver pod annotation setting the minimum number of executors of
+// the application, e.g. spark.dynamicAllocation.minExecutors, whose requests are reserved in the minResources of the
+// PodGroup of the driver once its first executor joins it.
+const SparkMinExecutorsAnnotationKey = "volcano.sh/spark-min-executors"
+
+// ArgoGangAnnotationKey is the key of Argo Workflows template annotation, propagated to its pods, gang scheduling the
+// pods of a workflow sharing its value in a PodGroup. With an empty value, the pods of the same fan-out step, e.g. of
+// withItems or withParam, are gang scheduled together.
+const ArgoGangAnnotationKey = "volcano.sh/argo-gang"
+
+// ArgoGangMinMemberAnnotationKey is the key of Argo Workflows template annotation setting the minimum number of pods
+// of its gang scheduled together, 1 by default.
+const ArgoGangMinMemberAnnotationKey = "volcano.sh/argo-gang-min-member"
+
+// DatasetsAnnotationKey is the key of pod annotation listing the datasets read by the pod, separated by commas, as
+// <name> in the namespace of the pod or <namespace>/<name>.
+const DatasetsAnnotationKey = "volcano.sh/datasets"
+
+// CachedDatasetsAnnotationKey is the key of node annotation listing the datasets cached on the node, separated by
+// commas, as <namespace>/<name>, optionally followed by =<ratio> of the dataset cached between 0 and 1.
+const CachedDatasetsAnnotationKey = "volcano.sh/cached-datasets"
+
+// ActiveQuotaScheduleAnnotationKey is the key of queue annotation naming the window of the quota schedules of the
+// queue whose quotas the queue controller applied to the spec of the queue.
+const ActiveQuotaScheduleAnnotationKey = "volcano.sh/active-quota-schedule"
+
+// QuotaScheduleBaselineAnnotationKey is the key of queue annotation keeping the capability and the deserved resources
+// of the spec of the queue, as JSON, restored by the queue controller once no window of its quota schedules applies.
+const QuotaScheduleBaselineAnnotationKey = "volcano.sh/quota-schedule-baseline"
+
+// DeservedNodeSelectorAnnotationKey is the key of queue annotation holding a label selector of nodes, the queue
+// controller keeping the deserved resources of the queue at the allocatable resources of the ready and schedulable
+// nodes it selects. An empty selector selects all nodes.
+const DeservedNodeSelectorAnnotationKey = "volcano.sh/deserved-node-selector"
+
+// SubmitterAnnotationKey is the key of job and podgroup annotation naming the user who submitted the job, set by the
+// admission webhook to account the job to the sub-quotas of its queue.
+const SubmitterAnnotationKey = "volcano.sh/submitter"
+
+// SubmitterGroupsAnnotationKey is the key of job and podgroup annotation listing the groups of the user who submitted
+// the job, separated by commas, set by the admission webhook along with SubmitterAnnotationKey.
+const SubmitterGroupsAnnotationKey = "volcano.sh/submitter-groups"
+
+// DrainDeadlineAnnotationKey is the key of queue annotation setting the time, in RFC 3339 format, after which the jobs
+// still running in the queue are evicted while it is draining.
+const DrainDeadlineAnnotationKey = "volcano.sh/drain-deadline"
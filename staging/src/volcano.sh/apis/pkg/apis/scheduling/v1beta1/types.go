@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,6 +32,8 @@ const (
 	QueueStateClosed QueueState = "Closed"
 	// QueueStateClosing indicate `Closing` state of queue
 	QueueStateClosing QueueState = "Closing"
+	// QueueStateDraining indicate `Draining` state of queue
+	QueueStateDraining QueueState = "Draining"
 	// QueueStateUnknown indicate `Unknown` state of queue
 	QueueStateUnknown QueueState = "Unknown"
 )
@@ -67,6 +70,18 @@ const (
 
 	// PodGroupScheduled is scheduled event type
 	PodGroupScheduled PodGroupConditionType = "Scheduled"
+
+	// PodGroupBindFailedType is the condition type recorded when a task of the pod group
+	// failed to be bound after it was allocated, and the allocation has been rolled back
+	PodGroupBindFailedType PodGroupConditionType = "BindFailed"
+
+	// PodGroupDeadlineMissedType is the condition type recorded when the pod group can no longer
+	// complete before the deadline set by its volcano.sh/deadline annotation
+	PodGroupDeadlineMissedType PodGroupConditionType = "DeadlineMissed"
+
+	// PodGroupWaitingForProvisioningType is the condition type recorded while the nodes requested from the cluster
+	// autoscaler for the pod group, which could not be scheduled for lack of capacity, are being provisioned
+	PodGroupWaitingForProvisioningType PodGroupConditionType = "WaitingForProvisioning"
 )
 
 type PodGroupConditionDetail string
@@ -115,6 +130,9 @@ const (
 	// NotEnoughPodsReason is probed if there're not enough tasks compared to `spec.minMember`
 	NotEnoughPodsReason string = "NotEnoughTasks"
 
+	// BindFailedReason is probed if a task of PodGroup failed to bind to the allocated node
+	BindFailedReason string = "BindFailed"
+
 	// NotEnoughPodsOfTaskReason is probed if there're not enough pods of task compared to `spec.minTaskMember`
 	NotEnoughPodsOfTaskReason string = "NotEnoughPodsOfTask"
 )
@@ -139,6 +157,8 @@ const (
 	OpenQueueAction QueueAction = "OpenQueue"
 	// CloseQueueAction is the action to close queue
 	CloseQueueAction QueueAction = "CloseQueue"
+	// DrainQueueAction is the action to drain queue
+	DrainQueueAction QueueAction = "DrainQueue"
 )
 
 // +genclient
@@ -320,6 +340,94 @@ type PodGroupStatus struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	Failed int32 `json:"failed,omitempty" protobuf:"bytes,5,opt,name=failed"`
+
+	// TopologyDecision records the topology domain the scheduler chose for the
+	// PodGroup and where each of its tasks was placed, so that launchers can
+	// derive communication topology hints without inspecting nodes.
+	// +optional
+	TopologyDecision *TopologyDecision `json:"topologyDecision,omitempty" protobuf:"bytes,6,opt,name=topologyDecision"`
+
+	// TaskMembers tracks the members of each task with a minimum in minTaskMember, sorted by task name.
+	// +optional
+	TaskMembers []TaskMemberStatus `json:"taskMembers,omitempty" protobuf:"bytes,7,rep,name=taskMembers"`
+
+	// ScaleDownRequest asks the controller of an elastic PodGroup to shrink it,
+	// it is set by the scheduler instead of evicting the members under reclaim pressure.
+	// +optional
+	ScaleDownRequest *ScaleDownRequest `json:"scaleDownRequest,omitempty" protobuf:"bytes,8,opt,name=scaleDownRequest"`
+}
+
+// ScaleDownRequest is a request to shrink an elastic PodGroup before its members are evicted.
+type ScaleDownRequest struct {
+	// Replicas is the number of members the PodGroup is asked to shrink to.
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas" protobuf:"bytes,1,opt,name=replicas"`
+
+	// Reason is the reason of the request.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+
+	// RequestTime is the time the request was made.
+	// +optional
+	RequestTime metav1.Time `json:"requestTime,omitempty" protobuf:"bytes,3,opt,name=requestTime"`
+
+	// Deadline is the time after which the members of the PodGroup are evicted
+	// if it has not shrunk to Replicas.
+	// +optional
+	Deadline metav1.Time `json:"deadline,omitempty" protobuf:"bytes,4,opt,name=deadline"`
+}
+
+// TaskMemberStatus is the number of members of a task of a PodGroup against its minimum in minTaskMember.
+type TaskMemberStatus struct {
+	// Name is the name of the task.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// MinMember is the minimum number of members of the task.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinMember int32 `json:"minMember,omitempty" protobuf:"bytes,2,opt,name=minMember"`
+
+	// Scheduled is the number of members of the task scheduled to the nodes.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Scheduled int32 `json:"scheduled,omitempty" protobuf:"bytes,3,opt,name=scheduled"`
+
+	// Running is the number of members of the task running.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Running int32 `json:"running,omitempty" protobuf:"bytes,4,opt,name=running"`
+}
+
+// TopologyDecision is the topology placement chosen for a PodGroup.
+type TopologyDecision struct {
+	// HyperNode is the lowest HyperNode that contains all placed tasks.
+	// +optional
+	HyperNode string `json:"hyperNode,omitempty" protobuf:"bytes,1,opt,name=hyperNode"`
+
+	// Tier is the tier of HyperNode.
+	// +optional
+	Tier int32 `json:"tier,omitempty" protobuf:"bytes,2,opt,name=tier"`
+
+	// Placements lists the node and leaf HyperNode of each placed task, sorted by task name.
+	// +optional
+	Placements []TaskPlacement `json:"placements,omitempty" protobuf:"bytes,3,rep,name=placements"`
+}
+
+// TaskPlacement is the placement of a single task of a PodGroup.
+type TaskPlacement struct {
+	// Name is the name of the pod.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// TaskSpec is the name of the task spec the pod belongs to.
+	// +optional
+	TaskSpec string `json:"taskSpec,omitempty" protobuf:"bytes,2,opt,name=taskSpec"`
+
+	// NodeName is the node the pod is placed on.
+	NodeName string `json:"nodeName" protobuf:"bytes,3,opt,name=nodeName"`
+
+	// HyperNode is the lowest-tier HyperNode containing NodeName.
+	// +optional
+	HyperNode string `json:"hyperNode,omitempty" protobuf:"bytes,4,opt,name=hyperNode"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -387,7 +495,7 @@ type Reservation struct {
 // QueueStatus represents the status of Queue.
 type QueueStatus struct {
 	// State is state of queue
-	// +kubebuilder:validation:Enum=Open;Closed;Closing;Unknown
+	// +kubebuilder:validation:Enum=Open;Closed;Closing;Draining;Unknown
 	// +optional
 	State QueueState `json:"state,omitempty" protobuf:"bytes,1,opt,name=state"`
 
@@ -418,6 +526,74 @@ type QueueStatus struct {
 	// Allocated is allocated resources in queue
 	// +optional
 	Allocated v1.ResourceList `json:"allocated" protobuf:"bytes,8,opt,name=allocated"`
+
+	// Conditions are the current conditions of the queue
+	// +optional
+	Conditions []QueueCondition `json:"conditions,omitempty" protobuf:"bytes,9,rep,name=conditions"`
+
+	// Reclaim is the statistics of the reclaims involving the queue over the recent scheduling cycles
+	// +optional
+	Reclaim *QueueReclaimStatus `json:"reclaim,omitempty" protobuf:"bytes,10,opt,name=reclaim"`
+
+	// Cost is the cost spent by the jobs of the queue in the current month, tracked by the cost plugin of the scheduler
+	// +optional
+	Cost *QueueCostStatus `json:"cost,omitempty" protobuf:"bytes,11,opt,name=cost"`
+}
+
+// QueueCostStatus is the cost spent by the jobs of a queue in a calendar month.
+type QueueCostStatus struct {
+	// Spent is the cost spent by the jobs of the queue since the start of the period.
+	Spent resource.Quantity `json:"spent" protobuf:"bytes,1,opt,name=spent"`
+	// PeriodStart is the start of the calendar month the cost is spent in, in UTC.
+	PeriodStart metav1.Time `json:"periodStart" protobuf:"bytes,2,opt,name=periodStart"`
+}
+
+// QueueReclaimStatus is the statistics of the reclaims involving a queue, counted by the scheduler
+// over a sliding window of one hour.
+type QueueReclaimStatus struct {
+	// The number of tasks of other queues evicted to reclaim resources for this queue.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Reclaiming int32 `json:"reclaiming,omitempty" protobuf:"varint,1,opt,name=reclaiming"`
+	// The number of tasks of this queue evicted to reclaim resources for other queues.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Reclaimed int32 `json:"reclaimed,omitempty" protobuf:"varint,2,opt,name=reclaimed"`
+	// LastReclaimTime is the last time a task was evicted to reclaim resources for or from this queue.
+	// +optional
+	LastReclaimTime *metav1.Time `json:"lastReclaimTime,omitempty" protobuf:"bytes,3,opt,name=lastReclaimTime"`
+}
+
+// QueueConditionType is the type of queue condition.
+type QueueConditionType string
+
+const (
+	// QueueFairShareViolated means the realized share of the queue stayed below its deserved
+	// share over the fairness audit window, while the queue had pending demand
+	QueueFairShareViolated QueueConditionType = "FairShareViolated"
+	// QueueOverBudget means the cost spent by the jobs of the queue in the current month reached its monthly budget
+	QueueOverBudget QueueConditionType = "OverBudget"
+)
+
+// QueueCondition contains details for the current condition of this queue.
+type QueueCondition struct {
+	// Type is the type of the condition
+	Type QueueConditionType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+
+	// Status is the status of the condition.
+	Status v1.ConditionStatus `json:"status,omitempty" protobuf:"bytes,2,opt,name=status"`
+
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
+
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
 }
 
 // CluterSpec represents the template of Cluster
@@ -503,8 +679,212 @@ type QueueSpec struct {
 	// +kubebuilder:default:=traverse
 	// +kubebuilder:validation:Enum=fifo;traverse
 	DequeueStrategy DequeueStrategy `json:"dequeueStrategy,omitempty" protobuf:"bytes,11,opt,name=dequeueStrategy"`
+
+	// MaxRunSeconds is the maximum time in seconds the jobs of the queue may run, the jobs are not limited if not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxRunSeconds *int64 `json:"maxRunSeconds,omitempty" protobuf:"varint,12,opt,name=maxRunSeconds"`
+
+	// MaxRunPolicy is what happens to the jobs running longer than MaxRunSeconds, Terminate by default.
+	// +kubebuilder:validation:Enum=Terminate;Reclaim
+	// +optional
+	MaxRunPolicy MaxRunPolicy `json:"maxRunPolicy,omitempty" protobuf:"bytes,13,opt,name=maxRunPolicy"`
+
+	// Budget is the monthly cost budget of the queue, the cost of the queue is not limited if not set.
+	// +optional
+	Budget *QueueBudget `json:"budget,omitempty" protobuf:"bytes,14,opt,name=budget"`
+
+	// Dispatch dispatches the jobs of the queue to member clusters instead of running them in this cluster.
+	// +optional
+	Dispatch *QueueDispatchPolicy `json:"dispatch,omitempty" protobuf:"bytes,15,opt,name=dispatch"`
+
+	// Burst lets the queue use more than its deserved resources for a while, within a token bucket.
+	// +optional
+	Burst *QueueBurst `json:"burst,omitempty" protobuf:"bytes,16,opt,name=burst"`
+
+	// QuotaSchedules override the capability and the deserved resources of the queue within recurring time windows,
+	// applied by the queue controller. The first window containing the current time applies, the quotas of the spec
+	// apply out of all the windows.
+	// +optional
+	QuotaSchedules []QueueQuotaSchedule `json:"quotaSchedules,omitempty" protobuf:"bytes,17,rep,name=quotaSchedules"`
+
+	// NodeSelector binds the queue to the pool of nodes with these labels: the jobs of the queue run on the nodes of
+	// the pool only, and its deserved resources and capability are limited to the resources of the pool.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,18,rep,name=nodeSelector"`
+
+	// NamespacePolicy binds the queue to the namespaces selected by their labels: only their jobs may be submitted to
+	// the queue, and it may be the default queue of their jobs.
+	// +optional
+	NamespacePolicy *QueueNamespacePolicy `json:"namespacePolicy,omitempty" protobuf:"bytes,19,opt,name=namespacePolicy"`
+
+	// SubQuotas share the queue among its users and groups: the jobs submitted by the users and groups of a sub-quota
+	// use at most its deserved resources in the queue. The jobs of the other submitters are only limited by the queue.
+	// +optional
+	SubQuotas []QueueSubQuota `json:"subQuotas,omitempty" protobuf:"bytes,20,rep,name=subQuotas"`
+
+	// DeletionPolicy is what happens to the jobs of the queue when it is deleted, the jobs are left in place if not
+	// set.
+	// +optional
+	DeletionPolicy *QueueDeletionPolicy `json:"deletionPolicy,omitempty" protobuf:"bytes,21,opt,name=deletionPolicy"`
+}
+
+// QueueQuotaSchedule is a recurring time window of the week and the quotas of a queue within it.
+type QueueQuotaSchedule struct {
+	// Name identifies the window, the queue is annotated with the name of the window applied.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Days are the days of the week the window starts on, every day if empty.
+	// +kubebuilder:validation:items:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	// +optional
+	Days []string `json:"days,omitempty" protobuf:"bytes,2,rep,name=days"`
+
+	// Start is the time of the day the window starts at, HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start" protobuf:"bytes,3,opt,name=start"`
+
+	// End is the time of the day the window ends at, HH:MM, exclusive. The window ends the next day if End is not after
+	// Start.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end" protobuf:"bytes,4,opt,name=end"`
+
+	// TimeZone is the time zone of Start and End, e.g. Europe/Budapest, UTC by default.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,5,opt,name=timeZone"`
+
+	// Capability is the capability of the queue within the window, the capability of the spec if not set.
+	// +optional
+	Capability v1.ResourceList `json:"capability,omitempty" protobuf:"bytes,6,opt,name=capability"`
+
+	// Deserved is the deserved resources of the queue within the window, the deserved resources of the spec if not set.
+	// +optional
+	Deserved v1.ResourceList `json:"deserved,omitempty" protobuf:"bytes,7,opt,name=deserved"`
+}
+
+// QueueBurst is a token bucket of the resources a queue may use above its deserved resources, over time.
+type QueueBurst struct {
+	// Capacity is the size of the token bucket, in resource-seconds used above the deserved resources, e.g. a cpu of
+	// 3600 lets the queue use 4 CPUs above its deserved resources for 15 minutes from a full bucket.
+	Capacity v1.ResourceList `json:"capacity" protobuf:"bytes,1,opt,name=capacity"`
+
+	// RefillRate is the tokens added to the bucket per second, i.e. the resources the queue may use above its
+	// deserved resources for good.
+	// +optional
+	RefillRate v1.ResourceList `json:"refillRate,omitempty" protobuf:"bytes,2,opt,name=refillRate"`
 }
 
+// QueueDispatchPolicy selects the member cluster the jobs of a queue are dispatched to.
+type QueueDispatchPolicy struct {
+	// Clusters are the member clusters the jobs of the queue may be dispatched to, all the member clusters if empty.
+	// +optional
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,1,rep,name=clusters"`
+
+	// Queue is the queue of the member clusters the jobs are dispatched to, the queue of the same name if empty.
+	// +optional
+	Queue string `json:"queue,omitempty" protobuf:"bytes,2,opt,name=queue"`
+
+	// Strategy selects the member cluster among those whose queue has room for the job, MostAvailable by default.
+	// +kubebuilder:validation:Enum=MostAvailable;FirstFit
+	// +optional
+	Strategy DispatchStrategy `json:"strategy,omitempty" protobuf:"bytes,3,opt,name=strategy"`
+}
+
+// QueueNamespacePolicy selects the namespaces a queue is bound to.
+type QueueNamespacePolicy struct {
+	// NamespaceSelector selects the namespaces whose jobs may be submitted to the queue, by their labels, e.g. the
+	// kubernetes.io/metadata.name label for the name of a namespace. All the namespaces are selected if not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty" protobuf:"bytes,1,opt,name=namespaceSelector"`
+
+	// Default makes the queue the default queue of the jobs of the selected namespaces which do not set their queue.
+	// +optional
+	Default bool `json:"default,omitempty" protobuf:"varint,2,opt,name=default"`
+}
+
+// QueueSubQuota is the share of a queue deserved by some of its users and groups.
+type QueueSubQuota struct {
+	// Name identifies the sub-quota.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Users are the names of the users the sub-quota applies to.
+	// +optional
+	Users []string `json:"users,omitempty" protobuf:"bytes,2,rep,name=users"`
+
+	// Groups are the names of the groups the sub-quota applies to.
+	// +optional
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,3,rep,name=groups"`
+
+	// Deserved is the resources the jobs of the users and groups use at most in the queue, in the resource dimensions
+	// set.
+	Deserved v1.ResourceList `json:"deserved" protobuf:"bytes,4,opt,name=deserved"`
+}
+
+// QueueDeletionPolicy is what happens to the jobs of a queue when it is deleted.
+type QueueDeletionPolicy struct {
+	// Type is Forbid to reject the deletion of the queue while it has jobs, Cascade to delete its jobs along with it,
+	// or Orphan to move its jobs to the fallback queue.
+	// +kubebuilder:validation:Enum=Forbid;Cascade;Orphan
+	Type QueueDeletionPolicyType `json:"type" protobuf:"bytes,1,opt,name=type"`
+
+	// FallbackQueue is the queue the jobs are moved to with the Orphan policy.
+	// +optional
+	FallbackQueue string `json:"fallbackQueue,omitempty" protobuf:"bytes,2,opt,name=fallbackQueue"`
+}
+
+// DispatchStrategy defines how the member cluster a job is dispatched to is selected
+type DispatchStrategy string
+
+const (
+	// DispatchStrategyMostAvailable dispatches the job to the member cluster whose queue keeps the largest share of
+	// its capability once the job is placed.
+	DispatchStrategyMostAvailable DispatchStrategy = "MostAvailable"
+	// DispatchStrategyFirstFit dispatches the job to the first member cluster of the list whose queue has room for it.
+	DispatchStrategyFirstFit DispatchStrategy = "FirstFit"
+)
+
+// QueueDeletionPolicyType defines what happens to the jobs of a queue when it is deleted
+type QueueDeletionPolicyType string
+
+const (
+	// QueueDeletionForbid rejects the deletion of the queue while it has jobs not completed.
+	QueueDeletionForbid QueueDeletionPolicyType = "Forbid"
+	// QueueDeletionCascade deletes the jobs of the queue along with it.
+	QueueDeletionCascade QueueDeletionPolicyType = "Cascade"
+	// QueueDeletionOrphan moves the jobs of the queue to its fallback queue.
+	QueueDeletionOrphan QueueDeletionPolicyType = "Orphan"
+)
+
+// QueueBudget is the cost a queue may spend in a calendar month, in the currency of the prices of the nodes.
+type QueueBudget struct {
+	// Monthly is the cost the jobs of the queue may spend in a calendar month.
+	Monthly resource.Quantity `json:"monthly" protobuf:"bytes,1,opt,name=monthly"`
+
+	// Policy is what happens to the jobs of the queue once it exceeded its budget, Deprioritize by default.
+	// +kubebuilder:validation:Enum=Deprioritize;Gate
+	// +optional
+	Policy BudgetPolicy `json:"policy,omitempty" protobuf:"bytes,2,opt,name=policy"`
+}
+
+// BudgetPolicy defines what happens to the jobs of a queue which exceeded its budget
+type BudgetPolicy string
+
+const (
+	// BudgetPolicyDeprioritize schedules the jobs of the queue after the jobs of the queues within their budget.
+	BudgetPolicyDeprioritize BudgetPolicy = "Deprioritize"
+	// BudgetPolicyGate keeps the new jobs of the queue pending until the next month, or a larger budget.
+	BudgetPolicyGate BudgetPolicy = "Gate"
+)
+
+// MaxRunPolicy defines what happens to the jobs running longer than the maxRunSeconds of their queue
+type MaxRunPolicy string
+
+const (
+	// MaxRunPolicyTerminate terminates the jobs as a whole, all the pods of the job are evicted at once.
+	MaxRunPolicyTerminate MaxRunPolicy = "Terminate"
+	// MaxRunPolicyReclaim keeps the jobs running, their pods are the first victims when other queues reclaim resources.
+	MaxRunPolicyReclaim MaxRunPolicy = "Reclaim"
+)
+
 type DequeueStrategy string
 
 const (
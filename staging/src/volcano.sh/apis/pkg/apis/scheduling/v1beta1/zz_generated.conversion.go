@@ -157,6 +157,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QueueBudget)(nil), (*scheduling.QueueBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueBudget_To_scheduling_QueueBudget(a.(*QueueBudget), b.(*scheduling.QueueBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueBudget)(nil), (*QueueBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueBudget_To_v1beta1_QueueBudget(a.(*scheduling.QueueBudget), b.(*QueueBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueBurst)(nil), (*scheduling.QueueBurst)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueBurst_To_scheduling_QueueBurst(a.(*QueueBurst), b.(*scheduling.QueueBurst), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueBurst)(nil), (*QueueBurst)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueBurst_To_v1beta1_QueueBurst(a.(*scheduling.QueueBurst), b.(*QueueBurst), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueCondition)(nil), (*scheduling.QueueCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueCondition_To_scheduling_QueueCondition(a.(*QueueCondition), b.(*scheduling.QueueCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueCondition)(nil), (*QueueCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueCondition_To_v1beta1_QueueCondition(a.(*scheduling.QueueCondition), b.(*QueueCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueCostStatus)(nil), (*scheduling.QueueCostStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueCostStatus_To_scheduling_QueueCostStatus(a.(*QueueCostStatus), b.(*scheduling.QueueCostStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueCostStatus)(nil), (*QueueCostStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueCostStatus_To_v1beta1_QueueCostStatus(a.(*scheduling.QueueCostStatus), b.(*QueueCostStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueDeletionPolicy)(nil), (*scheduling.QueueDeletionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueDeletionPolicy_To_scheduling_QueueDeletionPolicy(a.(*QueueDeletionPolicy), b.(*scheduling.QueueDeletionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueDeletionPolicy)(nil), (*QueueDeletionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueDeletionPolicy_To_v1beta1_QueueDeletionPolicy(a.(*scheduling.QueueDeletionPolicy), b.(*QueueDeletionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueDispatchPolicy)(nil), (*scheduling.QueueDispatchPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueDispatchPolicy_To_scheduling_QueueDispatchPolicy(a.(*QueueDispatchPolicy), b.(*scheduling.QueueDispatchPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueDispatchPolicy)(nil), (*QueueDispatchPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueDispatchPolicy_To_v1beta1_QueueDispatchPolicy(a.(*scheduling.QueueDispatchPolicy), b.(*QueueDispatchPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QueueList)(nil), (*scheduling.QueueList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QueueList_To_scheduling_QueueList(a.(*QueueList), b.(*scheduling.QueueList), scope)
 	}); err != nil {
@@ -167,6 +227,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QueueNamespacePolicy)(nil), (*scheduling.QueueNamespacePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueNamespacePolicy_To_scheduling_QueueNamespacePolicy(a.(*QueueNamespacePolicy), b.(*scheduling.QueueNamespacePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueNamespacePolicy)(nil), (*QueueNamespacePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueNamespacePolicy_To_v1beta1_QueueNamespacePolicy(a.(*scheduling.QueueNamespacePolicy), b.(*QueueNamespacePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueQuotaSchedule)(nil), (*scheduling.QueueQuotaSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueQuotaSchedule_To_scheduling_QueueQuotaSchedule(a.(*QueueQuotaSchedule), b.(*scheduling.QueueQuotaSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueQuotaSchedule)(nil), (*QueueQuotaSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueQuotaSchedule_To_v1beta1_QueueQuotaSchedule(a.(*scheduling.QueueQuotaSchedule), b.(*QueueQuotaSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QueueReclaimStatus)(nil), (*scheduling.QueueReclaimStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueReclaimStatus_To_scheduling_QueueReclaimStatus(a.(*QueueReclaimStatus), b.(*scheduling.QueueReclaimStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueReclaimStatus)(nil), (*QueueReclaimStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueReclaimStatus_To_v1beta1_QueueReclaimStatus(a.(*scheduling.QueueReclaimStatus), b.(*QueueReclaimStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QueueSpec)(nil), (*scheduling.QueueSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QueueSpec_To_scheduling_QueueSpec(a.(*QueueSpec), b.(*scheduling.QueueSpec), scope)
 	}); err != nil {
@@ -187,6 +277,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QueueSubQuota)(nil), (*scheduling.QueueSubQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QueueSubQuota_To_scheduling_QueueSubQuota(a.(*QueueSubQuota), b.(*scheduling.QueueSubQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.QueueSubQuota)(nil), (*QueueSubQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_QueueSubQuota_To_v1beta1_QueueSubQuota(a.(*scheduling.QueueSubQuota), b.(*QueueSubQuota), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Reservation)(nil), (*scheduling.Reservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Reservation_To_scheduling_Reservation(a.(*Reservation), b.(*scheduling.Reservation), scope)
 	}); err != nil {
@@ -197,6 +297,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScaleDownRequest)(nil), (*scheduling.ScaleDownRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ScaleDownRequest_To_scheduling_ScaleDownRequest(a.(*ScaleDownRequest), b.(*scheduling.ScaleDownRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.ScaleDownRequest)(nil), (*ScaleDownRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_ScaleDownRequest_To_v1beta1_ScaleDownRequest(a.(*scheduling.ScaleDownRequest), b.(*ScaleDownRequest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SubGroupPolicySpec)(nil), (*scheduling.SubGroupPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SubGroupPolicySpec_To_scheduling_SubGroupPolicySpec(a.(*SubGroupPolicySpec), b.(*scheduling.SubGroupPolicySpec), scope)
 	}); err != nil {
@@ -207,6 +317,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TaskMemberStatus)(nil), (*scheduling.TaskMemberStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TaskMemberStatus_To_scheduling_TaskMemberStatus(a.(*TaskMemberStatus), b.(*scheduling.TaskMemberStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.TaskMemberStatus)(nil), (*TaskMemberStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_TaskMemberStatus_To_v1beta1_TaskMemberStatus(a.(*scheduling.TaskMemberStatus), b.(*TaskMemberStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TaskPlacement)(nil), (*scheduling.TaskPlacement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TaskPlacement_To_scheduling_TaskPlacement(a.(*TaskPlacement), b.(*scheduling.TaskPlacement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.TaskPlacement)(nil), (*TaskPlacement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_TaskPlacement_To_v1beta1_TaskPlacement(a.(*scheduling.TaskPlacement), b.(*TaskPlacement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TopologyDecision)(nil), (*scheduling.TopologyDecision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TopologyDecision_To_scheduling_TopologyDecision(a.(*TopologyDecision), b.(*scheduling.TopologyDecision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*scheduling.TopologyDecision)(nil), (*TopologyDecision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_scheduling_TopologyDecision_To_v1beta1_TopologyDecision(a.(*scheduling.TopologyDecision), b.(*TopologyDecision), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -466,6 +606,9 @@ func autoConvert_v1beta1_PodGroupStatus_To_scheduling_PodGroupStatus(in *PodGrou
 	out.Running = in.Running
 	out.Succeeded = in.Succeeded
 	out.Failed = in.Failed
+	out.TopologyDecision = (*scheduling.TopologyDecision)(unsafe.Pointer(in.TopologyDecision))
+	out.TaskMembers = *(*[]scheduling.TaskMemberStatus)(unsafe.Pointer(&in.TaskMembers))
+	out.ScaleDownRequest = (*scheduling.ScaleDownRequest)(unsafe.Pointer(in.ScaleDownRequest))
 	return nil
 }
 
@@ -480,6 +623,9 @@ func autoConvert_scheduling_PodGroupStatus_To_v1beta1_PodGroupStatus(in *schedul
 	out.Running = in.Running
 	out.Succeeded = in.Succeeded
 	out.Failed = in.Failed
+	out.TopologyDecision = (*TopologyDecision)(unsafe.Pointer(in.TopologyDecision))
+	out.TaskMembers = *(*[]TaskMemberStatus)(unsafe.Pointer(&in.TaskMembers))
+	out.ScaleDownRequest = (*ScaleDownRequest)(unsafe.Pointer(in.ScaleDownRequest))
 	return nil
 }
 
@@ -520,6 +666,146 @@ func Convert_scheduling_Queue_To_v1beta1_Queue(in *scheduling.Queue, out *Queue,
 	return autoConvert_scheduling_Queue_To_v1beta1_Queue(in, out, s)
 }
 
+func autoConvert_v1beta1_QueueBudget_To_scheduling_QueueBudget(in *QueueBudget, out *scheduling.QueueBudget, s conversion.Scope) error {
+	out.Monthly = in.Monthly
+	out.Policy = scheduling.BudgetPolicy(in.Policy)
+	return nil
+}
+
+// Convert_v1beta1_QueueBudget_To_scheduling_QueueBudget is an autogenerated conversion function.
+func Convert_v1beta1_QueueBudget_To_scheduling_QueueBudget(in *QueueBudget, out *scheduling.QueueBudget, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueBudget_To_scheduling_QueueBudget(in, out, s)
+}
+
+func autoConvert_scheduling_QueueBudget_To_v1beta1_QueueBudget(in *scheduling.QueueBudget, out *QueueBudget, s conversion.Scope) error {
+	out.Monthly = in.Monthly
+	out.Policy = BudgetPolicy(in.Policy)
+	return nil
+}
+
+// Convert_scheduling_QueueBudget_To_v1beta1_QueueBudget is an autogenerated conversion function.
+func Convert_scheduling_QueueBudget_To_v1beta1_QueueBudget(in *scheduling.QueueBudget, out *QueueBudget, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueBudget_To_v1beta1_QueueBudget(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueBurst_To_scheduling_QueueBurst(in *QueueBurst, out *scheduling.QueueBurst, s conversion.Scope) error {
+	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.RefillRate = *(*v1.ResourceList)(unsafe.Pointer(&in.RefillRate))
+	return nil
+}
+
+// Convert_v1beta1_QueueBurst_To_scheduling_QueueBurst is an autogenerated conversion function.
+func Convert_v1beta1_QueueBurst_To_scheduling_QueueBurst(in *QueueBurst, out *scheduling.QueueBurst, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueBurst_To_scheduling_QueueBurst(in, out, s)
+}
+
+func autoConvert_scheduling_QueueBurst_To_v1beta1_QueueBurst(in *scheduling.QueueBurst, out *QueueBurst, s conversion.Scope) error {
+	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.RefillRate = *(*v1.ResourceList)(unsafe.Pointer(&in.RefillRate))
+	return nil
+}
+
+// Convert_scheduling_QueueBurst_To_v1beta1_QueueBurst is an autogenerated conversion function.
+func Convert_scheduling_QueueBurst_To_v1beta1_QueueBurst(in *scheduling.QueueBurst, out *QueueBurst, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueBurst_To_v1beta1_QueueBurst(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueCondition_To_scheduling_QueueCondition(in *QueueCondition, out *scheduling.QueueCondition, s conversion.Scope) error {
+	out.Type = scheduling.QueueConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_QueueCondition_To_scheduling_QueueCondition is an autogenerated conversion function.
+func Convert_v1beta1_QueueCondition_To_scheduling_QueueCondition(in *QueueCondition, out *scheduling.QueueCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueCondition_To_scheduling_QueueCondition(in, out, s)
+}
+
+func autoConvert_scheduling_QueueCondition_To_v1beta1_QueueCondition(in *scheduling.QueueCondition, out *QueueCondition, s conversion.Scope) error {
+	out.Type = QueueConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_scheduling_QueueCondition_To_v1beta1_QueueCondition is an autogenerated conversion function.
+func Convert_scheduling_QueueCondition_To_v1beta1_QueueCondition(in *scheduling.QueueCondition, out *QueueCondition, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueCondition_To_v1beta1_QueueCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueCostStatus_To_scheduling_QueueCostStatus(in *QueueCostStatus, out *scheduling.QueueCostStatus, s conversion.Scope) error {
+	out.Spent = in.Spent
+	out.PeriodStart = in.PeriodStart
+	return nil
+}
+
+// Convert_v1beta1_QueueCostStatus_To_scheduling_QueueCostStatus is an autogenerated conversion function.
+func Convert_v1beta1_QueueCostStatus_To_scheduling_QueueCostStatus(in *QueueCostStatus, out *scheduling.QueueCostStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueCostStatus_To_scheduling_QueueCostStatus(in, out, s)
+}
+
+func autoConvert_scheduling_QueueCostStatus_To_v1beta1_QueueCostStatus(in *scheduling.QueueCostStatus, out *QueueCostStatus, s conversion.Scope) error {
+	out.Spent = in.Spent
+	out.PeriodStart = in.PeriodStart
+	return nil
+}
+
+// Convert_scheduling_QueueCostStatus_To_v1beta1_QueueCostStatus is an autogenerated conversion function.
+func Convert_scheduling_QueueCostStatus_To_v1beta1_QueueCostStatus(in *scheduling.QueueCostStatus, out *QueueCostStatus, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueCostStatus_To_v1beta1_QueueCostStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueDeletionPolicy_To_scheduling_QueueDeletionPolicy(in *QueueDeletionPolicy, out *scheduling.QueueDeletionPolicy, s conversion.Scope) error {
+	out.Type = scheduling.QueueDeletionPolicyType(in.Type)
+	out.FallbackQueue = in.FallbackQueue
+	return nil
+}
+
+// Convert_v1beta1_QueueDeletionPolicy_To_scheduling_QueueDeletionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_QueueDeletionPolicy_To_scheduling_QueueDeletionPolicy(in *QueueDeletionPolicy, out *scheduling.QueueDeletionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueDeletionPolicy_To_scheduling_QueueDeletionPolicy(in, out, s)
+}
+
+func autoConvert_scheduling_QueueDeletionPolicy_To_v1beta1_QueueDeletionPolicy(in *scheduling.QueueDeletionPolicy, out *QueueDeletionPolicy, s conversion.Scope) error {
+	out.Type = QueueDeletionPolicyType(in.Type)
+	out.FallbackQueue = in.FallbackQueue
+	return nil
+}
+
+// Convert_scheduling_QueueDeletionPolicy_To_v1beta1_QueueDeletionPolicy is an autogenerated conversion function.
+func Convert_scheduling_QueueDeletionPolicy_To_v1beta1_QueueDeletionPolicy(in *scheduling.QueueDeletionPolicy, out *QueueDeletionPolicy, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueDeletionPolicy_To_v1beta1_QueueDeletionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueDispatchPolicy_To_scheduling_QueueDispatchPolicy(in *QueueDispatchPolicy, out *scheduling.QueueDispatchPolicy, s conversion.Scope) error {
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.Queue = in.Queue
+	out.Strategy = scheduling.DispatchStrategy(in.Strategy)
+	return nil
+}
+
+// Convert_v1beta1_QueueDispatchPolicy_To_scheduling_QueueDispatchPolicy is an autogenerated conversion function.
+func Convert_v1beta1_QueueDispatchPolicy_To_scheduling_QueueDispatchPolicy(in *QueueDispatchPolicy, out *scheduling.QueueDispatchPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueDispatchPolicy_To_scheduling_QueueDispatchPolicy(in, out, s)
+}
+
+func autoConvert_scheduling_QueueDispatchPolicy_To_v1beta1_QueueDispatchPolicy(in *scheduling.QueueDispatchPolicy, out *QueueDispatchPolicy, s conversion.Scope) error {
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.Queue = in.Queue
+	out.Strategy = DispatchStrategy(in.Strategy)
+	return nil
+}
+
+// Convert_scheduling_QueueDispatchPolicy_To_v1beta1_QueueDispatchPolicy is an autogenerated conversion function.
+func Convert_scheduling_QueueDispatchPolicy_To_v1beta1_QueueDispatchPolicy(in *scheduling.QueueDispatchPolicy, out *QueueDispatchPolicy, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueDispatchPolicy_To_v1beta1_QueueDispatchPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_QueueList_To_scheduling_QueueList(in *QueueList, out *scheduling.QueueList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]scheduling.Queue)(unsafe.Pointer(&in.Items))
@@ -542,6 +828,84 @@ func Convert_scheduling_QueueList_To_v1beta1_QueueList(in *scheduling.QueueList,
 	return autoConvert_scheduling_QueueList_To_v1beta1_QueueList(in, out, s)
 }
 
+func autoConvert_v1beta1_QueueNamespacePolicy_To_scheduling_QueueNamespacePolicy(in *QueueNamespacePolicy, out *scheduling.QueueNamespacePolicy, s conversion.Scope) error {
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Default = in.Default
+	return nil
+}
+
+// Convert_v1beta1_QueueNamespacePolicy_To_scheduling_QueueNamespacePolicy is an autogenerated conversion function.
+func Convert_v1beta1_QueueNamespacePolicy_To_scheduling_QueueNamespacePolicy(in *QueueNamespacePolicy, out *scheduling.QueueNamespacePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueNamespacePolicy_To_scheduling_QueueNamespacePolicy(in, out, s)
+}
+
+func autoConvert_scheduling_QueueNamespacePolicy_To_v1beta1_QueueNamespacePolicy(in *scheduling.QueueNamespacePolicy, out *QueueNamespacePolicy, s conversion.Scope) error {
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Default = in.Default
+	return nil
+}
+
+// Convert_scheduling_QueueNamespacePolicy_To_v1beta1_QueueNamespacePolicy is an autogenerated conversion function.
+func Convert_scheduling_QueueNamespacePolicy_To_v1beta1_QueueNamespacePolicy(in *scheduling.QueueNamespacePolicy, out *QueueNamespacePolicy, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueNamespacePolicy_To_v1beta1_QueueNamespacePolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueQuotaSchedule_To_scheduling_QueueQuotaSchedule(in *QueueQuotaSchedule, out *scheduling.QueueQuotaSchedule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Days = *(*[]string)(unsafe.Pointer(&in.Days))
+	out.Start = in.Start
+	out.End = in.End
+	out.TimeZone = in.TimeZone
+	out.Capability = *(*v1.ResourceList)(unsafe.Pointer(&in.Capability))
+	out.Deserved = *(*v1.ResourceList)(unsafe.Pointer(&in.Deserved))
+	return nil
+}
+
+// Convert_v1beta1_QueueQuotaSchedule_To_scheduling_QueueQuotaSchedule is an autogenerated conversion function.
+func Convert_v1beta1_QueueQuotaSchedule_To_scheduling_QueueQuotaSchedule(in *QueueQuotaSchedule, out *scheduling.QueueQuotaSchedule, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueQuotaSchedule_To_scheduling_QueueQuotaSchedule(in, out, s)
+}
+
+func autoConvert_scheduling_QueueQuotaSchedule_To_v1beta1_QueueQuotaSchedule(in *scheduling.QueueQuotaSchedule, out *QueueQuotaSchedule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Days = *(*[]string)(unsafe.Pointer(&in.Days))
+	out.Start = in.Start
+	out.End = in.End
+	out.TimeZone = in.TimeZone
+	out.Capability = *(*v1.ResourceList)(unsafe.Pointer(&in.Capability))
+	out.Deserved = *(*v1.ResourceList)(unsafe.Pointer(&in.Deserved))
+	return nil
+}
+
+// Convert_scheduling_QueueQuotaSchedule_To_v1beta1_QueueQuotaSchedule is an autogenerated conversion function.
+func Convert_scheduling_QueueQuotaSchedule_To_v1beta1_QueueQuotaSchedule(in *scheduling.QueueQuotaSchedule, out *QueueQuotaSchedule, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueQuotaSchedule_To_v1beta1_QueueQuotaSchedule(in, out, s)
+}
+
+func autoConvert_v1beta1_QueueReclaimStatus_To_scheduling_QueueReclaimStatus(in *QueueReclaimStatus, out *scheduling.QueueReclaimStatus, s conversion.Scope) error {
+	out.Reclaiming = in.Reclaiming
+	out.Reclaimed = in.Reclaimed
+	out.LastReclaimTime = (*metav1.Time)(unsafe.Pointer(in.LastReclaimTime))
+	return nil
+}
+
+// Convert_v1beta1_QueueReclaimStatus_To_scheduling_QueueReclaimStatus is an autogenerated conversion function.
+func Convert_v1beta1_QueueReclaimStatus_To_scheduling_QueueReclaimStatus(in *QueueReclaimStatus, out *scheduling.QueueReclaimStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueReclaimStatus_To_scheduling_QueueReclaimStatus(in, out, s)
+}
+
+func autoConvert_scheduling_QueueReclaimStatus_To_v1beta1_QueueReclaimStatus(in *scheduling.QueueReclaimStatus, out *QueueReclaimStatus, s conversion.Scope) error {
+	out.Reclaiming = in.Reclaiming
+	out.Reclaimed = in.Reclaimed
+	out.LastReclaimTime = (*metav1.Time)(unsafe.Pointer(in.LastReclaimTime))
+	return nil
+}
+
+// Convert_scheduling_QueueReclaimStatus_To_v1beta1_QueueReclaimStatus is an autogenerated conversion function.
+func Convert_scheduling_QueueReclaimStatus_To_v1beta1_QueueReclaimStatus(in *scheduling.QueueReclaimStatus, out *QueueReclaimStatus, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueReclaimStatus_To_v1beta1_QueueReclaimStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_QueueSpec_To_scheduling_QueueSpec(in *QueueSpec, out *scheduling.QueueSpec, s conversion.Scope) error {
 	out.Weight = in.Weight
 	out.Capability = *(*v1.ResourceList)(unsafe.Pointer(&in.Capability))
@@ -556,6 +920,16 @@ func autoConvert_v1beta1_QueueSpec_To_scheduling_QueueSpec(in *QueueSpec, out *s
 	out.Deserved = *(*v1.ResourceList)(unsafe.Pointer(&in.Deserved))
 	out.Priority = in.Priority
 	out.DequeueStrategy = scheduling.DequeueStrategy(in.DequeueStrategy)
+	out.MaxRunSeconds = (*int64)(unsafe.Pointer(in.MaxRunSeconds))
+	out.MaxRunPolicy = scheduling.MaxRunPolicy(in.MaxRunPolicy)
+	out.Budget = (*scheduling.QueueBudget)(unsafe.Pointer(in.Budget))
+	out.Dispatch = (*scheduling.QueueDispatchPolicy)(unsafe.Pointer(in.Dispatch))
+	out.Burst = (*scheduling.QueueBurst)(unsafe.Pointer(in.Burst))
+	out.QuotaSchedules = *(*[]scheduling.QueueQuotaSchedule)(unsafe.Pointer(&in.QuotaSchedules))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.NamespacePolicy = (*scheduling.QueueNamespacePolicy)(unsafe.Pointer(in.NamespacePolicy))
+	out.SubQuotas = *(*[]scheduling.QueueSubQuota)(unsafe.Pointer(&in.SubQuotas))
+	out.DeletionPolicy = (*scheduling.QueueDeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
 	return nil
 }
 
@@ -578,6 +952,16 @@ func autoConvert_scheduling_QueueSpec_To_v1beta1_QueueSpec(in *scheduling.QueueS
 	out.Deserved = *(*v1.ResourceList)(unsafe.Pointer(&in.Deserved))
 	out.Priority = in.Priority
 	out.DequeueStrategy = DequeueStrategy(in.DequeueStrategy)
+	out.MaxRunSeconds = (*int64)(unsafe.Pointer(in.MaxRunSeconds))
+	out.MaxRunPolicy = MaxRunPolicy(in.MaxRunPolicy)
+	out.Budget = (*QueueBudget)(unsafe.Pointer(in.Budget))
+	out.Dispatch = (*QueueDispatchPolicy)(unsafe.Pointer(in.Dispatch))
+	out.Burst = (*QueueBurst)(unsafe.Pointer(in.Burst))
+	out.QuotaSchedules = *(*[]QueueQuotaSchedule)(unsafe.Pointer(&in.QuotaSchedules))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.NamespacePolicy = (*QueueNamespacePolicy)(unsafe.Pointer(in.NamespacePolicy))
+	out.SubQuotas = *(*[]QueueSubQuota)(unsafe.Pointer(&in.SubQuotas))
+	out.DeletionPolicy = (*QueueDeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
 	return nil
 }
 
@@ -597,6 +981,9 @@ func autoConvert_v1beta1_QueueStatus_To_scheduling_QueueStatus(in *QueueStatus,
 		return err
 	}
 	out.Allocated = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Conditions = *(*[]scheduling.QueueCondition)(unsafe.Pointer(&in.Conditions))
+	out.Reclaim = (*scheduling.QueueReclaimStatus)(unsafe.Pointer(in.Reclaim))
+	out.Cost = (*scheduling.QueueCostStatus)(unsafe.Pointer(in.Cost))
 	return nil
 }
 
@@ -616,6 +1003,9 @@ func autoConvert_scheduling_QueueStatus_To_v1beta1_QueueStatus(in *scheduling.Qu
 		return err
 	}
 	out.Allocated = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Conditions = *(*[]QueueCondition)(unsafe.Pointer(&in.Conditions))
+	out.Reclaim = (*QueueReclaimStatus)(unsafe.Pointer(in.Reclaim))
+	out.Cost = (*QueueCostStatus)(unsafe.Pointer(in.Cost))
 	return nil
 }
 
@@ -624,6 +1014,32 @@ func Convert_scheduling_QueueStatus_To_v1beta1_QueueStatus(in *scheduling.QueueS
 	return autoConvert_scheduling_QueueStatus_To_v1beta1_QueueStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_QueueSubQuota_To_scheduling_QueueSubQuota(in *QueueSubQuota, out *scheduling.QueueSubQuota, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Deserved = *(*v1.ResourceList)(unsafe.Pointer(&in.Deserved))
+	return nil
+}
+
+// Convert_v1beta1_QueueSubQuota_To_scheduling_QueueSubQuota is an autogenerated conversion function.
+func Convert_v1beta1_QueueSubQuota_To_scheduling_QueueSubQuota(in *QueueSubQuota, out *scheduling.QueueSubQuota, s conversion.Scope) error {
+	return autoConvert_v1beta1_QueueSubQuota_To_scheduling_QueueSubQuota(in, out, s)
+}
+
+func autoConvert_scheduling_QueueSubQuota_To_v1beta1_QueueSubQuota(in *scheduling.QueueSubQuota, out *QueueSubQuota, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Deserved = *(*v1.ResourceList)(unsafe.Pointer(&in.Deserved))
+	return nil
+}
+
+// Convert_scheduling_QueueSubQuota_To_v1beta1_QueueSubQuota is an autogenerated conversion function.
+func Convert_scheduling_QueueSubQuota_To_v1beta1_QueueSubQuota(in *scheduling.QueueSubQuota, out *QueueSubQuota, s conversion.Scope) error {
+	return autoConvert_scheduling_QueueSubQuota_To_v1beta1_QueueSubQuota(in, out, s)
+}
+
 func autoConvert_v1beta1_Reservation_To_scheduling_Reservation(in *Reservation, out *scheduling.Reservation, s conversion.Scope) error {
 	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
 	out.Resource = *(*v1.ResourceList)(unsafe.Pointer(&in.Resource))
@@ -646,6 +1062,32 @@ func Convert_scheduling_Reservation_To_v1beta1_Reservation(in *scheduling.Reserv
 	return autoConvert_scheduling_Reservation_To_v1beta1_Reservation(in, out, s)
 }
 
+func autoConvert_v1beta1_ScaleDownRequest_To_scheduling_ScaleDownRequest(in *ScaleDownRequest, out *scheduling.ScaleDownRequest, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Reason = in.Reason
+	out.RequestTime = in.RequestTime
+	out.Deadline = in.Deadline
+	return nil
+}
+
+// Convert_v1beta1_ScaleDownRequest_To_scheduling_ScaleDownRequest is an autogenerated conversion function.
+func Convert_v1beta1_ScaleDownRequest_To_scheduling_ScaleDownRequest(in *ScaleDownRequest, out *scheduling.ScaleDownRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_ScaleDownRequest_To_scheduling_ScaleDownRequest(in, out, s)
+}
+
+func autoConvert_scheduling_ScaleDownRequest_To_v1beta1_ScaleDownRequest(in *scheduling.ScaleDownRequest, out *ScaleDownRequest, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Reason = in.Reason
+	out.RequestTime = in.RequestTime
+	out.Deadline = in.Deadline
+	return nil
+}
+
+// Convert_scheduling_ScaleDownRequest_To_v1beta1_ScaleDownRequest is an autogenerated conversion function.
+func Convert_scheduling_ScaleDownRequest_To_v1beta1_ScaleDownRequest(in *scheduling.ScaleDownRequest, out *ScaleDownRequest, s conversion.Scope) error {
+	return autoConvert_scheduling_ScaleDownRequest_To_v1beta1_ScaleDownRequest(in, out, s)
+}
+
 func autoConvert_v1beta1_SubGroupPolicySpec_To_scheduling_SubGroupPolicySpec(in *SubGroupPolicySpec, out *scheduling.SubGroupPolicySpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.NetworkTopology = (*scheduling.NetworkTopologySpec)(unsafe.Pointer(in.NetworkTopology))
@@ -675,3 +1117,79 @@ func autoConvert_scheduling_SubGroupPolicySpec_To_v1beta1_SubGroupPolicySpec(in
 func Convert_scheduling_SubGroupPolicySpec_To_v1beta1_SubGroupPolicySpec(in *scheduling.SubGroupPolicySpec, out *SubGroupPolicySpec, s conversion.Scope) error {
 	return autoConvert_scheduling_SubGroupPolicySpec_To_v1beta1_SubGroupPolicySpec(in, out, s)
 }
+
+func autoConvert_v1beta1_TaskMemberStatus_To_scheduling_TaskMemberStatus(in *TaskMemberStatus, out *scheduling.TaskMemberStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinMember = in.MinMember
+	out.Scheduled = in.Scheduled
+	out.Running = in.Running
+	return nil
+}
+
+// Convert_v1beta1_TaskMemberStatus_To_scheduling_TaskMemberStatus is an autogenerated conversion function.
+func Convert_v1beta1_TaskMemberStatus_To_scheduling_TaskMemberStatus(in *TaskMemberStatus, out *scheduling.TaskMemberStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_TaskMemberStatus_To_scheduling_TaskMemberStatus(in, out, s)
+}
+
+func autoConvert_scheduling_TaskMemberStatus_To_v1beta1_TaskMemberStatus(in *scheduling.TaskMemberStatus, out *TaskMemberStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinMember = in.MinMember
+	out.Scheduled = in.Scheduled
+	out.Running = in.Running
+	return nil
+}
+
+// Convert_scheduling_TaskMemberStatus_To_v1beta1_TaskMemberStatus is an autogenerated conversion function.
+func Convert_scheduling_TaskMemberStatus_To_v1beta1_TaskMemberStatus(in *scheduling.TaskMemberStatus, out *TaskMemberStatus, s conversion.Scope) error {
+	return autoConvert_scheduling_TaskMemberStatus_To_v1beta1_TaskMemberStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_TaskPlacement_To_scheduling_TaskPlacement(in *TaskPlacement, out *scheduling.TaskPlacement, s conversion.Scope) error {
+	out.Name = in.Name
+	out.TaskSpec = in.TaskSpec
+	out.NodeName = in.NodeName
+	out.HyperNode = in.HyperNode
+	return nil
+}
+
+// Convert_v1beta1_TaskPlacement_To_scheduling_TaskPlacement is an autogenerated conversion function.
+func Convert_v1beta1_TaskPlacement_To_scheduling_TaskPlacement(in *TaskPlacement, out *scheduling.TaskPlacement, s conversion.Scope) error {
+	return autoConvert_v1beta1_TaskPlacement_To_scheduling_TaskPlacement(in, out, s)
+}
+
+func autoConvert_scheduling_TaskPlacement_To_v1beta1_TaskPlacement(in *scheduling.TaskPlacement, out *TaskPlacement, s conversion.Scope) error {
+	out.Name = in.Name
+	out.TaskSpec = in.TaskSpec
+	out.NodeName = in.NodeName
+	out.HyperNode = in.HyperNode
+	return nil
+}
+
+// Convert_scheduling_TaskPlacement_To_v1beta1_TaskPlacement is an autogenerated conversion function.
+func Convert_scheduling_TaskPlacement_To_v1beta1_TaskPlacement(in *scheduling.TaskPlacement, out *TaskPlacement, s conversion.Scope) error {
+	return autoConvert_scheduling_TaskPlacement_To_v1beta1_TaskPlacement(in, out, s)
+}
+
+func autoConvert_v1beta1_TopologyDecision_To_scheduling_TopologyDecision(in *TopologyDecision, out *scheduling.TopologyDecision, s conversion.Scope) error {
+	out.HyperNode = in.HyperNode
+	out.Tier = in.Tier
+	out.Placements = *(*[]scheduling.TaskPlacement)(unsafe.Pointer(&in.Placements))
+	return nil
+}
+
+// Convert_v1beta1_TopologyDecision_To_scheduling_TopologyDecision is an autogenerated conversion function.
+func Convert_v1beta1_TopologyDecision_To_scheduling_TopologyDecision(in *TopologyDecision, out *scheduling.TopologyDecision, s conversion.Scope) error {
+	return autoConvert_v1beta1_TopologyDecision_To_scheduling_TopologyDecision(in, out, s)
+}
+
+func autoConvert_scheduling_TopologyDecision_To_v1beta1_TopologyDecision(in *scheduling.TopologyDecision, out *TopologyDecision, s conversion.Scope) error {
+	out.HyperNode = in.HyperNode
+	out.Tier = in.Tier
+	out.Placements = *(*[]TaskPlacement)(unsafe.Pointer(&in.Placements))
+	return nil
+}
+
+// Convert_scheduling_TopologyDecision_To_v1beta1_TopologyDecision is an autogenerated conversion function.
+func Convert_scheduling_TopologyDecision_To_v1beta1_TopologyDecision(in *scheduling.TopologyDecision, out *TopologyDecision, s conversion.Scope) error {
+	return autoConvert_scheduling_TopologyDecision_To_v1beta1_TopologyDecision(in, out, s)
+}
@@ -306,6 +306,21 @@ func (in *PodGroupStatus) DeepCopyInto(out *PodGroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologyDecision != nil {
+		in, out := &in.TopologyDecision, &out.TopologyDecision
+		*out = new(TopologyDecision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaskMembers != nil {
+		in, out := &in.TaskMembers, &out.TaskMembers
+		*out = make([]TaskMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScaleDownRequest != nil {
+		in, out := &in.ScaleDownRequest, &out.ScaleDownRequest
+		*out = new(ScaleDownRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -347,6 +362,125 @@ func (in *Queue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBudget) DeepCopyInto(out *QueueBudget) {
+	*out = *in
+	out.Monthly = in.Monthly.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBudget.
+func (in *QueueBudget) DeepCopy() *QueueBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBurst) DeepCopyInto(out *QueueBurst) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.RefillRate != nil {
+		in, out := &in.RefillRate, &out.RefillRate
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBurst.
+func (in *QueueBurst) DeepCopy() *QueueBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueCondition) DeepCopyInto(out *QueueCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueCondition.
+func (in *QueueCondition) DeepCopy() *QueueCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueCostStatus) DeepCopyInto(out *QueueCostStatus) {
+	*out = *in
+	out.Spent = in.Spent.DeepCopy()
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueCostStatus.
+func (in *QueueCostStatus) DeepCopy() *QueueCostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueCostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueDeletionPolicy) DeepCopyInto(out *QueueDeletionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueDeletionPolicy.
+func (in *QueueDeletionPolicy) DeepCopy() *QueueDeletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueDeletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueDispatchPolicy) DeepCopyInto(out *QueueDispatchPolicy) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueDispatchPolicy.
+func (in *QueueDispatchPolicy) DeepCopy() *QueueDispatchPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueDispatchPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueList) DeepCopyInto(out *QueueList) {
 	*out = *in
@@ -380,6 +514,82 @@ func (in *QueueList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueNamespacePolicy) DeepCopyInto(out *QueueNamespacePolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueNamespacePolicy.
+func (in *QueueNamespacePolicy) DeepCopy() *QueueNamespacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueNamespacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueQuotaSchedule) DeepCopyInto(out *QueueQuotaSchedule) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Capability != nil {
+		in, out := &in.Capability, &out.Capability
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Deserved != nil {
+		in, out := &in.Deserved, &out.Deserved
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueQuotaSchedule.
+func (in *QueueQuotaSchedule) DeepCopy() *QueueQuotaSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueQuotaSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueReclaimStatus) DeepCopyInto(out *QueueReclaimStatus) {
+	*out = *in
+	if in.LastReclaimTime != nil {
+		in, out := &in.LastReclaimTime, &out.LastReclaimTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueReclaimStatus.
+func (in *QueueReclaimStatus) DeepCopy() *QueueReclaimStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueReclaimStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 	*out = *in
@@ -415,6 +625,57 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.MaxRunSeconds != nil {
+		in, out := &in.MaxRunSeconds, &out.MaxRunSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(QueueBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dispatch != nil {
+		in, out := &in.Dispatch, &out.Dispatch
+		*out = new(QueueDispatchPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(QueueBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaSchedules != nil {
+		in, out := &in.QuotaSchedules, &out.QuotaSchedules
+		*out = make([]QueueQuotaSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespacePolicy != nil {
+		in, out := &in.NamespacePolicy, &out.NamespacePolicy
+		*out = new(QueueNamespacePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubQuotas != nil {
+		in, out := &in.SubQuotas, &out.SubQuotas
+		*out = make([]QueueSubQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(QueueDeletionPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -439,6 +700,23 @@ func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]QueueCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reclaim != nil {
+		in, out := &in.Reclaim, &out.Reclaim
+		*out = new(QueueReclaimStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		*out = new(QueueCostStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -452,6 +730,39 @@ func (in *QueueStatus) DeepCopy() *QueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueSubQuota) DeepCopyInto(out *QueueSubQuota) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deserved != nil {
+		in, out := &in.Deserved, &out.Deserved
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSubQuota.
+func (in *QueueSubQuota) DeepCopy() *QueueSubQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueSubQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Reservation) DeepCopyInto(out *Reservation) {
 	*out = *in
@@ -480,6 +791,24 @@ func (in *Reservation) DeepCopy() *Reservation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleDownRequest) DeepCopyInto(out *ScaleDownRequest) {
+	*out = *in
+	in.RequestTime.DeepCopyInto(&out.RequestTime)
+	in.Deadline.DeepCopyInto(&out.Deadline)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownRequest.
+func (in *ScaleDownRequest) DeepCopy() *ScaleDownRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleDownRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubGroupPolicySpec) DeepCopyInto(out *SubGroupPolicySpec) {
 	*out = *in
@@ -520,3 +849,56 @@ func (in *SubGroupPolicySpec) DeepCopy() *SubGroupPolicySpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskMemberStatus) DeepCopyInto(out *TaskMemberStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskMemberStatus.
+func (in *TaskMemberStatus) DeepCopy() *TaskMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskPlacement) DeepCopyInto(out *TaskPlacement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskPlacement.
+func (in *TaskPlacement) DeepCopy() *TaskPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDecision) DeepCopyInto(out *TopologyDecision) {
+	*out = *in
+	if in.Placements != nil {
+		in, out := &in.Placements, &out.Placements
+		*out = make([]TaskPlacement, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDecision.
+func (in *TopologyDecision) DeepCopy() *TopologyDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDecision)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -19,6 +19,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 )
 
 // JobSpecApplyConfiguration represents a declarative configuration of the JobSpec type for use
@@ -61,6 +62,15 @@ type JobSpecApplyConfiguration struct {
 	MinSuccess *int32 `json:"minSuccess,omitempty"`
 	// NetworkTopology defines the NetworkTopology config, this field works in conjunction with network topology feature and hyperNode CRD.
 	NetworkTopology *NetworkTopologySpecApplyConfiguration `json:"networkTopology,omitempty"`
+	// PodReplacementPolicy specifies when to create the replacement of a pod being deleted, e.g. evicted by reclaim.
+	// `TerminatingOrFailed` replaces the pod as soon as it is terminating, the terminating pod is force deleted
+	// and may run together with its replacement for a while.
+	// `Failed` waits for the pod to be fully terminated, and the restarting job waits for all its terminating pods,
+	// so that no pod of the job runs twice.
+	// If not set, the replacement is created once the pod is gone, without holding the restarting job.
+	PodReplacementPolicy *batchv1alpha1.PodReplacementPolicy `json:"podReplacementPolicy,omitempty"`
+	// Autoscaling scales the replicas of a task by the scale subresource of the job, e.g. by a KEDA ScaledObject.
+	Autoscaling *AutoscalingSpecApplyConfiguration `json:"autoscaling,omitempty"`
 }
 
 // JobSpecApplyConfiguration constructs a declarative configuration of the JobSpec type for use with
@@ -193,3 +203,19 @@ func (b *JobSpecApplyConfiguration) WithNetworkTopology(value *NetworkTopologySp
 	b.NetworkTopology = value
 	return b
 }
+
+// WithPodReplacementPolicy sets the PodReplacementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodReplacementPolicy field is set to the value of the last call.
+func (b *JobSpecApplyConfiguration) WithPodReplacementPolicy(value batchv1alpha1.PodReplacementPolicy) *JobSpecApplyConfiguration {
+	b.PodReplacementPolicy = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *JobSpecApplyConfiguration) WithAutoscaling(value *AutoscalingSpecApplyConfiguration) *JobSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
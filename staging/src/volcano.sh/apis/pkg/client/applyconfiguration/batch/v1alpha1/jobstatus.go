@@ -54,6 +54,8 @@ type JobStatusApplyConfiguration struct {
 	ControlledResources map[string]string `json:"controlledResources,omitempty"`
 	// Which conditions caused the current job state.
 	Conditions []JobConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The status of the task scaled by the scale subresource.
+	Autoscaling *AutoscalingStatusApplyConfiguration `json:"autoscaling,omitempty"`
 }
 
 // JobStatusApplyConfiguration constructs a declarative configuration of the JobStatus type for use with
@@ -190,3 +192,11 @@ func (b *JobStatusApplyConfiguration) WithConditions(values ...*JobConditionAppl
 	}
 	return b
 }
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithAutoscaling(value *AutoscalingStatusApplyConfiguration) *JobStatusApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
@@ -19,6 +19,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apisbatchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	batchv1alpha1 "volcano.sh/apis/pkg/client/applyconfiguration/batch/v1alpha1"
 )
 
@@ -172,6 +173,24 @@ func (b *PatchApplyConfiguration) WithNetworkTopology(value *batchv1alpha1.Netwo
 	return b
 }
 
+// WithPodReplacementPolicy sets the PodReplacementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodReplacementPolicy field is set to the value of the last call.
+func (b *PatchApplyConfiguration) WithPodReplacementPolicy(value apisbatchv1alpha1.PodReplacementPolicy) *PatchApplyConfiguration {
+	b.ensureJobSpecApplyConfigurationExists()
+	b.JobSpecApplyConfiguration.PodReplacementPolicy = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *PatchApplyConfiguration) WithAutoscaling(value *batchv1alpha1.AutoscalingSpecApplyConfiguration) *PatchApplyConfiguration {
+	b.ensureJobSpecApplyConfigurationExists()
+	b.JobSpecApplyConfiguration.Autoscaling = value
+	return b
+}
+
 func (b *PatchApplyConfiguration) ensureJobSpecApplyConfigurationExists() {
 	if b.JobSpecApplyConfiguration == nil {
 		b.JobSpecApplyConfiguration = &batchv1alpha1.JobSpecApplyConfiguration{}
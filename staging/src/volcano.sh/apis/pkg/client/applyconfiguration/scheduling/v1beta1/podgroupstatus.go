@@ -36,6 +36,15 @@ type PodGroupStatusApplyConfiguration struct {
 	Succeeded *int32 `json:"succeeded,omitempty"`
 	// The number of pods which reached phase Failed.
 	Failed *int32 `json:"failed,omitempty"`
+	// TopologyDecision records the topology domain the scheduler chose for the
+	// PodGroup and where each of its tasks was placed, so that launchers can
+	// derive communication topology hints without inspecting nodes.
+	TopologyDecision *TopologyDecisionApplyConfiguration `json:"topologyDecision,omitempty"`
+	// TaskMembers tracks the members of each task with a minimum in minTaskMember, sorted by task name.
+	TaskMembers []TaskMemberStatusApplyConfiguration `json:"taskMembers,omitempty"`
+	// ScaleDownRequest asks the controller of an elastic PodGroup to shrink it,
+	// it is set by the scheduler instead of evicting the members under reclaim pressure.
+	ScaleDownRequest *ScaleDownRequestApplyConfiguration `json:"scaleDownRequest,omitempty"`
 }
 
 // PodGroupStatusApplyConfiguration constructs a declarative configuration of the PodGroupStatus type for use with
@@ -88,3 +97,32 @@ func (b *PodGroupStatusApplyConfiguration) WithFailed(value int32) *PodGroupStat
 	b.Failed = &value
 	return b
 }
+
+// WithTopologyDecision sets the TopologyDecision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyDecision field is set to the value of the last call.
+func (b *PodGroupStatusApplyConfiguration) WithTopologyDecision(value *TopologyDecisionApplyConfiguration) *PodGroupStatusApplyConfiguration {
+	b.TopologyDecision = value
+	return b
+}
+
+// WithTaskMembers adds the given value to the TaskMembers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TaskMembers field.
+func (b *PodGroupStatusApplyConfiguration) WithTaskMembers(values ...*TaskMemberStatusApplyConfiguration) *PodGroupStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTaskMembers")
+		}
+		b.TaskMembers = append(b.TaskMembers, *values[i])
+	}
+	return b
+}
+
+// WithScaleDownRequest sets the ScaleDownRequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleDownRequest field is set to the value of the last call.
+func (b *PodGroupStatusApplyConfiguration) WithScaleDownRequest(value *ScaleDownRequestApplyConfiguration) *PodGroupStatusApplyConfiguration {
+	b.ScaleDownRequest = value
+	return b
+}
@@ -47,6 +47,27 @@ type QueueSpecApplyConfiguration struct {
 	Priority *int32 `json:"priority,omitempty"`
 	// DequeueStrategy defines the dequeue strategy of queue
 	DequeueStrategy *schedulingv1beta1.DequeueStrategy `json:"dequeueStrategy,omitempty"`
+	// MaxRunSeconds is the maximum time in seconds the jobs of the queue may run, the jobs are not limited if not set.
+	MaxRunSeconds *int64 `json:"maxRunSeconds,omitempty"`
+	// MaxRunPolicy is what happens to the jobs running longer than MaxRunSeconds, Terminate by default.
+	MaxRunPolicy *schedulingv1beta1.MaxRunPolicy `json:"maxRunPolicy,omitempty"`
+	// Budget is the monthly cost budget of the queue, the cost of the queue is not limited if not set.
+	Budget *QueueBudgetApplyConfiguration `json:"budget,omitempty"`
+	// Dispatch dispatches the jobs of the queue to member clusters instead of running them in this cluster.
+	Dispatch *QueueDispatchPolicyApplyConfiguration `json:"dispatch,omitempty"`
+	// Burst lets the queue use more than its deserved resources for a while, within a token bucket.
+	Burst *QueueBurstApplyConfiguration `json:"burst,omitempty"`
+	// QuotaSchedules override the capability and the deserved resources of the queue within recurring time windows,
+	// applied by the queue controller.
+	QuotaSchedules []QueueQuotaScheduleApplyConfiguration `json:"quotaSchedules,omitempty"`
+	// NodeSelector binds the queue to the pool of nodes with these labels.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// NamespacePolicy binds the queue to the namespaces selected by their labels.
+	NamespacePolicy *QueueNamespacePolicyApplyConfiguration `json:"namespacePolicy,omitempty"`
+	// SubQuotas share the queue among its users and groups.
+	SubQuotas []QueueSubQuotaApplyConfiguration `json:"subQuotas,omitempty"`
+	// DeletionPolicy is what happens to the jobs of the queue when it is deleted.
+	DeletionPolicy *QueueDeletionPolicyApplyConfiguration `json:"deletionPolicy,omitempty"`
 }
 
 // QueueSpecApplyConfiguration constructs a declarative configuration of the QueueSpec type for use with
@@ -147,3 +168,99 @@ func (b *QueueSpecApplyConfiguration) WithDequeueStrategy(value schedulingv1beta
 	b.DequeueStrategy = &value
 	return b
 }
+
+// WithMaxRunSeconds sets the MaxRunSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRunSeconds field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithMaxRunSeconds(value int64) *QueueSpecApplyConfiguration {
+	b.MaxRunSeconds = &value
+	return b
+}
+
+// WithMaxRunPolicy sets the MaxRunPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRunPolicy field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithMaxRunPolicy(value schedulingv1beta1.MaxRunPolicy) *QueueSpecApplyConfiguration {
+	b.MaxRunPolicy = &value
+	return b
+}
+
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithBudget(value *QueueBudgetApplyConfiguration) *QueueSpecApplyConfiguration {
+	b.Budget = value
+	return b
+}
+
+// WithDispatch sets the Dispatch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Dispatch field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithDispatch(value *QueueDispatchPolicyApplyConfiguration) *QueueSpecApplyConfiguration {
+	b.Dispatch = value
+	return b
+}
+
+// WithBurst sets the Burst field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Burst field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithBurst(value *QueueBurstApplyConfiguration) *QueueSpecApplyConfiguration {
+	b.Burst = value
+	return b
+}
+
+// WithQuotaSchedules adds the given value to the QuotaSchedules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the QuotaSchedules field.
+func (b *QueueSpecApplyConfiguration) WithQuotaSchedules(values ...*QueueQuotaScheduleApplyConfiguration) *QueueSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithQuotaSchedules")
+		}
+		b.QuotaSchedules = append(b.QuotaSchedules, *values[i])
+	}
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *QueueSpecApplyConfiguration) WithNodeSelector(entries map[string]string) *QueueSpecApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithNamespacePolicy sets the NamespacePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespacePolicy field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithNamespacePolicy(value *QueueNamespacePolicyApplyConfiguration) *QueueSpecApplyConfiguration {
+	b.NamespacePolicy = value
+	return b
+}
+
+// WithSubQuotas adds the given value to the SubQuotas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SubQuotas field.
+func (b *QueueSpecApplyConfiguration) WithSubQuotas(values ...*QueueSubQuotaApplyConfiguration) *QueueSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSubQuotas")
+		}
+		b.SubQuotas = append(b.SubQuotas, *values[i])
+	}
+	return b
+}
+
+// WithDeletionPolicy sets the DeletionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPolicy field is set to the value of the last call.
+func (b *QueueSpecApplyConfiguration) WithDeletionPolicy(value *QueueDeletionPolicyApplyConfiguration) *QueueSpecApplyConfiguration {
+	b.DeletionPolicy = value
+	return b
+}
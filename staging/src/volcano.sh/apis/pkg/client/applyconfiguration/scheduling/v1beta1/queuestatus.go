@@ -43,6 +43,12 @@ type QueueStatusApplyConfiguration struct {
 	Reservation *ReservationApplyConfiguration `json:"reservation,omitempty"`
 	// Allocated is allocated resources in queue
 	Allocated *v1.ResourceList `json:"allocated,omitempty"`
+	// Conditions are the current conditions of the queue
+	Conditions []QueueConditionApplyConfiguration `json:"conditions,omitempty"`
+	// Reclaim is the statistics of the reclaims involving the queue over the recent scheduling cycles
+	Reclaim *QueueReclaimStatusApplyConfiguration `json:"reclaim,omitempty"`
+	// Cost is the cost spent by the jobs of the queue in the current month, tracked by the cost plugin of the scheduler
+	Cost *QueueCostStatusApplyConfiguration `json:"cost,omitempty"`
 }
 
 // QueueStatusApplyConfiguration constructs a declarative configuration of the QueueStatus type for use with
@@ -114,3 +120,32 @@ func (b *QueueStatusApplyConfiguration) WithAllocated(value v1.ResourceList) *Qu
 	b.Allocated = &value
 	return b
 }
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *QueueStatusApplyConfiguration) WithConditions(values ...*QueueConditionApplyConfiguration) *QueueStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithReclaim sets the Reclaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reclaim field is set to the value of the last call.
+func (b *QueueStatusApplyConfiguration) WithReclaim(value *QueueReclaimStatusApplyConfiguration) *QueueStatusApplyConfiguration {
+	b.Reclaim = value
+	return b
+}
+
+// WithCost sets the Cost field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cost field is set to the value of the last call.
+func (b *QueueStatusApplyConfiguration) WithCost(value *QueueCostStatusApplyConfiguration) *QueueStatusApplyConfiguration {
+	b.Cost = value
+	return b
+}
@@ -27,6 +27,7 @@ import (
 	datadependencyv1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 	nodeinfov1alpha1 "volcano.sh/apis/pkg/apis/nodeinfo/v1alpha1"
+	schedulingv1alpha1 "volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	v1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	shardv1alpha1 "volcano.sh/apis/pkg/apis/shard/v1alpha1"
 	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
@@ -38,6 +39,7 @@ import (
 	applyconfigurationflowv1alpha1 "volcano.sh/apis/pkg/client/applyconfiguration/flow/v1alpha1"
 	internal "volcano.sh/apis/pkg/client/applyconfiguration/internal"
 	applyconfigurationnodeinfov1alpha1 "volcano.sh/apis/pkg/client/applyconfiguration/nodeinfo/v1alpha1"
+	applyconfigurationschedulingv1alpha1 "volcano.sh/apis/pkg/client/applyconfiguration/scheduling/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/client/applyconfiguration/scheduling/v1beta1"
 	applyconfigurationshardv1alpha1 "volcano.sh/apis/pkg/client/applyconfiguration/shard/v1alpha1"
 	applyconfigurationtopologyv1alpha1 "volcano.sh/apis/pkg/client/applyconfiguration/topology/v1alpha1"
@@ -49,6 +51,10 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=batch.volcano.sh, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AutoscalingSpec"):
+		return &batchv1alpha1.AutoscalingSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AutoscalingStatus"):
+		return &batchv1alpha1.AutoscalingStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CronJob"):
 		return &batchv1alpha1.CronJobApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CronJobSpec"):
@@ -164,6 +170,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	case nodeinfov1alpha1.SchemeGroupVersion.WithKind("ResourceInfo"):
 		return &applyconfigurationnodeinfov1alpha1.ResourceInfoApplyConfiguration{}
 
+		// Group=scheduling.volcano.sh, Version=v1alpha1
+	case schedulingv1alpha1.SchemeGroupVersion.WithKind("Reservation"):
+		return &applyconfigurationschedulingv1alpha1.ReservationApplyConfiguration{}
+	case schedulingv1alpha1.SchemeGroupVersion.WithKind("ReservationSpec"):
+		return &applyconfigurationschedulingv1alpha1.ReservationSpecApplyConfiguration{}
+	case schedulingv1alpha1.SchemeGroupVersion.WithKind("ReservationStatus"):
+		return &applyconfigurationschedulingv1alpha1.ReservationStatusApplyConfiguration{}
+	case schedulingv1alpha1.SchemeGroupVersion.WithKind("ReservedNode"):
+		return &applyconfigurationschedulingv1alpha1.ReservedNodeApplyConfiguration{}
+
 		// Group=scheduling.volcano.sh, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithKind("Affinity"):
 		return &schedulingv1beta1.AffinityApplyConfiguration{}
@@ -187,14 +203,42 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &schedulingv1beta1.PodGroupStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Queue"):
 		return &schedulingv1beta1.QueueApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueBudget"):
+		return &schedulingv1beta1.QueueBudgetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueBurst"):
+		return &schedulingv1beta1.QueueBurstApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueCondition"):
+		return &schedulingv1beta1.QueueConditionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueCostStatus"):
+		return &schedulingv1beta1.QueueCostStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueDeletionPolicy"):
+		return &schedulingv1beta1.QueueDeletionPolicyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueDispatchPolicy"):
+		return &schedulingv1beta1.QueueDispatchPolicyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueNamespacePolicy"):
+		return &schedulingv1beta1.QueueNamespacePolicyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueQuotaSchedule"):
+		return &schedulingv1beta1.QueueQuotaScheduleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueReclaimStatus"):
+		return &schedulingv1beta1.QueueReclaimStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("QueueSpec"):
 		return &schedulingv1beta1.QueueSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("QueueStatus"):
 		return &schedulingv1beta1.QueueStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueSubQuota"):
+		return &schedulingv1beta1.QueueSubQuotaApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Reservation"):
 		return &schedulingv1beta1.ReservationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ScaleDownRequest"):
+		return &schedulingv1beta1.ScaleDownRequestApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SubGroupPolicySpec"):
 		return &schedulingv1beta1.SubGroupPolicySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskMemberStatus"):
+		return &schedulingv1beta1.TaskMemberStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TaskPlacement"):
+		return &schedulingv1beta1.TaskPlacementApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TopologyDecision"):
+		return &schedulingv1beta1.TopologyDecisionApplyConfiguration{}
 
 		// Group=shard.volcano.sh, Version=v1alpha1
 	case shardv1alpha1.SchemeGroupVersion.WithKind("NodeShard"):
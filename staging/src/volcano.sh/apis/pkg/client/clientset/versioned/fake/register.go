@@ -29,6 +29,7 @@ import (
 	datadependencyv1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 	nodeinfov1alpha1 "volcano.sh/apis/pkg/apis/nodeinfo/v1alpha1"
+	schedulingv1alpha1 "volcano.sh/apis/pkg/apis/scheduling/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	shardv1alpha1 "volcano.sh/apis/pkg/apis/shard/v1alpha1"
 	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
@@ -45,6 +46,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	datadependencyv1alpha1.AddToScheme,
 	flowv1alpha1.AddToScheme,
 	nodeinfov1alpha1.AddToScheme,
+	schedulingv1alpha1.AddToScheme,
 	schedulingv1beta1.AddToScheme,
 	shardv1alpha1.AddToScheme,
 	topologyv1alpha1.AddToScheme,